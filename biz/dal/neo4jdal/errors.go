@@ -4,3 +4,21 @@ import "errors"
 
 // ErrNotFound 表示在数据库中未找到请求的记录。
 var ErrNotFound = errors.New("neo4jdal: record not found")
+
+// ErrInvalidFilter 表示搜索过滤表达式无效 (字段名非法、缺少取值、操作符与取值类型不匹配等)。
+var ErrInvalidFilter = errors.New("neo4jdal: invalid search filter")
+
+// ErrInvalidSort 表示排序键无效 (字段名非法或不可排序、字段重复、排序方向未知等)。
+var ErrInvalidSort = errors.New("neo4jdal: invalid sort")
+
+// ErrInvalidFacet 表示分面字段无效 (字段名非法或不可统计、字段重复、数量超限等)。
+var ErrInvalidFacet = errors.New("neo4jdal: invalid facet")
+
+// ErrTypeDefExists 表示同名的类型定义已经存在 (创建时违反 name 唯一约束)。
+var ErrTypeDefExists = errors.New("neo4jdal: type definition already exists")
+
+// ErrNotPerson 表示推荐的目标节点不是 PERSON 节点。
+var ErrNotPerson = errors.New("neo4jdal: node is not a person")
+
+// ErrPathSearchTooLarge 表示路径搜索超出了展开预算 (图过于稠密或跳数过大)，调用方应减小最大深度或增加约束。
+var ErrPathSearchTooLarge = errors.New("neo4jdal: path search too large")
@@ -0,0 +1,111 @@
+package neo4jdal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FacetTypeField 按节点类型 (标签) 统计的分面字段
+const FacetTypeField = "type"
+
+// 分面的数量限制
+const (
+	MaxFacets        = 5
+	DefaultFacetSize = 10
+	MaxFacetSize     = 100
+)
+
+// facetOverFetch 属性分面多读取的倍数：数据库中不同的取值转换为字符串后可能相同 (如 1 与 "1")，
+// 调用方合并后再截取 Size 个
+const facetOverFetch = 2
+
+// FacetRequest 是已校验的分面请求：按 Fields 分别统计全部匹配节点的取值，每个字段最多返回 Size 个取值
+type FacetRequest struct {
+	Fields []string
+	Size   int64
+	// Labels 非空时 type 分面只统计这些标签 (已注册节点类型的名称)，在查询中过滤后再截取
+	Labels []string
+}
+
+// FacetCount 是分面中的一个取值 (数据库中的原始值，type 分面为节点标签) 及其节点数
+type FacetCount struct {
+	Value any
+	Count int64
+}
+
+// Facet 是一个字段的分面统计，Counts 按节点数降序、取值升序排列
+type Facet struct {
+	Field  string
+	Counts []FacetCount
+}
+
+// NewFacetRequest 校验分面字段：字段为 FacetTypeField 或合法的属性名，同一字段只能出现一次，
+// allowed 非 nil 时属性必须被其接受。size <= 0 时使用 DefaultFacetSize。没有分面字段时返回 nil。
+func NewFacetRequest(fields []string, size int32, allowed func(field string) bool) (*FacetRequest, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	if len(fields) > MaxFacets {
+		return nil, fmt.Errorf("%w: 分面字段超过 %d 个", ErrInvalidFacet, MaxFacets)
+	}
+	if size > MaxFacetSize {
+		return nil, fmt.Errorf("%w: facet_size 不能超过 %d", ErrInvalidFacet, MaxFacetSize)
+	}
+	req := &FacetRequest{Fields: make([]string, 0, len(fields)), Size: int64(size)}
+	if req.Size <= 0 {
+		req.Size = DefaultFacetSize
+	}
+	for _, f := range fields {
+		if f != FacetTypeField {
+			if !filterFieldPattern.MatchString(f) {
+				return nil, fmt.Errorf("%w: 非法的字段名 '%s'", ErrInvalidFacet, f)
+			}
+			if allowed != nil && !allowed(f) {
+				return nil, fmt.Errorf("%w: 字段 '%s' 不支持分面统计", ErrInvalidFacet, f)
+			}
+		}
+		for _, seen := range req.Fields {
+			if seen == f {
+				return nil, fmt.Errorf("%w: 字段 '%s' 重复", ErrInvalidFacet, f)
+			}
+		}
+		req.Fields = append(req.Fields, f)
+	}
+	return req, nil
+}
+
+// Key 返回分面请求的规范字符串 (如 "10:type,city")，用于缓存键；nil 时返回空字符串
+func (r *FacetRequest) Key() string {
+	if r == nil {
+		return ""
+	}
+	return strconv.FormatInt(r.Size, 10) + ":" + strings.Join(r.Fields, ",")
+}
+
+// FetchSize 返回统计 field 时从数据库读取的取值个数上限：type 分面为 Size，属性分面多读取一些供合并
+func (r *FacetRequest) FetchSize(field string) int64 {
+	if field == FacetTypeField {
+		return r.Size
+	}
+	return r.Size * facetOverFetch
+}
+
+// facetQuery 返回统计一个分面的查询，matchWhere 为搜索的 MATCH ... WHERE 部分 (与计数查询相同)。
+// filterLabels 为 true 时 type 分面只统计 $facetLabels 中的标签。
+// type 分面最多返回 $facetSize 个取值，属性分面最多返回 $facetFetchSize 个。
+func facetQuery(matchWhere, field string, filterLabels bool) (string, error) {
+	const tail = " RETURN value, count(*) AS count ORDER BY count DESC, value LIMIT "
+	if field == FacetTypeField {
+		query := matchWhere + " WITH DISTINCT n UNWIND labels(n) AS value"
+		if filterLabels {
+			query += " WITH value WHERE value IN $facetLabels"
+		}
+		return query + tail + "$facetSize", nil
+	}
+	if !filterFieldPattern.MatchString(field) {
+		return "", fmt.Errorf("%w: 非法的字段名 '%s'", ErrInvalidFacet, field)
+	}
+	prop := fmt.Sprintf("n.`%s`", field)
+	return matchWhere + " WITH DISTINCT n WHERE " + prop + " IS NOT NULL WITH " + prop + " AS value" + tail + "$facetFetchSize", nil
+}
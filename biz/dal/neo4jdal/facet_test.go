@@ -0,0 +1,62 @@
+package neo4jdal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFacetRequest(t *testing.T) {
+	req, err := NewFacetRequest(nil, 5, nil)
+	require.NoError(t, err)
+	assert.Nil(t, req, "未设置分面字段时不统计")
+	assert.Empty(t, req.Key())
+
+	allowed := func(f string) bool { return f != "avatar" }
+	req, err = NewFacetRequest([]string{"type", "city"}, 0, allowed)
+	require.NoError(t, err)
+	assert.Equal(t, &FacetRequest{Fields: []string{"type", "city"}, Size: DefaultFacetSize}, req)
+	assert.Equal(t, "10:type,city", req.Key())
+
+	invalid := []struct {
+		fields []string
+		size   int32
+	}{
+		{[]string{"n.name; DROP"}, 0},
+		{[]string{"avatar"}, 0},
+		{[]string{"city", "city"}, 0},
+		{[]string{"type"}, MaxFacetSize + 1},
+		{[]string{"a", "b", "c", "d", "e", "f"}, 0},
+	}
+	for _, tc := range invalid {
+		_, err := NewFacetRequest(tc.fields, tc.size, allowed)
+		assert.ErrorIs(t, err, ErrInvalidFacet, tc.fields)
+	}
+}
+
+func TestFacetQuery(t *testing.T) {
+	const matchWhere = "MATCH (n:Person) WHERE n.name = $name"
+	query, err := facetQuery(matchWhere, FacetTypeField, false)
+	require.NoError(t, err)
+	assert.Equal(t, matchWhere+" WITH DISTINCT n UNWIND labels(n) AS value"+
+		" RETURN value, count(*) AS count ORDER BY count DESC, value LIMIT $facetSize", query)
+
+	// 标签在截取之前过滤，未注册的标签不会占用名额
+	query, err = facetQuery(matchWhere, FacetTypeField, true)
+	require.NoError(t, err)
+	assert.Equal(t, matchWhere+" WITH DISTINCT n UNWIND labels(n) AS value WITH value WHERE value IN $facetLabels"+
+		" RETURN value, count(*) AS count ORDER BY count DESC, value LIMIT $facetSize", query)
+
+	query, err = facetQuery(matchWhere, "city", true)
+	require.NoError(t, err)
+	assert.Equal(t, matchWhere+" WITH DISTINCT n WHERE n.`city` IS NOT NULL WITH n.`city` AS value"+
+		" RETURN value, count(*) AS count ORDER BY count DESC, value LIMIT $facetFetchSize", query)
+
+	_, err = facetQuery(matchWhere, "x` RETURN 1", false)
+	assert.ErrorIs(t, err, ErrInvalidFacet)
+
+	req := &FacetRequest{Fields: []string{"type", "city"}, Size: 10}
+	assert.Equal(t, int64(10), req.FetchSize(FacetTypeField))
+	assert.Equal(t, int64(20), req.FetchSize("city"), "属性分面多读取，合并后再截取")
+}
@@ -0,0 +1,336 @@
+package neo4jdal
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/propvalue"
+)
+
+// 过滤表达式的规模限制，防止生成过大的查询
+const (
+	maxFilterDepth      = 4   // 分组最大嵌套层数 (顶层为第 1 层)
+	maxFilterConditions = 50  // 所有层级的条件总数上限
+	maxFilterInValues   = 100 // 单个 IN 条件的取值个数上限
+)
+
+// filterFieldPattern 过滤字段名只允许字母、数字和下划线，编译时直接写入 Cypher
+var filterFieldPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,63}$`)
+
+// ValidateNodeFilter 校验节点搜索的过滤表达式，nil 表示不过滤。错误均包装 ErrInvalidFilter。
+func ValidateNodeFilter(expr *network.FilterExpr) error {
+	count := 0
+	return validateFilterExpr(expr, 1, &count)
+}
+
+func validateFilterExpr(expr *network.FilterExpr, depth int, count *int) error {
+	if expr == nil {
+		return nil
+	}
+	if depth > maxFilterDepth {
+		return fmt.Errorf("%w: 分组嵌套不能超过 %d 层", ErrInvalidFilter, maxFilterDepth)
+	}
+	if expr.Logic != nil && *expr.Logic != network.FilterLogic_AND && *expr.Logic != network.FilterLogic_OR {
+		return fmt.Errorf("%w: 无效的逻辑运算 %d", ErrInvalidFilter, *expr.Logic)
+	}
+	for _, c := range expr.Conditions {
+		*count++
+		if *count > maxFilterConditions {
+			return fmt.Errorf("%w: 条件总数不能超过 %d", ErrInvalidFilter, maxFilterConditions)
+		}
+		if err := validateFilterCondition(c); err != nil {
+			return err
+		}
+	}
+	for _, g := range expr.Groups {
+		if err := validateFilterExpr(g, depth+1, count); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateFilterCondition(c *network.FilterCondition) error {
+	if c == nil {
+		return fmt.Errorf("%w: 条件不能为空", ErrInvalidFilter)
+	}
+	if !filterFieldPattern.MatchString(c.Field) {
+		return fmt.Errorf("%w: 无效的字段名 %q", ErrInvalidFilter, c.Field)
+	}
+	var values []*network.PropertyValue
+	switch c.Op {
+	case network.FilterOp_EXISTS, network.FilterOp_NOT_EXISTS:
+		if c.Value != nil || len(c.Values) > 0 {
+			return fmt.Errorf("%w: 字段 %s: %s 不接受取值", ErrInvalidFilter, c.Field, c.Op)
+		}
+		if c.GetCaseInsensitive() {
+			return fmt.Errorf("%w: 字段 %s: case_insensitive 仅适用于字符串比较", ErrInvalidFilter, c.Field)
+		}
+		return nil
+	case network.FilterOp_IN:
+		if c.Value != nil {
+			return fmt.Errorf("%w: 字段 %s: IN 须使用 values 而不是 value", ErrInvalidFilter, c.Field)
+		}
+		if len(c.Values) == 0 || len(c.Values) > maxFilterInValues {
+			return fmt.Errorf("%w: 字段 %s: IN 的取值个数须在 1 到 %d 之间", ErrInvalidFilter, c.Field, maxFilterInValues)
+		}
+		values = c.Values
+	case network.FilterOp_EQ, network.FilterOp_NE,
+		network.FilterOp_CONTAINS, network.FilterOp_STARTS_WITH, network.FilterOp_ENDS_WITH,
+		network.FilterOp_GT, network.FilterOp_GTE, network.FilterOp_LT, network.FilterOp_LTE:
+		if c.Value == nil || len(c.Values) > 0 {
+			return fmt.Errorf("%w: 字段 %s: %s 须且只能设置 value", ErrInvalidFilter, c.Field, c.Op)
+		}
+		values = []*network.PropertyValue{c.Value}
+	default:
+		return fmt.Errorf("%w: 字段 %s: 无效的操作符 %d", ErrInvalidFilter, c.Field, c.Op)
+	}
+
+	for _, v := range values {
+		if _, err := propvalue.ToDB(v); err != nil {
+			return fmt.Errorf("%w: 字段 %s: %v", ErrInvalidFilter, c.Field, err)
+		}
+		switch {
+		case isStringFilterOp(c.Op) && v.Kind != network.PropertyValueKind_STRING:
+			return fmt.Errorf("%w: 字段 %s: %s 只支持 STRING 取值", ErrInvalidFilter, c.Field, c.Op)
+		case isRangeFilterOp(c.Op) && (v.Kind == network.PropertyValueKind_BOOL || v.Kind == network.PropertyValueKind_LIST):
+			return fmt.Errorf("%w: 字段 %s: 范围比较不支持 %s 取值", ErrInvalidFilter, c.Field, v.Kind)
+		case c.Op == network.FilterOp_IN && v.Kind == network.PropertyValueKind_LIST:
+			return fmt.Errorf("%w: 字段 %s: IN 的取值不能是 LIST", ErrInvalidFilter, c.Field)
+		case c.GetCaseInsensitive() && v.Kind != network.PropertyValueKind_STRING:
+			return fmt.Errorf("%w: 字段 %s: case_insensitive 仅适用于字符串比较", ErrInvalidFilter, c.Field)
+		}
+	}
+	return nil
+}
+
+func isStringFilterOp(op network.FilterOp) bool {
+	return op == network.FilterOp_CONTAINS || op == network.FilterOp_STARTS_WITH || op == network.FilterOp_ENDS_WITH
+}
+
+func isRangeFilterOp(op network.FilterOp) bool {
+	return op == network.FilterOp_GT || op == network.FilterOp_GTE || op == network.FilterOp_LT || op == network.FilterOp_LTE
+}
+
+// NormalizeNodeFilter 返回已校验过滤表达式的规范形式 (不修改入参)：逻辑默认为 AND，去掉空分组，
+// 展开只有一个子项或与上层逻辑相同的分组，忽略大小写时取值转为小写，条件、分组和 IN 取值按规范顺序排列并去重。
+// 语义相同的写法规范化后得到相同的 FilterKey；不含任何条件时返回 nil。
+func NormalizeNodeFilter(expr *network.FilterExpr) *network.FilterExpr {
+	out := normalizeFilterExpr(expr)
+	if out != nil && len(out.Conditions)+len(out.Groups) == 1 {
+		logic := network.FilterLogic_AND
+		out.Logic = &logic
+	}
+	return out
+}
+
+func normalizeFilterExpr(expr *network.FilterExpr) *network.FilterExpr {
+	if expr == nil {
+		return nil
+	}
+	logic := network.FilterLogic_AND
+	if expr.Logic != nil {
+		logic = *expr.Logic
+	}
+	out := &network.FilterExpr{Logic: &logic}
+	for _, c := range expr.Conditions {
+		out.Conditions = append(out.Conditions, normalizeFilterCondition(c))
+	}
+	for _, g := range expr.Groups {
+		ng := normalizeFilterExpr(g)
+		if ng == nil {
+			continue
+		}
+		if *ng.Logic == logic || (len(ng.Conditions) == 1 && len(ng.Groups) == 0) {
+			out.Conditions = append(out.Conditions, ng.Conditions...)
+			out.Groups = append(out.Groups, ng.Groups...)
+			continue
+		}
+		out.Groups = append(out.Groups, ng)
+	}
+	out.Conditions = sortUniqueByKey(out.Conditions)
+	out.Groups = sortUniqueByKey(out.Groups)
+
+	switch {
+	case len(out.Conditions)+len(out.Groups) == 0:
+		return nil
+	case len(out.Conditions) == 0 && len(out.Groups) == 1:
+		return out.Groups[0]
+	}
+	return out
+}
+
+func normalizeFilterCondition(c *network.FilterCondition) *network.FilterCondition {
+	out := &network.FilterCondition{Field: c.Field, Op: c.Op}
+	ci := c.GetCaseInsensitive()
+	if ci {
+		out.CaseInsensitive = &ci
+	}
+	normalize := func(v *network.PropertyValue) *network.PropertyValue {
+		if !ci {
+			return v
+		}
+		lower := strings.ToLower(v.GetStringValue())
+		return &network.PropertyValue{Kind: v.Kind, StringValue: &lower}
+	}
+	if c.Value != nil {
+		out.Value = normalize(c.Value)
+	}
+	for _, v := range c.Values {
+		out.Values = append(out.Values, normalize(v))
+	}
+	out.Values = sortUniqueByKey(out.Values)
+	return out
+}
+
+// FilterKey 返回规范化过滤表达式的字符串形式，用作缓存键的一部分；nil 返回空串
+func FilterKey(expr *network.FilterExpr) string {
+	if expr == nil {
+		return ""
+	}
+	return filterJSONKey(expr)
+}
+
+func filterJSONKey(v any) string {
+	// Thrift 结构体的 JSON 序列化只包含已设置的字段且顺序固定，可直接作为规范形式
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+// sortUniqueByKey 按 JSON 形式排序并去掉重复项
+func sortUniqueByKey[T any](items []T) []T {
+	if len(items) < 2 {
+		return items
+	}
+	keys := make(map[string]T, len(items))
+	order := make([]string, 0, len(items))
+	for _, item := range items {
+		k := filterJSONKey(item)
+		if _, dup := keys[k]; dup {
+			continue
+		}
+		keys[k] = item
+		order = append(order, k)
+	}
+	sort.Strings(order)
+	out := make([]T, len(order))
+	for i, k := range order {
+		out[i] = keys[k]
+	}
+	return out
+}
+
+// filterCompiler 将过滤表达式编译为作用于节点变量 n 的 Cypher 条件，取值全部参数化
+type filterCompiler struct {
+	params map[string]any
+	next   int
+}
+
+// compileNodeFilter 编译已校验的过滤表达式，参数 (以 f_ 开头) 写入 params；nil 返回空串
+func compileNodeFilter(expr *network.FilterExpr, params map[string]any) (string, error) {
+	if expr == nil {
+		return "", nil
+	}
+	c := &filterCompiler{params: params}
+	return c.expr(expr)
+}
+
+func (c *filterCompiler) expr(expr *network.FilterExpr) (string, error) {
+	var parts []string
+	for _, cond := range expr.Conditions {
+		part, err := c.condition(cond)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, part)
+	}
+	for _, g := range expr.Groups {
+		part, err := c.expr(g)
+		if err != nil {
+			return "", err
+		}
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	if len(parts) == 0 {
+		return "", nil
+	}
+	sep := " AND "
+	if expr.Logic != nil && *expr.Logic == network.FilterLogic_OR {
+		sep = " OR "
+	}
+	return "(" + strings.Join(parts, sep) + ")", nil
+}
+
+func (c *filterCompiler) condition(cond *network.FilterCondition) (string, error) {
+	if !filterFieldPattern.MatchString(cond.Field) {
+		return "", fmt.Errorf("%w: 无效的字段名 %q", ErrInvalidFilter, cond.Field)
+	}
+	prop := fmt.Sprintf("n.`%s`", cond.Field)
+	ci := cond.GetCaseInsensitive()
+	if ci {
+		// toStringOrNull 使非字符串标量可比较，列表等类型得到 null (不匹配)
+		prop = fmt.Sprintf("toLower(toStringOrNull(%s))", prop)
+	}
+
+	switch cond.Op {
+	case network.FilterOp_EXISTS:
+		return prop + " IS NOT NULL", nil
+	case network.FilterOp_NOT_EXISTS:
+		return prop + " IS NULL", nil
+	case network.FilterOp_IN:
+		list := make([]any, 0, len(cond.Values))
+		for _, v := range cond.Values {
+			dbValue, err := c.value(v, ci)
+			if err != nil {
+				return "", fmt.Errorf("%w: 字段 %s: %v", ErrInvalidFilter, cond.Field, err)
+			}
+			list = append(list, dbValue)
+		}
+		return fmt.Sprintf("%s IN $%s", prop, c.param(list)), nil
+	}
+
+	operators := map[network.FilterOp]string{
+		network.FilterOp_EQ:          "=",
+		network.FilterOp_NE:          "<>",
+		network.FilterOp_CONTAINS:    "CONTAINS",
+		network.FilterOp_STARTS_WITH: "STARTS WITH",
+		network.FilterOp_ENDS_WITH:   "ENDS WITH",
+		network.FilterOp_GT:          ">",
+		network.FilterOp_GTE:         ">=",
+		network.FilterOp_LT:          "<",
+		network.FilterOp_LTE:         "<=",
+	}
+	operator, ok := operators[cond.Op]
+	if !ok {
+		return "", fmt.Errorf("%w: 字段 %s: 无效的操作符 %d", ErrInvalidFilter, cond.Field, cond.Op)
+	}
+	dbValue, err := c.value(cond.Value, ci)
+	if err != nil {
+		return "", fmt.Errorf("%w: 字段 %s: %v", ErrInvalidFilter, cond.Field, err)
+	}
+	return fmt.Sprintf("%s %s $%s", prop, operator, c.param(dbValue)), nil
+}
+
+func (c *filterCompiler) value(v *network.PropertyValue, lower bool) (any, error) {
+	dbValue, err := propvalue.ToDB(v)
+	if err != nil {
+		return nil, err
+	}
+	if s, ok := dbValue.(string); ok && lower {
+		return strings.ToLower(s), nil
+	}
+	return dbValue, nil
+}
+
+func (c *filterCompiler) param(value any) string {
+	name := fmt.Sprintf("f_%d", c.next)
+	c.next++
+	c.params[name] = value
+	return name
+}
@@ -0,0 +1,118 @@
+package neo4jdal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	network "labelwall/biz/model/relationship/network"
+)
+
+func strValue(v string) *network.PropertyValue {
+	return &network.PropertyValue{Kind: network.PropertyValueKind_STRING, StringValue: &v}
+}
+
+func intValue(v int64) *network.PropertyValue {
+	return &network.PropertyValue{Kind: network.PropertyValueKind_INT, IntValue: &v}
+}
+
+func filterLogic(l network.FilterLogic) *network.FilterLogic { return &l }
+
+func TestValidateNodeFilter(t *testing.T) {
+	b, ci := true, true
+	valid := &network.FilterExpr{
+		Logic: filterLogic(network.FilterLogic_OR),
+		Conditions: []*network.FilterCondition{
+			{Field: "name", Op: network.FilterOp_STARTS_WITH, Value: strValue("Al"), CaseInsensitive: &ci},
+			{Field: "age", Op: network.FilterOp_GTE, Value: intValue(18)},
+			{Field: "city", Op: network.FilterOp_IN, Values: []*network.PropertyValue{strValue("北京"), strValue("上海")}},
+			{Field: "email", Op: network.FilterOp_NOT_EXISTS},
+		},
+		Groups: []*network.FilterExpr{{Conditions: []*network.FilterCondition{
+			{Field: "birthday", Op: network.FilterOp_LT, Value: &network.PropertyValue{Kind: network.PropertyValueKind_DATE, DateValue: strValue("2000-01-01").StringValue}},
+		}}},
+	}
+	require.NoError(t, ValidateNodeFilter(valid))
+	require.NoError(t, ValidateNodeFilter(nil))
+
+	cond := func(c *network.FilterCondition) *network.FilterExpr {
+		return &network.FilterExpr{Conditions: []*network.FilterCondition{c}}
+	}
+	deep := &network.FilterExpr{}
+	for i, e := 0, deep; i < maxFilterDepth; i++ {
+		child := &network.FilterExpr{}
+		e.Groups = []*network.FilterExpr{child}
+		e = child
+	}
+	invalid := map[string]*network.FilterExpr{
+		"非法字段名":         cond(&network.FilterCondition{Field: "a`) DETACH DELETE n //", Op: network.FilterOp_EQ, Value: strValue("x")}),
+		"缺少取值":          cond(&network.FilterCondition{Field: "age", Op: network.FilterOp_GT}),
+		"取值与类型不符":       cond(&network.FilterCondition{Field: "age", Op: network.FilterOp_EQ, Value: &network.PropertyValue{Kind: network.PropertyValueKind_INT}}),
+		"CONTAINS 非字符串": cond(&network.FilterCondition{Field: "age", Op: network.FilterOp_CONTAINS, Value: intValue(1)}),
+		"范围比较布尔值":       cond(&network.FilterCondition{Field: "ok", Op: network.FilterOp_GT, Value: &network.PropertyValue{Kind: network.PropertyValueKind_BOOL, BoolValue: &b}}),
+		"空 IN":          cond(&network.FilterCondition{Field: "city", Op: network.FilterOp_IN}),
+		"EXISTS 带取值":    cond(&network.FilterCondition{Field: "city", Op: network.FilterOp_EXISTS, Value: strValue("x")}),
+		"忽略大小写非字符串":     cond(&network.FilterCondition{Field: "age", Op: network.FilterOp_EQ, Value: intValue(1), CaseInsensitive: &ci}),
+		"无效操作符":         cond(&network.FilterCondition{Field: "age", Op: 99, Value: intValue(1)}),
+		"无效逻辑":          {Logic: filterLogic(7)},
+		"嵌套过深":          deep,
+	}
+	for name, expr := range invalid {
+		assert.ErrorIs(t, ValidateNodeFilter(expr), ErrInvalidFilter, name)
+	}
+}
+
+func TestNormalizeNodeFilter(t *testing.T) {
+	ci := true
+	a := &network.FilterCondition{Field: "age", Op: network.FilterOp_GT, Value: intValue(18)}
+	b := &network.FilterCondition{Field: "city", Op: network.FilterOp_IN, Values: []*network.PropertyValue{strValue("上海"), strValue("北京"), strValue("上海")}}
+	c := &network.FilterCondition{Field: "name", Op: network.FilterOp_EQ, Value: strValue("Alice"), CaseInsensitive: &ci}
+
+	x := &network.FilterExpr{Conditions: []*network.FilterCondition{c, b}, Groups: []*network.FilterExpr{
+		{Conditions: []*network.FilterCondition{a}},
+		{Logic: filterLogic(network.FilterLogic_OR)},
+	}}
+	y := &network.FilterExpr{Logic: filterLogic(network.FilterLogic_AND), Conditions: []*network.FilterCondition{a}, Groups: []*network.FilterExpr{
+		{Conditions: []*network.FilterCondition{b, c}},
+	}}
+	nx, ny := NormalizeNodeFilter(x), NormalizeNodeFilter(y)
+	assert.Equal(t, FilterKey(nx), FilterKey(ny), "语义相同的写法规范化后键相同")
+	assert.Empty(t, nx.Groups, "同逻辑和单条件分组被展开，空分组被去掉")
+	require.Len(t, nx.Conditions, 3)
+	assert.Equal(t, "age", nx.Conditions[0].Field)
+	assert.Len(t, nx.Conditions[1].Values, 2, "IN 取值去重")
+	assert.Equal(t, "alice", nx.Conditions[2].Value.GetStringValue(), "忽略大小写时取值转为小写")
+	assert.Equal(t, "Alice", c.Value.GetStringValue(), "不修改入参")
+
+	single := NormalizeNodeFilter(&network.FilterExpr{Logic: filterLogic(network.FilterLogic_OR), Conditions: []*network.FilterCondition{a}})
+	assert.Equal(t, network.FilterLogic_AND, single.GetLogic())
+	assert.Nil(t, NormalizeNodeFilter(&network.FilterExpr{Groups: []*network.FilterExpr{{}}}))
+	assert.Empty(t, FilterKey(nil))
+}
+
+func TestCompileNodeFilter(t *testing.T) {
+	ci := true
+	expr := &network.FilterExpr{
+		Conditions: []*network.FilterCondition{
+			{Field: "name", Op: network.FilterOp_CONTAINS, Value: strValue("Al"), CaseInsensitive: &ci},
+			{Field: "email", Op: network.FilterOp_EXISTS},
+		},
+		Groups: []*network.FilterExpr{{
+			Logic: filterLogic(network.FilterLogic_OR),
+			Conditions: []*network.FilterCondition{
+				{Field: "age", Op: network.FilterOp_LTE, Value: intValue(30)},
+				{Field: "city", Op: network.FilterOp_IN, Values: []*network.PropertyValue{strValue("北京")}},
+			},
+		}},
+	}
+	params := map[string]any{}
+	clause, err := compileNodeFilter(expr, params)
+	require.NoError(t, err)
+	assert.Equal(t, "(toLower(toStringOrNull(n.`name`)) CONTAINS $f_0 AND n.`email` IS NOT NULL AND (n.`age` <= $f_1 OR n.`city` IN $f_2))", clause)
+	assert.Equal(t, map[string]any{"f_0": "al", "f_1": int64(30), "f_2": []any{"北京"}}, params)
+
+	clause, err = compileNodeFilter(nil, params)
+	require.NoError(t, err)
+	assert.Empty(t, clause)
+}
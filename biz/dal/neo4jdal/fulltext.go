@@ -0,0 +1,206 @@
+package neo4jdal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/fulltext"
+	"labelwall/pkg/typeregistry"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+// NodeFulltextIndex 节点全文索引的名称
+const NodeFulltextIndex = "node_fulltext_index"
+
+// FulltextNodeHit 是全文搜索命中的节点
+type FulltextNodeHit struct {
+	Node   neo4j.Node
+	Labels []string
+	Score  float64
+}
+
+// NodeFulltextIndexSpec 返回全文索引覆盖的标签和属性：所有节点类型，name、profession
+// 以及各类型属性约束中声明的文本属性 (string、enum、url)。标签和自定义属性均按名称排序。
+func NodeFulltextIndexSpec(defs []typeregistry.NodeTypeDef) (labels, props []string) {
+	custom := make(map[string]bool)
+	for _, def := range defs {
+		if !slices.Contains(labels, def.Name) {
+			labels = append(labels, def.Name)
+		}
+		for _, p := range def.Properties {
+			switch p.Type {
+			case "", typeregistry.ValueString, typeregistry.ValueEnum, typeregistry.ValueURL:
+				custom[p.Key] = true
+			}
+		}
+	}
+	sort.Strings(labels)
+	props = []string{"name", "profession"}
+	var keys []string
+	for k := range custom {
+		if !slices.Contains(props, k) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return labels, append(props, keys...)
+}
+
+// nodeFulltextIndexQuery 返回创建全文索引的语句 (标签和属性名来自类型注册表，已校验)
+func nodeFulltextIndexQuery(labels, props []string) string {
+	fields := make([]string, len(props))
+	for i, p := range props {
+		fields[i] = fmt.Sprintf("n.`%s`", p)
+	}
+	return fmt.Sprintf("CREATE FULLTEXT INDEX %s IF NOT EXISTS FOR (n:%s) ON EACH [%s]",
+		NodeFulltextIndex, strings.Join(labels, "|"), strings.Join(fields, ", "))
+}
+
+func (d *neo4jTypeDAL) ExecEnsureNodeFulltextIndex(ctx context.Context, session neo4j.SessionWithContext, defs []typeregistry.NodeTypeDef) error {
+	labels, props := NodeFulltextIndexSpec(defs)
+	if len(labels) == 0 {
+		return nil
+	}
+
+	// 1. 读取现有索引的定义，与期望一致时不做任何操作
+	result, err := session.Run(ctx, "SHOW FULLTEXT INDEXES YIELD name, labelsOrTypes, properties", nil)
+	if err != nil {
+		return fmt.Errorf("DAL: 查询全文索引失败: %w", err)
+	}
+	records, err := result.Collect(ctx)
+	if err != nil {
+		return fmt.Errorf("DAL: 读取全文索引失败: %w", err)
+	}
+	exists := false
+	for _, record := range records {
+		name, _ := record.Get("name")
+		if name != NodeFulltextIndex {
+			continue
+		}
+		exists = true
+		existingLabels, _ := record.Get("labelsOrTypes")
+		existingProps, _ := record.Get("properties")
+		if sameStringSet(existingLabels, labels) && sameStringSet(existingProps, props) {
+			return nil
+		}
+	}
+
+	// 2. 全文索引的标签和属性不能修改，定义变化时删除后重建 (重建期间的搜索结果可能不完整)
+	queries := []string{nodeFulltextIndexQuery(labels, props)}
+	if exists {
+		queries = append([]string{"DROP INDEX " + NodeFulltextIndex + " IF EXISTS"}, queries...)
+	}
+	for _, query := range queries {
+		result, err := session.Run(ctx, query, nil)
+		if err == nil {
+			_, err = result.Consume(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("DAL: 创建全文索引失败 '%s': %w", query, err)
+		}
+	}
+	return nil
+}
+
+// sameStringSet 比较 Neo4j 返回的字符串列表与期望的列表是否包含相同的元素
+func sameStringSet(value any, want []string) bool {
+	list, ok := value.([]any)
+	if !ok || len(list) != len(want) {
+		return false
+	}
+	for _, v := range list {
+		s, ok := v.(string)
+		if !ok || !slices.Contains(want, s) {
+			return false
+		}
+	}
+	return true
+}
+
+// ExecFulltextSearchNodes 使用全文索引搜索节点，结果按得分降序、id 升序排列。
+// text 按 fulltext.BuildQuery 转换为 Lucene 查询，没有可用的词时返回 fulltext.ErrEmptyQuery。
+func (d *neo4jNodeDAL) ExecFulltextSearchNodes(ctx context.Context, session neo4j.SessionWithContext, text string, fuzzy bool, nodeType *network.NodeType, limit, offset int64) ([]FulltextNodeHit, int64, error) {
+	query, _, err := fulltext.BuildQuery(text, fuzzy)
+	if err != nil {
+		return nil, 0, err
+	}
+	params := map[string]any{
+		"index":  NodeFulltextIndex,
+		"query":  query,
+		"label":  nil,
+		"limit":  limit,
+		"offset": offset,
+	}
+	if nodeType != nil {
+		label, err := NodeLabel(*nodeType)
+		if err != nil {
+			return nil, 0, err
+		}
+		params["label"] = label
+	}
+
+	const match = `
+		CALL db.index.fulltext.queryNodes($index, $query) YIELD node, score
+		WHERE $label IS NULL OR $label IN labels(node)`
+	var hits []FulltextNodeHit
+	var total int64
+	_, err = session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		countResult, err := tx.Run(ctx, match+" RETURN count(node) AS total", params)
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 全文搜索计数失败: %w", err)
+		}
+		countRecord, err := countResult.Single(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 读取全文搜索计数失败: %w", err)
+		}
+		totalValue, _ := countRecord.Get("total")
+		total, _ = totalValue.(int64)
+		if total == 0 {
+			hits = []FulltextNodeHit{}
+			return nil, nil
+		}
+
+		result, err := tx.Run(ctx, match+`
+			RETURN node, labels(node) AS labels, score
+			ORDER BY score DESC, node.id
+			SKIP $offset LIMIT $limit`, params)
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 全文搜索失败: %w", err)
+		}
+		records, err := result.Collect(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 读取全文搜索结果失败: %w", err)
+		}
+		hits = make([]FulltextNodeHit, 0, len(records))
+		for _, record := range records {
+			nodeValue, _ := record.Get("node")
+			labelsValue, _ := record.Get("labels")
+			scoreValue, _ := record.Get("score")
+			node, ok := nodeValue.(dbtype.Node)
+			if !ok {
+				return nil, fmt.Errorf("DAL: 全文搜索结果中的节点格式无效")
+			}
+			hit := FulltextNodeHit{Node: node}
+			hit.Score, _ = scoreValue.(float64)
+			if list, ok := labelsValue.([]any); ok {
+				for _, l := range list {
+					if s, ok := l.(string); ok {
+						hit.Labels = append(hit.Labels, s)
+					}
+				}
+			}
+			hits = append(hits, hit)
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return hits, total, nil
+}
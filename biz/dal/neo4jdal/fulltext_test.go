@@ -0,0 +1,30 @@
+package neo4jdal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/typeregistry"
+)
+
+func TestNodeFulltextIndexSpec(t *testing.T) {
+	defs := []typeregistry.NodeTypeDef{
+		{Name: "PERSON", Code: network.NodeType_PERSON, Properties: []typeregistry.PropertySchema{
+			{Key: "city"}, {Key: "age", Type: typeregistry.ValueInt}, {Key: "gender", Type: typeregistry.ValueEnum, EnumValues: []string{"male"}},
+		}},
+		{Name: "COMPANY", Code: network.NodeType_COMPANY, Properties: []typeregistry.PropertySchema{
+			{Key: "homepage", Type: typeregistry.ValueURL}, {Key: "city", Type: typeregistry.ValueString}, {Key: "founded", Type: typeregistry.ValueDate},
+		}},
+	}
+	labels, props := NodeFulltextIndexSpec(defs)
+	assert.Equal(t, []string{"COMPANY", "PERSON"}, labels)
+	assert.Equal(t, []string{"name", "profession", "city", "gender", "homepage"}, props, "只包含文本属性")
+
+	assert.Equal(t, "CREATE FULLTEXT INDEX node_fulltext_index IF NOT EXISTS FOR (n:COMPANY|PERSON) ON EACH [n.`name`, n.`profession`]",
+		nodeFulltextIndexQuery(labels, props[:2]))
+
+	assert.True(t, sameStringSet([]any{"PERSON", "COMPANY"}, labels))
+	assert.False(t, sameStringSet([]any{"PERSON"}, labels))
+}
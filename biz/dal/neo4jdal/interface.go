@@ -8,28 +8,76 @@ import (
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
 )
 
+// NodeKeyset 表示节点搜索的游标位置：默认排序时为 (n.name, n.id) (name 为 null 时按空字符串比较)，
+// 自定义排序时为 (Values..., n.id)。传入 ExecSearchNodes 时，只返回排在该位置之后的节点。
+type NodeKeyset struct {
+	Name   string
+	ID     string
+	Values []any // 自定义排序时上一页最后一个节点在各排序键上的取值 (与排序键一一对应，缺失为 nil)，此时不使用 Name
+}
+
+// RelationKeyset 表示节点关系列表的游标位置：上一页最后一条关系的 id，
+// 自定义排序时还有它在各排序键上的取值 (与排序键一一对应，缺失为 nil)。
+type RelationKeyset struct {
+	ID     string
+	Values []any
+}
+
+// BatchNodeInput 表示批量创建中的单个节点，Properties 由 Repo 层构建 (包含 id)。
+type BatchNodeInput struct {
+	NodeType   network.NodeType
+	Properties map[string]any
+}
+
+// BatchRelationInput 表示批量创建中的单个关系，Properties 由 Repo 层构建 (包含 id)。
+type BatchRelationInput struct {
+	SourceID   string
+	TargetID   string
+	RelType    network.RelationType
+	Properties map[string]any
+}
+
+// DeleteResult 描述一次删除实际影响的数据，内容与发件箱删除事件记录的一致，
+// 供没有发件箱消费者时在写路径上同步使缓存失效。
+type DeleteResult struct {
+	NodeIDs     []string // 被删除的节点及其邻居，或被删除关系的两端
+	RelationIDs []string // 被删除的关系本身或随节点一起删除的关系
+	NodeLabels  []string // 被删除节点的标签，删除关系时为空
+}
+
 // NodeDAL 定义了节点数据访问的底层操作
 type NodeDAL interface {
 	ExecCreateNode(ctx context.Context, session neo4j.SessionWithContext, nodeType network.NodeType, properties map[string]any) (neo4j.Node, error)
 	ExecGetNodeByID(ctx context.Context, session neo4j.SessionWithContext, id string) (neo4j.Node, []string /*labels*/, error)
+	ExecGetNodesByIDs(ctx context.Context, session neo4j.SessionWithContext, ids []string) ([]neo4j.Node, [][]string /*labels*/, error)
 	ExecUpdateNode(ctx context.Context, session neo4j.SessionWithContext, id string, updates map[string]any) (neo4j.Node, []string /*labels*/, error)
-	ExecDeleteNode(ctx context.Context, session neo4j.SessionWithContext, id string) error
-	ExecSearchNodes(ctx context.Context, session neo4j.SessionWithContext, criteria map[string]string, nodeType *network.NodeType, limit, offset int64) ([]neo4j.Node, [][]string /*labels*/, int64 /*total*/, error)
+	ExecDeleteNode(ctx context.Context, session neo4j.SessionWithContext, id string) (DeleteResult, error)
+	ExecBatchCreateNodes(ctx context.Context, session neo4j.SessionWithContext, nodes []BatchNodeInput) ([]neo4j.Node, error)
+	ExecBatchCreateGraph(ctx context.Context, session neo4j.SessionWithContext, nodes []BatchNodeInput, rels []BatchRelationInput) ([]neo4j.Node, map[int]neo4j.Relationship /*按输入下标*/, error)
+	ExecBatchMergeNodes(ctx context.Context, session neo4j.SessionWithContext, nodes []BatchNodeInput) ([]bool /*本次新建*/, error)
+	ExecSearchNodes(ctx context.Context, session neo4j.SessionWithContext, criteria map[string]string, filter *network.FilterExpr, sortKeys []SortKey, facets *FacetRequest, nodeType *network.NodeType, limit, offset int64, after *NodeKeyset) ([]neo4j.Node, [][]string /*labels*/, int64 /*total*/, []Facet, error)
 	ExecGetNetwork(ctx context.Context, session neo4j.SessionWithContext,
 		startNodeCriteria map[string]string,
 		depth int32,
 		limit, offset int64,
+		maxRelations int64,
 		relationTypes []network.RelationType,
 		nodeTypes []network.NodeType,
-	) ([]neo4j.Node, []neo4j.Relationship, error)
-	ExecGetPath(ctx context.Context, session neo4j.SessionWithContext, sourceID, targetID string, maxDepth int32, relTypes []string) ([]neo4j.Node, []neo4j.Relationship, error)
+		traversal Traversal,
+	) ([]neo4j.Node, []neo4j.Relationship, bool /*truncated*/, error)
+	ExecGetPaths(ctx context.Context, session neo4j.SessionWithContext, q PathQuery) ([]Path, error)
+	ExecFulltextSearchNodes(ctx context.Context, session neo4j.SessionWithContext, text string, fuzzy bool, nodeType *network.NodeType, limit, offset int64) ([]FulltextNodeHit, int64 /*total*/, error)
+	ExecGetRecommendationCandidates(ctx context.Context, session neo4j.SessionWithContext, q RecommendationQuery) (Neighborhood, error)
 }
 
 // RelationDAL 定义了关系数据访问的底层操作
 type RelationDAL interface {
 	ExecCreateRelation(ctx context.Context, session neo4j.SessionWithContext, sourceID, targetID string, relType network.RelationType, properties map[string]any) (neo4j.Relationship, error)
 	ExecGetRelationByID(ctx context.Context, session neo4j.SessionWithContext, id string) (dbtype.Relationship, string /*type*/, string /*sourceId*/, string /*targetId*/, error)
+	ExecGetRelationsByIDs(ctx context.Context, session neo4j.SessionWithContext, ids []string) ([]dbtype.Relationship, []string /*types*/, []string /*sourceIds*/, []string /*targetIds*/, error)
 	ExecUpdateRelation(ctx context.Context, session neo4j.SessionWithContext, id string, updates map[string]any) (dbtype.Relationship, string /*type*/, string /*sourceId*/, string /*targetId*/, error)
-	ExecDeleteRelation(ctx context.Context, session neo4j.SessionWithContext, id string) error
-	ExecGetNodeRelations(ctx context.Context, session neo4j.SessionWithContext, nodeID string, types []string, outgoing, incoming bool, limit, offset int64) ([]dbtype.Relationship, []string /*types*/, []string /*sourceIds*/, []string /*targetIds*/, int64 /*total*/, error)
+	ExecDeleteRelation(ctx context.Context, session neo4j.SessionWithContext, id string) (DeleteResult, error)
+	ExecBatchCreateRelations(ctx context.Context, session neo4j.SessionWithContext, rels []BatchRelationInput) (map[int]neo4j.Relationship /*按输入下标*/, error)
+	ExecBatchMergeRelations(ctx context.Context, session neo4j.SessionWithContext, rels []BatchRelationInput) (map[int]bool /*按输入下标，本次新建*/, error)
+	ExecGetNodeRelations(ctx context.Context, session neo4j.SessionWithContext, nodeID string, types []string, outgoing, incoming bool, sortKeys []SortKey, limit, offset int64, after *RelationKeyset) ([]dbtype.Relationship, []string /*types*/, []string /*sourceIds*/, []string /*targetIds*/, int64 /*total*/, error)
 }
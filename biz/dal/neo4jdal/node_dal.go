@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/typeregistry"
 
 	"errors"
 
@@ -28,10 +29,14 @@ func NewNodeDAL() NodeDAL { // 返回接口类型
 // ExecCreateNode 执行创建节点的 Cypher 语句。
 // properties map 由 Repo 层构建，包含 id 和所有节点属性。
 func (d *neo4jNodeDAL) ExecCreateNode(ctx context.Context, session neo4j.SessionWithContext, nodeType network.NodeType, properties map[string]any) (neo4j.Node, error) {
+	label, err := NodeLabel(nodeType)
+	if err != nil {
+		return dbtype.Node{}, err
+	}
 	// 使用 ExecuteWrite 在事务中执行写操作。
 	nodeResult, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
-		// 构建 Cypher 查询语句，使用 NodeType 作为标签。
-		query := fmt.Sprintf(`CREATE (n:%s $props) RETURN n`, nodeType.String())
+		// 构建 Cypher 查询语句，使用注册表中的类型名作为标签。
+		query := fmt.Sprintf(`CREATE (n:%s $props) RETURN n`, label)
 		// 执行查询，传入属性 map。
 		result, err := tx.Run(ctx, query, map[string]any{"props": properties})
 		if err != nil {
@@ -71,6 +76,183 @@ func (d *neo4jNodeDAL) ExecCreateNode(ctx context.Context, session neo4j.Session
 	return createdNode, nil
 }
 
+// ExecBatchCreateNodes 在单个写事务中批量创建节点。
+// 节点按类型分组，每个类型执行一次 UNWIND 创建；返回的节点与输入顺序一致。
+func (d *neo4jNodeDAL) ExecBatchCreateNodes(ctx context.Context, session neo4j.SessionWithContext, nodes []BatchNodeInput) ([]neo4j.Node, error) {
+	if len(nodes) == 0 {
+		return []neo4j.Node{}, nil
+	}
+	nodeBatch, err := groupBatchNodes(nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	writeResult, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		return nodeBatch.run(ctx, tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	createdNodes, ok := writeResult.([]dbtype.Node)
+	if !ok {
+		return nil, fmt.Errorf("DAL: 事务返回了非预期的节点列表类型")
+	}
+	return createdNodes, nil
+}
+
+// ExecBatchCreateGraph 在单个写事务中先批量创建节点、再批量创建关系，关系可以引用本批次节点的 id。
+// 节点部分与 ExecBatchCreateNodes 相同；关系部分与 ExecBatchCreateRelations 相同，
+// 源或目标节点不存在、或两端节点类型不被该关系类型允许的项不会出现在结果中。任何错误都会回滚整个批次。
+func (d *neo4jNodeDAL) ExecBatchCreateGraph(ctx context.Context, session neo4j.SessionWithContext, nodes []BatchNodeInput, rels []BatchRelationInput) ([]neo4j.Node, map[int]neo4j.Relationship, error) {
+	nodeBatch, err := groupBatchNodes(nodes)
+	if err != nil {
+		return nil, nil, err
+	}
+	relBatch, err := groupBatchRelations(rels)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	type graphResult struct {
+		nodes []dbtype.Node
+		rels  map[int]dbtype.Relationship
+	}
+	writeResult, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		createdNodes, err := nodeBatch.run(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+		createdRels, err := relBatch.run(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+		return graphResult{nodes: createdNodes, rels: createdRels}, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created, ok := writeResult.(graphResult)
+	if !ok {
+		return nil, nil, fmt.Errorf("DAL: 事务返回了非预期的批量创建结果类型")
+	}
+	return created.nodes, created.rels, nil
+}
+
+// ExecBatchMergeNodes 在单个写事务中按 id 批量 MERGE 节点，已存在的节点保持不变。
+// 返回每个输入是否为本次新建，重复执行同一批输入不会产生重复节点 (用于可重复执行的导入)。
+func (d *neo4jNodeDAL) ExecBatchMergeNodes(ctx context.Context, session neo4j.SessionWithContext, nodes []BatchNodeInput) ([]bool, error) {
+	if len(nodes) == 0 {
+		return []bool{}, nil
+	}
+	g, err := groupBatchNodes(nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	writeResult, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		created := make([]bool, len(nodes))
+		for _, nodeType := range g.typeOrder {
+			query := fmt.Sprintf(`
+				UNWIND $items AS item
+				OPTIONAL MATCH (existing:%[1]s {id: item.props.id})
+				WITH item, existing IS NULL AS created
+				MERGE (n:%[1]s {id: item.props.id})
+				ON CREATE SET n = item.props
+				RETURN item.idx AS idx, created`, g.labels[nodeType])
+			result, err := tx.Run(ctx, query, map[string]any{"items": g.items[nodeType]})
+			if err != nil {
+				return nil, fmt.Errorf("DAL: 运行批量合并节点查询失败: %w", err)
+			}
+			records, err := result.Collect(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("DAL: 获取批量合并节点结果失败: %w", err)
+			}
+			for _, record := range records {
+				idxInterface, _ := record.Get("idx")
+				createdInterface, _ := record.Get("created")
+				idx, idxOk := idxInterface.(int64)
+				isNew, createdOk := createdInterface.(bool)
+				if !idxOk || !createdOk || idx < 0 || int(idx) >= len(created) {
+					return nil, fmt.Errorf("DAL: 批量合并节点返回了非预期的结果")
+				}
+				created[idx] = isNew
+			}
+		}
+		return created, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	created, ok := writeResult.([]bool)
+	if !ok {
+		return nil, fmt.Errorf("DAL: 事务返回了非预期的合并结果类型")
+	}
+	return created, nil
+}
+
+// batchNodeGroups 是按节点类型分组的批量创建参数
+type batchNodeGroups struct {
+	size      int
+	typeOrder []network.NodeType
+	labels    map[network.NodeType]string
+	items     map[network.NodeType][]map[string]any
+}
+
+// groupBatchNodes 按节点类型分组 (标签不能参数化)，保留原始下标；未注册的类型返回错误
+func groupBatchNodes(nodes []BatchNodeInput) (batchNodeGroups, error) {
+	g := batchNodeGroups{
+		size:   len(nodes),
+		labels: make(map[network.NodeType]string),
+		items:  make(map[network.NodeType][]map[string]any),
+	}
+	for i, n := range nodes {
+		if _, ok := g.items[n.NodeType]; !ok {
+			label, err := NodeLabel(n.NodeType)
+			if err != nil {
+				return batchNodeGroups{}, err
+			}
+			g.labels[n.NodeType] = label
+			g.typeOrder = append(g.typeOrder, n.NodeType)
+		}
+		g.items[n.NodeType] = append(g.items[n.NodeType], map[string]any{"idx": int64(i), "props": n.Properties})
+	}
+	return g, nil
+}
+
+// run 在事务中为每个类型执行一次 UNWIND 创建，返回的节点与输入顺序一致
+func (g batchNodeGroups) run(ctx context.Context, tx neo4j.ManagedTransaction) ([]dbtype.Node, error) {
+	created := make([]dbtype.Node, g.size)
+	for _, nodeType := range g.typeOrder {
+		query := fmt.Sprintf(`
+			UNWIND $items AS item
+			CREATE (n:%s)
+			SET n = item.props
+			RETURN item.idx AS idx, n`, g.labels[nodeType])
+		result, err := tx.Run(ctx, query, map[string]any{"items": g.items[nodeType]})
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 运行批量创建节点查询失败: %w", err)
+		}
+		records, err := result.Collect(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 获取批量创建节点结果失败: %w", err)
+		}
+		for _, record := range records {
+			idxInterface, _ := record.Get("idx")
+			nodeInterface, _ := record.Get("n")
+			idx, idxOk := idxInterface.(int64)
+			dbNode, nodeOk := nodeInterface.(dbtype.Node)
+			if !idxOk || !nodeOk || idx < 0 || int(idx) >= len(created) {
+				return nil, fmt.Errorf("DAL: 批量创建节点返回了非预期的结果")
+			}
+			created[idx] = dbNode
+		}
+	}
+	return created, nil
+}
+
 // ExecGetNodeByID 执行按 ID 获取节点的 Cypher。
 // 返回 Neo4j 节点、节点的标签列表以及错误。
 func (d *neo4jNodeDAL) ExecGetNodeByID(ctx context.Context, session neo4j.SessionWithContext, id string) (neo4j.Node, []string, error) {
@@ -125,6 +307,54 @@ func (d *neo4jNodeDAL) ExecGetNodeByID(ctx context.Context, session neo4j.Sessio
 	return resultMap["node"].(dbtype.Node), resultMap["labels"].([]string), nil
 }
 
+// ExecGetNodesByIDs 执行按 ID 批量获取节点的 Cypher。
+// 返回找到的节点及对应的标签列表 (顺序不保证与 ids 一致)，不存在的 ID 直接忽略。
+func (d *neo4jNodeDAL) ExecGetNodesByIDs(ctx context.Context, session neo4j.SessionWithContext, ids []string) ([]neo4j.Node, [][]string, error) {
+	if len(ids) == 0 {
+		return []neo4j.Node{}, [][]string{}, nil
+	}
+	nodesResult, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		query := `MATCH (n) WHERE n.id IN $ids RETURN n, labels(n) AS labels`
+		result, err := tx.Run(ctx, query, map[string]any{"ids": ids})
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 运行批量获取节点查询失败: %w", err)
+		}
+		records, err := result.Collect(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 获取批量节点结果失败: %w", err)
+		}
+		nodes := make([]neo4j.Node, 0, len(records))
+		labelsList := make([][]string, 0, len(records))
+		for _, record := range records {
+			nodeInterface, _ := record.Get("n")
+			labelsInterface, _ := record.Get("labels")
+			dbNode, ok := nodeInterface.(dbtype.Node)
+			if !ok {
+				return nil, fmt.Errorf("DAL: 结果中的 'n' 不是有效的节点类型")
+			}
+			labelsRaw, ok := labelsInterface.([]any)
+			if !ok {
+				return nil, fmt.Errorf("DAL: 无法解析节点标签")
+			}
+			labels := make([]string, len(labelsRaw))
+			for i, l := range labelsRaw {
+				labels[i] = l.(string)
+			}
+			nodes = append(nodes, dbNode)
+			labelsList = append(labelsList, labels)
+		}
+		return map[string]any{"nodes": nodes, "labels": labelsList}, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	resultMap, ok := nodesResult.(map[string]any)
+	if !ok {
+		return nil, nil, fmt.Errorf("DAL: 事务返回了非预期的批量节点结果类型")
+	}
+	return resultMap["nodes"].([]neo4j.Node), resultMap["labels"].([][]string), nil
+}
+
 // ExecUpdateNode 执行更新节点的 Cypher。
 // updates map 由 Repo 层准备，包含需要 SET 的属性。
 func (d *neo4jNodeDAL) ExecUpdateNode(ctx context.Context, session neo4j.SessionWithContext, id string, updates map[string]any) (neo4j.Node, []string, error) {
@@ -171,7 +401,7 @@ func (d *neo4jNodeDAL) ExecUpdateNode(ctx context.Context, session neo4j.Session
 			usageErr := new(neo4j.UsageError)
 			if errors.As(err, &usageErr) && strings.Contains(usageErr.Error(), "result contains no more records") {
 				// 返回表示未找到的错误，让 Repo 层处理
-				return nil, fmt.Errorf("DAL: 未找到要更新的节点 ID %s", id)
+				return nil, fmt.Errorf("DAL: 未找到要更新的节点 ID %s: %w", id, ErrNotFound)
 			}
 			return nil, fmt.Errorf("DAL: 获取更新节点结果失败: %w", err)
 		}
@@ -227,53 +457,60 @@ func (d *neo4jNodeDAL) ExecUpdateNode(ctx context.Context, session neo4j.Session
 	return updatedNode, labels, nil
 }
 
-// ExecDeleteNode 执行根据id删除节点的 Cypher，并确认节点是否被删除。
+// ExecDeleteNode 执行根据id删除节点的 Cypher，返回被删除节点的标签、随之删除的关系和这些关系另一端的邻居。
 // 如果节点不存在，则返回一个表示未找到的错误。
-func (d *neo4jNodeDAL) ExecDeleteNode(ctx context.Context, session neo4j.SessionWithContext, id string) error {
+func (d *neo4jNodeDAL) ExecDeleteNode(ctx context.Context, session neo4j.SessionWithContext, id string) (DeleteResult, error) {
 	// 执行写事务。
-	resultSummary, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
-		// 使用 DETACH DELETE 删除节点及其所有关联关系。
-		query := `MATCH (n {id: $id}) DETACH DELETE n`
+	txResult, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		// 在 DETACH DELETE 之前收集相连的关系和邻居，节点不存在时没有记录返回。
+		query := `
+			MATCH (n {id: $id})
+			OPTIONAL MATCH (n)-[r]-(m)
+			WITH n, labels(n) AS labels, collect(DISTINCT r.id) AS relIds, collect(DISTINCT m.id) AS neighbourIds
+			DETACH DELETE n
+			RETURN labels, relIds, neighbourIds`
 		result, err := tx.Run(ctx, query, map[string]any{"id": id})
 		if err != nil {
 			// 处理查询执行错误。
 			return nil, fmt.Errorf("query execution failed: %w", err)
 		}
-		// 获取结果摘要信息。
-		// result.Consume(ctx) 会返回错误如果 tx.Run 发生了错误。
-		// result.Consume(ctx) 不会返回错误如果 MATCH 语句没有找到要删除的节点。
-		summary, err := result.Consume(ctx)
+		records, err := result.Collect(ctx)
 		if err != nil {
-			// 处理获取摘要时的错误。
 			return nil, fmt.Errorf("result consumption failed: %w", err)
 		}
-		// 返回摘要信息供事务外检查。
-		return summary, nil
+		// 节点不存在时返回空结果，由事务外判断。
+		deleted := DeleteResult{}
+		if len(records) > 0 {
+			values := records[0].AsMap()
+			deleted.NodeIDs = append([]string{id}, stringListProp(values, "neighbourIds")...)
+			deleted.RelationIDs = stringListProp(values, "relIds")
+			deleted.NodeLabels = stringListProp(values, "labels")
+		}
+		return deleted, nil
 	})
 
 	// 处理事务本身的错误或事务函数返回的错误。
 	if err != nil {
-		return fmt.Errorf("DAL: 删除节点事务失败: %w", err)
+		return DeleteResult{}, fmt.Errorf("DAL: 删除节点事务失败: %w", err)
 	}
 
-	// 事务成功后，检查结果摘要。
-	if summary, ok := resultSummary.(neo4j.ResultSummary); ok {
-		// 检查计数器中被删除的节点数量。
-		if summary.Counters().NodesDeleted() == 0 {
-			// 如果没有节点被删除，说明具有该 ID 的节点不存在。
-			// 返回一个特定的错误（或哨兵错误）。
-			return fmt.Errorf("DAL: node with id '%s' not found for deletion", id)
-		}
-		// 节点删除成功。
-		return nil
+	deleted, ok := txResult.(DeleteResult)
+	if !ok {
+		return DeleteResult{}, fmt.Errorf("DAL: 删除节点事务返回了非预期的结果类型")
 	}
-
-	// 如果 ExecuteWrite 成功但返回的不是预期的 ResultSummary 类型（理论上不太可能）。
-	return fmt.Errorf("DAL: 删除节点事务返回了非预期的结果类型")
+	if len(deleted.NodeIDs) == 0 {
+		// 没有节点被删除，说明具有该 ID 的节点不存在。
+		return DeleteResult{}, fmt.Errorf("DAL: node with id '%s' not found for deletion", id)
+	}
+	return deleted, nil
 }
 
 // ExecSearchNodes 执行搜索节点的 Cypher，返回匹配的节点、标签列表和总数。
-func (d *neo4jNodeDAL) ExecSearchNodes(ctx context.Context, session neo4j.SessionWithContext, criteria map[string]string, nodeType *network.NodeType, limit, offset int64) ([]neo4j.Node, [][]string, int64, error) {
+// filter 为已校验的结构化过滤表达式 (见 ValidateNodeFilter)，与 criteria 以 AND 组合，nil 表示不过滤。
+// sortKeys 为空时结果按 (n.name, n.id) 排序 (name 为 null 时按空字符串比较，排在最前)，否则按 sortKeys 排序并以 n.id 作为最后的排序键；
+// after 不为 nil 时使用 keyset 分页并忽略 offset，总数不受游标影响。
+// facets 不为 nil 时在同一读事务中统计全部匹配节点的分面，按 facets.Fields 的顺序返回。
+func (d *neo4jNodeDAL) ExecSearchNodes(ctx context.Context, session neo4j.SessionWithContext, criteria map[string]string, filter *network.FilterExpr, sortKeys []SortKey, facets *FacetRequest, nodeType *network.NodeType, limit, offset int64, after *NodeKeyset) ([]neo4j.Node, [][]string, int64, []Facet, error) {
 	// --- Remove Debug Logging --- VVV
 	/*
 		var nodeTypeStr string
@@ -300,9 +537,15 @@ func (d *neo4jNodeDAL) ExecSearchNodes(ctx context.Context, session neo4j.Sessio
 
 	// --- Restore original MATCH logic --- VVV
 	if nodeType != nil {
-		matchClause = fmt.Sprintf("MATCH (n:%s)", nodeType.String())
+		label, err := NodeLabel(*nodeType)
+		if err != nil {
+			return nil, nil, 0, nil, err
+		}
+		matchClause = fmt.Sprintf("MATCH (n:%s)", label)
 	} else {
 		matchClause = "MATCH (n)"
+		// 不限类型时排除发件箱事件和类型定义节点
+		whereClauses = append(whereClauses, "NOT n:"+OutboxLabel, "NOT n:"+NodeTypeDefLabel, "NOT n:"+RelationTypeDefLabel)
 	}
 	// --- Remove DEBUG comments ---
 	/*
@@ -341,6 +584,20 @@ func (d *neo4jNodeDAL) ExecSearchNodes(ctx context.Context, session neo4j.Sessio
 	}
 	// --- End Revert --- ^^^
 
+	// 结构化过滤条件，参数同时用于计数和主查询
+	filterParams := make(map[string]any)
+	filterClause, err := compileNodeFilter(filter, filterParams)
+	if err != nil {
+		return nil, nil, 0, nil, err
+	}
+	if filterClause != "" {
+		whereClauses = append(whereClauses, filterClause)
+		for k, v := range filterParams {
+			mainParams[k] = v
+			countParams[k] = v
+		}
+	}
+
 	// Build count query string
 	countQueryBuilder := strings.Builder{}
 	countQueryBuilder.WriteString(matchClause) // Use restored matchClause
@@ -349,28 +606,75 @@ func (d *neo4jNodeDAL) ExecSearchNodes(ctx context.Context, session neo4j.Sessio
 		countQueryBuilder.WriteString(" WHERE ")
 		countQueryBuilder.WriteString(strings.Join(whereClauses, " AND "))
 	}
+	matchWhere := countQueryBuilder.String() // 分面统计使用与计数相同的匹配条件
 	countQueryBuilder.WriteString(" RETURN count(DISTINCT n) AS total")
 	countQuery := countQueryBuilder.String()
 
+	// 分面查询，参数为计数参数加上取值个数上限和 type 分面统计的标签
+	var facetQueries []string
+	var facetParams map[string]any
+	if facets != nil {
+		for _, field := range facets.Fields {
+			query, err := facetQuery(matchWhere, field, len(facets.Labels) > 0)
+			if err != nil {
+				return nil, nil, 0, nil, err
+			}
+			facetQueries = append(facetQueries, query)
+		}
+		facetParams = make(map[string]any, len(countParams)+3)
+		for k, v := range countParams {
+			facetParams[k] = v
+		}
+		facetParams["facetSize"] = facets.Size
+		facetParams["facetFetchSize"] = facets.Size * facetOverFetch
+		facetParams["facetLabels"] = facets.Labels
+	}
+
+	// id 作为次排序键，保证顺序稳定以支持游标分页；name 为 null 时按空字符串比较，否则 keyset 条件永远不成立
+	orderBy := " ORDER BY coalesce(n.name, ''), n.id"
+	keyset := "(coalesce(n.name, '') > $afterName OR (coalesce(n.name, '') = $afterName AND n.id > $afterId))"
+	if len(sortKeys) > 0 {
+		var afterValues []any
+		var afterID string
+		if after != nil {
+			afterValues, afterID = after.Values, after.ID
+		}
+		if orderBy, keyset, err = compileOrderBy("n", sortKeys, afterValues, afterID, mainParams); err != nil {
+			return nil, nil, 0, nil, err
+		}
+	}
+
+	// 游标分页: 只取排在游标之后的节点，仅作用于主查询
+	mainWhereClauses := whereClauses
+	if after != nil {
+		mainWhereClauses = append(append([]string{}, whereClauses...), keyset)
+		if len(sortKeys) == 0 {
+			mainParams["afterName"] = after.Name
+			mainParams["afterId"] = after.ID
+		}
+		mainParams["offset"] = int64(0)
+	}
+
 	// Build main query string
 	queryBuilder := strings.Builder{}
 	queryBuilder.WriteString(matchClause) // Use restored matchClause
 	// Restore WHERE clause logic
-	if len(whereClauses) > 0 {
+	if len(mainWhereClauses) > 0 {
 		queryBuilder.WriteString(" WHERE ")
-		queryBuilder.WriteString(strings.Join(whereClauses, " AND "))
+		queryBuilder.WriteString(strings.Join(mainWhereClauses, " AND "))
 	}
 	queryBuilder.WriteString(" RETURN DISTINCT n, labels(n) AS labels")
-	queryBuilder.WriteString(" ORDER BY n.name") // Keep ordering
+	queryBuilder.WriteString(orderBy)
 	queryBuilder.WriteString(" SKIP $offset LIMIT $limit")
 	finalQuery := queryBuilder.String()
 
 	var nodes []neo4j.Node
 	var labelsList [][]string
 	var total int64
+	var facetResults []Facet
 
 	// Use ExecuteRead for both queries within the same transaction
-	_, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+	_, err = session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
 		// Get total count first using countParams (no limit/offset)
 		countResult, err := tx.Run(ctx, countQuery, countParams) // <<< Use countParams
 		if err != nil {
@@ -395,6 +699,31 @@ func (d *neo4jNodeDAL) ExecSearchNodes(ctx context.Context, session neo4j.Sessio
 			total = totalConv // Assign converted value
 		}
 
+		// 分面统计 (没有匹配的节点时各分面为空)
+		if facets != nil {
+			facetResults = make([]Facet, len(facets.Fields))
+			for i, field := range facets.Fields {
+				facetResults[i] = Facet{Field: field, Counts: []FacetCount{}}
+				if total == 0 {
+					continue
+				}
+				facetResult, err := tx.Run(ctx, facetQueries[i], facetParams)
+				if err != nil {
+					return nil, fmt.Errorf("DAL: 运行分面统计查询失败 '%s': %w", field, err)
+				}
+				facetRecords, err := facetResult.Collect(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("DAL: 读取分面统计结果失败 '%s': %w", field, err)
+				}
+				for _, record := range facetRecords {
+					value, _ := record.Get("value")
+					countValue, _ := record.Get("count")
+					count, _ := countValue.(int64)
+					facetResults[i].Counts = append(facetResults[i].Counts, FacetCount{Value: value, Count: count})
+				}
+			}
+		}
+
 		// If total is 0, no need to run the main query
 		if total == 0 {
 			nodes = []neo4j.Node{}
@@ -452,22 +781,28 @@ func (d *neo4jNodeDAL) ExecSearchNodes(ctx context.Context, session neo4j.Sessio
 	})
 
 	if err != nil {
-		return nil, nil, 0, err // Return error from transaction
+		return nil, nil, 0, nil, err // Return error from transaction
 	}
 
 	// Return collected results
-	return nodes, labelsList, total, nil
+	return nodes, labelsList, total, facetResults, nil
 }
 
 // ExecGetNetwork 执行网络查询的 Cypher。
 // 根据起始节点条件、深度、关系类型和节点类型查询相关节点和关系。
+// 分页作用于按 id 排序的去重节点列表 (offset/limit)。每条关系在其两端中排序靠后的一端所在的页返回，
+// 即本页返回一端在本页、另一端在本页或之前页的关系，逐页读取时每条关系恰好出现一次且两端节点都已返回；
+// 关系按 id 排序后截取前 maxRelations 条 (maxRelations <= 0 表示不限制)。
+// 当还有节点未返回或关系被截断时，truncated 为 true。traversal 限制从起始节点向外遍历时的关系方向。
 func (d *neo4jNodeDAL) ExecGetNetwork(ctx context.Context, session neo4j.SessionWithContext,
 	startNodeCriteria map[string]string,
 	depth int32,
 	limit, offset int64,
+	maxRelations int64,
 	relationTypes []network.RelationType,
 	nodeTypes []network.NodeType,
-) ([]neo4j.Node, []neo4j.Relationship, error) {
+	traversal Traversal,
+) ([]neo4j.Node, []neo4j.Relationship, bool, error) {
 
 	// --- Handle Depth 0 Case --- (Added)
 	if depth == 0 {
@@ -487,8 +822,9 @@ func (d *neo4jNodeDAL) ExecGetNetwork(ctx context.Context, session neo4j.Session
 	readResult, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
 		var queryBuilder strings.Builder
 		params := map[string]any{
-			"offset": offset,
-			"limit":  limit,
+			"offset":       offset,
+			"limit":        limit,
+			"maxRelations": maxRelations,
 			// RelationTypes and NodeTypes will be handled in WHERE if needed
 		}
 
@@ -515,14 +851,20 @@ func (d *neo4jNodeDAL) ExecGetNetwork(ctx context.Context, session neo4j.Session
 		}
 
 		// Build the path MATCH and WHERE clause for types
-		queryBuilder.WriteString(fmt.Sprintf(" MATCH path = (startNode)-[*1..%d]-(neighbor)", depth))
+		pattern, directionCond := traversal.pattern("startNode", "neighbor", int(depth), "path")
+		queryBuilder.WriteString(" MATCH path = " + pattern)
 
 		whereClauses := []string{}
+		// Filter by per-type relation directions
+		if directionCond != "" {
+			whereClauses = append(whereClauses, directionCond)
+			traversal.addParams(params)
+		}
 		// Filter by relation types
 		if len(relationTypes) > 0 {
 			relTypeStrings := make([]string, len(relationTypes))
 			for i, rt := range relationTypes {
-				relTypeStrings[i] = rt.String()
+				relTypeStrings[i] = typeregistry.Default().RelationTypeName(rt)
 			}
 			whereClauses = append(whereClauses, "ALL(r IN relationships(path) WHERE type(r) IN $relTypes)")
 			params["relTypes"] = relTypeStrings
@@ -532,7 +874,7 @@ func (d *neo4jNodeDAL) ExecGetNetwork(ctx context.Context, session neo4j.Session
 		if len(nodeTypes) > 0 {
 			nodeTypeStrings := make([]string, len(nodeTypes))
 			for i, nt := range nodeTypes {
-				nodeTypeStrings[i] = nt.String()
+				nodeTypeStrings[i] = typeregistry.Default().NodeTypeName(nt)
 			}
 			whereClauses = append(whereClauses, "ALL(n IN nodes(path) WHERE ANY(lbl IN labels(n) WHERE lbl IN $nodeTypes))")
 			params["nodeTypes"] = nodeTypeStrings
@@ -543,15 +885,34 @@ func (d *neo4jNodeDAL) ExecGetNetwork(ctx context.Context, session neo4j.Session
 			queryBuilder.WriteString(strings.Join(whereClauses, " AND "))
 		}
 
-		// Final part of the query to collect and paginate
+		// Final part of the query: 对去重后的节点按 id 排序分页，再取靠后一端落在本页的关系
 		queryBuilder.WriteString(`
-			WITH path
-			UNWIND nodes(path) as n
-			UNWIND relationships(path) as r
-			WITH collect(distinct n) as all_nodes, collect(distinct r) as all_rels
+			WITH collect(path) AS paths
+			CALL {
+				WITH paths
+				UNWIND paths AS p
+				UNWIND nodes(p) AS n
+				WITH DISTINCT n
+				ORDER BY n.id
+				RETURN collect(n) AS all_nodes
+			}
+			WITH paths, all_nodes[$offset..$offset+$limit] AS page_nodes, all_nodes[$offset+$limit..] AS later_nodes, size(all_nodes) AS total_nodes
+			CALL {
+				WITH paths, page_nodes, later_nodes
+				UNWIND paths AS p
+				UNWIND relationships(p) AS r
+				WITH DISTINCT r, page_nodes, later_nodes
+				WHERE (startNode(r) IN page_nodes OR endNode(r) IN page_nodes)
+				  AND NOT startNode(r) IN later_nodes AND NOT endNode(r) IN later_nodes
+				WITH r
+				ORDER BY r.id
+				RETURN collect(r) AS page_rels
+			}
 			RETURN
-				CASE size(all_nodes) > $offset WHEN true THEN all_nodes[$offset..$offset+$limit] ELSE [] END AS nodes,
-				CASE size(all_rels) > $offset WHEN true THEN all_rels[$offset..$offset+$limit] ELSE [] END AS relations
+				page_nodes AS nodes,
+				CASE WHEN $maxRelations > 0 THEN page_rels[0..$maxRelations] ELSE page_rels END AS relations,
+				total_nodes AS totalNodes,
+				size(page_rels) AS totalRelations
 		`)
 
 		query := queryBuilder.String()
@@ -566,7 +927,7 @@ func (d *neo4jNodeDAL) ExecGetNetwork(ctx context.Context, session neo4j.Session
 			usageErr := new(neo4j.UsageError)
 			if errors.As(err, &usageErr) && strings.Contains(usageErr.Error(), "result contains no more records") {
 				// No matching path found based on criteria/types
-				return map[string]any{"nodes": nodes, "rels": relationships}, nil
+				return map[string]any{"nodes": nodes, "rels": relationships, "truncated": false}, nil
 			}
 			return nil, fmt.Errorf("DAL: 获取 GetNetwork 结果失败: %w", err)
 		}
@@ -602,18 +963,26 @@ func (d *neo4jNodeDAL) ExecGetNetwork(ctx context.Context, session neo4j.Session
 			relationships[i] = rel
 		}
 
-		return map[string]any{"nodes": nodes, "rels": relationships}, nil
+		// 判断结果是否被截断 (还有更多节点，或本页的关系超过 maxRelations)
+		totalNodes, _ := record.Get("totalNodes")
+		totalRelations, _ := record.Get("totalRelations")
+		totalNodesVal, _ := totalNodes.(int64)
+		totalRelationsVal, _ := totalRelations.(int64)
+		truncated := offset+int64(len(nodes)) < totalNodesVal || int64(len(relationships)) < totalRelationsVal
+
+		return map[string]any{"nodes": nodes, "rels": relationships, "truncated": truncated}, nil
 	})
 
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, false, err
 	}
 
 	resultMap := readResult.(map[string]any)
 	finalNodes := resultMap["nodes"].([]dbtype.Node)
 	finalRels := resultMap["rels"].([]dbtype.Relationship)
+	truncated, _ := resultMap["truncated"].(bool)
 
-	return finalNodes, finalRels, nil
+	return finalNodes, finalRels, truncated, nil
 }
 
 // --- Added Helper for Depth 0 --- (New Function)
@@ -622,7 +991,7 @@ func (d *neo4jNodeDAL) execGetNetworkDepthZero(
 	startNodeCriteria map[string]string,
 	limit, offset int64,
 	nodeTypes []network.NodeType,
-) ([]neo4j.Node, []neo4j.Relationship, error) {
+) ([]neo4j.Node, []neo4j.Relationship, bool, error) {
 
 	var startNodeClauses []string
 	params := map[string]any{}
@@ -637,7 +1006,7 @@ func (d *neo4jNodeDAL) execGetNetworkDepthZero(
 	if len(nodeTypes) > 0 {
 		var typeLabels []string
 		for _, nt := range nodeTypes {
-			typeLabels = append(typeLabels, nt.String())
+			typeLabels = append(typeLabels, typeregistry.Default().NodeTypeName(nt))
 		}
 		nodeTypeFilter = fmt.Sprintf("AND any(lbl IN labels(startNode) WHERE lbl IN ['%s'])", strings.Join(typeLabels, "', '"))
 	}
@@ -653,7 +1022,7 @@ func (d *neo4jNodeDAL) execGetNetworkDepthZero(
     `, strings.Join(startNodeClauses, " AND "), nodeTypeFilter)
 
 	params["offset"] = offset
-	params["limit"] = limit
+	params["limit"] = limit + 1 // 多取一条用于判断是否还有更多节点
 
 	var nodes []dbtype.Node
 	_, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
@@ -677,120 +1046,14 @@ func (d *neo4jNodeDAL) execGetNetworkDepthZero(
 	})
 
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, false, err
 	}
 
-	// For depth 0, relations are always empty
-	return nodes, []dbtype.Relationship{}, nil
-}
-
-// ExecGetPath 执行路径查询的 Cypher。
-// 查找两个节点之间的路径，可指定最大深度和关系类型。
-// TODO: config 文件应该包含depth设置
-func (d *neo4jNodeDAL) ExecGetPath(ctx context.Context, session neo4j.SessionWithContext, sourceID, targetID string, maxDepth int32, relTypes []string) ([]neo4j.Node, []neo4j.Relationship, error) {
-	var nodes []neo4j.Node
-	var relationships []neo4j.Relationship
-
-	// 确保最大深度至少为 1
-	if maxDepth <= 0 {
-		maxDepth = 1 // 或者根据业务逻辑返回错误
+	truncated := int64(len(nodes)) > limit
+	if truncated {
+		nodes = nodes[:limit]
 	}
 
-	readResult, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
-		// 构建查询语句
-		var queryBuilder strings.Builder
-		queryBuilder.WriteString(fmt.Sprintf(`
-            MATCH (source {id: $sourceId}), (target {id: $targetId})
-            MATCH path = shortestPath((source)-[r*1..%d]-(target))
-        `, maxDepth))
-
-		params := map[string]any{
-			"sourceId": sourceID,
-			"targetId": targetID,
-			"relTypes": relTypes, // 将 relTypes 列表作为参数传递
-			// maxDepth 已直接嵌入查询字符串
-		}
-
-		// 如果指定了关系类型，则添加 WHERE 子句进行过滤
-		if len(relTypes) > 0 {
-			queryBuilder.WriteString(` WHERE ALL(rel IN relationships(path) WHERE type(rel) IN $relTypes)`) // $relTypes 参数是一个列表
-		}
-
-		queryBuilder.WriteString(` RETURN nodes(path) as nodes, relationships(path) as relations LIMIT 1`) // 即使 allShortestPaths 也只取一条
-
-		query := queryBuilder.String()
-
-		result, err := tx.Run(ctx, query, params)
-		if err != nil {
-			return nil, fmt.Errorf("DAL: 运行 GetPath 查询失败: %w", err)
-		}
-
-		record, err := result.Single(ctx) // shortestPath 只会返回一条或零条路径
-		if err != nil {
-			// 检查是否因为未找到路径而无结果
-			usageErr := new(neo4j.UsageError)
-			if errors.As(err, &usageErr) && strings.Contains(usageErr.Error(), "result contains no more records") {
-				// 没有找到路径，返回空结果 (nil slices, nil error)
-				return nil, nil
-			}
-			// 其他获取结果的错误
-			return nil, fmt.Errorf("DAL: 获取 GetPath 结果失败: %w", err)
-		}
-
-		// 提取节点列表
-		nodesInterface, nodesOk := record.Get("nodes")
-		// 提取关系列表
-		relsInterface, relsOk := record.Get("relations")
-
-		if !nodesOk || !relsOk {
-			return nil, fmt.Errorf("DAL: GetPath 查询返回结果缺少 'nodes' 或 'relations' 字段")
-		}
-
-		// 类型断言和转换节点列表
-		nodesRaw, ok := nodesInterface.([]any)
-		if !ok {
-			return nil, fmt.Errorf("DAL: 无法将 'nodes' 断言为 []any")
-		}
-		nodes = make([]neo4j.Node, len(nodesRaw))
-		for i, nodeRaw := range nodesRaw {
-			node, nodeAssertionOk := nodeRaw.(dbtype.Node)
-			if !nodeAssertionOk {
-				return nil, fmt.Errorf("DAL: 无法将 GetPath 'nodes' 列表中的元素断言为 dbtype.Node")
-			}
-			nodes[i] = node
-		}
-
-		// 类型断言和转换关系列表
-		relsRaw, ok := relsInterface.([]any)
-		if !ok {
-			return nil, fmt.Errorf("DAL: 无法将 'relations' 断言为 []any")
-		}
-		relationships = make([]neo4j.Relationship, len(relsRaw))
-		for i, relRaw := range relsRaw {
-			rel, relAssertionOk := relRaw.(dbtype.Relationship)
-			if !relAssertionOk {
-				return nil, fmt.Errorf("DAL: 无法将 GetPath 'relations' 列表中的元素断言为 dbtype.Relationship")
-			}
-			relationships[i] = rel
-		}
-
-		// 返回包含节点和关系的 map，供事务外解析
-		return map[string]any{"nodes": nodes, "rels": relationships}, nil
-	})
-
-	if err != nil {
-		return nil, nil, err // 返回事务错误
-	}
-
-	// 处理未找到路径的情况 (事务函数返回了 nil, nil)
-	if readResult == nil {
-		return nil, nil, nil // 返回 nil slices 和 nil error 表示未找到路径
-	}
-
-	// 解析事务返回的 map
-	resultMap := readResult.(map[string]any)
-	finalNodes := resultMap["nodes"].([]neo4j.Node)
-	finalRels := resultMap["rels"].([]neo4j.Relationship)
-
-	return finalNodes, finalRels, nil
+	// For depth 0, relations are always empty
+	return nodes, []dbtype.Relationship{}, truncated, nil
 }
@@ -10,6 +10,9 @@ import (
 	"time"
 
 	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/fulltext"
+	"labelwall/pkg/recommend"
+	"labelwall/pkg/typeregistry"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
@@ -122,6 +125,115 @@ func TestNeo4jNodeDAL_ExecCreateNode(t *testing.T) {
 	})
 }
 
+// --- 测试 ExecBatchCreateNodes ---
+func TestNeo4jNodeDAL_ExecBatchCreateNodes(t *testing.T) {
+	dal := NewNodeDAL()
+	ctx := context.Background()
+
+	inputs := []BatchNodeInput{
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "b1", "name": "Alice"}},
+		{NodeType: network.NodeType_COMPANY, Properties: map[string]any{"id": "b2", "name": "CompA"}},
+	}
+	mockNodes := []dbtype.Node{
+		{Id: 1, Labels: []string{"PERSON"}, Props: inputs[0].Properties},
+		{Id: 2, Labels: []string{"COMPANY"}, Props: inputs[1].Properties},
+	}
+
+	t.Run("批量创建节点成功", func(t *testing.T) {
+		mockSession := new(MockSession)
+		mockSession.On("ExecuteWrite", ctx, mock.AnythingOfType("neo4j.ManagedTransactionWork"), mock.Anything).Return(mockNodes, nil).Once()
+
+		nodes, err := dal.ExecBatchCreateNodes(ctx, mockSession, inputs)
+		assert.NoError(t, err)
+		assert.Equal(t, mockNodes, nodes)
+		mockSession.AssertExpectations(t)
+	})
+
+	t.Run("空输入不执行事务", func(t *testing.T) {
+		mockSession := new(MockSession)
+		nodes, err := dal.ExecBatchCreateNodes(ctx, mockSession, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, nodes)
+		mockSession.AssertNotCalled(t, "ExecuteWrite", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("写事务失败", func(t *testing.T) {
+		mockSession := new(MockSession)
+		expectedErr := errors.New("写事务失败")
+		mockSession.On("ExecuteWrite", ctx, mock.AnythingOfType("neo4j.ManagedTransactionWork"), mock.Anything).Return(nil, expectedErr).Once()
+
+		nodes, err := dal.ExecBatchCreateNodes(ctx, mockSession, inputs)
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, nodes)
+		mockSession.AssertExpectations(t)
+	})
+}
+
+// --- 测试 ExecBatchMergeNodes ---
+func TestNeo4jNodeDAL_ExecBatchMergeNodes(t *testing.T) {
+	dal := NewNodeDAL()
+	ctx := context.Background()
+
+	inputs := []BatchNodeInput{
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "m1", "name": "Alice"}},
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "m2", "name": "Bob"}},
+	}
+
+	t.Run("返回每个输入是否为本次新建", func(t *testing.T) {
+		mockSession := new(MockSession)
+		mockSession.On("ExecuteWrite", ctx, mock.AnythingOfType("neo4j.ManagedTransactionWork"), mock.Anything).Return([]bool{false, true}, nil).Once()
+
+		created, err := dal.ExecBatchMergeNodes(ctx, mockSession, inputs)
+		assert.NoError(t, err)
+		assert.Equal(t, []bool{false, true}, created)
+		mockSession.AssertExpectations(t)
+	})
+
+	t.Run("未注册的节点类型不执行事务", func(t *testing.T) {
+		mockSession := new(MockSession)
+		created, err := dal.ExecBatchMergeNodes(ctx, mockSession, []BatchNodeInput{{NodeType: network.NodeType(999)}})
+		assert.ErrorContains(t, err, "未注册的节点类型")
+		assert.Nil(t, created)
+		mockSession.AssertNotCalled(t, "ExecuteWrite", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+// --- 测试 ExecBatchCreateGraph ---
+func TestNeo4jNodeDAL_ExecBatchCreateGraph(t *testing.T) {
+	dal := NewNodeDAL()
+	ctx := context.Background()
+
+	nodes := []BatchNodeInput{
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "g1", "name": "Alice"}},
+		{NodeType: network.NodeType_COMPANY, Properties: map[string]any{"id": "g2", "name": "CompA"}},
+	}
+	rels := []BatchRelationInput{
+		{SourceID: "g1", TargetID: "g2", RelType: network.RelationType_COLLEAGUE, Properties: map[string]any{"id": "gr1"}},
+	}
+
+	t.Run("未注册的关系类型不执行事务", func(t *testing.T) {
+		mockSession := new(MockSession)
+		bad := []BatchRelationInput{{SourceID: "g1", TargetID: "g2", RelType: network.RelationType(999)}}
+		createdNodes, createdRels, err := dal.ExecBatchCreateGraph(ctx, mockSession, nodes, bad)
+		assert.ErrorContains(t, err, "未注册的关系类型")
+		assert.Nil(t, createdNodes)
+		assert.Nil(t, createdRels)
+		mockSession.AssertNotCalled(t, "ExecuteWrite", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("写事务失败", func(t *testing.T) {
+		mockSession := new(MockSession)
+		expectedErr := errors.New("写事务失败")
+		mockSession.On("ExecuteWrite", ctx, mock.AnythingOfType("neo4j.ManagedTransactionWork"), mock.Anything).Return(nil, expectedErr).Once()
+
+		createdNodes, createdRels, err := dal.ExecBatchCreateGraph(ctx, mockSession, nodes, rels)
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, createdNodes)
+		assert.Nil(t, createdRels)
+		mockSession.AssertExpectations(t)
+	})
+}
+
 // --- 测试 ExecGetNodeByID ---
 func TestNeo4jNodeDAL_ExecGetNodeByID(t *testing.T) {
 	dal := NewNodeDAL()
@@ -173,6 +285,52 @@ func TestNeo4jNodeDAL_ExecGetNodeByID(t *testing.T) {
 	})
 }
 
+// --- 测试 ExecGetNodesByIDs ---
+func TestNeo4jNodeDAL_ExecGetNodesByIDs(t *testing.T) {
+	dal := NewNodeDAL()
+	ctx := context.Background()
+	ids := []string{"n1", "n2", "missing"}
+
+	dbNodes := []neo4j.Node{
+		{Id: 1, Labels: []string{"PERSON"}, Props: map[string]any{"id": "n1"}},
+		{Id: 2, Labels: []string{"COMPANY"}, Props: map[string]any{"id": "n2"}},
+	}
+	labels := [][]string{{"PERSON"}, {"COMPANY"}}
+
+	t.Run("查询到部分节点", func(t *testing.T) {
+		mockSession := new(MockSession)
+		mockSession.On("ExecuteRead", ctx, mock.AnythingOfType("neo4j.ManagedTransactionWork"), mock.Anything).
+			Return(map[string]any{"nodes": dbNodes, "labels": labels}, nil).Once()
+
+		nodes, gotLabels, err := dal.ExecGetNodesByIDs(ctx, mockSession, ids)
+		assert.NoError(t, err)
+		assert.Equal(t, dbNodes, nodes)
+		assert.Equal(t, labels, gotLabels)
+		mockSession.AssertExpectations(t)
+	})
+
+	t.Run("空 ID 列表不访问数据库", func(t *testing.T) {
+		mockSession := new(MockSession)
+		nodes, gotLabels, err := dal.ExecGetNodesByIDs(ctx, mockSession, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, nodes)
+		assert.Empty(t, gotLabels)
+		mockSession.AssertNotCalled(t, "ExecuteRead", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("读事务错误", func(t *testing.T) {
+		mockSession := new(MockSession)
+		expectedErr := errors.New("读事务失败")
+		mockSession.On("ExecuteRead", ctx, mock.AnythingOfType("neo4j.ManagedTransactionWork"), mock.Anything).
+			Return(nil, expectedErr).Once()
+
+		nodes, _, err := dal.ExecGetNodesByIDs(ctx, mockSession, ids)
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, nodes)
+		mockSession.AssertExpectations(t)
+	})
+}
+
 // --- 测试 ExecUpdateNode ---
 func TestNeo4jNodeDAL_ExecUpdateNode(t *testing.T) {
 	dal := NewNodeDAL()
@@ -219,27 +377,24 @@ func TestNeo4jNodeDAL_ExecDeleteNode(t *testing.T) {
 
 	t.Run("删除节点成功", func(t *testing.T) {
 		mockSession := new(MockSession)
-		mockSummary := &MockResultSummary{
-			CountersMock: MockCounters{NodesDeletedCount: 1},
-		}
-		// Mock ExecuteWrite 返回模拟的 summary
+		expected := DeleteResult{NodeIDs: []string{id, "node2"}, RelationIDs: []string{"rel1"}, NodeLabels: []string{"Person"}}
+		// Mock ExecuteWrite 返回事务内收集的删除结果
 		mockSession.On("ExecuteWrite", ctx, mock.AnythingOfType("neo4j.ManagedTransactionWork"), mock.Anything).
-			Return(mockSummary, nil).Once()
+			Return(expected, nil).Once()
 
-		err := dal.ExecDeleteNode(ctx, mockSession, id)
+		deleted, err := dal.ExecDeleteNode(ctx, mockSession, id)
 		assert.NoError(t, err)
+		assert.Equal(t, expected, deleted)
 		mockSession.AssertExpectations(t)
 	})
 
 	t.Run("删除节点未找到", func(t *testing.T) {
 		mockSession := new(MockSession)
-		mockSummary := &MockResultSummary{
-			CountersMock: MockCounters{NodesDeletedCount: 0}, // 模拟未删除任何节点
-		}
+		// 模拟未匹配到节点: 事务返回空结果
 		mockSession.On("ExecuteWrite", ctx, mock.AnythingOfType("neo4j.ManagedTransactionWork"), mock.Anything).
-			Return(mockSummary, nil).Once()
+			Return(DeleteResult{}, nil).Once()
 
-		err := dal.ExecDeleteNode(ctx, mockSession, id)
+		_, err := dal.ExecDeleteNode(ctx, mockSession, id)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "not found for deletion") // 检查特定的未找到错误
 		mockSession.AssertExpectations(t)
@@ -251,7 +406,7 @@ func TestNeo4jNodeDAL_ExecDeleteNode(t *testing.T) {
 		mockSession.On("ExecuteWrite", ctx, mock.AnythingOfType("neo4j.ManagedTransactionWork"), mock.Anything).
 			Return(nil, expectedErr).Once()
 
-		err := dal.ExecDeleteNode(ctx, mockSession, id)
+		_, err := dal.ExecDeleteNode(ctx, mockSession, id)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), expectedErr.Error())
 		mockSession.AssertExpectations(t)
@@ -259,11 +414,11 @@ func TestNeo4jNodeDAL_ExecDeleteNode(t *testing.T) {
 
 	t.Run("返回非预期类型", func(t *testing.T) {
 		mockSession := new(MockSession)
-		// stub 返回非 ResultSummary 类型
+		// stub 返回非 DeleteResult 类型
 		mockSession.On("ExecuteWrite", ctx, mock.AnythingOfType("neo4j.ManagedTransactionWork"), mock.Anything).
 			Return("not_summary", nil).Once()
 
-		err := dal.ExecDeleteNode(ctx, mockSession, id)
+		_, err := dal.ExecDeleteNode(ctx, mockSession, id)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "非预期的结果类型")
 		mockSession.AssertExpectations(t)
@@ -303,7 +458,7 @@ func TestNeo4jNodeDAL_ExecSearchNodes(t *testing.T) {
 
 	// Execute the function being tested
 	// Use blank identifiers for unused return values
-	_, _, _, err := dal.ExecSearchNodes(ctx, mockSession, criteria, nodeType, limit, offset)
+	_, _, _, _, err := dal.ExecSearchNodes(ctx, mockSession, criteria, nil, nil, nil, nodeType, limit, offset, nil)
 
 	// Assertions: Check if the function processed the (simulated) results correctly.
 	// Since the mock doesn't directly return the data slices, we compare against expected values.
@@ -369,7 +524,7 @@ func TestNeo4jNodeDAL_ExecGetNetwork(t *testing.T) {
 		mockSession.On("ExecuteRead", ctx, mock.AnythingOfType("neo4j.ManagedTransactionWork"), mock.Anything).
 			Return(map[string]any{"nodes": expectedNodes, "rels": expectedRels}, nil).Once()
 
-		gotNodes, gotRels, err := dal.ExecGetNetwork(ctx, mockSession, startCriteria, depth, limit, offset, relTypes, nodeTypes)
+		gotNodes, gotRels, _, err := dal.ExecGetNetwork(ctx, mockSession, startCriteria, depth, limit, offset, 0, relTypes, nodeTypes, Traversal{})
 		assert.NoError(t, err)
 
 		// 对比返回的节点和关系 (可能需要排序以确保一致性)
@@ -408,7 +563,7 @@ func TestNeo4jNodeDAL_ExecGetNetwork(t *testing.T) {
 		mockSession.On("ExecuteRead", ctx, mock.AnythingOfType("neo4j.ManagedTransactionWork"), mock.Anything).
 			Return(map[string]any{"nodes": expectedNodesPage2, "rels": expectedRelsPage2}, nil).Once()
 
-		gotNodes, gotRels, err := dal.ExecGetNetwork(ctx, mockSession, startCriteria, depth, limit, offset, relTypes, nodeTypes)
+		gotNodes, gotRels, _, err := dal.ExecGetNetwork(ctx, mockSession, startCriteria, depth, limit, offset, 0, relTypes, nodeTypes, Traversal{})
 		assert.NoError(t, err)
 
 		// 断言分页结果
@@ -434,7 +589,7 @@ func TestNeo4jNodeDAL_ExecGetNetwork(t *testing.T) {
 		mockSession.On("ExecuteRead", ctx, mock.AnythingOfType("neo4j.ManagedTransactionWork"), mock.Anything).
 			Return(map[string]any{"nodes": expectedNodes, "rels": expectedRels}, nil).Once()
 
-		gotNodes, gotRels, err := dal.ExecGetNetwork(ctx, mockSession, startCriteria, depth, limit, offset, relTypes, nodeTypes)
+		gotNodes, gotRels, _, err := dal.ExecGetNetwork(ctx, mockSession, startCriteria, depth, limit, offset, 0, relTypes, nodeTypes, Traversal{})
 		assert.NoError(t, err)
 		assert.Empty(t, gotNodes, "无匹配起始节点时应返回空节点列表")
 		assert.Empty(t, gotRels, "无匹配起始节点时应返回空关系列表")
@@ -442,29 +597,32 @@ func TestNeo4jNodeDAL_ExecGetNetwork(t *testing.T) {
 	})
 }
 
-// --- 测试 ExecGetPath ---
-func TestNeo4jNodeDAL_ExecGetPath(t *testing.T) {
+// --- 测试 ExecGetPaths ---
+func TestNeo4jNodeDAL_ExecGetPaths(t *testing.T) {
 	dal := NewNodeDAL()
 	ctx := context.Background()
 	src, dst := "A", "B"
-	maxDepth := int32(1)
-	relTypes := []string{"FRIEND"}
+	query := PathQuery{SourceID: src, TargetID: dst, MaxDepth: 1, RelTypes: []string{"FRIEND"}}
 
 	// 模拟路径查询结果
 	n1 := dbtype.Node{Id: 1, Labels: []string{"PERSON"}, Props: map[string]any{"id": src}}
 	n2 := dbtype.Node{Id: 2, Labels: []string{"PERSON"}, Props: map[string]any{"id": dst}}
-	nodes := []neo4j.Node{n1, n2}
 	r1 := dbtype.Relationship{Id: 300, Type: "FRIEND"}
-	rels := []neo4j.Relationship{r1}
+	paths := []Path{{Nodes: []neo4j.Node{n1, n2}, Relationships: []neo4j.Relationship{r1}}}
 
 	mockSession := new(MockSession)
 	mockSession.On("ExecuteRead", ctx, mock.AnythingOfType("neo4j.ManagedTransactionWork"), mock.Anything).
-		Return(map[string]any{"nodes": nodes, "rels": rels}, nil).Once()
+		Return(paths, nil).Once()
+
+	got, err := dal.ExecGetPaths(ctx, mockSession, query)
+	assert.NoError(t, err)
+	assert.Equal(t, paths, got)
+	mockSession.AssertExpectations(t)
 
-	gotNodes, gotRels, err := dal.ExecGetPath(ctx, mockSession, src, dst, maxDepth, relTypes)
+	// 起点和终点相同时不查询数据库
+	got, err = dal.ExecGetPaths(ctx, mockSession, PathQuery{SourceID: src, TargetID: src})
 	assert.NoError(t, err)
-	assert.Equal(t, nodes, gotNodes)
-	assert.Equal(t, rels, gotRels)
+	assert.Empty(t, got)
 	mockSession.AssertExpectations(t)
 }
 
@@ -534,6 +692,31 @@ func getIntegrationTestDriver() (neo4j.DriverWithContext, error) {
 	return integrationTestDriver, nil
 }
 
+// requireIntegrationTestDriver returns the integration test driver, skipping the test when NEO4J_URI is not set
+func requireIntegrationTestDriver(t *testing.T) neo4j.DriverWithContext {
+	t.Helper()
+	if os.Getenv("NEO4J_URI") == "" {
+		t.Skip("NEO4J_URI not set, skipping Neo4j integration test")
+	}
+	driver, err := getIntegrationTestDriver()
+	if err != nil {
+		t.Fatalf("Failed to get integration test driver: %v", err)
+	}
+	return driver
+}
+
+// runIntegrationCypher runs a write statement used to seed integration test data
+func runIntegrationCypher(ctx context.Context, t *testing.T, driver neo4j.DriverWithContext, query string, params map[string]any) {
+	t.Helper()
+	session := driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+	result, err := session.Run(ctx, query, params)
+	if err == nil {
+		_, err = result.Consume(ctx)
+	}
+	require.NoError(t, err, "Failed to run integration test statement")
+}
+
 // Helper to clean integration test data (simplified from repo test)
 func clearIntegrationTestData(ctx context.Context, driver neo4j.DriverWithContext) {
 	if driver == nil {
@@ -582,16 +765,8 @@ func createIntegrationTestNode(ctx context.Context, driver neo4j.DriverWithConte
 
 // --- Integration Test for ExecSearchNodes ---
 func TestNeo4jNodeDAL_ExecSearchNodes_Integration(t *testing.T) {
-	// Skip if running in short mode or if integration env vars not set? (Optional)
-	// if testing.Short() {
-	//  t.Skip("Skipping integration test in short mode.")
-	// }
-
 	ctx := context.Background()
-	driver, err := getIntegrationTestDriver()
-	if err != nil {
-		t.Fatalf("Failed to get integration test driver: %v", err)
-	}
+	driver := requireIntegrationTestDriver(t)
 	// Ensure cleanup happens even if setup fails partially (though getIntegrationTestDriver handles some)
 	// defer driver.Close(ctx) // Close might be handled globally if reused
 
@@ -620,7 +795,7 @@ func TestNeo4jNodeDAL_ExecSearchNodes_Integration(t *testing.T) {
 	limit := int64(10)
 	offset := int64(0)
 
-	nodes, labels, total, errSearch := dal.ExecSearchNodes(ctx, session, criteria, &nodeType, limit, offset)
+	nodes, labels, total, _, errSearch := dal.ExecSearchNodes(ctx, session, criteria, nil, nil, nil, &nodeType, limit, offset, nil)
 
 	// --- Assertions ---
 	assert.NoError(t, errSearch, "ExecSearchNodes returned an error")
@@ -635,3 +810,206 @@ func TestNeo4jNodeDAL_ExecSearchNodes_Integration(t *testing.T) {
 	// --- Cleanup (optional, might be handled globally) ---
 	// clearIntegrationTestData(ctx, driver)
 }
+
+// --- Integration Test for ExecGetNetwork paging ---
+// 关系 a-c 的两端落在不同的页: 应在 c 所在的页返回，逐页读取时每条关系恰好出现一次
+func TestNeo4jNodeDAL_ExecGetNetwork_PageBoundary_Integration(t *testing.T) {
+	ctx := context.Background()
+	driver := requireIntegrationTestDriver(t)
+	dal := NewNodeDAL()
+	clearIntegrationTestData(ctx, driver)
+
+	for _, id := range []string{"net-a", "net-b", "net-c"} {
+		require.NoError(t, createIntegrationTestNode(ctx, driver, &network.Node{ID: id, Type: network.NodeType_PERSON, Name: id}))
+	}
+	writeSession := driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	_, err := writeSession.Run(ctx, `
+		MATCH (a {id: 'net-a'}), (b {id: 'net-b'}), (c {id: 'net-c'})
+		CREATE (a)-[:FRIEND {id: 'net-ab'}]->(b), (a)-[:FRIEND {id: 'net-ac'}]->(c)`, nil)
+	writeSession.Close(ctx)
+	require.NoError(t, err)
+
+	session := driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	criteria := map[string]string{"id": "net-a"}
+
+	nodes, rels, truncated, err := dal.ExecGetNetwork(ctx, session, criteria, 1, 2, 0, 0, nil, nil, Traversal{})
+	require.NoError(t, err)
+	assert.True(t, truncated)
+	require.Len(t, nodes, 2)
+	assert.Equal(t, []any{"net-a", "net-b"}, []any{nodes[0].Props["id"], nodes[1].Props["id"]})
+	require.Len(t, rels, 1, "a-c 的 c 在下一页")
+	assert.Equal(t, "net-ab", rels[0].Props["id"])
+
+	nodes, rels, truncated, err = dal.ExecGetNetwork(ctx, session, criteria, 1, 2, 2, 0, nil, nil, Traversal{})
+	require.NoError(t, err)
+	assert.False(t, truncated)
+	require.Len(t, nodes, 1)
+	assert.Equal(t, "net-c", nodes[0].Props["id"])
+	require.Len(t, rels, 1, "跨页关系 a-c 在 c 所在的页返回")
+	assert.Equal(t, "net-ac", rels[0].Props["id"])
+}
+
+// --- Integration Test for ExecSearchNodes facets ---
+// 分面查询按全部匹配节点统计，type 分面可以限定标签
+func TestNeo4jNodeDAL_ExecSearchNodes_Facets_Integration(t *testing.T) {
+	ctx := context.Background()
+	driver := requireIntegrationTestDriver(t)
+	dal := NewNodeDAL()
+	clearIntegrationTestData(ctx, driver)
+
+	runIntegrationCypher(ctx, t, driver, `
+		CREATE (:PERSON {id: 'fa-p1', name: 'Facet One', city: 'Beijing'}),
+		       (:PERSON {id: 'fa-p2', name: 'Facet Two', city: 'Beijing'}),
+		       (:PERSON {id: 'fa-p3', name: 'Facet Three', city: 'Shanghai'}),
+		       (:COMPANY {id: 'fa-c1', name: 'Facet Corp', city: 'Beijing'})`, nil)
+
+	session := driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	facets, err := NewFacetRequest([]string{FacetTypeField, "city"}, 10, nil)
+	require.NoError(t, err)
+
+	nodes, _, total, got, err := dal.ExecSearchNodes(ctx, session, nil, nil, nil, facets, nil, 1, 0, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, total)
+	assert.Len(t, nodes, 1, "分面统计不受分页影响")
+	assert.Equal(t, []Facet{
+		{Field: FacetTypeField, Counts: []FacetCount{{Value: "PERSON", Count: 3}, {Value: "COMPANY", Count: 1}}},
+		{Field: "city", Counts: []FacetCount{{Value: "Beijing", Count: 3}, {Value: "Shanghai", Count: 1}}},
+	}, got)
+
+	nodeType := network.NodeType_PERSON
+	_, _, total, got, err = dal.ExecSearchNodes(ctx, session, nil, nil, nil, facets, &nodeType, 10, 0, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, total)
+	assert.Equal(t, []Facet{
+		{Field: FacetTypeField, Counts: []FacetCount{{Value: "PERSON", Count: 3}}},
+		{Field: "city", Counts: []FacetCount{{Value: "Beijing", Count: 2}, {Value: "Shanghai", Count: 1}}},
+	}, got)
+
+	facets.Labels = []string{"COMPANY"}
+	_, _, _, got, err = dal.ExecSearchNodes(ctx, session, nil, nil, nil, facets, nil, 10, 0, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, got)
+	assert.Equal(t, Facet{Field: FacetTypeField, Counts: []FacetCount{{Value: "COMPANY", Count: 1}}}, got[0], "type 分面只统计限定的标签")
+}
+
+// --- Integration Test for ExecFulltextSearchNodes ---
+func TestNeo4jNodeDAL_ExecFulltextSearchNodes_Integration(t *testing.T) {
+	ctx := context.Background()
+	driver := requireIntegrationTestDriver(t)
+	dal := NewNodeDAL()
+	clearIntegrationTestData(ctx, driver)
+
+	writeSession := driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	err := NewTypeDAL().ExecEnsureNodeFulltextIndex(ctx, writeSession, typeregistry.Default().NodeTypes())
+	writeSession.Close(ctx)
+	require.NoError(t, err)
+	runIntegrationCypher(ctx, t, driver, `
+		CREATE (:PERSON {id: 'ft-p1', name: 'Alice Smith', profession: 'engineer'}),
+		       (:PERSON {id: 'ft-p2', name: 'Bob Jones', profession: 'engineer'}),
+		       (:COMPANY {id: 'ft-c1', name: 'Engineer Hub'})`, nil)
+	runIntegrationCypher(ctx, t, driver, "CALL db.awaitIndexes(60)", nil)
+
+	session := driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	hitIDs := func(hits []FulltextNodeHit) []any {
+		ids := make([]any, len(hits))
+		for i, h := range hits {
+			ids[i] = h.Node.Props["id"]
+		}
+		return ids
+	}
+
+	hits, total, err := dal.ExecFulltextSearchNodes(ctx, session, "engineer", false, nil, 10, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, total)
+	assert.ElementsMatch(t, []any{"ft-p1", "ft-p2", "ft-c1"}, hitIDs(hits))
+	for i := 1; i < len(hits); i++ {
+		assert.GreaterOrEqual(t, hits[i-1].Score, hits[i].Score, "结果按得分降序排列")
+	}
+
+	page, total, err := dal.ExecFulltextSearchNodes(ctx, session, "engineer", false, nil, 1, 1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, total)
+	assert.Equal(t, hitIDs(hits[1:2]), hitIDs(page))
+
+	nodeType := network.NodeType_PERSON
+	hits, total, err = dal.ExecFulltextSearchNodes(ctx, session, "engineer", false, &nodeType, 10, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, total)
+	assert.ElementsMatch(t, []any{"ft-p1", "ft-p2"}, hitIDs(hits))
+	for _, h := range hits {
+		assert.Contains(t, h.Labels, "PERSON")
+	}
+
+	hits, total, err = dal.ExecFulltextSearchNodes(ctx, session, "alise", false, nil, 10, 0)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+	assert.Empty(t, hits)
+	hits, _, err = dal.ExecFulltextSearchNodes(ctx, session, "alise", true, nil, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []any{"ft-p1"}, hitIDs(hits), "模糊搜索允许一处编辑")
+
+	_, _, err = dal.ExecFulltextSearchNodes(ctx, session, "  ", false, nil, 10, 0)
+	assert.ErrorIs(t, err, fulltext.ErrEmptyQuery)
+}
+
+// --- Integration Test for ExecGetRecommendationCandidates ---
+// 与 storage 包中内存图的推荐测试使用相同的图，结果应一致
+func TestNeo4jNodeDAL_ExecGetRecommendationCandidates_Integration(t *testing.T) {
+	ctx := context.Background()
+	driver := requireIntegrationTestDriver(t)
+	dal := NewNodeDAL()
+	clearIntegrationTestData(ctx, driver)
+
+	runIntegrationCypher(ctx, t, driver, `
+		CREATE (p1:PERSON {id: 'p1', name: 'Alice'}), (p2:PERSON {id: 'p2', name: 'Bob'}),
+		       (p3:PERSON {id: 'p3', name: 'Carol'}), (p4:PERSON {id: 'p4', name: 'Alicia'}),
+		       (p5:PERSON {id: 'p5'}), (p6:PERSON {id: 'p6'}), (c1:COMPANY {id: 'c1', name: 'Acme'}),
+		       (p1)-[:FRIEND {id: 'r1'}]->(p2), (p2)-[:COLLEAGUE {id: 'r2'}]->(p3),
+		       (p3)-[:FRIEND {id: 'r3'}]->(p4), (p1)-[:VISITED {id: 'r4'}]->(c1),
+		       (p5)-[:FRIEND {id: 'r6'}]->(p2), (p5)-[:VISITED {id: 'r7'}]->(c1),
+		       (p2)-[:FOLLOWING {id: 'r8'}]->(p6), (p1)-[:FRIEND {id: 'r9'}]->(p4)`, nil)
+
+	session := driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+	q := RecommendationQuery{
+		NodeID:          "p1",
+		ConnectionTypes: []string{"FRIEND", "COLLEAGUE", "SCHOOLMATE"},
+		PersonLabel:     "PERSON",
+		OrgLabels:       []string{"COMPANY", "SCHOOL"},
+	}
+	got, err := dal.ExecGetRecommendationCandidates(ctx, session, q)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"p2", "p4"}, got.Contacts)
+	assert.Equal(t, []string{"c1"}, got.Orgs)
+	// p4 已直接相连；p6 只经过非联系关系 (FOLLOWING) 可达
+	assert.Equal(t, []recommend.Candidate{
+		{ID: "p3", Contacts: 2, Mutuals: []recommend.Common{{ID: "p2", Degree: 4}, {ID: "p4", Degree: 2}}},
+		{ID: "p5", Contacts: 1, Mutuals: []recommend.Common{{ID: "p2", Degree: 4}}, SharedOrgs: []recommend.Common{{ID: "c1", Degree: 2}}},
+	}, got.Candidates)
+
+	q.MaxCandidates = 1
+	got, err = dal.ExecGetRecommendationCandidates(ctx, session, q)
+	require.NoError(t, err)
+	require.Len(t, got.Candidates, 1)
+	assert.Equal(t, "p3", got.Candidates[0].ID, "共同邻居数相同时按 id 排序")
+
+	q.MaxCandidates = 0
+	q.MaxCommonDegree = 3
+	got, err = dal.ExecGetRecommendationCandidates(ctx, session, q)
+	require.NoError(t, err)
+	assert.Equal(t, []recommend.Candidate{
+		{ID: "p3", Contacts: 2, Mutuals: []recommend.Common{{ID: "p4", Degree: 2}}},
+		{ID: "p5", Contacts: 1, SharedOrgs: []recommend.Common{{ID: "c1", Degree: 2}}},
+	}, got.Candidates, "关系数超过上限的 p2 不再展开")
+
+	q.NodeID = "c1"
+	_, err = dal.ExecGetRecommendationCandidates(ctx, session, q)
+	assert.ErrorIs(t, err, ErrNotPerson)
+
+	q.NodeID = "missing"
+	_, err = dal.ExecGetRecommendationCandidates(ctx, session, q)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
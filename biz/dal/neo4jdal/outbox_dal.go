@@ -0,0 +1,381 @@
+package neo4jdal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"labelwall/pkg/typeregistry"
+
+	"github.com/google/uuid"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+// 变更事件类型，同时作为 RabbitMQ 的 routing key
+const (
+	EventNodeCreated     = "node.created"
+	EventNodeUpdated     = "node.updated"
+	EventNodeDeleted     = "node.deleted"
+	EventRelationCreated = "relation.created"
+	EventRelationUpdated = "relation.updated"
+	EventRelationDeleted = "relation.deleted"
+)
+
+// OutboxLabel 是发件箱事件节点的标签。
+// 事件的业务 ID 存放在 event_id 而不是 id 上，避免被 MATCH (n {id: $id}) 之类的节点查询匹配到。
+const OutboxLabel = "OutboxEvent"
+
+// ChangeEvent 描述一次写操作需要产生的变更事件，由 Repo 层构建。
+// 受影响的节点/关系 ID 在事务内根据 AggregateID 查询补全。
+type ChangeEvent struct {
+	EventType   string
+	AggregateID string
+	// Label 是聚合的节点标签或关系类型，已知时按标签/类型查找聚合 (走 id 唯一约束或关系 id 索引)；
+	// 为空时在每个已注册的节点标签或关系类型上各做一次索引查找
+	Label string
+}
+
+// OutboxEvent 是发件箱中一条待发布的事件
+type OutboxEvent struct {
+	EventID       string
+	EventType     string
+	AggregateType string // "node" 或 "relation"
+	AggregateID   string
+	NodeIDs       []string // 受影响的节点 (节点本身、关系两端或被删除节点的邻居)
+	RelationIDs   []string // 受影响的关系 (关系本身或随节点一起删除的关系)
+	NodeLabels    []string // 节点事件中聚合节点的标签，关系事件为空
+	CreatedAt     time.Time
+	Attempts      int64
+}
+
+// aggregateType 根据事件类型推断聚合类型
+func aggregateType(eventType string) string {
+	if i := strings.IndexByte(eventType, '.'); i > 0 {
+		return eventType[:i]
+	}
+	return eventType
+}
+
+// outboxCreateClause 创建发件箱事件节点，nodeIDs / relationIDs / nodeLabels 为受影响 ID 列表和聚合节点标签的 Cypher 表达式
+func outboxCreateClause(nodeIDs, relationIDs, nodeLabels string) string {
+	return `
+		CREATE (o:` + OutboxLabel + ` {event_id: e.event_id, event_type: e.event_type, aggregate_type: e.aggregate_type,
+			aggregate_id: e.aggregate_id, node_ids: ` + nodeIDs + `, relation_ids: ` + relationIDs + `, node_labels: ` + nodeLabels + `, seq: e.seq,
+			created_at: datetime(), published_at: null, attempts: 0})`
+}
+
+// outboxNodeMatch 返回按 id 匹配事件节点 n 的子句。
+// 节点查询必须带标签才能使用 id 唯一约束索引: 标签未知时对每个已注册的节点标签各做一次索引查找再合并。
+func outboxNodeMatch(label string) string {
+	if label != "" {
+		return fmt.Sprintf("MATCH (n:%s {id: e.aggregate_id})", label)
+	}
+	defs := typeregistry.Default().NodeTypes()
+	if len(defs) == 0 {
+		return "MATCH (n {id: e.aggregate_id}) WHERE NOT n:" + OutboxLabel
+	}
+	branches := make([]string, len(defs))
+	for i, def := range defs {
+		branches[i] = fmt.Sprintf("WITH e MATCH (n:%s {id: e.aggregate_id}) RETURN n", def.Name)
+	}
+	return "CALL { " + strings.Join(branches, " UNION ") + " }"
+}
+
+// outboxRelationMatch 返回按 id 匹配事件关系 (s)-[r]->(t) 的子句。
+// 关系 id 索引按类型建立 (见 RelationTypeSchemaQueries): 类型未知时对每个已注册的关系类型各做一次索引查找再合并。
+func outboxRelationMatch(relType string) string {
+	if relType != "" {
+		return fmt.Sprintf("MATCH (s)-[r:%s {id: e.aggregate_id}]->(t)", relType)
+	}
+	defs := typeregistry.Default().RelationTypes()
+	if len(defs) == 0 {
+		return "MATCH (s)-[r {id: e.aggregate_id}]->(t)"
+	}
+	branches := make([]string, len(defs))
+	for i, def := range defs {
+		branches[i] = fmt.Sprintf("WITH e MATCH (s)-[r:%s {id: e.aggregate_id}]->(t) RETURN s, r, t", def.Name)
+	}
+	return "CALL { " + strings.Join(branches, " UNION ") + " }"
+}
+
+// outboxInsertQuery 按事件类型给出写入发件箱的 Cypher，label 为聚合的节点标签或关系类型 (可为空)。
+// 每条语句都以 MATCH 聚合本身为前提: 写操作没有真正命中数据时不会产生事件。
+// 删除事件在删除之前写入，以便记录关系两端和随节点删除的关系。
+func outboxInsertQuery(eventType, label string) (string, error) {
+	switch eventType {
+	case EventNodeCreated, EventNodeUpdated:
+		return `
+		UNWIND $events AS e
+		` + outboxNodeMatch(label) + outboxCreateClause("[n.id]", "[]", "labels(n)"), nil
+	case EventNodeDeleted:
+		return `
+		UNWIND $events AS e
+		` + outboxNodeMatch(label) + `
+		OPTIONAL MATCH (n)-[r]-(m)
+		WITH e, n, collect(DISTINCT r.id) AS relIds, collect(DISTINCT m.id) AS neighbourIds` +
+			outboxCreateClause("[n.id] + neighbourIds", "relIds", "labels(n)"), nil
+	case EventRelationCreated, EventRelationUpdated, EventRelationDeleted:
+		return `
+		UNWIND $events AS e
+		` + outboxRelationMatch(label) + outboxCreateClause("[s.id, t.id]", "[r.id]", "[]"), nil
+	default:
+		return "", fmt.Errorf("DAL: 未知的事件类型 '%s'", eventType)
+	}
+}
+
+// isDeleteEvent 删除事件需要在写操作之前记录
+func isDeleteEvent(eventType string) bool {
+	return eventType == EventNodeDeleted || eventType == EventRelationDeleted
+}
+
+// outboxSession 包装一个 session，在每个写事务中同时写入发件箱事件。
+// 事件与业务数据在同一事务中提交或回滚，因此下游既不会漏掉变更，也不会看到未发生的变更。
+type outboxSession struct {
+	neo4j.SessionWithContext
+	before []map[string]any // 删除事件: 在写操作之前记录
+	after  []map[string]any // 创建/更新事件: 在写操作之后记录
+	// derive 非 nil 时根据写操作的结果给出事件，在写操作之后记录
+	derive func(result any) []ChangeEvent
+}
+
+// outboxEventParams 返回写入一条事件所需的参数，seq 为事件在本次写操作中的顺序
+func outboxEventParams(e ChangeEvent, seq int) map[string]any {
+	return map[string]any{
+		"event_id":       uuid.NewString(),
+		"event_type":     e.EventType,
+		"aggregate_type": aggregateType(e.EventType),
+		"aggregate_id":   e.AggregateID,
+		"seq":            int64(seq),
+		"label":          e.Label,
+	}
+}
+
+// WithOutbox 返回一个在写事务中附带写入 events 的 session。
+// DAL 方法无需感知发件箱；事件 ID 在此处生成，事务重试时保持不变。
+func WithOutbox(session neo4j.SessionWithContext, events []ChangeEvent) neo4j.SessionWithContext {
+	if len(events) == 0 {
+		return session
+	}
+	s := &outboxSession{SessionWithContext: session}
+	for i, e := range events {
+		params := outboxEventParams(e, i)
+		if isDeleteEvent(e.EventType) {
+			s.before = append(s.before, params)
+		} else {
+			s.after = append(s.after, params)
+		}
+	}
+	return s
+}
+
+// WithDerivedOutbox 返回一个写事务 session，事件由 derive 根据写操作 (work) 的结果给出，在写操作之后的同一事务中写入。
+// 用于写入之后才知道哪些记录真正发生变化的操作，如按 id MERGE 的批量导入只为新建的记录产生事件。
+// derive 给出的删除事件同样在写操作之后记录，此时聚合已不存在，不会产生事件。
+// 事件 ID 在每次执行事务时生成，重试前的事务已回滚，不会留下事件。
+func WithDerivedOutbox(session neo4j.SessionWithContext, derive func(result any) []ChangeEvent) neo4j.SessionWithContext {
+	return &outboxSession{SessionWithContext: session, derive: derive}
+}
+
+// ExecuteWrite 在同一个托管事务中依次写入删除事件、执行 work、写入创建/更新事件
+func (s *outboxSession) ExecuteWrite(ctx context.Context, work neo4j.ManagedTransactionWork, configurers ...func(*neo4j.TransactionConfig)) (any, error) {
+	return s.SessionWithContext.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		if err := writeOutboxEvents(ctx, tx, s.before); err != nil {
+			return nil, err
+		}
+		result, err := work(tx)
+		if err != nil {
+			return nil, err
+		}
+		after := s.after
+		if s.derive != nil {
+			for _, e := range s.derive(result) {
+				after = append(after, outboxEventParams(e, len(after)))
+			}
+		}
+		if err := writeOutboxEvents(ctx, tx, after); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}, configurers...)
+}
+
+// writeOutboxEvents 按事件类型和标签分组写入发件箱
+func writeOutboxEvents(ctx context.Context, tx neo4j.ManagedTransaction, events []map[string]any) error {
+	type groupKey struct{ eventType, label string }
+	groups := make(map[groupKey][]map[string]any)
+	var order []groupKey
+	for _, e := range events {
+		key := groupKey{eventType: e["event_type"].(string), label: e["label"].(string)}
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], e)
+	}
+	for _, key := range order {
+		query, err := outboxInsertQuery(key.eventType, key.label)
+		if err != nil {
+			return err
+		}
+		result, err := tx.Run(ctx, query, map[string]any{"events": groups[key]})
+		if err != nil {
+			return fmt.Errorf("DAL: 写入发件箱事件失败: %w", err)
+		}
+		if _, err := result.Consume(ctx); err != nil {
+			return fmt.Errorf("DAL: 写入发件箱事件失败: %w", err)
+		}
+	}
+	return nil
+}
+
+// OutboxDAL 定义了发件箱的底层操作，供 relay 使用
+type OutboxDAL interface {
+	// ExecFetchPendingEvents 按写入顺序返回最多 limit 条未发布的事件
+	ExecFetchPendingEvents(ctx context.Context, session neo4j.SessionWithContext, limit int64) ([]OutboxEvent, error)
+	// ExecMarkEventsPublished 将事件标记为已发布
+	ExecMarkEventsPublished(ctx context.Context, session neo4j.SessionWithContext, eventIDs []string) error
+	// ExecMarkEventFailed 记录一次发布失败 (attempts + 1, last_error)
+	ExecMarkEventFailed(ctx context.Context, session neo4j.SessionWithContext, eventID string, lastError string) error
+	// ExecPurgePublishedEvents 删除 before 之前已发布的事件，返回删除数量
+	ExecPurgePublishedEvents(ctx context.Context, session neo4j.SessionWithContext, before time.Time) (int64, error)
+}
+
+// neo4jOutboxDAL 实现了 OutboxDAL 接口
+type neo4jOutboxDAL struct{}
+
+// NewOutboxDAL 创建一个新的 OutboxDAL 实例
+func NewOutboxDAL() OutboxDAL {
+	return &neo4jOutboxDAL{}
+}
+
+// ExecFetchPendingEvents 按 (created_at, seq) 顺序读取未发布的事件
+func (d *neo4jOutboxDAL) ExecFetchPendingEvents(ctx context.Context, session neo4j.SessionWithContext, limit int64) ([]OutboxEvent, error) {
+	readResult, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		query := `
+			MATCH (o:` + OutboxLabel + `) WHERE o.published_at IS NULL
+			RETURN o ORDER BY o.created_at, o.seq LIMIT $limit`
+		result, err := tx.Run(ctx, query, map[string]any{"limit": limit})
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 运行读取发件箱查询失败: %w", err)
+		}
+		records, err := result.Collect(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 获取发件箱事件失败: %w", err)
+		}
+		events := make([]OutboxEvent, 0, len(records))
+		for _, record := range records {
+			nodeInterface, _ := record.Get("o")
+			dbNode, ok := nodeInterface.(dbtype.Node)
+			if !ok {
+				return nil, fmt.Errorf("DAL: 结果中的 'o' 不是有效的节点类型")
+			}
+			events = append(events, mapOutboxEvent(dbNode))
+		}
+		return events, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	events, ok := readResult.([]OutboxEvent)
+	if !ok {
+		return nil, fmt.Errorf("DAL: 事务返回了非预期的事件列表类型")
+	}
+	return events, nil
+}
+
+// ExecMarkEventsPublished 设置 published_at，已发布的事件不会再被读取
+func (d *neo4jOutboxDAL) ExecMarkEventsPublished(ctx context.Context, session neo4j.SessionWithContext, eventIDs []string) error {
+	if len(eventIDs) == 0 {
+		return nil
+	}
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		query := `
+			MATCH (o:` + OutboxLabel + `) WHERE o.event_id IN $ids
+			SET o.published_at = datetime()`
+		result, err := tx.Run(ctx, query, map[string]any{"ids": eventIDs})
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 运行标记事件已发布查询失败: %w", err)
+		}
+		return result.Consume(ctx)
+	})
+	if err != nil {
+		return fmt.Errorf("DAL: 标记事件已发布事务失败: %w", err)
+	}
+	return nil
+}
+
+// ExecMarkEventFailed 累加失败次数并记录最后一次错误
+func (d *neo4jOutboxDAL) ExecMarkEventFailed(ctx context.Context, session neo4j.SessionWithContext, eventID string, lastError string) error {
+	_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		query := `
+			MATCH (o:` + OutboxLabel + ` {event_id: $id})
+			SET o.attempts = coalesce(o.attempts, 0) + 1, o.last_error = $error, o.last_attempt_at = datetime()`
+		result, err := tx.Run(ctx, query, map[string]any{"id": eventID, "error": lastError})
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 运行标记事件失败查询失败: %w", err)
+		}
+		return result.Consume(ctx)
+	})
+	if err != nil {
+		return fmt.Errorf("DAL: 标记事件失败事务失败: %w", err)
+	}
+	return nil
+}
+
+// ExecPurgePublishedEvents 清理已发布且早于 before 的事件
+func (d *neo4jOutboxDAL) ExecPurgePublishedEvents(ctx context.Context, session neo4j.SessionWithContext, before time.Time) (int64, error) {
+	resultSummary, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		query := `
+			MATCH (o:` + OutboxLabel + `) WHERE o.published_at IS NOT NULL AND o.published_at < $before
+			DELETE o`
+		result, err := tx.Run(ctx, query, map[string]any{"before": before.UTC()})
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 运行清理发件箱查询失败: %w", err)
+		}
+		return result.Consume(ctx)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("DAL: 清理发件箱事务失败: %w", err)
+	}
+	if summary, ok := resultSummary.(neo4j.ResultSummary); ok {
+		return int64(summary.Counters().NodesDeleted()), nil
+	}
+	return 0, fmt.Errorf("DAL: 清理发件箱事务返回了非预期的结果类型")
+}
+
+// mapOutboxEvent 将发件箱节点映射为 OutboxEvent
+func mapOutboxEvent(n dbtype.Node) OutboxEvent {
+	event := OutboxEvent{
+		EventID:       stringProp(n.Props, "event_id"),
+		EventType:     stringProp(n.Props, "event_type"),
+		AggregateType: stringProp(n.Props, "aggregate_type"),
+		AggregateID:   stringProp(n.Props, "aggregate_id"),
+		NodeIDs:       stringListProp(n.Props, "node_ids"),
+		RelationIDs:   stringListProp(n.Props, "relation_ids"),
+		NodeLabels:    stringListProp(n.Props, "node_labels"),
+	}
+	if t, ok := n.Props["created_at"].(time.Time); ok {
+		event.CreatedAt = t
+	}
+	if attempts, ok := n.Props["attempts"].(int64); ok {
+		event.Attempts = attempts
+	}
+	return event
+}
+
+func stringProp(props map[string]any, key string) string {
+	s, _ := props[key].(string)
+	return s
+}
+
+func stringListProp(props map[string]any, key string) []string {
+	raw, _ := props[key].([]any)
+	out := make([]string, 0, len(raw))
+	for _, v := range raw {
+		if s, ok := v.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
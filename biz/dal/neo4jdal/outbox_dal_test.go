@@ -0,0 +1,210 @@
+package neo4jdal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingTx 记录事务中执行的查询，所有查询都返回空结果
+type recordingTx struct {
+	neo4j.ManagedTransaction
+	queries []string
+	params  []map[string]any
+}
+
+func (t *recordingTx) Run(ctx context.Context, cypher string, params map[string]any) (neo4j.ResultWithContext, error) {
+	t.queries = append(t.queries, cypher)
+	t.params = append(t.params, params)
+	return &emptyResult{}, nil
+}
+
+type emptyResult struct {
+	neo4j.ResultWithContext
+}
+
+func (r *emptyResult) Consume(ctx context.Context) (neo4j.ResultSummary, error) {
+	return &MockResultSummary{}, nil
+}
+
+// runWorkSession 在 ExecuteWrite 中用 recordingTx 真正执行 work
+type runWorkSession struct {
+	MockSession
+	tx *recordingTx
+}
+
+func (s *runWorkSession) ExecuteWrite(ctx context.Context, work neo4j.ManagedTransactionWork, configurers ...func(*neo4j.TransactionConfig)) (any, error) {
+	return work(s.tx)
+}
+
+func TestWithOutbox(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("没有事件时返回原 session", func(t *testing.T) {
+		session := new(MockSession)
+		assert.Same(t, session, WithOutbox(session, nil))
+	})
+
+	t.Run("删除事件在写操作之前记录，其余在之后", func(t *testing.T) {
+		tx := &recordingTx{}
+		session := &runWorkSession{tx: tx}
+		wrapped := WithOutbox(session, []ChangeEvent{
+			{EventType: EventRelationCreated, AggregateID: "r1"},
+			{EventType: EventNodeDeleted, AggregateID: "n1"},
+			{EventType: EventRelationCreated, AggregateID: "r2"},
+		})
+
+		result, err := wrapped.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+			_, err := tx.Run(ctx, "WORK", nil)
+			return "done", err
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "done", result)
+
+		require.Len(t, tx.queries, 3)
+		assert.Contains(t, tx.queries[0], "OPTIONAL MATCH (n)-[r]-(m)", "删除事件应先写入")
+		assert.Equal(t, "WORK", tx.queries[1])
+		assert.Contains(t, tx.queries[2], "MATCH (s)-[r:FRIEND {id: e.aggregate_id}]->(t)")
+
+		// 同类型事件合并为一次 UNWIND
+		events := tx.params[2]["events"].([]map[string]any)
+		require.Len(t, events, 2)
+		assert.Equal(t, "r1", events[0]["aggregate_id"])
+		assert.Equal(t, "relation", events[0]["aggregate_type"])
+		assert.NotEmpty(t, events[0]["event_id"])
+		assert.NotEqual(t, events[0]["event_id"], events[1]["event_id"])
+	})
+
+	t.Run("写操作失败时不写入后置事件", func(t *testing.T) {
+		tx := &recordingTx{}
+		wrapped := WithOutbox(&runWorkSession{tx: tx}, []ChangeEvent{{EventType: EventNodeCreated, AggregateID: "n1"}})
+		expectedErr := errors.New("写入失败")
+
+		_, err := wrapped.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+			return nil, expectedErr
+		})
+		assert.ErrorIs(t, err, expectedErr)
+		assert.Empty(t, tx.queries)
+	})
+
+	t.Run("节点事件按标签查找节点", func(t *testing.T) {
+		for _, eventType := range []string{EventNodeCreated, EventNodeUpdated, EventNodeDeleted} {
+			query, err := outboxInsertQuery(eventType, "PERSON")
+			require.NoError(t, err)
+			assert.Contains(t, query, "MATCH (n:PERSON {id: e.aggregate_id})", eventType)
+
+			// 标签未知时在每个已注册的标签上做索引查找，不做无标签扫描
+			query, err = outboxInsertQuery(eventType, "")
+			require.NoError(t, err)
+			assert.NotContains(t, query, "MATCH (n {id:", eventType)
+			for _, label := range []string{"PERSON", "COMPANY", "SCHOOL"} {
+				assert.Contains(t, query, "MATCH (n:"+label+" {id: e.aggregate_id})", eventType)
+			}
+		}
+
+		for _, eventType := range []string{EventRelationCreated, EventRelationUpdated, EventRelationDeleted} {
+			query, err := outboxInsertQuery(eventType, "FRIEND")
+			require.NoError(t, err)
+			assert.Contains(t, query, "MATCH (s)-[r:FRIEND {id: e.aggregate_id}]->(t)", eventType)
+
+			// 类型未知时在每个已注册的关系类型上做索引查找，不做无类型扫描
+			query, err = outboxInsertQuery(eventType, "")
+			require.NoError(t, err)
+			assert.NotContains(t, query, "[r {id:", eventType)
+			for _, relType := range []string{"FRIEND", "COLLEAGUE", "SCHOOLMATE", "VISITED", "FOLLOWING"} {
+				assert.Contains(t, query, "MATCH (s)-[r:"+relType+" {id: e.aggregate_id}]->(t)", eventType)
+			}
+		}
+
+		_, err := outboxInsertQuery("node.renamed", "")
+		assert.Error(t, err)
+	})
+
+	t.Run("按写操作的结果给出事件", func(t *testing.T) {
+		tx := &recordingTx{}
+		wrapped := WithDerivedOutbox(&runWorkSession{tx: tx}, func(result any) []ChangeEvent {
+			var events []ChangeEvent
+			for i, isNew := range result.([]bool) {
+				if isNew {
+					events = append(events, ChangeEvent{EventType: EventNodeCreated, AggregateID: []string{"n1", "n2", "n3"}[i], Label: "PERSON"})
+				}
+			}
+			return events
+		})
+
+		_, err := wrapped.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+			_, err := tx.Run(ctx, "WORK", nil)
+			return []bool{true, false, true}, err
+		})
+		require.NoError(t, err)
+		require.Len(t, tx.queries, 2)
+		assert.Equal(t, "WORK", tx.queries[0])
+		events := tx.params[1]["events"].([]map[string]any)
+		require.Len(t, events, 2, "只为新建的节点写入事件")
+		assert.Equal(t, "n1", events[0]["aggregate_id"])
+		assert.Equal(t, "n3", events[1]["aggregate_id"])
+		assert.Equal(t, int64(1), events[1]["seq"])
+
+		// 没有新建的记录时不写入发件箱
+		tx = &recordingTx{}
+		wrapped = WithDerivedOutbox(&runWorkSession{tx: tx}, func(result any) []ChangeEvent { return nil })
+		_, err = wrapped.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) { return []bool{false}, nil })
+		require.NoError(t, err)
+		assert.Empty(t, tx.queries)
+	})
+
+	t.Run("不同标签的同类型事件分别写入", func(t *testing.T) {
+		tx := &recordingTx{}
+		wrapped := WithOutbox(&runWorkSession{tx: tx}, []ChangeEvent{
+			{EventType: EventNodeCreated, AggregateID: "p1", Label: "PERSON"},
+			{EventType: EventNodeCreated, AggregateID: "c1", Label: "COMPANY"},
+			{EventType: EventNodeCreated, AggregateID: "p2", Label: "PERSON"},
+		})
+		_, err := wrapped.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) { return nil, nil })
+		require.NoError(t, err)
+
+		require.Len(t, tx.queries, 2)
+		assert.Contains(t, tx.queries[0], "(n:PERSON {id: e.aggregate_id})")
+		assert.Len(t, tx.params[0]["events"], 2)
+		assert.Contains(t, tx.queries[1], "(n:COMPANY {id: e.aggregate_id})")
+	})
+}
+
+func TestNeo4jOutboxDAL_ExecMarkEventsPublished(t *testing.T) {
+	dal := NewOutboxDAL()
+	ctx := context.Background()
+
+	t.Run("空列表不访问数据库", func(t *testing.T) {
+		mockSession := new(MockSession)
+		assert.NoError(t, dal.ExecMarkEventsPublished(ctx, mockSession, nil))
+		mockSession.AssertNotCalled(t, "ExecuteWrite", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("事务失败", func(t *testing.T) {
+		mockSession := new(MockSession)
+		mockSession.On("ExecuteWrite", ctx, mock.AnythingOfType("neo4j.ManagedTransactionWork"), mock.Anything).Return(nil, errors.New("连接断开")).Once()
+		err := dal.ExecMarkEventsPublished(ctx, mockSession, []string{"e1"})
+		assert.Error(t, err)
+		mockSession.AssertExpectations(t)
+	})
+}
+
+func TestNeo4jOutboxDAL_ExecPurgePublishedEvents(t *testing.T) {
+	dal := NewOutboxDAL()
+	ctx := context.Background()
+
+	mockSession := new(MockSession)
+	summary := &MockResultSummary{CountersMock: MockCounters{NodesDeletedCount: 3}}
+	mockSession.On("ExecuteWrite", ctx, mock.AnythingOfType("neo4j.ManagedTransactionWork"), mock.Anything).Return(summary, nil).Once()
+
+	deleted, err := dal.ExecPurgePublishedEvents(ctx, mockSession, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+	mockSession.AssertExpectations(t)
+}
@@ -0,0 +1,506 @@
+package neo4jdal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/pathfind"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+// 路径查询的数量限制
+const (
+	DefaultPathK        = 3
+	MaxPathK            = 10
+	MaxAllShortestPaths = 50
+	MaxViaNodes         = 5 // 路径必须经过的节点数上限
+)
+
+// WEIGHTED 模式的默认代价属性和缺省代价
+const (
+	DefaultWeightProperty = "weight"
+	DefaultRelationWeight = 1.0
+)
+
+// PathSearchBudget 是在 Go 中运行的一次路径搜索 (WEIGHTED 模式和带节点约束的查询) 的预算：
+// 每读取一个节点的邻居是事务中的一次查询，超出时返回 ErrPathSearchTooLarge
+var PathSearchBudget = pathfind.Budget{MaxExpansions: 20000, MaxNodes: 2000}
+
+// PathQuery 是路径查询的参数
+type PathQuery struct {
+	SourceID  string
+	TargetID  string
+	MaxDepth  int32            // 最大跳数，<= 0 时按 1 处理
+	RelTypes  []string         // 允许经过的关系类型，为空时不限制
+	Traversal Traversal        // 从起点走向终点时允许的关系方向
+	Mode      network.PathMode // 0 等同于 SINGLE
+	K         int              // K_SHORTEST 模式返回的路径数，<= 0 时使用 DefaultPathK
+
+	WeightProperty string  // WEIGHTED 模式作为代价的关系属性，为空时使用 DefaultWeightProperty
+	DefaultWeight  float64 // 关系缺少代价属性 (或不是非负数值) 时的代价
+
+	// 中间节点的约束，节点以业务 id 表示，起点和终点不受约束
+	ExcludeNodeIDs    []string // 不能经过的节点
+	ViaNodeIDs        []string // 必须经过的节点 (顺序不限)
+	IntermediateTypes []string // 中间节点允许的标签，为空时不限制；ViaNodeIDs 中的节点不受此限制
+}
+
+// AllowsIntermediate 判断业务 id 为 id、带有 labels 标签的节点能否作为路径的中间节点
+func (q PathQuery) AllowsIntermediate(id string, labels []string) bool {
+	if slices.Contains(q.ExcludeNodeIDs, id) {
+		return false
+	}
+	if len(q.IntermediateTypes) == 0 || slices.Contains(q.ViaNodeIDs, id) {
+		return true
+	}
+	return slices.ContainsFunc(labels, func(l string) bool { return slices.Contains(q.IntermediateTypes, l) })
+}
+
+// RemainingVia 返回 ViaNodeIDs 中不在 visited 里的节点 (非 nil)，用于 Yen 算法的偏离路径扣除 root 已经经过的节点
+func (q PathQuery) RemainingVia(visited []string) []string {
+	remaining := make([]string, 0, len(q.ViaNodeIDs))
+	for _, id := range q.ViaNodeIDs {
+		if !slices.Contains(visited, id) && !slices.Contains(remaining, id) {
+			remaining = append(remaining, id)
+		}
+	}
+	return remaining
+}
+
+// searchInGo 判断是否在 Go 中搜索路径。节点约束和按类型覆盖的方向只能写成对整条路径求值的 WHERE 条件，
+// Neo4j 无法在 shortestPath 的 BFS 中检查，会退化为穷举搜索 (开启 dbms.cypher.forbid_exhaustive_shortestpath 时直接失败)
+func (q PathQuery) searchInGo() bool {
+	if len(q.ExcludeNodeIDs) > 0 || len(q.ViaNodeIDs) > 0 || len(q.IntermediateTypes) > 0 {
+		return true
+	}
+	_, uniform := q.Traversal.uniform()
+	return !uniform
+}
+
+// Path 是一条路径，Relationships[i] 连接 Nodes[i] 和 Nodes[i+1]
+type Path struct {
+	Nodes         []neo4j.Node
+	Relationships []neo4j.Relationship
+	Cost          float64 // WEIGHTED 模式下的总代价
+}
+
+// RelationCost 返回关系在 WEIGHTED 模式下的代价: props[property] 为非负数值 (或可解析为非负数的字符串) 时取该值，
+// 否则为 defaultCost
+func RelationCost(props map[string]any, property string, defaultCost float64) float64 {
+	var cost float64
+	switch v := props[property].(type) {
+	case int64:
+		cost = float64(v)
+	case float64:
+		cost = v
+	case string:
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return defaultCost
+		}
+		cost = parsed
+	default:
+		return defaultCost
+	}
+	if cost < 0 || math.IsNaN(cost) || math.IsInf(cost, 0) {
+		return defaultCost
+	}
+	return cost
+}
+
+// shortestPathQuery 构造 q 的最短路径查询，fn 为 shortestPath 或 allShortestPaths。
+// spur 为 true 时起点按 elementId ($fromId) 匹配，并避开 $excludedNodes / $excludedRels (Yen 算法的偏离路径)。
+// 只用于不需要在 Go 中搜索的查询 (见 searchInGo)：没有节点约束，所有关系类型的方向相同。
+// 结果按路径上节点的业务 id 排序，最多 $limit 条。
+func shortestPathQuery(fn string, maxDepth int, spur bool, q PathQuery) string {
+	var b strings.Builder
+	if spur {
+		b.WriteString("MATCH (source) WHERE elementId(source) = $fromId MATCH (target {id: $targetId})")
+	} else {
+		b.WriteString("MATCH (source {id: $sourceId}), (target {id: $targetId})")
+	}
+	pattern, _ := q.Traversal.pattern("source", "target", maxDepth, "path")
+	fmt.Fprintf(&b, " MATCH path = %s(%s)", fn, pattern)
+	var conds []string
+	if len(q.RelTypes) > 0 {
+		conds = append(conds, "ALL(rel IN relationships(path) WHERE type(rel) IN $relTypes)")
+	}
+	if spur {
+		conds = append(conds,
+			"NONE(n IN nodes(path) WHERE elementId(n) IN $excludedNodes)",
+			"NONE(rel IN relationships(path) WHERE elementId(rel) IN $excludedRels)")
+	}
+	if len(conds) > 0 {
+		b.WriteString(" WHERE " + strings.Join(conds, " AND "))
+	}
+	b.WriteString(" WITH path ORDER BY [n IN nodes(path) | n.id] LIMIT $limit")
+	b.WriteString(" RETURN nodes(path) AS nodes, relationships(path) AS relations")
+	return b.String()
+}
+
+// ExecGetPaths 查询两个节点之间满足 q 中约束的路径 (起点和终点不同)，按 q.Mode:
+//   - SINGLE: 一条最短路径
+//   - ALL_SHORTEST: 全部最短路径，最多 MaxAllShortestPaths 条
+//   - K_SHORTEST: 用 Yen 算法求前 q.K 条无环路径，按跳数升序；每条偏离路径是同一读事务中的一次 shortestPath 查询
+//   - WEIGHTED: 按 RelationCost 求总代价最小的一条路径，在 Go 中运行 Dijkstra，每个节点的关系在首次展开时查询一次
+//
+// 带节点约束或按类型覆盖方向时 (见 searchInGo)，前三种模式也在 Go 中以单位代价搜索 (execConstrainedPaths)。
+// 在 Go 中搜索超出 PathSearchBudget 时返回 ErrPathSearchTooLarge。未找到路径时返回空列表。
+func (d *neo4jNodeDAL) ExecGetPaths(ctx context.Context, session neo4j.SessionWithContext, q PathQuery) ([]Path, error) {
+	if q.SourceID == q.TargetID {
+		return []Path{}, nil
+	}
+	maxDepth := int(q.MaxDepth)
+	if maxDepth <= 0 {
+		maxDepth = 1
+	}
+	params := map[string]any{
+		"sourceId": q.SourceID,
+		"targetId": q.TargetID,
+		"relTypes": q.RelTypes,
+		"limit":    1,
+	}
+	q.Traversal.addParams(params)
+
+	readResult, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		if q.Mode != network.PathMode_WEIGHTED && q.searchInGo() {
+			return execConstrainedPaths(ctx, tx, q, maxDepth)
+		}
+		switch q.Mode {
+		case network.PathMode_ALL_SHORTEST:
+			params["limit"] = MaxAllShortestPaths
+			return runPathQuery(ctx, tx, shortestPathQuery("allShortestPaths", maxDepth, false, q), params)
+		case network.PathMode_K_SHORTEST:
+			return execKShortestPaths(ctx, tx, q, maxDepth, params)
+		case network.PathMode_WEIGHTED:
+			return execCheapestPath(ctx, tx, q, maxDepth)
+		default:
+			return runPathQuery(ctx, tx, shortestPathQuery("shortestPath", maxDepth, false, q), params)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	paths, _ := readResult.([]Path)
+	if paths == nil {
+		paths = []Path{}
+	}
+	return paths, nil
+}
+
+// execKShortestPaths 在事务中运行 Yen 算法。pathfind 中的节点和边以 elementId 表示，
+// 起点以空字符串表示 (按业务 id 匹配)，之后的偏离点都是已查询到的节点。
+func execKShortestPaths(ctx context.Context, tx neo4j.ManagedTransaction, q PathQuery, maxDepth int, params map[string]any) ([]Path, error) {
+	k := q.K
+	if k <= 0 {
+		k = DefaultPathK
+	}
+	nodes := make(map[string]neo4j.Node)
+	rels := make(map[string]neo4j.Relationship)
+	shortest := func(from string, maxHops int, excl pathfind.Exclusion) (pathfind.Path, bool, error) {
+		spur := from != ""
+		spurParams := maps.Clone(params)
+		spurParams["limit"] = 1
+		spurParams["fromId"] = from
+		spurParams["excludedNodes"] = setKeys(excl.Nodes)
+		spurParams["excludedRels"] = setKeys(excl.Edges)
+		found, err := runPathQuery(ctx, tx, shortestPathQuery("shortestPath", maxHops, spur, q), spurParams)
+		if err != nil || len(found) == 0 {
+			return pathfind.Path{}, false, err
+		}
+		p := pathfind.Path{}
+		for _, n := range found[0].Nodes {
+			nodes[n.ElementId] = n
+			p.Nodes = append(p.Nodes, n.ElementId)
+		}
+		for _, r := range found[0].Relationships {
+			rels[r.ElementId] = r
+			p.Edges = append(p.Edges, r.ElementId)
+		}
+		return p, true, nil
+	}
+
+	found, err := pathfind.KShortest("", k, maxDepth, shortest)
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]Path, len(found))
+	for i, p := range found {
+		paths[i].Nodes = make([]neo4j.Node, len(p.Nodes))
+		for j, id := range p.Nodes {
+			paths[i].Nodes[j] = nodes[id]
+		}
+		paths[i].Relationships = make([]neo4j.Relationship, len(p.Edges))
+		for j, id := range p.Edges {
+			paths[i].Relationships[j] = rels[id]
+		}
+	}
+	return paths, nil
+}
+
+// pathExpandQuery 查询节点的全部关系和另一端节点，顺序固定以保证结果稳定
+func pathExpandQuery(filterTypes bool) string {
+	query := "MATCH (n)-[r]-(m) WHERE elementId(n) = $nodeId"
+	if filterTypes {
+		query += " AND type(r) IN $relTypes"
+	}
+	return query + " RETURN r, m ORDER BY m.id, elementId(r)"
+}
+
+// pathGraph 在读事务中按需读取节点的关系，供在 Go 中运行的路径搜索使用。pathfind 中的节点和边以 elementId 表示，
+// 每个节点的关系在首次展开时查询一次并在本次请求内缓存，总量受 PathSearchBudget 限制。
+// 展开时已按 q 的关系类型、方向和中间节点约束过滤。
+type pathGraph struct {
+	source, target neo4j.Node
+	via            []string // 必须经过的节点的 elementId
+	nodes          map[string]neo4j.Node
+	rels           map[string]neo4j.Relationship
+	expand         pathfind.ExpandFunc
+}
+
+// loadPathGraph 查询 q 的起点、终点和必须经过的节点，任一不存在时返回 nil。cost 返回经过关系的代价
+func loadPathGraph(ctx context.Context, tx neo4j.ManagedTransaction, q PathQuery, cost func(rel neo4j.Relationship) float64) (*pathGraph, error) {
+	endpointQuery := "MATCH (source {id: $sourceId}), (target {id: $targetId}) RETURN source, target, [] AS via LIMIT 1"
+	via := q.RemainingVia(nil)
+	if len(via) > 0 {
+		endpointQuery = "MATCH (source {id: $sourceId}), (target {id: $targetId}) OPTIONAL MATCH (v) WHERE v.id IN $requiredIds" +
+			" RETURN source, target, collect(elementId(v)) AS via LIMIT 1"
+	}
+	result, err := tx.Run(ctx, endpointQuery, map[string]any{"sourceId": q.SourceID, "targetId": q.TargetID, "requiredIds": via})
+	if err != nil {
+		return nil, fmt.Errorf("DAL: 运行 GetPath 端点查询失败: %w", err)
+	}
+	records, err := result.Collect(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("DAL: 获取 GetPath 端点结果失败: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	sourceValue, _ := records[0].Get("source")
+	targetValue, _ := records[0].Get("target")
+	source, sourceOk := sourceValue.(dbtype.Node)
+	target, targetOk := targetValue.(dbtype.Node)
+	if !sourceOk || !targetOk {
+		return nil, fmt.Errorf("DAL: 无法将 GetPath 端点断言为 dbtype.Node")
+	}
+	viaValue, _ := records[0].Get("via")
+	viaRaw, _ := viaValue.([]any)
+	if len(viaRaw) < len(via) {
+		return nil, nil // 必须经过的节点不存在
+	}
+	g := &pathGraph{
+		source: source,
+		target: target,
+		nodes:  map[string]neo4j.Node{source.ElementId: source, target.ElementId: target},
+		rels:   make(map[string]neo4j.Relationship),
+	}
+	for _, v := range viaRaw {
+		if id, ok := v.(string); ok {
+			g.via = append(g.via, id)
+		}
+	}
+
+	expandQuery := pathExpandQuery(len(q.RelTypes) > 0)
+	expand := func(node string) ([]pathfind.Edge, error) {
+		result, err := tx.Run(ctx, expandQuery, map[string]any{"nodeId": node, "relTypes": q.RelTypes})
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 运行 GetPath 邻居查询失败: %w", err)
+		}
+		records, err := result.Collect(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 获取 GetPath 邻居结果失败: %w", err)
+		}
+		edges := make([]pathfind.Edge, 0, len(records))
+		for _, record := range records {
+			relValue, _ := record.Get("r")
+			otherValue, _ := record.Get("m")
+			rel, relOk := relValue.(dbtype.Relationship)
+			other, otherOk := otherValue.(dbtype.Node)
+			if !relOk || !otherOk {
+				return nil, fmt.Errorf("DAL: 无法将 GetPath 邻居结果断言为 dbtype.Relationship / dbtype.Node")
+			}
+			if !q.Traversal.Allows(rel.Type, rel.StartElementId == node) {
+				continue
+			}
+			if other.ElementId != target.ElementId && !q.AllowsIntermediate(nodeBusinessID(other), other.Labels) {
+				continue
+			}
+			g.rels[rel.ElementId] = rel
+			g.nodes[other.ElementId] = other
+			edges = append(edges, pathfind.Edge{ID: rel.ElementId, To: other.ElementId, Cost: cost(rel)})
+		}
+		return edges, nil
+	}
+	g.expand = pathfind.CachedExpand(expand, PathSearchBudget)
+	return g, nil
+}
+
+// toPath 将 pathfind 的路径转换为 Path
+func (g *pathGraph) toPath(p pathfind.Path) Path {
+	path := Path{Nodes: make([]neo4j.Node, len(p.Nodes)), Relationships: make([]neo4j.Relationship, len(p.Edges)), Cost: p.Cost}
+	for i, id := range p.Nodes {
+		path.Nodes[i] = g.nodes[id]
+	}
+	for i, id := range p.Edges {
+		path.Relationships[i] = g.rels[id]
+	}
+	return path
+}
+
+// searchError 将超出搜索预算的错误转换为 ErrPathSearchTooLarge
+func searchError(err error) error {
+	if errors.Is(err, pathfind.ErrBudgetExceeded) {
+		return fmt.Errorf("%w: 展开超过 %d 次或读取超过 %d 个节点", ErrPathSearchTooLarge, PathSearchBudget.MaxExpansions, PathSearchBudget.MaxNodes)
+	}
+	return err
+}
+
+// execCheapestPath 在事务中运行 Dijkstra，求 WEIGHTED 模式的最小代价路径
+func execCheapestPath(ctx context.Context, tx neo4j.ManagedTransaction, q PathQuery, maxDepth int) ([]Path, error) {
+	property := q.WeightProperty
+	if property == "" {
+		property = DefaultWeightProperty
+	}
+	g, err := loadPathGraph(ctx, tx, q, func(rel neo4j.Relationship) float64 { return RelationCost(rel.Props, property, q.DefaultWeight) })
+	if err != nil || g == nil {
+		return nil, err
+	}
+	p, ok, err := pathfind.CheapestVia(g.source.ElementId, g.target.ElementId, maxDepth, g.via, g.expand)
+	if err != nil || !ok {
+		return nil, searchError(err)
+	}
+	return []Path{g.toPath(p)}, nil
+}
+
+// execConstrainedPaths 在 Go 中以单位代价按 q.Mode 搜索路径 (与 Cypher 查询的结果一致)：最短路径用 pathfind.CheapestVia，
+// K_SHORTEST 在其上运行 Yen 算法，ALL_SHORTEST 取 k 最短路径中跳数最少的部分并按节点业务 id 排序
+func execConstrainedPaths(ctx context.Context, tx neo4j.ManagedTransaction, q PathQuery, maxDepth int) ([]Path, error) {
+	g, err := loadPathGraph(ctx, tx, q, func(neo4j.Relationship) float64 { return 1 })
+	if err != nil || g == nil {
+		return nil, err
+	}
+	// shortest 是 Yen 算法使用的最短路径函数，root 上的节点 (excl.Nodes) 和偏离点算作已经过
+	shortest := func(from string, maxHops int, excl pathfind.Exclusion) (pathfind.Path, bool, error) {
+		expand := func(node string) ([]pathfind.Edge, error) {
+			edges, err := g.expand(node)
+			if err != nil {
+				return nil, err
+			}
+			return slices.DeleteFunc(slices.Clone(edges), func(e pathfind.Edge) bool { return excl.Edges[e.ID] || excl.Nodes[e.To] }), nil
+		}
+		remaining := slices.DeleteFunc(slices.Clone(g.via), func(v string) bool { return v == from || excl.Nodes[v] })
+		p, ok, err := pathfind.CheapestVia(from, g.target.ElementId, maxHops, remaining, expand)
+		return p, ok, err
+	}
+
+	var found []pathfind.Path
+	switch q.Mode {
+	case network.PathMode_ALL_SHORTEST:
+		found, err = pathfind.KShortest(g.source.ElementId, MaxAllShortestPaths, maxDepth, shortest)
+		if len(found) > 0 {
+			minHops := found[0].Hops()
+			found = slices.DeleteFunc(found, func(p pathfind.Path) bool { return p.Hops() > minHops })
+		}
+	case network.PathMode_K_SHORTEST:
+		k := q.K
+		if k <= 0 {
+			k = DefaultPathK
+		}
+		found, err = pathfind.KShortest(g.source.ElementId, k, maxDepth, shortest)
+	default:
+		var p pathfind.Path
+		var ok bool
+		if p, ok, err = shortest(g.source.ElementId, maxDepth, pathfind.Exclusion{}); ok {
+			found = []pathfind.Path{p}
+		}
+	}
+	if err != nil {
+		return nil, searchError(err)
+	}
+
+	paths := make([]Path, len(found))
+	for i, p := range found {
+		paths[i] = g.toPath(p)
+	}
+	if q.Mode == network.PathMode_ALL_SHORTEST {
+		// ORDER BY [n IN nodes(path) | n.id]
+		slices.SortStableFunc(paths, func(a, b Path) int {
+			return slices.CompareFunc(a.Nodes, b.Nodes, func(x, y neo4j.Node) int { return strings.Compare(nodeBusinessID(x), nodeBusinessID(y)) })
+		})
+	}
+	return paths, nil
+}
+
+// runPathQuery 运行路径查询并解析每条记录的 nodes 和 relations
+func runPathQuery(ctx context.Context, tx neo4j.ManagedTransaction, query string, params map[string]any) ([]Path, error) {
+	result, err := tx.Run(ctx, query, params)
+	if err != nil {
+		return nil, fmt.Errorf("DAL: 运行 GetPath 查询失败: %w", err)
+	}
+	records, err := result.Collect(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("DAL: 获取 GetPath 结果失败: %w", err)
+	}
+	paths := make([]Path, 0, len(records))
+	for _, record := range records {
+		nodesValue, nodesOk := record.Get("nodes")
+		relsValue, relsOk := record.Get("relations")
+		if !nodesOk || !relsOk {
+			return nil, fmt.Errorf("DAL: GetPath 查询返回结果缺少 'nodes' 或 'relations' 字段")
+		}
+		nodesRaw, ok := nodesValue.([]any)
+		if !ok {
+			return nil, fmt.Errorf("DAL: 无法将 'nodes' 断言为 []any")
+		}
+		relsRaw, ok := relsValue.([]any)
+		if !ok {
+			return nil, fmt.Errorf("DAL: 无法将 'relations' 断言为 []any")
+		}
+		p := Path{Nodes: make([]neo4j.Node, len(nodesRaw)), Relationships: make([]neo4j.Relationship, len(relsRaw))}
+		for i, raw := range nodesRaw {
+			if p.Nodes[i], ok = raw.(dbtype.Node); !ok {
+				return nil, fmt.Errorf("DAL: 无法将 GetPath 'nodes' 列表中的元素断言为 dbtype.Node")
+			}
+		}
+		for i, raw := range relsRaw {
+			if p.Relationships[i], ok = raw.(dbtype.Relationship); !ok {
+				return nil, fmt.Errorf("DAL: 无法将 GetPath 'relations' 列表中的元素断言为 dbtype.Relationship")
+			}
+		}
+		paths = append(paths, p)
+	}
+	return paths, nil
+}
+
+// nodeBusinessID 返回节点的业务 id 属性
+func nodeBusinessID(n neo4j.Node) string {
+	id, _ := n.Props["id"].(string)
+	return id
+}
+
+// nonNil 将 nil 切片转换为空切片 (作为查询参数时是空列表而不是 null)
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// setKeys 返回集合中的键 (非 nil，空集合作为查询参数时是空列表而不是 null)
+func setKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	return keys
+}
@@ -0,0 +1,74 @@
+package neo4jdal
+
+import (
+	"testing"
+
+	network "labelwall/biz/model/relationship/network"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShortestPathQuery(t *testing.T) {
+	assert.Equal(t,
+		"MATCH (source {id: $sourceId}), (target {id: $targetId})"+
+			" MATCH path = shortestPath((source)-[*1..3]-(target))"+
+			" WITH path ORDER BY [n IN nodes(path) | n.id] LIMIT $limit"+
+			" RETURN nodes(path) AS nodes, relationships(path) AS relations",
+		shortestPathQuery("shortestPath", 3, false, PathQuery{}))
+
+	assert.Equal(t,
+		"MATCH (source) WHERE elementId(source) = $fromId MATCH (target {id: $targetId})"+
+			" MATCH path = shortestPath((source)-[*1..2]-(target))"+
+			" WHERE ALL(rel IN relationships(path) WHERE type(rel) IN $relTypes)"+
+			" AND NONE(n IN nodes(path) WHERE elementId(n) IN $excludedNodes)"+
+			" AND NONE(rel IN relationships(path) WHERE elementId(rel) IN $excludedRels)"+
+			" WITH path ORDER BY [n IN nodes(path) | n.id] LIMIT $limit"+
+			" RETURN nodes(path) AS nodes, relationships(path) AS relations",
+		shortestPathQuery("shortestPath", 2, true, PathQuery{RelTypes: []string{"FRIEND"}}), "Yen 算法的偏离路径查询")
+
+	assert.Contains(t, shortestPathQuery("allShortestPaths", 4, false, PathQuery{}), "allShortestPaths((source)-[*1..4]-(target))")
+
+}
+
+func TestPathQuery_Constraints(t *testing.T) {
+	q := PathQuery{ExcludeNodeIDs: []string{"x"}, ViaNodeIDs: []string{"c", "v", "c"}, IntermediateTypes: []string{"PERSON"}}
+	assert.True(t, q.AllowsIntermediate("p", []string{"PERSON"}))
+	assert.False(t, q.AllowsIntermediate("x", []string{"PERSON"}), "排除的节点")
+	assert.False(t, q.AllowsIntermediate("s", []string{"SCHOOL"}), "类型不允许")
+	assert.True(t, q.AllowsIntermediate("c", []string{"COMPANY"}), "必须经过的节点不受类型限制")
+	assert.True(t, PathQuery{}.AllowsIntermediate("s", nil))
+
+	assert.Equal(t, []string{"c", "v"}, q.RemainingVia(nil), "去重")
+	assert.Equal(t, []string{"v"}, q.RemainingVia([]string{"a", "c"}))
+	assert.Equal(t, []string{}, PathQuery{}.RemainingVia(nil))
+}
+
+func TestPathQuery_SearchInGo(t *testing.T) {
+	assert.False(t, PathQuery{}.searchInGo())
+	assert.False(t, PathQuery{RelTypes: []string{"FRIEND"}}.searchInGo(), "只按关系类型过滤时使用 shortestPath")
+	assert.True(t, PathQuery{ExcludeNodeIDs: []string{"x"}}.searchInGo())
+	assert.True(t, PathQuery{ViaNodeIDs: []string{"v"}}.searchInGo())
+	assert.True(t, PathQuery{IntermediateTypes: []string{"PERSON"}}.searchInGo())
+	mixed := Traversal{TypeDirections: map[string]network.Direction{"FOLLOWING": network.Direction_OUTGOING}}
+	assert.True(t, PathQuery{Traversal: mixed}.searchInGo(), "按类型覆盖方向")
+}
+
+func TestSetKeys(t *testing.T) {
+	assert.Equal(t, []string{}, setKeys(nil), "空集合作为参数时是空列表")
+	assert.Equal(t, []string{"a"}, setKeys(map[string]bool{"a": true}))
+}
+
+func TestRelationCost(t *testing.T) {
+	assert.Equal(t, 2.5, RelationCost(map[string]any{"weight": 2.5}, "weight", 1))
+	assert.Equal(t, 3.0, RelationCost(map[string]any{"weight": int64(3)}, "weight", 1))
+	assert.Equal(t, 0.4, RelationCost(map[string]any{"closeness": " 0.4"}, "closeness", 1), "兼容字符串形式的属性值")
+	assert.Equal(t, 1.0, RelationCost(map[string]any{}, "weight", 1), "缺少属性")
+	assert.Equal(t, 1.0, RelationCost(map[string]any{"weight": -2.0}, "weight", 1), "负数")
+	assert.Equal(t, 1.0, RelationCost(map[string]any{"weight": "heavy"}, "weight", 1))
+	assert.Equal(t, 1.0, RelationCost(map[string]any{"weight": true}, "weight", 1))
+}
+
+func TestPathExpandQuery(t *testing.T) {
+	assert.Equal(t, "MATCH (n)-[r]-(m) WHERE elementId(n) = $nodeId RETURN r, m ORDER BY m.id, elementId(r)", pathExpandQuery(false))
+	assert.Contains(t, pathExpandQuery(true), "AND type(r) IN $relTypes")
+}
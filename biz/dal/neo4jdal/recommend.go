@@ -0,0 +1,165 @@
+package neo4jdal
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"labelwall/pkg/recommend"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// DefaultMaxRecommendationCandidates 是参与打分的候选人数量的默认上限
+const DefaultMaxRecommendationCandidates = 500
+
+// DefaultMaxCommonDegree 是共同邻居关系数的默认上限。关系数超过上限的联系人或组织 (如大公司) 不再向外展开第二跳，
+// 避免一次查询读取枢纽节点的全部邻居；按 Adamic-Adar 这类节点本来贡献也很小
+const DefaultMaxCommonDegree = 1000
+
+// RecommendationQuery 是推荐查询的参数
+type RecommendationQuery struct {
+	NodeID          string
+	ConnectionTypes []string // 视为 "认识" 的关系类型，共同联系人两侧的关系都必须是这些类型
+	PersonLabel     string   // 候选人和联系人的标签
+	OrgLabels       []string // 组织 (公司、学校) 的标签，与组织之间任意类型的关系都视为所属关系
+	MaxCandidates   int      // 参与打分的候选人上限 (按共同邻居数取前若干个)，<= 0 时使用 DefaultMaxRecommendationCandidates
+	MaxCommonDegree int      // 共同邻居的关系数上限，超过的节点不参与推荐，<= 0 时使用 DefaultMaxCommonDegree
+}
+
+// Neighborhood 是推荐查询的结果
+type Neighborhood struct {
+	Contacts []string // 目标节点的联系人 (去重，按 id 排序)
+	Orgs     []string // 目标节点所属的组织 (去重，按 id 排序)
+	// 二跳候选人，不包括与目标节点直接相连的节点；按共同邻居数降序、id 升序，Mutuals 和 SharedOrgs 按 id 排序
+	Candidates []recommend.Candidate
+}
+
+const recommendationSourceQuery = `
+	MATCH (u {id: $nodeId})
+	RETURN $personLabel IN labels(u) AS person,
+	       [(u)-[r]-(p) WHERE type(r) IN $connectionTypes AND $personLabel IN labels(p) | p.id] AS contacts,
+	       [(u)--(o) WHERE any(l IN labels(o) WHERE l IN $orgLabels) | o.id] AS orgs
+	LIMIT 1`
+
+// recommendationCandidatesQuery 经过联系人 (两侧都是联系关系) 或组织 (任意关系) 查找二跳候选人，
+// 每个共同邻居附带是否为组织以及它的关系数。关系数超过 $maxCommonDegree 的共同邻居在第二跳之前过滤
+const recommendationCandidatesQuery = `
+	MATCH (u {id: $nodeId})-[r1]-(z)
+	WHERE z.id IS NOT NULL
+	  AND (($personLabel IN labels(z) AND type(r1) IN $connectionTypes) OR any(l IN labels(z) WHERE l IN $orgLabels))
+	WITH DISTINCT u, z, any(l IN labels(z) WHERE l IN $orgLabels) AS org, COUNT { (z)--() } AS degree
+	WHERE degree <= $maxCommonDegree
+	MATCH (z)-[r2]-(c)
+	WHERE c <> u AND $personLabel IN labels(c) AND c.id IS NOT NULL
+	  AND (org OR type(r2) IN $connectionTypes)
+	  AND NOT EXISTS { (u)--(c) }
+	WITH DISTINCT c, z, org, degree
+	WITH c, collect({id: z.id, org: org, degree: degree}) AS commons
+	ORDER BY size(commons) DESC, c.id
+	LIMIT $maxCandidates
+	RETURN c.id AS id, commons,
+	       [(c)-[r]-(p) WHERE type(r) IN $connectionTypes AND $personLabel IN labels(p) | p.id] AS contacts`
+
+// ExecGetRecommendationCandidates 查询推荐所需的邻居数据，节点不存在时返回 ErrNotFound，不是 q.PersonLabel 节点时返回 ErrNotPerson
+func (d *neo4jNodeDAL) ExecGetRecommendationCandidates(ctx context.Context, session neo4j.SessionWithContext, q RecommendationQuery) (Neighborhood, error) {
+	maxCandidates := q.MaxCandidates
+	if maxCandidates <= 0 {
+		maxCandidates = DefaultMaxRecommendationCandidates
+	}
+	maxCommonDegree := q.MaxCommonDegree
+	if maxCommonDegree <= 0 {
+		maxCommonDegree = DefaultMaxCommonDegree
+	}
+	params := map[string]any{
+		"nodeId":          q.NodeID,
+		"connectionTypes": nonNil(q.ConnectionTypes),
+		"personLabel":     q.PersonLabel,
+		"orgLabels":       nonNil(q.OrgLabels),
+		"maxCandidates":   maxCandidates,
+		"maxCommonDegree": maxCommonDegree,
+	}
+
+	readResult, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx, recommendationSourceQuery, params)
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 运行推荐节点查询失败: %w", err)
+		}
+		records, err := result.Collect(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 获取推荐节点结果失败: %w", err)
+		}
+		if len(records) == 0 {
+			return nil, ErrNotFound
+		}
+		if person, _ := records[0].Get("person"); person != true {
+			return nil, ErrNotPerson
+		}
+		contactsValue, _ := records[0].Get("contacts")
+		orgsValue, _ := records[0].Get("orgs")
+		n := Neighborhood{Contacts: uniqueStrings(contactsValue), Orgs: uniqueStrings(orgsValue)}
+
+		result, err = tx.Run(ctx, recommendationCandidatesQuery, params)
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 运行推荐候选人查询失败: %w", err)
+		}
+		records, err = result.Collect(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 获取推荐候选人结果失败: %w", err)
+		}
+		n.Candidates = make([]recommend.Candidate, 0, len(records))
+		for _, record := range records {
+			idValue, _ := record.Get("id")
+			commonsValue, _ := record.Get("commons")
+			contactsValue, _ := record.Get("contacts")
+			id, ok := idValue.(string)
+			commons, commonsOk := commonsValue.([]any)
+			if !ok || !commonsOk {
+				return nil, fmt.Errorf("DAL: 推荐候选人结果缺少 'id' 或 'commons' 字段")
+			}
+			c := recommend.Candidate{ID: id, Contacts: len(uniqueStrings(contactsValue))}
+			for _, raw := range commons {
+				m, ok := raw.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("DAL: 无法将 'commons' 列表中的元素断言为 map[string]any")
+				}
+				commonID, _ := m["id"].(string)
+				degree, _ := m["degree"].(int64)
+				common := recommend.Common{ID: commonID, Degree: int(degree)}
+				if org, _ := m["org"].(bool); org {
+					c.SharedOrgs = append(c.SharedOrgs, common)
+				} else {
+					c.Mutuals = append(c.Mutuals, common)
+				}
+			}
+			SortCommons(&c)
+			n.Candidates = append(n.Candidates, c)
+		}
+		return n, nil
+	})
+	if err != nil {
+		return Neighborhood{}, err
+	}
+	return readResult.(Neighborhood), nil
+}
+
+// SortCommons 将候选人的共同联系人和共同组织按 id 排序
+func SortCommons(c *recommend.Candidate) {
+	byID := func(a, b recommend.Common) int { return cmp.Compare(a.ID, b.ID) }
+	slices.SortFunc(c.Mutuals, byID)
+	slices.SortFunc(c.SharedOrgs, byID)
+}
+
+// uniqueStrings 将查询返回的列表转换为去重、排序后的字符串切片 (非 nil)
+func uniqueStrings(value any) []string {
+	raw, _ := value.([]any)
+	values := make([]string, 0, len(raw))
+	for _, v := range raw {
+		if s, ok := v.(string); ok {
+			values = append(values, s)
+		}
+	}
+	slices.Sort(values)
+	return slices.Compact(values)
+}
@@ -0,0 +1,24 @@
+package neo4jdal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"labelwall/pkg/recommend"
+)
+
+func TestUniqueStrings(t *testing.T) {
+	assert.Equal(t, []string{"a", "b"}, uniqueStrings([]any{"b", nil, "a", "b"}), "null 元素被忽略")
+	assert.Equal(t, []string{}, uniqueStrings(nil))
+}
+
+func TestSortCommons(t *testing.T) {
+	c := recommend.Candidate{
+		Mutuals:    []recommend.Common{{ID: "p2"}, {ID: "p1"}},
+		SharedOrgs: []recommend.Common{{ID: "s1"}, {ID: "c1"}},
+	}
+	SortCommons(&c)
+	assert.Equal(t, []recommend.Common{{ID: "p1"}, {ID: "p2"}}, c.Mutuals)
+	assert.Equal(t, []recommend.Common{{ID: "c1"}, {ID: "s1"}}, c.SharedOrgs)
+}
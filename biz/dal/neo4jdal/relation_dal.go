@@ -26,13 +26,36 @@ func NewRelationDAL() RelationDAL {
 // ExecCreateRelation 执行创建关系的 Cypher 语句。
 // properties map 由 Repo 层构建，包含 id 和所有关系属性。
 func (d *neo4jRelationDAL) ExecCreateRelation(ctx context.Context, session neo4j.SessionWithContext, sourceID, targetID string, relType network.RelationType, properties map[string]any) (neo4j.Relationship, error) {
+	label, err := RelationLabel(relType)
+	if err != nil {
+		return dbtype.Relationship{}, err
+	}
+	pairs := allowedPairsParam(relType)
 	// 执行写事务。
 	relResult, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		// 关系类型限制了两端节点类型时，先检查两端节点的标签，以便区分节点不存在和类型不允许。
+		if pairs != nil {
+			checkResult, err := tx.Run(ctx, `
+				MATCH (source {id: $sourceId}), (target {id: $targetId})
+				RETURN `+allowedPairsCondition+` AS allowed`,
+				map[string]any{"sourceId": sourceID, "targetId": targetID, "pairs": pairs})
+			if err != nil {
+				return nil, fmt.Errorf("DAL: 运行关系类型检查查询失败: %w", err)
+			}
+			checkRecord, err := checkResult.Single(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("DAL: 获取创建关系结果失败 (可能是节点不存在): %w", err)
+			}
+			if allowed, _ := checkRecord.Get("allowed"); allowed != true {
+				return nil, fmt.Errorf("DAL: 关系类型 %s 不允许连接节点 '%s' 和 '%s': %w", label, sourceID, targetID, ErrRelationNotAllowed)
+			}
+		}
+
 		// 构建查询语句，匹配源节点和目标节点，然后创建带有类型和属性的关系。
 		query := fmt.Sprintf(`
             MATCH (source {id: $sourceId}), (target {id: $targetId})
             CREATE (source)-[rel:%s $props]->(target)
-            RETURN rel`, relType.String()) // 使用关系类型的字符串表示
+            RETURN rel`, label) // 使用注册表中的关系类型名
 
 		// 执行查询。
 		result, err := tx.Run(ctx, query, map[string]any{
@@ -79,6 +102,154 @@ func (d *neo4jRelationDAL) ExecCreateRelation(ctx context.Context, session neo4j
 	return createdRel, nil
 }
 
+// ExecBatchCreateRelations 在单个写事务中批量创建关系。
+// 关系按类型分组，每个类型执行一次 UNWIND 创建；返回以输入下标为键的已创建关系，
+// 源或目标节点不存在、或两端节点类型不被该关系类型允许的项不会出现在结果中。
+func (d *neo4jRelationDAL) ExecBatchCreateRelations(ctx context.Context, session neo4j.SessionWithContext, rels []BatchRelationInput) (map[int]neo4j.Relationship, error) {
+	if len(rels) == 0 {
+		return map[int]neo4j.Relationship{}, nil
+	}
+	relBatch, err := groupBatchRelations(rels)
+	if err != nil {
+		return nil, err
+	}
+
+	writeResult, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		return relBatch.run(ctx, tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	createdRels, ok := writeResult.(map[int]dbtype.Relationship)
+	if !ok {
+		return nil, fmt.Errorf("DAL: 事务返回了非预期的关系结果类型")
+	}
+	return createdRels, nil
+}
+
+// ExecBatchMergeRelations 在单个写事务中按 id 批量 MERGE 关系，两端之间已存在同 id 关系时保持不变。
+// 返回以输入下标为键的结果 (true 表示本次新建)，源或目标节点不存在、或两端节点类型不被该关系类型允许的项不会出现在结果中。
+func (d *neo4jRelationDAL) ExecBatchMergeRelations(ctx context.Context, session neo4j.SessionWithContext, rels []BatchRelationInput) (map[int]bool, error) {
+	if len(rels) == 0 {
+		return map[int]bool{}, nil
+	}
+	g, err := groupBatchRelations(rels)
+	if err != nil {
+		return nil, err
+	}
+
+	writeResult, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		merged := make(map[int]bool, len(rels))
+		for _, relType := range g.typeOrder {
+			query := fmt.Sprintf(`
+				UNWIND $items AS item
+				MATCH (source {id: item.sourceId}), (target {id: item.targetId})
+				WHERE %[1]s
+				OPTIONAL MATCH (source)-[existing:%[2]s {id: item.props.id}]->(target)
+				WITH item, source, target, existing IS NULL AS created
+				MERGE (source)-[rel:%[2]s {id: item.props.id}]->(target)
+				ON CREATE SET rel = item.props
+				RETURN item.idx AS idx, created`, allowedPairsCondition, g.labels[relType])
+			result, err := tx.Run(ctx, query, map[string]any{"items": g.items[relType], "pairs": allowedPairsParam(relType)})
+			if err != nil {
+				return nil, fmt.Errorf("DAL: 运行批量合并关系查询失败: %w", err)
+			}
+			records, err := result.Collect(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("DAL: 获取批量合并关系结果失败: %w", err)
+			}
+			for _, record := range records {
+				idxInterface, _ := record.Get("idx")
+				createdInterface, _ := record.Get("created")
+				idx, idxOk := idxInterface.(int64)
+				isNew, createdOk := createdInterface.(bool)
+				if !idxOk || !createdOk {
+					return nil, fmt.Errorf("DAL: 批量合并关系返回了非预期的结果")
+				}
+				merged[int(idx)] = isNew
+			}
+		}
+		return merged, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	merged, ok := writeResult.(map[int]bool)
+	if !ok {
+		return nil, fmt.Errorf("DAL: 事务返回了非预期的合并结果类型")
+	}
+	return merged, nil
+}
+
+// batchRelationGroups 是按关系类型分组的批量创建参数
+type batchRelationGroups struct {
+	size      int
+	typeOrder []network.RelationType
+	labels    map[network.RelationType]string
+	items     map[network.RelationType][]map[string]any
+}
+
+// groupBatchRelations 按关系类型分组 (关系类型不能参数化)，保留原始下标；未注册的类型返回错误
+func groupBatchRelations(rels []BatchRelationInput) (batchRelationGroups, error) {
+	g := batchRelationGroups{
+		size:   len(rels),
+		labels: make(map[network.RelationType]string),
+		items:  make(map[network.RelationType][]map[string]any),
+	}
+	for i, rel := range rels {
+		if _, ok := g.items[rel.RelType]; !ok {
+			label, err := RelationLabel(rel.RelType)
+			if err != nil {
+				return batchRelationGroups{}, err
+			}
+			g.labels[rel.RelType] = label
+			g.typeOrder = append(g.typeOrder, rel.RelType)
+		}
+		g.items[rel.RelType] = append(g.items[rel.RelType], map[string]any{
+			"idx":      int64(i),
+			"sourceId": rel.SourceID,
+			"targetId": rel.TargetID,
+			"props":    rel.Properties,
+		})
+	}
+	return g, nil
+}
+
+// run 在事务中为每个类型执行一次 UNWIND 创建，返回以输入下标为键的已创建关系
+func (g batchRelationGroups) run(ctx context.Context, tx neo4j.ManagedTransaction) (map[int]dbtype.Relationship, error) {
+	created := make(map[int]dbtype.Relationship, g.size)
+	for _, relType := range g.typeOrder {
+		query := fmt.Sprintf(`
+			UNWIND $items AS item
+			MATCH (source {id: item.sourceId}), (target {id: item.targetId})
+			WHERE %s
+			CREATE (source)-[rel:%s]->(target)
+			SET rel = item.props
+			RETURN item.idx AS idx, rel`, allowedPairsCondition, g.labels[relType])
+		result, err := tx.Run(ctx, query, map[string]any{"items": g.items[relType], "pairs": allowedPairsParam(relType)})
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 运行批量创建关系查询失败: %w", err)
+		}
+		records, err := result.Collect(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 获取批量创建关系结果失败: %w", err)
+		}
+		for _, record := range records {
+			idxInterface, _ := record.Get("idx")
+			relInterface, _ := record.Get("rel")
+			idx, idxOk := idxInterface.(int64)
+			dbRel, relOk := relInterface.(dbtype.Relationship)
+			if !idxOk || !relOk {
+				return nil, fmt.Errorf("DAL: 批量创建关系返回了非预期的结果")
+			}
+			created[int(idx)] = dbRel
+		}
+	}
+	return created, nil
+}
+
 // ExecGetRelationByID 执行按 ID 获取关系的 Cypher。
 // 返回关系本身、类型字符串、源节点 ID 和目标节点 ID。
 func (d *neo4jRelationDAL) ExecGetRelationByID(ctx context.Context, session neo4j.SessionWithContext, id string) (dbtype.Relationship, string, string, string, error) {
@@ -142,6 +313,58 @@ func (d *neo4jRelationDAL) ExecGetRelationByID(ctx context.Context, session neo4
 		nil
 }
 
+// ExecGetRelationsByIDs 执行按 ID 批量获取关系的 Cypher。
+// 返回找到的关系及对应的类型、源节点 ID、目标节点 ID (顺序不保证与 ids 一致)，不存在的 ID 直接忽略。
+func (d *neo4jRelationDAL) ExecGetRelationsByIDs(ctx context.Context, session neo4j.SessionWithContext, ids []string) ([]dbtype.Relationship, []string, []string, []string, error) {
+	if len(ids) == 0 {
+		return []dbtype.Relationship{}, []string{}, []string{}, []string{}, nil
+	}
+	readResult, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		query := `MATCH (s)-[r]->(t) WHERE r.id IN $ids RETURN r, type(r) AS type, s.id AS sourceId, t.id AS targetId`
+		result, err := tx.Run(ctx, query, map[string]any{"ids": ids})
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 运行批量获取关系查询失败: %w", err)
+		}
+		records, err := result.Collect(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 获取批量关系结果失败: %w", err)
+		}
+		rels := make([]dbtype.Relationship, 0, len(records))
+		types := make([]string, 0, len(records))
+		sourceIDs := make([]string, 0, len(records))
+		targetIDs := make([]string, 0, len(records))
+		for _, record := range records {
+			relInterface, _ := record.Get("r")
+			typeInterface, _ := record.Get("type")
+			sourceIdInterface, _ := record.Get("sourceId")
+			targetIdInterface, _ := record.Get("targetId")
+			dbRel, ok := relInterface.(dbtype.Relationship)
+			if !ok {
+				return nil, fmt.Errorf("DAL: 结果中的 'r' 不是有效的关系类型")
+			}
+			sourceID, _ := sourceIdInterface.(string)
+			targetID, _ := targetIdInterface.(string)
+			rels = append(rels, dbRel)
+			types = append(types, typeInterface.(string))
+			sourceIDs = append(sourceIDs, sourceID)
+			targetIDs = append(targetIDs, targetID)
+		}
+		return map[string]any{"rels": rels, "types": types, "sourceIds": sourceIDs, "targetIds": targetIDs}, nil
+	})
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	resultMap, ok := readResult.(map[string]any)
+	if !ok {
+		return nil, nil, nil, nil, fmt.Errorf("DAL: 事务返回了非预期的批量关系结果类型")
+	}
+	return resultMap["rels"].([]dbtype.Relationship),
+		resultMap["types"].([]string),
+		resultMap["sourceIds"].([]string),
+		resultMap["targetIds"].([]string),
+		nil
+}
+
 // ExecUpdateRelation 执行更新关系的 Cypher。
 // updates map 由 Repo 层准备。
 func (d *neo4jRelationDAL) ExecUpdateRelation(ctx context.Context, session neo4j.SessionWithContext, id string, updates map[string]any) (dbtype.Relationship, string, string, string, error) {
@@ -202,47 +425,58 @@ func (d *neo4jRelationDAL) ExecUpdateRelation(ctx context.Context, session neo4j
 		nil
 }
 
-// ExecDeleteRelation 执行删除关系的 Cypher。
-// 返回错误信息，如果关系未找到则报错。
-func (d *neo4jRelationDAL) ExecDeleteRelation(ctx context.Context, session neo4j.SessionWithContext, id string) error {
+// ExecDeleteRelation 执行删除关系的 Cypher，返回被删除的关系和它的两端节点。
+// 如果关系不存在，返回 ErrNotFound。
+func (d *neo4jRelationDAL) ExecDeleteRelation(ctx context.Context, session neo4j.SessionWithContext, id string) (DeleteResult, error) {
 	// 执行写事务。
-	resultSummary, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
-		// 匹配并删除指定 ID 的关系。
-		query := `MATCH ()-[r {id: $id}]->() DELETE r`
+	txResult, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		// 匹配并删除指定 ID 的关系，同时返回两端节点的 ID。
+		query := `
+			MATCH (s)-[r {id: $id}]->(t)
+			WITH r, s.id AS sourceId, t.id AS targetId
+			DELETE r
+			RETURN sourceId, targetId`
 		result, err := tx.Run(ctx, query, map[string]any{"id": id})
 		if err != nil {
 			return nil, fmt.Errorf("query execution failed: %w", err)
 		}
-		// 获取并返回结果摘要，用于检查删除计数
-		summary, err := result.Consume(ctx)
+		records, err := result.Collect(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("result consumption failed: %w", err)
 		}
-		return summary, nil
+		// 关系不存在时返回空结果，由事务外判断。
+		deleted := DeleteResult{}
+		if len(records) > 0 {
+			sourceID, _ := records[0].Get("sourceId")
+			targetID, _ := records[0].Get("targetId")
+			s, _ := sourceID.(string)
+			t, _ := targetID.(string)
+			deleted.NodeIDs = []string{s, t}
+			deleted.RelationIDs = []string{id}
+		}
+		return deleted, nil
 	})
 	// 处理事务错误。
 	if err != nil {
-		return fmt.Errorf("DAL: 删除关系事务失败: %w", err)
+		return DeleteResult{}, fmt.Errorf("DAL: 删除关系事务失败: %w", err)
 	}
 
-	// 事务成功后，检查结果摘要。
-	if summary, ok := resultSummary.(neo4j.ResultSummary); ok {
-		// 检查计数器中被删除的关系数量。
-		if summary.Counters().RelationshipsDeleted() == 0 {
-			// 如果没有关系被删除，说明具有该 ID 的关系不存在。
-			return ErrNotFound // 返回导出的 ErrNotFound
-		}
-		// 关系删除成功。
-		return nil
+	deleted, ok := txResult.(DeleteResult)
+	if !ok {
+		// 如果 ExecuteWrite 成功但返回的不是预期的结果类型。
+		return DeleteResult{}, fmt.Errorf("DAL: 删除关系事务返回了非预期的结果类型")
 	}
-
-	// 如果 ExecuteWrite 成功但返回的不是预期的 ResultSummary 类型。
-	return fmt.Errorf("DAL: 删除关系事务返回了非预期的结果类型")
+	if len(deleted.RelationIDs) == 0 {
+		// 没有关系被删除，说明具有该 ID 的关系不存在。
+		return DeleteResult{}, ErrNotFound // 返回导出的 ErrNotFound
+	}
+	return deleted, nil
 }
 
 // ExecGetNodeRelations 执行获取特定节点所有关系的 Cypher。
-// 支持按类型、方向、分页进行过滤。
-func (d *neo4jRelationDAL) ExecGetNodeRelations(ctx context.Context, session neo4j.SessionWithContext, nodeID string, types []string, outgoing, incoming bool, limit, offset int64) ([]dbtype.Relationship, []string, []string, []string, int64, error) {
+// 支持按类型、方向、分页进行过滤。sortKeys 为空时结果按关系 id 排序，否则按 sortKeys 排序并以 r.id 作为最后的排序键；
+// after 非空时使用 keyset 分页 (只返回排在 after 之后的关系) 并忽略 offset，总数不受游标影响。
+func (d *neo4jRelationDAL) ExecGetNodeRelations(ctx context.Context, session neo4j.SessionWithContext, nodeID string, types []string, outgoing, incoming bool, sortKeys []SortKey, limit, offset int64, after *RelationKeyset) ([]dbtype.Relationship, []string, []string, []string, int64, error) {
 	// 初始化返回值。
 	rels := []dbtype.Relationship{}
 	relTypes := []string{}
@@ -250,6 +484,18 @@ func (d *neo4jRelationDAL) ExecGetNodeRelations(ctx context.Context, session neo
 	targetIDs := []string{}
 	var total int64 = 0
 
+	// 排序子句和游标分页条件，默认排序时即按 r.id
+	keysetParams := make(map[string]any)
+	var afterValues []any
+	var afterID string
+	if after != nil {
+		afterValues, afterID = after.Values, after.ID
+	}
+	orderBy, keyset, err := compileOrderBy("r", sortKeys, afterValues, afterID, keysetParams)
+	if err != nil {
+		return nil, nil, nil, nil, 0, err
+	}
+
 	// 执行读事务。
 	readResult, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
 		params := map[string]any{ // 初始化查询参数
@@ -316,11 +562,25 @@ func (d *neo4jRelationDAL) ExecGetNodeRelations(ctx context.Context, session neo
 			// 入向查询时，目标节点是 $nodeId。
 			returnClause = "RETURN r, type(r) as type, neighbor.id as sourceId, $nodeId as targetId"
 		}
-		// 构建完整的数据查询语句，添加排序和分页。
-		dataQuery := matchBuilder.String() + whereBuilder.String() + returnClause + " ORDER BY r.created_at DESC SKIP $offset LIMIT $limit" // 示例：按创建时间降序排序
+		// 游标分页条件只作用于数据查询，不影响总数。
+		dataWhere := whereBuilder.String()
+		dataParams := params
+		if keyset != "" {
+			dataWhere += " AND " + keyset
+			dataParams = make(map[string]any, len(params)+len(keysetParams))
+			for k, v := range params {
+				dataParams[k] = v
+			}
+			for k, v := range keysetParams {
+				dataParams[k] = v
+			}
+			dataParams["offset"] = int64(0)
+		}
+		// 构建完整的数据查询语句，排序以关系 id 结尾以保证分页顺序稳定。
+		dataQuery := matchBuilder.String() + dataWhere + " " + returnClause + orderBy + " SKIP $offset LIMIT $limit"
 
 		// 执行数据查询。
-		dataResult, err := tx.Run(ctx, dataQuery, params)
+		dataResult, err := tx.Run(ctx, dataQuery, dataParams)
 		if err != nil {
 			return nil, fmt.Errorf("DAL: 运行获取节点关系数据查询失败: %w", err)
 		}
@@ -47,6 +47,72 @@ func TestNeo4jRelationDAL_ExecCreateRelation(t *testing.T) {
 	})
 }
 
+// 测试 ExecBatchCreateRelations
+func TestNeo4jRelationDAL_ExecBatchCreateRelations(t *testing.T) {
+	dal := NewRelationDAL()
+	ctx := context.Background()
+	inputs := []BatchRelationInput{
+		{SourceID: "A", TargetID: "B", RelType: network.RelationType_FRIEND, Properties: map[string]any{"id": "r1"}},
+		{SourceID: "A", TargetID: "missing", RelType: network.RelationType_COLLEAGUE, Properties: map[string]any{"id": "r2"}},
+	}
+	// 第二项的目标节点不存在，因此只返回下标 0
+	created := map[int]dbtype.Relationship{
+		0: {Id: 1, StartId: 1, EndId: 2, Type: "FRIEND", Props: inputs[0].Properties},
+	}
+
+	mockSession := new(MockSession)
+	mockSession.On("ExecuteWrite", ctx, mock.AnythingOfType("neo4j.ManagedTransactionWork"), mock.Anything).
+		Return(created, nil).Once()
+
+	gotRels, err := dal.ExecBatchCreateRelations(ctx, mockSession, inputs)
+	assert.NoError(t, err)
+	assert.Equal(t, created, gotRels)
+	_, ok := gotRels[1]
+	assert.False(t, ok)
+	mockSession.AssertExpectations(t)
+
+	t.Run("写事务失败", func(t *testing.T) {
+		mockSession := new(MockSession)
+		expErr := errors.New("exec failed")
+		mockSession.On("ExecuteWrite", ctx, mock.AnythingOfType("neo4j.ManagedTransactionWork"), mock.Anything).
+			Return(nil, expErr).Once()
+
+		gotRels, err := dal.ExecBatchCreateRelations(ctx, mockSession, inputs)
+		assert.Equal(t, expErr, err)
+		assert.Nil(t, gotRels)
+		mockSession.AssertExpectations(t)
+	})
+}
+
+// 测试 ExecBatchMergeRelations
+func TestNeo4jRelationDAL_ExecBatchMergeRelations(t *testing.T) {
+	dal := NewRelationDAL()
+	ctx := context.Background()
+	inputs := []BatchRelationInput{
+		{SourceID: "A", TargetID: "B", RelType: network.RelationType_FRIEND, Properties: map[string]any{"id": "r1"}},
+		{SourceID: "A", TargetID: "C", RelType: network.RelationType_FRIEND, Properties: map[string]any{"id": "r2"}},
+	}
+	// r1 本次新建，r2 在之前的运行中已写入
+	merged := map[int]bool{0: true, 1: false}
+
+	mockSession := new(MockSession)
+	mockSession.On("ExecuteWrite", ctx, mock.AnythingOfType("neo4j.ManagedTransactionWork"), mock.Anything).
+		Return(merged, nil).Once()
+
+	got, err := dal.ExecBatchMergeRelations(ctx, mockSession, inputs)
+	assert.NoError(t, err)
+	assert.Equal(t, merged, got)
+	mockSession.AssertExpectations(t)
+
+	t.Run("空输入不执行事务", func(t *testing.T) {
+		mockSession := new(MockSession)
+		got, err := dal.ExecBatchMergeRelations(ctx, mockSession, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+		mockSession.AssertNotCalled(t, "ExecuteWrite", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
 // 测试 ExecGetRelationByID
 func TestNeo4jRelationDAL_ExecGetRelationByID(t *testing.T) {
 	dal := NewRelationDAL()
@@ -98,6 +164,52 @@ func TestNeo4jRelationDAL_ExecGetRelationByID(t *testing.T) {
 	})
 }
 
+// 测试 ExecGetRelationsByIDs
+func TestNeo4jRelationDAL_ExecGetRelationsByIDs(t *testing.T) {
+	dal := NewRelationDAL()
+	ctx := context.Background()
+	ids := []string{"r1", "r2"}
+	rels := []dbtype.Relationship{{Id: 1, StartId: 1, EndId: 2, Type: "FRIEND"}, {Id: 2, StartId: 2, EndId: 3, Type: "COLLEAGUE"}}
+
+	t.Run("找到关系", func(t *testing.T) {
+		mockSession := new(MockSession)
+		mockSession.On("ExecuteRead", ctx, mock.AnythingOfType("neo4j.ManagedTransactionWork"), mock.Anything).
+			Return(map[string]any{
+				"rels":      rels,
+				"types":     []string{"FRIEND", "COLLEAGUE"},
+				"sourceIds": []string{"A", "B"},
+				"targetIds": []string{"B", "C"},
+			}, nil).Once()
+
+		gotRels, gotTypes, gotSrcs, gotDsts, err := dal.ExecGetRelationsByIDs(ctx, mockSession, ids)
+		require.NoError(t, err)
+		assert.Equal(t, rels, gotRels)
+		assert.Equal(t, []string{"FRIEND", "COLLEAGUE"}, gotTypes)
+		assert.Equal(t, []string{"A", "B"}, gotSrcs)
+		assert.Equal(t, []string{"B", "C"}, gotDsts)
+		mockSession.AssertExpectations(t)
+	})
+
+	t.Run("空 ID 列表不访问数据库", func(t *testing.T) {
+		mockSession := new(MockSession)
+		gotRels, _, _, _, err := dal.ExecGetRelationsByIDs(ctx, mockSession, []string{})
+		require.NoError(t, err)
+		assert.Empty(t, gotRels)
+		mockSession.AssertNotCalled(t, "ExecuteRead", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("读事务失败", func(t *testing.T) {
+		mockSession := new(MockSession)
+		expErr := errors.New("read fail")
+		mockSession.On("ExecuteRead", ctx, mock.Anything, mock.Anything).Return(nil, expErr).Once()
+
+		gotRels, _, _, _, err := dal.ExecGetRelationsByIDs(ctx, mockSession, ids)
+		assert.Equal(t, expErr, err)
+		assert.Nil(t, gotRels)
+		mockSession.AssertExpectations(t)
+	})
+}
+
 // 测试 ExecUpdateRelation
 func TestNeo4jRelationDAL_ExecUpdateRelation(t *testing.T) {
 	dal := NewRelationDAL()
@@ -133,18 +245,38 @@ func TestNeo4jRelationDAL_ExecDeleteRelation(t *testing.T) {
 		expErr := errors.New("delete fail")
 		mockSession.On("ExecuteWrite", ctx, mock.Anything, mock.Anything).Return(nil, expErr).Once()
 
-		err := dal.ExecDeleteRelation(ctx, mockSession, id)
+		_, err := dal.ExecDeleteRelation(ctx, mockSession, id)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), expErr.Error())
 		mockSession.AssertExpectations(t)
 	})
 
+	t.Run("删除成功返回两端节点", func(t *testing.T) {
+		mockSession := new(MockSession)
+		expected := DeleteResult{NodeIDs: []string{"n1", "n2"}, RelationIDs: []string{id}}
+		mockSession.On("ExecuteWrite", ctx, mock.Anything, mock.Anything).Return(expected, nil).Once()
+
+		deleted, err := dal.ExecDeleteRelation(ctx, mockSession, id)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, deleted)
+		mockSession.AssertExpectations(t)
+	})
+
+	t.Run("关系不存在", func(t *testing.T) {
+		mockSession := new(MockSession)
+		mockSession.On("ExecuteWrite", ctx, mock.Anything, mock.Anything).Return(DeleteResult{}, nil).Once()
+
+		_, err := dal.ExecDeleteRelation(ctx, mockSession, id)
+		assert.ErrorIs(t, err, ErrNotFound)
+		mockSession.AssertExpectations(t)
+	})
+
 	t.Run("非预期返回类型", func(t *testing.T) {
 		mockSession := new(MockSession)
-		// stub 返回非 ResultSummary
+		// stub 返回非 DeleteResult
 		mockSession.On("ExecuteWrite", ctx, mock.Anything, mock.Anything).Return("bad", nil).Once()
 
-		err := dal.ExecDeleteRelation(ctx, mockSession, id)
+		_, err := dal.ExecDeleteRelation(ctx, mockSession, id)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "非预期的结果类型")
 		mockSession.AssertExpectations(t)
@@ -172,7 +304,7 @@ func TestNeo4jRelationDAL_ExecGetNodeRelations(t *testing.T) {
 	mockSession.On("ExecuteRead", ctx, mock.Anything, mock.Anything).
 		Return(map[string]any{"rels": rels, "types": typesList, "sourceIds": srcList, "targetIds": dstList, "total": total}, nil).Once()
 
-	gotRels, gotTypes, gotSrc, gotDst, gotTotal, err := dal.ExecGetNodeRelations(ctx, mockSession, nodeID, types, outgoing, incoming, limit, offset)
+	gotRels, gotTypes, gotSrc, gotDst, gotTotal, err := dal.ExecGetNodeRelations(ctx, mockSession, nodeID, types, outgoing, incoming, nil, limit, offset, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, rels, gotRels)
 	assert.Equal(t, typesList, gotTypes)
@@ -0,0 +1,127 @@
+package neo4jdal
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	network "labelwall/biz/model/relationship/network"
+)
+
+// MaxSortKeys 单次查询最多的排序键数
+const MaxSortKeys = 5
+
+// SortKey 是已校验的排序键
+type SortKey struct {
+	Field string
+	Desc  bool
+}
+
+// SortKeys 校验请求中的排序键并转换为 SortKey：字段名格式与过滤字段相同，同一字段只能出现一次，
+// allowed 非 nil 时字段必须被其接受。未设置排序时返回 nil (使用默认排序)。
+func SortKeys(fields []*network.SortField, allowed func(field string) bool) ([]SortKey, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	if len(fields) > MaxSortKeys {
+		return nil, fmt.Errorf("%w: 排序键超过 %d 个", ErrInvalidSort, MaxSortKeys)
+	}
+	keys := make([]SortKey, 0, len(fields))
+	seen := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		if f == nil {
+			return nil, fmt.Errorf("%w: 排序键为空", ErrInvalidSort)
+		}
+		if !filterFieldPattern.MatchString(f.Field) {
+			return nil, fmt.Errorf("%w: 非法的字段名 '%s'", ErrInvalidSort, f.Field)
+		}
+		if allowed != nil && !allowed(f.Field) {
+			return nil, fmt.Errorf("%w: 字段 '%s' 不支持排序", ErrInvalidSort, f.Field)
+		}
+		if seen[f.Field] {
+			return nil, fmt.Errorf("%w: 字段 '%s' 重复", ErrInvalidSort, f.Field)
+		}
+		seen[f.Field] = true
+		key := SortKey{Field: f.Field}
+		if f.Order != nil {
+			switch *f.Order {
+			case network.SortOrder_ASC:
+			case network.SortOrder_DESC:
+				key.Desc = true
+			default:
+				return nil, fmt.Errorf("%w: 字段 '%s' 的排序方向未知", ErrInvalidSort, f.Field)
+			}
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// SortSpec 返回排序键的规范字符串 (如 "created_at:desc,name:asc")，用于缓存键和游标校验；没有排序键时返回空字符串
+func SortSpec(keys []SortKey) string {
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		order := "asc"
+		if k.Desc {
+			order = "desc"
+		}
+		parts[i] = k.Field + ":" + order
+	}
+	return strings.Join(parts, ",")
+}
+
+// compileOrderBy 生成按 keys 排序的 ORDER BY 子句，最后以 id 升序作为次排序键保证分页顺序稳定。
+// 与 Cypher 的默认行为一致，属性缺失 (null) 的记录在升序时排在最后、降序时排在最前。
+//
+// afterID 非空时还返回 keyset 分页条件，即 (k1, k2, ..., id) > (afterValues..., afterID)：只保留排在上一页最后一条记录之后的记录。
+// afterValues 是该记录在各排序键上的取值 (与 keys 一一对应，缺失为 nil)，写入 params 的 $afterSort_<i> 和 $afterId。
+// Cypher 中不同类型的值之间比较的结果为 null，与游标取值类型不同的非 null 值会被跳过，排序字段的类型由属性约束保证一致。
+func compileOrderBy(variable string, keys []SortKey, afterValues []any, afterID string, params map[string]any) (string, string, error) {
+	items := make([]string, 0, len(keys)+1)
+	var alternatives, equal []string // equal 是前 i 个排序键都与游标相等的条件
+	if afterID != "" && len(afterValues) != len(keys) {
+		return "", "", fmt.Errorf("%w: 游标与排序键个数不一致", ErrInvalidSort)
+	}
+	for i, k := range keys {
+		if !filterFieldPattern.MatchString(k.Field) {
+			return "", "", fmt.Errorf("%w: 非法的字段名 '%s'", ErrInvalidSort, k.Field)
+		}
+		field := fmt.Sprintf("%s.`%s`", variable, k.Field)
+		item := field
+		if k.Desc {
+			item += " DESC"
+		}
+		items = append(items, item)
+		if afterID == "" {
+			continue
+		}
+
+		// after 是只按这个排序键排在游标之后的条件，null 的位置与 ORDER BY 一致
+		var after, eq string
+		param := fmt.Sprintf("$afterSort_%d", i)
+		switch value := afterValues[i]; {
+		case value == nil && k.Desc:
+			after, eq = field+" IS NOT NULL", field+" IS NULL"
+		case value == nil:
+			eq = field + " IS NULL" // 升序时 null 排在最后，没有更靠后的值
+		case k.Desc:
+			after, eq = fmt.Sprintf("%s < %s", field, param), fmt.Sprintf("%s = %s", field, param)
+			params[param[1:]] = value
+		default:
+			after, eq = fmt.Sprintf("(%s > %s OR %s IS NULL)", field, param, field), fmt.Sprintf("%s = %s", field, param)
+			params[param[1:]] = value
+		}
+		if after != "" {
+			alternatives = append(alternatives, "("+strings.Join(append(slices.Clone(equal), after), " AND ")+")")
+		}
+		equal = append(equal, eq)
+	}
+	items = append(items, variable+".id")
+	orderBy := " ORDER BY " + strings.Join(items, ", ")
+	if afterID == "" {
+		return orderBy, "", nil
+	}
+	params["afterId"] = afterID
+	alternatives = append(alternatives, "("+strings.Join(append(equal, variable+".id > $afterId"), " AND ")+")")
+	return orderBy, "(" + strings.Join(alternatives, " OR ") + ")", nil
+}
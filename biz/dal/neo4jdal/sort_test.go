@@ -0,0 +1,86 @@
+package neo4jdal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	network "labelwall/biz/model/relationship/network"
+)
+
+func sortField(field string, order network.SortOrder) *network.SortField {
+	return &network.SortField{Field: field, Order: &order}
+}
+
+func TestSortKeys(t *testing.T) {
+	keys, err := SortKeys(nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, keys, "未设置排序时使用默认排序")
+
+	allowed := func(f string) bool { return f != "avatar" }
+	keys, err = SortKeys([]*network.SortField{
+		sortField("created_at", network.SortOrder_DESC),
+		{Field: "name"},
+	}, allowed)
+	require.NoError(t, err)
+	assert.Equal(t, []SortKey{{Field: "created_at", Desc: true}, {Field: "name"}}, keys)
+	assert.Equal(t, "created_at:desc,name:asc", SortSpec(keys))
+	assert.Empty(t, SortSpec(nil))
+
+	invalid := [][]*network.SortField{
+		{{Field: "n.name; DROP"}},
+		{{Field: "avatar"}},
+		{{Field: "name"}, sortField("name", network.SortOrder_DESC)},
+		{sortField("name", network.SortOrder(9))},
+		{nil},
+		{{Field: "a"}, {Field: "b"}, {Field: "c"}, {Field: "d"}, {Field: "e"}, {Field: "f"}},
+	}
+	for _, fields := range invalid {
+		_, err := SortKeys(fields, allowed)
+		assert.ErrorIs(t, err, ErrInvalidSort)
+	}
+}
+
+func TestCompileOrderBy(t *testing.T) {
+	keys := []SortKey{{Field: "created_at", Desc: true}, {Field: "score"}}
+	orderBy, keyset, err := compileOrderBy("n", keys, nil, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, " ORDER BY n.`created_at` DESC, n.`score`, n.id", orderBy)
+	assert.Empty(t, keyset, "没有游标时不生成分页条件")
+
+	orderBy, keyset, err = compileOrderBy("r", nil, nil, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, " ORDER BY r.id", orderBy)
+	assert.Empty(t, keyset)
+
+	_, _, err = compileOrderBy("n", []SortKey{{Field: "x` DESC"}}, nil, "", nil)
+	assert.ErrorIs(t, err, ErrInvalidSort)
+}
+
+func TestCompileOrderBy_Keyset(t *testing.T) {
+	keys := []SortKey{{Field: "created_at", Desc: true}, {Field: "score"}}
+	params := map[string]any{}
+	_, keyset, err := compileOrderBy("n", keys, []any{"2024-01-01", int64(3)}, "n9", params)
+	require.NoError(t, err)
+	assert.Equal(t, "((n.`created_at` < $afterSort_0)"+
+		" OR (n.`created_at` = $afterSort_0 AND (n.`score` > $afterSort_1 OR n.`score` IS NULL))"+
+		" OR (n.`created_at` = $afterSort_0 AND n.`score` = $afterSort_1 AND n.id > $afterId))", keyset)
+	assert.Equal(t, map[string]any{"afterSort_0": "2024-01-01", "afterSort_1": int64(3), "afterId": "n9"}, params)
+
+	// 游标取值为 null: 降序时 null 排在最前，其后是所有非 null 值；升序时 null 排在最后
+	params = map[string]any{}
+	_, keyset, err = compileOrderBy("r", keys, []any{nil, nil}, "r1", params)
+	require.NoError(t, err)
+	assert.Equal(t, "((r.`created_at` IS NOT NULL)"+
+		" OR (r.`created_at` IS NULL AND r.`score` IS NULL AND r.id > $afterId))", keyset)
+	assert.Equal(t, map[string]any{"afterId": "r1"}, params)
+
+	// 默认排序只按 id
+	_, keyset, err = compileOrderBy("r", nil, nil, "r1", map[string]any{})
+	require.NoError(t, err)
+	assert.Equal(t, "((r.id > $afterId))", keyset)
+
+	_, _, err = compileOrderBy("n", keys, []any{"x"}, "n1", map[string]any{})
+	assert.ErrorIs(t, err, ErrInvalidSort, "游标与排序键个数不一致")
+}
@@ -0,0 +1,80 @@
+package neo4jdal
+
+import (
+	"fmt"
+
+	network "labelwall/biz/model/relationship/network"
+)
+
+// Traversal 描述遍历时允许的关系方向。方向相对于遍历的前进方向:
+// GetNetwork 中从起始节点向外，GetPath 中从起点走向终点。零值表示不区分方向。
+type Traversal struct {
+	Direction      network.Direction            // 0 等同于 BOTH
+	TypeDirections map[string]network.Direction // 按关系类型名覆盖 Direction
+}
+
+// DirectionOf 返回 relType 类型关系的遍历方向
+func (t Traversal) DirectionOf(relType string) network.Direction {
+	if d, ok := t.TypeDirections[relType]; ok && d != 0 {
+		return d
+	}
+	if t.Direction == 0 {
+		return network.Direction_BOTH
+	}
+	return t.Direction
+}
+
+// Allows 判断能否从当前节点经过 relType 类型的关系前进，outgoing 表示关系从当前节点出发
+func (t Traversal) Allows(relType string, outgoing bool) bool {
+	switch t.DirectionOf(relType) {
+	case network.Direction_OUTGOING:
+		return outgoing
+	case network.Direction_INCOMING:
+		return !outgoing
+	}
+	return true
+}
+
+// uniform 在所有关系类型的方向都相同时返回该方向
+func (t Traversal) uniform() (network.Direction, bool) {
+	base := t.DirectionOf("")
+	for _, d := range t.TypeDirections {
+		if d != 0 && d != base {
+			return 0, false
+		}
+	}
+	return base, true
+}
+
+// pattern 返回 from 到 to 的变长关系模式 (1 到 hops 跳) 和逐跳检查方向的条件 (没有时为空字符串)。
+// 所有类型方向相同时方向直接写在模式的箭头上；否则使用无向模式，由条件根据 $direction 和 $relDirections
+// (见 addParams) 检查 pathVar 的每一跳。
+func (t Traversal) pattern(from, to string, hops int, pathVar string) (string, string) {
+	d, ok := t.uniform()
+	if !ok {
+		cond := fmt.Sprintf("ALL(i IN range(0, length(%[1]s) - 1) WHERE CASE coalesce($relDirections[type(relationships(%[1]s)[i])], $direction)"+
+			" WHEN 'OUTGOING' THEN startNode(relationships(%[1]s)[i]) = nodes(%[1]s)[i]"+
+			" WHEN 'INCOMING' THEN endNode(relationships(%[1]s)[i]) = nodes(%[1]s)[i]"+
+			" ELSE true END)", pathVar)
+		return fmt.Sprintf("(%s)-[*1..%d]-(%s)", from, hops, to), cond
+	}
+	switch d {
+	case network.Direction_OUTGOING:
+		return fmt.Sprintf("(%s)-[*1..%d]->(%s)", from, hops, to), ""
+	case network.Direction_INCOMING:
+		return fmt.Sprintf("(%s)<-[*1..%d]-(%s)", from, hops, to), ""
+	}
+	return fmt.Sprintf("(%s)-[*1..%d]-(%s)", from, hops, to), ""
+}
+
+// addParams 写入 pattern 的方向条件使用的参数
+func (t Traversal) addParams(params map[string]any) {
+	params["direction"] = t.DirectionOf("").String()
+	relDirections := make(map[string]any, len(t.TypeDirections))
+	for typ, d := range t.TypeDirections {
+		if d != 0 {
+			relDirections[typ] = d.String()
+		}
+	}
+	params["relDirections"] = relDirections
+}
@@ -0,0 +1,45 @@
+package neo4jdal
+
+import (
+	"testing"
+
+	network "labelwall/biz/model/relationship/network"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraversal_Allows(t *testing.T) {
+	assert.True(t, Traversal{}.Allows("FRIEND", false), "零值不区分方向")
+
+	out := Traversal{Direction: network.Direction_OUTGOING, TypeDirections: map[string]network.Direction{"FOLLOWING": network.Direction_INCOMING}}
+	assert.True(t, out.Allows("FRIEND", true))
+	assert.False(t, out.Allows("FRIEND", false))
+	assert.True(t, out.Allows("FOLLOWING", false), "按类型覆盖")
+	assert.False(t, out.Allows("FOLLOWING", true))
+}
+
+func TestTraversal_Pattern(t *testing.T) {
+	pattern, cond := Traversal{}.pattern("a", "b", 3, "path")
+	assert.Equal(t, "(a)-[*1..3]-(b)", pattern)
+	assert.Empty(t, cond)
+
+	pattern, cond = Traversal{Direction: network.Direction_OUTGOING}.pattern("a", "b", 2, "path")
+	assert.Equal(t, "(a)-[*1..2]->(b)", pattern)
+	assert.Empty(t, cond)
+
+	// 覆盖的方向与默认方向相同时仍然可以直接使用箭头
+	pattern, cond = Traversal{Direction: network.Direction_INCOMING, TypeDirections: map[string]network.Direction{"VISITED": network.Direction_INCOMING}}.pattern("a", "b", 2, "path")
+	assert.Equal(t, "(a)<-[*1..2]-(b)", pattern)
+	assert.Empty(t, cond)
+
+	mixed := Traversal{TypeDirections: map[string]network.Direction{"FOLLOWING": network.Direction_OUTGOING}}
+	pattern, cond = mixed.pattern("a", "b", 2, "p")
+	assert.Equal(t, "(a)-[*1..2]-(b)", pattern)
+	assert.Contains(t, cond, "coalesce($relDirections[type(relationships(p)[i])], $direction)")
+	assert.Contains(t, cond, "WHEN 'OUTGOING' THEN startNode(relationships(p)[i]) = nodes(p)[i]")
+
+	params := map[string]any{}
+	mixed.addParams(params)
+	assert.Equal(t, "BOTH", params["direction"])
+	assert.Equal(t, map[string]any{"FOLLOWING": "OUTGOING"}, params["relDirections"])
+}
@@ -0,0 +1,386 @@
+package neo4jdal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/typeregistry"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// 类型定义节点的标签。定义节点以 name 而不是 id 标识，不会被 MATCH (n {id: $id}) 之类的节点查询匹配到。
+const (
+	NodeTypeDefLabel     = "NodeTypeDef"
+	RelationTypeDefLabel = "RelationTypeDef"
+	// TypeCodeCounterLabel 是类型编码计数器节点的标签，每种类型 (kind 为 node / relation) 一个，
+	// 分配编码时先锁定计数器，使多个实例并发创建类型时分配到不同的编码
+	TypeCodeCounterLabel = "TypeCodeCounter"
+)
+
+// ErrRelationNotAllowed 表示关系类型不允许连接这两种节点类型
+var ErrRelationNotAllowed = errors.New("neo4jdal: relation type not allowed between these node types")
+
+// NodeLabel 通过类型注册表取得节点类型的标签，未注册的类型不能拼接进 Cypher
+func NodeLabel(nodeType network.NodeType) (string, error) {
+	label, ok := typeregistry.Default().NodeLabel(nodeType)
+	if !ok {
+		return "", fmt.Errorf("DAL: 未注册的节点类型 %d", nodeType)
+	}
+	return label, nil
+}
+
+// RelationLabel 通过类型注册表取得关系类型名，未注册的类型不能拼接进 Cypher
+func RelationLabel(relType network.RelationType) (string, error) {
+	label, ok := typeregistry.Default().RelationLabel(relType)
+	if !ok {
+		return "", fmt.Errorf("DAL: 未注册的关系类型 %d", relType)
+	}
+	return label, nil
+}
+
+// allowedPairsParam 将关系类型允许的节点类型组合转换为查询参数 ([[source, target], ...])，不限制时返回 nil
+func allowedPairsParam(relType network.RelationType) []any {
+	pairs := typeregistry.Default().AllowedPairs(relType)
+	if len(pairs) == 0 {
+		return nil
+	}
+	param := make([]any, len(pairs))
+	for i, p := range pairs {
+		param[i] = []any{p.Source, p.Target}
+	}
+	return param
+}
+
+// allowedPairsCondition 用于 WHERE 子句，$pairs 为 null 时不限制
+const allowedPairsCondition = `($pairs IS NULL OR any(p IN $pairs WHERE p[0] IN labels(source) AND p[1] IN labels(target)))`
+
+// NodeLabelSchemaQueries 返回某个节点标签需要的约束和索引 (id 唯一、name 索引)，语句均可重复执行。
+// 内置类型沿用原有的约束/索引名称。
+func NodeLabelSchemaQueries(label string) []string {
+	prefix := strings.ToLower(label)
+	return []string{
+		fmt.Sprintf("CREATE CONSTRAINT %s_id_unique IF NOT EXISTS FOR (n:%s) REQUIRE n.id IS UNIQUE", prefix, label),
+		fmt.Sprintf("CREATE INDEX %s_name_index IF NOT EXISTS FOR (n:%s) ON (n.name)", prefix, label),
+	}
+}
+
+// RelationTypeSchemaQueries 返回某个关系类型需要的索引 (id 索引)，语句可重复执行。
+// 关系属性索引只服务于带类型的关系模式，因此每个关系类型各建一个。
+func RelationTypeSchemaQueries(relType string) []string {
+	return []string{
+		fmt.Sprintf("CREATE INDEX %s_relation_id_index IF NOT EXISTS FOR ()-[r:%s]-() ON (r.id)", strings.ToLower(relType), relType),
+	}
+}
+
+// TypeDAL 定义了类型定义的持久化操作
+type TypeDAL interface {
+	// ExecListTypeDefs 返回已持久化的节点类型和关系类型定义
+	ExecListTypeDefs(ctx context.Context, session neo4j.SessionWithContext) ([]typeregistry.NodeTypeDef, []typeregistry.RelationTypeDef, error)
+	// ExecSaveNodeTypeDef 按 name 写入节点类型定义
+	ExecSaveNodeTypeDef(ctx context.Context, session neo4j.SessionWithContext, def typeregistry.NodeTypeDef) error
+	// ExecCreateNodeTypeDef 创建新的节点类型定义并在同一事务中分配编码 (不小于 def.Code)，返回分配的编码。
+	// 同名定义已存在时返回 ErrTypeDefExists。
+	ExecCreateNodeTypeDef(ctx context.Context, session neo4j.SessionWithContext, def typeregistry.NodeTypeDef) (network.NodeType, error)
+	// ExecCreateRelationTypeDef 创建新的关系类型定义并在同一事务中分配编码，语义同 ExecCreateNodeTypeDef
+	ExecCreateRelationTypeDef(ctx context.Context, session neo4j.SessionWithContext, def typeregistry.RelationTypeDef) (network.RelationType, error)
+	// ExecSaveRelationTypeDef 按 name 写入关系类型定义 (覆盖允许的节点类型组合)
+	ExecSaveRelationTypeDef(ctx context.Context, session neo4j.SessionWithContext, def typeregistry.RelationTypeDef) error
+	// ExecApplyNodeLabelSchema 为节点标签创建约束和索引 (schema 语句不能与数据写入放在同一事务)
+	ExecApplyNodeLabelSchema(ctx context.Context, session neo4j.SessionWithContext, label string) error
+	// ExecApplyRelationTypeSchema 为关系类型创建索引，语义同 ExecApplyNodeLabelSchema
+	ExecApplyRelationTypeSchema(ctx context.Context, session neo4j.SessionWithContext, relType string) error
+	// ExecEnsureNodeFulltextIndex 使节点全文索引覆盖 defs 中的所有节点类型及其文本属性，定义变化时重建索引
+	ExecEnsureNodeFulltextIndex(ctx context.Context, session neo4j.SessionWithContext, defs []typeregistry.NodeTypeDef) error
+}
+
+// neo4jTypeDAL 实现了 TypeDAL 接口
+type neo4jTypeDAL struct{}
+
+// NewTypeDAL 创建一个新的 TypeDAL 实例
+func NewTypeDAL() TypeDAL {
+	return &neo4jTypeDAL{}
+}
+
+func (d *neo4jTypeDAL) ExecListTypeDefs(ctx context.Context, session neo4j.SessionWithContext) ([]typeregistry.NodeTypeDef, []typeregistry.RelationTypeDef, error) {
+	type defs struct {
+		nodes     []typeregistry.NodeTypeDef
+		relations []typeregistry.RelationTypeDef
+	}
+	result, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		var out defs
+		nodeResult, err := tx.Run(ctx, `
+			MATCH (t:`+NodeTypeDefLabel+`)
+			RETURN t.name AS name, t.code AS code, t.property_schema AS schema
+			ORDER BY t.code`, nil)
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 查询节点类型定义失败: %w", err)
+		}
+		nodeRecords, err := nodeResult.Collect(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 读取节点类型定义失败: %w", err)
+		}
+		for _, record := range nodeRecords {
+			name, _ := record.Get("name")
+			code, _ := record.Get("code")
+			nameStr, nameOk := name.(string)
+			codeInt, codeOk := code.(int64)
+			if !nameOk || !codeOk {
+				return nil, fmt.Errorf("DAL: 节点类型定义格式无效: %v", record.Values)
+			}
+			schema, _ := record.Get("schema")
+			props, err := decodePropertySchema(schema)
+			if err != nil {
+				return nil, fmt.Errorf("DAL: 节点类型 %s 的属性约束无效: %w", nameStr, err)
+			}
+			out.nodes = append(out.nodes, typeregistry.NodeTypeDef{Name: nameStr, Code: network.NodeType(codeInt), Properties: props})
+		}
+
+		relResult, err := tx.Run(ctx, `
+			MATCH (t:`+RelationTypeDefLabel+`)
+			RETURN t.name AS name, t.code AS code, t.allowed_sources AS sources, t.allowed_targets AS targets,
+			       t.property_schema AS schema
+			ORDER BY t.code`, nil)
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 查询关系类型定义失败: %w", err)
+		}
+		relRecords, err := relResult.Collect(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 读取关系类型定义失败: %w", err)
+		}
+		for _, record := range relRecords {
+			name, _ := record.Get("name")
+			code, _ := record.Get("code")
+			nameStr, nameOk := name.(string)
+			codeInt, codeOk := code.(int64)
+			if !nameOk || !codeOk {
+				return nil, fmt.Errorf("DAL: 关系类型定义格式无效: %v", record.Values)
+			}
+			sources, _ := record.Get("sources")
+			targets, _ := record.Get("targets")
+			pairs, err := toTypePairs(sources, targets)
+			if err != nil {
+				return nil, fmt.Errorf("DAL: 关系类型 %s 的定义无效: %w", nameStr, err)
+			}
+			schema, _ := record.Get("schema")
+			props, err := decodePropertySchema(schema)
+			if err != nil {
+				return nil, fmt.Errorf("DAL: 关系类型 %s 的属性约束无效: %w", nameStr, err)
+			}
+			out.relations = append(out.relations, typeregistry.RelationTypeDef{
+				Name: nameStr, Code: network.RelationType(codeInt), AllowedPairs: pairs, Properties: props,
+			})
+		}
+		return out, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	out := result.(defs)
+	return out.nodes, out.relations, nil
+}
+
+// toTypePairs 将并行存储的 allowed_sources / allowed_targets 列表还原为组合
+func toTypePairs(sources, targets any) ([]typeregistry.TypePair, error) {
+	if sources == nil && targets == nil {
+		return nil, nil
+	}
+	srcList, srcOk := sources.([]any)
+	tgtList, tgtOk := targets.([]any)
+	if !srcOk || !tgtOk || len(srcList) != len(tgtList) {
+		return nil, fmt.Errorf("allowed_sources 和 allowed_targets 必须是等长列表")
+	}
+	pairs := make([]typeregistry.TypePair, 0, len(srcList))
+	for i := range srcList {
+		src, ok1 := srcList[i].(string)
+		tgt, ok2 := tgtList[i].(string)
+		if !ok1 || !ok2 {
+			return nil, fmt.Errorf("组合 %d 不是字符串", i)
+		}
+		pairs = append(pairs, typeregistry.TypePair{Source: src, Target: tgt})
+	}
+	return pairs, nil
+}
+
+// decodePropertySchema 解析以 JSON 字符串保存的属性约束 (Neo4j 属性不能保存嵌套结构)，null 表示不限制
+func decodePropertySchema(v any) ([]typeregistry.PropertySchema, error) {
+	if v == nil {
+		return nil, nil
+	}
+	str, ok := v.(string)
+	if !ok {
+		return nil, fmt.Errorf("property_schema 不是字符串")
+	}
+	var schema []typeregistry.PropertySchema
+	if err := json.Unmarshal([]byte(str), &schema); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// encodePropertySchema 将属性约束编码为 JSON 字符串，空约束返回 nil (删除该属性)
+func encodePropertySchema(schema []typeregistry.PropertySchema) (any, error) {
+	if len(schema) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("DAL: 编码属性约束失败: %w", err)
+	}
+	return string(data), nil
+}
+
+func (d *neo4jTypeDAL) ExecSaveNodeTypeDef(ctx context.Context, session neo4j.SessionWithContext, def typeregistry.NodeTypeDef) error {
+	schema, err := encodePropertySchema(def.Properties)
+	if err != nil {
+		return err
+	}
+	_, err = session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		_, err := tx.Run(ctx, `
+			MERGE (t:`+NodeTypeDefLabel+` {name: $name})
+			ON CREATE SET t.created_at = datetime()
+			SET t.code = $code, t.builtin = $builtin, t.property_schema = $schema`,
+			map[string]any{"name": def.Name, "code": int64(def.Code), "builtin": def.Builtin, "schema": schema})
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 保存节点类型定义失败: %w", err)
+		}
+		return nil, nil
+	})
+	return err
+}
+
+func (d *neo4jTypeDAL) ExecSaveRelationTypeDef(ctx context.Context, session neo4j.SessionWithContext, def typeregistry.RelationTypeDef) error {
+	sources := make([]string, len(def.AllowedPairs))
+	targets := make([]string, len(def.AllowedPairs))
+	for i, p := range def.AllowedPairs {
+		sources[i], targets[i] = p.Source, p.Target
+	}
+	schema, err := encodePropertySchema(def.Properties)
+	if err != nil {
+		return err
+	}
+	_, err = session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		_, err := tx.Run(ctx, `
+			MERGE (t:`+RelationTypeDefLabel+` {name: $name})
+			ON CREATE SET t.created_at = datetime()
+			SET t.code = $code, t.builtin = $builtin, t.allowed_sources = $sources, t.allowed_targets = $targets,
+			    t.property_schema = $schema`,
+			map[string]any{"name": def.Name, "code": int64(def.Code), "builtin": def.Builtin, "sources": sources, "targets": targets, "schema": schema})
+		if err != nil {
+			return nil, fmt.Errorf("DAL: 保存关系类型定义失败: %w", err)
+		}
+		return nil, nil
+	})
+	return err
+}
+
+// allocateTypeCodeClause 锁定 kind 对应的计数器并把 c.last 推进到下一个编码。
+// 编码不小于 $minCode，也大于已有定义的最大编码 (计数器创建之前写入的定义)。
+// 先 SET c._lock 取得计数器的写锁再读取，并发的分配在此串行化。
+func allocateTypeCodeClause(defLabel string) string {
+	return `
+		MERGE (c:` + TypeCodeCounterLabel + ` {kind: $kind})
+		SET c._lock = true
+		WITH c
+		OPTIONAL MATCH (d:` + defLabel + `)
+		WITH c, coalesce(max(d.code), 0) AS maxCode
+		WITH c, CASE WHEN coalesce(c.last, 0) > maxCode THEN coalesce(c.last, 0) ELSE maxCode END AS last
+		SET c.last = CASE WHEN last + 1 > $minCode THEN last + 1 ELSE $minCode END
+		REMOVE c._lock
+		WITH c`
+}
+
+// createTypeDef 执行创建类型定义的查询并返回分配的编码，违反 name 唯一约束时返回 ErrTypeDefExists
+func createTypeDef(ctx context.Context, session neo4j.SessionWithContext, query string, params map[string]any) (int64, error) {
+	result, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		res, err := tx.Run(ctx, query, params)
+		if err != nil {
+			return nil, err
+		}
+		record, err := res.Single(ctx)
+		if err != nil {
+			return nil, err
+		}
+		code, _ := record.Get("code")
+		return code, nil
+	})
+	if err != nil {
+		var neoErr *neo4j.Neo4jError
+		if errors.As(err, &neoErr) && neoErr.Code == "Neo.ClientError.Schema.ConstraintValidationFailed" {
+			return 0, fmt.Errorf("%w: %s", ErrTypeDefExists, params["name"])
+		}
+		return 0, fmt.Errorf("DAL: 创建类型定义失败: %w", err)
+	}
+	code, ok := result.(int64)
+	if !ok {
+		return 0, fmt.Errorf("DAL: 类型编码格式无效: %v", result)
+	}
+	return code, nil
+}
+
+func (d *neo4jTypeDAL) ExecCreateNodeTypeDef(ctx context.Context, session neo4j.SessionWithContext, def typeregistry.NodeTypeDef) (network.NodeType, error) {
+	schema, err := encodePropertySchema(def.Properties)
+	if err != nil {
+		return 0, err
+	}
+	query := allocateTypeCodeClause(NodeTypeDefLabel) + `
+		CREATE (t:` + NodeTypeDefLabel + ` {name: $name})
+		SET t.code = c.last, t.created_at = datetime(), t.builtin = $builtin, t.property_schema = $schema
+		RETURN t.code AS code`
+	code, err := createTypeDef(ctx, session, query, map[string]any{
+		"kind": "node", "minCode": int64(def.Code), "name": def.Name, "builtin": def.Builtin, "schema": schema,
+	})
+	return network.NodeType(code), err
+}
+
+func (d *neo4jTypeDAL) ExecCreateRelationTypeDef(ctx context.Context, session neo4j.SessionWithContext, def typeregistry.RelationTypeDef) (network.RelationType, error) {
+	sources := make([]string, len(def.AllowedPairs))
+	targets := make([]string, len(def.AllowedPairs))
+	for i, p := range def.AllowedPairs {
+		sources[i], targets[i] = p.Source, p.Target
+	}
+	schema, err := encodePropertySchema(def.Properties)
+	if err != nil {
+		return 0, err
+	}
+	query := allocateTypeCodeClause(RelationTypeDefLabel) + `
+		CREATE (t:` + RelationTypeDefLabel + ` {name: $name})
+		SET t.code = c.last, t.created_at = datetime(), t.builtin = $builtin, t.allowed_sources = $sources,
+		    t.allowed_targets = $targets, t.property_schema = $schema
+		RETURN t.code AS code`
+	code, err := createTypeDef(ctx, session, query, map[string]any{
+		"kind": "relation", "minCode": int64(def.Code), "name": def.Name, "builtin": def.Builtin,
+		"sources": sources, "targets": targets, "schema": schema,
+	})
+	return network.RelationType(code), err
+}
+
+func (d *neo4jTypeDAL) ExecApplyNodeLabelSchema(ctx context.Context, session neo4j.SessionWithContext, label string) error {
+	for _, query := range NodeLabelSchemaQueries(label) {
+		result, err := session.Run(ctx, query, nil)
+		if err == nil {
+			_, err = result.Consume(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("DAL: 创建节点标签 %s 的 schema 失败 '%s': %w", label, query, err)
+		}
+	}
+	return nil
+}
+
+func (d *neo4jTypeDAL) ExecApplyRelationTypeSchema(ctx context.Context, session neo4j.SessionWithContext, relType string) error {
+	for _, query := range RelationTypeSchemaQueries(relType) {
+		result, err := session.Run(ctx, query, nil)
+		if err == nil {
+			_, err = result.Consume(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("DAL: 创建关系类型 %s 的 schema 失败 '%s': %w", relType, query, err)
+		}
+	}
+	return nil
+}
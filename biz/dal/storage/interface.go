@@ -0,0 +1,79 @@
+package storage
+
+import (
+	"context"
+
+	"labelwall/biz/dal/neo4jdal"
+	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/typeregistry"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+// 存储后端名称，对应配置项 database.backend
+const (
+	BackendNeo4j  = "neo4j"  // Neo4j (默认)
+	BackendMemory = "memory" // 进程内内存图，用于本地运行和测试
+)
+
+// NodeStore 定义了与会话无关的节点存储操作，语义与 neo4jdal.NodeDAL 一致。
+// 写操作的 events 与写入在同一事务中提交到发件箱；不支持发件箱的后端忽略 events。
+type NodeStore interface {
+	CreateNode(ctx context.Context, nodeType network.NodeType, properties map[string]any, events ...neo4jdal.ChangeEvent) (dbtype.Node, error)
+	// GetNodeByID 未找到时返回零值节点、nil 标签和 nil 错误
+	GetNodeByID(ctx context.Context, id string) (dbtype.Node, []string /*labels*/, error)
+	GetNodesByIDs(ctx context.Context, ids []string) ([]dbtype.Node, [][]string /*labels*/, error)
+	UpdateNode(ctx context.Context, id string, updates map[string]any, events ...neo4jdal.ChangeEvent) (dbtype.Node, []string /*labels*/, error)
+	DeleteNode(ctx context.Context, id string, events ...neo4jdal.ChangeEvent) (neo4jdal.DeleteResult, error)
+	BatchCreateNodes(ctx context.Context, nodes []neo4jdal.BatchNodeInput, events ...neo4jdal.ChangeEvent) ([]dbtype.Node, error)
+	// BatchCreateGraph 在一个写事务中创建节点和关系，关系可以引用本批次节点的 id；两端不存在或类型组合不被允许的关系被跳过
+	BatchCreateGraph(ctx context.Context, nodes []neo4jdal.BatchNodeInput, rels []neo4jdal.BatchRelationInput, events ...neo4jdal.ChangeEvent) ([]dbtype.Node, map[int]dbtype.Relationship /*按输入下标*/, error)
+	SearchNodes(ctx context.Context, criteria map[string]string, filter *network.FilterExpr, sortKeys []neo4jdal.SortKey, facets *neo4jdal.FacetRequest, nodeType *network.NodeType, limit, offset int64, after *neo4jdal.NodeKeyset) ([]dbtype.Node, [][]string /*labels*/, int64 /*total*/, []neo4jdal.Facet, error)
+	GetNetwork(ctx context.Context,
+		startNodeCriteria map[string]string,
+		depth int32,
+		limit, offset int64,
+		maxRelations int64,
+		relationTypes []network.RelationType,
+		nodeTypes []network.NodeType,
+		traversal neo4jdal.Traversal,
+	) ([]dbtype.Node, []dbtype.Relationship, bool /*truncated*/, error)
+	// GetPaths 按 q.Mode 查询两个节点之间的路径，未找到路径时返回空列表
+	GetPaths(ctx context.Context, q neo4jdal.PathQuery) ([]neo4jdal.Path, error)
+	// FulltextSearchNodes 全文搜索节点 (按 pkg/fulltext 的规则切词)，结果按得分降序、id 升序排列
+	FulltextSearchNodes(ctx context.Context, text string, fuzzy bool, nodeType *network.NodeType, limit, offset int64) ([]neo4jdal.FulltextNodeHit, int64 /*total*/, error)
+	// GetRecommendationCandidates 查询为节点推荐 "可能认识的人" 所需的联系人、组织和二跳候选人，节点不存在时返回 neo4jdal.ErrNotFound
+	GetRecommendationCandidates(ctx context.Context, q neo4jdal.RecommendationQuery) (neo4jdal.Neighborhood, error)
+}
+
+// RelationStore 定义了与会话无关的关系存储操作，语义与 neo4jdal.RelationDAL 一致。
+type RelationStore interface {
+	CreateRelation(ctx context.Context, sourceID, targetID string, relType network.RelationType, properties map[string]any, events ...neo4jdal.ChangeEvent) (dbtype.Relationship, error)
+	GetRelationByID(ctx context.Context, id string) (dbtype.Relationship, string /*type*/, string /*sourceId*/, string /*targetId*/, error)
+	GetRelationsByIDs(ctx context.Context, ids []string) ([]dbtype.Relationship, []string /*types*/, []string /*sourceIds*/, []string /*targetIds*/, error)
+	UpdateRelation(ctx context.Context, id string, updates map[string]any, events ...neo4jdal.ChangeEvent) (dbtype.Relationship, string /*type*/, string /*sourceId*/, string /*targetId*/, error)
+	DeleteRelation(ctx context.Context, id string, events ...neo4jdal.ChangeEvent) (neo4jdal.DeleteResult, error)
+	BatchCreateRelations(ctx context.Context, rels []neo4jdal.BatchRelationInput, events ...neo4jdal.ChangeEvent) (map[int]dbtype.Relationship /*按输入下标*/, error)
+	GetNodeRelations(ctx context.Context, nodeID string, types []string, outgoing, incoming bool, sortKeys []neo4jdal.SortKey, limit, offset int64, after *neo4jdal.RelationKeyset) ([]dbtype.Relationship, []string /*types*/, []string /*sourceIds*/, []string /*targetIds*/, int64 /*total*/, error)
+}
+
+// TypeStore 定义了类型注册表中节点类型和关系类型定义的持久化操作
+type TypeStore interface {
+	ListTypeDefs(ctx context.Context) ([]typeregistry.NodeTypeDef, []typeregistry.RelationTypeDef, error)
+	// SaveNodeTypeDef 按名称写入节点类型定义，并为新标签创建所需的约束和索引 (包括使全文索引覆盖该类型)
+	SaveNodeTypeDef(ctx context.Context, def typeregistry.NodeTypeDef) error
+	// SaveRelationTypeDef 按名称写入关系类型定义 (覆盖允许的节点类型组合)
+	SaveRelationTypeDef(ctx context.Context, def typeregistry.RelationTypeDef) error
+	// CreateNodeTypeDef 创建新的节点类型定义，编码由存储原子地分配 (不小于 def.Code，且大于所有已有编码)，
+	// 返回带有分配编码的定义。同名定义已存在时返回 neo4jdal.ErrTypeDefExists。
+	CreateNodeTypeDef(ctx context.Context, def typeregistry.NodeTypeDef) (typeregistry.NodeTypeDef, error)
+	// CreateRelationTypeDef 创建新的关系类型定义并分配编码，语义同 CreateNodeTypeDef
+	CreateRelationTypeDef(ctx context.Context, def typeregistry.RelationTypeDef) (typeregistry.RelationTypeDef, error)
+}
+
+// GraphStore 组合了节点、关系和类型定义存储，由具体后端 (Neo4j 或内存图) 实现
+type GraphStore interface {
+	NodeStore
+	RelationStore
+	TypeStore
+}
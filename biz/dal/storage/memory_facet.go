@@ -0,0 +1,61 @@
+package storage
+
+import (
+	"cmp"
+	"reflect"
+	"slices"
+	"sort"
+
+	"labelwall/biz/dal/neo4jdal"
+)
+
+// facetCounts 按 neo4jdal 分面查询的语义统计节点：type 分面按标签统计 (req.Labels 非空时只统计其中的标签)，
+// 其他字段跳过属性缺失的节点；取值按 Cypher 的分组规则合并 (整数与浮点数相等时视为同一取值，保留按创建顺序最先出现的值)，
+// 结果按节点数降序、取值升序，最多 req.FetchSize(field) 个。
+func facetCounts(nodes []*memNode, req *neo4jdal.FacetRequest) []neo4jdal.Facet {
+	if req == nil {
+		return nil
+	}
+	nodes = slices.Clone(nodes)
+	slices.SortFunc(nodes, func(a, b *memNode) int { return cmp.Compare(a.id, b.id) })
+	facets := make([]neo4jdal.Facet, len(req.Fields))
+	for i, field := range req.Fields {
+		var counts []neo4jdal.FacetCount
+		add := func(value any) {
+			for j := range counts {
+				if filterEqual(counts[j].Value, value) || reflect.DeepEqual(counts[j].Value, value) {
+					counts[j].Count++
+					return
+				}
+			}
+			counts = append(counts, neo4jdal.FacetCount{Value: value, Count: 1})
+		}
+		for _, n := range nodes {
+			if field == neo4jdal.FacetTypeField {
+				for _, label := range n.labels {
+					if len(req.Labels) == 0 || slices.Contains(req.Labels, label) {
+						add(label)
+					}
+				}
+				continue
+			}
+			if value, ok := n.props[field]; ok && value != nil {
+				add(value)
+			}
+		}
+		sort.SliceStable(counts, func(a, b int) bool {
+			if counts[a].Count != counts[b].Count {
+				return counts[a].Count > counts[b].Count
+			}
+			return compareSortValues(counts[a].Value, counts[b].Value) < 0
+		})
+		if size := req.FetchSize(field); int64(len(counts)) > size {
+			counts = counts[:size]
+		}
+		if counts == nil {
+			counts = []neo4jdal.FacetCount{}
+		}
+		facets[i] = neo4jdal.Facet{Field: field, Counts: counts}
+	}
+	return facets
+}
@@ -0,0 +1,214 @@
+package storage
+
+import (
+	"strings"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+
+	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/propvalue"
+)
+
+// matchFilter 按 Cypher 的语义在内存中求值已校验的过滤表达式 (与 neo4jdal 编译出的条件一致)：
+// 属性缺失或类型不可比较时比较结果为 null，视为不匹配 (NOT_EXISTS 除外)。
+func matchFilter(props map[string]any, expr *network.FilterExpr) bool {
+	if expr == nil {
+		return true
+	}
+	or := expr.Logic != nil && *expr.Logic == network.FilterLogic_OR
+	results := make([]bool, 0, len(expr.Conditions)+len(expr.Groups))
+	for _, c := range expr.Conditions {
+		results = append(results, matchFilterCondition(props, c))
+	}
+	for _, g := range expr.Groups {
+		results = append(results, matchFilter(props, g))
+	}
+	if len(results) == 0 {
+		return true
+	}
+	for _, r := range results {
+		if or && r {
+			return true
+		}
+		if !or && !r {
+			return false
+		}
+	}
+	return !or
+}
+
+func matchFilterCondition(props map[string]any, c *network.FilterCondition) bool {
+	prop, exists := props[c.Field]
+	exists = exists && prop != nil
+	switch c.Op {
+	case network.FilterOp_EXISTS:
+		return exists
+	case network.FilterOp_NOT_EXISTS:
+		return !exists
+	}
+	if !exists {
+		return false
+	}
+	ci := c.GetCaseInsensitive()
+	if ci {
+		// 对应 toLower(toStringOrNull(n.field))，列表等不能转为字符串的值不匹配
+		if _, isList := prop.([]any); isList {
+			return false
+		}
+		if _, isList := prop.([]string); isList {
+			return false
+		}
+		s, ok := propvalue.Format(prop)
+		if !ok {
+			return false
+		}
+		prop = strings.ToLower(s)
+	}
+	value := func(v *network.PropertyValue) (any, bool) {
+		dbValue, err := propvalue.ToDB(v)
+		if err != nil {
+			return nil, false
+		}
+		if s, ok := dbValue.(string); ok && ci {
+			return strings.ToLower(s), true
+		}
+		return dbValue, true
+	}
+
+	if c.Op == network.FilterOp_IN {
+		for _, v := range c.Values {
+			if want, ok := value(v); ok && filterEqual(prop, want) {
+				return true
+			}
+		}
+		return false
+	}
+	want, ok := value(c.Value)
+	if !ok {
+		return false
+	}
+	switch c.Op {
+	case network.FilterOp_EQ:
+		return filterEqual(prop, want)
+	case network.FilterOp_NE:
+		return !filterEqual(prop, want)
+	case network.FilterOp_CONTAINS, network.FilterOp_STARTS_WITH, network.FilterOp_ENDS_WITH:
+		s, sok := prop.(string)
+		w, wok := want.(string)
+		if !sok || !wok {
+			return false
+		}
+		switch c.Op {
+		case network.FilterOp_CONTAINS:
+			return strings.Contains(s, w)
+		case network.FilterOp_STARTS_WITH:
+			return strings.HasPrefix(s, w)
+		default:
+			return strings.HasSuffix(s, w)
+		}
+	}
+	cmp, ok := filterCompare(prop, want)
+	if !ok {
+		return false
+	}
+	switch c.Op {
+	case network.FilterOp_GT:
+		return cmp > 0
+	case network.FilterOp_GTE:
+		return cmp >= 0
+	case network.FilterOp_LT:
+		return cmp < 0
+	case network.FilterOp_LTE:
+		return cmp <= 0
+	}
+	return false
+}
+
+// filterEqual 对应 Cypher 的 =：数值跨整数/浮点比较，列表逐项比较，其他类型不同则不相等
+func filterEqual(a, b any) bool {
+	if cmp, ok := filterCompare(a, b); ok {
+		return cmp == 0
+	}
+	if ab, ok := a.(bool); ok {
+		bb, ok := b.(bool)
+		return ok && ab == bb
+	}
+	al, aok := filterList(a)
+	bl, bok := filterList(b)
+	if !aok || !bok || len(al) != len(bl) {
+		return false
+	}
+	for i := range al {
+		if !filterEqual(al[i], bl[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// filterCompare 比较同类可排序的值 (数值、字符串、Date、带时区时间)，类型不可比较时返回 false
+func filterCompare(a, b any) (int, bool) {
+	if af, ok := filterNumber(a); ok {
+		bf, ok := filterNumber(b)
+		if !ok {
+			return 0, false
+		}
+		return compareOrdered(af, bf), true
+	}
+	switch av := a.(type) {
+	case string:
+		bv, ok := b.(string)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(av, bv), true
+	case dbtype.Date:
+		bv, ok := b.(dbtype.Date)
+		if !ok {
+			return 0, false
+		}
+		return av.Time().Compare(bv.Time()), true
+	case time.Time:
+		bv, ok := b.(time.Time)
+		if !ok {
+			return 0, false
+		}
+		return av.Compare(bv), true
+	}
+	return 0, false
+}
+
+func filterNumber(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+func filterList(v any) ([]any, bool) {
+	switch l := v.(type) {
+	case []any:
+		return l, true
+	case []string:
+		out := make([]any, len(l))
+		for i, s := range l {
+			out[i] = s
+		}
+		return out, true
+	}
+	return nil, false
+}
+
+func compareOrdered(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
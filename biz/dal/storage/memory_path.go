@@ -0,0 +1,168 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"labelwall/biz/dal/neo4jdal"
+	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/pathfind"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+// GetPaths 在内存图上按 neo4jdal.ExecGetPaths 的语义查询路径 (方向由 q.Traversal 决定)，pathfind 中的节点和边为内部 ID。
+// 全部最短路径与 Cypher 查询一样按节点业务 id 排序 (截断发生在排序之前)。
+// 要求经过指定节点时，最短路径用单位代价的 pathfind.CheapestVia 查找，全部最短路径取 k 最短路径中跳数最少的部分。
+func (s *memoryStore) GetPaths(ctx context.Context, q neo4jdal.PathQuery) ([]neo4jdal.Path, error) {
+	maxDepth := int(q.MaxDepth)
+	if maxDepth <= 0 {
+		maxDepth = 1
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	source, target := s.nodeByBusinessID(q.SourceID), s.nodeByBusinessID(q.TargetID)
+	if source == nil || target == nil || source.id == target.id {
+		return []neo4jdal.Path{}, nil
+	}
+	sourceKey, targetKey := strconv.FormatInt(source.id, 10), strconv.FormatInt(target.id, 10)
+	var via []string
+	for _, id := range q.RemainingVia(nil) {
+		n := s.nodeByBusinessID(id)
+		if n == nil {
+			return []neo4jdal.Path{}, nil
+		}
+		via = append(via, strconv.FormatInt(n.id, 10))
+	}
+	neighbors := func(node string) []pathfind.Edge {
+		nodeID, _ := strconv.ParseInt(node, 10, 64)
+		var edges []pathfind.Edge
+		for _, relID := range s.adjacency[nodeID] {
+			r := s.rels[relID]
+			if (len(q.RelTypes) > 0 && !slices.Contains(q.RelTypes, r.typ)) || !q.Traversal.Allows(r.typ, r.start == nodeID) {
+				continue
+			}
+			other := s.nodes[r.other(nodeID)]
+			if other.id != target.id && !q.AllowsIntermediate(other.key(), other.labels) {
+				continue
+			}
+			edges = append(edges, pathfind.Edge{ID: strconv.FormatInt(relID, 10), To: strconv.FormatInt(other.id, 10), Cost: 1})
+		}
+		return edges
+	}
+
+	// 要求经过指定节点的搜索与 ExecGetPaths 一样受 PathSearchBudget 限制，整个请求共用一份预算
+	budgeted := pathfind.CachedExpand(func(node string) ([]pathfind.Edge, error) { return neighbors(node), nil }, neo4jdal.PathSearchBudget)
+
+	// shortest 是 Yen 算法使用的最短路径函数，root 上的节点 (excl.Nodes) 和偏离点算作已经过
+	shortest := func(from string, maxHops int, excl pathfind.Exclusion) (pathfind.Path, bool, error) {
+		if len(via) == 0 {
+			p, ok := pathfind.Shortest(from, targetKey, maxHops, neighbors, excl)
+			return p, ok, nil
+		}
+		expand := func(node string) ([]pathfind.Edge, error) {
+			edges, err := budgeted(node)
+			if err != nil {
+				return nil, err
+			}
+			return slices.DeleteFunc(slices.Clone(edges), func(e pathfind.Edge) bool { return excl.Edges[e.ID] || excl.Nodes[e.To] }), nil
+		}
+		visited := []string{from}
+		for node := range excl.Nodes {
+			visited = append(visited, node)
+		}
+		var remaining []string
+		for _, v := range via {
+			if !slices.Contains(visited, v) {
+				remaining = append(remaining, v)
+			}
+		}
+		return pathfind.CheapestVia(from, targetKey, maxHops, remaining, expand)
+	}
+
+	var found []pathfind.Path
+	var err error
+	switch q.Mode {
+	case network.PathMode_ALL_SHORTEST:
+		if len(via) == 0 {
+			found = pathfind.AllShortest(sourceKey, targetKey, maxDepth, neo4jdal.MaxAllShortestPaths, neighbors)
+			break
+		}
+		// k 最短路径按跳数升序，保留与第一条跳数相同的部分
+		found, err = pathfind.KShortest(sourceKey, neo4jdal.MaxAllShortestPaths, maxDepth, shortest)
+		if len(found) > 0 {
+			minHops := found[0].Hops()
+			found = slices.DeleteFunc(found, func(p pathfind.Path) bool { return p.Hops() > minHops })
+		}
+	case network.PathMode_K_SHORTEST:
+		k := q.K
+		if k <= 0 {
+			k = neo4jdal.DefaultPathK
+		}
+		found, err = pathfind.KShortest(sourceKey, k, maxDepth, shortest)
+	case network.PathMode_WEIGHTED:
+		property := q.WeightProperty
+		if property == "" {
+			property = neo4jdal.DefaultWeightProperty
+		}
+		expand := func(node string) ([]pathfind.Edge, error) {
+			edges := neighbors(node)
+			for i := range edges {
+				relID, _ := strconv.ParseInt(edges[i].ID, 10, 64)
+				edges[i].Cost = neo4jdal.RelationCost(s.rels[relID].props, property, q.DefaultWeight)
+			}
+			return edges, nil
+		}
+		var p pathfind.Path
+		var ok bool
+		if p, ok, err = pathfind.CheapestVia(sourceKey, targetKey, maxDepth, via, pathfind.CachedExpand(expand, neo4jdal.PathSearchBudget)); ok {
+			found = []pathfind.Path{p}
+		}
+	default:
+		var p pathfind.Path
+		var ok bool
+		if p, ok, err = shortest(sourceKey, maxDepth, pathfind.Exclusion{}); ok {
+			found = []pathfind.Path{p}
+		}
+	}
+	if errors.Is(err, pathfind.ErrBudgetExceeded) {
+		return nil, fmt.Errorf("%w: 展开超过 %d 次或读取超过 %d 个节点", neo4jdal.ErrPathSearchTooLarge,
+			neo4jdal.PathSearchBudget.MaxExpansions, neo4jdal.PathSearchBudget.MaxNodes)
+	}
+
+	paths := make([]neo4jdal.Path, len(found))
+	keys := make([][]string, len(found))
+	for i, p := range found {
+		paths[i].Cost = p.Cost
+		paths[i].Nodes = make([]dbtype.Node, len(p.Nodes))
+		keys[i] = make([]string, len(p.Nodes))
+		for j, id := range p.Nodes {
+			nodeID, _ := strconv.ParseInt(id, 10, 64)
+			paths[i].Nodes[j] = s.nodes[nodeID].toDB()
+			keys[i][j] = s.nodes[nodeID].key()
+		}
+		paths[i].Relationships = make([]dbtype.Relationship, len(p.Edges))
+		for j, id := range p.Edges {
+			relID, _ := strconv.ParseInt(id, 10, 64)
+			paths[i].Relationships[j] = s.relToDB(s.rels[relID])
+		}
+	}
+	if q.Mode == network.PathMode_ALL_SHORTEST {
+		// ORDER BY [n IN nodes(path) | n.id]
+		order := make([]int, len(paths))
+		for i := range order {
+			order[i] = i
+		}
+		slices.SortStableFunc(order, func(a, b int) int { return slices.Compare(keys[a], keys[b]) })
+		sorted := make([]neo4jdal.Path, len(paths))
+		for i, j := range order {
+			sorted[i] = paths[j]
+		}
+		paths = sorted
+	}
+	return paths, nil
+}
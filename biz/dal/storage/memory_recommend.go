@@ -0,0 +1,117 @@
+package storage
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"labelwall/biz/dal/neo4jdal"
+	"labelwall/pkg/recommend"
+)
+
+// GetRecommendationCandidates 在内存图上按 neo4jdal.ExecGetRecommendationCandidates 的语义查询推荐所需的邻居数据
+func (s *memoryStore) GetRecommendationCandidates(ctx context.Context, q neo4jdal.RecommendationQuery) (neo4jdal.Neighborhood, error) {
+	maxCandidates := q.MaxCandidates
+	if maxCandidates <= 0 {
+		maxCandidates = neo4jdal.DefaultMaxRecommendationCandidates
+	}
+	maxCommonDegree := q.MaxCommonDegree
+	if maxCommonDegree <= 0 {
+		maxCommonDegree = neo4jdal.DefaultMaxCommonDegree
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	u := s.nodeByBusinessID(q.NodeID)
+	if u == nil {
+		return neo4jdal.Neighborhood{}, neo4jdal.ErrNotFound
+	}
+	isPerson := func(n *memNode) bool { return slices.Contains(n.labels, q.PersonLabel) }
+	isOrg := func(n *memNode) bool {
+		return slices.ContainsFunc(n.labels, func(l string) bool { return slices.Contains(q.OrgLabels, l) })
+	}
+	isConnection := func(r *memRel) bool { return slices.Contains(q.ConnectionTypes, r.typ) }
+	if !isPerson(u) {
+		return neo4jdal.Neighborhood{}, neo4jdal.ErrNotPerson
+	}
+	contactsOf := func(n *memNode) []string {
+		ids := []string{}
+		for _, relID := range s.adjacency[n.id] {
+			r := s.rels[relID]
+			if p := s.nodes[r.other(n.id)]; isConnection(r) && isPerson(p) && p.key() != "" {
+				ids = append(ids, p.key())
+			}
+		}
+		slices.Sort(ids)
+		return slices.Compact(ids)
+	}
+
+	result := neo4jdal.Neighborhood{Contacts: contactsOf(u), Orgs: []string{}}
+	connected := map[int64]bool{}
+	for _, relID := range s.adjacency[u.id] {
+		o := s.nodes[s.rels[relID].other(u.id)]
+		connected[o.id] = true
+		if isOrg(o) && o.key() != "" {
+			result.Orgs = append(result.Orgs, o.key())
+		}
+	}
+	slices.Sort(result.Orgs)
+	result.Orgs = slices.Compact(result.Orgs)
+
+	// 候选人 -> 共同邻居 (内部 ID)，与 Cypher 的 WITH DISTINCT c, z 一致
+	commons := map[int64]map[int64]bool{}
+	for _, r1ID := range s.adjacency[u.id] {
+		r1 := s.rels[r1ID]
+		z := s.nodes[r1.other(u.id)]
+		if z.id == u.id || z.key() == "" || len(s.adjacency[z.id]) > maxCommonDegree {
+			continue
+		}
+		viaOrg := isOrg(z)
+		if !viaOrg && !(isPerson(z) && isConnection(r1)) {
+			continue
+		}
+		for _, r2ID := range s.adjacency[z.id] {
+			r2 := s.rels[r2ID]
+			c := s.nodes[r2.other(z.id)]
+			if r2ID == r1ID || c.id == u.id || connected[c.id] || !isPerson(c) || c.key() == "" {
+				continue
+			}
+			if !viaOrg && !isConnection(r2) {
+				continue
+			}
+			if commons[c.id] == nil {
+				commons[c.id] = map[int64]bool{}
+			}
+			commons[c.id][z.id] = true
+		}
+	}
+
+	candidates := make([]recommend.Candidate, 0, len(commons))
+	for cID, zs := range commons {
+		c := s.nodes[cID]
+		candidate := recommend.Candidate{ID: c.key(), Contacts: len(contactsOf(c))}
+		for zID := range zs {
+			z := s.nodes[zID]
+			common := recommend.Common{ID: z.key(), Degree: len(s.adjacency[zID])}
+			if isOrg(z) {
+				candidate.SharedOrgs = append(candidate.SharedOrgs, common)
+			} else {
+				candidate.Mutuals = append(candidate.Mutuals, common)
+			}
+		}
+		neo4jdal.SortCommons(&candidate)
+		candidates = append(candidates, candidate)
+	}
+	commonCount := func(c recommend.Candidate) int { return len(c.Mutuals) + len(c.SharedOrgs) }
+	slices.SortFunc(candidates, func(a, b recommend.Candidate) int {
+		if n, m := commonCount(a), commonCount(b); n != m {
+			return m - n
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+	if len(candidates) > maxCandidates {
+		candidates = candidates[:maxCandidates]
+	}
+	result.Candidates = candidates
+	return result, nil
+}
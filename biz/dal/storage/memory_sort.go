@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"strings"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+
+	"labelwall/biz/dal/neo4jdal"
+)
+
+// compareBySortKeys 按排序键比较两条记录的属性 (与 neo4jdal 编译出的 ORDER BY 一致)，
+// 所有排序键都相等时返回 0，由调用方再按 id 比较。
+func compareBySortKeys(a, b map[string]any, keys []neo4jdal.SortKey) int {
+	for _, k := range keys {
+		cmp := compareSortValues(a[k.Field], b[k.Field])
+		if k.Desc {
+			cmp = -cmp
+		}
+		if cmp != 0 {
+			return cmp
+		}
+	}
+	return 0
+}
+
+// compareSortValues 按 Cypher 的可排序性 (升序) 比较两个属性值：不同类型之间
+// 列表 < 带时区时间 < LocalDateTime < Date < 字符串 < 布尔 < 数值 < null，同类型按值比较。
+func compareSortValues(a, b any) int {
+	ra, rb := sortRank(a), sortRank(b)
+	if ra != rb {
+		return ra - rb
+	}
+	switch av := a.(type) {
+	case string:
+		return strings.Compare(av, b.(string))
+	case bool:
+		bv := b.(bool)
+		switch {
+		case av == bv:
+			return 0
+		case !av:
+			return -1
+		}
+		return 1
+	case time.Time:
+		return av.Compare(b.(time.Time))
+	case dbtype.LocalDateTime:
+		return av.Time().Compare(b.(dbtype.LocalDateTime).Time())
+	case dbtype.Date:
+		return av.Time().Compare(b.(dbtype.Date).Time())
+	}
+	if af, ok := filterNumber(a); ok {
+		bf, _ := filterNumber(b)
+		return compareOrdered(af, bf)
+	}
+	if al, ok := filterList(a); ok {
+		bl, _ := filterList(b)
+		for i := 0; i < len(al) && i < len(bl); i++ {
+			if cmp := compareSortValues(al[i], bl[i]); cmp != 0 {
+				return cmp
+			}
+		}
+		return len(al) - len(bl)
+	}
+	return 0
+}
+
+// sortCursorProps 将游标中的排序键取值转换为属性 map，以便与记录一起传给 compareBySortKeys
+func sortCursorProps(keys []neo4jdal.SortKey, values []any) map[string]any {
+	props := make(map[string]any, len(keys))
+	for i, k := range keys {
+		if i < len(values) && values[i] != nil {
+			props[k.Field] = values[i]
+		}
+	}
+	return props
+}
+
+func sortRank(v any) int {
+	switch v.(type) {
+	case nil:
+		return 7
+	case int64, float64:
+		return 6
+	case bool:
+		return 5
+	case string:
+		return 4
+	case dbtype.Date:
+		return 3
+	case dbtype.LocalDateTime:
+		return 2
+	case time.Time:
+		return 1
+	case []any, []string:
+		return 0
+	}
+	// 其他类型 (内存存储中不会出现) 排在最前
+	return -1
+}
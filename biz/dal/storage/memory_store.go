@@ -0,0 +1,929 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"labelwall/biz/dal/neo4jdal"
+	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/fulltext"
+	"labelwall/pkg/typeregistry"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+// memNode 是内存图中的节点，id 为内部自增 ID (对应 Neo4j 的 <id>)
+type memNode struct {
+	id     int64
+	labels []string
+	props  map[string]any
+}
+
+// memRel 是内存图中的有向关系
+type memRel struct {
+	id         int64
+	typ        string
+	start, end int64
+	props      map[string]any
+}
+
+// memoryStore 是纯 Go 的进程内图存储，实现 GraphStore。
+// 语义与 Neo4j DAL 保持一致 (排序、分页游标、截断标记、无向最短路径)，
+// 用于无需 Neo4j 的本地运行和测试。不支持发件箱，写操作忽略 events。
+type memoryStore struct {
+	mu     sync.RWMutex
+	nextID int64
+
+	nodes     map[int64]*memNode
+	nodeByKey map[string]int64 // 业务 id -> 内部 ID
+	rels      map[int64]*memRel
+	relByKey  map[string]int64  // 业务 id -> 内部 ID
+	adjacency map[int64][]int64 // 内部节点 ID -> 相连的内部关系 ID (按创建顺序)
+
+	nodeTypeDefs     map[string]typeregistry.NodeTypeDef // 类型名 -> 定义
+	relationTypeDefs map[string]typeregistry.RelationTypeDef
+}
+
+// NewMemoryStore 创建一个空的内存图 GraphStore
+func NewMemoryStore() GraphStore {
+	return &memoryStore{
+		nodes:     make(map[int64]*memNode),
+		nodeByKey: make(map[string]int64),
+		rels:      make(map[int64]*memRel),
+		relByKey:  make(map[string]int64),
+		adjacency: make(map[int64][]int64),
+
+		nodeTypeDefs:     make(map[string]typeregistry.NodeTypeDef),
+		relationTypeDefs: make(map[string]typeregistry.RelationTypeDef),
+	}
+}
+
+// --- 节点操作 ---
+
+func (s *memoryStore) CreateNode(ctx context.Context, nodeType network.NodeType, properties map[string]any, _ ...neo4jdal.ChangeEvent) (dbtype.Node, error) {
+	label, err := neo4jdal.NodeLabel(nodeType)
+	if err != nil {
+		return dbtype.Node{}, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, _ := properties["id"].(string)
+	if _, exists := s.nodeByKey[key]; exists && key != "" {
+		return dbtype.Node{}, fmt.Errorf("DAL: 节点 id '%s' 已存在", key)
+	}
+	n := s.insertNode(label, properties)
+	return n.toDB(), nil
+}
+
+func (s *memoryStore) BatchCreateNodes(ctx context.Context, nodes []neo4jdal.BatchNodeInput, _ ...neo4jdal.ChangeEvent) ([]dbtype.Node, error) {
+	created, _, err := s.BatchCreateGraph(ctx, nodes, nil)
+	return created, err
+}
+
+func (s *memoryStore) BatchCreateGraph(ctx context.Context, nodes []neo4jdal.BatchNodeInput, rels []neo4jdal.BatchRelationInput, _ ...neo4jdal.ChangeEvent) ([]dbtype.Node, map[int]dbtype.Relationship, error) {
+	// 先整体校验，保证与单个写事务一样要么全部成功要么全部失败
+	relLabels := make([]string, len(rels))
+	for i, rel := range rels {
+		label, err := neo4jdal.RelationLabel(rel.RelType)
+		if err != nil {
+			return nil, nil, err
+		}
+		relLabels[i] = label
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seen := make(map[string]bool, len(nodes))
+	labels := make([]string, len(nodes))
+	for i, n := range nodes {
+		label, err := neo4jdal.NodeLabel(n.NodeType)
+		if err != nil {
+			return nil, nil, err
+		}
+		labels[i] = label
+		key, _ := n.Properties["id"].(string)
+		if key == "" {
+			continue
+		}
+		if _, exists := s.nodeByKey[key]; exists || seen[key] {
+			return nil, nil, fmt.Errorf("DAL: 节点 id '%s' 已存在", key)
+		}
+		seen[key] = true
+	}
+
+	createdNodes := make([]dbtype.Node, len(nodes))
+	for i, n := range nodes {
+		createdNodes[i] = s.insertNode(labels[i], n.Properties).toDB()
+	}
+	return createdNodes, s.insertBatchRels(rels, relLabels), nil
+}
+
+func (s *memoryStore) GetNodeByID(ctx context.Context, id string) (dbtype.Node, []string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	n := s.nodeByBusinessID(id)
+	if n == nil {
+		return dbtype.Node{}, nil, nil
+	}
+	return n.toDB(), slices.Clone(n.labels), nil
+}
+
+func (s *memoryStore) GetNodesByIDs(ctx context.Context, ids []string) ([]dbtype.Node, [][]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	nodes := make([]dbtype.Node, 0, len(ids))
+	labelsList := make([][]string, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if n := s.nodeByBusinessID(id); n != nil {
+			nodes = append(nodes, n.toDB())
+			labelsList = append(labelsList, slices.Clone(n.labels))
+		}
+	}
+	return nodes, labelsList, nil
+}
+
+func (s *memoryStore) UpdateNode(ctx context.Context, id string, updates map[string]any, _ ...neo4jdal.ChangeEvent) (dbtype.Node, []string, error) {
+	if len(updates) == 0 {
+		return dbtype.Node{}, nil, fmt.Errorf("DAL: 内部错误 - 更新属性列表为空")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := s.nodeByBusinessID(id)
+	if n == nil {
+		return dbtype.Node{}, nil, fmt.Errorf("DAL: 未找到要更新的节点 ID %s: %w", id, neo4jdal.ErrNotFound)
+	}
+	if newKey, ok := updates["id"].(string); ok && newKey != id {
+		if _, exists := s.nodeByKey[newKey]; exists {
+			return dbtype.Node{}, nil, fmt.Errorf("DAL: 节点 id '%s' 已存在", newKey)
+		}
+		delete(s.nodeByKey, id)
+		s.nodeByKey[newKey] = n.id
+	}
+	for k, v := range updates {
+		n.props[k] = v
+	}
+	return n.toDB(), slices.Clone(n.labels), nil
+}
+
+func (s *memoryStore) DeleteNode(ctx context.Context, id string, _ ...neo4jdal.ChangeEvent) (neo4jdal.DeleteResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := s.nodeByBusinessID(id)
+	if n == nil {
+		return neo4jdal.DeleteResult{}, fmt.Errorf("DAL: node with id '%s' not found for deletion", id)
+	}
+	deleted := neo4jdal.DeleteResult{NodeIDs: []string{id}, NodeLabels: slices.Clone(n.labels)}
+	// DETACH DELETE: 先删除所有相连的关系，记录关系和另一端的邻居
+	for _, relID := range slices.Clone(s.adjacency[n.id]) {
+		r := s.rels[relID]
+		if key, ok := r.props["id"].(string); ok {
+			deleted.RelationIDs = append(deleted.RelationIDs, key)
+		}
+		other := r.end
+		if other == n.id {
+			other = r.start
+		}
+		if neighbour, ok := s.nodes[other].props["id"].(string); ok && !slices.Contains(deleted.NodeIDs, neighbour) {
+			deleted.NodeIDs = append(deleted.NodeIDs, neighbour)
+		}
+		s.removeRel(r)
+	}
+	delete(s.adjacency, n.id)
+	delete(s.nodes, n.id)
+	if key, ok := n.props["id"].(string); ok {
+		delete(s.nodeByKey, key)
+	}
+	return deleted, nil
+}
+
+func (s *memoryStore) SearchNodes(ctx context.Context, criteria map[string]string, filter *network.FilterExpr, sortKeys []neo4jdal.SortKey, facets *neo4jdal.FacetRequest, nodeType *network.NodeType, limit, offset int64, after *neo4jdal.NodeKeyset) ([]dbtype.Node, [][]string, int64, []neo4jdal.Facet, error) {
+	if len(sortKeys) > 0 && after != nil && len(after.Values) != len(sortKeys) {
+		return nil, nil, 0, nil, fmt.Errorf("%w: 游标与排序键个数不一致", neo4jdal.ErrInvalidSort)
+	}
+	var label string
+	if nodeType != nil {
+		var err error
+		if label, err = neo4jdal.NodeLabel(*nodeType); err != nil {
+			return nil, nil, 0, nil, err
+		}
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var matched []*memNode
+	for _, n := range s.nodes {
+		if nodeType != nil && !slices.Contains(n.labels, label) {
+			continue
+		}
+		if nodeType == nil && slices.Contains(n.labels, neo4jdal.OutboxLabel) {
+			continue
+		}
+		if !matchSearchCriteria(n.props, criteria) || !matchFilter(n.props, filter) {
+			continue
+		}
+		matched = append(matched, n)
+	}
+	total := int64(len(matched))
+	facetResults := facetCounts(matched, facets)
+	if total == 0 {
+		return []dbtype.Node{}, [][]string{}, 0, facetResults, nil
+	}
+
+	if len(sortKeys) > 0 {
+		// ORDER BY <sortKeys>, n.id
+		sort.Slice(matched, func(i, j int) bool {
+			if cmp := compareBySortKeys(matched[i].props, matched[j].props, sortKeys); cmp != 0 {
+				return cmp < 0
+			}
+			return matched[i].key() < matched[j].key()
+		})
+	} else {
+		// ORDER BY coalesce(n.name, ''), n.id
+		sort.Slice(matched, func(i, j int) bool {
+			ni, _ := matched[i].props["name"].(string)
+			nj, _ := matched[j].props["name"].(string)
+			if ni != nj {
+				return ni < nj
+			}
+			return matched[i].key() < matched[j].key()
+		})
+	}
+
+	// 游标分页: 只取排在游标之后的节点，忽略 offset
+	if after != nil {
+		offset = 0
+		cursor := sortCursorProps(sortKeys, after.Values)
+		filtered := matched[:0:0]
+		for _, n := range matched {
+			if len(sortKeys) > 0 {
+				if cmp := compareBySortKeys(n.props, cursor, sortKeys); cmp > 0 || (cmp == 0 && n.key() > after.ID) {
+					filtered = append(filtered, n)
+				}
+				continue
+			}
+			name, _ := n.props["name"].(string)
+			if name > after.Name || (name == after.Name && n.key() > after.ID) {
+				filtered = append(filtered, n)
+			}
+		}
+		matched = filtered
+	}
+
+	page := pageSlice(matched, offset, limit)
+	nodes := make([]dbtype.Node, len(page))
+	labelsList := make([][]string, len(page))
+	for i, n := range page {
+		nodes[i] = n.toDB()
+		labelsList[i] = slices.Clone(n.labels)
+	}
+	return nodes, labelsList, total, facetResults, nil
+}
+
+func (s *memoryStore) GetNetwork(ctx context.Context,
+	startNodeCriteria map[string]string,
+	depth int32,
+	limit, offset int64,
+	maxRelations int64,
+	relationTypes []network.RelationType,
+	nodeTypes []network.NodeType,
+	traversal neo4jdal.Traversal,
+) ([]dbtype.Node, []dbtype.Relationship, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	nodeTypeStrs := make([]string, len(nodeTypes))
+	for i, nt := range nodeTypes {
+		nodeTypeStrs[i] = typeregistry.Default().NodeTypeName(nt)
+	}
+	nodeAllowed := func(n *memNode) bool {
+		if len(nodeTypeStrs) == 0 {
+			return true
+		}
+		for _, lbl := range n.labels {
+			if slices.Contains(nodeTypeStrs, lbl) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if depth == 0 {
+		var starts []*memNode
+		for _, n := range s.nodes {
+			if matchExactCriteria(n.props, startNodeCriteria, false) && nodeAllowed(n) {
+				starts = append(starts, n)
+			}
+		}
+		sortNodesByKey(starts)
+		page := pageSlice(starts, offset, limit+1) // 多取一条用于判断是否还有更多节点
+		truncated := int64(len(page)) > limit
+		if truncated {
+			page = page[:limit]
+		}
+		nodes := make([]dbtype.Node, len(page))
+		for i, n := range page {
+			nodes[i] = n.toDB()
+		}
+		return nodes, []dbtype.Relationship{}, truncated, nil
+	}
+
+	relTypeStrs := make([]string, len(relationTypes))
+	for i, rt := range relationTypes {
+		relTypeStrs[i] = typeregistry.Default().RelationTypeName(rt)
+	}
+	relAllowed := func(r *memRel, from int64) bool {
+		return (len(relTypeStrs) == 0 || slices.Contains(relTypeStrs, r.typ)) && traversal.Allows(r.typ, r.start == from)
+	}
+
+	// 从每个起始节点做 BFS；路径上的所有节点和关系都必须满足类型过滤和方向限制。
+	// 关系 (u, v) 位于某条长度 <= depth 的路径上，当且仅当 min(dist(u), dist(v)) < depth。
+	nodeSet := make(map[int64]*memNode)
+	relSet := make(map[int64]*memRel)
+	for _, start := range s.nodes {
+		if !matchExactCriteria(start.props, startNodeCriteria, true) || !nodeAllowed(start) {
+			continue
+		}
+		dist := s.bfs(start.id, int(depth), relAllowed, nodeAllowed)
+		for relID := range s.reachableRels(dist, int(depth), relAllowed, nodeAllowed) {
+			r := s.rels[relID]
+			relSet[relID] = r
+			nodeSet[r.start] = s.nodes[r.start]
+			nodeSet[r.end] = s.nodes[r.end]
+		}
+	}
+
+	allNodes := make([]*memNode, 0, len(nodeSet))
+	for _, n := range nodeSet {
+		allNodes = append(allNodes, n)
+	}
+	sortNodesByKey(allNodes)
+	pageNodes := pageSlice(allNodes, offset, limit)
+	nodes := make([]dbtype.Node, len(pageNodes))
+	for i, n := range pageNodes {
+		nodes[i] = n.toDB()
+	}
+
+	// 每条关系在两端中排序靠后的一端所在的页返回
+	position := make(map[int64]int64, len(allNodes))
+	for i, n := range allNodes {
+		position[n.id] = int64(i)
+	}
+	var pageRels []*memRel
+	for _, r := range relSet {
+		if last := max(position[r.start], position[r.end]); last >= offset && last < offset+int64(len(pageNodes)) {
+			pageRels = append(pageRels, r)
+		}
+	}
+	sort.Slice(pageRels, func(i, j int) bool {
+		ki, kj := pageRels[i].key(), pageRels[j].key()
+		if ki != kj {
+			return ki < kj
+		}
+		return pageRels[i].id < pageRels[j].id
+	})
+	totalRelations := len(pageRels)
+	if maxRelations > 0 && int64(len(pageRels)) > maxRelations {
+		pageRels = pageRels[:maxRelations]
+	}
+	rels := make([]dbtype.Relationship, len(pageRels))
+	for i, r := range pageRels {
+		rels[i] = s.relToDB(r)
+	}
+
+	truncated := offset+int64(len(nodes)) < int64(len(allNodes)) || len(rels) < totalRelations
+	return nodes, rels, truncated, nil
+}
+
+// --- 关系操作 ---
+
+// FulltextSearchNodes 在内存中模拟全文索引：只检查索引覆盖的类型和文本属性，
+// 每个与查询词匹配的词计 1 分 (仅模糊匹配的计 0.5 分)，不计算 Lucene 的 TF-IDF。
+func (s *memoryStore) FulltextSearchNodes(ctx context.Context, text string, fuzzy bool, nodeType *network.NodeType, limit, offset int64) ([]neo4jdal.FulltextNodeHit, int64, error) {
+	terms := fulltext.Terms(text)
+	if len(terms) == 0 {
+		return nil, 0, fulltext.ErrEmptyQuery
+	}
+	var label string
+	if nodeType != nil {
+		var err error
+		if label, err = neo4jdal.NodeLabel(*nodeType); err != nil {
+			return nil, 0, err
+		}
+	}
+	labels, props := neo4jdal.NodeFulltextIndexSpec(typeregistry.Default().NodeTypes())
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	type scored struct {
+		node  *memNode
+		score float64
+	}
+	var matched []scored
+	for _, n := range s.nodes {
+		if label != "" && !slices.Contains(n.labels, label) {
+			continue
+		}
+		if !slices.ContainsFunc(n.labels, func(l string) bool { return slices.Contains(labels, l) }) {
+			continue
+		}
+		var score float64
+		for _, key := range props {
+			value, ok := n.props[key].(string)
+			if !ok {
+				continue
+			}
+			for _, tok := range fulltext.Tokenize(value) {
+				for _, term := range terms {
+					if term == tok.Term {
+						score++
+					} else if fulltext.Match(term, tok.Term, fuzzy) {
+						score += 0.5
+					}
+				}
+			}
+		}
+		if score > 0 {
+			matched = append(matched, scored{node: n, score: score})
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		if matched[i].score != matched[j].score {
+			return matched[i].score > matched[j].score
+		}
+		return matched[i].node.key() < matched[j].node.key()
+	})
+
+	page := pageSlice(matched, offset, limit)
+	hits := make([]neo4jdal.FulltextNodeHit, len(page))
+	for i, m := range page {
+		hits[i] = neo4jdal.FulltextNodeHit{Node: m.node.toDB(), Labels: slices.Clone(m.node.labels), Score: m.score}
+	}
+	return hits, int64(len(matched)), nil
+}
+
+func (s *memoryStore) CreateRelation(ctx context.Context, sourceID, targetID string, relType network.RelationType, properties map[string]any, _ ...neo4jdal.ChangeEvent) (dbtype.Relationship, error) {
+	label, err := neo4jdal.RelationLabel(relType)
+	if err != nil {
+		return dbtype.Relationship{}, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	source, target := s.nodeByBusinessID(sourceID), s.nodeByBusinessID(targetID)
+	if source == nil || target == nil {
+		return dbtype.Relationship{}, fmt.Errorf("DAL: 获取创建关系结果失败 (可能是节点不存在): %w", neo4jdal.ErrNotFound)
+	}
+	if !typeregistry.Default().AllowsRelation(relType, source.labels, target.labels) {
+		return dbtype.Relationship{}, fmt.Errorf("DAL: 关系类型 %s 不允许连接节点 '%s' 和 '%s': %w", label, sourceID, targetID, neo4jdal.ErrRelationNotAllowed)
+	}
+	return s.relToDB(s.insertRel(source.id, target.id, label, properties)), nil
+}
+
+func (s *memoryStore) BatchCreateRelations(ctx context.Context, rels []neo4jdal.BatchRelationInput, _ ...neo4jdal.ChangeEvent) (map[int]dbtype.Relationship, error) {
+	labels := make([]string, len(rels))
+	for i, rel := range rels {
+		label, err := neo4jdal.RelationLabel(rel.RelType)
+		if err != nil {
+			return nil, err
+		}
+		labels[i] = label
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.insertBatchRels(rels, labels), nil
+}
+
+// insertBatchRels 写入一批关系，返回以输入下标为键的已创建关系 (调用方持有写锁)
+func (s *memoryStore) insertBatchRels(rels []neo4jdal.BatchRelationInput, labels []string) map[int]dbtype.Relationship {
+	created := make(map[int]dbtype.Relationship, len(rels))
+	for i, rel := range rels {
+		source, target := s.nodeByBusinessID(rel.SourceID), s.nodeByBusinessID(rel.TargetID)
+		if source == nil || target == nil {
+			continue // 与 MATCH 一致，源或目标不存在时跳过
+		}
+		if !typeregistry.Default().AllowsRelation(rel.RelType, source.labels, target.labels) {
+			continue // 与 WHERE 条件一致，节点类型组合不被允许时跳过
+		}
+		created[i] = s.relToDB(s.insertRel(source.id, target.id, labels[i], rel.Properties))
+	}
+	return created
+}
+
+func (s *memoryStore) GetRelationByID(ctx context.Context, id string) (dbtype.Relationship, string, string, string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	r := s.relByBusinessID(id)
+	if r == nil {
+		return dbtype.Relationship{}, "", "", "", neo4jdal.ErrNotFound
+	}
+	return s.relToDB(r), r.typ, s.nodes[r.start].key(), s.nodes[r.end].key(), nil
+}
+
+func (s *memoryStore) GetRelationsByIDs(ctx context.Context, ids []string) ([]dbtype.Relationship, []string, []string, []string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rels := make([]dbtype.Relationship, 0, len(ids))
+	types := make([]string, 0, len(ids))
+	sourceIDs := make([]string, 0, len(ids))
+	targetIDs := make([]string, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if r := s.relByBusinessID(id); r != nil {
+			rels = append(rels, s.relToDB(r))
+			types = append(types, r.typ)
+			sourceIDs = append(sourceIDs, s.nodes[r.start].key())
+			targetIDs = append(targetIDs, s.nodes[r.end].key())
+		}
+	}
+	return rels, types, sourceIDs, targetIDs, nil
+}
+
+func (s *memoryStore) UpdateRelation(ctx context.Context, id string, updates map[string]any, _ ...neo4jdal.ChangeEvent) (dbtype.Relationship, string, string, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r := s.relByBusinessID(id)
+	if r == nil {
+		return dbtype.Relationship{}, "", "", "", fmt.Errorf("DAL: 获取更新关系结果失败: %w", neo4jdal.ErrNotFound)
+	}
+	if newKey, ok := updates["id"].(string); ok && newKey != id {
+		delete(s.relByKey, id)
+		s.relByKey[newKey] = r.id
+	}
+	for k, v := range updates {
+		r.props[k] = v
+	}
+	r.props["updated_at"] = time.Now().UTC() // 自动更新 updated_at
+	return s.relToDB(r), r.typ, s.nodes[r.start].key(), s.nodes[r.end].key(), nil
+}
+
+func (s *memoryStore) DeleteRelation(ctx context.Context, id string, _ ...neo4jdal.ChangeEvent) (neo4jdal.DeleteResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r := s.relByBusinessID(id)
+	if r == nil {
+		return neo4jdal.DeleteResult{}, neo4jdal.ErrNotFound
+	}
+	sourceID, _ := s.nodes[r.start].props["id"].(string)
+	targetID, _ := s.nodes[r.end].props["id"].(string)
+	s.removeRel(r)
+	return neo4jdal.DeleteResult{NodeIDs: []string{sourceID, targetID}, RelationIDs: []string{id}}, nil
+}
+
+func (s *memoryStore) GetNodeRelations(ctx context.Context, nodeID string, types []string, outgoing, incoming bool, sortKeys []neo4jdal.SortKey, limit, offset int64, after *neo4jdal.RelationKeyset) ([]dbtype.Relationship, []string, []string, []string, int64, error) {
+	if after != nil && len(after.Values) != len(sortKeys) {
+		return nil, nil, nil, nil, 0, fmt.Errorf("%w: 游标与排序键个数不一致", neo4jdal.ErrInvalidSort)
+	}
+	rels := []dbtype.Relationship{}
+	relTypes := []string{}
+	sourceIDs := []string{}
+	targetIDs := []string{}
+	if !outgoing && !incoming {
+		return rels, relTypes, sourceIDs, targetIDs, 0, nil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	n := s.nodeByBusinessID(nodeID)
+	if n == nil {
+		return rels, relTypes, sourceIDs, targetIDs, 0, nil
+	}
+	var matched []*memRel
+	for _, relID := range s.adjacency[n.id] {
+		r := s.rels[relID]
+		if !(outgoing && r.start == n.id) && !(incoming && r.end == n.id) {
+			continue
+		}
+		if len(types) > 0 && !slices.Contains(types, r.typ) {
+			continue
+		}
+		matched = append(matched, r)
+	}
+	total := int64(len(matched))
+	if total == 0 {
+		return rels, relTypes, sourceIDs, targetIDs, 0, nil
+	}
+
+	// ORDER BY [<sortKeys>,] r.id
+	sort.Slice(matched, func(i, j int) bool {
+		if cmp := compareBySortKeys(matched[i].props, matched[j].props, sortKeys); cmp != 0 {
+			return cmp < 0
+		}
+		return matched[i].key() < matched[j].key()
+	})
+	if after != nil {
+		offset = 0
+		cursor := sortCursorProps(sortKeys, after.Values)
+		filtered := matched[:0:0]
+		for _, r := range matched {
+			if cmp := compareBySortKeys(r.props, cursor, sortKeys); cmp > 0 || (cmp == 0 && r.key() > after.ID) {
+				filtered = append(filtered, r)
+			}
+		}
+		matched = filtered
+	}
+	for _, r := range pageSlice(matched, offset, limit) {
+		rels = append(rels, s.relToDB(r))
+		relTypes = append(relTypes, r.typ)
+		sourceIDs = append(sourceIDs, s.nodes[r.start].key())
+		targetIDs = append(targetIDs, s.nodes[r.end].key())
+	}
+	return rels, relTypes, sourceIDs, targetIDs, total, nil
+}
+
+// --- 类型定义 ---
+
+func (s *memoryStore) ListTypeDefs(ctx context.Context) ([]typeregistry.NodeTypeDef, []typeregistry.RelationTypeDef, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	nodeDefs := make([]typeregistry.NodeTypeDef, 0, len(s.nodeTypeDefs))
+	for _, def := range s.nodeTypeDefs {
+		def.Properties = typeregistry.CloneSchema(def.Properties)
+		nodeDefs = append(nodeDefs, def)
+	}
+	sort.Slice(nodeDefs, func(i, j int) bool { return nodeDefs[i].Code < nodeDefs[j].Code })
+	relDefs := make([]typeregistry.RelationTypeDef, 0, len(s.relationTypeDefs))
+	for _, def := range s.relationTypeDefs {
+		def.AllowedPairs = slices.Clone(def.AllowedPairs)
+		def.Properties = typeregistry.CloneSchema(def.Properties)
+		relDefs = append(relDefs, def)
+	}
+	sort.Slice(relDefs, func(i, j int) bool { return relDefs[i].Code < relDefs[j].Code })
+	return nodeDefs, relDefs, nil
+}
+
+func (s *memoryStore) SaveNodeTypeDef(ctx context.Context, def typeregistry.NodeTypeDef) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	def.Properties = typeregistry.CloneSchema(def.Properties)
+	s.nodeTypeDefs[def.Name] = def
+	return nil
+}
+
+func (s *memoryStore) SaveRelationTypeDef(ctx context.Context, def typeregistry.RelationTypeDef) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	def.AllowedPairs = slices.Clone(def.AllowedPairs)
+	def.Properties = typeregistry.CloneSchema(def.Properties)
+	s.relationTypeDefs[def.Name] = def
+	return nil
+}
+
+func (s *memoryStore) CreateNodeTypeDef(ctx context.Context, def typeregistry.NodeTypeDef) (typeregistry.NodeTypeDef, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.nodeTypeDefs[def.Name]; exists {
+		return typeregistry.NodeTypeDef{}, fmt.Errorf("%w: %s", neo4jdal.ErrTypeDefExists, def.Name)
+	}
+	for _, d := range s.nodeTypeDefs {
+		def.Code = max(def.Code, d.Code+1)
+	}
+	def.Properties = typeregistry.CloneSchema(def.Properties)
+	s.nodeTypeDefs[def.Name] = def
+	return def, nil
+}
+
+func (s *memoryStore) CreateRelationTypeDef(ctx context.Context, def typeregistry.RelationTypeDef) (typeregistry.RelationTypeDef, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.relationTypeDefs[def.Name]; exists {
+		return typeregistry.RelationTypeDef{}, fmt.Errorf("%w: %s", neo4jdal.ErrTypeDefExists, def.Name)
+	}
+	for _, d := range s.relationTypeDefs {
+		def.Code = max(def.Code, d.Code+1)
+	}
+	def.AllowedPairs = slices.Clone(def.AllowedPairs)
+	def.Properties = typeregistry.CloneSchema(def.Properties)
+	s.relationTypeDefs[def.Name] = def
+	return def, nil
+}
+
+// --- 内部辅助函数 (调用方持有锁) ---
+
+func (s *memoryStore) insertNode(label string, properties map[string]any) *memNode {
+	s.nextID++
+	n := &memNode{id: s.nextID, labels: []string{label}, props: copyProps(properties)}
+	s.nodes[n.id] = n
+	if key, ok := n.props["id"].(string); ok {
+		s.nodeByKey[key] = n.id
+	}
+	return n
+}
+
+func (s *memoryStore) insertRel(start, end int64, relType string, properties map[string]any) *memRel {
+	s.nextID++
+	r := &memRel{id: s.nextID, typ: relType, start: start, end: end, props: copyProps(properties)}
+	s.rels[r.id] = r
+	if key, ok := r.props["id"].(string); ok {
+		s.relByKey[key] = r.id
+	}
+	s.adjacency[start] = append(s.adjacency[start], r.id)
+	if end != start {
+		s.adjacency[end] = append(s.adjacency[end], r.id)
+	}
+	return r
+}
+
+func (s *memoryStore) removeRel(r *memRel) {
+	delete(s.rels, r.id)
+	if key, ok := r.props["id"].(string); ok && s.relByKey[key] == r.id {
+		delete(s.relByKey, key)
+	}
+	for _, nodeID := range []int64{r.start, r.end} {
+		s.adjacency[nodeID] = slices.DeleteFunc(s.adjacency[nodeID], func(id int64) bool { return id == r.id })
+	}
+}
+
+func (s *memoryStore) nodeByBusinessID(id string) *memNode {
+	internalID, ok := s.nodeByKey[id]
+	if !ok {
+		return nil
+	}
+	return s.nodes[internalID]
+}
+
+func (s *memoryStore) relByBusinessID(id string) *memRel {
+	internalID, ok := s.relByKey[id]
+	if !ok {
+		return nil
+	}
+	return s.rels[internalID]
+}
+
+// bfs 计算从 start 出发、经过满足过滤条件的关系和节点在 maxDepth 步内可达节点的最短距离
+func (s *memoryStore) bfs(start int64, maxDepth int, relAllowed func(r *memRel, from int64) bool, nodeAllowed func(*memNode) bool) map[int64]int {
+	dist := map[int64]int{start: 0}
+	frontier := []int64{start}
+	for level := 0; level < maxDepth && len(frontier) > 0; level++ {
+		var next []int64
+		for _, nodeID := range frontier {
+			for _, relID := range s.adjacency[nodeID] {
+				r := s.rels[relID]
+				other := r.other(nodeID)
+				if !relAllowed(r, nodeID) || !nodeAllowed(s.nodes[other]) {
+					continue
+				}
+				if _, visited := dist[other]; visited {
+					continue
+				}
+				dist[other] = level + 1
+				next = append(next, other)
+			}
+		}
+		frontier = next
+	}
+	return dist
+}
+
+// reachableRels 返回可以从距离小于 maxDepth 的一端经过且满足过滤条件的关系，即位于某条长度 <= maxDepth 的路径上的关系
+func (s *memoryStore) reachableRels(dist map[int64]int, maxDepth int, relAllowed func(r *memRel, from int64) bool, nodeAllowed func(*memNode) bool) map[int64]struct{} {
+	result := make(map[int64]struct{})
+	for nodeID, d := range dist {
+		if d >= maxDepth {
+			continue
+		}
+		for _, relID := range s.adjacency[nodeID] {
+			r := s.rels[relID]
+			if relAllowed(r, nodeID) && nodeAllowed(s.nodes[r.other(nodeID)]) {
+				result[relID] = struct{}{}
+			}
+		}
+	}
+	return result
+}
+
+func (s *memoryStore) relToDB(r *memRel) dbtype.Relationship {
+	return dbtype.Relationship{
+		Id:             r.id,
+		ElementId:      elementID(r.id),
+		StartId:        r.start,
+		EndId:          r.end,
+		StartElementId: elementID(r.start),
+		EndElementId:   elementID(r.end),
+		Type:           r.typ,
+		Props:          copyProps(r.props),
+	}
+}
+
+func (n *memNode) toDB() dbtype.Node {
+	return dbtype.Node{
+		Id:        n.id,
+		ElementId: elementID(n.id),
+		Labels:    slices.Clone(n.labels),
+		Props:     copyProps(n.props),
+	}
+}
+
+// key 返回节点的业务 id 属性
+func (n *memNode) key() string {
+	key, _ := n.props["id"].(string)
+	return key
+}
+
+// key 返回关系的业务 id 属性
+func (r *memRel) key() string {
+	key, _ := r.props["id"].(string)
+	return key
+}
+
+// other 返回关系另一端的节点 (自环返回自身)
+func (r *memRel) other(nodeID int64) int64 {
+	if r.start == nodeID {
+		return r.end
+	}
+	return r.start
+}
+
+func elementID(id int64) string {
+	return "mem:" + strconv.FormatInt(id, 10)
+}
+
+func copyProps(props map[string]any) map[string]any {
+	out := make(map[string]any, len(props))
+	for k, v := range props {
+		out[k] = v
+	}
+	return out
+}
+
+// matchSearchCriteria 与 ExecSearchNodes 一致: name 使用 CONTAINS，其他属性精确匹配
+func matchSearchCriteria(props map[string]any, criteria map[string]string) bool {
+	for key, value := range criteria {
+		prop, ok := props[key].(string)
+		if !ok {
+			return false
+		}
+		if key == "name" {
+			if !strings.Contains(prop, value) {
+				return false
+			}
+		} else if prop != value {
+			return false
+		}
+	}
+	return true
+}
+
+// matchExactCriteria 精确匹配起始节点条件；skipEmpty 为 true 时忽略空键或空值 (与 depth > 0 的查询一致)
+func matchExactCriteria(props map[string]any, criteria map[string]string, skipEmpty bool) bool {
+	for key, value := range criteria {
+		if skipEmpty && (key == "" || value == "") {
+			continue
+		}
+		if prop, ok := props[key].(string); !ok || prop != value {
+			return false
+		}
+	}
+	return true
+}
+
+func sortNodesByKey(nodes []*memNode) {
+	sort.Slice(nodes, func(i, j int) bool {
+		ki, kj := nodes[i].key(), nodes[j].key()
+		if ki != kj {
+			return ki < kj
+		}
+		return nodes[i].id < nodes[j].id
+	})
+}
+
+// pageSlice 返回 [offset, offset+limit) 范围内的元素，越界部分截断
+func pageSlice[T any](items []T, offset, limit int64) []T {
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= int64(len(items)) || limit <= 0 {
+		return nil
+	}
+	end := offset + limit
+	if end > int64(len(items)) {
+		end = int64(len(items))
+	}
+	return items[offset:end]
+}
@@ -0,0 +1,819 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"labelwall/biz/dal/neo4jdal"
+	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/fulltext"
+	"labelwall/pkg/recommend"
+	"labelwall/pkg/typeregistry"
+)
+
+// seedGraph 创建一个小图:
+//
+//	p1 -FRIEND-> p2 -COLLEAGUE-> p3 -FRIEND-> p4
+//	p1 -VISITED-> c1
+func seedGraph(t *testing.T, s GraphStore) {
+	t.Helper()
+	ctx := context.Background()
+	nodes := []neo4jdal.BatchNodeInput{
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "p1", "name": "Alice"}},
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "p2", "name": "Bob", "profession": "engineer"}},
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "p3", "name": "Carol", "profession": "engineer"}},
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "p4", "name": "Alicia"}},
+		{NodeType: network.NodeType_COMPANY, Properties: map[string]any{"id": "c1", "name": "Acme"}},
+	}
+	_, err := s.BatchCreateNodes(ctx, nodes)
+	require.NoError(t, err)
+
+	rels := []neo4jdal.BatchRelationInput{
+		{SourceID: "p1", TargetID: "p2", RelType: network.RelationType_FRIEND, Properties: map[string]any{"id": "r1"}},
+		{SourceID: "p2", TargetID: "p3", RelType: network.RelationType_COLLEAGUE, Properties: map[string]any{"id": "r2"}},
+		{SourceID: "p3", TargetID: "p4", RelType: network.RelationType_FRIEND, Properties: map[string]any{"id": "r3"}},
+		{SourceID: "p1", TargetID: "c1", RelType: network.RelationType_VISITED, Properties: map[string]any{"id": "r4"}},
+		{SourceID: "p1", TargetID: "missing", RelType: network.RelationType_FRIEND, Properties: map[string]any{"id": "r5"}},
+	}
+	created, err := s.BatchCreateRelations(ctx, rels)
+	require.NoError(t, err)
+	assert.Len(t, created, 4)
+	assert.NotContains(t, created, 4, "目标节点不存在的关系应被跳过")
+}
+
+// relIDs 返回关系的业务 id 列表
+func relIDs(rels []dbtype.Relationship) []string {
+	ids := make([]string, len(rels))
+	for i, r := range rels {
+		ids[i], _ = r.Props["id"].(string)
+	}
+	return ids
+}
+
+// propIDs 返回节点的业务 id 列表
+func propIDs(nodes []dbtype.Node) []string {
+	ids := make([]string, len(nodes))
+	for i, n := range nodes {
+		ids[i], _ = n.Props["id"].(string)
+	}
+	return ids
+}
+
+func TestMemoryStore_NodeCRUD(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+
+	created, err := s.CreateNode(ctx, network.NodeType_PERSON, map[string]any{"id": "n1", "name": "Alice"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"PERSON"}, created.Labels)
+	assert.Equal(t, "Alice", created.Props["name"])
+
+	_, err = s.CreateNode(ctx, network.NodeType_PERSON, map[string]any{"id": "n1"})
+	assert.Error(t, err, "重复的 id 应创建失败")
+
+	node, labels, err := s.GetNodeByID(ctx, "n1")
+	require.NoError(t, err)
+	assert.Equal(t, created.Id, node.Id)
+	assert.Equal(t, []string{"PERSON"}, labels)
+
+	// 返回值是副本，修改不影响存储
+	node.Props["name"] = "Mallory"
+	node, _, _ = s.GetNodeByID(ctx, "n1")
+	assert.Equal(t, "Alice", node.Props["name"])
+
+	updated, _, err := s.UpdateNode(ctx, "n1", map[string]any{"name": "Alicia"})
+	require.NoError(t, err)
+	assert.Equal(t, "Alicia", updated.Props["name"])
+
+	_, _, err = s.UpdateNode(ctx, "nope", map[string]any{"name": "x"})
+	assert.Error(t, err)
+
+	_, err = s.DeleteNode(ctx, "n1")
+	require.NoError(t, err)
+	node, labels, err = s.GetNodeByID(ctx, "n1")
+	require.NoError(t, err, "未找到时与 DAL 一致返回 nil 错误")
+	assert.Nil(t, labels)
+	assert.Zero(t, node.Id)
+
+	_, err = s.DeleteNode(ctx, "n1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestMemoryStore_DeleteNodeDetachesRelations(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+	seedGraph(t, s)
+
+	deleted, err := s.DeleteNode(ctx, "p2")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"p2", "p1", "p3"}, deleted.NodeIDs, "被删除的节点和它的邻居")
+	assert.ElementsMatch(t, []string{"r1", "r2"}, deleted.RelationIDs)
+	assert.Equal(t, []string{"PERSON"}, deleted.NodeLabels)
+	_, _, _, _, err = s.GetRelationByID(ctx, "r1")
+	assert.ErrorIs(t, err, neo4jdal.ErrNotFound)
+	_, _, _, _, total, err := s.GetNodeRelations(ctx, "p1", nil, true, true, nil, 10, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total, "只剩 p1 -> c1")
+}
+
+func TestMemoryStore_BatchCreateGraph(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+
+	nodes := []neo4jdal.BatchNodeInput{
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "g1", "name": "Alice"}},
+		{NodeType: network.NodeType_COMPANY, Properties: map[string]any{"id": "g2", "name": "Acme"}},
+	}
+	rels := []neo4jdal.BatchRelationInput{
+		{SourceID: "g1", TargetID: "g2", RelType: network.RelationType_COLLEAGUE, Properties: map[string]any{"id": "gr1"}},
+		{SourceID: "g1", TargetID: "missing", RelType: network.RelationType_FRIEND, Properties: map[string]any{"id": "gr2"}},
+	}
+	createdNodes, createdRels, err := s.BatchCreateGraph(ctx, nodes, rels)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"g1", "g2"}, propIDs(createdNodes))
+	require.Len(t, createdRels, 1, "关系可以引用同一批次的新节点，端点不存在的关系被跳过")
+	assert.Equal(t, "gr1", createdRels[0].Props["id"])
+
+	// 节点 id 冲突时整个批次失败，关系也不会写入
+	_, _, err = s.BatchCreateGraph(ctx,
+		[]neo4jdal.BatchNodeInput{{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "g1"}}},
+		[]neo4jdal.BatchRelationInput{{SourceID: "g2", TargetID: "g2", RelType: network.RelationType_FRIEND, Properties: map[string]any{"id": "gr3"}}})
+	assert.Error(t, err)
+	_, _, _, _, err = s.GetRelationByID(ctx, "gr3")
+	assert.ErrorIs(t, err, neo4jdal.ErrNotFound)
+
+	// 未注册的关系类型在写入任何节点前被拒绝
+	_, _, err = s.BatchCreateGraph(ctx,
+		[]neo4jdal.BatchNodeInput{{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "g3"}}},
+		[]neo4jdal.BatchRelationInput{{SourceID: "g3", TargetID: "g1", RelType: network.RelationType(999)}})
+	assert.Error(t, err)
+	node, _, err := s.GetNodeByID(ctx, "g3")
+	require.NoError(t, err)
+	assert.Nil(t, node.Props, "被拒绝的批次不应写入节点")
+}
+
+func TestMemoryStore_SearchNodes(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+	seedGraph(t, s)
+
+	// name 使用 CONTAINS，按 name 排序
+	nodes, _, total, _, err := s.SearchNodes(ctx, map[string]string{"name": "Ali"}, nil, nil, nil, nil, 10, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, nodes, 2)
+	assert.Equal(t, "p1", nodes[0].Props["id"])
+	assert.Equal(t, "p4", nodes[1].Props["id"])
+
+	// 其他属性精确匹配 + 类型过滤
+	person := network.NodeType_PERSON
+	nodes, _, total, _, err = s.SearchNodes(ctx, map[string]string{"profession": "engineer"}, nil, nil, nil, &person, 1, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, nodes, 1)
+	assert.Equal(t, "Bob", nodes[0].Props["name"])
+
+	// 游标分页忽略 offset，总数不受影响
+	nodes, _, total, _, err = s.SearchNodes(ctx, map[string]string{"profession": "engineer"}, nil, nil, nil, &person, 10, 5, &neo4jdal.NodeKeyset{Name: "Bob", ID: "p2"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, nodes, 1)
+	assert.Equal(t, "Carol", nodes[0].Props["name"])
+
+	// 没有 name 的节点排在最前，游标分页时不会丢失
+	_, err = s.BatchCreateNodes(ctx, []neo4jdal.BatchNodeInput{{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "p0", "profession": "engineer"}}})
+	require.NoError(t, err)
+	nodes, _, _, _, err = s.SearchNodes(ctx, map[string]string{"profession": "engineer"}, nil, nil, nil, &person, 1, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	assert.Equal(t, "p0", nodes[0].Props["id"])
+	nodes, _, total, _, err = s.SearchNodes(ctx, map[string]string{"profession": "engineer"}, nil, nil, nil, &person, 10, 0, &neo4jdal.NodeKeyset{Name: "", ID: "p0"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	assert.Len(t, nodes, 2)
+}
+
+func TestMemoryStore_SearchNodesFilter(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+	nodes := []neo4jdal.BatchNodeInput{
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "p1", "name": "Alice", "age": int64(30), "city": "Beijing"}},
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "p2", "name": "Bob", "age": 41.5, "city": "Shanghai"}},
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "p3", "name": "Carol", "age": "unknown", "tags": []string{"go"}}},
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "p4", "name": "alfred"}},
+	}
+	_, err := s.BatchCreateNodes(ctx, nodes)
+	require.NoError(t, err)
+
+	str := func(v string) *network.PropertyValue {
+		return &network.PropertyValue{Kind: network.PropertyValueKind_STRING, StringValue: &v}
+	}
+	num := func(v int64) *network.PropertyValue {
+		return &network.PropertyValue{Kind: network.PropertyValueKind_INT, IntValue: &v}
+	}
+	cond := func(field string, op network.FilterOp, v *network.PropertyValue) *network.FilterCondition {
+		return &network.FilterCondition{Field: field, Op: op, Value: v}
+	}
+	ci := true
+	or := network.FilterLogic_OR
+
+	cases := []struct {
+		name string
+		expr *network.FilterExpr
+		want []string
+	}{
+		{"数值范围 (整数与浮点混合比较，非数值不匹配)", &network.FilterExpr{Conditions: []*network.FilterCondition{cond("age", network.FilterOp_GT, num(35))}}, []string{"p2"}},
+		{"前缀忽略大小写", &network.FilterExpr{Conditions: []*network.FilterCondition{{Field: "name", Op: network.FilterOp_STARTS_WITH, Value: str("AL"), CaseInsensitive: &ci}}}, []string{"p1", "p4"}},
+		{"后缀区分大小写", &network.FilterExpr{Conditions: []*network.FilterCondition{cond("name", network.FilterOp_ENDS_WITH, str("ob"))}}, []string{"p2"}},
+		{"IN", &network.FilterExpr{Conditions: []*network.FilterCondition{{Field: "city", Op: network.FilterOp_IN, Values: []*network.PropertyValue{str("Shanghai"), str("Shenzhen")}}}}, []string{"p2"}},
+		{"NE 不匹配缺失的属性", &network.FilterExpr{Conditions: []*network.FilterCondition{cond("city", network.FilterOp_NE, str("Beijing"))}}, []string{"p2"}},
+		{"不存在", &network.FilterExpr{Conditions: []*network.FilterCondition{{Field: "city", Op: network.FilterOp_NOT_EXISTS}}}, []string{"p3", "p4"}},
+		{"OR 分组", &network.FilterExpr{Logic: &or, Conditions: []*network.FilterCondition{
+			{Field: "tags", Op: network.FilterOp_EXISTS},
+			cond("age", network.FilterOp_LTE, num(30)),
+		}}, []string{"p1", "p3"}},
+		{"AND 嵌套 OR", &network.FilterExpr{
+			Conditions: []*network.FilterCondition{{Field: "city", Op: network.FilterOp_EXISTS}},
+			Groups: []*network.FilterExpr{{Logic: &or, Conditions: []*network.FilterCondition{
+				cond("name", network.FilterOp_CONTAINS, str("li")),
+				cond("age", network.FilterOp_GTE, num(100)),
+			}}},
+		}, []string{"p1"}},
+	}
+	for _, tc := range cases {
+		got, _, total, _, err := s.SearchNodes(ctx, nil, tc.expr, nil, nil, nil, 10, 0, nil)
+		require.NoError(t, err, tc.name)
+		assert.Equal(t, tc.want, propIDs(got), tc.name)
+		assert.Equal(t, int64(len(tc.want)), total, tc.name)
+	}
+}
+
+func TestMemoryStore_SearchNodesSort(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+	nodes := []neo4jdal.BatchNodeInput{
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "p1", "name": "Alice", "created_at": day(3), "age": int64(30)}},
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "p2", "name": "Bob", "created_at": day(1), "age": 25.5}},
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "p3", "name": "Carol", "created_at": day(2), "age": "unknown"}},
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "p4", "name": "Dave", "created_at": day(2)}},
+	}
+	_, err := s.BatchCreateNodes(ctx, nodes)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name string
+		keys []neo4jdal.SortKey
+		want []string
+	}{
+		{"时间降序，相同时按 id", []neo4jdal.SortKey{{Field: "created_at", Desc: true}}, []string{"p1", "p3", "p4", "p2"}},
+		{"多键排序", []neo4jdal.SortKey{{Field: "created_at"}, {Field: "name", Desc: true}}, []string{"p2", "p4", "p3", "p1"}},
+		{"升序时字符串排在数值前，null 最后", []neo4jdal.SortKey{{Field: "age"}}, []string{"p3", "p2", "p1", "p4"}},
+		{"降序时 null 最前", []neo4jdal.SortKey{{Field: "age", Desc: true}}, []string{"p4", "p1", "p2", "p3"}},
+	}
+	for _, tc := range cases {
+		got, _, total, _, err := s.SearchNodes(ctx, nil, nil, tc.keys, nil, nil, 10, 0, nil)
+		require.NoError(t, err, tc.name)
+		assert.Equal(t, tc.want, propIDs(got), tc.name)
+		assert.Equal(t, int64(4), total, tc.name)
+	}
+
+	// 自定义排序使用偏移量分页
+	got, _, _, _, err := s.SearchNodes(ctx, nil, nil, cases[0].keys, nil, nil, 2, 2, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"p4", "p2"}, propIDs(got))
+	_, _, _, _, err = s.SearchNodes(ctx, nil, nil, cases[0].keys, nil, nil, 2, 0, &neo4jdal.NodeKeyset{Name: "Alice", ID: "p1"})
+	assert.Error(t, err, "自定义排序不支持 keyset 游标")
+}
+
+func TestMemoryStore_SearchNodesFacets(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+	nodes := []neo4jdal.BatchNodeInput{
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "p1", "name": "Alice", "level": int64(3)}},
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "p2", "name": "Bob", "level": 3.0}},
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "p3", "name": "Carol", "level": int64(1)}},
+		{NodeType: network.NodeType_COMPANY, Properties: map[string]any{"id": "c1", "name": "Acme"}},
+	}
+	_, err := s.BatchCreateNodes(ctx, nodes)
+	require.NoError(t, err)
+
+	facets := &neo4jdal.FacetRequest{Fields: []string{"type", "level"}, Size: 10}
+	page, _, total, got, err := s.SearchNodes(ctx, nil, nil, nil, facets, nil, 1, 0, nil)
+	require.NoError(t, err)
+	assert.Len(t, page, 1, "分面统计不受分页影响")
+	assert.Equal(t, int64(4), total)
+	assert.Equal(t, []neo4jdal.Facet{
+		{Field: "type", Counts: []neo4jdal.FacetCount{{Value: "PERSON", Count: 3}, {Value: "COMPANY", Count: 1}}},
+		{Field: "level", Counts: []neo4jdal.FacetCount{{Value: int64(3), Count: 2}, {Value: int64(1), Count: 1}}},
+	}, got, "整数与相等的浮点数合并，缺失属性的节点不计入")
+
+	facets.Size = 1
+	person := network.NodeType_PERSON
+	_, _, _, got, err = s.SearchNodes(ctx, map[string]string{"name": "Carol"}, nil, nil, facets, &person, 10, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []neo4jdal.FacetCount{{Value: int64(1), Count: 1}}, got[1].Counts)
+
+	// type 分面先按 Labels 过滤再截取，未列出的标签不占用名额
+	labeled := &neo4jdal.FacetRequest{Fields: []string{"type"}, Size: 1, Labels: []string{"COMPANY"}}
+	_, _, _, got, err = s.SearchNodes(ctx, nil, nil, nil, labeled, nil, 10, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []neo4jdal.FacetCount{{Value: "COMPANY", Count: 1}}, got[0].Counts)
+
+	_, _, total, got, err = s.SearchNodes(ctx, map[string]string{"name": "Nobody"}, nil, nil, facets, nil, 10, 0, nil)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+	assert.Equal(t, []neo4jdal.Facet{{Field: "type", Counts: []neo4jdal.FacetCount{}}, {Field: "level", Counts: []neo4jdal.FacetCount{}}}, got)
+
+	_, _, _, got, err = s.SearchNodes(ctx, nil, nil, nil, nil, nil, 10, 0, nil)
+	require.NoError(t, err)
+	assert.Nil(t, got, "未请求分面时不统计")
+}
+
+func TestMemoryStore_GetNodeRelationsSort(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+	seedGraph(t, s)
+	for id, d := range map[string]int{"r1": 2, "r4": 1} {
+		_, _, _, _, err := s.UpdateRelation(ctx, id, map[string]any{"since": time.Date(2020, 1, d, 0, 0, 0, 0, time.UTC)})
+		require.NoError(t, err)
+	}
+
+	// 最近建立的关系在前，没有 since 的关系 (null) 在降序时排在最前
+	got, _, _, _, total, err := s.GetNodeRelations(ctx, "p1", nil, true, true, []neo4jdal.SortKey{{Field: "since", Desc: true}}, 10, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, got, 2)
+	assert.Equal(t, "r1", got[0].Props["id"])
+	assert.Equal(t, "r4", got[1].Props["id"])
+
+	got, _, _, _, _, err = s.GetNodeRelations(ctx, "p2", nil, true, true, []neo4jdal.SortKey{{Field: "since", Desc: true}}, 10, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "r2", got[0].Props["id"], "since 缺失")
+	assert.Equal(t, "r1", got[1].Props["id"])
+
+	// keyset 游标: 从 (since, id) 位置之后继续，null 在降序时排在最前
+	bySince := []neo4jdal.SortKey{{Field: "since", Desc: true}}
+	got, _, _, _, _, err = s.GetNodeRelations(ctx, "p2", nil, true, true, bySince, 10, 0, &neo4jdal.RelationKeyset{ID: "r2", Values: []any{nil}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"r1"}, relIDs(got))
+	got, _, _, _, _, err = s.GetNodeRelations(ctx, "p1", nil, true, true, bySince, 10, 0, &neo4jdal.RelationKeyset{ID: "r1", Values: []any{time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"r4"}, relIDs(got))
+	_, _, _, _, _, err = s.GetNodeRelations(ctx, "p1", nil, true, true, bySince, 10, 0, &neo4jdal.RelationKeyset{ID: "r1"})
+	assert.ErrorIs(t, err, neo4jdal.ErrInvalidSort, "游标缺少排序键取值")
+}
+
+func TestMemoryStore_FulltextSearchNodes(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+	nodes := []neo4jdal.BatchNodeInput{
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "p1", "name": "Alice Smith", "profession": "Engineer"}},
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "p2", "name": "Bob", "profession": "Software Engineer"}},
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "p3", "name": "张三", "city": "engineer"}},
+		{NodeType: network.NodeType_COMPANY, Properties: map[string]any{"id": "c1", "name": "Alice Corp"}},
+	}
+	_, err := s.BatchCreateNodes(ctx, nodes)
+	require.NoError(t, err)
+
+	// 两个词都命中的节点排在前面；未在索引中的属性 (city) 不参与匹配
+	hits, total, err := s.FulltextSearchNodes(ctx, "alice engineer", false, nil, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	require.Len(t, hits, 3)
+	assert.Equal(t, "p1", hits[0].Node.Props["id"])
+	assert.Equal(t, 2.0, hits[0].Score)
+	assert.Equal(t, []string{"c1", "p2"}, propIDs([]dbtype.Node{hits[1].Node, hits[2].Node}), "同分按 id 排序")
+
+	person := network.NodeType_PERSON
+	hits, total, err = s.FulltextSearchNodes(ctx, "alise", true, &person, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, 0.5, hits[0].Score, "模糊命中计 0.5 分")
+
+	hits, _, err = s.FulltextSearchNodes(ctx, "三", false, nil, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "p3", hits[0].Node.Props["id"])
+
+	_, _, err = s.FulltextSearchNodes(ctx, "!!", false, nil, 10, 0)
+	assert.ErrorIs(t, err, fulltext.ErrEmptyQuery)
+}
+
+func TestMemoryStore_GetNetwork(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+	seedGraph(t, s)
+
+	t.Run("depth 0 returns start nodes only", func(t *testing.T) {
+		nodes, rels, truncated, err := s.GetNetwork(ctx, map[string]string{"profession": "engineer"}, 0, 1, 0, 0, nil, nil, neo4jdal.Traversal{})
+		require.NoError(t, err)
+		assert.Len(t, nodes, 1)
+		assert.Empty(t, rels)
+		assert.True(t, truncated)
+	})
+
+	t.Run("depth 1", func(t *testing.T) {
+		nodes, rels, truncated, err := s.GetNetwork(ctx, map[string]string{"id": "p1"}, 1, 100, 0, 0, nil, nil, neo4jdal.Traversal{})
+		require.NoError(t, err)
+		assert.False(t, truncated)
+		assert.Equal(t, []string{"c1", "p1", "p2"}, propIDs(nodes))
+		assert.Len(t, rels, 2)
+	})
+
+	t.Run("depth 2 with relation and node type filters", func(t *testing.T) {
+		nodes, rels, _, err := s.GetNetwork(ctx, map[string]string{"id": "p1"}, 2,
+			100, 0, 0,
+			[]network.RelationType{network.RelationType_FRIEND, network.RelationType_COLLEAGUE},
+			[]network.NodeType{network.NodeType_PERSON}, neo4jdal.Traversal{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"p1", "p2", "p3"}, propIDs(nodes))
+		assert.Len(t, rels, 2)
+	})
+
+	t.Run("direction", func(t *testing.T) {
+		// p1 -FRIEND-> p2 -COLLEAGUE-> p3
+		nodes, _, _, err := s.GetNetwork(ctx, map[string]string{"id": "p2"}, 1, 100, 0, 0, nil, nil, neo4jdal.Traversal{Direction: network.Direction_OUTGOING})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"p2", "p3"}, propIDs(nodes))
+
+		nodes, _, _, err = s.GetNetwork(ctx, map[string]string{"id": "p2"}, 1, 100, 0, 0, nil, nil, neo4jdal.Traversal{Direction: network.Direction_INCOMING})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"p1", "p2"}, propIDs(nodes))
+
+		nodes, _, _, err = s.GetNetwork(ctx, map[string]string{"id": "p2"}, 1, 100, 0, 0, nil, nil,
+			neo4jdal.Traversal{TypeDirections: map[string]network.Direction{"COLLEAGUE": network.Direction_INCOMING}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"p1", "p2"}, propIDs(nodes), "按类型覆盖方向")
+	})
+
+	t.Run("paging and relation cap mark truncated", func(t *testing.T) {
+		nodes, rels, truncated, err := s.GetNetwork(ctx, map[string]string{"id": "p1"}, 3, 2, 0, 0, nil, nil, neo4jdal.Traversal{})
+		require.NoError(t, err)
+		assert.True(t, truncated)
+		assert.Equal(t, []string{"c1", "p1"}, propIDs(nodes))
+		require.Len(t, rels, 1, "p1-p2 的 p2 在下一页，留到下一页返回")
+		assert.Equal(t, "r4", rels[0].Props["id"])
+
+		// 跨页关系 r1 (p1-p2) 在 p2 所在的页返回，逐页读取时每条关系恰好出现一次
+		nodes, rels, truncated, err = s.GetNetwork(ctx, map[string]string{"id": "p1"}, 3, 2, 2, 0, nil, nil, neo4jdal.Traversal{})
+		require.NoError(t, err)
+		assert.True(t, truncated)
+		assert.Equal(t, []string{"p2", "p3"}, propIDs(nodes))
+		assert.ElementsMatch(t, []string{"r1", "r2"}, relIDs(rels))
+
+		nodes, rels, truncated, err = s.GetNetwork(ctx, map[string]string{"id": "p1"}, 3, 2, 4, 0, nil, nil, neo4jdal.Traversal{})
+		require.NoError(t, err)
+		assert.False(t, truncated)
+		assert.Equal(t, []string{"p4"}, propIDs(nodes))
+		require.Len(t, rels, 1)
+		assert.Equal(t, "r3", rels[0].Props["id"])
+
+		_, rels, truncated, err = s.GetNetwork(ctx, map[string]string{"id": "p1"}, 3, 100, 0, 1, nil, nil, neo4jdal.Traversal{})
+		require.NoError(t, err)
+		assert.True(t, truncated)
+		assert.Len(t, rels, 1)
+	})
+
+	t.Run("relations reference returned node ids", func(t *testing.T) {
+		nodes, rels, _, err := s.GetNetwork(ctx, map[string]string{"id": "p2"}, 1, 100, 0, 0, nil, nil, neo4jdal.Traversal{})
+		require.NoError(t, err)
+		ids := make(map[int64]bool)
+		for _, n := range nodes {
+			ids[n.Id] = true
+		}
+		for _, r := range rels {
+			assert.True(t, ids[r.StartId] && ids[r.EndId])
+		}
+	})
+}
+
+func TestMemoryStore_GetPaths(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+	seedGraph(t, s)
+
+	paths, err := s.GetPaths(ctx, neo4jdal.PathQuery{SourceID: "c1", TargetID: "p4", MaxDepth: 5})
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+	assert.Equal(t, []string{"c1", "p1", "p2", "p3", "p4"}, propIDs(paths[0].Nodes))
+	rels := paths[0].Relationships
+	require.Len(t, rels, 4)
+	assert.Equal(t, "r4", rels[0].Props["id"])
+	assert.Equal(t, "r3", rels[3].Props["id"])
+
+	paths, err = s.GetPaths(ctx, neo4jdal.PathQuery{SourceID: "c1", TargetID: "p4", MaxDepth: 3})
+	require.NoError(t, err)
+	assert.Empty(t, paths, "超过最大深度时没有路径")
+
+	paths, err = s.GetPaths(ctx, neo4jdal.PathQuery{SourceID: "p1", TargetID: "p4", MaxDepth: 5, RelTypes: []string{"FRIEND"}})
+	require.NoError(t, err)
+	assert.Empty(t, paths, "COLLEAGUE 关系被过滤后不连通")
+
+	// p3 -VISITED-> c1 之后 p1 到 p3 有两条两跳的路径
+	_, err = s.CreateRelation(ctx, "p3", "c1", network.RelationType_VISITED, map[string]any{"id": "r6"})
+	require.NoError(t, err)
+
+	paths, err = s.GetPaths(ctx, neo4jdal.PathQuery{SourceID: "p1", TargetID: "p3", MaxDepth: 5, Mode: network.PathMode_ALL_SHORTEST})
+	require.NoError(t, err)
+	require.Len(t, paths, 2)
+	assert.Equal(t, []string{"p1", "c1", "p3"}, propIDs(paths[0].Nodes), "按节点业务 id 排序")
+	assert.Equal(t, []string{"p1", "p2", "p3"}, propIDs(paths[1].Nodes))
+
+	paths, err = s.GetPaths(ctx, neo4jdal.PathQuery{SourceID: "p2", TargetID: "c1", MaxDepth: 5, Mode: network.PathMode_K_SHORTEST, K: 3})
+	require.NoError(t, err)
+	require.Len(t, paths, 2, "只有两条无环路径")
+	assert.Len(t, paths[0].Relationships, 2)
+	assert.Len(t, paths[1].Relationships, 2)
+
+	paths, err = s.GetPaths(ctx, neo4jdal.PathQuery{SourceID: "p2", TargetID: "p4", MaxDepth: 5, Mode: network.PathMode_K_SHORTEST, K: 1})
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+	assert.Equal(t, []string{"p2", "p3", "p4"}, propIDs(paths[0].Nodes))
+
+	// 加权: r1 (p1-p2) 代价 5，其他关系使用缺省代价
+	_, _, _, _, err = s.UpdateRelation(ctx, "r1", map[string]any{"weight": 5.0})
+	require.NoError(t, err)
+	paths, err = s.GetPaths(ctx, neo4jdal.PathQuery{SourceID: "p1", TargetID: "p3", MaxDepth: 5, Mode: network.PathMode_WEIGHTED, DefaultWeight: 1})
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+	assert.Equal(t, []string{"p1", "c1", "p3"}, propIDs(paths[0].Nodes))
+	assert.Equal(t, 2.0, paths[0].Cost)
+
+	paths, err = s.GetPaths(ctx, neo4jdal.PathQuery{SourceID: "p1", TargetID: "p3", MaxDepth: 5, Mode: network.PathMode_WEIGHTED, DefaultWeight: 10})
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+	assert.Equal(t, []string{"p1", "p2", "p3"}, propIDs(paths[0].Nodes), "缺省代价较高时经过有 weight 的关系")
+	assert.Equal(t, 15.0, paths[0].Cost)
+
+	// 超出搜索预算时返回 ErrPathSearchTooLarge
+	budget := neo4jdal.PathSearchBudget
+	neo4jdal.PathSearchBudget.MaxNodes = 1
+	_, err = s.GetPaths(ctx, neo4jdal.PathQuery{SourceID: "p1", TargetID: "p3", MaxDepth: 5, Mode: network.PathMode_WEIGHTED, DefaultWeight: 1})
+	neo4jdal.PathSearchBudget = budget
+	assert.ErrorIs(t, err, neo4jdal.ErrPathSearchTooLarge)
+
+	// 有向: p4 沿关系方向无法到达 p1 (p1->p2->p3->p4)，逆向可以
+	paths, err = s.GetPaths(ctx, neo4jdal.PathQuery{SourceID: "p4", TargetID: "p1", MaxDepth: 5, Traversal: neo4jdal.Traversal{Direction: network.Direction_OUTGOING}})
+	require.NoError(t, err)
+	assert.Empty(t, paths)
+	paths, err = s.GetPaths(ctx, neo4jdal.PathQuery{SourceID: "p4", TargetID: "p1", MaxDepth: 5, Traversal: neo4jdal.Traversal{Direction: network.Direction_INCOMING}})
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+	assert.Equal(t, []string{"p4", "p3", "p2", "p1"}, propIDs(paths[0].Nodes))
+
+	// 节点约束
+	paths, err = s.GetPaths(ctx, neo4jdal.PathQuery{SourceID: "p1", TargetID: "p3", MaxDepth: 5, Mode: network.PathMode_ALL_SHORTEST, ExcludeNodeIDs: []string{"c1"}})
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+	assert.Equal(t, []string{"p1", "p2", "p3"}, propIDs(paths[0].Nodes))
+	paths, err = s.GetPaths(ctx, neo4jdal.PathQuery{SourceID: "p1", TargetID: "p3", MaxDepth: 5, Mode: network.PathMode_ALL_SHORTEST, IntermediateTypes: []string{"PERSON"}})
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+	assert.Equal(t, []string{"p1", "p2", "p3"}, propIDs(paths[0].Nodes), "c1 不是 PERSON")
+	paths, err = s.GetPaths(ctx, neo4jdal.PathQuery{SourceID: "p1", TargetID: "p3", MaxDepth: 5, Mode: network.PathMode_ALL_SHORTEST, ViaNodeIDs: []string{"c1"}, IntermediateTypes: []string{"PERSON"}})
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+	assert.Equal(t, []string{"p1", "c1", "p3"}, propIDs(paths[0].Nodes), "必须经过的节点不受类型限制")
+
+	paths, err = s.GetPaths(ctx, neo4jdal.PathQuery{SourceID: "p2", TargetID: "p4", MaxDepth: 5, ViaNodeIDs: []string{"c1"}})
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+	assert.Equal(t, []string{"p2", "p1", "c1", "p3", "p4"}, propIDs(paths[0].Nodes))
+	paths, err = s.GetPaths(ctx, neo4jdal.PathQuery{SourceID: "p2", TargetID: "p4", MaxDepth: 5, Mode: network.PathMode_K_SHORTEST, K: 3, ViaNodeIDs: []string{"c1"}})
+	require.NoError(t, err)
+	assert.Len(t, paths, 1, "p2-p3-c1 之后无法不重复经过 p3 到达 p4")
+	paths, err = s.GetPaths(ctx, neo4jdal.PathQuery{SourceID: "p2", TargetID: "p4", MaxDepth: 3, ViaNodeIDs: []string{"c1"}})
+	require.NoError(t, err)
+	assert.Empty(t, paths, "超过最大深度")
+	paths, err = s.GetPaths(ctx, neo4jdal.PathQuery{SourceID: "p2", TargetID: "p4", MaxDepth: 5, ViaNodeIDs: []string{"missing"}})
+	require.NoError(t, err)
+	assert.Empty(t, paths, "必须经过的节点不存在")
+}
+
+func TestMemoryStore_GetRecommendationCandidates(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+	seedGraph(t, s)
+	_, err := s.BatchCreateNodes(ctx, []neo4jdal.BatchNodeInput{
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "p5"}},
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "p6"}},
+	})
+	require.NoError(t, err)
+	_, err = s.BatchCreateRelations(ctx, []neo4jdal.BatchRelationInput{
+		{SourceID: "p5", TargetID: "p2", RelType: network.RelationType_FRIEND, Properties: map[string]any{"id": "r6"}},
+		{SourceID: "p5", TargetID: "c1", RelType: network.RelationType_VISITED, Properties: map[string]any{"id": "r7"}},
+		{SourceID: "p2", TargetID: "p6", RelType: network.RelationType_FOLLOWING, Properties: map[string]any{"id": "r8"}},
+		{SourceID: "p1", TargetID: "p4", RelType: network.RelationType_FRIEND, Properties: map[string]any{"id": "r9"}},
+	})
+	require.NoError(t, err)
+
+	q := neo4jdal.RecommendationQuery{
+		NodeID:          "p1",
+		ConnectionTypes: []string{"FRIEND", "COLLEAGUE", "SCHOOLMATE"},
+		PersonLabel:     "PERSON",
+		OrgLabels:       []string{"COMPANY", "SCHOOL"},
+	}
+	got, err := s.GetRecommendationCandidates(ctx, q)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"p2", "p4"}, got.Contacts)
+	assert.Equal(t, []string{"c1"}, got.Orgs)
+	// p4 已直接相连；p6 只经过非联系关系 (FOLLOWING) 可达
+	assert.Equal(t, []recommend.Candidate{
+		{ID: "p3", Contacts: 2, Mutuals: []recommend.Common{{ID: "p2", Degree: 4}, {ID: "p4", Degree: 2}}},
+		{ID: "p5", Contacts: 1, Mutuals: []recommend.Common{{ID: "p2", Degree: 4}}, SharedOrgs: []recommend.Common{{ID: "c1", Degree: 2}}},
+	}, got.Candidates)
+
+	q.MaxCandidates = 1
+	got, err = s.GetRecommendationCandidates(ctx, q)
+	require.NoError(t, err)
+	require.Len(t, got.Candidates, 1)
+	assert.Equal(t, "p3", got.Candidates[0].ID, "共同邻居数相同时按 id 排序")
+
+	q.MaxCandidates = 0
+	q.MaxCommonDegree = 3
+	got, err = s.GetRecommendationCandidates(ctx, q)
+	require.NoError(t, err)
+	assert.Equal(t, []recommend.Candidate{
+		{ID: "p3", Contacts: 2, Mutuals: []recommend.Common{{ID: "p4", Degree: 2}}},
+		{ID: "p5", Contacts: 1, SharedOrgs: []recommend.Common{{ID: "c1", Degree: 2}}},
+	}, got.Candidates, "关系数超过上限的 p2 不再展开")
+
+	q.NodeID = "c1"
+	_, err = s.GetRecommendationCandidates(ctx, q)
+	assert.ErrorIs(t, err, neo4jdal.ErrNotPerson)
+
+	q.NodeID = "missing"
+	_, err = s.GetRecommendationCandidates(ctx, q)
+	assert.ErrorIs(t, err, neo4jdal.ErrNotFound)
+}
+
+func TestMemoryStore_Relations(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+	seedGraph(t, s)
+
+	_, err := s.CreateRelation(ctx, "p1", "missing", network.RelationType_FRIEND, map[string]any{"id": "rx"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "可能是节点不存在")
+
+	rel, typ, src, tgt, err := s.GetRelationByID(ctx, "r2")
+	require.NoError(t, err)
+	assert.Equal(t, "COLLEAGUE", typ)
+	assert.Equal(t, "p2", src)
+	assert.Equal(t, "p3", tgt)
+	assert.Equal(t, "COLLEAGUE", rel.Type)
+
+	rels, _, _, _, err := s.GetRelationsByIDs(ctx, []string{"r1", "r3", "nope"})
+	require.NoError(t, err)
+	assert.Len(t, rels, 2)
+
+	updated, _, _, _, err := s.UpdateRelation(ctx, "r2", map[string]any{"label": "team"})
+	require.NoError(t, err)
+	assert.Equal(t, "team", updated.Props["label"])
+	assert.Contains(t, updated.Props, "updated_at")
+
+	// 方向过滤
+	_, _, _, _, total, err := s.GetNodeRelations(ctx, "p2", nil, true, false, nil, 10, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	_, _, _, _, total, err = s.GetNodeRelations(ctx, "p2", nil, false, true, nil, 10, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	_, _, _, _, total, err = s.GetNodeRelations(ctx, "p2", nil, false, false, nil, 10, 0, nil)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+
+	// 类型过滤 + 游标分页
+	got, _, sources, targets, total, err := s.GetNodeRelations(ctx, "p1", []string{"FRIEND", "VISITED"}, true, true, nil, 10, 0, &neo4jdal.RelationKeyset{ID: "r1"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, got, 1)
+	assert.Equal(t, "r4", got[0].Props["id"])
+	assert.Equal(t, "p1", sources[0])
+	assert.Equal(t, "c1", targets[0])
+
+	deleted, err := s.DeleteRelation(ctx, "r2")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"p2", "p3"}, deleted.NodeIDs)
+	_, err = s.DeleteRelation(ctx, "r2")
+	assert.ErrorIs(t, err, neo4jdal.ErrNotFound)
+}
+
+func TestMemoryStore_DynamicTypes(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+	registry := typeregistry.Default()
+
+	// 动态类型注册到进程级注册表，名称在本测试内唯一
+	projectType := network.NodeType(registry.NextNodeTypeCode())
+	require.NoError(t, registry.PutNodeType(typeregistry.NodeTypeDef{Name: "MEMTEST_PROJECT", Code: projectType}))
+	worksOn := registry.NextRelationTypeCode()
+	require.NoError(t, registry.PutRelationType(typeregistry.RelationTypeDef{
+		Name:         "MEMTEST_WORKS_ON",
+		Code:         worksOn,
+		AllowedPairs: []typeregistry.TypePair{{Source: "PERSON", Target: "MEMTEST_PROJECT"}},
+	}))
+
+	_, err := s.BatchCreateNodes(ctx, []neo4jdal.BatchNodeInput{
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "p1", "name": "Alice"}},
+		{NodeType: network.NodeType_COMPANY, Properties: map[string]any{"id": "c1", "name": "Acme"}},
+	})
+	require.NoError(t, err)
+	project, err := s.CreateNode(ctx, projectType, map[string]any{"id": "x1", "name": "Apollo"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"MEMTEST_PROJECT"}, project.Labels)
+
+	_, err = s.CreateNode(ctx, network.NodeType(9999), map[string]any{"id": "bad", "name": "bad"})
+	assert.Error(t, err, "未注册的节点类型应被拒绝")
+
+	nodes, _, total, _, err := s.SearchNodes(ctx, map[string]string{}, nil, nil, nil, &projectType, 10, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, []string{"x1"}, propIDs(nodes))
+
+	// 关系类型只允许 PERSON -> MEMTEST_PROJECT
+	rel, err := s.CreateRelation(ctx, "p1", "x1", worksOn, map[string]any{"id": "w1"})
+	require.NoError(t, err)
+	assert.Equal(t, "MEMTEST_WORKS_ON", rel.Type)
+	_, err = s.CreateRelation(ctx, "p1", "c1", worksOn, map[string]any{"id": "w2"})
+	assert.ErrorIs(t, err, neo4jdal.ErrRelationNotAllowed)
+
+	created, err := s.BatchCreateRelations(ctx, []neo4jdal.BatchRelationInput{
+		{SourceID: "x1", TargetID: "p1", RelType: worksOn, Properties: map[string]any{"id": "w3"}},
+		{SourceID: "p1", TargetID: "x1", RelType: worksOn, Properties: map[string]any{"id": "w4"}},
+		{SourceID: "p1", TargetID: "c1", RelType: network.RelationType_VISITED, Properties: map[string]any{"id": "w5"}},
+	})
+	require.NoError(t, err)
+	assert.Len(t, created, 2)
+	assert.NotContains(t, created, 0, "方向不被允许的关系应被跳过")
+}
+
+func TestMemoryStore_TypeDefs(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+
+	nodeDefs, relDefs, err := s.ListTypeDefs(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, nodeDefs)
+	assert.Empty(t, relDefs)
+
+	require.NoError(t, s.SaveNodeTypeDef(ctx, typeregistry.NodeTypeDef{Name: "PROJECT", Code: 5}))
+	require.NoError(t, s.SaveNodeTypeDef(ctx, typeregistry.NodeTypeDef{Name: "EVENT", Code: 4}))
+	pairs := []typeregistry.TypePair{{Source: "PERSON", Target: "PROJECT"}}
+	props := []typeregistry.PropertySchema{{Key: "role", Type: typeregistry.ValueEnum, EnumValues: []string{"owner", "member"}}}
+	require.NoError(t, s.SaveRelationTypeDef(ctx, typeregistry.RelationTypeDef{Name: "WORKS_ON", Code: 6, AllowedPairs: pairs, Properties: props}))
+	pairs[0].Target = "EVENT" // 存储保存的是副本
+	props[0].EnumValues[0] = "admin"
+
+	nodeDefs, relDefs, err = s.ListTypeDefs(ctx)
+	require.NoError(t, err)
+	require.Len(t, nodeDefs, 2)
+	assert.Equal(t, "EVENT", nodeDefs[0].Name, "按编码排序")
+	assert.Equal(t, "PROJECT", nodeDefs[1].Name)
+	require.Len(t, relDefs, 1)
+	assert.Equal(t, []typeregistry.TypePair{{Source: "PERSON", Target: "PROJECT"}}, relDefs[0].AllowedPairs)
+	assert.Equal(t, []string{"owner", "member"}, relDefs[0].Properties[0].EnumValues)
+
+	// 按名称覆盖
+	require.NoError(t, s.SaveRelationTypeDef(ctx, typeregistry.RelationTypeDef{Name: "WORKS_ON", Code: 6}))
+	_, relDefs, err = s.ListTypeDefs(ctx)
+	require.NoError(t, err)
+	require.Len(t, relDefs, 1)
+	assert.Empty(t, relDefs[0].AllowedPairs)
+	assert.Empty(t, relDefs[0].Properties)
+}
+
+func TestMemoryStore_CreateTypeDefs(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+
+	require.NoError(t, s.SaveNodeTypeDef(ctx, typeregistry.NodeTypeDef{Name: "PROJECT", Code: 5}))
+
+	// 编码不小于下限，且大于已有的编码
+	def, err := s.CreateNodeTypeDef(ctx, typeregistry.NodeTypeDef{Name: "EVENT", Code: 4})
+	require.NoError(t, err)
+	assert.Equal(t, network.NodeType(6), def.Code)
+	def, err = s.CreateNodeTypeDef(ctx, typeregistry.NodeTypeDef{Name: "TEAM", Code: 10})
+	require.NoError(t, err)
+	assert.Equal(t, network.NodeType(10), def.Code)
+
+	_, err = s.CreateNodeTypeDef(ctx, typeregistry.NodeTypeDef{Name: "EVENT", Code: 4})
+	assert.ErrorIs(t, err, neo4jdal.ErrTypeDefExists)
+
+	rel, err := s.CreateRelationTypeDef(ctx, typeregistry.RelationTypeDef{Name: "WORKS_ON", Code: 3})
+	require.NoError(t, err)
+	assert.Equal(t, network.RelationType(3), rel.Code)
+	rel, err = s.CreateRelationTypeDef(ctx, typeregistry.RelationTypeDef{Name: "OWNS", Code: 3})
+	require.NoError(t, err)
+	assert.Equal(t, network.RelationType(4), rel.Code)
+	_, err = s.CreateRelationTypeDef(ctx, typeregistry.RelationTypeDef{Name: "OWNS", Code: 3})
+	assert.ErrorIs(t, err, neo4jdal.ErrTypeDefExists)
+}
@@ -0,0 +1,233 @@
+package storage
+
+import (
+	"context"
+	"slices"
+
+	"labelwall/biz/dal/neo4jdal"
+	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/typeregistry"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+// neo4jStore 基于 Neo4j DAL 实现 GraphStore，每次调用打开一个 session。
+type neo4jStore struct {
+	driver      neo4j.DriverWithContext
+	nodeDAL     neo4jdal.NodeDAL
+	relationDAL neo4jdal.RelationDAL
+	typeDAL     neo4jdal.TypeDAL
+}
+
+// NewNeo4jStore 创建一个基于 Neo4j 的 GraphStore
+func NewNeo4jStore(driver neo4j.DriverWithContext, nodeDAL neo4jdal.NodeDAL, relationDAL neo4jdal.RelationDAL, typeDAL neo4jdal.TypeDAL) GraphStore {
+	return &neo4jStore{
+		driver:      driver,
+		nodeDAL:     nodeDAL,
+		relationDAL: relationDAL,
+		typeDAL:     typeDAL,
+	}
+}
+
+// readSession 打开一个读 session
+func (s *neo4jStore) readSession(ctx context.Context) neo4j.SessionWithContext {
+	return s.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+}
+
+// writeSession 打开一个写 session，并返回附带 events 的包装 (events 为空时即原 session)。
+// 调用方负责关闭返回的第一个 session。
+func (s *neo4jStore) writeSession(ctx context.Context, events []neo4jdal.ChangeEvent) (neo4j.SessionWithContext, neo4j.SessionWithContext) {
+	session := s.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	return session, neo4jdal.WithOutbox(session, events)
+}
+
+func (s *neo4jStore) CreateNode(ctx context.Context, nodeType network.NodeType, properties map[string]any, events ...neo4jdal.ChangeEvent) (dbtype.Node, error) {
+	session, tx := s.writeSession(ctx, events)
+	defer session.Close(ctx)
+	return s.nodeDAL.ExecCreateNode(ctx, tx, nodeType, properties)
+}
+
+func (s *neo4jStore) GetNodeByID(ctx context.Context, id string) (dbtype.Node, []string, error) {
+	session := s.readSession(ctx)
+	defer session.Close(ctx)
+	return s.nodeDAL.ExecGetNodeByID(ctx, session, id)
+}
+
+func (s *neo4jStore) GetNodesByIDs(ctx context.Context, ids []string) ([]dbtype.Node, [][]string, error) {
+	session := s.readSession(ctx)
+	defer session.Close(ctx)
+	return s.nodeDAL.ExecGetNodesByIDs(ctx, session, ids)
+}
+
+func (s *neo4jStore) UpdateNode(ctx context.Context, id string, updates map[string]any, events ...neo4jdal.ChangeEvent) (dbtype.Node, []string, error) {
+	session, tx := s.writeSession(ctx, events)
+	defer session.Close(ctx)
+	return s.nodeDAL.ExecUpdateNode(ctx, tx, id, updates)
+}
+
+func (s *neo4jStore) DeleteNode(ctx context.Context, id string, events ...neo4jdal.ChangeEvent) (neo4jdal.DeleteResult, error) {
+	session, tx := s.writeSession(ctx, events)
+	defer session.Close(ctx)
+	return s.nodeDAL.ExecDeleteNode(ctx, tx, id)
+}
+
+func (s *neo4jStore) BatchCreateNodes(ctx context.Context, nodes []neo4jdal.BatchNodeInput, events ...neo4jdal.ChangeEvent) ([]dbtype.Node, error) {
+	session, tx := s.writeSession(ctx, events)
+	defer session.Close(ctx)
+	return s.nodeDAL.ExecBatchCreateNodes(ctx, tx, nodes)
+}
+
+func (s *neo4jStore) BatchCreateGraph(ctx context.Context, nodes []neo4jdal.BatchNodeInput, rels []neo4jdal.BatchRelationInput, events ...neo4jdal.ChangeEvent) ([]dbtype.Node, map[int]dbtype.Relationship, error) {
+	session, tx := s.writeSession(ctx, events)
+	defer session.Close(ctx)
+	return s.nodeDAL.ExecBatchCreateGraph(ctx, tx, nodes, rels)
+}
+
+func (s *neo4jStore) SearchNodes(ctx context.Context, criteria map[string]string, filter *network.FilterExpr, sortKeys []neo4jdal.SortKey, facets *neo4jdal.FacetRequest, nodeType *network.NodeType, limit, offset int64, after *neo4jdal.NodeKeyset) ([]dbtype.Node, [][]string, int64, []neo4jdal.Facet, error) {
+	session := s.readSession(ctx)
+	defer session.Close(ctx)
+	return s.nodeDAL.ExecSearchNodes(ctx, session, criteria, filter, sortKeys, facets, nodeType, limit, offset, after)
+}
+
+func (s *neo4jStore) GetNetwork(ctx context.Context,
+	startNodeCriteria map[string]string,
+	depth int32,
+	limit, offset int64,
+	maxRelations int64,
+	relationTypes []network.RelationType,
+	nodeTypes []network.NodeType,
+	traversal neo4jdal.Traversal,
+) ([]dbtype.Node, []dbtype.Relationship, bool, error) {
+	session := s.readSession(ctx)
+	defer session.Close(ctx)
+	return s.nodeDAL.ExecGetNetwork(ctx, session, startNodeCriteria, depth, limit, offset, maxRelations, relationTypes, nodeTypes, traversal)
+}
+
+func (s *neo4jStore) GetPaths(ctx context.Context, q neo4jdal.PathQuery) ([]neo4jdal.Path, error) {
+	session := s.readSession(ctx)
+	defer session.Close(ctx)
+	return s.nodeDAL.ExecGetPaths(ctx, session, q)
+}
+
+func (s *neo4jStore) FulltextSearchNodes(ctx context.Context, text string, fuzzy bool, nodeType *network.NodeType, limit, offset int64) ([]neo4jdal.FulltextNodeHit, int64, error) {
+	session := s.readSession(ctx)
+	defer session.Close(ctx)
+	return s.nodeDAL.ExecFulltextSearchNodes(ctx, session, text, fuzzy, nodeType, limit, offset)
+}
+
+func (s *neo4jStore) GetRecommendationCandidates(ctx context.Context, q neo4jdal.RecommendationQuery) (neo4jdal.Neighborhood, error) {
+	session := s.readSession(ctx)
+	defer session.Close(ctx)
+	return s.nodeDAL.ExecGetRecommendationCandidates(ctx, session, q)
+}
+
+func (s *neo4jStore) CreateRelation(ctx context.Context, sourceID, targetID string, relType network.RelationType, properties map[string]any, events ...neo4jdal.ChangeEvent) (dbtype.Relationship, error) {
+	session, tx := s.writeSession(ctx, events)
+	defer session.Close(ctx)
+	return s.relationDAL.ExecCreateRelation(ctx, tx, sourceID, targetID, relType, properties)
+}
+
+func (s *neo4jStore) GetRelationByID(ctx context.Context, id string) (dbtype.Relationship, string, string, string, error) {
+	session := s.readSession(ctx)
+	defer session.Close(ctx)
+	return s.relationDAL.ExecGetRelationByID(ctx, session, id)
+}
+
+func (s *neo4jStore) GetRelationsByIDs(ctx context.Context, ids []string) ([]dbtype.Relationship, []string, []string, []string, error) {
+	session := s.readSession(ctx)
+	defer session.Close(ctx)
+	return s.relationDAL.ExecGetRelationsByIDs(ctx, session, ids)
+}
+
+func (s *neo4jStore) UpdateRelation(ctx context.Context, id string, updates map[string]any, events ...neo4jdal.ChangeEvent) (dbtype.Relationship, string, string, string, error) {
+	session, tx := s.writeSession(ctx, events)
+	defer session.Close(ctx)
+	return s.relationDAL.ExecUpdateRelation(ctx, tx, id, updates)
+}
+
+func (s *neo4jStore) DeleteRelation(ctx context.Context, id string, events ...neo4jdal.ChangeEvent) (neo4jdal.DeleteResult, error) {
+	session, tx := s.writeSession(ctx, events)
+	defer session.Close(ctx)
+	return s.relationDAL.ExecDeleteRelation(ctx, tx, id)
+}
+
+func (s *neo4jStore) BatchCreateRelations(ctx context.Context, rels []neo4jdal.BatchRelationInput, events ...neo4jdal.ChangeEvent) (map[int]dbtype.Relationship, error) {
+	session, tx := s.writeSession(ctx, events)
+	defer session.Close(ctx)
+	return s.relationDAL.ExecBatchCreateRelations(ctx, tx, rels)
+}
+
+func (s *neo4jStore) GetNodeRelations(ctx context.Context, nodeID string, types []string, outgoing, incoming bool, sortKeys []neo4jdal.SortKey, limit, offset int64, after *neo4jdal.RelationKeyset) ([]dbtype.Relationship, []string, []string, []string, int64, error) {
+	session := s.readSession(ctx)
+	defer session.Close(ctx)
+	return s.relationDAL.ExecGetNodeRelations(ctx, session, nodeID, types, outgoing, incoming, sortKeys, limit, offset, after)
+}
+
+func (s *neo4jStore) ListTypeDefs(ctx context.Context) ([]typeregistry.NodeTypeDef, []typeregistry.RelationTypeDef, error) {
+	session := s.readSession(ctx)
+	defer session.Close(ctx)
+	return s.typeDAL.ExecListTypeDefs(ctx, session)
+}
+
+func (s *neo4jStore) SaveNodeTypeDef(ctx context.Context, def typeregistry.NodeTypeDef) error {
+	session, _ := s.writeSession(ctx, nil)
+	defer session.Close(ctx)
+	if err := s.applyNodeTypeSchema(ctx, session, def); err != nil {
+		return err
+	}
+	return s.typeDAL.ExecSaveNodeTypeDef(ctx, session, def)
+}
+
+func (s *neo4jStore) CreateNodeTypeDef(ctx context.Context, def typeregistry.NodeTypeDef) (typeregistry.NodeTypeDef, error) {
+	session, _ := s.writeSession(ctx, nil)
+	defer session.Close(ctx)
+	if err := s.applyNodeTypeSchema(ctx, session, def); err != nil {
+		return typeregistry.NodeTypeDef{}, err
+	}
+	code, err := s.typeDAL.ExecCreateNodeTypeDef(ctx, session, def)
+	if err != nil {
+		return typeregistry.NodeTypeDef{}, err
+	}
+	def.Code = code
+	return def, nil
+}
+
+// applyNodeTypeSchema 先创建约束和索引，再写入定义: 定义可见时新标签的 id 唯一约束已经生效
+func (s *neo4jStore) applyNodeTypeSchema(ctx context.Context, session neo4j.SessionWithContext, def typeregistry.NodeTypeDef) error {
+	if err := s.typeDAL.ExecApplyNodeLabelSchema(ctx, session, def.Name); err != nil {
+		return err
+	}
+	// 全文索引按包含新定义的类型列表重建 (标签或文本属性未变化时不做操作)
+	defs := typeregistry.Default().NodeTypes()
+	if i := slices.IndexFunc(defs, func(d typeregistry.NodeTypeDef) bool { return d.Name == def.Name }); i >= 0 {
+		defs[i] = def
+	} else {
+		defs = append(defs, def)
+	}
+	return s.typeDAL.ExecEnsureNodeFulltextIndex(ctx, session, defs)
+}
+
+func (s *neo4jStore) SaveRelationTypeDef(ctx context.Context, def typeregistry.RelationTypeDef) error {
+	session, _ := s.writeSession(ctx, nil)
+	defer session.Close(ctx)
+	if err := s.typeDAL.ExecApplyRelationTypeSchema(ctx, session, def.Name); err != nil {
+		return err
+	}
+	return s.typeDAL.ExecSaveRelationTypeDef(ctx, session, def)
+}
+
+func (s *neo4jStore) CreateRelationTypeDef(ctx context.Context, def typeregistry.RelationTypeDef) (typeregistry.RelationTypeDef, error) {
+	session, _ := s.writeSession(ctx, nil)
+	defer session.Close(ctx)
+	// 先创建 id 索引再写入定义，与节点类型相同
+	if err := s.typeDAL.ExecApplyRelationTypeSchema(ctx, session, def.Name); err != nil {
+		return typeregistry.RelationTypeDef{}, err
+	}
+	code, err := s.typeDAL.ExecCreateRelationTypeDef(ctx, session, def)
+	if err != nil {
+		return typeregistry.RelationTypeDef{}, err
+	}
+	def.Code = code
+	return def, nil
+}
@@ -5,9 +5,12 @@ package network
 import (
 	"context"
 	"fmt"
+	"io"
+	"strconv"
 
 	network "labelwall/biz/model/relationship/network"
 	"labelwall/biz/service" // Import service layer
+	"labelwall/pkg/graphexport"
 
 	"go.uber.org/zap" // 添加 zap 导入
 
@@ -67,17 +70,7 @@ func GetNetwork(ctx context.Context, c *app.RequestContext) {
 	}
 
 	// --- Manual Binding for StartNodeCriteria ---
-	log.Debug("Starting manual binding for StartNodeCriteria")
-	req.StartNodeCriteria = make(map[string]string) // Initialize the map
-	c.QueryArgs().VisitAll(func(key, value []byte) {
-		keyStr := string(key)
-		if len(keyStr) > len("startNodeCriteria[]") && keyStr[:len("startNodeCriteria[")] == "startNodeCriteria[" && keyStr[len(keyStr)-1] == ']' {
-			mapKey := keyStr[len("startNodeCriteria[") : len(keyStr)-1]
-			req.StartNodeCriteria[mapKey] = string(value)
-			log.Debug("Manually bound StartNodeCriteria entry", zap.String("key", mapKey), zap.String("value", string(value)))
-		}
-	})
-	log.Debug("Finished manual binding for StartNodeCriteria", zap.Any("boundMap", req.StartNodeCriteria))
+	req.StartNodeCriteria = bindStartNodeCriteria(c, log)
 	// --- End Manual Binding ---
 
 	log.Debug("GetNetwork request parameters bound (final)", zap.Any("request", req))
@@ -90,8 +83,14 @@ func GetNetwork(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	// GetNetwork always returns OK status, even if no results found
-	log.Info("GetNetwork handler finished successfully", zap.Bool("responseSuccess", resp.Success), zap.Int("nodeCount", len(resp.Nodes)), zap.Int("relationCount", len(resp.Relations)))
+	if !resp.Success {
+		log.Warn("GetNetwork: Service returned logical failure", zap.String("message", resp.Message))
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	// GetNetwork returns OK status even if no results found
+	log.Info("GetNetwork handler finished successfully", zap.Bool("responseSuccess", resp.Success), zap.Int("nodeCount", len(resp.Nodes)), zap.Int("relationCount", len(resp.Relations)), zap.Bool("truncated", resp.Truncated))
 	c.JSON(consts.StatusOK, resp)
 }
 
@@ -138,7 +137,7 @@ func SearchNodes(ctx context.Context, c *app.RequestContext) {
 	log.Info("Handler SearchNodes called")
 	var err error
 	var req network.SearchNodesRequest
-	// Bind Query Params (type, limit, offset) - Criteria needs manual binding
+	// Bind Query Params (type, limit, offset, cursor, filter as a JSON value) - Criteria needs manual binding
 	err = c.BindAndValidate(&req)
 	if err != nil {
 		log.Error("SearchNodes: BindAndValidate failed for standard params", zap.Error(err))
@@ -170,11 +169,53 @@ func SearchNodes(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	// Logical failures (e.g. invalid cursor) are caused by bad input
+	if !resp.Success {
+		log.Warn("SearchNodes: Service returned logical failure", zap.String("message", resp.Message))
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
 	// Search always returns OK status, even if no results found
 	log.Info("SearchNodes handler finished successfully", zap.Bool("responseSuccess", resp.Success), zap.Int32("totalFound", resp.Total), zap.Int("resultsReturned", len(resp.Nodes)))
 	c.JSON(consts.StatusOK, resp)
 }
 
+// FulltextSearchNodes .
+// @router /api/v1/nodes/fulltext [GET]
+func FulltextSearchNodes(ctx context.Context, c *app.RequestContext) {
+	log := ensureLogger()
+	log.Info("Handler FulltextSearchNodes called")
+	var err error
+	var req network.FulltextSearchNodesRequest
+	// Bind Query Params (query, type, fuzzy, limit, offset)
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		log.Error("FulltextSearchNodes: BindAndValidate failed", zap.Error(err))
+		c.JSON(consts.StatusBadRequest, &network.FulltextSearchNodesResponse{Success: false, Message: "无效请求参数: " + err.Error()})
+		return
+	}
+	log.Debug("FulltextSearchNodes request parameters bound", zap.Any("request", req))
+
+	// Call Service
+	resp, err := networkService.FulltextSearchNodes(ctx, &req)
+	if err != nil {
+		log.Error("FulltextSearchNodes: Service call failed", zap.Error(err))
+		c.JSON(consts.StatusInternalServerError, &network.FulltextSearchNodesResponse{Success: false, Message: "全文搜索节点失败: " + err.Error()})
+		return
+	}
+
+	// Logical failures (empty query, negative paging) are caused by bad input
+	if !resp.Success {
+		log.Warn("FulltextSearchNodes: Service returned logical failure", zap.String("message", resp.Message))
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	log.Info("FulltextSearchNodes handler finished successfully", zap.Int32("totalFound", resp.Total), zap.Int("resultsReturned", len(resp.Hits)))
+	c.JSON(consts.StatusOK, resp)
+}
+
 // CreateNode .
 // @router /api/v1/nodes [POST]
 func CreateNode(ctx context.Context, c *app.RequestContext) {
@@ -286,6 +327,13 @@ func UpdateNode(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	// Invalid typed values or properties violating the node type's schema are a client error
+	if len(resp.FieldErrors) > 0 {
+		log.Warn("UpdateNode: Properties failed schema validation", zap.String("nodeID", req.ID), zap.Int("fieldErrors", len(resp.FieldErrors)))
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
 	// Handle Not Found (Success=false from service)
 	if !resp.Success {
 		log.Info("UpdateNode: Node not found or service indicated failure", zap.String("nodeID", req.ID), zap.String("message", resp.Message))
@@ -424,6 +472,13 @@ func UpdateRelation(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	// Invalid typed property values are a client error
+	if len(resp.FieldErrors) > 0 {
+		log.Warn("UpdateRelation: Typed properties failed validation", zap.String("relationID", req.ID), zap.Int("fieldErrors", len(resp.FieldErrors)))
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
 	// Handle Not Found (Success=false from service). README specifies 200 OK.
 	log.Info("UpdateRelation handler finished", zap.String("relationID", req.ID), zap.Bool("responseSuccess", resp.Success))
 	c.JSON(consts.StatusOK, resp)
@@ -490,7 +545,324 @@ func GetNodeRelations(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	// Logical failures (e.g. invalid cursor) are caused by bad input
+	if !resp.Success {
+		log.Warn("GetNodeRelations: Service returned logical failure", zap.String("nodeID", req.NodeID), zap.String("message", resp.Message))
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
 	// Always return OK status, even if no relations found
 	log.Info("GetNodeRelations handler finished successfully", zap.String("nodeID", req.NodeID), zap.Bool("responseSuccess", resp.Success), zap.Int32("totalFound", resp.Total), zap.Int("resultsReturned", len(resp.Relations)))
 	c.JSON(consts.StatusOK, resp)
 }
+
+// GetRecommendations .
+// @router /api/v1/nodes/:node_id/recommendations [GET]
+func GetRecommendations(ctx context.Context, c *app.RequestContext) {
+	log := ensureLogger()
+	log.Info("Handler GetRecommendations called")
+	var err error
+	var req network.GetRecommendationsRequest
+
+	// Bind Path Param "node_id"
+	req.NodeID = c.Param("node_id")
+	if req.NodeID == "" {
+		log.Warn("GetRecommendations: Missing node ID")
+		c.JSON(consts.StatusBadRequest, &network.GetRecommendationsResponse{Success: false, Message: "节点 ID 不能为空"})
+		return
+	}
+
+	// Bind Query Params (limit)
+	if err = c.BindAndValidate(&req); err != nil {
+		log.Error("GetRecommendations: BindAndValidate failed", zap.String("nodeID", req.NodeID), zap.Error(err))
+		c.JSON(consts.StatusBadRequest, &network.GetRecommendationsResponse{Success: false, Message: "无效请求参数: " + err.Error()})
+		return
+	}
+
+	// Call Service
+	resp, err := networkService.GetRecommendations(ctx, &req)
+	if err != nil {
+		log.Error("GetRecommendations: Service call failed", zap.String("nodeID", req.NodeID), zap.Error(err))
+		c.JSON(consts.StatusInternalServerError, &network.GetRecommendationsResponse{Success: false, Message: "获取推荐失败: " + err.Error()})
+		return
+	}
+
+	// Logical failures (invalid limit, unknown or non-PERSON node) are caused by bad input
+	if !resp.Success {
+		log.Warn("GetRecommendations: Service returned logical failure", zap.String("nodeID", req.NodeID), zap.String("message", resp.Message))
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	// An empty list is still a successful response
+	log.Info("GetRecommendations handler finished successfully", zap.String("nodeID", req.NodeID), zap.Int("recommendations", len(resp.Recommendations)))
+	c.JSON(consts.StatusOK, resp)
+}
+
+// BatchCreateNodes .
+// @router /api/v1/nodes/batch [POST]
+func BatchCreateNodes(ctx context.Context, c *app.RequestContext) {
+	log := ensureLogger()
+	log.Info("Handler BatchCreateNodes called")
+	var err error
+	var req network.BatchCreateNodesRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		log.Error("BatchCreateNodes: BindAndValidate failed", zap.Error(err))
+		c.JSON(consts.StatusBadRequest, &network.BatchCreateNodesResponse{Success: false, Message: "无效请求: " + err.Error()})
+		return
+	}
+
+	// Call Service
+	resp, err := networkService.BatchCreateNodes(ctx, &req)
+	if err != nil {
+		log.Error("BatchCreateNodes: Service call failed", zap.Error(err))
+		c.JSON(consts.StatusInternalServerError, &network.BatchCreateNodesResponse{Success: false, Message: "批量创建节点失败: " + err.Error()})
+		return
+	}
+
+	// Batch-level validation failures (empty or oversized batch)
+	if !resp.Success {
+		log.Warn("BatchCreateNodes: Service returned logical failure", zap.String("message", resp.Message))
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	// Per-item failures are reported in the results, the batch itself is OK
+	log.Info("BatchCreateNodes handler finished successfully", zap.Int("nodeResults", len(resp.NodeResults)), zap.Int("relationResults", len(resp.RelationResults)))
+	c.JSON(consts.StatusOK, resp)
+}
+
+// BatchCreateRelations .
+// @router /api/v1/relations/batch [POST]
+func BatchCreateRelations(ctx context.Context, c *app.RequestContext) {
+	log := ensureLogger()
+	log.Info("Handler BatchCreateRelations called")
+	var err error
+	var req network.BatchCreateRelationsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		log.Error("BatchCreateRelations: BindAndValidate failed", zap.Error(err))
+		c.JSON(consts.StatusBadRequest, &network.BatchCreateRelationsResponse{Success: false, Message: "无效请求: " + err.Error()})
+		return
+	}
+
+	// Call Service
+	resp, err := networkService.BatchCreateRelations(ctx, &req)
+	if err != nil {
+		log.Error("BatchCreateRelations: Service call failed", zap.Error(err))
+		c.JSON(consts.StatusInternalServerError, &network.BatchCreateRelationsResponse{Success: false, Message: "批量创建关系失败: " + err.Error()})
+		return
+	}
+
+	// Batch-level validation failures (empty or oversized batch)
+	if !resp.Success {
+		log.Warn("BatchCreateRelations: Service returned logical failure", zap.String("message", resp.Message))
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	// Per-item failures are reported in the results, the batch itself is OK
+	log.Info("BatchCreateRelations handler finished successfully", zap.Int("results", len(resp.Results)))
+	c.JSON(consts.StatusOK, resp)
+}
+
+// bindStartNodeCriteria 手动绑定 startNodeCriteria[key]=value 形式的查询参数
+func bindStartNodeCriteria(c *app.RequestContext, log *zap.Logger) map[string]string {
+	log.Debug("Starting manual binding for StartNodeCriteria")
+	criteria := make(map[string]string)
+	c.QueryArgs().VisitAll(func(key, value []byte) {
+		keyStr := string(key)
+		if len(keyStr) > len("startNodeCriteria[]") && keyStr[:len("startNodeCriteria[")] == "startNodeCriteria[" && keyStr[len(keyStr)-1] == ']' {
+			mapKey := keyStr[len("startNodeCriteria[") : len(keyStr)-1]
+			criteria[mapKey] = string(value)
+			log.Debug("Manually bound StartNodeCriteria entry", zap.String("key", mapKey), zap.String("value", string(value)))
+		}
+	})
+	log.Debug("Finished manual binding for StartNodeCriteria", zap.Any("boundMap", criteria))
+	return criteria
+}
+
+// ExportNetwork .
+// @router /api/v1/network/export [GET]
+func ExportNetwork(ctx context.Context, c *app.RequestContext) {
+	log := ensureLogger()
+	log.Info("Handler ExportNetwork called")
+	var err error
+	var req network.ExportNetworkRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		log.Error("ExportNetwork: BindAndValidate failed", zap.Error(err))
+		c.JSON(consts.StatusBadRequest, &network.ExportNetworkResponse{Success: false, Message: "无效请求参数: " + err.Error()})
+		return
+	}
+	req.StartNodeCriteria = bindStartNodeCriteria(c, log)
+
+	// Call Service
+	resp, err := networkService.ExportNetwork(ctx, &req)
+	if err != nil {
+		log.Error("ExportNetwork: Service call failed", zap.Error(err))
+		c.JSON(consts.StatusInternalServerError, &network.ExportNetworkResponse{Success: false, Message: "导出网络图谱失败: " + err.Error()})
+		return
+	}
+	if !resp.Success {
+		log.Warn("ExportNetwork: Service returned logical failure", zap.String("message", resp.Message))
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	// Format was validated by the service
+	format, _ := graphexport.ParseFormat(req.GetFormat())
+	c.SetContentType(format.ContentType())
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="network.%s"`, format.Extension()))
+	c.Header("X-Graph-Truncated", strconv.FormatBool(resp.Truncated))
+
+	// Stream the encoded graph as a chunked body instead of buffering the whole document
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(graphexport.Write(pw, format, &graphexport.Graph{
+			Nodes:     resp.Nodes,
+			Relations: resp.Relations,
+			Truncated: resp.Truncated,
+		}))
+	}()
+	c.SetBodyStream(pr, -1)
+	log.Info("ExportNetwork handler streaming response", zap.String("format", string(format)), zap.Int("nodeCount", len(resp.Nodes)), zap.Int("relationCount", len(resp.Relations)), zap.Bool("truncated", resp.Truncated))
+}
+
+// ListTypes .
+// @router /api/v1/admin/types [GET]
+func ListTypes(ctx context.Context, c *app.RequestContext) {
+	log := ensureLogger()
+	log.Info("Handler ListTypes called")
+	var req network.ListTypesRequest
+
+	// Call Service
+	resp, err := networkService.ListTypes(ctx, &req)
+	if err != nil {
+		log.Error("ListTypes: Service call failed", zap.Error(err))
+		c.JSON(consts.StatusInternalServerError, &network.ListTypesResponse{Success: false, Message: "获取类型列表失败: " + err.Error()})
+		return
+	}
+
+	log.Info("ListTypes handler finished successfully", zap.Int("nodeTypes", len(resp.NodeTypes)), zap.Int("relationTypes", len(resp.RelationTypes)))
+	c.JSON(consts.StatusOK, resp)
+}
+
+// CreateNodeType .
+// @router /api/v1/admin/types/nodes [POST]
+func CreateNodeType(ctx context.Context, c *app.RequestContext) {
+	log := ensureLogger()
+	log.Info("Handler CreateNodeType called")
+	var err error
+	var req network.CreateNodeTypeRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		log.Error("CreateNodeType: BindAndValidate failed", zap.Error(err))
+		c.JSON(consts.StatusBadRequest, &network.CreateNodeTypeResponse{Success: false, Message: "无效请求: " + err.Error()})
+		return
+	}
+
+	// Call Service
+	resp, err := networkService.CreateNodeType(ctx, &req)
+	if err != nil {
+		log.Error("CreateNodeType: Service call failed", zap.String("name", req.Name), zap.Error(err))
+		c.JSON(consts.StatusInternalServerError, &network.CreateNodeTypeResponse{Success: false, Message: "创建节点类型失败: " + err.Error()})
+		return
+	}
+
+	// Invalid or duplicate type name
+	if !resp.Success {
+		log.Warn("CreateNodeType: Service returned logical failure", zap.String("name", req.Name), zap.String("message", resp.Message))
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	log.Info("CreateNodeType handler finished successfully", zap.String("name", resp.NodeType.Name), zap.Int64("code", resp.NodeType.Code))
+	c.JSON(consts.StatusOK, resp)
+}
+
+// SaveRelationType .
+// @router /api/v1/admin/types/relations/:name [PUT]
+func SaveRelationType(ctx context.Context, c *app.RequestContext) {
+	log := ensureLogger()
+	log.Info("Handler SaveRelationType called")
+	var err error
+	var req network.SaveRelationTypeRequest
+
+	// Bind JSON Body
+	if err = c.BindAndValidate(&req); err != nil {
+		log.Error("SaveRelationType: BindAndValidate failed", zap.Error(err))
+		c.JSON(consts.StatusBadRequest, &network.SaveRelationTypeResponse{Success: false, Message: "无效请求体: " + err.Error()})
+		return
+	}
+
+	// Bind Path Param "name" (takes precedence over the body)
+	req.Name = c.Param("name")
+	if req.Name == "" {
+		log.Warn("SaveRelationType: Missing relation type name")
+		c.JSON(consts.StatusBadRequest, &network.SaveRelationTypeResponse{Success: false, Message: "关系类型名不能为空"})
+		return
+	}
+
+	// Call Service
+	resp, err := networkService.SaveRelationType(ctx, &req)
+	if err != nil {
+		log.Error("SaveRelationType: Service call failed", zap.String("name", req.Name), zap.Error(err))
+		c.JSON(consts.StatusInternalServerError, &network.SaveRelationTypeResponse{Success: false, Message: "保存关系类型失败: " + err.Error()})
+		return
+	}
+
+	// Invalid type name or unknown node types in allowed_pairs
+	if !resp.Success {
+		log.Warn("SaveRelationType: Service returned logical failure", zap.String("name", req.Name), zap.String("message", resp.Message))
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	log.Info("SaveRelationType handler finished successfully", zap.String("name", resp.RelationType.Name), zap.Int("allowedPairs", len(resp.RelationType.AllowedPairs)))
+	c.JSON(consts.StatusOK, resp)
+}
+
+// UpdateNodeType .
+// @router /api/v1/admin/types/nodes/:name [PUT]
+func UpdateNodeType(ctx context.Context, c *app.RequestContext) {
+	log := ensureLogger()
+	log.Info("Handler UpdateNodeType called")
+	var err error
+	var req network.UpdateNodeTypeRequest
+
+	// Bind JSON Body
+	if err = c.BindAndValidate(&req); err != nil {
+		log.Error("UpdateNodeType: BindAndValidate failed", zap.Error(err))
+		c.JSON(consts.StatusBadRequest, &network.UpdateNodeTypeResponse{Success: false, Message: "无效请求体: " + err.Error()})
+		return
+	}
+
+	// Bind Path Param "name" (takes precedence over the body)
+	req.Name = c.Param("name")
+	if req.Name == "" {
+		log.Warn("UpdateNodeType: Missing node type name")
+		c.JSON(consts.StatusBadRequest, &network.UpdateNodeTypeResponse{Success: false, Message: "节点类型名不能为空"})
+		return
+	}
+
+	// Call Service
+	resp, err := networkService.UpdateNodeType(ctx, &req)
+	if err != nil {
+		log.Error("UpdateNodeType: Service call failed", zap.String("name", req.Name), zap.Error(err))
+		c.JSON(consts.StatusInternalServerError, &network.UpdateNodeTypeResponse{Success: false, Message: "更新节点类型失败: " + err.Error()})
+		return
+	}
+
+	// Unknown node type or invalid property schema
+	if !resp.Success {
+		log.Warn("UpdateNodeType: Service returned logical failure", zap.String("name", req.Name), zap.String("message", resp.Message))
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+
+	log.Info("UpdateNodeType handler finished successfully", zap.String("name", resp.NodeType.Name), zap.Int("properties", len(resp.NodeType.Properties)))
+	c.JSON(consts.StatusOK, resp)
+}
@@ -121,90 +121,484 @@ func (p *RelationType) Value() (driver.Value, error) {
 	return int64(*p), nil
 }
 
-// 节点信息
-type Node struct {
-	// 节点ID
-	ID string `thrift:"id,1" form:"id" json:"id" query:"id"`
-	// 节点类型
-	Type NodeType `thrift:"type,2" form:"type" json:"type" query:"type"`
-	// 名称
-	Name string `thrift:"name,3" form:"name" json:"name" query:"name"`
-	// 头像URL
-	Avatar *string `thrift:"avatar,4,optional" form:"avatar" json:"avatar,omitempty" query:"avatar"`
-	// 职业
-	Profession *string `thrift:"profession,5,optional" form:"profession" json:"profession,omitempty" query:"profession"`
-	// 其他属性
-	Properties map[string]string `thrift:"properties,6,optional" form:"properties" json:"properties,omitempty" query:"properties"`
+// 属性值类型
+type PropertyValueKind int64
+
+const (
+	PropertyValueKind_STRING PropertyValueKind = 1
+	PropertyValueKind_INT    PropertyValueKind = 2
+	PropertyValueKind_DOUBLE PropertyValueKind = 3
+	PropertyValueKind_BOOL   PropertyValueKind = 4
+	// 日期或时间
+	PropertyValueKind_DATE PropertyValueKind = 5
+	// 字符串列表
+	PropertyValueKind_LIST PropertyValueKind = 6
+)
+
+func (p PropertyValueKind) String() string {
+	switch p {
+	case PropertyValueKind_STRING:
+		return "STRING"
+	case PropertyValueKind_INT:
+		return "INT"
+	case PropertyValueKind_DOUBLE:
+		return "DOUBLE"
+	case PropertyValueKind_BOOL:
+		return "BOOL"
+	case PropertyValueKind_DATE:
+		return "DATE"
+	case PropertyValueKind_LIST:
+		return "LIST"
+	}
+	return "<UNSET>"
 }
 
-func NewNode() *Node {
-	return &Node{}
+func PropertyValueKindFromString(s string) (PropertyValueKind, error) {
+	switch s {
+	case "STRING":
+		return PropertyValueKind_STRING, nil
+	case "INT":
+		return PropertyValueKind_INT, nil
+	case "DOUBLE":
+		return PropertyValueKind_DOUBLE, nil
+	case "BOOL":
+		return PropertyValueKind_BOOL, nil
+	case "DATE":
+		return PropertyValueKind_DATE, nil
+	case "LIST":
+		return PropertyValueKind_LIST, nil
+	}
+	return PropertyValueKind(0), fmt.Errorf("not a valid PropertyValueKind string")
+}
+
+func PropertyValueKindPtr(v PropertyValueKind) *PropertyValueKind { return &v }
+func (p *PropertyValueKind) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = PropertyValueKind(result.Int64)
+	return
 }
 
-func (p *Node) InitDefault() {
+func (p *PropertyValueKind) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
 }
 
-func (p *Node) GetID() (v string) {
-	return p.ID
+// 搜索过滤运算符
+type FilterOp int64
+
+const (
+	// 等于
+	FilterOp_EQ FilterOp = 1
+	// 不等于
+	FilterOp_NE FilterOp = 2
+	// 包含子串
+	FilterOp_CONTAINS FilterOp = 3
+	// 前缀匹配
+	FilterOp_STARTS_WITH FilterOp = 4
+	// 后缀匹配
+	FilterOp_ENDS_WITH FilterOp = 5
+	// 等于 values 中的任意一个
+	FilterOp_IN FilterOp = 6
+	// 大于
+	FilterOp_GT FilterOp = 7
+	// 大于等于
+	FilterOp_GTE FilterOp = 8
+	// 小于
+	FilterOp_LT FilterOp = 9
+	// 小于等于
+	FilterOp_LTE FilterOp = 10
+	// 属性存在
+	FilterOp_EXISTS FilterOp = 11
+	// 属性不存在
+	FilterOp_NOT_EXISTS FilterOp = 12
+)
+
+func (p FilterOp) String() string {
+	switch p {
+	case FilterOp_EQ:
+		return "EQ"
+	case FilterOp_NE:
+		return "NE"
+	case FilterOp_CONTAINS:
+		return "CONTAINS"
+	case FilterOp_STARTS_WITH:
+		return "STARTS_WITH"
+	case FilterOp_ENDS_WITH:
+		return "ENDS_WITH"
+	case FilterOp_IN:
+		return "IN"
+	case FilterOp_GT:
+		return "GT"
+	case FilterOp_GTE:
+		return "GTE"
+	case FilterOp_LT:
+		return "LT"
+	case FilterOp_LTE:
+		return "LTE"
+	case FilterOp_EXISTS:
+		return "EXISTS"
+	case FilterOp_NOT_EXISTS:
+		return "NOT_EXISTS"
+	}
+	return "<UNSET>"
 }
 
-func (p *Node) GetType() (v NodeType) {
-	return p.Type
+func FilterOpFromString(s string) (FilterOp, error) {
+	switch s {
+	case "EQ":
+		return FilterOp_EQ, nil
+	case "NE":
+		return FilterOp_NE, nil
+	case "CONTAINS":
+		return FilterOp_CONTAINS, nil
+	case "STARTS_WITH":
+		return FilterOp_STARTS_WITH, nil
+	case "ENDS_WITH":
+		return FilterOp_ENDS_WITH, nil
+	case "IN":
+		return FilterOp_IN, nil
+	case "GT":
+		return FilterOp_GT, nil
+	case "GTE":
+		return FilterOp_GTE, nil
+	case "LT":
+		return FilterOp_LT, nil
+	case "LTE":
+		return FilterOp_LTE, nil
+	case "EXISTS":
+		return FilterOp_EXISTS, nil
+	case "NOT_EXISTS":
+		return FilterOp_NOT_EXISTS, nil
+	}
+	return FilterOp(0), fmt.Errorf("not a valid FilterOp string")
+}
+
+func FilterOpPtr(v FilterOp) *FilterOp { return &v }
+func (p *FilterOp) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = FilterOp(result.Int64)
+	return
 }
 
-func (p *Node) GetName() (v string) {
-	return p.Name
+func (p *FilterOp) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
 }
 
-var Node_Avatar_DEFAULT string
+// 过滤表达式中各项的组合方式
+type FilterLogic int64
 
-func (p *Node) GetAvatar() (v string) {
-	if !p.IsSetAvatar() {
-		return Node_Avatar_DEFAULT
+const (
+	FilterLogic_AND FilterLogic = 1
+	FilterLogic_OR  FilterLogic = 2
+)
+
+func (p FilterLogic) String() string {
+	switch p {
+	case FilterLogic_AND:
+		return "AND"
+	case FilterLogic_OR:
+		return "OR"
 	}
-	return *p.Avatar
+	return "<UNSET>"
 }
 
-var Node_Profession_DEFAULT string
+func FilterLogicFromString(s string) (FilterLogic, error) {
+	switch s {
+	case "AND":
+		return FilterLogic_AND, nil
+	case "OR":
+		return FilterLogic_OR, nil
+	}
+	return FilterLogic(0), fmt.Errorf("not a valid FilterLogic string")
+}
 
-func (p *Node) GetProfession() (v string) {
-	if !p.IsSetProfession() {
-		return Node_Profession_DEFAULT
+func FilterLogicPtr(v FilterLogic) *FilterLogic { return &v }
+func (p *FilterLogic) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = FilterLogic(result.Int64)
+	return
+}
+
+func (p *FilterLogic) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
 	}
-	return *p.Profession
+	return int64(*p), nil
 }
 
-var Node_Properties_DEFAULT map[string]string
+// 排序方向
+type SortOrder int64
 
-func (p *Node) GetProperties() (v map[string]string) {
-	if !p.IsSetProperties() {
-		return Node_Properties_DEFAULT
+const (
+	// 升序
+	SortOrder_ASC SortOrder = 1
+	// 降序
+	SortOrder_DESC SortOrder = 2
+)
+
+func (p SortOrder) String() string {
+	switch p {
+	case SortOrder_ASC:
+		return "ASC"
+	case SortOrder_DESC:
+		return "DESC"
 	}
-	return p.Properties
+	return "<UNSET>"
 }
 
-var fieldIDToName_Node = map[int16]string{
-	1: "id",
-	2: "type",
-	3: "name",
-	4: "avatar",
-	5: "profession",
-	6: "properties",
+func SortOrderFromString(s string) (SortOrder, error) {
+	switch s {
+	case "ASC":
+		return SortOrder_ASC, nil
+	case "DESC":
+		return SortOrder_DESC, nil
+	}
+	return SortOrder(0), fmt.Errorf("not a valid SortOrder string")
 }
 
-func (p *Node) IsSetAvatar() bool {
-	return p.Avatar != nil
+func SortOrderPtr(v SortOrder) *SortOrder { return &v }
+func (p *SortOrder) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = SortOrder(result.Int64)
+	return
 }
 
-func (p *Node) IsSetProfession() bool {
-	return p.Profession != nil
+func (p *SortOrder) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
 }
 
-func (p *Node) IsSetProperties() bool {
-	return p.Properties != nil
+// 路径查询模式
+type PathMode int64
+
+const (
+	// 一条最短路径
+	PathMode_SINGLE PathMode = 1
+	// 全部最短路径
+	PathMode_ALL_SHORTEST PathMode = 2
+	// 前 k 条最短路径 (Yen 算法)
+	PathMode_K_SHORTEST PathMode = 3
+	// 按关系属性加权的代价最小路径 (Dijkstra)
+	PathMode_WEIGHTED PathMode = 4
+)
+
+func (p PathMode) String() string {
+	switch p {
+	case PathMode_SINGLE:
+		return "SINGLE"
+	case PathMode_ALL_SHORTEST:
+		return "ALL_SHORTEST"
+	case PathMode_K_SHORTEST:
+		return "K_SHORTEST"
+	case PathMode_WEIGHTED:
+		return "WEIGHTED"
+	}
+	return "<UNSET>"
 }
 
-func (p *Node) Read(iprot thrift.TProtocol) (err error) {
+func PathModeFromString(s string) (PathMode, error) {
+	switch s {
+	case "SINGLE":
+		return PathMode_SINGLE, nil
+	case "ALL_SHORTEST":
+		return PathMode_ALL_SHORTEST, nil
+	case "K_SHORTEST":
+		return PathMode_K_SHORTEST, nil
+	case "WEIGHTED":
+		return PathMode_WEIGHTED, nil
+	}
+	return PathMode(0), fmt.Errorf("not a valid PathMode string")
+}
+
+func PathModePtr(v PathMode) *PathMode { return &v }
+func (p *PathMode) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = PathMode(result.Int64)
+	return
+}
+
+func (p *PathMode) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+// 遍历时允许的关系方向
+type Direction int64
+
+const (
+	// 不区分方向
+	Direction_BOTH Direction = 1
+	// 只沿关系方向 (start -> end) 前进
+	Direction_OUTGOING Direction = 2
+	// 只逆着关系方向前进
+	Direction_INCOMING Direction = 3
+)
+
+func (p Direction) String() string {
+	switch p {
+	case Direction_BOTH:
+		return "BOTH"
+	case Direction_OUTGOING:
+		return "OUTGOING"
+	case Direction_INCOMING:
+		return "INCOMING"
+	}
+	return "<UNSET>"
+}
+
+func DirectionFromString(s string) (Direction, error) {
+	switch s {
+	case "BOTH":
+		return Direction_BOTH, nil
+	case "OUTGOING":
+		return Direction_OUTGOING, nil
+	case "INCOMING":
+		return Direction_INCOMING, nil
+	}
+	return Direction(0), fmt.Errorf("not a valid Direction string")
+}
+
+func DirectionPtr(v Direction) *Direction { return &v }
+func (p *Direction) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = Direction(result.Int64)
+	return
+}
+
+func (p *Direction) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+// 带类型的属性值，按 kind 读取对应的字段
+type PropertyValue struct {
+	Kind        PropertyValueKind `thrift:"kind,1" form:"kind" json:"kind" query:"kind"`
+	StringValue *string           `thrift:"string_value,2,optional" form:"string_value" json:"string_value,omitempty" query:"string_value"`
+	IntValue    *int64            `thrift:"int_value,3,optional" form:"int_value" json:"int_value,omitempty" query:"int_value"`
+	DoubleValue *float64          `thrift:"double_value,4,optional" form:"double_value" json:"double_value,omitempty" query:"double_value"`
+	BoolValue   *bool             `thrift:"bool_value,5,optional" form:"bool_value" json:"bool_value,omitempty" query:"bool_value"`
+	// YYYY-MM-DD 或 RFC 3339 时间
+	DateValue *string  `thrift:"date_value,6,optional" form:"date_value" json:"date_value,omitempty" query:"date_value"`
+	ListValue []string `thrift:"list_value,7,optional" form:"list_value" json:"list_value,omitempty" query:"list_value"`
+}
+
+func NewPropertyValue() *PropertyValue {
+	return &PropertyValue{}
+}
+
+func (p *PropertyValue) InitDefault() {
+}
+
+func (p *PropertyValue) GetKind() (v PropertyValueKind) {
+	return p.Kind
+}
+
+var PropertyValue_StringValue_DEFAULT string
+
+func (p *PropertyValue) GetStringValue() (v string) {
+	if !p.IsSetStringValue() {
+		return PropertyValue_StringValue_DEFAULT
+	}
+	return *p.StringValue
+}
+
+var PropertyValue_IntValue_DEFAULT int64
+
+func (p *PropertyValue) GetIntValue() (v int64) {
+	if !p.IsSetIntValue() {
+		return PropertyValue_IntValue_DEFAULT
+	}
+	return *p.IntValue
+}
+
+var PropertyValue_DoubleValue_DEFAULT float64
+
+func (p *PropertyValue) GetDoubleValue() (v float64) {
+	if !p.IsSetDoubleValue() {
+		return PropertyValue_DoubleValue_DEFAULT
+	}
+	return *p.DoubleValue
+}
+
+var PropertyValue_BoolValue_DEFAULT bool
+
+func (p *PropertyValue) GetBoolValue() (v bool) {
+	if !p.IsSetBoolValue() {
+		return PropertyValue_BoolValue_DEFAULT
+	}
+	return *p.BoolValue
+}
+
+var PropertyValue_DateValue_DEFAULT string
+
+func (p *PropertyValue) GetDateValue() (v string) {
+	if !p.IsSetDateValue() {
+		return PropertyValue_DateValue_DEFAULT
+	}
+	return *p.DateValue
+}
+
+var PropertyValue_ListValue_DEFAULT []string
+
+func (p *PropertyValue) GetListValue() (v []string) {
+	if !p.IsSetListValue() {
+		return PropertyValue_ListValue_DEFAULT
+	}
+	return p.ListValue
+}
+
+var fieldIDToName_PropertyValue = map[int16]string{
+	1: "kind",
+	2: "string_value",
+	3: "int_value",
+	4: "double_value",
+	5: "bool_value",
+	6: "date_value",
+	7: "list_value",
+}
+
+func (p *PropertyValue) IsSetStringValue() bool {
+	return p.StringValue != nil
+}
+
+func (p *PropertyValue) IsSetIntValue() bool {
+	return p.IntValue != nil
+}
+
+func (p *PropertyValue) IsSetDoubleValue() bool {
+	return p.DoubleValue != nil
+}
+
+func (p *PropertyValue) IsSetBoolValue() bool {
+	return p.BoolValue != nil
+}
+
+func (p *PropertyValue) IsSetDateValue() bool {
+	return p.DateValue != nil
+}
+
+func (p *PropertyValue) IsSetListValue() bool {
+	return p.ListValue != nil
+}
+
+func (p *PropertyValue) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -223,7 +617,7 @@ func (p *Node) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -231,7 +625,7 @@ func (p *Node) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -239,7 +633,7 @@ func (p *Node) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -247,7 +641,7 @@ func (p *Node) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -255,7 +649,7 @@ func (p *Node) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.BOOL {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -263,13 +657,21 @@ func (p *Node) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 6:
-			if fieldTypeId == thrift.MAP {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 7:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -289,7 +691,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Node[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PropertyValue[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -299,51 +701,62 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *Node) ReadField1(iprot thrift.TProtocol) error {
+func (p *PropertyValue) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field string
+	var _field PropertyValueKind
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = PropertyValueKind(v)
+	}
+	p.Kind = _field
+	return nil
+}
+func (p *PropertyValue) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.ID = _field
+	p.StringValue = _field
 	return nil
 }
-func (p *Node) ReadField2(iprot thrift.TProtocol) error {
+func (p *PropertyValue) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field NodeType
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = NodeType(v)
+		_field = &v
 	}
-	p.Type = _field
+	p.IntValue = _field
 	return nil
 }
-func (p *Node) ReadField3(iprot thrift.TProtocol) error {
+func (p *PropertyValue) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.Name = _field
+	p.DoubleValue = _field
 	return nil
 }
-func (p *Node) ReadField4(iprot thrift.TProtocol) error {
+func (p *PropertyValue) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Avatar = _field
+	p.BoolValue = _field
 	return nil
 }
-func (p *Node) ReadField5(iprot thrift.TProtocol) error {
+func (p *PropertyValue) ReadField6(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -351,42 +764,36 @@ func (p *Node) ReadField5(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.Profession = _field
+	p.DateValue = _field
 	return nil
 }
-func (p *Node) ReadField6(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
+func (p *PropertyValue) ReadField7(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make(map[string]string, size)
+	_field := make([]string, 0, size)
 	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
 
-		var _val string
+		var _elem string
 		if v, err := iprot.ReadString(); err != nil {
 			return err
 		} else {
-			_val = v
+			_elem = v
 		}
 
-		_field[_key] = _val
+		_field = append(_field, _elem)
 	}
-	if err := iprot.ReadMapEnd(); err != nil {
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.Properties = _field
+	p.ListValue = _field
 	return nil
 }
 
-func (p *Node) Write(oprot thrift.TProtocol) (err error) {
+func (p *PropertyValue) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Node"); err != nil {
+	if err = oprot.WriteStructBegin("PropertyValue"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -414,6 +821,10 @@ func (p *Node) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 6
 			goto WriteFieldError
 		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -432,11 +843,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *Node) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+func (p *PropertyValue) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("kind", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ID); err != nil {
+	if err := oprot.WriteI32(int32(p.Kind)); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -448,15 +859,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *Node) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("type", thrift.I32, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(int32(p.Type)); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *PropertyValue) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStringValue() {
+		if err = oprot.WriteFieldBegin("string_value", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.StringValue); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -464,15 +877,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *Node) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("name", thrift.STRING, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Name); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *PropertyValue) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIntValue() {
+		if err = oprot.WriteFieldBegin("int_value", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.IntValue); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -480,12 +895,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *Node) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAvatar() {
-		if err = oprot.WriteFieldBegin("avatar", thrift.STRING, 4); err != nil {
+func (p *PropertyValue) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDoubleValue() {
+		if err = oprot.WriteFieldBegin("double_value", thrift.DOUBLE, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Avatar); err != nil {
+		if err := oprot.WriteDouble(*p.DoubleValue); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -498,12 +913,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *Node) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetProfession() {
-		if err = oprot.WriteFieldBegin("profession", thrift.STRING, 5); err != nil {
+func (p *PropertyValue) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBoolValue() {
+		if err = oprot.WriteFieldBegin("bool_value", thrift.BOOL, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Profession); err != nil {
+		if err := oprot.WriteBool(*p.BoolValue); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -516,23 +931,38 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-func (p *Node) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetProperties() {
-		if err = oprot.WriteFieldBegin("properties", thrift.MAP, 6); err != nil {
+func (p *PropertyValue) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDateValue() {
+		if err = oprot.WriteFieldBegin("date_value", thrift.STRING, 6); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Properties)); err != nil {
+		if err := oprot.WriteString(*p.DateValue); err != nil {
 			return err
 		}
-		for k, v := range p.Properties {
-			if err := oprot.WriteString(k); err != nil {
-				return err
-			}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *PropertyValue) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetListValue() {
+		if err = oprot.WriteFieldBegin("list_value", thrift.LIST, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.ListValue)); err != nil {
+			return err
+		}
+		for _, v := range p.ListValue {
 			if err := oprot.WriteString(v); err != nil {
 				return err
 			}
 		}
-		if err := oprot.WriteMapEnd(); err != nil {
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -541,94 +971,119 @@ func (p *Node) writeField6(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *Node) String() string {
+func (p *PropertyValue) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Node(%+v)", *p)
+	return fmt.Sprintf("PropertyValue(%+v)", *p)
 
 }
 
-// 关系信息
-type Relation struct {
-	// 关系ID
+// 节点信息
+type Node struct {
+	// 节点ID
 	ID string `thrift:"id,1" form:"id" json:"id" query:"id"`
-	// 起始节点ID
-	Source string `thrift:"source,2" form:"source" json:"source" query:"source"`
-	// 目标节点ID
-	Target string `thrift:"target,3" form:"target" json:"target" query:"target"`
-	// 关系类型
-	Type RelationType `thrift:"type,4" form:"type" json:"type" query:"type"`
-	// 关系标签
-	Label *string `thrift:"label,5,optional" form:"label" json:"label,omitempty" query:"label"`
-	// 关系属性
+	// 节点类型
+	Type NodeType `thrift:"type,2" form:"type" json:"type" query:"type"`
+	// 名称
+	Name string `thrift:"name,3" form:"name" json:"name" query:"name"`
+	// 头像URL
+	Avatar *string `thrift:"avatar,4,optional" form:"avatar" json:"avatar,omitempty" query:"avatar"`
+	// 职业
+	Profession *string `thrift:"profession,5,optional" form:"profession" json:"profession,omitempty" query:"profession"`
+	// 其他属性 (所有值的字符串形式，兼容旧客户端)
 	Properties map[string]string `thrift:"properties,6,optional" form:"properties" json:"properties,omitempty" query:"properties"`
+	// 带类型的其他属性
+	TypedProperties map[string]*PropertyValue `thrift:"typed_properties,7,optional" form:"typed_properties" json:"typed_properties,omitempty" query:"typed_properties"`
 }
 
-func NewRelation() *Relation {
-	return &Relation{}
+func NewNode() *Node {
+	return &Node{}
 }
 
-func (p *Relation) InitDefault() {
+func (p *Node) InitDefault() {
 }
 
-func (p *Relation) GetID() (v string) {
+func (p *Node) GetID() (v string) {
 	return p.ID
 }
 
-func (p *Relation) GetSource() (v string) {
-	return p.Source
+func (p *Node) GetType() (v NodeType) {
+	return p.Type
 }
 
-func (p *Relation) GetTarget() (v string) {
-	return p.Target
+func (p *Node) GetName() (v string) {
+	return p.Name
 }
 
-func (p *Relation) GetType() (v RelationType) {
-	return p.Type
+var Node_Avatar_DEFAULT string
+
+func (p *Node) GetAvatar() (v string) {
+	if !p.IsSetAvatar() {
+		return Node_Avatar_DEFAULT
+	}
+	return *p.Avatar
 }
 
-var Relation_Label_DEFAULT string
+var Node_Profession_DEFAULT string
 
-func (p *Relation) GetLabel() (v string) {
-	if !p.IsSetLabel() {
-		return Relation_Label_DEFAULT
+func (p *Node) GetProfession() (v string) {
+	if !p.IsSetProfession() {
+		return Node_Profession_DEFAULT
 	}
-	return *p.Label
+	return *p.Profession
 }
 
-var Relation_Properties_DEFAULT map[string]string
+var Node_Properties_DEFAULT map[string]string
 
-func (p *Relation) GetProperties() (v map[string]string) {
+func (p *Node) GetProperties() (v map[string]string) {
 	if !p.IsSetProperties() {
-		return Relation_Properties_DEFAULT
+		return Node_Properties_DEFAULT
 	}
 	return p.Properties
 }
 
-var fieldIDToName_Relation = map[int16]string{
+var Node_TypedProperties_DEFAULT map[string]*PropertyValue
+
+func (p *Node) GetTypedProperties() (v map[string]*PropertyValue) {
+	if !p.IsSetTypedProperties() {
+		return Node_TypedProperties_DEFAULT
+	}
+	return p.TypedProperties
+}
+
+var fieldIDToName_Node = map[int16]string{
 	1: "id",
-	2: "source",
-	3: "target",
-	4: "type",
-	5: "label",
+	2: "type",
+	3: "name",
+	4: "avatar",
+	5: "profession",
 	6: "properties",
+	7: "typed_properties",
 }
 
-func (p *Relation) IsSetLabel() bool {
-	return p.Label != nil
+func (p *Node) IsSetAvatar() bool {
+	return p.Avatar != nil
 }
 
-func (p *Relation) IsSetProperties() bool {
+func (p *Node) IsSetProfession() bool {
+	return p.Profession != nil
+}
+
+func (p *Node) IsSetProperties() bool {
 	return p.Properties != nil
 }
 
-func (p *Relation) Read(iprot thrift.TProtocol) (err error) {
+func (p *Node) IsSetTypedProperties() bool {
+	return p.TypedProperties != nil
+}
+
+func (p *Node) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -655,7 +1110,7 @@ func (p *Relation) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -671,7 +1126,7 @@ func (p *Relation) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -694,6 +1149,14 @@ func (p *Relation) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 7:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -713,7 +1176,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Relation[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Node[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -723,7 +1186,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *Relation) ReadField1(iprot thrift.TProtocol) error {
+func (p *Node) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -734,18 +1197,18 @@ func (p *Relation) ReadField1(iprot thrift.TProtocol) error {
 	p.ID = _field
 	return nil
 }
-func (p *Relation) ReadField2(iprot thrift.TProtocol) error {
+func (p *Node) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field NodeType
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = NodeType(v)
 	}
-	p.Source = _field
+	p.Type = _field
 	return nil
 }
-func (p *Relation) ReadField3(iprot thrift.TProtocol) error {
+func (p *Node) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -753,21 +1216,21 @@ func (p *Relation) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Target = _field
+	p.Name = _field
 	return nil
 }
-func (p *Relation) ReadField4(iprot thrift.TProtocol) error {
+func (p *Node) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field RelationType
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = RelationType(v)
+		_field = &v
 	}
-	p.Type = _field
+	p.Avatar = _field
 	return nil
 }
-func (p *Relation) ReadField5(iprot thrift.TProtocol) error {
+func (p *Node) ReadField5(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -775,10 +1238,10 @@ func (p *Relation) ReadField5(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.Label = _field
+	p.Profession = _field
 	return nil
 }
-func (p *Relation) ReadField6(iprot thrift.TProtocol) error {
+func (p *Node) ReadField6(iprot thrift.TProtocol) error {
 	_, _, size, err := iprot.ReadMapBegin()
 	if err != nil {
 		return err
@@ -807,10 +1270,39 @@ func (p *Relation) ReadField6(iprot thrift.TProtocol) error {
 	p.Properties = _field
 	return nil
 }
+func (p *Node) ReadField7(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]*PropertyValue, size)
+	values := make([]PropertyValue, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
 
-func (p *Relation) Write(oprot thrift.TProtocol) (err error) {
+		_val := &values[i]
+		_val.InitDefault()
+		if err := _val.Read(iprot); err != nil {
+			return err
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.TypedProperties = _field
+	return nil
+}
+
+func (p *Node) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Relation"); err != nil {
+	if err = oprot.WriteStructBegin("Node"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -838,6 +1330,10 @@ func (p *Relation) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 6
 			goto WriteFieldError
 		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -856,7 +1352,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *Relation) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *Node) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -872,11 +1368,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *Relation) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("source", thrift.STRING, 2); err != nil {
+func (p *Node) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("type", thrift.I32, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Source); err != nil {
+	if err := oprot.WriteI32(int32(p.Type)); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -888,11 +1384,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *Relation) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("target", thrift.STRING, 3); err != nil {
+func (p *Node) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Target); err != nil {
+	if err := oprot.WriteString(p.Name); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -904,15 +1400,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *Relation) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("type", thrift.I32, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(int32(p.Type)); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *Node) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAvatar() {
+		if err = oprot.WriteFieldBegin("avatar", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Avatar); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -920,12 +1418,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *Relation) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLabel() {
-		if err = oprot.WriteFieldBegin("label", thrift.STRING, 5); err != nil {
+func (p *Node) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetProfession() {
+		if err = oprot.WriteFieldBegin("profession", thrift.STRING, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Label); err != nil {
+		if err := oprot.WriteString(*p.Profession); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -938,7 +1436,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-func (p *Relation) writeField6(oprot thrift.TProtocol) (err error) {
+func (p *Node) writeField6(oprot thrift.TProtocol) (err error) {
 	if p.IsSetProperties() {
 		if err = oprot.WriteFieldBegin("properties", thrift.MAP, 6); err != nil {
 			goto WriteFieldBeginError
@@ -967,88 +1465,135 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
+func (p *Node) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTypedProperties() {
+		if err = oprot.WriteFieldBegin("typed_properties", thrift.MAP, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRUCT, len(p.TypedProperties)); err != nil {
+			return err
+		}
+		for k, v := range p.TypedProperties {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
 
-func (p *Relation) String() string {
+func (p *Node) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Relation(%+v)", *p)
+	return fmt.Sprintf("Node(%+v)", *p)
 
 }
 
-// =============== 节点 CRUD 操作 ===============
-// 创建节点请求
-type CreateNodeRequest struct {
-	Type       NodeType          `thrift:"type,1" form:"type" json:"type" query:"type"`
-	Name       string            `thrift:"name,2" form:"name" json:"name" query:"name"`
-	Avatar     *string           `thrift:"avatar,3,optional" form:"avatar" json:"avatar,omitempty" query:"avatar"`
-	Profession *string           `thrift:"profession,4,optional" form:"profession" json:"profession,omitempty" query:"profession"`
-	Properties map[string]string `thrift:"properties,5,optional" form:"properties" json:"properties,omitempty" query:"properties"`
+// 关系信息
+type Relation struct {
+	// 关系ID
+	ID string `thrift:"id,1" form:"id" json:"id" query:"id"`
+	// 起始节点ID
+	Source string `thrift:"source,2" form:"source" json:"source" query:"source"`
+	// 目标节点ID
+	Target string `thrift:"target,3" form:"target" json:"target" query:"target"`
+	// 关系类型
+	Type RelationType `thrift:"type,4" form:"type" json:"type" query:"type"`
+	// 关系标签
+	Label *string `thrift:"label,5,optional" form:"label" json:"label,omitempty" query:"label"`
+	// 关系属性 (所有值的字符串形式，兼容旧客户端)
+	Properties map[string]string `thrift:"properties,6,optional" form:"properties" json:"properties,omitempty" query:"properties"`
+	// 带类型的关系属性
+	TypedProperties map[string]*PropertyValue `thrift:"typed_properties,7,optional" form:"typed_properties" json:"typed_properties,omitempty" query:"typed_properties"`
 }
 
-func NewCreateNodeRequest() *CreateNodeRequest {
-	return &CreateNodeRequest{}
+func NewRelation() *Relation {
+	return &Relation{}
 }
 
-func (p *CreateNodeRequest) InitDefault() {
+func (p *Relation) InitDefault() {
 }
 
-func (p *CreateNodeRequest) GetType() (v NodeType) {
-	return p.Type
+func (p *Relation) GetID() (v string) {
+	return p.ID
 }
 
-func (p *CreateNodeRequest) GetName() (v string) {
-	return p.Name
+func (p *Relation) GetSource() (v string) {
+	return p.Source
 }
 
-var CreateNodeRequest_Avatar_DEFAULT string
+func (p *Relation) GetTarget() (v string) {
+	return p.Target
+}
 
-func (p *CreateNodeRequest) GetAvatar() (v string) {
-	if !p.IsSetAvatar() {
-		return CreateNodeRequest_Avatar_DEFAULT
-	}
-	return *p.Avatar
+func (p *Relation) GetType() (v RelationType) {
+	return p.Type
 }
 
-var CreateNodeRequest_Profession_DEFAULT string
+var Relation_Label_DEFAULT string
 
-func (p *CreateNodeRequest) GetProfession() (v string) {
-	if !p.IsSetProfession() {
-		return CreateNodeRequest_Profession_DEFAULT
+func (p *Relation) GetLabel() (v string) {
+	if !p.IsSetLabel() {
+		return Relation_Label_DEFAULT
 	}
-	return *p.Profession
+	return *p.Label
 }
 
-var CreateNodeRequest_Properties_DEFAULT map[string]string
+var Relation_Properties_DEFAULT map[string]string
 
-func (p *CreateNodeRequest) GetProperties() (v map[string]string) {
+func (p *Relation) GetProperties() (v map[string]string) {
 	if !p.IsSetProperties() {
-		return CreateNodeRequest_Properties_DEFAULT
+		return Relation_Properties_DEFAULT
 	}
 	return p.Properties
 }
 
-var fieldIDToName_CreateNodeRequest = map[int16]string{
-	1: "type",
-	2: "name",
-	3: "avatar",
-	4: "profession",
-	5: "properties",
+var Relation_TypedProperties_DEFAULT map[string]*PropertyValue
+
+func (p *Relation) GetTypedProperties() (v map[string]*PropertyValue) {
+	if !p.IsSetTypedProperties() {
+		return Relation_TypedProperties_DEFAULT
+	}
+	return p.TypedProperties
 }
 
-func (p *CreateNodeRequest) IsSetAvatar() bool {
-	return p.Avatar != nil
+var fieldIDToName_Relation = map[int16]string{
+	1: "id",
+	2: "source",
+	3: "target",
+	4: "type",
+	5: "label",
+	6: "properties",
+	7: "typed_properties",
 }
 
-func (p *CreateNodeRequest) IsSetProfession() bool {
-	return p.Profession != nil
+func (p *Relation) IsSetLabel() bool {
+	return p.Label != nil
 }
 
-func (p *CreateNodeRequest) IsSetProperties() bool {
+func (p *Relation) IsSetProperties() bool {
 	return p.Properties != nil
 }
 
-func (p *CreateNodeRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *Relation) IsSetTypedProperties() bool {
+	return p.TypedProperties != nil
+}
+
+func (p *Relation) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1067,7 +1612,7 @@ func (p *CreateNodeRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1091,7 +1636,7 @@ func (p *CreateNodeRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1099,13 +1644,29 @@ func (p *CreateNodeRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.MAP {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 6:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1125,7 +1686,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateNodeRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Relation[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1135,18 +1696,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CreateNodeRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *Relation) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field NodeType
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = NodeType(v)
+		_field = v
 	}
-	p.Type = _field
+	p.ID = _field
 	return nil
 }
-func (p *CreateNodeRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *Relation) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1154,21 +1715,32 @@ func (p *CreateNodeRequest) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Name = _field
+	p.Source = _field
 	return nil
 }
-func (p *CreateNodeRequest) ReadField3(iprot thrift.TProtocol) error {
+func (p *Relation) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *string
+	var _field string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.Avatar = _field
+	p.Target = _field
 	return nil
 }
-func (p *CreateNodeRequest) ReadField4(iprot thrift.TProtocol) error {
+func (p *Relation) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field RelationType
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = RelationType(v)
+	}
+	p.Type = _field
+	return nil
+}
+func (p *Relation) ReadField5(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1176,10 +1748,10 @@ func (p *CreateNodeRequest) ReadField4(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.Profession = _field
+	p.Label = _field
 	return nil
 }
-func (p *CreateNodeRequest) ReadField5(iprot thrift.TProtocol) error {
+func (p *Relation) ReadField6(iprot thrift.TProtocol) error {
 	_, _, size, err := iprot.ReadMapBegin()
 	if err != nil {
 		return err
@@ -1208,10 +1780,39 @@ func (p *CreateNodeRequest) ReadField5(iprot thrift.TProtocol) error {
 	p.Properties = _field
 	return nil
 }
+func (p *Relation) ReadField7(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]*PropertyValue, size)
+	values := make([]PropertyValue, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		_val := &values[i]
+		_val.InitDefault()
+		if err := _val.Read(iprot); err != nil {
+			return err
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.TypedProperties = _field
+	return nil
+}
 
-func (p *CreateNodeRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *Relation) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateNodeRequest"); err != nil {
+	if err = oprot.WriteStructBegin("Relation"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1235,6 +1836,14 @@ func (p *CreateNodeRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1253,11 +1862,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CreateNodeRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("type", thrift.I32, 1); err != nil {
+func (p *Relation) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(int32(p.Type)); err != nil {
+	if err := oprot.WriteString(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1269,11 +1878,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *CreateNodeRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
+func (p *Relation) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("source", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Name); err != nil {
+	if err := oprot.WriteString(p.Source); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1285,12 +1894,44 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *CreateNodeRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAvatar() {
-		if err = oprot.WriteFieldBegin("avatar", thrift.STRING, 3); err != nil {
+func (p *Relation) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("target", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Target); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *Relation) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("type", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(int32(p.Type)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *Relation) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabel() {
+		if err = oprot.WriteFieldBegin("label", thrift.STRING, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Avatar); err != nil {
+		if err := oprot.WriteString(*p.Label); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -1299,16 +1940,27 @@ func (p *CreateNodeRequest) writeField3(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-func (p *CreateNodeRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetProfession() {
-		if err = oprot.WriteFieldBegin("profession", thrift.STRING, 4); err != nil {
+func (p *Relation) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetProperties() {
+		if err = oprot.WriteFieldBegin("properties", thrift.MAP, 6); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Profession); err != nil {
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Properties)); err != nil {
+			return err
+		}
+		for k, v := range p.Properties {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -1317,23 +1969,23 @@ func (p *CreateNodeRequest) writeField4(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
-func (p *CreateNodeRequest) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetProperties() {
-		if err = oprot.WriteFieldBegin("properties", thrift.MAP, 5); err != nil {
+func (p *Relation) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTypedProperties() {
+		if err = oprot.WriteFieldBegin("typed_properties", thrift.MAP, 7); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Properties)); err != nil {
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRUCT, len(p.TypedProperties)); err != nil {
 			return err
 		}
-		for k, v := range p.Properties {
+		for k, v := range p.TypedProperties {
 			if err := oprot.WriteString(k); err != nil {
 				return err
 			}
-			if err := oprot.WriteString(v); err != nil {
+			if err := v.Write(oprot); err != nil {
 				return err
 			}
 		}
@@ -1346,61 +1998,108 @@ func (p *CreateNodeRequest) writeField5(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *CreateNodeRequest) String() string {
+func (p *Relation) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CreateNodeRequest(%+v)", *p)
+	return fmt.Sprintf("Relation(%+v)", *p)
 
 }
 
-// 创建节点响应
-type CreateNodeResponse struct {
-	Success bool   `thrift:"success,1" form:"success" json:"success" query:"success"`
-	Message string `thrift:"message,2" form:"message" json:"message" query:"message"`
-	Node    *Node  `thrift:"node,3" form:"node" json:"node" query:"node"`
+// =============== 节点 CRUD 操作 ===============
+// 创建节点请求
+type CreateNodeRequest struct {
+	Type       NodeType          `thrift:"type,1" form:"type" json:"type" query:"type"`
+	Name       string            `thrift:"name,2" form:"name" json:"name" query:"name"`
+	Avatar     *string           `thrift:"avatar,3,optional" form:"avatar" json:"avatar,omitempty" query:"avatar"`
+	Profession *string           `thrift:"profession,4,optional" form:"profession" json:"profession,omitempty" query:"profession"`
+	Properties map[string]string `thrift:"properties,5,optional" form:"properties" json:"properties,omitempty" query:"properties"`
+	// 带类型的属性，与 properties 中的同名键冲突时优先
+	TypedProperties map[string]*PropertyValue `thrift:"typed_properties,6,optional" form:"typed_properties" json:"typed_properties,omitempty" query:"typed_properties"`
 }
 
-func NewCreateNodeResponse() *CreateNodeResponse {
-	return &CreateNodeResponse{}
+func NewCreateNodeRequest() *CreateNodeRequest {
+	return &CreateNodeRequest{}
 }
 
-func (p *CreateNodeResponse) InitDefault() {
+func (p *CreateNodeRequest) InitDefault() {
 }
 
-func (p *CreateNodeResponse) GetSuccess() (v bool) {
-	return p.Success
+func (p *CreateNodeRequest) GetType() (v NodeType) {
+	return p.Type
 }
 
-func (p *CreateNodeResponse) GetMessage() (v string) {
-	return p.Message
+func (p *CreateNodeRequest) GetName() (v string) {
+	return p.Name
 }
 
-var CreateNodeResponse_Node_DEFAULT *Node
+var CreateNodeRequest_Avatar_DEFAULT string
 
-func (p *CreateNodeResponse) GetNode() (v *Node) {
-	if !p.IsSetNode() {
-		return CreateNodeResponse_Node_DEFAULT
+func (p *CreateNodeRequest) GetAvatar() (v string) {
+	if !p.IsSetAvatar() {
+		return CreateNodeRequest_Avatar_DEFAULT
 	}
-	return p.Node
+	return *p.Avatar
 }
 
-var fieldIDToName_CreateNodeResponse = map[int16]string{
-	1: "success",
-	2: "message",
-	3: "node",
+var CreateNodeRequest_Profession_DEFAULT string
+
+func (p *CreateNodeRequest) GetProfession() (v string) {
+	if !p.IsSetProfession() {
+		return CreateNodeRequest_Profession_DEFAULT
+	}
+	return *p.Profession
 }
 
-func (p *CreateNodeResponse) IsSetNode() bool {
-	return p.Node != nil
+var CreateNodeRequest_Properties_DEFAULT map[string]string
+
+func (p *CreateNodeRequest) GetProperties() (v map[string]string) {
+	if !p.IsSetProperties() {
+		return CreateNodeRequest_Properties_DEFAULT
+	}
+	return p.Properties
 }
 
-func (p *CreateNodeResponse) Read(iprot thrift.TProtocol) (err error) {
+var CreateNodeRequest_TypedProperties_DEFAULT map[string]*PropertyValue
+
+func (p *CreateNodeRequest) GetTypedProperties() (v map[string]*PropertyValue) {
+	if !p.IsSetTypedProperties() {
+		return CreateNodeRequest_TypedProperties_DEFAULT
+	}
+	return p.TypedProperties
+}
+
+var fieldIDToName_CreateNodeRequest = map[int16]string{
+	1: "type",
+	2: "name",
+	3: "avatar",
+	4: "profession",
+	5: "properties",
+	6: "typed_properties",
+}
+
+func (p *CreateNodeRequest) IsSetAvatar() bool {
+	return p.Avatar != nil
+}
+
+func (p *CreateNodeRequest) IsSetProfession() bool {
+	return p.Profession != nil
+}
+
+func (p *CreateNodeRequest) IsSetProperties() bool {
+	return p.Properties != nil
+}
+
+func (p *CreateNodeRequest) IsSetTypedProperties() bool {
+	return p.TypedProperties != nil
+}
+
+func (p *CreateNodeRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1419,7 +2118,7 @@ func (p *CreateNodeResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.BOOL {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1435,13 +2134,37 @@ func (p *CreateNodeResponse) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1461,7 +2184,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateNodeResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateNodeRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1471,18 +2194,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CreateNodeResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *CreateNodeRequest) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field bool
-	if v, err := iprot.ReadBool(); err != nil {
+	var _field NodeType
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = NodeType(v)
 	}
-	p.Success = _field
+	p.Type = _field
 	return nil
 }
-func (p *CreateNodeResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *CreateNodeRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1490,46 +2213,130 @@ func (p *CreateNodeResponse) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Message = _field
+	p.Name = _field
 	return nil
 }
-func (p *CreateNodeResponse) ReadField3(iprot thrift.TProtocol) error {
-	_field := NewNode()
-	if err := _field.Read(iprot); err != nil {
+func (p *CreateNodeRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Node = _field
+	p.Avatar = _field
 	return nil
 }
+func (p *CreateNodeRequest) ReadField4(iprot thrift.TProtocol) error {
 
-func (p *CreateNodeResponse) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateNodeResponse"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
+	p.Profession = _field
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+}
+func (p *CreateNodeRequest) ReadField5(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Properties = _field
+	return nil
+}
+func (p *CreateNodeRequest) ReadField6(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]*PropertyValue, size)
+	values := make([]PropertyValue, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		_val := &values[i]
+		_val.InitDefault()
+		if err := _val.Read(iprot); err != nil {
+			return err
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.TypedProperties = _field
+	return nil
+}
+
+func (p *CreateNodeRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateNodeRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 WriteFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
 WriteFieldStopError:
@@ -1538,11 +2345,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CreateNodeResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+func (p *CreateNodeRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("type", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteBool(p.Success); err != nil {
+	if err := oprot.WriteI32(int32(p.Type)); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1554,11 +2361,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *CreateNodeResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+func (p *CreateNodeRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Message); err != nil {
+	if err := oprot.WriteString(p.Name); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1570,15 +2377,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *CreateNodeResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("node", thrift.STRUCT, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := p.Node.Write(oprot); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *CreateNodeRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAvatar() {
+		if err = oprot.WriteFieldBegin("avatar", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Avatar); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -1586,97 +2395,149 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *CreateNodeRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetProfession() {
+		if err = oprot.WriteFieldBegin("profession", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Profession); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *CreateNodeRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetProperties() {
+		if err = oprot.WriteFieldBegin("properties", thrift.MAP, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Properties)); err != nil {
+			return err
+		}
+		for k, v := range p.Properties {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *CreateNodeRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTypedProperties() {
+		if err = oprot.WriteFieldBegin("typed_properties", thrift.MAP, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRUCT, len(p.TypedProperties)); err != nil {
+			return err
+		}
+		for k, v := range p.TypedProperties {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
 
-func (p *CreateNodeResponse) String() string {
+func (p *CreateNodeRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CreateNodeResponse(%+v)", *p)
+	return fmt.Sprintf("CreateNodeRequest(%+v)", *p)
 
 }
 
-// 更新节点请求
-type UpdateNodeRequest struct {
-	// 要更新的节点ID
-	ID         string            `thrift:"id,1" form:"id" json:"id" query:"id"`
-	Name       *string           `thrift:"name,2,optional" form:"name" json:"name,omitempty" query:"name"`
-	Avatar     *string           `thrift:"avatar,3,optional" form:"avatar" json:"avatar,omitempty" query:"avatar"`
-	Profession *string           `thrift:"profession,4,optional" form:"profession" json:"profession,omitempty" query:"profession"`
-	Properties map[string]string `thrift:"properties,5,optional" form:"properties" json:"properties,omitempty" query:"properties"`
+// 创建节点响应
+type CreateNodeResponse struct {
+	Success bool   `thrift:"success,1" form:"success" json:"success" query:"success"`
+	Message string `thrift:"message,2" form:"message" json:"message" query:"message"`
+	Node    *Node  `thrift:"node,3" form:"node" json:"node" query:"node"`
+	// 属性不符合类型约束时的逐项错误
+	FieldErrors []*FieldError `thrift:"field_errors,4,optional" form:"field_errors" json:"field_errors,omitempty" query:"field_errors"`
 }
 
-func NewUpdateNodeRequest() *UpdateNodeRequest {
-	return &UpdateNodeRequest{}
+func NewCreateNodeResponse() *CreateNodeResponse {
+	return &CreateNodeResponse{}
 }
 
-func (p *UpdateNodeRequest) InitDefault() {
+func (p *CreateNodeResponse) InitDefault() {
 }
 
-func (p *UpdateNodeRequest) GetID() (v string) {
-	return p.ID
+func (p *CreateNodeResponse) GetSuccess() (v bool) {
+	return p.Success
 }
 
-var UpdateNodeRequest_Name_DEFAULT string
+func (p *CreateNodeResponse) GetMessage() (v string) {
+	return p.Message
+}
 
-func (p *UpdateNodeRequest) GetName() (v string) {
-	if !p.IsSetName() {
-		return UpdateNodeRequest_Name_DEFAULT
+var CreateNodeResponse_Node_DEFAULT *Node
+
+func (p *CreateNodeResponse) GetNode() (v *Node) {
+	if !p.IsSetNode() {
+		return CreateNodeResponse_Node_DEFAULT
 	}
-	return *p.Name
+	return p.Node
 }
 
-var UpdateNodeRequest_Avatar_DEFAULT string
+var CreateNodeResponse_FieldErrors_DEFAULT []*FieldError
 
-func (p *UpdateNodeRequest) GetAvatar() (v string) {
-	if !p.IsSetAvatar() {
-		return UpdateNodeRequest_Avatar_DEFAULT
+func (p *CreateNodeResponse) GetFieldErrors() (v []*FieldError) {
+	if !p.IsSetFieldErrors() {
+		return CreateNodeResponse_FieldErrors_DEFAULT
 	}
-	return *p.Avatar
+	return p.FieldErrors
 }
 
-var UpdateNodeRequest_Profession_DEFAULT string
+var fieldIDToName_CreateNodeResponse = map[int16]string{
+	1: "success",
+	2: "message",
+	3: "node",
+	4: "field_errors",
+}
 
-func (p *UpdateNodeRequest) GetProfession() (v string) {
-	if !p.IsSetProfession() {
-		return UpdateNodeRequest_Profession_DEFAULT
-	}
-	return *p.Profession
+func (p *CreateNodeResponse) IsSetNode() bool {
+	return p.Node != nil
 }
 
-var UpdateNodeRequest_Properties_DEFAULT map[string]string
+func (p *CreateNodeResponse) IsSetFieldErrors() bool {
+	return p.FieldErrors != nil
+}
 
-func (p *UpdateNodeRequest) GetProperties() (v map[string]string) {
-	if !p.IsSetProperties() {
-		return UpdateNodeRequest_Properties_DEFAULT
-	}
-	return p.Properties
-}
-
-var fieldIDToName_UpdateNodeRequest = map[int16]string{
-	1: "id",
-	2: "name",
-	3: "avatar",
-	4: "profession",
-	5: "properties",
-}
-
-func (p *UpdateNodeRequest) IsSetName() bool {
-	return p.Name != nil
-}
-
-func (p *UpdateNodeRequest) IsSetAvatar() bool {
-	return p.Avatar != nil
-}
-
-func (p *UpdateNodeRequest) IsSetProfession() bool {
-	return p.Profession != nil
-}
-
-func (p *UpdateNodeRequest) IsSetProperties() bool {
-	return p.Properties != nil
-}
-
-func (p *UpdateNodeRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *CreateNodeResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1695,7 +2556,7 @@ func (p *UpdateNodeRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.BOOL {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1711,7 +2572,7 @@ func (p *UpdateNodeRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1719,21 +2580,13 @@ func (p *UpdateNodeRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 5:
-			if fieldTypeId == thrift.MAP {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1753,7 +2606,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateNodeRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateNodeResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1763,83 +2616,63 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateNodeRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *CreateNodeResponse) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.ID = _field
-	return nil
-}
-func (p *UpdateNodeRequest) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Name = _field
+	p.Success = _field
 	return nil
 }
-func (p *UpdateNodeRequest) ReadField3(iprot thrift.TProtocol) error {
+func (p *CreateNodeResponse) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *string
+	var _field string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.Avatar = _field
+	p.Message = _field
 	return nil
 }
-func (p *UpdateNodeRequest) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *CreateNodeResponse) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewNode()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Profession = _field
+	p.Node = _field
 	return nil
 }
-func (p *UpdateNodeRequest) ReadField5(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
+func (p *CreateNodeResponse) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make(map[string]string, size)
+	_field := make([]*FieldError, 0, size)
+	values := make([]FieldError, size)
 	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
+		_elem := &values[i]
+		_elem.InitDefault()
 
-		var _val string
-		if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
 			return err
-		} else {
-			_val = v
 		}
 
-		_field[_key] = _val
+		_field = append(_field, _elem)
 	}
-	if err := iprot.ReadMapEnd(); err != nil {
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.Properties = _field
+	p.FieldErrors = _field
 	return nil
 }
 
-func (p *UpdateNodeRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *CreateNodeResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateNodeRequest"); err != nil {
+	if err = oprot.WriteStructBegin("CreateNodeResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1859,10 +2692,6 @@ func (p *UpdateNodeRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1881,11 +2710,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateNodeRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+func (p *CreateNodeResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ID); err != nil {
+	if err := oprot.WriteBool(p.Success); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1897,17 +2726,15 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *UpdateNodeRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetName() {
-		if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Name); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *CreateNodeResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -1915,17 +2742,15 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *UpdateNodeRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAvatar() {
-		if err = oprot.WriteFieldBegin("avatar", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Avatar); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *CreateNodeResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("node", thrift.STRUCT, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Node.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -1933,41 +2758,20 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *UpdateNodeRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetProfession() {
-		if err = oprot.WriteFieldBegin("profession", thrift.STRING, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Profession); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-func (p *UpdateNodeRequest) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetProperties() {
-		if err = oprot.WriteFieldBegin("properties", thrift.MAP, 5); err != nil {
+func (p *CreateNodeResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFieldErrors() {
+		if err = oprot.WriteFieldBegin("field_errors", thrift.LIST, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Properties)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FieldErrors)); err != nil {
 			return err
 		}
-		for k, v := range p.Properties {
-			if err := oprot.WriteString(k); err != nil {
-				return err
-			}
-			if err := oprot.WriteString(v); err != nil {
+		for _, v := range p.FieldErrors {
+			if err := v.Write(oprot); err != nil {
 				return err
 			}
 		}
-		if err := oprot.WriteMapEnd(); err != nil {
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -1976,61 +2780,117 @@ func (p *UpdateNodeRequest) writeField5(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *UpdateNodeRequest) String() string {
+func (p *CreateNodeResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateNodeRequest(%+v)", *p)
+	return fmt.Sprintf("CreateNodeResponse(%+v)", *p)
 
 }
 
-// 更新节点响应
-type UpdateNodeResponse struct {
-	Success bool   `thrift:"success,1" form:"success" json:"success" query:"success"`
-	Message string `thrift:"message,2" form:"message" json:"message" query:"message"`
-	Node    *Node  `thrift:"node,3" form:"node" json:"node" query:"node"`
+// 更新节点请求
+type UpdateNodeRequest struct {
+	// 要更新的节点ID
+	ID         string            `thrift:"id,1" form:"id" json:"id" query:"id"`
+	Name       *string           `thrift:"name,2,optional" form:"name" json:"name,omitempty" query:"name"`
+	Avatar     *string           `thrift:"avatar,3,optional" form:"avatar" json:"avatar,omitempty" query:"avatar"`
+	Profession *string           `thrift:"profession,4,optional" form:"profession" json:"profession,omitempty" query:"profession"`
+	Properties map[string]string `thrift:"properties,5,optional" form:"properties" json:"properties,omitempty" query:"properties"`
+	// 带类型的属性，与 properties 中的同名键冲突时优先
+	TypedProperties map[string]*PropertyValue `thrift:"typed_properties,6,optional" form:"typed_properties" json:"typed_properties,omitempty" query:"typed_properties"`
 }
 
-func NewUpdateNodeResponse() *UpdateNodeResponse {
-	return &UpdateNodeResponse{}
+func NewUpdateNodeRequest() *UpdateNodeRequest {
+	return &UpdateNodeRequest{}
 }
 
-func (p *UpdateNodeResponse) InitDefault() {
+func (p *UpdateNodeRequest) InitDefault() {
 }
 
-func (p *UpdateNodeResponse) GetSuccess() (v bool) {
-	return p.Success
+func (p *UpdateNodeRequest) GetID() (v string) {
+	return p.ID
 }
 
-func (p *UpdateNodeResponse) GetMessage() (v string) {
-	return p.Message
+var UpdateNodeRequest_Name_DEFAULT string
+
+func (p *UpdateNodeRequest) GetName() (v string) {
+	if !p.IsSetName() {
+		return UpdateNodeRequest_Name_DEFAULT
+	}
+	return *p.Name
 }
 
-var UpdateNodeResponse_Node_DEFAULT *Node
+var UpdateNodeRequest_Avatar_DEFAULT string
 
-func (p *UpdateNodeResponse) GetNode() (v *Node) {
-	if !p.IsSetNode() {
-		return UpdateNodeResponse_Node_DEFAULT
+func (p *UpdateNodeRequest) GetAvatar() (v string) {
+	if !p.IsSetAvatar() {
+		return UpdateNodeRequest_Avatar_DEFAULT
 	}
-	return p.Node
+	return *p.Avatar
 }
 
-var fieldIDToName_UpdateNodeResponse = map[int16]string{
-	1: "success",
-	2: "message",
-	3: "node",
-}
+var UpdateNodeRequest_Profession_DEFAULT string
 
-func (p *UpdateNodeResponse) IsSetNode() bool {
-	return p.Node != nil
+func (p *UpdateNodeRequest) GetProfession() (v string) {
+	if !p.IsSetProfession() {
+		return UpdateNodeRequest_Profession_DEFAULT
+	}
+	return *p.Profession
 }
 
-func (p *UpdateNodeResponse) Read(iprot thrift.TProtocol) (err error) {
+var UpdateNodeRequest_Properties_DEFAULT map[string]string
+
+func (p *UpdateNodeRequest) GetProperties() (v map[string]string) {
+	if !p.IsSetProperties() {
+		return UpdateNodeRequest_Properties_DEFAULT
+	}
+	return p.Properties
+}
+
+var UpdateNodeRequest_TypedProperties_DEFAULT map[string]*PropertyValue
+
+func (p *UpdateNodeRequest) GetTypedProperties() (v map[string]*PropertyValue) {
+	if !p.IsSetTypedProperties() {
+		return UpdateNodeRequest_TypedProperties_DEFAULT
+	}
+	return p.TypedProperties
+}
+
+var fieldIDToName_UpdateNodeRequest = map[int16]string{
+	1: "id",
+	2: "name",
+	3: "avatar",
+	4: "profession",
+	5: "properties",
+	6: "typed_properties",
+}
+
+func (p *UpdateNodeRequest) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *UpdateNodeRequest) IsSetAvatar() bool {
+	return p.Avatar != nil
+}
+
+func (p *UpdateNodeRequest) IsSetProfession() bool {
+	return p.Profession != nil
+}
+
+func (p *UpdateNodeRequest) IsSetProperties() bool {
+	return p.Properties != nil
+}
+
+func (p *UpdateNodeRequest) IsSetTypedProperties() bool {
+	return p.TypedProperties != nil
+}
+
+func (p *UpdateNodeRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2049,7 +2909,7 @@ func (p *UpdateNodeResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.BOOL {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2065,13 +2925,37 @@ func (p *UpdateNodeResponse) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2091,7 +2975,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateNodeResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateNodeRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2101,40 +2985,112 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateNodeResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *UpdateNodeRequest) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field bool
-	if v, err := iprot.ReadBool(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Success = _field
+	p.ID = _field
 	return nil
 }
-func (p *UpdateNodeResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *UpdateNodeRequest) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field string
+	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.Message = _field
+	p.Name = _field
 	return nil
 }
-func (p *UpdateNodeResponse) ReadField3(iprot thrift.TProtocol) error {
-	_field := NewNode()
-	if err := _field.Read(iprot); err != nil {
+func (p *UpdateNodeRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Node = _field
+	p.Avatar = _field
 	return nil
 }
+func (p *UpdateNodeRequest) ReadField4(iprot thrift.TProtocol) error {
 
-func (p *UpdateNodeResponse) Write(oprot thrift.TProtocol) (err error) {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Profession = _field
+	return nil
+}
+func (p *UpdateNodeRequest) ReadField5(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Properties = _field
+	return nil
+}
+func (p *UpdateNodeRequest) ReadField6(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]*PropertyValue, size)
+	values := make([]PropertyValue, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		_val := &values[i]
+		_val.InitDefault()
+		if err := _val.Read(iprot); err != nil {
+			return err
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.TypedProperties = _field
+	return nil
+}
+
+func (p *UpdateNodeRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateNodeResponse"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateNodeRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2150,6 +3106,18 @@ func (p *UpdateNodeResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2168,11 +3136,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateNodeResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+func (p *UpdateNodeRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteBool(p.Success); err != nil {
+	if err := oprot.WriteString(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2184,15 +3152,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *UpdateNodeResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Message); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *UpdateNodeRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Name); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -2200,15 +3170,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *UpdateNodeResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("node", thrift.STRUCT, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := p.Node.Write(oprot); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *UpdateNodeRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAvatar() {
+		if err = oprot.WriteFieldBegin("avatar", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Avatar); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -2216,197 +3188,149 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-
-func (p *UpdateNodeResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *UpdateNodeRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetProfession() {
+		if err = oprot.WriteFieldBegin("profession", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Profession); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return fmt.Sprintf("UpdateNodeResponse(%+v)", *p)
-
-}
-
-// 获取节点请求
-type GetNodeRequest struct {
-	ID string `thrift:"id,1" form:"id" json:"id" query:"id"`
-}
-
-func NewGetNodeRequest() *GetNodeRequest {
-	return &GetNodeRequest{}
-}
-
-func (p *GetNodeRequest) InitDefault() {
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-
-func (p *GetNodeRequest) GetID() (v string) {
-	return p.ID
-}
-
-var fieldIDToName_GetNodeRequest = map[int16]string{
-	1: "id",
-}
-
-func (p *GetNodeRequest) Read(iprot thrift.TProtocol) (err error) {
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
-	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
+func (p *UpdateNodeRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetProperties() {
+		if err = oprot.WriteFieldBegin("properties", thrift.MAP, 5); err != nil {
+			goto WriteFieldBeginError
 		}
-		if fieldTypeId == thrift.STOP {
-			break
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Properties)); err != nil {
+			return err
 		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
+		for k, v := range p.Properties {
+			if err := oprot.WriteString(k); err != nil {
+				return err
 			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
+			if err := oprot.WriteString(v); err != nil {
+				return err
 			}
 		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
 		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
-	}
-
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetNodeRequest[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-}
-
-func (p *GetNodeRequest) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ID = _field
-	return nil
-}
-
-func (p *GetNodeRequest) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("GetNodeRequest"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
 	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
-	}
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-
-func (p *GetNodeRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.ID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *UpdateNodeRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTypedProperties() {
+		if err = oprot.WriteFieldBegin("typed_properties", thrift.MAP, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRUCT, len(p.TypedProperties)); err != nil {
+			return err
+		}
+		for k, v := range p.TypedProperties {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *GetNodeRequest) String() string {
+func (p *UpdateNodeRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetNodeRequest(%+v)", *p)
+	return fmt.Sprintf("UpdateNodeRequest(%+v)", *p)
 
 }
 
-// 获取节点响应
-type GetNodeResponse struct {
+// 更新节点响应
+type UpdateNodeResponse struct {
 	Success bool   `thrift:"success,1" form:"success" json:"success" query:"success"`
 	Message string `thrift:"message,2" form:"message" json:"message" query:"message"`
 	Node    *Node  `thrift:"node,3" form:"node" json:"node" query:"node"`
+	// 属性不符合类型约束时的逐项错误
+	FieldErrors []*FieldError `thrift:"field_errors,4,optional" form:"field_errors" json:"field_errors,omitempty" query:"field_errors"`
 }
 
-func NewGetNodeResponse() *GetNodeResponse {
-	return &GetNodeResponse{}
+func NewUpdateNodeResponse() *UpdateNodeResponse {
+	return &UpdateNodeResponse{}
 }
 
-func (p *GetNodeResponse) InitDefault() {
+func (p *UpdateNodeResponse) InitDefault() {
 }
 
-func (p *GetNodeResponse) GetSuccess() (v bool) {
+func (p *UpdateNodeResponse) GetSuccess() (v bool) {
 	return p.Success
 }
 
-func (p *GetNodeResponse) GetMessage() (v string) {
+func (p *UpdateNodeResponse) GetMessage() (v string) {
 	return p.Message
 }
 
-var GetNodeResponse_Node_DEFAULT *Node
+var UpdateNodeResponse_Node_DEFAULT *Node
 
-func (p *GetNodeResponse) GetNode() (v *Node) {
+func (p *UpdateNodeResponse) GetNode() (v *Node) {
 	if !p.IsSetNode() {
-		return GetNodeResponse_Node_DEFAULT
+		return UpdateNodeResponse_Node_DEFAULT
 	}
 	return p.Node
 }
 
-var fieldIDToName_GetNodeResponse = map[int16]string{
+var UpdateNodeResponse_FieldErrors_DEFAULT []*FieldError
+
+func (p *UpdateNodeResponse) GetFieldErrors() (v []*FieldError) {
+	if !p.IsSetFieldErrors() {
+		return UpdateNodeResponse_FieldErrors_DEFAULT
+	}
+	return p.FieldErrors
+}
+
+var fieldIDToName_UpdateNodeResponse = map[int16]string{
 	1: "success",
 	2: "message",
 	3: "node",
+	4: "field_errors",
 }
 
-func (p *GetNodeResponse) IsSetNode() bool {
+func (p *UpdateNodeResponse) IsSetNode() bool {
 	return p.Node != nil
 }
 
-func (p *GetNodeResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *UpdateNodeResponse) IsSetFieldErrors() bool {
+	return p.FieldErrors != nil
+}
+
+func (p *UpdateNodeResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2448,6 +3372,14 @@ func (p *GetNodeResponse) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2467,7 +3399,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetNodeResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateNodeResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2477,7 +3409,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetNodeResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *UpdateNodeResponse) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field bool
 	if v, err := iprot.ReadBool(); err != nil {
@@ -2488,7 +3420,7 @@ func (p *GetNodeResponse) ReadField1(iprot thrift.TProtocol) error {
 	p.Success = _field
 	return nil
 }
-func (p *GetNodeResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *UpdateNodeResponse) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2499,7 +3431,7 @@ func (p *GetNodeResponse) ReadField2(iprot thrift.TProtocol) error {
 	p.Message = _field
 	return nil
 }
-func (p *GetNodeResponse) ReadField3(iprot thrift.TProtocol) error {
+func (p *UpdateNodeResponse) ReadField3(iprot thrift.TProtocol) error {
 	_field := NewNode()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -2507,10 +3439,33 @@ func (p *GetNodeResponse) ReadField3(iprot thrift.TProtocol) error {
 	p.Node = _field
 	return nil
 }
+func (p *UpdateNodeResponse) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*FieldError, 0, size)
+	values := make([]FieldError, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-func (p *GetNodeResponse) Write(oprot thrift.TProtocol) (err error) {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.FieldErrors = _field
+	return nil
+}
+
+func (p *UpdateNodeResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetNodeResponse"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateNodeResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2526,6 +3481,10 @@ func (p *GetNodeResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2544,7 +3503,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetNodeResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UpdateNodeResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2560,7 +3519,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *GetNodeResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *UpdateNodeResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2576,7 +3535,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *GetNodeResponse) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *UpdateNodeResponse) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("node", thrift.STRUCT, 3); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2592,36 +3551,62 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *UpdateNodeResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFieldErrors() {
+		if err = oprot.WriteFieldBegin("field_errors", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FieldErrors)); err != nil {
+			return err
+		}
+		for _, v := range p.FieldErrors {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
 
-func (p *GetNodeResponse) String() string {
+func (p *UpdateNodeResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetNodeResponse(%+v)", *p)
+	return fmt.Sprintf("UpdateNodeResponse(%+v)", *p)
 
 }
 
-// 删除节点请求
-type DeleteNodeRequest struct {
+// 获取节点请求
+type GetNodeRequest struct {
 	ID string `thrift:"id,1" form:"id" json:"id" query:"id"`
 }
 
-func NewDeleteNodeRequest() *DeleteNodeRequest {
-	return &DeleteNodeRequest{}
+func NewGetNodeRequest() *GetNodeRequest {
+	return &GetNodeRequest{}
 }
 
-func (p *DeleteNodeRequest) InitDefault() {
+func (p *GetNodeRequest) InitDefault() {
 }
 
-func (p *DeleteNodeRequest) GetID() (v string) {
+func (p *GetNodeRequest) GetID() (v string) {
 	return p.ID
 }
 
-var fieldIDToName_DeleteNodeRequest = map[int16]string{
+var fieldIDToName_GetNodeRequest = map[int16]string{
 	1: "id",
 }
 
-func (p *DeleteNodeRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetNodeRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2666,7 +3651,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteNodeRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetNodeRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2676,7 +3661,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DeleteNodeRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetNodeRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2688,9 +3673,9 @@ func (p *DeleteNodeRequest) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *DeleteNodeRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetNodeRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteNodeRequest"); err != nil {
+	if err = oprot.WriteStructBegin("GetNodeRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2716,7 +3701,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeleteNodeRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *GetNodeRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2733,41 +3718,56 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DeleteNodeRequest) String() string {
+func (p *GetNodeRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteNodeRequest(%+v)", *p)
+	return fmt.Sprintf("GetNodeRequest(%+v)", *p)
 
 }
 
-// 删除节点响应
-type DeleteNodeResponse struct {
+// 获取节点响应
+type GetNodeResponse struct {
 	Success bool   `thrift:"success,1" form:"success" json:"success" query:"success"`
 	Message string `thrift:"message,2" form:"message" json:"message" query:"message"`
+	Node    *Node  `thrift:"node,3" form:"node" json:"node" query:"node"`
 }
 
-func NewDeleteNodeResponse() *DeleteNodeResponse {
-	return &DeleteNodeResponse{}
+func NewGetNodeResponse() *GetNodeResponse {
+	return &GetNodeResponse{}
 }
 
-func (p *DeleteNodeResponse) InitDefault() {
+func (p *GetNodeResponse) InitDefault() {
 }
 
-func (p *DeleteNodeResponse) GetSuccess() (v bool) {
+func (p *GetNodeResponse) GetSuccess() (v bool) {
 	return p.Success
 }
 
-func (p *DeleteNodeResponse) GetMessage() (v string) {
+func (p *GetNodeResponse) GetMessage() (v string) {
 	return p.Message
 }
 
-var fieldIDToName_DeleteNodeResponse = map[int16]string{
+var GetNodeResponse_Node_DEFAULT *Node
+
+func (p *GetNodeResponse) GetNode() (v *Node) {
+	if !p.IsSetNode() {
+		return GetNodeResponse_Node_DEFAULT
+	}
+	return p.Node
+}
+
+var fieldIDToName_GetNodeResponse = map[int16]string{
 	1: "success",
 	2: "message",
+	3: "node",
 }
 
-func (p *DeleteNodeResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetNodeResponse) IsSetNode() bool {
+	return p.Node != nil
+}
+
+func (p *GetNodeResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2801,6 +3801,14 @@ func (p *DeleteNodeResponse) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2820,7 +3828,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteNodeResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetNodeResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2830,7 +3838,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DeleteNodeResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetNodeResponse) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field bool
 	if v, err := iprot.ReadBool(); err != nil {
@@ -2841,7 +3849,7 @@ func (p *DeleteNodeResponse) ReadField1(iprot thrift.TProtocol) error {
 	p.Success = _field
 	return nil
 }
-func (p *DeleteNodeResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *GetNodeResponse) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2852,10 +3860,18 @@ func (p *DeleteNodeResponse) ReadField2(iprot thrift.TProtocol) error {
 	p.Message = _field
 	return nil
 }
+func (p *GetNodeResponse) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewNode()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Node = _field
+	return nil
+}
 
-func (p *DeleteNodeResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetNodeResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteNodeResponse"); err != nil {
+	if err = oprot.WriteStructBegin("GetNodeResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2867,6 +3883,10 @@ func (p *DeleteNodeResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2885,7 +3905,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeleteNodeResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *GetNodeResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2901,7 +3921,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *DeleteNodeResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *GetNodeResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2917,94 +3937,52 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
-func (p *DeleteNodeResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *GetNodeResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("node", thrift.STRUCT, 3); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("DeleteNodeResponse(%+v)", *p)
-
-}
-
-// 搜索节点请求
-type SearchNodesRequest struct {
-	// 新增: 搜索条件 (key: 属性名, value: 搜索值)
-	Criteria map[string]string `thrift:"criteria,1,optional" form:"criteria" json:"criteria,omitempty" query:"criteria"`
-	// 节点类型(可选)
-	Type *NodeType `thrift:"type,2,optional" form:"type" json:"type,omitempty" query:"type"`
-	// 限制返回数量
-	Limit *int32 `thrift:"limit,3,optional" form:"limit" json:"limit,omitempty" query:"limit"`
-	// 偏移量，用于分页
-	Offset *int32 `thrift:"offset,4,optional" form:"offset" json:"offset,omitempty" query:"offset"`
-}
-
-func NewSearchNodesRequest() *SearchNodesRequest {
-	return &SearchNodesRequest{}
-}
-
-func (p *SearchNodesRequest) InitDefault() {
-}
-
-var SearchNodesRequest_Criteria_DEFAULT map[string]string
-
-func (p *SearchNodesRequest) GetCriteria() (v map[string]string) {
-	if !p.IsSetCriteria() {
-		return SearchNodesRequest_Criteria_DEFAULT
+	if err := p.Node.Write(oprot); err != nil {
+		return err
 	}
-	return p.Criteria
-}
-
-var SearchNodesRequest_Type_DEFAULT NodeType
-
-func (p *SearchNodesRequest) GetType() (v NodeType) {
-	if !p.IsSetType() {
-		return SearchNodesRequest_Type_DEFAULT
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return *p.Type
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-var SearchNodesRequest_Limit_DEFAULT int32
-
-func (p *SearchNodesRequest) GetLimit() (v int32) {
-	if !p.IsSetLimit() {
-		return SearchNodesRequest_Limit_DEFAULT
+func (p *GetNodeResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.Limit
-}
-
-var SearchNodesRequest_Offset_DEFAULT int32
+	return fmt.Sprintf("GetNodeResponse(%+v)", *p)
 
-func (p *SearchNodesRequest) GetOffset() (v int32) {
-	if !p.IsSetOffset() {
-		return SearchNodesRequest_Offset_DEFAULT
-	}
-	return *p.Offset
 }
 
-var fieldIDToName_SearchNodesRequest = map[int16]string{
-	1: "criteria",
-	2: "type",
-	3: "limit",
-	4: "offset",
+// 删除节点请求
+type DeleteNodeRequest struct {
+	ID string `thrift:"id,1" form:"id" json:"id" query:"id"`
 }
 
-func (p *SearchNodesRequest) IsSetCriteria() bool {
-	return p.Criteria != nil
+func NewDeleteNodeRequest() *DeleteNodeRequest {
+	return &DeleteNodeRequest{}
 }
 
-func (p *SearchNodesRequest) IsSetType() bool {
-	return p.Type != nil
+func (p *DeleteNodeRequest) InitDefault() {
 }
 
-func (p *SearchNodesRequest) IsSetLimit() bool {
-	return p.Limit != nil
+func (p *DeleteNodeRequest) GetID() (v string) {
+	return p.ID
 }
 
-func (p *SearchNodesRequest) IsSetOffset() bool {
-	return p.Offset != nil
+var fieldIDToName_DeleteNodeRequest = map[int16]string{
+	1: "id",
 }
 
-func (p *SearchNodesRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *DeleteNodeRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3023,37 +4001,13 @@ func (p *SearchNodesRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.MAP {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3073,7 +4027,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SearchNodesRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteNodeRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3083,73 +4037,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SearchNodesRequest) ReadField1(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return err
-	}
-	_field := make(map[string]string, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
-
-		var _val string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_val = v
-		}
-
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
-		return err
-	}
-	p.Criteria = _field
-	return nil
-}
-func (p *SearchNodesRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *DeleteNodeRequest) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *NodeType
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		tmp := NodeType(v)
-		_field = &tmp
+		_field = v
 	}
-	p.Type = _field
+	p.ID = _field
 	return nil
 }
-func (p *SearchNodesRequest) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Limit = _field
-	return nil
-}
-func (p *SearchNodesRequest) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Offset = _field
-	return nil
-}
-
-func (p *SearchNodesRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *DeleteNodeRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SearchNodesRequest"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteNodeRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3157,18 +4059,6 @@ func (p *SearchNodesRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3187,138 +4077,58 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SearchNodesRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCriteria() {
-		if err = oprot.WriteFieldBegin("criteria", thrift.MAP, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Criteria)); err != nil {
-			return err
-		}
-		for k, v := range p.Criteria {
-			if err := oprot.WriteString(k); err != nil {
-				return err
-			}
-			if err := oprot.WriteString(v); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteMapEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-func (p *SearchNodesRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetType() {
-		if err = oprot.WriteFieldBegin("type", thrift.I32, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(int32(*p.Type)); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *DeleteNodeRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-func (p *SearchNodesRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLimit() {
-		if err = oprot.WriteFieldBegin("limit", thrift.I32, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(*p.Limit); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	if err := oprot.WriteString(p.ID); err != nil {
+		return err
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *SearchNodesRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetOffset() {
-		if err = oprot.WriteFieldBegin("offset", thrift.I32, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(*p.Offset); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SearchNodesRequest) String() string {
+func (p *DeleteNodeRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SearchNodesRequest(%+v)", *p)
+	return fmt.Sprintf("DeleteNodeRequest(%+v)", *p)
 
 }
 
-// 搜索节点响应
-type SearchNodesResponse struct {
-	Success bool    `thrift:"success,1" form:"success" json:"success" query:"success"`
-	Message string  `thrift:"message,2" form:"message" json:"message" query:"message"`
-	Nodes   []*Node `thrift:"nodes,3" form:"nodes" json:"nodes" query:"nodes"`
-	// 总匹配数
-	Total int32 `thrift:"total,4" form:"total" json:"total" query:"total"`
+// 删除节点响应
+type DeleteNodeResponse struct {
+	Success bool   `thrift:"success,1" form:"success" json:"success" query:"success"`
+	Message string `thrift:"message,2" form:"message" json:"message" query:"message"`
 }
 
-func NewSearchNodesResponse() *SearchNodesResponse {
-	return &SearchNodesResponse{}
+func NewDeleteNodeResponse() *DeleteNodeResponse {
+	return &DeleteNodeResponse{}
 }
 
-func (p *SearchNodesResponse) InitDefault() {
+func (p *DeleteNodeResponse) InitDefault() {
 }
 
-func (p *SearchNodesResponse) GetSuccess() (v bool) {
+func (p *DeleteNodeResponse) GetSuccess() (v bool) {
 	return p.Success
 }
 
-func (p *SearchNodesResponse) GetMessage() (v string) {
+func (p *DeleteNodeResponse) GetMessage() (v string) {
 	return p.Message
 }
 
-func (p *SearchNodesResponse) GetNodes() (v []*Node) {
-	return p.Nodes
-}
-
-func (p *SearchNodesResponse) GetTotal() (v int32) {
-	return p.Total
-}
-
-var fieldIDToName_SearchNodesResponse = map[int16]string{
+var fieldIDToName_DeleteNodeResponse = map[int16]string{
 	1: "success",
 	2: "message",
-	3: "nodes",
-	4: "total",
 }
 
-func (p *SearchNodesResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *DeleteNodeResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3352,22 +4162,6 @@ func (p *SearchNodesResponse) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3387,7 +4181,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SearchNodesResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteNodeResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3397,7 +4191,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SearchNodesResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *DeleteNodeResponse) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field bool
 	if v, err := iprot.ReadBool(); err != nil {
@@ -3408,7 +4202,7 @@ func (p *SearchNodesResponse) ReadField1(iprot thrift.TProtocol) error {
 	p.Success = _field
 	return nil
 }
-func (p *SearchNodesResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *DeleteNodeResponse) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3419,44 +4213,10 @@ func (p *SearchNodesResponse) ReadField2(iprot thrift.TProtocol) error {
 	p.Message = _field
 	return nil
 }
-func (p *SearchNodesResponse) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*Node, 0, size)
-	values := make([]Node, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.Nodes = _field
-	return nil
-}
-func (p *SearchNodesResponse) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Total = _field
-	return nil
-}
 
-func (p *SearchNodesResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *DeleteNodeResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SearchNodesResponse"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteNodeResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3468,14 +4228,6 @@ func (p *SearchNodesResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3494,7 +4246,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SearchNodesResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DeleteNodeResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3510,7 +4262,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *SearchNodesResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *DeleteNodeResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3526,124 +4278,91 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *SearchNodesResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("nodes", thrift.LIST, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Nodes)); err != nil {
-		return err
-	}
-	for _, v := range p.Nodes {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+
+func (p *DeleteNodeResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *SearchNodesResponse) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("total", thrift.I32, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(p.Total); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *SearchNodesResponse) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("SearchNodesResponse(%+v)", *p)
+	return fmt.Sprintf("DeleteNodeResponse(%+v)", *p)
 
 }
 
-// =============== 关系 CRUD 操作 ===============
-// 创建关系请求
-type CreateRelationRequest struct {
-	// 源节点ID
-	Source string `thrift:"source,1" form:"source" json:"source" query:"source"`
-	// 目标节点ID
-	Target string `thrift:"target,2" form:"target" json:"target" query:"target"`
-	// 关系类型
-	Type RelationType `thrift:"type,3" form:"type" json:"type" query:"type"`
-	// 关系标签
-	Label *string `thrift:"label,4,optional" form:"label" json:"label,omitempty" query:"label"`
-	// 关系属性
-	Properties map[string]string `thrift:"properties,5,optional" form:"properties" json:"properties,omitempty" query:"properties"`
+// 单个属性条件
+type FilterCondition struct {
+	// 属性名 (包括 name、profession 等核心属性)
+	Field string   `thrift:"field,1" form:"field" json:"field" query:"field"`
+	Op    FilterOp `thrift:"op,2" form:"op" json:"op" query:"op"`
+	// 比较值，IN、EXISTS、NOT_EXISTS 不使用
+	Value *PropertyValue `thrift:"value,3,optional" form:"value" json:"value,omitempty" query:"value"`
+	// IN 的候选值
+	Values []*PropertyValue `thrift:"values,4,optional" form:"values" json:"values,omitempty" query:"values"`
+	// 字符串比较时忽略大小写
+	CaseInsensitive *bool `thrift:"case_insensitive,5,optional" form:"case_insensitive" json:"case_insensitive,omitempty" query:"case_insensitive"`
 }
 
-func NewCreateRelationRequest() *CreateRelationRequest {
-	return &CreateRelationRequest{}
+func NewFilterCondition() *FilterCondition {
+	return &FilterCondition{}
 }
 
-func (p *CreateRelationRequest) InitDefault() {
+func (p *FilterCondition) InitDefault() {
 }
 
-func (p *CreateRelationRequest) GetSource() (v string) {
-	return p.Source
+func (p *FilterCondition) GetField() (v string) {
+	return p.Field
 }
 
-func (p *CreateRelationRequest) GetTarget() (v string) {
-	return p.Target
+func (p *FilterCondition) GetOp() (v FilterOp) {
+	return p.Op
 }
 
-func (p *CreateRelationRequest) GetType() (v RelationType) {
-	return p.Type
+var FilterCondition_Value_DEFAULT *PropertyValue
+
+func (p *FilterCondition) GetValue() (v *PropertyValue) {
+	if !p.IsSetValue() {
+		return FilterCondition_Value_DEFAULT
+	}
+	return p.Value
 }
 
-var CreateRelationRequest_Label_DEFAULT string
+var FilterCondition_Values_DEFAULT []*PropertyValue
 
-func (p *CreateRelationRequest) GetLabel() (v string) {
-	if !p.IsSetLabel() {
-		return CreateRelationRequest_Label_DEFAULT
+func (p *FilterCondition) GetValues() (v []*PropertyValue) {
+	if !p.IsSetValues() {
+		return FilterCondition_Values_DEFAULT
 	}
-	return *p.Label
+	return p.Values
 }
 
-var CreateRelationRequest_Properties_DEFAULT map[string]string
+var FilterCondition_CaseInsensitive_DEFAULT bool
 
-func (p *CreateRelationRequest) GetProperties() (v map[string]string) {
-	if !p.IsSetProperties() {
-		return CreateRelationRequest_Properties_DEFAULT
+func (p *FilterCondition) GetCaseInsensitive() (v bool) {
+	if !p.IsSetCaseInsensitive() {
+		return FilterCondition_CaseInsensitive_DEFAULT
 	}
-	return p.Properties
+	return *p.CaseInsensitive
 }
 
-var fieldIDToName_CreateRelationRequest = map[int16]string{
-	1: "source",
-	2: "target",
-	3: "type",
-	4: "label",
-	5: "properties",
+var fieldIDToName_FilterCondition = map[int16]string{
+	1: "field",
+	2: "op",
+	3: "value",
+	4: "values",
+	5: "case_insensitive",
 }
 
-func (p *CreateRelationRequest) IsSetLabel() bool {
-	return p.Label != nil
+func (p *FilterCondition) IsSetValue() bool {
+	return p.Value != nil
 }
 
-func (p *CreateRelationRequest) IsSetProperties() bool {
-	return p.Properties != nil
+func (p *FilterCondition) IsSetValues() bool {
+	return p.Values != nil
 }
 
-func (p *CreateRelationRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *FilterCondition) IsSetCaseInsensitive() bool {
+	return p.CaseInsensitive != nil
+}
+
+func (p *FilterCondition) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3670,7 +4389,7 @@ func (p *CreateRelationRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3678,7 +4397,7 @@ func (p *CreateRelationRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3686,7 +4405,7 @@ func (p *CreateRelationRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3694,7 +4413,7 @@ func (p *CreateRelationRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.MAP {
+			if fieldTypeId == thrift.BOOL {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3720,7 +4439,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateRelationRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FilterCondition[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3730,18 +4449,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CreateRelationRequest) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Source = _field
-	return nil
-}
-func (p *CreateRelationRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *FilterCondition) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3749,64 +4457,66 @@ func (p *CreateRelationRequest) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Target = _field
+	p.Field = _field
 	return nil
 }
-func (p *CreateRelationRequest) ReadField3(iprot thrift.TProtocol) error {
+func (p *FilterCondition) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field RelationType
+	var _field FilterOp
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		_field = RelationType(v)
+		_field = FilterOp(v)
 	}
-	p.Type = _field
+	p.Op = _field
 	return nil
 }
-func (p *CreateRelationRequest) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *FilterCondition) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewPropertyValue()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Label = _field
+	p.Value = _field
 	return nil
 }
-func (p *CreateRelationRequest) ReadField5(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
+func (p *FilterCondition) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make(map[string]string, size)
+	_field := make([]*PropertyValue, 0, size)
+	values := make([]PropertyValue, size)
 	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
+		_elem := &values[i]
+		_elem.InitDefault()
 
-		var _val string
-		if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
 			return err
-		} else {
-			_val = v
 		}
 
-		_field[_key] = _val
+		_field = append(_field, _elem)
 	}
-	if err := iprot.ReadMapEnd(); err != nil {
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.Properties = _field
+	p.Values = _field
 	return nil
 }
+func (p *FilterCondition) ReadField5(iprot thrift.TProtocol) error {
 
-func (p *CreateRelationRequest) Write(oprot thrift.TProtocol) (err error) {
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CaseInsensitive = _field
+	return nil
+}
+
+func (p *FilterCondition) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateRelationRequest"); err != nil {
+	if err = oprot.WriteStructBegin("FilterCondition"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3848,11 +4558,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CreateRelationRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("source", thrift.STRING, 1); err != nil {
+func (p *FilterCondition) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("field", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Source); err != nil {
+	if err := oprot.WriteString(p.Field); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3864,11 +4574,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *CreateRelationRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("target", thrift.STRING, 2); err != nil {
+func (p *FilterCondition) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("op", thrift.I32, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Target); err != nil {
+	if err := oprot.WriteI32(int32(p.Op)); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3880,15 +4590,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *CreateRelationRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("type", thrift.I32, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(int32(p.Type)); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *FilterCondition) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetValue() {
+		if err = oprot.WriteFieldBegin("value", thrift.STRUCT, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Value.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -3896,12 +4608,20 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *CreateRelationRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLabel() {
-		if err = oprot.WriteFieldBegin("label", thrift.STRING, 4); err != nil {
+func (p *FilterCondition) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetValues() {
+		if err = oprot.WriteFieldBegin("values", thrift.LIST, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Label); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Values)); err != nil {
+			return err
+		}
+		for _, v := range p.Values {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3914,23 +4634,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *CreateRelationRequest) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetProperties() {
-		if err = oprot.WriteFieldBegin("properties", thrift.MAP, 5); err != nil {
+func (p *FilterCondition) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCaseInsensitive() {
+		if err = oprot.WriteFieldBegin("case_insensitive", thrift.BOOL, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Properties)); err != nil {
-			return err
-		}
-		for k, v := range p.Properties {
-			if err := oprot.WriteString(k); err != nil {
-				return err
-			}
-			if err := oprot.WriteString(v); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteMapEnd(); err != nil {
+		if err := oprot.WriteBool(*p.CaseInsensitive); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3944,56 +4653,74 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *CreateRelationRequest) String() string {
+func (p *FilterCondition) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CreateRelationRequest(%+v)", *p)
+	return fmt.Sprintf("FilterCondition(%+v)", *p)
 
 }
 
-// 创建关系响应
-type CreateRelationResponse struct {
-	Success  bool      `thrift:"success,1" form:"success" json:"success" query:"success"`
-	Message  string    `thrift:"message,2" form:"message" json:"message" query:"message"`
-	Relation *Relation `thrift:"relation,3" form:"relation" json:"relation" query:"relation"`
+// 过滤表达式，conditions 和 groups 中的各项按 logic 组合 (默认 AND)，groups 可以嵌套
+type FilterExpr struct {
+	Logic      *FilterLogic       `thrift:"logic,1,optional" form:"logic" json:"logic,omitempty" query:"logic"`
+	Conditions []*FilterCondition `thrift:"conditions,2,optional" form:"conditions" json:"conditions,omitempty" query:"conditions"`
+	Groups     []*FilterExpr      `thrift:"groups,3,optional" form:"groups" json:"groups,omitempty" query:"groups"`
 }
 
-func NewCreateRelationResponse() *CreateRelationResponse {
-	return &CreateRelationResponse{}
+func NewFilterExpr() *FilterExpr {
+	return &FilterExpr{}
 }
 
-func (p *CreateRelationResponse) InitDefault() {
+func (p *FilterExpr) InitDefault() {
 }
 
-func (p *CreateRelationResponse) GetSuccess() (v bool) {
-	return p.Success
+var FilterExpr_Logic_DEFAULT FilterLogic
+
+func (p *FilterExpr) GetLogic() (v FilterLogic) {
+	if !p.IsSetLogic() {
+		return FilterExpr_Logic_DEFAULT
+	}
+	return *p.Logic
 }
 
-func (p *CreateRelationResponse) GetMessage() (v string) {
-	return p.Message
+var FilterExpr_Conditions_DEFAULT []*FilterCondition
+
+func (p *FilterExpr) GetConditions() (v []*FilterCondition) {
+	if !p.IsSetConditions() {
+		return FilterExpr_Conditions_DEFAULT
+	}
+	return p.Conditions
 }
 
-var CreateRelationResponse_Relation_DEFAULT *Relation
+var FilterExpr_Groups_DEFAULT []*FilterExpr
 
-func (p *CreateRelationResponse) GetRelation() (v *Relation) {
-	if !p.IsSetRelation() {
-		return CreateRelationResponse_Relation_DEFAULT
+func (p *FilterExpr) GetGroups() (v []*FilterExpr) {
+	if !p.IsSetGroups() {
+		return FilterExpr_Groups_DEFAULT
 	}
-	return p.Relation
+	return p.Groups
 }
 
-var fieldIDToName_CreateRelationResponse = map[int16]string{
-	1: "success",
-	2: "message",
-	3: "relation",
+var fieldIDToName_FilterExpr = map[int16]string{
+	1: "logic",
+	2: "conditions",
+	3: "groups",
 }
 
-func (p *CreateRelationResponse) IsSetRelation() bool {
-	return p.Relation != nil
+func (p *FilterExpr) IsSetLogic() bool {
+	return p.Logic != nil
 }
 
-func (p *CreateRelationResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *FilterExpr) IsSetConditions() bool {
+	return p.Conditions != nil
+}
+
+func (p *FilterExpr) IsSetGroups() bool {
+	return p.Groups != nil
+}
+
+func (p *FilterExpr) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -4012,7 +4739,7 @@ func (p *CreateRelationResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.BOOL {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4020,7 +4747,7 @@ func (p *CreateRelationResponse) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4028,7 +4755,7 @@ func (p *CreateRelationResponse) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4054,7 +4781,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateRelationResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FilterExpr[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4064,40 +4791,68 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CreateRelationResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *FilterExpr) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field bool
-	if v, err := iprot.ReadBool(); err != nil {
+	var _field *FilterLogic
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		_field = v
+		tmp := FilterLogic(v)
+		_field = &tmp
 	}
-	p.Success = _field
+	p.Logic = _field
 	return nil
 }
-func (p *CreateRelationResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *FilterExpr) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*FilterCondition, 0, size)
+	values := make([]FilterCondition, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.Message = _field
+	p.Conditions = _field
 	return nil
 }
-func (p *CreateRelationResponse) ReadField3(iprot thrift.TProtocol) error {
-	_field := NewRelation()
-	if err := _field.Read(iprot); err != nil {
+func (p *FilterExpr) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
 	}
-	p.Relation = _field
+	_field := make([]*FilterExpr, 0, size)
+	values := make([]FilterExpr, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Groups = _field
 	return nil
 }
 
-func (p *CreateRelationResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *FilterExpr) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateRelationResponse"); err != nil {
+	if err = oprot.WriteStructBegin("FilterExpr"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4131,15 +4886,17 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CreateRelationResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteBool(p.Success); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *FilterExpr) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLogic() {
+		if err = oprot.WriteFieldBegin("logic", thrift.I32, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.Logic)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -4147,15 +4904,25 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *CreateRelationResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Message); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *FilterExpr) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConditions() {
+		if err = oprot.WriteFieldBegin("conditions", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Conditions)); err != nil {
+			return err
+		}
+		for _, v := range p.Conditions {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -4163,15 +4930,25 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *CreateRelationResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("relation", thrift.STRUCT, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := p.Relation.Write(oprot); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *FilterExpr) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGroups() {
+		if err = oprot.WriteFieldBegin("groups", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Groups)); err != nil {
+			return err
+		}
+		for _, v := range p.Groups {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -4180,81 +4957,52 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *CreateRelationResponse) String() string {
+func (p *FilterExpr) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CreateRelationResponse(%+v)", *p)
-
-}
-
-// 更新关系请求
-type UpdateRelationRequest struct {
-	// 关系ID
-	ID         string            `thrift:"id,1" form:"id" json:"id" query:"id"`
-	Type       *RelationType     `thrift:"type,2,optional" form:"type" json:"type,omitempty" query:"type"`
-	Label      *string           `thrift:"label,3,optional" form:"label" json:"label,omitempty" query:"label"`
-	Properties map[string]string `thrift:"properties,4,optional" form:"properties" json:"properties,omitempty" query:"properties"`
-}
+	return fmt.Sprintf("FilterExpr(%+v)", *p)
 
-func NewUpdateRelationRequest() *UpdateRelationRequest {
-	return &UpdateRelationRequest{}
 }
 
-func (p *UpdateRelationRequest) InitDefault() {
+// 排序键
+type SortField struct {
+	// 属性名: id、name、created_at、updated_at 等核心属性，或类型属性约束中声明的属性
+	Field string `thrift:"field,1" form:"field" json:"field" query:"field"`
+	// 排序方向，默认 ASC
+	Order *SortOrder `thrift:"order,2,optional" form:"order" json:"order,omitempty" query:"order"`
 }
 
-func (p *UpdateRelationRequest) GetID() (v string) {
-	return p.ID
+func NewSortField() *SortField {
+	return &SortField{}
 }
 
-var UpdateRelationRequest_Type_DEFAULT RelationType
-
-func (p *UpdateRelationRequest) GetType() (v RelationType) {
-	if !p.IsSetType() {
-		return UpdateRelationRequest_Type_DEFAULT
-	}
-	return *p.Type
+func (p *SortField) InitDefault() {
 }
 
-var UpdateRelationRequest_Label_DEFAULT string
-
-func (p *UpdateRelationRequest) GetLabel() (v string) {
-	if !p.IsSetLabel() {
-		return UpdateRelationRequest_Label_DEFAULT
-	}
-	return *p.Label
+func (p *SortField) GetField() (v string) {
+	return p.Field
 }
 
-var UpdateRelationRequest_Properties_DEFAULT map[string]string
+var SortField_Order_DEFAULT SortOrder
 
-func (p *UpdateRelationRequest) GetProperties() (v map[string]string) {
-	if !p.IsSetProperties() {
-		return UpdateRelationRequest_Properties_DEFAULT
+func (p *SortField) GetOrder() (v SortOrder) {
+	if !p.IsSetOrder() {
+		return SortField_Order_DEFAULT
 	}
-	return p.Properties
-}
-
-var fieldIDToName_UpdateRelationRequest = map[int16]string{
-	1: "id",
-	2: "type",
-	3: "label",
-	4: "properties",
-}
-
-func (p *UpdateRelationRequest) IsSetType() bool {
-	return p.Type != nil
+	return *p.Order
 }
 
-func (p *UpdateRelationRequest) IsSetLabel() bool {
-	return p.Label != nil
+var fieldIDToName_SortField = map[int16]string{
+	1: "field",
+	2: "order",
 }
 
-func (p *UpdateRelationRequest) IsSetProperties() bool {
-	return p.Properties != nil
+func (p *SortField) IsSetOrder() bool {
+	return p.Order != nil
 }
 
-func (p *UpdateRelationRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *SortField) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -4288,22 +5036,6 @@ func (p *UpdateRelationRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.MAP {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4323,7 +5055,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateRelationRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SortField[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4333,7 +5065,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateRelationRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *SortField) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -4341,65 +5073,25 @@ func (p *UpdateRelationRequest) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.ID = _field
+	p.Field = _field
 	return nil
 }
-func (p *UpdateRelationRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *SortField) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *RelationType
+	var _field *SortOrder
 	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		tmp := RelationType(v)
+		tmp := SortOrder(v)
 		_field = &tmp
 	}
-	p.Type = _field
-	return nil
-}
-func (p *UpdateRelationRequest) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Label = _field
-	return nil
-}
-func (p *UpdateRelationRequest) ReadField4(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return err
-	}
-	_field := make(map[string]string, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
-
-		var _val string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_val = v
-		}
-
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
-		return err
-	}
-	p.Properties = _field
+	p.Order = _field
 	return nil
 }
 
-func (p *UpdateRelationRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *SortField) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateRelationRequest"); err != nil {
+	if err = oprot.WriteStructBegin("SortField"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4411,14 +5103,6 @@ func (p *UpdateRelationRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4437,11 +5121,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateRelationRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+func (p *SortField) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("field", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ID); err != nil {
+	if err := oprot.WriteString(p.Field); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4453,12 +5137,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *UpdateRelationRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetType() {
-		if err = oprot.WriteFieldBegin("type", thrift.I32, 2); err != nil {
+func (p *SortField) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOrder() {
+		if err = oprot.WriteFieldBegin("order", thrift.I32, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(int32(*p.Type)); err != nil {
+		if err := oprot.WriteI32(int32(*p.Order)); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4471,104 +5155,174 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *UpdateRelationRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLabel() {
-		if err = oprot.WriteFieldBegin("label", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Label); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+
+func (p *SortField) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return fmt.Sprintf("SortField(%+v)", *p)
+
 }
-func (p *UpdateRelationRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetProperties() {
-		if err = oprot.WriteFieldBegin("properties", thrift.MAP, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Properties)); err != nil {
-			return err
-		}
-		for k, v := range p.Properties {
-			if err := oprot.WriteString(k); err != nil {
-				return err
-			}
-			if err := oprot.WriteString(v); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteMapEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+
+// 搜索节点请求
+type SearchNodesRequest struct {
+	// 新增: 搜索条件 (key: 属性名, value: 搜索值)
+	Criteria map[string]string `thrift:"criteria,1,optional" form:"criteria" json:"criteria,omitempty" query:"criteria"`
+	// 节点类型(可选)
+	Type *NodeType `thrift:"type,2,optional" form:"type" json:"type,omitempty" query:"type"`
+	// 限制返回数量
+	Limit *int32 `thrift:"limit,3,optional" form:"limit" json:"limit,omitempty" query:"limit"`
+	// 偏移量，用于分页
+	Offset *int32 `thrift:"offset,4,optional" form:"offset" json:"offset,omitempty" query:"offset"`
+	// 游标，取自上一页响应的 next_cursor；设置后忽略 offset
+	Cursor *string `thrift:"cursor,5,optional" form:"cursor" json:"cursor,omitempty" query:"cursor"`
+	// 结构化过滤表达式，与 criteria 按 AND 组合 (GET 请求中以 JSON 字符串传入)
+	Filter *FilterExpr `thrift:"filter,6,optional" form:"filter" json:"filter,omitempty" query:"filter"`
+	// 排序键，按顺序比较，未设置时按 name、id 升序 (GET 请求中以 JSON 字符串传入)
+	Sort []*SortField `thrift:"sort,7,optional" form:"sort" json:"sort,omitempty" query:"sort"`
+	// 分面字段: type (节点类型)、name、profession 或类型属性约束中声明的属性，按全部匹配的节点统计
+	Facets []string `thrift:"facets,8,optional" form:"facets" json:"facets,omitempty" query:"facets"`
+	// 每个分面最多返回的取值数，默认 10
+	FacetSize *int32 `thrift:"facet_size,9,optional" form:"facet_size" json:"facet_size,omitempty" query:"facet_size"`
+}
+
+func NewSearchNodesRequest() *SearchNodesRequest {
+	return &SearchNodesRequest{}
+}
+
+func (p *SearchNodesRequest) InitDefault() {
+}
+
+var SearchNodesRequest_Criteria_DEFAULT map[string]string
+
+func (p *SearchNodesRequest) GetCriteria() (v map[string]string) {
+	if !p.IsSetCriteria() {
+		return SearchNodesRequest_Criteria_DEFAULT
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return p.Criteria
 }
 
-func (p *UpdateRelationRequest) String() string {
-	if p == nil {
-		return "<nil>"
+var SearchNodesRequest_Type_DEFAULT NodeType
+
+func (p *SearchNodesRequest) GetType() (v NodeType) {
+	if !p.IsSetType() {
+		return SearchNodesRequest_Type_DEFAULT
 	}
-	return fmt.Sprintf("UpdateRelationRequest(%+v)", *p)
+	return *p.Type
+}
+
+var SearchNodesRequest_Limit_DEFAULT int32
 
+func (p *SearchNodesRequest) GetLimit() (v int32) {
+	if !p.IsSetLimit() {
+		return SearchNodesRequest_Limit_DEFAULT
+	}
+	return *p.Limit
 }
 
-// 更新关系响应
-type UpdateRelationResponse struct {
-	Success  bool      `thrift:"success,1" form:"success" json:"success" query:"success"`
-	Message  string    `thrift:"message,2" form:"message" json:"message" query:"message"`
-	Relation *Relation `thrift:"relation,3" form:"relation" json:"relation" query:"relation"`
+var SearchNodesRequest_Offset_DEFAULT int32
+
+func (p *SearchNodesRequest) GetOffset() (v int32) {
+	if !p.IsSetOffset() {
+		return SearchNodesRequest_Offset_DEFAULT
+	}
+	return *p.Offset
 }
 
-func NewUpdateRelationResponse() *UpdateRelationResponse {
-	return &UpdateRelationResponse{}
+var SearchNodesRequest_Cursor_DEFAULT string
+
+func (p *SearchNodesRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return SearchNodesRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
 }
 
-func (p *UpdateRelationResponse) InitDefault() {
+var SearchNodesRequest_Filter_DEFAULT *FilterExpr
+
+func (p *SearchNodesRequest) GetFilter() (v *FilterExpr) {
+	if !p.IsSetFilter() {
+		return SearchNodesRequest_Filter_DEFAULT
+	}
+	return p.Filter
 }
 
-func (p *UpdateRelationResponse) GetSuccess() (v bool) {
-	return p.Success
+var SearchNodesRequest_Sort_DEFAULT []*SortField
+
+func (p *SearchNodesRequest) GetSort() (v []*SortField) {
+	if !p.IsSetSort() {
+		return SearchNodesRequest_Sort_DEFAULT
+	}
+	return p.Sort
 }
 
-func (p *UpdateRelationResponse) GetMessage() (v string) {
-	return p.Message
+var SearchNodesRequest_Facets_DEFAULT []string
+
+func (p *SearchNodesRequest) GetFacets() (v []string) {
+	if !p.IsSetFacets() {
+		return SearchNodesRequest_Facets_DEFAULT
+	}
+	return p.Facets
 }
 
-var UpdateRelationResponse_Relation_DEFAULT *Relation
+var SearchNodesRequest_FacetSize_DEFAULT int32
 
-func (p *UpdateRelationResponse) GetRelation() (v *Relation) {
-	if !p.IsSetRelation() {
-		return UpdateRelationResponse_Relation_DEFAULT
+func (p *SearchNodesRequest) GetFacetSize() (v int32) {
+	if !p.IsSetFacetSize() {
+		return SearchNodesRequest_FacetSize_DEFAULT
 	}
-	return p.Relation
+	return *p.FacetSize
 }
 
-var fieldIDToName_UpdateRelationResponse = map[int16]string{
-	1: "success",
-	2: "message",
-	3: "relation",
+var fieldIDToName_SearchNodesRequest = map[int16]string{
+	1: "criteria",
+	2: "type",
+	3: "limit",
+	4: "offset",
+	5: "cursor",
+	6: "filter",
+	7: "sort",
+	8: "facets",
+	9: "facet_size",
 }
 
-func (p *UpdateRelationResponse) IsSetRelation() bool {
-	return p.Relation != nil
+func (p *SearchNodesRequest) IsSetCriteria() bool {
+	return p.Criteria != nil
 }
 
-func (p *UpdateRelationResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *SearchNodesRequest) IsSetType() bool {
+	return p.Type != nil
+}
+
+func (p *SearchNodesRequest) IsSetLimit() bool {
+	return p.Limit != nil
+}
+
+func (p *SearchNodesRequest) IsSetOffset() bool {
+	return p.Offset != nil
+}
+
+func (p *SearchNodesRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *SearchNodesRequest) IsSetFilter() bool {
+	return p.Filter != nil
+}
+
+func (p *SearchNodesRequest) IsSetSort() bool {
+	return p.Sort != nil
+}
+
+func (p *SearchNodesRequest) IsSetFacets() bool {
+	return p.Facets != nil
+}
+
+func (p *SearchNodesRequest) IsSetFacetSize() bool {
+	return p.FacetSize != nil
+}
+
+func (p *SearchNodesRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -4587,7 +5341,7 @@ func (p *UpdateRelationResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.BOOL {
+			if fieldTypeId == thrift.MAP {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4595,7 +5349,7 @@ func (p *UpdateRelationResponse) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4603,13 +5357,61 @@ func (p *UpdateRelationResponse) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4629,7 +5431,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateRelationResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SearchNodesRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4639,43 +5441,152 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateRelationResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *SearchNodesRequest) ReadField1(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
 
-	var _field bool
-	if v, err := iprot.ReadBool(); err != nil {
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Criteria = _field
+	return nil
+}
+func (p *SearchNodesRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *NodeType
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		_field = v
+		tmp := NodeType(v)
+		_field = &tmp
 	}
-	p.Success = _field
+	p.Type = _field
 	return nil
 }
-func (p *UpdateRelationResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *SearchNodesRequest) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field string
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Limit = _field
+	return nil
+}
+func (p *SearchNodesRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Offset = _field
+	return nil
+}
+func (p *SearchNodesRequest) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.Message = _field
+	p.Cursor = _field
 	return nil
 }
-func (p *UpdateRelationResponse) ReadField3(iprot thrift.TProtocol) error {
-	_field := NewRelation()
+func (p *SearchNodesRequest) ReadField6(iprot thrift.TProtocol) error {
+	_field := NewFilterExpr()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.Relation = _field
+	p.Filter = _field
 	return nil
 }
+func (p *SearchNodesRequest) ReadField7(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*SortField, 0, size)
+	values := make([]SortField, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-func (p *UpdateRelationResponse) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateRelationResponse"); err != nil {
-		goto WriteStructBeginError
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
 	}
-	if p != nil {
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Sort = _field
+	return nil
+}
+func (p *SearchNodesRequest) ReadField8(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Facets = _field
+	return nil
+}
+func (p *SearchNodesRequest) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.FacetSize = _field
+	return nil
+}
+
+func (p *SearchNodesRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SearchNodesRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
 		if err = p.writeField1(oprot); err != nil {
 			fieldId = 1
 			goto WriteFieldError
@@ -4688,6 +5599,30 @@ func (p *UpdateRelationResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4706,15 +5641,28 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateRelationResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteBool(p.Success); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *SearchNodesRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCriteria() {
+		if err = oprot.WriteFieldBegin("criteria", thrift.MAP, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Criteria)); err != nil {
+			return err
+		}
+		for k, v := range p.Criteria {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -4722,15 +5670,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *UpdateRelationResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Message); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *SearchNodesRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetType() {
+		if err = oprot.WriteFieldBegin("type", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.Type)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -4738,15 +5688,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *UpdateRelationResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("relation", thrift.STRUCT, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := p.Relation.Write(oprot); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *SearchNodesRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLimit() {
+		if err = oprot.WriteFieldBegin("limit", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Limit); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -4754,36 +5706,168 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *SearchNodesRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOffset() {
+		if err = oprot.WriteFieldBegin("offset", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Offset); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *SearchNodesRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCursor() {
+		if err = oprot.WriteFieldBegin("cursor", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Cursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *SearchNodesRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFilter() {
+		if err = oprot.WriteFieldBegin("filter", thrift.STRUCT, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Filter.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *SearchNodesRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSort() {
+		if err = oprot.WriteFieldBegin("sort", thrift.LIST, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Sort)); err != nil {
+			return err
+		}
+		for _, v := range p.Sort {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *SearchNodesRequest) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFacets() {
+		if err = oprot.WriteFieldBegin("facets", thrift.LIST, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Facets)); err != nil {
+			return err
+		}
+		for _, v := range p.Facets {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *SearchNodesRequest) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFacetSize() {
+		if err = oprot.WriteFieldBegin("facet_size", thrift.I32, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.FacetSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
 
-func (p *UpdateRelationResponse) String() string {
+func (p *SearchNodesRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateRelationResponse(%+v)", *p)
+	return fmt.Sprintf("SearchNodesRequest(%+v)", *p)
 
 }
 
-// 获取关系请求
-type GetRelationRequest struct {
-	ID string `thrift:"id,1" form:"id" json:"id" query:"id"`
+// 分面中的一个取值及其节点数
+type FacetBucket struct {
+	// 取值: type 分面为节点类型名称，其他字段为属性值的字符串形式
+	Value string `thrift:"value,1" form:"value" json:"value" query:"value"`
+	// 匹配该取值的节点数
+	Count int32 `thrift:"count,2" form:"count" json:"count" query:"count"`
 }
 
-func NewGetRelationRequest() *GetRelationRequest {
-	return &GetRelationRequest{}
+func NewFacetBucket() *FacetBucket {
+	return &FacetBucket{}
 }
 
-func (p *GetRelationRequest) InitDefault() {
+func (p *FacetBucket) InitDefault() {
 }
 
-func (p *GetRelationRequest) GetID() (v string) {
-	return p.ID
+func (p *FacetBucket) GetValue() (v string) {
+	return p.Value
 }
 
-var fieldIDToName_GetRelationRequest = map[int16]string{
-	1: "id",
+func (p *FacetBucket) GetCount() (v int32) {
+	return p.Count
 }
 
-func (p *GetRelationRequest) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_FacetBucket = map[int16]string{
+	1: "value",
+	2: "count",
+}
+
+func (p *FacetBucket) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -4809,6 +5893,14 @@ func (p *GetRelationRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4828,7 +5920,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetRelationRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FacetBucket[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4838,7 +5930,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetRelationRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *FacetBucket) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -4846,20 +5938,35 @@ func (p *GetRelationRequest) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.ID = _field
+	p.Value = _field
 	return nil
 }
+func (p *FacetBucket) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *GetRelationRequest) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("GetRelationRequest"); err != nil {
-		goto WriteStructBeginError
-	}
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Count = _field
+	return nil
+}
+
+func (p *FacetBucket) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("FacetBucket"); err != nil {
+		goto WriteStructBeginError
+	}
 	if p != nil {
 		if err = p.writeField1(oprot); err != nil {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4878,11 +5985,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetRelationRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+func (p *FacetBucket) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("value", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ID); err != nil {
+	if err := oprot.WriteString(p.Value); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4894,57 +6001,59 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
+func (p *FacetBucket) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("count", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Count); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
 
-func (p *GetRelationRequest) String() string {
+func (p *FacetBucket) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetRelationRequest(%+v)", *p)
-
-}
-
-// 获取关系响应
-type GetRelationResponse struct {
-	Success  bool      `thrift:"success,1" form:"success" json:"success" query:"success"`
-	Message  string    `thrift:"message,2" form:"message" json:"message" query:"message"`
-	Relation *Relation `thrift:"relation,3" form:"relation" json:"relation" query:"relation"`
-}
+	return fmt.Sprintf("FacetBucket(%+v)", *p)
 
-func NewGetRelationResponse() *GetRelationResponse {
-	return &GetRelationResponse{}
 }
 
-func (p *GetRelationResponse) InitDefault() {
+// 一个字段的分面统计
+type Facet struct {
+	Field string `thrift:"field,1" form:"field" json:"field" query:"field"`
+	// 按节点数降序、取值升序排列，最多 facet_size 个；属性缺失的节点不计入
+	Buckets []*FacetBucket `thrift:"buckets,2" form:"buckets" json:"buckets" query:"buckets"`
 }
 
-func (p *GetRelationResponse) GetSuccess() (v bool) {
-	return p.Success
+func NewFacet() *Facet {
+	return &Facet{}
 }
 
-func (p *GetRelationResponse) GetMessage() (v string) {
-	return p.Message
+func (p *Facet) InitDefault() {
 }
 
-var GetRelationResponse_Relation_DEFAULT *Relation
-
-func (p *GetRelationResponse) GetRelation() (v *Relation) {
-	if !p.IsSetRelation() {
-		return GetRelationResponse_Relation_DEFAULT
-	}
-	return p.Relation
+func (p *Facet) GetField() (v string) {
+	return p.Field
 }
 
-var fieldIDToName_GetRelationResponse = map[int16]string{
-	1: "success",
-	2: "message",
-	3: "relation",
+func (p *Facet) GetBuckets() (v []*FacetBucket) {
+	return p.Buckets
 }
 
-func (p *GetRelationResponse) IsSetRelation() bool {
-	return p.Relation != nil
+var fieldIDToName_Facet = map[int16]string{
+	1: "field",
+	2: "buckets",
 }
 
-func (p *GetRelationResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *Facet) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -4963,7 +6072,7 @@ func (p *GetRelationResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.BOOL {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4971,21 +6080,13 @@ func (p *GetRelationResponse) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -5005,7 +6106,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetRelationResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Facet[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5015,18 +6116,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetRelationResponse) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field bool
-	if v, err := iprot.ReadBool(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Success = _field
-	return nil
-}
-func (p *GetRelationResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *Facet) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -5034,21 +6124,36 @@ func (p *GetRelationResponse) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Message = _field
+	p.Field = _field
 	return nil
 }
-func (p *GetRelationResponse) ReadField3(iprot thrift.TProtocol) error {
-	_field := NewRelation()
-	if err := _field.Read(iprot); err != nil {
+func (p *Facet) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
 	}
-	p.Relation = _field
+	_field := make([]*FacetBucket, 0, size)
+	values := make([]FacetBucket, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Buckets = _field
 	return nil
 }
 
-func (p *GetRelationResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *Facet) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetRelationResponse"); err != nil {
+	if err = oprot.WriteStructBegin("Facet"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5060,10 +6165,6 @@ func (p *GetRelationResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5082,11 +6183,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetRelationResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+func (p *Facet) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("field", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteBool(p.Success); err != nil {
+	if err := oprot.WriteString(p.Field); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5098,27 +6199,19 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *GetRelationResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+func (p *Facet) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("buckets", thrift.LIST, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Message); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Buckets)); err != nil {
 		return err
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-func (p *GetRelationResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("relation", thrift.STRUCT, 3); err != nil {
-		goto WriteFieldBeginError
+	for _, v := range p.Buckets {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
 	}
-	if err := p.Relation.Write(oprot); err != nil {
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5126,40 +6219,91 @@ func (p *GetRelationResponse) writeField3(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetRelationResponse) String() string {
+func (p *Facet) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetRelationResponse(%+v)", *p)
+	return fmt.Sprintf("Facet(%+v)", *p)
 
 }
 
-// 删除关系请求
-type DeleteRelationRequest struct {
-	ID string `thrift:"id,1" form:"id" json:"id" query:"id"`
+// 搜索节点响应
+type SearchNodesResponse struct {
+	Success bool    `thrift:"success,1" form:"success" json:"success" query:"success"`
+	Message string  `thrift:"message,2" form:"message" json:"message" query:"message"`
+	Nodes   []*Node `thrift:"nodes,3" form:"nodes" json:"nodes" query:"nodes"`
+	// 总匹配数
+	Total int32 `thrift:"total,4" form:"total" json:"total" query:"total"`
+	// 下一页游标，为空表示没有更多结果
+	NextCursor *string `thrift:"next_cursor,5,optional" form:"next_cursor" json:"next_cursor,omitempty" query:"next_cursor"`
+	// 分面统计，按请求中 facets 的顺序排列
+	Facets []*Facet `thrift:"facets,6,optional" form:"facets" json:"facets,omitempty" query:"facets"`
 }
 
-func NewDeleteRelationRequest() *DeleteRelationRequest {
-	return &DeleteRelationRequest{}
+func NewSearchNodesResponse() *SearchNodesResponse {
+	return &SearchNodesResponse{}
 }
 
-func (p *DeleteRelationRequest) InitDefault() {
+func (p *SearchNodesResponse) InitDefault() {
 }
 
-func (p *DeleteRelationRequest) GetID() (v string) {
-	return p.ID
+func (p *SearchNodesResponse) GetSuccess() (v bool) {
+	return p.Success
 }
 
-var fieldIDToName_DeleteRelationRequest = map[int16]string{
-	1: "id",
+func (p *SearchNodesResponse) GetMessage() (v string) {
+	return p.Message
 }
 
-func (p *DeleteRelationRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *SearchNodesResponse) GetNodes() (v []*Node) {
+	return p.Nodes
+}
+
+func (p *SearchNodesResponse) GetTotal() (v int32) {
+	return p.Total
+}
+
+var SearchNodesResponse_NextCursor_DEFAULT string
+
+func (p *SearchNodesResponse) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return SearchNodesResponse_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+var SearchNodesResponse_Facets_DEFAULT []*Facet
+
+func (p *SearchNodesResponse) GetFacets() (v []*Facet) {
+	if !p.IsSetFacets() {
+		return SearchNodesResponse_Facets_DEFAULT
+	}
+	return p.Facets
+}
+
+var fieldIDToName_SearchNodesResponse = map[int16]string{
+	1: "success",
+	2: "message",
+	3: "nodes",
+	4: "total",
+	5: "next_cursor",
+	6: "facets",
+}
+
+func (p *SearchNodesResponse) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
+func (p *SearchNodesResponse) IsSetFacets() bool {
+	return p.Facets != nil
+}
+
+func (p *SearchNodesResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -5178,13 +6322,53 @@ func (p *DeleteRelationRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.BOOL {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -5204,7 +6388,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteRelationRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SearchNodesResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5214,7 +6398,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DeleteRelationRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *SearchNodesResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Success = _field
+	return nil
+}
+func (p *SearchNodesResponse) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -5222,13 +6417,81 @@ func (p *DeleteRelationRequest) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.ID = _field
+	p.Message = _field
 	return nil
 }
+func (p *SearchNodesResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Node, 0, size)
+	values := make([]Node, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-func (p *DeleteRelationRequest) Write(oprot thrift.TProtocol) (err error) {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Nodes = _field
+	return nil
+}
+func (p *SearchNodesResponse) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Total = _field
+	return nil
+}
+func (p *SearchNodesResponse) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.NextCursor = _field
+	return nil
+}
+func (p *SearchNodesResponse) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Facet, 0, size)
+	values := make([]Facet, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Facets = _field
+	return nil
+}
+
+func (p *SearchNodesResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteRelationRequest"); err != nil {
+	if err = oprot.WriteStructBegin("SearchNodesResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5236,6 +6499,26 @@ func (p *DeleteRelationRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5254,11 +6537,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeleteRelationRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+func (p *SearchNodesResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ID); err != nil {
+	if err := oprot.WriteBool(p.Success); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5270,42 +6553,202 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
+func (p *SearchNodesResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *SearchNodesResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("nodes", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Nodes)); err != nil {
+		return err
+	}
+	for _, v := range p.Nodes {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *SearchNodesResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *SearchNodesResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNextCursor() {
+		if err = oprot.WriteFieldBegin("next_cursor", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.NextCursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *SearchNodesResponse) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFacets() {
+		if err = oprot.WriteFieldBegin("facets", thrift.LIST, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Facets)); err != nil {
+			return err
+		}
+		for _, v := range p.Facets {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
 
-func (p *DeleteRelationRequest) String() string {
+func (p *SearchNodesResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteRelationRequest(%+v)", *p)
+	return fmt.Sprintf("SearchNodesResponse(%+v)", *p)
 
 }
 
-// 删除关系响应
-type DeleteRelationResponse struct {
-	Success bool   `thrift:"success,1" form:"success" json:"success" query:"success"`
-	Message string `thrift:"message,2" form:"message" json:"message" query:"message"`
+// =============== 批量创建 ===============
+// 全文搜索请求 (基于 Neo4j 全文索引，按相关度排序)
+type FulltextSearchNodesRequest struct {
+	// 搜索文本，按词切分；中文按单字切分
+	Query string `thrift:"query,1" form:"query" json:"query" query:"query"`
+	// 节点类型(可选)
+	Type *NodeType `thrift:"type,2,optional" form:"type" json:"type,omitempty" query:"type"`
+	// 模糊匹配，允许拼写错误 (3-5 个字符的词容忍 1 处编辑，更长的词容忍 2 处)
+	Fuzzy *bool `thrift:"fuzzy,3,optional" form:"fuzzy" json:"fuzzy,omitempty" query:"fuzzy"`
+	// 限制返回数量
+	Limit *int32 `thrift:"limit,4,optional" form:"limit" json:"limit,omitempty" query:"limit"`
+	// 偏移量，用于分页
+	Offset *int32 `thrift:"offset,5,optional" form:"offset" json:"offset,omitempty" query:"offset"`
 }
 
-func NewDeleteRelationResponse() *DeleteRelationResponse {
-	return &DeleteRelationResponse{}
+func NewFulltextSearchNodesRequest() *FulltextSearchNodesRequest {
+	return &FulltextSearchNodesRequest{}
 }
 
-func (p *DeleteRelationResponse) InitDefault() {
+func (p *FulltextSearchNodesRequest) InitDefault() {
 }
 
-func (p *DeleteRelationResponse) GetSuccess() (v bool) {
-	return p.Success
+func (p *FulltextSearchNodesRequest) GetQuery() (v string) {
+	return p.Query
 }
 
-func (p *DeleteRelationResponse) GetMessage() (v string) {
-	return p.Message
+var FulltextSearchNodesRequest_Type_DEFAULT NodeType
+
+func (p *FulltextSearchNodesRequest) GetType() (v NodeType) {
+	if !p.IsSetType() {
+		return FulltextSearchNodesRequest_Type_DEFAULT
+	}
+	return *p.Type
 }
 
-var fieldIDToName_DeleteRelationResponse = map[int16]string{
-	1: "success",
-	2: "message",
+var FulltextSearchNodesRequest_Fuzzy_DEFAULT bool
+
+func (p *FulltextSearchNodesRequest) GetFuzzy() (v bool) {
+	if !p.IsSetFuzzy() {
+		return FulltextSearchNodesRequest_Fuzzy_DEFAULT
+	}
+	return *p.Fuzzy
 }
 
-func (p *DeleteRelationResponse) Read(iprot thrift.TProtocol) (err error) {
+var FulltextSearchNodesRequest_Limit_DEFAULT int32
+
+func (p *FulltextSearchNodesRequest) GetLimit() (v int32) {
+	if !p.IsSetLimit() {
+		return FulltextSearchNodesRequest_Limit_DEFAULT
+	}
+	return *p.Limit
+}
+
+var FulltextSearchNodesRequest_Offset_DEFAULT int32
+
+func (p *FulltextSearchNodesRequest) GetOffset() (v int32) {
+	if !p.IsSetOffset() {
+		return FulltextSearchNodesRequest_Offset_DEFAULT
+	}
+	return *p.Offset
+}
+
+var fieldIDToName_FulltextSearchNodesRequest = map[int16]string{
+	1: "query",
+	2: "type",
+	3: "fuzzy",
+	4: "limit",
+	5: "offset",
+}
+
+func (p *FulltextSearchNodesRequest) IsSetType() bool {
+	return p.Type != nil
+}
+
+func (p *FulltextSearchNodesRequest) IsSetFuzzy() bool {
+	return p.Fuzzy != nil
+}
+
+func (p *FulltextSearchNodesRequest) IsSetLimit() bool {
+	return p.Limit != nil
+}
+
+func (p *FulltextSearchNodesRequest) IsSetOffset() bool {
+	return p.Offset != nil
+}
+
+func (p *FulltextSearchNodesRequest) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -5324,7 +6767,7 @@ func (p *DeleteRelationResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.BOOL {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5332,24 +6775,48 @@ func (p *DeleteRelationResponse) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
 
 	return nil
@@ -5358,7 +6825,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteRelationResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FulltextSearchNodesRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5368,32 +6835,66 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DeleteRelationResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *FulltextSearchNodesRequest) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field bool
-	if v, err := iprot.ReadBool(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Success = _field
+	p.Query = _field
 	return nil
 }
-func (p *DeleteRelationResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *FulltextSearchNodesRequest) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *NodeType
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		_field = v
+		tmp := NodeType(v)
+		_field = &tmp
 	}
-	p.Message = _field
+	p.Type = _field
 	return nil
 }
+func (p *FulltextSearchNodesRequest) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *DeleteRelationResponse) Write(oprot thrift.TProtocol) (err error) {
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Fuzzy = _field
+	return nil
+}
+func (p *FulltextSearchNodesRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Limit = _field
+	return nil
+}
+func (p *FulltextSearchNodesRequest) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Offset = _field
+	return nil
+}
+
+func (p *FulltextSearchNodesRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteRelationResponse"); err != nil {
+	if err = oprot.WriteStructBegin("FulltextSearchNodesRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5405,6 +6906,18 @@ func (p *DeleteRelationResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5423,11 +6936,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeleteRelationResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+func (p *FulltextSearchNodesRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("query", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteBool(p.Success); err != nil {
+	if err := oprot.WriteString(p.Query); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5439,15 +6952,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *DeleteRelationResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Message); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *FulltextSearchNodesRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetType() {
+		if err = oprot.WriteFieldBegin("type", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.Type)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -5455,117 +6970,113 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
-func (p *DeleteRelationResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *FulltextSearchNodesRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFuzzy() {
+		if err = oprot.WriteFieldBegin("fuzzy", thrift.BOOL, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Fuzzy); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return fmt.Sprintf("DeleteRelationResponse(%+v)", *p)
-
-}
-
-// 获取节点关系请求
-type GetNodeRelationsRequest struct {
-	// 节点ID
-	NodeID string `thrift:"node_id,1" form:"node_id" json:"node_id" query:"node_id"`
-	// 关系类型筛选(可选)
-	Types []RelationType `thrift:"types,2,optional" form:"types" json:"types,omitempty" query:"types"`
-	// 是否包含出去的关系，默认true
-	Outgoing *bool `thrift:"outgoing,3,optional" form:"outgoing" json:"outgoing,omitempty" query:"outgoing"`
-	// 是否包含进来的关系，默认true
-	Incoming *bool `thrift:"incoming,4,optional" form:"incoming" json:"incoming,omitempty" query:"incoming"`
-	// 限制返回数量
-	Limit *int32 `thrift:"limit,5,optional" form:"limit" json:"limit,omitempty" query:"limit"`
-	// 偏移量，用于分页
-	Offset *int32 `thrift:"offset,6,optional" form:"offset" json:"offset,omitempty" query:"offset"`
-}
-
-func NewGetNodeRelationsRequest() *GetNodeRelationsRequest {
-	return &GetNodeRelationsRequest{}
-}
-
-func (p *GetNodeRelationsRequest) InitDefault() {
-}
-
-func (p *GetNodeRelationsRequest) GetNodeID() (v string) {
-	return p.NodeID
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-
-var GetNodeRelationsRequest_Types_DEFAULT []RelationType
-
-func (p *GetNodeRelationsRequest) GetTypes() (v []RelationType) {
-	if !p.IsSetTypes() {
-		return GetNodeRelationsRequest_Types_DEFAULT
+func (p *FulltextSearchNodesRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLimit() {
+		if err = oprot.WriteFieldBegin("limit", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Limit); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return p.Types
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-
-var GetNodeRelationsRequest_Outgoing_DEFAULT bool
-
-func (p *GetNodeRelationsRequest) GetOutgoing() (v bool) {
-	if !p.IsSetOutgoing() {
-		return GetNodeRelationsRequest_Outgoing_DEFAULT
+func (p *FulltextSearchNodesRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOffset() {
+		if err = oprot.WriteFieldBegin("offset", thrift.I32, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Offset); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return *p.Outgoing
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-var GetNodeRelationsRequest_Incoming_DEFAULT bool
-
-func (p *GetNodeRelationsRequest) GetIncoming() (v bool) {
-	if !p.IsSetIncoming() {
-		return GetNodeRelationsRequest_Incoming_DEFAULT
+func (p *FulltextSearchNodesRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.Incoming
-}
-
-var GetNodeRelationsRequest_Limit_DEFAULT int32
+	return fmt.Sprintf("FulltextSearchNodesRequest(%+v)", *p)
 
-func (p *GetNodeRelationsRequest) GetLimit() (v int32) {
-	if !p.IsSetLimit() {
-		return GetNodeRelationsRequest_Limit_DEFAULT
-	}
-	return *p.Limit
 }
 
-var GetNodeRelationsRequest_Offset_DEFAULT int32
+// 全文搜索命中项
+type FulltextHit struct {
+	Node *Node `thrift:"node,1" form:"node" json:"node" query:"node"`
+	// 相关度得分，越大越相关
+	Score float64 `thrift:"score,2" form:"score" json:"score" query:"score"`
+	// 命中的属性 (name、profession 或自定义属性) 及其高亮文本，匹配部分以 <em></em> 包裹
+	Highlights map[string]string `thrift:"highlights,3" form:"highlights" json:"highlights" query:"highlights"`
+}
 
-func (p *GetNodeRelationsRequest) GetOffset() (v int32) {
-	if !p.IsSetOffset() {
-		return GetNodeRelationsRequest_Offset_DEFAULT
-	}
-	return *p.Offset
+func NewFulltextHit() *FulltextHit {
+	return &FulltextHit{}
 }
 
-var fieldIDToName_GetNodeRelationsRequest = map[int16]string{
-	1: "node_id",
-	2: "types",
-	3: "outgoing",
-	4: "incoming",
-	5: "limit",
-	6: "offset",
+func (p *FulltextHit) InitDefault() {
 }
 
-func (p *GetNodeRelationsRequest) IsSetTypes() bool {
-	return p.Types != nil
+var FulltextHit_Node_DEFAULT *Node
+
+func (p *FulltextHit) GetNode() (v *Node) {
+	if !p.IsSetNode() {
+		return FulltextHit_Node_DEFAULT
+	}
+	return p.Node
 }
 
-func (p *GetNodeRelationsRequest) IsSetOutgoing() bool {
-	return p.Outgoing != nil
+func (p *FulltextHit) GetScore() (v float64) {
+	return p.Score
 }
 
-func (p *GetNodeRelationsRequest) IsSetIncoming() bool {
-	return p.Incoming != nil
+func (p *FulltextHit) GetHighlights() (v map[string]string) {
+	return p.Highlights
 }
 
-func (p *GetNodeRelationsRequest) IsSetLimit() bool {
-	return p.Limit != nil
+var fieldIDToName_FulltextHit = map[int16]string{
+	1: "node",
+	2: "score",
+	3: "highlights",
 }
 
-func (p *GetNodeRelationsRequest) IsSetOffset() bool {
-	return p.Offset != nil
+func (p *FulltextHit) IsSetNode() bool {
+	return p.Node != nil
 }
 
-func (p *GetNodeRelationsRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *FulltextHit) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -5584,7 +7095,7 @@ func (p *GetNodeRelationsRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5592,7 +7103,7 @@ func (p *GetNodeRelationsRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.DOUBLE {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5600,39 +7111,15 @@ func (p *GetNodeRelationsRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.BOOL {
+			if fieldTypeId == thrift.MAP {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 4:
-			if fieldTypeId == thrift.BOOL {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		}
@@ -5650,7 +7137,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetNodeRelationsRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FulltextHit[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5660,88 +7147,58 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetNodeRelationsRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *FulltextHit) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewNode()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Node = _field
+	return nil
+}
+func (p *FulltextHit) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.NodeID = _field
+	p.Score = _field
 	return nil
 }
-func (p *GetNodeRelationsRequest) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
+func (p *FulltextHit) ReadField3(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]RelationType, 0, size)
+	_field := make(map[string]string, size)
 	for i := 0; i < size; i++ {
-
-		var _elem RelationType
-		if v, err := iprot.ReadI32(); err != nil {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
 			return err
 		} else {
-			_elem = RelationType(v)
+			_key = v
 		}
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.Types = _field
-	return nil
-}
-func (p *GetNodeRelationsRequest) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Outgoing = _field
-	return nil
-}
-func (p *GetNodeRelationsRequest) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Incoming = _field
-	return nil
-}
-func (p *GetNodeRelationsRequest) ReadField5(iprot thrift.TProtocol) error {
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
 
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = &v
+		_field[_key] = _val
 	}
-	p.Limit = _field
-	return nil
-}
-func (p *GetNodeRelationsRequest) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
+	if err := iprot.ReadMapEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Offset = _field
+	p.Highlights = _field
 	return nil
 }
 
-func (p *GetNodeRelationsRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *FulltextHit) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetNodeRelationsRequest"); err != nil {
+	if err = oprot.WriteStructBegin("FulltextHit"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5757,18 +7214,6 @@ func (p *GetNodeRelationsRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5787,11 +7232,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetNodeRelationsRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("node_id", thrift.STRING, 1); err != nil {
+func (p *FulltextHit) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("node", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.NodeID); err != nil {
+	if err := p.Node.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5803,25 +7248,15 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *GetNodeRelationsRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTypes() {
-		if err = oprot.WriteFieldBegin("types", thrift.LIST, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.I32, len(p.Types)); err != nil {
-			return err
-		}
-		for _, v := range p.Types {
-			if err := oprot.WriteI32(int32(v)); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *FulltextHit) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("score", thrift.DOUBLE, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.Score); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -5829,127 +7264,82 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *GetNodeRelationsRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetOutgoing() {
-		if err = oprot.WriteFieldBegin("outgoing", thrift.BOOL, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteBool(*p.Outgoing); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *FulltextHit) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("highlights", thrift.MAP, 3); err != nil {
+		goto WriteFieldBeginError
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *GetNodeRelationsRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIncoming() {
-		if err = oprot.WriteFieldBegin("incoming", thrift.BOOL, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteBool(*p.Incoming); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Highlights)); err != nil {
+		return err
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-func (p *GetNodeRelationsRequest) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLimit() {
-		if err = oprot.WriteFieldBegin("limit", thrift.I32, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(*p.Limit); err != nil {
+	for k, v := range p.Highlights {
+		if err := oprot.WriteString(k); err != nil {
 			return err
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-func (p *GetNodeRelationsRequest) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetOffset() {
-		if err = oprot.WriteFieldBegin("offset", thrift.I32, 6); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(*p.Offset); err != nil {
+		if err := oprot.WriteString(v); err != nil {
 			return err
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	}
+	if err := oprot.WriteMapEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *GetNodeRelationsRequest) String() string {
+func (p *FulltextHit) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetNodeRelationsRequest(%+v)", *p)
+	return fmt.Sprintf("FulltextHit(%+v)", *p)
 
 }
 
-// 获取节点关系响应
-type GetNodeRelationsResponse struct {
-	Success   bool        `thrift:"success,1" form:"success" json:"success" query:"success"`
-	Message   string      `thrift:"message,2" form:"message" json:"message" query:"message"`
-	Relations []*Relation `thrift:"relations,3" form:"relations" json:"relations" query:"relations"`
-	// 总关系数
+// 全文搜索响应
+type FulltextSearchNodesResponse struct {
+	Success bool           `thrift:"success,1" form:"success" json:"success" query:"success"`
+	Message string         `thrift:"message,2" form:"message" json:"message" query:"message"`
+	Hits    []*FulltextHit `thrift:"hits,3" form:"hits" json:"hits" query:"hits"`
+	// 总匹配数
 	Total int32 `thrift:"total,4" form:"total" json:"total" query:"total"`
 }
 
-func NewGetNodeRelationsResponse() *GetNodeRelationsResponse {
-	return &GetNodeRelationsResponse{}
+func NewFulltextSearchNodesResponse() *FulltextSearchNodesResponse {
+	return &FulltextSearchNodesResponse{}
 }
 
-func (p *GetNodeRelationsResponse) InitDefault() {
+func (p *FulltextSearchNodesResponse) InitDefault() {
 }
 
-func (p *GetNodeRelationsResponse) GetSuccess() (v bool) {
+func (p *FulltextSearchNodesResponse) GetSuccess() (v bool) {
 	return p.Success
 }
 
-func (p *GetNodeRelationsResponse) GetMessage() (v string) {
+func (p *FulltextSearchNodesResponse) GetMessage() (v string) {
 	return p.Message
 }
 
-func (p *GetNodeRelationsResponse) GetRelations() (v []*Relation) {
-	return p.Relations
+func (p *FulltextSearchNodesResponse) GetHits() (v []*FulltextHit) {
+	return p.Hits
 }
 
-func (p *GetNodeRelationsResponse) GetTotal() (v int32) {
+func (p *FulltextSearchNodesResponse) GetTotal() (v int32) {
 	return p.Total
 }
 
-var fieldIDToName_GetNodeRelationsResponse = map[int16]string{
+var fieldIDToName_FulltextSearchNodesResponse = map[int16]string{
 	1: "success",
 	2: "message",
-	3: "relations",
+	3: "hits",
 	4: "total",
 }
 
-func (p *GetNodeRelationsResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *FulltextSearchNodesResponse) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6018,7 +7408,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetNodeRelationsResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FulltextSearchNodesResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6028,7 +7418,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetNodeRelationsResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *FulltextSearchNodesResponse) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field bool
 	if v, err := iprot.ReadBool(); err != nil {
@@ -6039,7 +7429,7 @@ func (p *GetNodeRelationsResponse) ReadField1(iprot thrift.TProtocol) error {
 	p.Success = _field
 	return nil
 }
-func (p *GetNodeRelationsResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *FulltextSearchNodesResponse) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -6050,13 +7440,13 @@ func (p *GetNodeRelationsResponse) ReadField2(iprot thrift.TProtocol) error {
 	p.Message = _field
 	return nil
 }
-func (p *GetNodeRelationsResponse) ReadField3(iprot thrift.TProtocol) error {
+func (p *FulltextSearchNodesResponse) ReadField3(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*Relation, 0, size)
-	values := make([]Relation, size)
+	_field := make([]*FulltextHit, 0, size)
+	values := make([]FulltextHit, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -6070,10 +7460,10 @@ func (p *GetNodeRelationsResponse) ReadField3(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.Relations = _field
+	p.Hits = _field
 	return nil
 }
-func (p *GetNodeRelationsResponse) ReadField4(iprot thrift.TProtocol) error {
+func (p *FulltextSearchNodesResponse) ReadField4(iprot thrift.TProtocol) error {
 
 	var _field int32
 	if v, err := iprot.ReadI32(); err != nil {
@@ -6085,9 +7475,9 @@ func (p *GetNodeRelationsResponse) ReadField4(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *GetNodeRelationsResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *FulltextSearchNodesResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetNodeRelationsResponse"); err != nil {
+	if err = oprot.WriteStructBegin("FulltextSearchNodesResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6125,7 +7515,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetNodeRelationsResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *FulltextSearchNodesResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6141,7 +7531,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *GetNodeRelationsResponse) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *FulltextSearchNodesResponse) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6157,14 +7547,14 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *GetNodeRelationsResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("relations", thrift.LIST, 3); err != nil {
+func (p *FulltextSearchNodesResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("hits", thrift.LIST, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Relations)); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Hits)); err != nil {
 		return err
 	}
-	for _, v := range p.Relations {
+	for _, v := range p.Hits {
 		if err := v.Write(oprot); err != nil {
 			return err
 		}
@@ -6181,7 +7571,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *GetNodeRelationsResponse) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *FulltextSearchNodesResponse) writeField4(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("total", thrift.I32, 4); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6198,97 +7588,117 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *GetNodeRelationsResponse) String() string {
+func (p *FulltextSearchNodesResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetNodeRelationsResponse(%+v)", *p)
+	return fmt.Sprintf("FulltextSearchNodesResponse(%+v)", *p)
 
 }
 
-// 网络查询请求
-type GetNetworkRequest struct {
-	// (替换 profession) 用于查找起始节点的条件
-	StartNodeCriteria map[string]string `thrift:"startNodeCriteria,1,optional" form:"startNodeCriteria" json:"startNodeCriteria,omitempty" query:"startNodeCriteria"`
-	// 从起始节点扩展的深度
-	Depth int32 `thrift:"depth,2,optional" form:"depth" json:"depth,omitempty" query:"depth"`
-	// 要包含/遍历的关系类型过滤器
-	RelationTypes []RelationType `thrift:"relationTypes,3,optional" form:"relationTypes" json:"relationTypes,omitempty" query:"relationTypes"`
-	// 最终结果中要包含的节点类型过滤器
-	NodeTypes []NodeType `thrift:"nodeTypes,4,optional" form:"nodeTypes" json:"nodeTypes,omitempty" query:"nodeTypes"`
+// 批量创建中的单个节点
+type BatchNodeItem struct {
+	// 客户端临时键，同一批次的关系可通过 source_key/target_key 引用
+	TempKey         *string                   `thrift:"temp_key,1,optional" form:"temp_key" json:"temp_key,omitempty" query:"temp_key"`
+	Type            NodeType                  `thrift:"type,2" form:"type" json:"type" query:"type"`
+	Name            string                    `thrift:"name,3" form:"name" json:"name" query:"name"`
+	Avatar          *string                   `thrift:"avatar,4,optional" form:"avatar" json:"avatar,omitempty" query:"avatar"`
+	Profession      *string                   `thrift:"profession,5,optional" form:"profession" json:"profession,omitempty" query:"profession"`
+	Properties      map[string]string         `thrift:"properties,6,optional" form:"properties" json:"properties,omitempty" query:"properties"`
+	TypedProperties map[string]*PropertyValue `thrift:"typed_properties,7,optional" form:"typed_properties" json:"typed_properties,omitempty" query:"typed_properties"`
 }
 
-func NewGetNetworkRequest() *GetNetworkRequest {
-	return &GetNetworkRequest{
+func NewBatchNodeItem() *BatchNodeItem {
+	return &BatchNodeItem{}
+}
 
-		Depth: 1,
+func (p *BatchNodeItem) InitDefault() {
+}
+
+var BatchNodeItem_TempKey_DEFAULT string
+
+func (p *BatchNodeItem) GetTempKey() (v string) {
+	if !p.IsSetTempKey() {
+		return BatchNodeItem_TempKey_DEFAULT
 	}
+	return *p.TempKey
 }
 
-func (p *GetNetworkRequest) InitDefault() {
-	p.Depth = 1
+func (p *BatchNodeItem) GetType() (v NodeType) {
+	return p.Type
 }
 
-var GetNetworkRequest_StartNodeCriteria_DEFAULT map[string]string
+func (p *BatchNodeItem) GetName() (v string) {
+	return p.Name
+}
 
-func (p *GetNetworkRequest) GetStartNodeCriteria() (v map[string]string) {
-	if !p.IsSetStartNodeCriteria() {
-		return GetNetworkRequest_StartNodeCriteria_DEFAULT
+var BatchNodeItem_Avatar_DEFAULT string
+
+func (p *BatchNodeItem) GetAvatar() (v string) {
+	if !p.IsSetAvatar() {
+		return BatchNodeItem_Avatar_DEFAULT
 	}
-	return p.StartNodeCriteria
+	return *p.Avatar
 }
 
-var GetNetworkRequest_Depth_DEFAULT int32 = 1
+var BatchNodeItem_Profession_DEFAULT string
 
-func (p *GetNetworkRequest) GetDepth() (v int32) {
-	if !p.IsSetDepth() {
-		return GetNetworkRequest_Depth_DEFAULT
+func (p *BatchNodeItem) GetProfession() (v string) {
+	if !p.IsSetProfession() {
+		return BatchNodeItem_Profession_DEFAULT
 	}
-	return p.Depth
+	return *p.Profession
 }
 
-var GetNetworkRequest_RelationTypes_DEFAULT []RelationType
+var BatchNodeItem_Properties_DEFAULT map[string]string
 
-func (p *GetNetworkRequest) GetRelationTypes() (v []RelationType) {
-	if !p.IsSetRelationTypes() {
-		return GetNetworkRequest_RelationTypes_DEFAULT
+func (p *BatchNodeItem) GetProperties() (v map[string]string) {
+	if !p.IsSetProperties() {
+		return BatchNodeItem_Properties_DEFAULT
 	}
-	return p.RelationTypes
+	return p.Properties
 }
 
-var GetNetworkRequest_NodeTypes_DEFAULT []NodeType
+var BatchNodeItem_TypedProperties_DEFAULT map[string]*PropertyValue
 
-func (p *GetNetworkRequest) GetNodeTypes() (v []NodeType) {
-	if !p.IsSetNodeTypes() {
-		return GetNetworkRequest_NodeTypes_DEFAULT
+func (p *BatchNodeItem) GetTypedProperties() (v map[string]*PropertyValue) {
+	if !p.IsSetTypedProperties() {
+		return BatchNodeItem_TypedProperties_DEFAULT
 	}
-	return p.NodeTypes
+	return p.TypedProperties
 }
 
-var fieldIDToName_GetNetworkRequest = map[int16]string{
-	1: "startNodeCriteria",
-	2: "depth",
-	3: "relationTypes",
-	4: "nodeTypes",
+var fieldIDToName_BatchNodeItem = map[int16]string{
+	1: "temp_key",
+	2: "type",
+	3: "name",
+	4: "avatar",
+	5: "profession",
+	6: "properties",
+	7: "typed_properties",
 }
 
-func (p *GetNetworkRequest) IsSetStartNodeCriteria() bool {
-	return p.StartNodeCriteria != nil
+func (p *BatchNodeItem) IsSetTempKey() bool {
+	return p.TempKey != nil
 }
 
-func (p *GetNetworkRequest) IsSetDepth() bool {
-	return p.Depth != GetNetworkRequest_Depth_DEFAULT
+func (p *BatchNodeItem) IsSetAvatar() bool {
+	return p.Avatar != nil
 }
 
-func (p *GetNetworkRequest) IsSetRelationTypes() bool {
-	return p.RelationTypes != nil
+func (p *BatchNodeItem) IsSetProfession() bool {
+	return p.Profession != nil
 }
 
-func (p *GetNetworkRequest) IsSetNodeTypes() bool {
-	return p.NodeTypes != nil
+func (p *BatchNodeItem) IsSetProperties() bool {
+	return p.Properties != nil
 }
 
-func (p *GetNetworkRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *BatchNodeItem) IsSetTypedProperties() bool {
+	return p.TypedProperties != nil
+}
+
+func (p *BatchNodeItem) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6307,7 +7717,7 @@ func (p *GetNetworkRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.MAP {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6323,7 +7733,7 @@ func (p *GetNetworkRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6331,13 +7741,37 @@ func (p *GetNetworkRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6357,7 +7791,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetNetworkRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchNodeItem[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6367,7 +7801,62 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetNetworkRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *BatchNodeItem) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TempKey = _field
+	return nil
+}
+func (p *BatchNodeItem) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field NodeType
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = NodeType(v)
+	}
+	p.Type = _field
+	return nil
+}
+func (p *BatchNodeItem) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *BatchNodeItem) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Avatar = _field
+	return nil
+}
+func (p *BatchNodeItem) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Profession = _field
+	return nil
+}
+func (p *BatchNodeItem) ReadField6(iprot thrift.TProtocol) error {
 	_, _, size, err := iprot.ReadMapBegin()
 	if err != nil {
 		return err
@@ -6393,70 +7882,42 @@ func (p *GetNetworkRequest) ReadField1(iprot thrift.TProtocol) error {
 	if err := iprot.ReadMapEnd(); err != nil {
 		return err
 	}
-	p.StartNodeCriteria = _field
-	return nil
-}
-func (p *GetNetworkRequest) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Depth = _field
+	p.Properties = _field
 	return nil
 }
-func (p *GetNetworkRequest) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
+func (p *BatchNodeItem) ReadField7(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]RelationType, 0, size)
+	_field := make(map[string]*PropertyValue, size)
+	values := make([]PropertyValue, size)
 	for i := 0; i < size; i++ {
-
-		var _elem RelationType
-		if v, err := iprot.ReadI32(); err != nil {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
 			return err
 		} else {
-			_elem = RelationType(v)
+			_key = v
 		}
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.RelationTypes = _field
-	return nil
-}
-func (p *GetNetworkRequest) ReadField4(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]NodeType, 0, size)
-	for i := 0; i < size; i++ {
-
-		var _elem NodeType
-		if v, err := iprot.ReadI32(); err != nil {
+		_val := &values[i]
+		_val.InitDefault()
+		if err := _val.Read(iprot); err != nil {
 			return err
-		} else {
-			_elem = NodeType(v)
 		}
 
-		_field = append(_field, _elem)
+		_field[_key] = _val
 	}
-	if err := iprot.ReadListEnd(); err != nil {
+	if err := iprot.ReadMapEnd(); err != nil {
 		return err
 	}
-	p.NodeTypes = _field
+	p.TypedProperties = _field
 	return nil
 }
 
-func (p *GetNetworkRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *BatchNodeItem) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetNetworkRequest"); err != nil {
+	if err = oprot.WriteStructBegin("BatchNodeItem"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6476,6 +7937,18 @@ func (p *GetNetworkRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -6494,23 +7967,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetNetworkRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStartNodeCriteria() {
-		if err = oprot.WriteFieldBegin("startNodeCriteria", thrift.MAP, 1); err != nil {
+func (p *BatchNodeItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTempKey() {
+		if err = oprot.WriteFieldBegin("temp_key", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.StartNodeCriteria)); err != nil {
-			return err
-		}
-		for k, v := range p.StartNodeCriteria {
-			if err := oprot.WriteString(k); err != nil {
-				return err
-			}
-			if err := oprot.WriteString(v); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteMapEnd(); err != nil {
+		if err := oprot.WriteString(*p.TempKey); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6523,17 +7985,15 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *GetNetworkRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDepth() {
-		if err = oprot.WriteFieldBegin("depth", thrift.I32, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(p.Depth); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *BatchNodeItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("type", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(int32(p.Type)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -6541,46 +8001,75 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *GetNetworkRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRelationTypes() {
-		if err = oprot.WriteFieldBegin("relationTypes", thrift.LIST, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.I32, len(p.RelationTypes)); err != nil {
-			return err
-		}
-		for _, v := range p.RelationTypes {
-			if err := oprot.WriteI32(int32(v)); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
+func (p *BatchNodeItem) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *BatchNodeItem) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAvatar() {
+		if err = oprot.WriteFieldBegin("avatar", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Avatar); err != nil {
+			return err
+		}
 		if err = oprot.WriteFieldEnd(); err != nil {
 			goto WriteFieldEndError
 		}
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *GetNetworkRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetNodeTypes() {
-		if err = oprot.WriteFieldBegin("nodeTypes", thrift.LIST, 4); err != nil {
+func (p *BatchNodeItem) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetProfession() {
+		if err = oprot.WriteFieldBegin("profession", thrift.STRING, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.I32, len(p.NodeTypes)); err != nil {
+		if err := oprot.WriteString(*p.Profession); err != nil {
 			return err
 		}
-		for _, v := range p.NodeTypes {
-			if err := oprot.WriteI32(int32(v)); err != nil {
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *BatchNodeItem) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetProperties() {
+		if err = oprot.WriteFieldBegin("properties", thrift.MAP, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Properties)); err != nil {
+			return err
+		}
+		for k, v := range p.Properties {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
 				return err
 			}
 		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := oprot.WriteMapEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6589,59 +8078,178 @@ func (p *GetNetworkRequest) writeField4(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *BatchNodeItem) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTypedProperties() {
+		if err = oprot.WriteFieldBegin("typed_properties", thrift.MAP, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRUCT, len(p.TypedProperties)); err != nil {
+			return err
+		}
+		for k, v := range p.TypedProperties {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *GetNetworkRequest) String() string {
+func (p *BatchNodeItem) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetNetworkRequest(%+v)", *p)
+	return fmt.Sprintf("BatchNodeItem(%+v)", *p)
 
 }
 
-// 网络查询响应
-type GetNetworkResponse struct {
-	Success bool   `thrift:"success,1" form:"success" json:"success" query:"success"`
-	Message string `thrift:"message,2" form:"message" json:"message" query:"message"`
-	// 返回完整节点信息而非ID
-	Nodes     []*Node     `thrift:"nodes,3" form:"nodes" json:"nodes" query:"nodes"`
-	Relations []*Relation `thrift:"relations,4" form:"relations" json:"relations" query:"relations"`
+// 批量创建中的单个关系
+type BatchRelationItem struct {
+	// 源节点ID (已存在的节点)
+	Source *string `thrift:"source,1,optional" form:"source" json:"source,omitempty" query:"source"`
+	// 目标节点ID (已存在的节点)
+	Target     *string           `thrift:"target,2,optional" form:"target" json:"target,omitempty" query:"target"`
+	Type       RelationType      `thrift:"type,3" form:"type" json:"type" query:"type"`
+	Label      *string           `thrift:"label,4,optional" form:"label" json:"label,omitempty" query:"label"`
+	Properties map[string]string `thrift:"properties,5,optional" form:"properties" json:"properties,omitempty" query:"properties"`
+	// 引用同一批次节点的 temp_key，设置后忽略 source
+	SourceKey *string `thrift:"source_key,6,optional" form:"source_key" json:"source_key,omitempty" query:"source_key"`
+	// 引用同一批次节点的 temp_key，设置后忽略 target
+	TargetKey       *string                   `thrift:"target_key,7,optional" form:"target_key" json:"target_key,omitempty" query:"target_key"`
+	TypedProperties map[string]*PropertyValue `thrift:"typed_properties,8,optional" form:"typed_properties" json:"typed_properties,omitempty" query:"typed_properties"`
 }
 
-func NewGetNetworkResponse() *GetNetworkResponse {
-	return &GetNetworkResponse{}
+func NewBatchRelationItem() *BatchRelationItem {
+	return &BatchRelationItem{}
 }
 
-func (p *GetNetworkResponse) InitDefault() {
+func (p *BatchRelationItem) InitDefault() {
 }
 
-func (p *GetNetworkResponse) GetSuccess() (v bool) {
-	return p.Success
+var BatchRelationItem_Source_DEFAULT string
+
+func (p *BatchRelationItem) GetSource() (v string) {
+	if !p.IsSetSource() {
+		return BatchRelationItem_Source_DEFAULT
+	}
+	return *p.Source
 }
 
-func (p *GetNetworkResponse) GetMessage() (v string) {
-	return p.Message
+var BatchRelationItem_Target_DEFAULT string
+
+func (p *BatchRelationItem) GetTarget() (v string) {
+	if !p.IsSetTarget() {
+		return BatchRelationItem_Target_DEFAULT
+	}
+	return *p.Target
 }
 
-func (p *GetNetworkResponse) GetNodes() (v []*Node) {
-	return p.Nodes
+func (p *BatchRelationItem) GetType() (v RelationType) {
+	return p.Type
 }
 
-func (p *GetNetworkResponse) GetRelations() (v []*Relation) {
-	return p.Relations
+var BatchRelationItem_Label_DEFAULT string
+
+func (p *BatchRelationItem) GetLabel() (v string) {
+	if !p.IsSetLabel() {
+		return BatchRelationItem_Label_DEFAULT
+	}
+	return *p.Label
 }
 
-var fieldIDToName_GetNetworkResponse = map[int16]string{
-	1: "success",
-	2: "message",
-	3: "nodes",
-	4: "relations",
+var BatchRelationItem_Properties_DEFAULT map[string]string
+
+func (p *BatchRelationItem) GetProperties() (v map[string]string) {
+	if !p.IsSetProperties() {
+		return BatchRelationItem_Properties_DEFAULT
+	}
+	return p.Properties
 }
 
-func (p *GetNetworkResponse) Read(iprot thrift.TProtocol) (err error) {
+var BatchRelationItem_SourceKey_DEFAULT string
+
+func (p *BatchRelationItem) GetSourceKey() (v string) {
+	if !p.IsSetSourceKey() {
+		return BatchRelationItem_SourceKey_DEFAULT
+	}
+	return *p.SourceKey
+}
+
+var BatchRelationItem_TargetKey_DEFAULT string
+
+func (p *BatchRelationItem) GetTargetKey() (v string) {
+	if !p.IsSetTargetKey() {
+		return BatchRelationItem_TargetKey_DEFAULT
+	}
+	return *p.TargetKey
+}
+
+var BatchRelationItem_TypedProperties_DEFAULT map[string]*PropertyValue
+
+func (p *BatchRelationItem) GetTypedProperties() (v map[string]*PropertyValue) {
+	if !p.IsSetTypedProperties() {
+		return BatchRelationItem_TypedProperties_DEFAULT
+	}
+	return p.TypedProperties
+}
+
+var fieldIDToName_BatchRelationItem = map[int16]string{
+	1: "source",
+	2: "target",
+	3: "type",
+	4: "label",
+	5: "properties",
+	6: "source_key",
+	7: "target_key",
+	8: "typed_properties",
+}
+
+func (p *BatchRelationItem) IsSetSource() bool {
+	return p.Source != nil
+}
+
+func (p *BatchRelationItem) IsSetTarget() bool {
+	return p.Target != nil
+}
+
+func (p *BatchRelationItem) IsSetLabel() bool {
+	return p.Label != nil
+}
+
+func (p *BatchRelationItem) IsSetProperties() bool {
+	return p.Properties != nil
+}
+
+func (p *BatchRelationItem) IsSetSourceKey() bool {
+	return p.SourceKey != nil
+}
+
+func (p *BatchRelationItem) IsSetTargetKey() bool {
+	return p.TargetKey != nil
+}
+
+func (p *BatchRelationItem) IsSetTypedProperties() bool {
+	return p.TypedProperties != nil
+}
+
+func (p *BatchRelationItem) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6660,7 +8268,7 @@ func (p *GetNetworkResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.BOOL {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6676,7 +8284,7 @@ func (p *GetNetworkResponse) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6684,13 +8292,45 @@ func (p *GetNetworkResponse) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 5:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6710,7 +8350,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetNetworkResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchRelationItem[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6720,78 +8360,134 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetNetworkResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *BatchRelationItem) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field bool
-	if v, err := iprot.ReadBool(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.Success = _field
+	p.Source = _field
 	return nil
 }
-func (p *GetNetworkResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *BatchRelationItem) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field string
+	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.Message = _field
+	p.Target = _field
 	return nil
 }
-func (p *GetNetworkResponse) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *BatchRelationItem) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field RelationType
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		_field = RelationType(v)
 	}
-	_field := make([]*Node, 0, size)
-	values := make([]Node, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+	p.Type = _field
+	return nil
+}
+func (p *BatchRelationItem) ReadField4(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Nodes = _field
+	p.Label = _field
 	return nil
 }
-func (p *GetNetworkResponse) ReadField4(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
+func (p *BatchRelationItem) ReadField5(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*Relation, 0, size)
-	values := make([]Relation, size)
+	_field := make(map[string]string, size)
 	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
 			return err
+		} else {
+			_key = v
 		}
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
 		return err
 	}
-	p.Relations = _field
+	p.Properties = _field
 	return nil
 }
+func (p *BatchRelationItem) ReadField6(iprot thrift.TProtocol) error {
 
-func (p *GetNetworkResponse) Write(oprot thrift.TProtocol) (err error) {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SourceKey = _field
+	return nil
+}
+func (p *BatchRelationItem) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetKey = _field
+	return nil
+}
+func (p *BatchRelationItem) ReadField8(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]*PropertyValue, size)
+	values := make([]PropertyValue, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		_val := &values[i]
+		_val.InitDefault()
+		if err := _val.Read(iprot); err != nil {
+			return err
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.TypedProperties = _field
+	return nil
+}
+
+func (p *BatchRelationItem) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetNetworkResponse"); err != nil {
+	if err = oprot.WriteStructBegin("BatchRelationItem"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6811,6 +8507,22 @@ func (p *GetNetworkResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -6829,15 +8541,17 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetNetworkResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteBool(p.Success); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *BatchRelationItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSource() {
+		if err = oprot.WriteFieldBegin("source", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Source); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -6845,15 +8559,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *GetNetworkResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Message); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *BatchRelationItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTarget() {
+		if err = oprot.WriteFieldBegin("target", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Target); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -6861,19 +8577,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *GetNetworkResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("nodes", thrift.LIST, 3); err != nil {
+func (p *BatchRelationItem) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("type", thrift.I32, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Nodes)); err != nil {
-		return err
-	}
-	for _, v := range p.Nodes {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
+	if err := oprot.WriteI32(int32(p.Type)); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6885,100 +8593,200 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *GetNetworkResponse) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("relations", thrift.LIST, 4); err != nil {
-		goto WriteFieldBeginError
+func (p *BatchRelationItem) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabel() {
+		if err = oprot.WriteFieldBegin("label", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Label); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Relations)); err != nil {
-		return err
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *BatchRelationItem) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetProperties() {
+		if err = oprot.WriteFieldBegin("properties", thrift.MAP, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Properties)); err != nil {
+			return err
+		}
+		for k, v := range p.Properties {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	for _, v := range p.Relations {
-		if err := v.Write(oprot); err != nil {
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *BatchRelationItem) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSourceKey() {
+		if err = oprot.WriteFieldBegin("source_key", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SourceKey); err != nil {
 			return err
 		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *BatchRelationItem) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetKey() {
+		if err = oprot.WriteFieldBegin("target_key", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TargetKey); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *BatchRelationItem) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTypedProperties() {
+		if err = oprot.WriteFieldBegin("typed_properties", thrift.MAP, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRUCT, len(p.TypedProperties)); err != nil {
+			return err
+		}
+		for k, v := range p.TypedProperties {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func (p *GetNetworkResponse) String() string {
+func (p *BatchRelationItem) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetNetworkResponse(%+v)", *p)
+	return fmt.Sprintf("BatchRelationItem(%+v)", *p)
 
 }
 
-// 路径查询请求
-type GetPathRequest struct {
-	// 起始节点ID
-	SourceID string `thrift:"source_id,1" form:"source_id" json:"source_id" query:"source_id"`
-	// 目标节点ID
-	TargetID string `thrift:"target_id,2" form:"target_id" json:"target_id" query:"target_id"`
-	// 最大查询深度
-	MaxDepth *int32 `thrift:"max_depth,3,optional" form:"max_depth" json:"max_depth,omitempty" query:"max_depth"`
-	// 关系类型筛选(可选)
-	Types []RelationType `thrift:"types,4,optional" form:"types" json:"types,omitempty" query:"types"`
+// 单个节点的创建结果
+type BatchNodeResult struct {
+	// 在请求列表中的下标
+	Index   int32   `thrift:"index,1" form:"index" json:"index" query:"index"`
+	TempKey *string `thrift:"temp_key,2,optional" form:"temp_key" json:"temp_key,omitempty" query:"temp_key"`
+	Success bool    `thrift:"success,3" form:"success" json:"success" query:"success"`
+	Error   *string `thrift:"error,4,optional" form:"error" json:"error,omitempty" query:"error"`
+	Node    *Node   `thrift:"node,5,optional" form:"node" json:"node,omitempty" query:"node"`
 }
 
-func NewGetPathRequest() *GetPathRequest {
-	return &GetPathRequest{}
+func NewBatchNodeResult() *BatchNodeResult {
+	return &BatchNodeResult{}
 }
 
-func (p *GetPathRequest) InitDefault() {
+func (p *BatchNodeResult) InitDefault() {
 }
 
-func (p *GetPathRequest) GetSourceID() (v string) {
-	return p.SourceID
+func (p *BatchNodeResult) GetIndex() (v int32) {
+	return p.Index
 }
 
-func (p *GetPathRequest) GetTargetID() (v string) {
-	return p.TargetID
+var BatchNodeResult_TempKey_DEFAULT string
+
+func (p *BatchNodeResult) GetTempKey() (v string) {
+	if !p.IsSetTempKey() {
+		return BatchNodeResult_TempKey_DEFAULT
+	}
+	return *p.TempKey
 }
 
-var GetPathRequest_MaxDepth_DEFAULT int32
+func (p *BatchNodeResult) GetSuccess() (v bool) {
+	return p.Success
+}
 
-func (p *GetPathRequest) GetMaxDepth() (v int32) {
-	if !p.IsSetMaxDepth() {
-		return GetPathRequest_MaxDepth_DEFAULT
+var BatchNodeResult_Error_DEFAULT string
+
+func (p *BatchNodeResult) GetError() (v string) {
+	if !p.IsSetError() {
+		return BatchNodeResult_Error_DEFAULT
 	}
-	return *p.MaxDepth
+	return *p.Error
 }
 
-var GetPathRequest_Types_DEFAULT []RelationType
+var BatchNodeResult_Node_DEFAULT *Node
 
-func (p *GetPathRequest) GetTypes() (v []RelationType) {
-	if !p.IsSetTypes() {
-		return GetPathRequest_Types_DEFAULT
+func (p *BatchNodeResult) GetNode() (v *Node) {
+	if !p.IsSetNode() {
+		return BatchNodeResult_Node_DEFAULT
 	}
-	return p.Types
+	return p.Node
 }
 
-var fieldIDToName_GetPathRequest = map[int16]string{
-	1: "source_id",
-	2: "target_id",
-	3: "max_depth",
-	4: "types",
+var fieldIDToName_BatchNodeResult = map[int16]string{
+	1: "index",
+	2: "temp_key",
+	3: "success",
+	4: "error",
+	5: "node",
 }
 
-func (p *GetPathRequest) IsSetMaxDepth() bool {
-	return p.MaxDepth != nil
+func (p *BatchNodeResult) IsSetTempKey() bool {
+	return p.TempKey != nil
 }
 
-func (p *GetPathRequest) IsSetTypes() bool {
-	return p.Types != nil
+func (p *BatchNodeResult) IsSetError() bool {
+	return p.Error != nil
 }
 
-func (p *GetPathRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *BatchNodeResult) IsSetNode() bool {
+	return p.Node != nil
+}
+
+func (p *BatchNodeResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6997,7 +8805,7 @@ func (p *GetPathRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7013,7 +8821,7 @@ func (p *GetPathRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.BOOL {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7021,13 +8829,21 @@ func (p *GetPathRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -7047,7 +8863,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetPathRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchNodeResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7057,66 +8873,62 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetPathRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *BatchNodeResult) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.SourceID = _field
+	p.Index = _field
 	return nil
 }
-func (p *GetPathRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *BatchNodeResult) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field string
+	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.TargetID = _field
+	p.TempKey = _field
 	return nil
 }
-func (p *GetPathRequest) ReadField3(iprot thrift.TProtocol) error {
+func (p *BatchNodeResult) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.MaxDepth = _field
+	p.Success = _field
 	return nil
 }
-func (p *GetPathRequest) ReadField4(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]RelationType, 0, size)
-	for i := 0; i < size; i++ {
-
-		var _elem RelationType
-		if v, err := iprot.ReadI32(); err != nil {
-			return err
-		} else {
-			_elem = RelationType(v)
-		}
+func (p *BatchNodeResult) ReadField4(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	if err := iprot.ReadListEnd(); err != nil {
+	p.Error = _field
+	return nil
+}
+func (p *BatchNodeResult) ReadField5(iprot thrift.TProtocol) error {
+	_field := NewNode()
+	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.Types = _field
+	p.Node = _field
 	return nil
 }
 
-func (p *GetPathRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *BatchNodeResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetPathRequest"); err != nil {
+	if err = oprot.WriteStructBegin("BatchNodeResult"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7136,6 +8948,10 @@ func (p *GetPathRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7154,11 +8970,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetPathRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("source_id", thrift.STRING, 1); err != nil {
+func (p *BatchNodeResult) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("index", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.SourceID); err != nil {
+	if err := oprot.WriteI32(p.Index); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7170,11 +8986,29 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *GetPathRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("target_id", thrift.STRING, 2); err != nil {
+func (p *BatchNodeResult) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTempKey() {
+		if err = oprot.WriteFieldBegin("temp_key", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TempKey); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *BatchNodeResult) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.TargetID); err != nil {
+	if err := oprot.WriteBool(p.Success); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7182,16 +9016,16 @@ func (p *GetPathRequest) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *GetPathRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetMaxDepth() {
-		if err = oprot.WriteFieldBegin("max_depth", thrift.I32, 3); err != nil {
+func (p *BatchNodeResult) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetError() {
+		if err = oprot.WriteFieldBegin("error", thrift.STRING, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(*p.MaxDepth); err != nil {
+		if err := oprot.WriteString(*p.Error); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7200,24 +9034,16 @@ func (p *GetPathRequest) writeField3(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *GetPathRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTypes() {
-		if err = oprot.WriteFieldBegin("types", thrift.LIST, 4); err != nil {
+func (p *BatchNodeResult) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNode() {
+		if err = oprot.WriteFieldBegin("node", thrift.STRUCT, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.I32, len(p.Types)); err != nil {
-			return err
-		}
-		for _, v := range p.Types {
-			if err := oprot.WriteI32(int32(v)); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := p.Node.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -7226,60 +9052,77 @@ func (p *GetPathRequest) writeField4(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *GetPathRequest) String() string {
+func (p *BatchNodeResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetPathRequest(%+v)", *p)
+	return fmt.Sprintf("BatchNodeResult(%+v)", *p)
 
 }
 
-// 路径查询响应
-type GetPathResponse struct {
-	Success bool   `thrift:"success,1" form:"success" json:"success" query:"success"`
-	Message string `thrift:"message,2" form:"message" json:"message" query:"message"`
-	// 路径上的节点
-	Nodes []*Node `thrift:"nodes,3" form:"nodes" json:"nodes" query:"nodes"`
-	// 路径上的关系
-	Relations []*Relation `thrift:"relations,4" form:"relations" json:"relations" query:"relations"`
+// 单个关系的创建结果
+type BatchRelationResult struct {
+	// 在请求列表中的下标
+	Index    int32     `thrift:"index,1" form:"index" json:"index" query:"index"`
+	Success  bool      `thrift:"success,2" form:"success" json:"success" query:"success"`
+	Error    *string   `thrift:"error,3,optional" form:"error" json:"error,omitempty" query:"error"`
+	Relation *Relation `thrift:"relation,4,optional" form:"relation" json:"relation,omitempty" query:"relation"`
 }
 
-func NewGetPathResponse() *GetPathResponse {
-	return &GetPathResponse{}
+func NewBatchRelationResult() *BatchRelationResult {
+	return &BatchRelationResult{}
 }
 
-func (p *GetPathResponse) InitDefault() {
+func (p *BatchRelationResult) InitDefault() {
 }
 
-func (p *GetPathResponse) GetSuccess() (v bool) {
+func (p *BatchRelationResult) GetIndex() (v int32) {
+	return p.Index
+}
+
+func (p *BatchRelationResult) GetSuccess() (v bool) {
 	return p.Success
 }
 
-func (p *GetPathResponse) GetMessage() (v string) {
-	return p.Message
+var BatchRelationResult_Error_DEFAULT string
+
+func (p *BatchRelationResult) GetError() (v string) {
+	if !p.IsSetError() {
+		return BatchRelationResult_Error_DEFAULT
+	}
+	return *p.Error
 }
 
-func (p *GetPathResponse) GetNodes() (v []*Node) {
-	return p.Nodes
+var BatchRelationResult_Relation_DEFAULT *Relation
+
+func (p *BatchRelationResult) GetRelation() (v *Relation) {
+	if !p.IsSetRelation() {
+		return BatchRelationResult_Relation_DEFAULT
+	}
+	return p.Relation
 }
 
-func (p *GetPathResponse) GetRelations() (v []*Relation) {
-	return p.Relations
+var fieldIDToName_BatchRelationResult = map[int16]string{
+	1: "index",
+	2: "success",
+	3: "error",
+	4: "relation",
 }
 
-var fieldIDToName_GetPathResponse = map[int16]string{
-	1: "success",
-	2: "message",
-	3: "nodes",
-	4: "relations",
+func (p *BatchRelationResult) IsSetError() bool {
+	return p.Error != nil
 }
 
-func (p *GetPathResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *BatchRelationResult) IsSetRelation() bool {
+	return p.Relation != nil
+}
+
+func (p *BatchRelationResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7298,7 +9141,7 @@ func (p *GetPathResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.BOOL {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7306,7 +9149,7 @@ func (p *GetPathResponse) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.BOOL {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7314,7 +9157,7 @@ func (p *GetPathResponse) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7322,7 +9165,7 @@ func (p *GetPathResponse) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7348,7 +9191,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetPathResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchRelationResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7358,78 +9201,51 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetPathResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *BatchRelationResult) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field bool
-	if v, err := iprot.ReadBool(); err != nil {
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Success = _field
+	p.Index = _field
 	return nil
 }
-func (p *GetPathResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *BatchRelationResult) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Message = _field
+	p.Success = _field
 	return nil
 }
-func (p *GetPathResponse) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*Node, 0, size)
-	values := make([]Node, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+func (p *BatchRelationResult) ReadField3(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Nodes = _field
+	p.Error = _field
 	return nil
 }
-func (p *GetPathResponse) ReadField4(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*Relation, 0, size)
-	values := make([]Relation, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+func (p *BatchRelationResult) ReadField4(iprot thrift.TProtocol) error {
+	_field := NewRelation()
+	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.Relations = _field
+	p.Relation = _field
 	return nil
 }
 
-func (p *GetPathResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *BatchRelationResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetPathResponse"); err != nil {
+	if err = oprot.WriteStructBegin("BatchRelationResult"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7467,11 +9283,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetPathResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+func (p *BatchRelationResult) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("index", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteBool(p.Success); err != nil {
+	if err := oprot.WriteI32(p.Index); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7483,11 +9299,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *GetPathResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+func (p *BatchRelationResult) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Message); err != nil {
+	if err := oprot.WriteBool(p.Success); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7499,23 +9315,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *GetPathResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("nodes", thrift.LIST, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Nodes)); err != nil {
-		return err
-	}
-	for _, v := range p.Nodes {
-		if err := v.Write(oprot); err != nil {
+func (p *BatchRelationResult) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetError() {
+		if err = oprot.WriteFieldBegin("error", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Error); err != nil {
 			return err
 		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -7523,23 +9333,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *GetPathResponse) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("relations", thrift.LIST, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Relations)); err != nil {
-		return err
-	}
-	for _, v := range p.Relations {
-		if err := v.Write(oprot); err != nil {
+func (p *BatchRelationResult) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRelation() {
+		if err = oprot.WriteFieldBegin("relation", thrift.STRUCT, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Relation.Write(oprot); err != nil {
 			return err
 		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -7548,834 +9352,16496 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *GetPathResponse) String() string {
+func (p *BatchRelationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetPathResponse(%+v)", *p)
+	return fmt.Sprintf("BatchRelationResult(%+v)", *p)
 
 }
 
-// 关系网络服务定义
-type NetworkService interface {
-	// 网络查询
-	GetNetwork(ctx context.Context, req *GetNetworkRequest) (r *GetNetworkResponse, err error)
-	// 路径查询
-	GetPath(ctx context.Context, req *GetPathRequest) (r *GetPathResponse, err error)
-	// 搜索节点
-	SearchNodes(ctx context.Context, req *SearchNodesRequest) (r *SearchNodesResponse, err error)
-	// 节点 CRUD
-	CreateNode(ctx context.Context, req *CreateNodeRequest) (r *CreateNodeResponse, err error)
+// 批量创建节点请求
+type BatchCreateNodesRequest struct {
+	Nodes []*BatchNodeItem `thrift:"nodes,1" form:"nodes" json:"nodes" query:"nodes"`
+	// 可选，与节点在同一事务中创建的关系，可引用本批次节点的 temp_key
+	Relations []*BatchRelationItem `thrift:"relations,2,optional" form:"relations" json:"relations,omitempty" query:"relations"`
+}
 
-	GetNode(ctx context.Context, req *GetNodeRequest) (r *GetNodeResponse, err error)
+func NewBatchCreateNodesRequest() *BatchCreateNodesRequest {
+	return &BatchCreateNodesRequest{}
+}
 
-	UpdateNode(ctx context.Context, req *UpdateNodeRequest) (r *UpdateNodeResponse, err error)
+func (p *BatchCreateNodesRequest) InitDefault() {
+}
 
-	DeleteNode(ctx context.Context, req *DeleteNodeRequest) (r *DeleteNodeResponse, err error)
-	// 关系 CRUD
-	CreateRelation(ctx context.Context, req *CreateRelationRequest) (r *CreateRelationResponse, err error)
+func (p *BatchCreateNodesRequest) GetNodes() (v []*BatchNodeItem) {
+	return p.Nodes
+}
 
-	GetRelation(ctx context.Context, req *GetRelationRequest) (r *GetRelationResponse, err error)
+var BatchCreateNodesRequest_Relations_DEFAULT []*BatchRelationItem
 
-	UpdateRelation(ctx context.Context, req *UpdateRelationRequest) (r *UpdateRelationResponse, err error)
+func (p *BatchCreateNodesRequest) GetRelations() (v []*BatchRelationItem) {
+	if !p.IsSetRelations() {
+		return BatchCreateNodesRequest_Relations_DEFAULT
+	}
+	return p.Relations
+}
 
-	DeleteRelation(ctx context.Context, req *DeleteRelationRequest) (r *DeleteRelationResponse, err error)
-	// 获取节点的所有关系
-	GetNodeRelations(ctx context.Context, req *GetNodeRelationsRequest) (r *GetNodeRelationsResponse, err error)
+var fieldIDToName_BatchCreateNodesRequest = map[int16]string{
+	1: "nodes",
+	2: "relations",
 }
 
-type NetworkServiceClient struct {
-	c thrift.TClient
+func (p *BatchCreateNodesRequest) IsSetRelations() bool {
+	return p.Relations != nil
 }
 
-func NewNetworkServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *NetworkServiceClient {
-	return &NetworkServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+func (p *BatchCreateNodesRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-}
 
-func NewNetworkServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *NetworkServiceClient {
-	return &NetworkServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchCreateNodesRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func NewNetworkServiceClient(c thrift.TClient) *NetworkServiceClient {
-	return &NetworkServiceClient{
-		c: c,
+func (p *BatchCreateNodesRequest) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
 	}
-}
+	_field := make([]*BatchNodeItem, 0, size)
+	values := make([]BatchNodeItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-func (p *NetworkServiceClient) Client_() thrift.TClient {
-	return p.c
-}
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
 
-func (p *NetworkServiceClient) GetNetwork(ctx context.Context, req *GetNetworkRequest) (r *GetNetworkResponse, err error) {
-	var _args NetworkServiceGetNetworkArgs
-	_args.Req = req
-	var _result NetworkServiceGetNetworkResult
-	if err = p.Client_().Call(ctx, "GetNetwork", &_args, &_result); err != nil {
-		return
+		_field = append(_field, _elem)
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *NetworkServiceClient) GetPath(ctx context.Context, req *GetPathRequest) (r *GetPathResponse, err error) {
-	var _args NetworkServiceGetPathArgs
-	_args.Req = req
-	var _result NetworkServiceGetPathResult
-	if err = p.Client_().Call(ctx, "GetPath", &_args, &_result); err != nil {
-		return
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	p.Nodes = _field
+	return nil
 }
-func (p *NetworkServiceClient) SearchNodes(ctx context.Context, req *SearchNodesRequest) (r *SearchNodesResponse, err error) {
-	var _args NetworkServiceSearchNodesArgs
-	_args.Req = req
-	var _result NetworkServiceSearchNodesResult
-	if err = p.Client_().Call(ctx, "SearchNodes", &_args, &_result); err != nil {
-		return
+func (p *BatchCreateNodesRequest) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *NetworkServiceClient) CreateNode(ctx context.Context, req *CreateNodeRequest) (r *CreateNodeResponse, err error) {
-	var _args NetworkServiceCreateNodeArgs
-	_args.Req = req
-	var _result NetworkServiceCreateNodeResult
-	if err = p.Client_().Call(ctx, "CreateNode", &_args, &_result); err != nil {
-		return
+	_field := make([]*BatchRelationItem, 0, size)
+	values := make([]BatchRelationItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *NetworkServiceClient) GetNode(ctx context.Context, req *GetNodeRequest) (r *GetNodeResponse, err error) {
-	var _args NetworkServiceGetNodeArgs
-	_args.Req = req
-	var _result NetworkServiceGetNodeResult
-	if err = p.Client_().Call(ctx, "GetNode", &_args, &_result); err != nil {
-		return
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	p.Relations = _field
+	return nil
 }
-func (p *NetworkServiceClient) UpdateNode(ctx context.Context, req *UpdateNodeRequest) (r *UpdateNodeResponse, err error) {
-	var _args NetworkServiceUpdateNodeArgs
-	_args.Req = req
-	var _result NetworkServiceUpdateNodeResult
-	if err = p.Client_().Call(ctx, "UpdateNode", &_args, &_result); err != nil {
-		return
+
+func (p *BatchCreateNodesRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchCreateNodesRequest"); err != nil {
+		goto WriteStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *NetworkServiceClient) DeleteNode(ctx context.Context, req *DeleteNodeRequest) (r *DeleteNodeResponse, err error) {
-	var _args NetworkServiceDeleteNodeArgs
-	_args.Req = req
-	var _result NetworkServiceDeleteNodeResult
-	if err = p.Client_().Call(ctx, "DeleteNode", &_args, &_result); err != nil {
-		return
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *NetworkServiceClient) CreateRelation(ctx context.Context, req *CreateRelationRequest) (r *CreateRelationResponse, err error) {
-	var _args NetworkServiceCreateRelationArgs
-	_args.Req = req
-	var _result NetworkServiceCreateRelationResult
-	if err = p.Client_().Call(ctx, "CreateRelation", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *NetworkServiceClient) GetRelation(ctx context.Context, req *GetRelationRequest) (r *GetRelationResponse, err error) {
-	var _args NetworkServiceGetRelationArgs
-	_args.Req = req
-	var _result NetworkServiceGetRelationResult
-	if err = p.Client_().Call(ctx, "GetRelation", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *NetworkServiceClient) UpdateRelation(ctx context.Context, req *UpdateRelationRequest) (r *UpdateRelationResponse, err error) {
-	var _args NetworkServiceUpdateRelationArgs
-	_args.Req = req
-	var _result NetworkServiceUpdateRelationResult
-	if err = p.Client_().Call(ctx, "UpdateRelation", &_args, &_result); err != nil {
-		return
+
+func (p *BatchCreateNodesRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("nodes", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *NetworkServiceClient) DeleteRelation(ctx context.Context, req *DeleteRelationRequest) (r *DeleteRelationResponse, err error) {
-	var _args NetworkServiceDeleteRelationArgs
-	_args.Req = req
-	var _result NetworkServiceDeleteRelationResult
-	if err = p.Client_().Call(ctx, "DeleteRelation", &_args, &_result); err != nil {
-		return
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Nodes)); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *NetworkServiceClient) GetNodeRelations(ctx context.Context, req *GetNodeRelationsRequest) (r *GetNodeRelationsResponse, err error) {
-	var _args NetworkServiceGetNodeRelationsArgs
-	_args.Req = req
-	var _result NetworkServiceGetNodeRelationsResult
-	if err = p.Client_().Call(ctx, "GetNodeRelations", &_args, &_result); err != nil {
-		return
+	for _, v := range p.Nodes {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
 	}
-	return _result.GetSuccess(), nil
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *BatchCreateNodesRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRelations() {
+		if err = oprot.WriteFieldBegin("relations", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Relations)); err != nil {
+			return err
+		}
+		for _, v := range p.Relations {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-type NetworkServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      NetworkService
+func (p *BatchCreateNodesRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchCreateNodesRequest(%+v)", *p)
+
 }
 
-func (p *NetworkServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+// 批量创建节点响应
+type BatchCreateNodesResponse struct {
+	Success         bool                   `thrift:"success,1" form:"success" json:"success" query:"success"`
+	Message         string                 `thrift:"message,2" form:"message" json:"message" query:"message"`
+	NodeResults     []*BatchNodeResult     `thrift:"node_results,3" form:"node_results" json:"node_results" query:"node_results"`
+	RelationResults []*BatchRelationResult `thrift:"relation_results,4,optional" form:"relation_results" json:"relation_results,omitempty" query:"relation_results"`
 }
 
-func (p *NetworkServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+func NewBatchCreateNodesResponse() *BatchCreateNodesResponse {
+	return &BatchCreateNodesResponse{}
 }
 
-func (p *NetworkServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func (p *BatchCreateNodesResponse) InitDefault() {
 }
 
-func NewNetworkServiceProcessor(handler NetworkService) *NetworkServiceProcessor {
-	self := &NetworkServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("GetNetwork", &networkServiceProcessorGetNetwork{handler: handler})
-	self.AddToProcessorMap("GetPath", &networkServiceProcessorGetPath{handler: handler})
-	self.AddToProcessorMap("SearchNodes", &networkServiceProcessorSearchNodes{handler: handler})
-	self.AddToProcessorMap("CreateNode", &networkServiceProcessorCreateNode{handler: handler})
-	self.AddToProcessorMap("GetNode", &networkServiceProcessorGetNode{handler: handler})
-	self.AddToProcessorMap("UpdateNode", &networkServiceProcessorUpdateNode{handler: handler})
-	self.AddToProcessorMap("DeleteNode", &networkServiceProcessorDeleteNode{handler: handler})
-	self.AddToProcessorMap("CreateRelation", &networkServiceProcessorCreateRelation{handler: handler})
-	self.AddToProcessorMap("GetRelation", &networkServiceProcessorGetRelation{handler: handler})
-	self.AddToProcessorMap("UpdateRelation", &networkServiceProcessorUpdateRelation{handler: handler})
-	self.AddToProcessorMap("DeleteRelation", &networkServiceProcessorDeleteRelation{handler: handler})
-	self.AddToProcessorMap("GetNodeRelations", &networkServiceProcessorGetNodeRelations{handler: handler})
-	return self
+func (p *BatchCreateNodesResponse) GetSuccess() (v bool) {
+	return p.Success
 }
-func (p *NetworkServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
-	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
-	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+
+func (p *BatchCreateNodesResponse) GetMessage() (v string) {
+	return p.Message
 }
 
-type networkServiceProcessorGetNetwork struct {
-	handler NetworkService
+func (p *BatchCreateNodesResponse) GetNodeResults() (v []*BatchNodeResult) {
+	return p.NodeResults
 }
 
-func (p *networkServiceProcessorGetNetwork) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NetworkServiceGetNetworkArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetNetwork", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var BatchCreateNodesResponse_RelationResults_DEFAULT []*BatchRelationResult
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := NetworkServiceGetNetworkResult{}
-	var retval *GetNetworkResponse
-	if retval, err2 = p.handler.GetNetwork(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetNetwork: "+err2.Error())
-		oprot.WriteMessageBegin("GetNetwork", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetNetwork", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *BatchCreateNodesResponse) GetRelationResults() (v []*BatchRelationResult) {
+	if !p.IsSetRelationResults() {
+		return BatchCreateNodesResponse_RelationResults_DEFAULT
 	}
-	return true, err
+	return p.RelationResults
 }
 
-type networkServiceProcessorGetPath struct {
-	handler NetworkService
+var fieldIDToName_BatchCreateNodesResponse = map[int16]string{
+	1: "success",
+	2: "message",
+	3: "node_results",
+	4: "relation_results",
 }
 
-func (p *networkServiceProcessorGetPath) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NetworkServiceGetPathArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetPath", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *BatchCreateNodesResponse) IsSetRelationResults() bool {
+	return p.RelationResults != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := NetworkServiceGetPathResult{}
-	var retval *GetPathResponse
-	if retval, err2 = p.handler.GetPath(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetPath: "+err2.Error())
-		oprot.WriteMessageBegin("GetPath", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetPath", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *BatchCreateNodesResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchCreateNodesResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-type networkServiceProcessorSearchNodes struct {
-	handler NetworkService
+func (p *BatchCreateNodesResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Success = _field
+	return nil
 }
+func (p *BatchCreateNodesResponse) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *networkServiceProcessorSearchNodes) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NetworkServiceSearchNodesArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("SearchNodes", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := NetworkServiceSearchNodesResult{}
-	var retval *SearchNodesResponse
-	if retval, err2 = p.handler.SearchNodes(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SearchNodes: "+err2.Error())
-		oprot.WriteMessageBegin("SearchNodes", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
 	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("SearchNodes", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+		_field = v
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	p.Message = _field
+	return nil
+}
+func (p *BatchCreateNodesResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	_field := make([]*BatchNodeResult, 0, size)
+	values := make([]BatchNodeResult, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
 	}
+	p.NodeResults = _field
+	return nil
+}
+func (p *BatchCreateNodesResponse) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
 	if err != nil {
-		return
+		return err
 	}
-	return true, err
-}
+	_field := make([]*BatchRelationResult, 0, size)
+	values := make([]BatchRelationResult, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-type networkServiceProcessorCreateNode struct {
-	handler NetworkService
-}
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
 
-func (p *networkServiceProcessorCreateNode) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NetworkServiceCreateNodeArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CreateNode", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
 	}
+	p.RelationResults = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := NetworkServiceCreateNodeResult{}
-	var retval *CreateNodeResponse
-	if retval, err2 = p.handler.CreateNode(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateNode: "+err2.Error())
-		oprot.WriteMessageBegin("CreateNode", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *BatchCreateNodesResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchCreateNodesResponse"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("CreateNode", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *BatchCreateNodesResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err != nil {
-		return
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
 	}
-	return true, err
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
-type networkServiceProcessorGetNode struct {
-	handler NetworkService
+func (p *BatchCreateNodesResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
-func (p *networkServiceProcessorGetNode) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NetworkServiceGetNodeArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetNode", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *BatchCreateNodesResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("node_results", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
 	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := NetworkServiceGetNodeResult{}
-	var retval *GetNodeResponse
-	if retval, err2 = p.handler.GetNode(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetNode: "+err2.Error())
-		oprot.WriteMessageBegin("GetNode", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.NodeResults)); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageBegin("GetNode", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	for _, v := range p.NodeResults {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *BatchCreateNodesResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRelationResults() {
+		if err = oprot.WriteFieldBegin("relation_results", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.RelationResults)); err != nil {
+			return err
+		}
+		for _, v := range p.RelationResults {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *BatchCreateNodesResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("BatchCreateNodesResponse(%+v)", *p)
+
 }
 
-type networkServiceProcessorUpdateNode struct {
-	handler NetworkService
+// 批量创建关系请求
+type BatchCreateRelationsRequest struct {
+	Relations []*BatchRelationItem `thrift:"relations,1" form:"relations" json:"relations" query:"relations"`
 }
 
-func (p *networkServiceProcessorUpdateNode) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NetworkServiceUpdateNodeArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdateNode", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
+func NewBatchCreateRelationsRequest() *BatchCreateRelationsRequest {
+	return &BatchCreateRelationsRequest{}
+}
+
+func (p *BatchCreateRelationsRequest) InitDefault() {
+}
+
+func (p *BatchCreateRelationsRequest) GetRelations() (v []*BatchRelationItem) {
+	return p.Relations
+}
+
+var fieldIDToName_BatchCreateRelationsRequest = map[int16]string{
+	1: "relations",
+}
+
+func (p *BatchCreateRelationsRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchCreateRelationsRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *BatchCreateRelationsRequest) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*BatchRelationItem, 0, size)
+	values := make([]BatchRelationItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Relations = _field
+	return nil
+}
+
+func (p *BatchCreateRelationsRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchCreateRelationsRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *BatchCreateRelationsRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("relations", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Relations)); err != nil {
+		return err
+	}
+	for _, v := range p.Relations {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *BatchCreateRelationsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchCreateRelationsRequest(%+v)", *p)
+
+}
+
+// 批量创建关系响应
+type BatchCreateRelationsResponse struct {
+	Success bool                   `thrift:"success,1" form:"success" json:"success" query:"success"`
+	Message string                 `thrift:"message,2" form:"message" json:"message" query:"message"`
+	Results []*BatchRelationResult `thrift:"results,3" form:"results" json:"results" query:"results"`
+}
+
+func NewBatchCreateRelationsResponse() *BatchCreateRelationsResponse {
+	return &BatchCreateRelationsResponse{}
+}
+
+func (p *BatchCreateRelationsResponse) InitDefault() {
+}
+
+func (p *BatchCreateRelationsResponse) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *BatchCreateRelationsResponse) GetMessage() (v string) {
+	return p.Message
+}
+
+func (p *BatchCreateRelationsResponse) GetResults() (v []*BatchRelationResult) {
+	return p.Results
+}
+
+var fieldIDToName_BatchCreateRelationsResponse = map[int16]string{
+	1: "success",
+	2: "message",
+	3: "results",
+}
+
+func (p *BatchCreateRelationsResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchCreateRelationsResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *BatchCreateRelationsResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Success = _field
+	return nil
+}
+func (p *BatchCreateRelationsResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Message = _field
+	return nil
+}
+func (p *BatchCreateRelationsResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*BatchRelationResult, 0, size)
+	values := make([]BatchRelationResult, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Results = _field
+	return nil
+}
+
+func (p *BatchCreateRelationsResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchCreateRelationsResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *BatchCreateRelationsResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *BatchCreateRelationsResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *BatchCreateRelationsResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("results", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Results)); err != nil {
+		return err
+	}
+	for _, v := range p.Results {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *BatchCreateRelationsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchCreateRelationsResponse(%+v)", *p)
+
+}
+
+// =============== 关系 CRUD 操作 ===============
+// 创建关系请求
+type CreateRelationRequest struct {
+	// 源节点ID
+	Source string `thrift:"source,1" form:"source" json:"source" query:"source"`
+	// 目标节点ID
+	Target string `thrift:"target,2" form:"target" json:"target" query:"target"`
+	// 关系类型
+	Type RelationType `thrift:"type,3" form:"type" json:"type" query:"type"`
+	// 关系标签
+	Label *string `thrift:"label,4,optional" form:"label" json:"label,omitempty" query:"label"`
+	// 关系属性
+	Properties map[string]string `thrift:"properties,5,optional" form:"properties" json:"properties,omitempty" query:"properties"`
+	// 带类型的属性，与 properties 中的同名键冲突时优先
+	TypedProperties map[string]*PropertyValue `thrift:"typed_properties,6,optional" form:"typed_properties" json:"typed_properties,omitempty" query:"typed_properties"`
+}
+
+func NewCreateRelationRequest() *CreateRelationRequest {
+	return &CreateRelationRequest{}
+}
+
+func (p *CreateRelationRequest) InitDefault() {
+}
+
+func (p *CreateRelationRequest) GetSource() (v string) {
+	return p.Source
+}
+
+func (p *CreateRelationRequest) GetTarget() (v string) {
+	return p.Target
+}
+
+func (p *CreateRelationRequest) GetType() (v RelationType) {
+	return p.Type
+}
+
+var CreateRelationRequest_Label_DEFAULT string
+
+func (p *CreateRelationRequest) GetLabel() (v string) {
+	if !p.IsSetLabel() {
+		return CreateRelationRequest_Label_DEFAULT
+	}
+	return *p.Label
+}
+
+var CreateRelationRequest_Properties_DEFAULT map[string]string
+
+func (p *CreateRelationRequest) GetProperties() (v map[string]string) {
+	if !p.IsSetProperties() {
+		return CreateRelationRequest_Properties_DEFAULT
+	}
+	return p.Properties
+}
+
+var CreateRelationRequest_TypedProperties_DEFAULT map[string]*PropertyValue
+
+func (p *CreateRelationRequest) GetTypedProperties() (v map[string]*PropertyValue) {
+	if !p.IsSetTypedProperties() {
+		return CreateRelationRequest_TypedProperties_DEFAULT
+	}
+	return p.TypedProperties
+}
+
+var fieldIDToName_CreateRelationRequest = map[int16]string{
+	1: "source",
+	2: "target",
+	3: "type",
+	4: "label",
+	5: "properties",
+	6: "typed_properties",
+}
+
+func (p *CreateRelationRequest) IsSetLabel() bool {
+	return p.Label != nil
+}
+
+func (p *CreateRelationRequest) IsSetProperties() bool {
+	return p.Properties != nil
+}
+
+func (p *CreateRelationRequest) IsSetTypedProperties() bool {
+	return p.TypedProperties != nil
+}
+
+func (p *CreateRelationRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateRelationRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CreateRelationRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Source = _field
+	return nil
+}
+func (p *CreateRelationRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Target = _field
+	return nil
+}
+func (p *CreateRelationRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field RelationType
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = RelationType(v)
+	}
+	p.Type = _field
+	return nil
+}
+func (p *CreateRelationRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Label = _field
+	return nil
+}
+func (p *CreateRelationRequest) ReadField5(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Properties = _field
+	return nil
+}
+func (p *CreateRelationRequest) ReadField6(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]*PropertyValue, size)
+	values := make([]PropertyValue, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		_val := &values[i]
+		_val.InitDefault()
+		if err := _val.Read(iprot); err != nil {
+			return err
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.TypedProperties = _field
+	return nil
+}
+
+func (p *CreateRelationRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateRelationRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CreateRelationRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("source", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Source); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *CreateRelationRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("target", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Target); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *CreateRelationRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("type", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(int32(p.Type)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *CreateRelationRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabel() {
+		if err = oprot.WriteFieldBegin("label", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Label); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *CreateRelationRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetProperties() {
+		if err = oprot.WriteFieldBegin("properties", thrift.MAP, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Properties)); err != nil {
+			return err
+		}
+		for k, v := range p.Properties {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *CreateRelationRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTypedProperties() {
+		if err = oprot.WriteFieldBegin("typed_properties", thrift.MAP, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRUCT, len(p.TypedProperties)); err != nil {
+			return err
+		}
+		for k, v := range p.TypedProperties {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *CreateRelationRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateRelationRequest(%+v)", *p)
+
+}
+
+// 创建关系响应
+type CreateRelationResponse struct {
+	Success  bool      `thrift:"success,1" form:"success" json:"success" query:"success"`
+	Message  string    `thrift:"message,2" form:"message" json:"message" query:"message"`
+	Relation *Relation `thrift:"relation,3" form:"relation" json:"relation" query:"relation"`
+	// 属性不符合类型约束时的逐项错误
+	FieldErrors []*FieldError `thrift:"field_errors,4,optional" form:"field_errors" json:"field_errors,omitempty" query:"field_errors"`
+}
+
+func NewCreateRelationResponse() *CreateRelationResponse {
+	return &CreateRelationResponse{}
+}
+
+func (p *CreateRelationResponse) InitDefault() {
+}
+
+func (p *CreateRelationResponse) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *CreateRelationResponse) GetMessage() (v string) {
+	return p.Message
+}
+
+var CreateRelationResponse_Relation_DEFAULT *Relation
+
+func (p *CreateRelationResponse) GetRelation() (v *Relation) {
+	if !p.IsSetRelation() {
+		return CreateRelationResponse_Relation_DEFAULT
+	}
+	return p.Relation
+}
+
+var CreateRelationResponse_FieldErrors_DEFAULT []*FieldError
+
+func (p *CreateRelationResponse) GetFieldErrors() (v []*FieldError) {
+	if !p.IsSetFieldErrors() {
+		return CreateRelationResponse_FieldErrors_DEFAULT
+	}
+	return p.FieldErrors
+}
+
+var fieldIDToName_CreateRelationResponse = map[int16]string{
+	1: "success",
+	2: "message",
+	3: "relation",
+	4: "field_errors",
+}
+
+func (p *CreateRelationResponse) IsSetRelation() bool {
+	return p.Relation != nil
+}
+
+func (p *CreateRelationResponse) IsSetFieldErrors() bool {
+	return p.FieldErrors != nil
+}
+
+func (p *CreateRelationResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateRelationResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CreateRelationResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Success = _field
+	return nil
+}
+func (p *CreateRelationResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Message = _field
+	return nil
+}
+func (p *CreateRelationResponse) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewRelation()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Relation = _field
+	return nil
+}
+func (p *CreateRelationResponse) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*FieldError, 0, size)
+	values := make([]FieldError, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.FieldErrors = _field
+	return nil
+}
+
+func (p *CreateRelationResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateRelationResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CreateRelationResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *CreateRelationResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *CreateRelationResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("relation", thrift.STRUCT, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Relation.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *CreateRelationResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFieldErrors() {
+		if err = oprot.WriteFieldBegin("field_errors", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FieldErrors)); err != nil {
+			return err
+		}
+		for _, v := range p.FieldErrors {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *CreateRelationResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateRelationResponse(%+v)", *p)
+
+}
+
+// 更新关系请求
+type UpdateRelationRequest struct {
+	// 关系ID
+	ID         string            `thrift:"id,1" form:"id" json:"id" query:"id"`
+	Type       *RelationType     `thrift:"type,2,optional" form:"type" json:"type,omitempty" query:"type"`
+	Label      *string           `thrift:"label,3,optional" form:"label" json:"label,omitempty" query:"label"`
+	Properties map[string]string `thrift:"properties,4,optional" form:"properties" json:"properties,omitempty" query:"properties"`
+	// 带类型的属性，与 properties 中的同名键冲突时优先
+	TypedProperties map[string]*PropertyValue `thrift:"typed_properties,5,optional" form:"typed_properties" json:"typed_properties,omitempty" query:"typed_properties"`
+}
+
+func NewUpdateRelationRequest() *UpdateRelationRequest {
+	return &UpdateRelationRequest{}
+}
+
+func (p *UpdateRelationRequest) InitDefault() {
+}
+
+func (p *UpdateRelationRequest) GetID() (v string) {
+	return p.ID
+}
+
+var UpdateRelationRequest_Type_DEFAULT RelationType
+
+func (p *UpdateRelationRequest) GetType() (v RelationType) {
+	if !p.IsSetType() {
+		return UpdateRelationRequest_Type_DEFAULT
+	}
+	return *p.Type
+}
+
+var UpdateRelationRequest_Label_DEFAULT string
+
+func (p *UpdateRelationRequest) GetLabel() (v string) {
+	if !p.IsSetLabel() {
+		return UpdateRelationRequest_Label_DEFAULT
+	}
+	return *p.Label
+}
+
+var UpdateRelationRequest_Properties_DEFAULT map[string]string
+
+func (p *UpdateRelationRequest) GetProperties() (v map[string]string) {
+	if !p.IsSetProperties() {
+		return UpdateRelationRequest_Properties_DEFAULT
+	}
+	return p.Properties
+}
+
+var UpdateRelationRequest_TypedProperties_DEFAULT map[string]*PropertyValue
+
+func (p *UpdateRelationRequest) GetTypedProperties() (v map[string]*PropertyValue) {
+	if !p.IsSetTypedProperties() {
+		return UpdateRelationRequest_TypedProperties_DEFAULT
+	}
+	return p.TypedProperties
+}
+
+var fieldIDToName_UpdateRelationRequest = map[int16]string{
+	1: "id",
+	2: "type",
+	3: "label",
+	4: "properties",
+	5: "typed_properties",
+}
+
+func (p *UpdateRelationRequest) IsSetType() bool {
+	return p.Type != nil
+}
+
+func (p *UpdateRelationRequest) IsSetLabel() bool {
+	return p.Label != nil
+}
+
+func (p *UpdateRelationRequest) IsSetProperties() bool {
+	return p.Properties != nil
+}
+
+func (p *UpdateRelationRequest) IsSetTypedProperties() bool {
+	return p.TypedProperties != nil
+}
+
+func (p *UpdateRelationRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateRelationRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UpdateRelationRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *UpdateRelationRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *RelationType
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := RelationType(v)
+		_field = &tmp
+	}
+	p.Type = _field
+	return nil
+}
+func (p *UpdateRelationRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Label = _field
+	return nil
+}
+func (p *UpdateRelationRequest) ReadField4(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Properties = _field
+	return nil
+}
+func (p *UpdateRelationRequest) ReadField5(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]*PropertyValue, size)
+	values := make([]PropertyValue, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		_val := &values[i]
+		_val.InitDefault()
+		if err := _val.Read(iprot); err != nil {
+			return err
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.TypedProperties = _field
+	return nil
+}
+
+func (p *UpdateRelationRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateRelationRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateRelationRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *UpdateRelationRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetType() {
+		if err = oprot.WriteFieldBegin("type", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.Type)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *UpdateRelationRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabel() {
+		if err = oprot.WriteFieldBegin("label", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Label); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *UpdateRelationRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetProperties() {
+		if err = oprot.WriteFieldBegin("properties", thrift.MAP, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Properties)); err != nil {
+			return err
+		}
+		for k, v := range p.Properties {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *UpdateRelationRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTypedProperties() {
+		if err = oprot.WriteFieldBegin("typed_properties", thrift.MAP, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRUCT, len(p.TypedProperties)); err != nil {
+			return err
+		}
+		for k, v := range p.TypedProperties {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *UpdateRelationRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateRelationRequest(%+v)", *p)
+
+}
+
+// 更新关系响应
+type UpdateRelationResponse struct {
+	Success  bool      `thrift:"success,1" form:"success" json:"success" query:"success"`
+	Message  string    `thrift:"message,2" form:"message" json:"message" query:"message"`
+	Relation *Relation `thrift:"relation,3" form:"relation" json:"relation" query:"relation"`
+	// 带类型的属性值无效时的逐项错误
+	FieldErrors []*FieldError `thrift:"field_errors,4,optional" form:"field_errors" json:"field_errors,omitempty" query:"field_errors"`
+}
+
+func NewUpdateRelationResponse() *UpdateRelationResponse {
+	return &UpdateRelationResponse{}
+}
+
+func (p *UpdateRelationResponse) InitDefault() {
+}
+
+func (p *UpdateRelationResponse) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *UpdateRelationResponse) GetMessage() (v string) {
+	return p.Message
+}
+
+var UpdateRelationResponse_Relation_DEFAULT *Relation
+
+func (p *UpdateRelationResponse) GetRelation() (v *Relation) {
+	if !p.IsSetRelation() {
+		return UpdateRelationResponse_Relation_DEFAULT
+	}
+	return p.Relation
+}
+
+var UpdateRelationResponse_FieldErrors_DEFAULT []*FieldError
+
+func (p *UpdateRelationResponse) GetFieldErrors() (v []*FieldError) {
+	if !p.IsSetFieldErrors() {
+		return UpdateRelationResponse_FieldErrors_DEFAULT
+	}
+	return p.FieldErrors
+}
+
+var fieldIDToName_UpdateRelationResponse = map[int16]string{
+	1: "success",
+	2: "message",
+	3: "relation",
+	4: "field_errors",
+}
+
+func (p *UpdateRelationResponse) IsSetRelation() bool {
+	return p.Relation != nil
+}
+
+func (p *UpdateRelationResponse) IsSetFieldErrors() bool {
+	return p.FieldErrors != nil
+}
+
+func (p *UpdateRelationResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateRelationResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UpdateRelationResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Success = _field
+	return nil
+}
+func (p *UpdateRelationResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Message = _field
+	return nil
+}
+func (p *UpdateRelationResponse) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewRelation()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Relation = _field
+	return nil
+}
+func (p *UpdateRelationResponse) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*FieldError, 0, size)
+	values := make([]FieldError, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.FieldErrors = _field
+	return nil
+}
+
+func (p *UpdateRelationResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateRelationResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateRelationResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *UpdateRelationResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *UpdateRelationResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("relation", thrift.STRUCT, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Relation.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *UpdateRelationResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFieldErrors() {
+		if err = oprot.WriteFieldBegin("field_errors", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.FieldErrors)); err != nil {
+			return err
+		}
+		for _, v := range p.FieldErrors {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *UpdateRelationResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateRelationResponse(%+v)", *p)
+
+}
+
+// 获取关系请求
+type GetRelationRequest struct {
+	ID string `thrift:"id,1" form:"id" json:"id" query:"id"`
+}
+
+func NewGetRelationRequest() *GetRelationRequest {
+	return &GetRelationRequest{}
+}
+
+func (p *GetRelationRequest) InitDefault() {
+}
+
+func (p *GetRelationRequest) GetID() (v string) {
+	return p.ID
+}
+
+var fieldIDToName_GetRelationRequest = map[int16]string{
+	1: "id",
+}
+
+func (p *GetRelationRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetRelationRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetRelationRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+
+func (p *GetRelationRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetRelationRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetRelationRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetRelationRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetRelationRequest(%+v)", *p)
+
+}
+
+// 获取关系响应
+type GetRelationResponse struct {
+	Success  bool      `thrift:"success,1" form:"success" json:"success" query:"success"`
+	Message  string    `thrift:"message,2" form:"message" json:"message" query:"message"`
+	Relation *Relation `thrift:"relation,3" form:"relation" json:"relation" query:"relation"`
+}
+
+func NewGetRelationResponse() *GetRelationResponse {
+	return &GetRelationResponse{}
+}
+
+func (p *GetRelationResponse) InitDefault() {
+}
+
+func (p *GetRelationResponse) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *GetRelationResponse) GetMessage() (v string) {
+	return p.Message
+}
+
+var GetRelationResponse_Relation_DEFAULT *Relation
+
+func (p *GetRelationResponse) GetRelation() (v *Relation) {
+	if !p.IsSetRelation() {
+		return GetRelationResponse_Relation_DEFAULT
+	}
+	return p.Relation
+}
+
+var fieldIDToName_GetRelationResponse = map[int16]string{
+	1: "success",
+	2: "message",
+	3: "relation",
+}
+
+func (p *GetRelationResponse) IsSetRelation() bool {
+	return p.Relation != nil
+}
+
+func (p *GetRelationResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetRelationResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetRelationResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Success = _field
+	return nil
+}
+func (p *GetRelationResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Message = _field
+	return nil
+}
+func (p *GetRelationResponse) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewRelation()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Relation = _field
+	return nil
+}
+
+func (p *GetRelationResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetRelationResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetRelationResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *GetRelationResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *GetRelationResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("relation", thrift.STRUCT, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Relation.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *GetRelationResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetRelationResponse(%+v)", *p)
+
+}
+
+// 删除关系请求
+type DeleteRelationRequest struct {
+	ID string `thrift:"id,1" form:"id" json:"id" query:"id"`
+}
+
+func NewDeleteRelationRequest() *DeleteRelationRequest {
+	return &DeleteRelationRequest{}
+}
+
+func (p *DeleteRelationRequest) InitDefault() {
+}
+
+func (p *DeleteRelationRequest) GetID() (v string) {
+	return p.ID
+}
+
+var fieldIDToName_DeleteRelationRequest = map[int16]string{
+	1: "id",
+}
+
+func (p *DeleteRelationRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteRelationRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DeleteRelationRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+
+func (p *DeleteRelationRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteRelationRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeleteRelationRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DeleteRelationRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteRelationRequest(%+v)", *p)
+
+}
+
+// 删除关系响应
+type DeleteRelationResponse struct {
+	Success bool   `thrift:"success,1" form:"success" json:"success" query:"success"`
+	Message string `thrift:"message,2" form:"message" json:"message" query:"message"`
+}
+
+func NewDeleteRelationResponse() *DeleteRelationResponse {
+	return &DeleteRelationResponse{}
+}
+
+func (p *DeleteRelationResponse) InitDefault() {
+}
+
+func (p *DeleteRelationResponse) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *DeleteRelationResponse) GetMessage() (v string) {
+	return p.Message
+}
+
+var fieldIDToName_DeleteRelationResponse = map[int16]string{
+	1: "success",
+	2: "message",
+}
+
+func (p *DeleteRelationResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteRelationResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DeleteRelationResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Success = _field
+	return nil
+}
+func (p *DeleteRelationResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Message = _field
+	return nil
+}
+
+func (p *DeleteRelationResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteRelationResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeleteRelationResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *DeleteRelationResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *DeleteRelationResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteRelationResponse(%+v)", *p)
+
+}
+
+// 获取节点关系请求
+type GetNodeRelationsRequest struct {
+	// 节点ID
+	NodeID string `thrift:"node_id,1" form:"node_id" json:"node_id" query:"node_id"`
+	// 关系类型筛选(可选)
+	Types []RelationType `thrift:"types,2,optional" form:"types" json:"types,omitempty" query:"types"`
+	// 是否包含出去的关系，默认true
+	Outgoing *bool `thrift:"outgoing,3,optional" form:"outgoing" json:"outgoing,omitempty" query:"outgoing"`
+	// 是否包含进来的关系，默认true
+	Incoming *bool `thrift:"incoming,4,optional" form:"incoming" json:"incoming,omitempty" query:"incoming"`
+	// 限制返回数量
+	Limit *int32 `thrift:"limit,5,optional" form:"limit" json:"limit,omitempty" query:"limit"`
+	// 偏移量，用于分页
+	Offset *int32 `thrift:"offset,6,optional" form:"offset" json:"offset,omitempty" query:"offset"`
+	// 游标，取自上一页响应的 next_cursor；设置后忽略 offset
+	Cursor *string `thrift:"cursor,7,optional" form:"cursor" json:"cursor,omitempty" query:"cursor"`
+	// 排序键，按顺序比较，未设置时按 id 升序 (GET 请求中以 JSON 字符串传入)
+	Sort []*SortField `thrift:"sort,8,optional" form:"sort" json:"sort,omitempty" query:"sort"`
+}
+
+func NewGetNodeRelationsRequest() *GetNodeRelationsRequest {
+	return &GetNodeRelationsRequest{}
+}
+
+func (p *GetNodeRelationsRequest) InitDefault() {
+}
+
+func (p *GetNodeRelationsRequest) GetNodeID() (v string) {
+	return p.NodeID
+}
+
+var GetNodeRelationsRequest_Types_DEFAULT []RelationType
+
+func (p *GetNodeRelationsRequest) GetTypes() (v []RelationType) {
+	if !p.IsSetTypes() {
+		return GetNodeRelationsRequest_Types_DEFAULT
+	}
+	return p.Types
+}
+
+var GetNodeRelationsRequest_Outgoing_DEFAULT bool
+
+func (p *GetNodeRelationsRequest) GetOutgoing() (v bool) {
+	if !p.IsSetOutgoing() {
+		return GetNodeRelationsRequest_Outgoing_DEFAULT
+	}
+	return *p.Outgoing
+}
+
+var GetNodeRelationsRequest_Incoming_DEFAULT bool
+
+func (p *GetNodeRelationsRequest) GetIncoming() (v bool) {
+	if !p.IsSetIncoming() {
+		return GetNodeRelationsRequest_Incoming_DEFAULT
+	}
+	return *p.Incoming
+}
+
+var GetNodeRelationsRequest_Limit_DEFAULT int32
+
+func (p *GetNodeRelationsRequest) GetLimit() (v int32) {
+	if !p.IsSetLimit() {
+		return GetNodeRelationsRequest_Limit_DEFAULT
+	}
+	return *p.Limit
+}
+
+var GetNodeRelationsRequest_Offset_DEFAULT int32
+
+func (p *GetNodeRelationsRequest) GetOffset() (v int32) {
+	if !p.IsSetOffset() {
+		return GetNodeRelationsRequest_Offset_DEFAULT
+	}
+	return *p.Offset
+}
+
+var GetNodeRelationsRequest_Cursor_DEFAULT string
+
+func (p *GetNodeRelationsRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return GetNodeRelationsRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+var GetNodeRelationsRequest_Sort_DEFAULT []*SortField
+
+func (p *GetNodeRelationsRequest) GetSort() (v []*SortField) {
+	if !p.IsSetSort() {
+		return GetNodeRelationsRequest_Sort_DEFAULT
+	}
+	return p.Sort
+}
+
+var fieldIDToName_GetNodeRelationsRequest = map[int16]string{
+	1: "node_id",
+	2: "types",
+	3: "outgoing",
+	4: "incoming",
+	5: "limit",
+	6: "offset",
+	7: "cursor",
+	8: "sort",
+}
+
+func (p *GetNodeRelationsRequest) IsSetTypes() bool {
+	return p.Types != nil
+}
+
+func (p *GetNodeRelationsRequest) IsSetOutgoing() bool {
+	return p.Outgoing != nil
+}
+
+func (p *GetNodeRelationsRequest) IsSetIncoming() bool {
+	return p.Incoming != nil
+}
+
+func (p *GetNodeRelationsRequest) IsSetLimit() bool {
+	return p.Limit != nil
+}
+
+func (p *GetNodeRelationsRequest) IsSetOffset() bool {
+	return p.Offset != nil
+}
+
+func (p *GetNodeRelationsRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *GetNodeRelationsRequest) IsSetSort() bool {
+	return p.Sort != nil
+}
+
+func (p *GetNodeRelationsRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetNodeRelationsRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetNodeRelationsRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.NodeID = _field
+	return nil
+}
+func (p *GetNodeRelationsRequest) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]RelationType, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem RelationType
+		if v, err := iprot.ReadI32(); err != nil {
+			return err
+		} else {
+			_elem = RelationType(v)
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Types = _field
+	return nil
+}
+func (p *GetNodeRelationsRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Outgoing = _field
+	return nil
+}
+func (p *GetNodeRelationsRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Incoming = _field
+	return nil
+}
+func (p *GetNodeRelationsRequest) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Limit = _field
+	return nil
+}
+func (p *GetNodeRelationsRequest) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Offset = _field
+	return nil
+}
+func (p *GetNodeRelationsRequest) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Cursor = _field
+	return nil
+}
+func (p *GetNodeRelationsRequest) ReadField8(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*SortField, 0, size)
+	values := make([]SortField, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Sort = _field
+	return nil
+}
+
+func (p *GetNodeRelationsRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetNodeRelationsRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetNodeRelationsRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("node_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.NodeID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *GetNodeRelationsRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTypes() {
+		if err = oprot.WriteFieldBegin("types", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.I32, len(p.Types)); err != nil {
+			return err
+		}
+		for _, v := range p.Types {
+			if err := oprot.WriteI32(int32(v)); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *GetNodeRelationsRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOutgoing() {
+		if err = oprot.WriteFieldBegin("outgoing", thrift.BOOL, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Outgoing); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *GetNodeRelationsRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIncoming() {
+		if err = oprot.WriteFieldBegin("incoming", thrift.BOOL, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Incoming); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *GetNodeRelationsRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLimit() {
+		if err = oprot.WriteFieldBegin("limit", thrift.I32, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Limit); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *GetNodeRelationsRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOffset() {
+		if err = oprot.WriteFieldBegin("offset", thrift.I32, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Offset); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *GetNodeRelationsRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCursor() {
+		if err = oprot.WriteFieldBegin("cursor", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Cursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *GetNodeRelationsRequest) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSort() {
+		if err = oprot.WriteFieldBegin("sort", thrift.LIST, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Sort)); err != nil {
+			return err
+		}
+		for _, v := range p.Sort {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *GetNodeRelationsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetNodeRelationsRequest(%+v)", *p)
+
+}
+
+// 获取节点关系响应
+type GetNodeRelationsResponse struct {
+	Success   bool        `thrift:"success,1" form:"success" json:"success" query:"success"`
+	Message   string      `thrift:"message,2" form:"message" json:"message" query:"message"`
+	Relations []*Relation `thrift:"relations,3" form:"relations" json:"relations" query:"relations"`
+	// 总关系数
+	Total int32 `thrift:"total,4" form:"total" json:"total" query:"total"`
+	// 下一页游标，为空表示没有更多结果
+	NextCursor *string `thrift:"next_cursor,5,optional" form:"next_cursor" json:"next_cursor,omitempty" query:"next_cursor"`
+}
+
+func NewGetNodeRelationsResponse() *GetNodeRelationsResponse {
+	return &GetNodeRelationsResponse{}
+}
+
+func (p *GetNodeRelationsResponse) InitDefault() {
+}
+
+func (p *GetNodeRelationsResponse) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *GetNodeRelationsResponse) GetMessage() (v string) {
+	return p.Message
+}
+
+func (p *GetNodeRelationsResponse) GetRelations() (v []*Relation) {
+	return p.Relations
+}
+
+func (p *GetNodeRelationsResponse) GetTotal() (v int32) {
+	return p.Total
+}
+
+var GetNodeRelationsResponse_NextCursor_DEFAULT string
+
+func (p *GetNodeRelationsResponse) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return GetNodeRelationsResponse_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+var fieldIDToName_GetNodeRelationsResponse = map[int16]string{
+	1: "success",
+	2: "message",
+	3: "relations",
+	4: "total",
+	5: "next_cursor",
+}
+
+func (p *GetNodeRelationsResponse) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
+func (p *GetNodeRelationsResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetNodeRelationsResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetNodeRelationsResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Success = _field
+	return nil
+}
+func (p *GetNodeRelationsResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Message = _field
+	return nil
+}
+func (p *GetNodeRelationsResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Relation, 0, size)
+	values := make([]Relation, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Relations = _field
+	return nil
+}
+func (p *GetNodeRelationsResponse) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Total = _field
+	return nil
+}
+func (p *GetNodeRelationsResponse) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.NextCursor = _field
+	return nil
+}
+
+func (p *GetNodeRelationsResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetNodeRelationsResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetNodeRelationsResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *GetNodeRelationsResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *GetNodeRelationsResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("relations", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Relations)); err != nil {
+		return err
+	}
+	for _, v := range p.Relations {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *GetNodeRelationsResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *GetNodeRelationsResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNextCursor() {
+		if err = oprot.WriteFieldBegin("next_cursor", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.NextCursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *GetNodeRelationsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetNodeRelationsResponse(%+v)", *p)
+
+}
+
+// 可能认识的人推荐请求
+type GetRecommendationsRequest struct {
+	// 节点ID (PERSON)
+	NodeID string `thrift:"node_id,1" form:"node_id" json:"node_id" query:"node_id"`
+	// 返回数量，默认 10，最大 50
+	Limit *int32 `thrift:"limit,2,optional" form:"limit" json:"limit,omitempty" query:"limit"`
+}
+
+func NewGetRecommendationsRequest() *GetRecommendationsRequest {
+	return &GetRecommendationsRequest{}
+}
+
+func (p *GetRecommendationsRequest) InitDefault() {
+}
+
+func (p *GetRecommendationsRequest) GetNodeID() (v string) {
+	return p.NodeID
+}
+
+var GetRecommendationsRequest_Limit_DEFAULT int32
+
+func (p *GetRecommendationsRequest) GetLimit() (v int32) {
+	if !p.IsSetLimit() {
+		return GetRecommendationsRequest_Limit_DEFAULT
+	}
+	return *p.Limit
+}
+
+var fieldIDToName_GetRecommendationsRequest = map[int16]string{
+	1: "node_id",
+	2: "limit",
+}
+
+func (p *GetRecommendationsRequest) IsSetLimit() bool {
+	return p.Limit != nil
+}
+
+func (p *GetRecommendationsRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetRecommendationsRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetRecommendationsRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.NodeID = _field
+	return nil
+}
+func (p *GetRecommendationsRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Limit = _field
+	return nil
+}
+
+func (p *GetRecommendationsRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetRecommendationsRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetRecommendationsRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("node_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.NodeID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *GetRecommendationsRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLimit() {
+		if err = oprot.WriteFieldBegin("limit", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Limit); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetRecommendationsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetRecommendationsRequest(%+v)", *p)
+
+}
+
+// 推荐项
+type Recommendation struct {
+	// 推荐的人
+	Node *Node `thrift:"node,1" form:"node" json:"node" query:"node"`
+	// 综合得分，越大越可能认识
+	Score float64 `thrift:"score,2" form:"score" json:"score" query:"score"`
+	// 共同联系人数
+	MutualCount int32 `thrift:"mutual_count,3" form:"mutual_count" json:"mutual_count" query:"mutual_count"`
+	// 共同联系人 (推荐理由)
+	MutualNodes []*Node `thrift:"mutual_nodes,4" form:"mutual_nodes" json:"mutual_nodes" query:"mutual_nodes"`
+	// 共同所属的公司或学校 (推荐理由)
+	SharedOrganizations []*Node `thrift:"shared_organizations,5" form:"shared_organizations" json:"shared_organizations" query:"shared_organizations"`
+	// Adamic-Adar 指数
+	AdamicAdar float64 `thrift:"adamic_adar,6" form:"adamic_adar" json:"adamic_adar" query:"adamic_adar"`
+	// 联系人集合的 Jaccard 相似度
+	Jaccard float64 `thrift:"jaccard,7" form:"jaccard" json:"jaccard" query:"jaccard"`
+}
+
+func NewRecommendation() *Recommendation {
+	return &Recommendation{}
+}
+
+func (p *Recommendation) InitDefault() {
+}
+
+var Recommendation_Node_DEFAULT *Node
+
+func (p *Recommendation) GetNode() (v *Node) {
+	if !p.IsSetNode() {
+		return Recommendation_Node_DEFAULT
+	}
+	return p.Node
+}
+
+func (p *Recommendation) GetScore() (v float64) {
+	return p.Score
+}
+
+func (p *Recommendation) GetMutualCount() (v int32) {
+	return p.MutualCount
+}
+
+func (p *Recommendation) GetMutualNodes() (v []*Node) {
+	return p.MutualNodes
+}
+
+func (p *Recommendation) GetSharedOrganizations() (v []*Node) {
+	return p.SharedOrganizations
+}
+
+func (p *Recommendation) GetAdamicAdar() (v float64) {
+	return p.AdamicAdar
+}
+
+func (p *Recommendation) GetJaccard() (v float64) {
+	return p.Jaccard
+}
+
+var fieldIDToName_Recommendation = map[int16]string{
+	1: "node",
+	2: "score",
+	3: "mutual_count",
+	4: "mutual_nodes",
+	5: "shared_organizations",
+	6: "adamic_adar",
+	7: "jaccard",
+}
+
+func (p *Recommendation) IsSetNode() bool {
+	return p.Node != nil
+}
+
+func (p *Recommendation) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Recommendation[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *Recommendation) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewNode()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Node = _field
+	return nil
+}
+func (p *Recommendation) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Score = _field
+	return nil
+}
+func (p *Recommendation) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.MutualCount = _field
+	return nil
+}
+func (p *Recommendation) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Node, 0, size)
+	values := make([]Node, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.MutualNodes = _field
+	return nil
+}
+func (p *Recommendation) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Node, 0, size)
+	values := make([]Node, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.SharedOrganizations = _field
+	return nil
+}
+func (p *Recommendation) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AdamicAdar = _field
+	return nil
+}
+func (p *Recommendation) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Jaccard = _field
+	return nil
+}
+
+func (p *Recommendation) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Recommendation"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *Recommendation) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("node", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Node.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *Recommendation) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("score", thrift.DOUBLE, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.Score); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *Recommendation) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("mutual_count", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.MutualCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *Recommendation) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("mutual_nodes", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.MutualNodes)); err != nil {
+		return err
+	}
+	for _, v := range p.MutualNodes {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *Recommendation) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("shared_organizations", thrift.LIST, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.SharedOrganizations)); err != nil {
+		return err
+	}
+	for _, v := range p.SharedOrganizations {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *Recommendation) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("adamic_adar", thrift.DOUBLE, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.AdamicAdar); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *Recommendation) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("jaccard", thrift.DOUBLE, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.Jaccard); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *Recommendation) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Recommendation(%+v)", *p)
+
+}
+
+// 可能认识的人推荐响应
+type GetRecommendationsResponse struct {
+	Success bool   `thrift:"success,1" form:"success" json:"success" query:"success"`
+	Message string `thrift:"message,2" form:"message" json:"message" query:"message"`
+	// 按得分降序
+	Recommendations []*Recommendation `thrift:"recommendations,3" form:"recommendations" json:"recommendations" query:"recommendations"`
+}
+
+func NewGetRecommendationsResponse() *GetRecommendationsResponse {
+	return &GetRecommendationsResponse{}
+}
+
+func (p *GetRecommendationsResponse) InitDefault() {
+}
+
+func (p *GetRecommendationsResponse) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *GetRecommendationsResponse) GetMessage() (v string) {
+	return p.Message
+}
+
+func (p *GetRecommendationsResponse) GetRecommendations() (v []*Recommendation) {
+	return p.Recommendations
+}
+
+var fieldIDToName_GetRecommendationsResponse = map[int16]string{
+	1: "success",
+	2: "message",
+	3: "recommendations",
+}
+
+func (p *GetRecommendationsResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetRecommendationsResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetRecommendationsResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Success = _field
+	return nil
+}
+func (p *GetRecommendationsResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Message = _field
+	return nil
+}
+func (p *GetRecommendationsResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Recommendation, 0, size)
+	values := make([]Recommendation, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Recommendations = _field
+	return nil
+}
+
+func (p *GetRecommendationsResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetRecommendationsResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetRecommendationsResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *GetRecommendationsResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *GetRecommendationsResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("recommendations", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Recommendations)); err != nil {
+		return err
+	}
+	for _, v := range p.Recommendations {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *GetRecommendationsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetRecommendationsResponse(%+v)", *p)
+
+}
+
+// 按关系类型覆盖遍历方向
+type RelationDirection struct {
+	Type      RelationType `thrift:"type,1" form:"type" json:"type" query:"type"`
+	Direction Direction    `thrift:"direction,2" form:"direction" json:"direction" query:"direction"`
+}
+
+func NewRelationDirection() *RelationDirection {
+	return &RelationDirection{}
+}
+
+func (p *RelationDirection) InitDefault() {
+}
+
+func (p *RelationDirection) GetType() (v RelationType) {
+	return p.Type
+}
+
+func (p *RelationDirection) GetDirection() (v Direction) {
+	return p.Direction
+}
+
+var fieldIDToName_RelationDirection = map[int16]string{
+	1: "type",
+	2: "direction",
+}
+
+func (p *RelationDirection) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationDirection[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RelationDirection) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field RelationType
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = RelationType(v)
+	}
+	p.Type = _field
+	return nil
+}
+func (p *RelationDirection) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field Direction
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = Direction(v)
+	}
+	p.Direction = _field
+	return nil
+}
+
+func (p *RelationDirection) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RelationDirection"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RelationDirection) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("type", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(int32(p.Type)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *RelationDirection) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("direction", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(int32(p.Direction)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RelationDirection) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RelationDirection(%+v)", *p)
+
+}
+
+// 网络查询请求
+type GetNetworkRequest struct {
+	// (替换 profession) 用于查找起始节点的条件
+	StartNodeCriteria map[string]string `thrift:"startNodeCriteria,1,optional" form:"startNodeCriteria" json:"startNodeCriteria,omitempty" query:"startNodeCriteria"`
+	// 从起始节点扩展的深度
+	Depth int32 `thrift:"depth,2,optional" form:"depth" json:"depth,omitempty" query:"depth"`
+	// 要包含/遍历的关系类型过滤器
+	RelationTypes []RelationType `thrift:"relationTypes,3,optional" form:"relationTypes" json:"relationTypes,omitempty" query:"relationTypes"`
+	// 最终结果中要包含的节点类型过滤器
+	NodeTypes []NodeType `thrift:"nodeTypes,4,optional" form:"nodeTypes" json:"nodeTypes,omitempty" query:"nodeTypes"`
+	// 每页返回的节点数量 (按节点 id 排序分页)
+	Limit *int32 `thrift:"limit,5,optional" form:"limit" json:"limit,omitempty" query:"limit"`
+	// 节点偏移量，用于分页
+	Offset *int32 `thrift:"offset,6,optional" form:"offset" json:"offset,omitempty" query:"offset"`
+	// 返回节点数上限
+	MaxNodes *int32 `thrift:"max_nodes,7,optional" form:"max_nodes" json:"max_nodes,omitempty" query:"max_nodes"`
+	// 返回关系数上限
+	MaxRelations *int32 `thrift:"max_relations,8,optional" form:"max_relations" json:"max_relations,omitempty" query:"max_relations"`
+	// 遍历的关系方向 (从起始节点向外)，默认 BOTH
+	Direction *Direction `thrift:"direction,9,optional" form:"direction" json:"direction,omitempty" query:"direction"`
+	// 按关系类型覆盖 direction
+	RelationDirections []*RelationDirection `thrift:"relation_directions,10,optional" form:"relation_directions" json:"relation_directions,omitempty" query:"relation_directions"`
+}
+
+func NewGetNetworkRequest() *GetNetworkRequest {
+	return &GetNetworkRequest{
+
+		Depth: 1,
+	}
+}
+
+func (p *GetNetworkRequest) InitDefault() {
+	p.Depth = 1
+}
+
+var GetNetworkRequest_StartNodeCriteria_DEFAULT map[string]string
+
+func (p *GetNetworkRequest) GetStartNodeCriteria() (v map[string]string) {
+	if !p.IsSetStartNodeCriteria() {
+		return GetNetworkRequest_StartNodeCriteria_DEFAULT
+	}
+	return p.StartNodeCriteria
+}
+
+var GetNetworkRequest_Depth_DEFAULT int32 = 1
+
+func (p *GetNetworkRequest) GetDepth() (v int32) {
+	if !p.IsSetDepth() {
+		return GetNetworkRequest_Depth_DEFAULT
+	}
+	return p.Depth
+}
+
+var GetNetworkRequest_RelationTypes_DEFAULT []RelationType
+
+func (p *GetNetworkRequest) GetRelationTypes() (v []RelationType) {
+	if !p.IsSetRelationTypes() {
+		return GetNetworkRequest_RelationTypes_DEFAULT
+	}
+	return p.RelationTypes
+}
+
+var GetNetworkRequest_NodeTypes_DEFAULT []NodeType
+
+func (p *GetNetworkRequest) GetNodeTypes() (v []NodeType) {
+	if !p.IsSetNodeTypes() {
+		return GetNetworkRequest_NodeTypes_DEFAULT
+	}
+	return p.NodeTypes
+}
+
+var GetNetworkRequest_Limit_DEFAULT int32
+
+func (p *GetNetworkRequest) GetLimit() (v int32) {
+	if !p.IsSetLimit() {
+		return GetNetworkRequest_Limit_DEFAULT
+	}
+	return *p.Limit
+}
+
+var GetNetworkRequest_Offset_DEFAULT int32
+
+func (p *GetNetworkRequest) GetOffset() (v int32) {
+	if !p.IsSetOffset() {
+		return GetNetworkRequest_Offset_DEFAULT
+	}
+	return *p.Offset
+}
+
+var GetNetworkRequest_MaxNodes_DEFAULT int32
+
+func (p *GetNetworkRequest) GetMaxNodes() (v int32) {
+	if !p.IsSetMaxNodes() {
+		return GetNetworkRequest_MaxNodes_DEFAULT
+	}
+	return *p.MaxNodes
+}
+
+var GetNetworkRequest_MaxRelations_DEFAULT int32
+
+func (p *GetNetworkRequest) GetMaxRelations() (v int32) {
+	if !p.IsSetMaxRelations() {
+		return GetNetworkRequest_MaxRelations_DEFAULT
+	}
+	return *p.MaxRelations
+}
+
+var GetNetworkRequest_Direction_DEFAULT Direction
+
+func (p *GetNetworkRequest) GetDirection() (v Direction) {
+	if !p.IsSetDirection() {
+		return GetNetworkRequest_Direction_DEFAULT
+	}
+	return *p.Direction
+}
+
+var GetNetworkRequest_RelationDirections_DEFAULT []*RelationDirection
+
+func (p *GetNetworkRequest) GetRelationDirections() (v []*RelationDirection) {
+	if !p.IsSetRelationDirections() {
+		return GetNetworkRequest_RelationDirections_DEFAULT
+	}
+	return p.RelationDirections
+}
+
+var fieldIDToName_GetNetworkRequest = map[int16]string{
+	1:  "startNodeCriteria",
+	2:  "depth",
+	3:  "relationTypes",
+	4:  "nodeTypes",
+	5:  "limit",
+	6:  "offset",
+	7:  "max_nodes",
+	8:  "max_relations",
+	9:  "direction",
+	10: "relation_directions",
+}
+
+func (p *GetNetworkRequest) IsSetStartNodeCriteria() bool {
+	return p.StartNodeCriteria != nil
+}
+
+func (p *GetNetworkRequest) IsSetDepth() bool {
+	return p.Depth != GetNetworkRequest_Depth_DEFAULT
+}
+
+func (p *GetNetworkRequest) IsSetRelationTypes() bool {
+	return p.RelationTypes != nil
+}
+
+func (p *GetNetworkRequest) IsSetNodeTypes() bool {
+	return p.NodeTypes != nil
+}
+
+func (p *GetNetworkRequest) IsSetLimit() bool {
+	return p.Limit != nil
+}
+
+func (p *GetNetworkRequest) IsSetOffset() bool {
+	return p.Offset != nil
+}
+
+func (p *GetNetworkRequest) IsSetMaxNodes() bool {
+	return p.MaxNodes != nil
+}
+
+func (p *GetNetworkRequest) IsSetMaxRelations() bool {
+	return p.MaxRelations != nil
+}
+
+func (p *GetNetworkRequest) IsSetDirection() bool {
+	return p.Direction != nil
+}
+
+func (p *GetNetworkRequest) IsSetRelationDirections() bool {
+	return p.RelationDirections != nil
+}
+
+func (p *GetNetworkRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetNetworkRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetNetworkRequest) ReadField1(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.StartNodeCriteria = _field
+	return nil
+}
+func (p *GetNetworkRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Depth = _field
+	return nil
+}
+func (p *GetNetworkRequest) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]RelationType, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem RelationType
+		if v, err := iprot.ReadI32(); err != nil {
+			return err
+		} else {
+			_elem = RelationType(v)
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.RelationTypes = _field
+	return nil
+}
+func (p *GetNetworkRequest) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]NodeType, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem NodeType
+		if v, err := iprot.ReadI32(); err != nil {
+			return err
+		} else {
+			_elem = NodeType(v)
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.NodeTypes = _field
+	return nil
+}
+func (p *GetNetworkRequest) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Limit = _field
+	return nil
+}
+func (p *GetNetworkRequest) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Offset = _field
+	return nil
+}
+func (p *GetNetworkRequest) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MaxNodes = _field
+	return nil
+}
+func (p *GetNetworkRequest) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MaxRelations = _field
+	return nil
+}
+
+func (p *GetNetworkRequest) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *Direction
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := Direction(v)
+		_field = &tmp
+	}
+	p.Direction = _field
+	return nil
+}
+func (p *GetNetworkRequest) ReadField10(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*RelationDirection, 0, size)
+	values := make([]RelationDirection, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.RelationDirections = _field
+	return nil
+}
+
+func (p *GetNetworkRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetNetworkRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetNetworkRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStartNodeCriteria() {
+		if err = oprot.WriteFieldBegin("startNodeCriteria", thrift.MAP, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.StartNodeCriteria)); err != nil {
+			return err
+		}
+		for k, v := range p.StartNodeCriteria {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *GetNetworkRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDepth() {
+		if err = oprot.WriteFieldBegin("depth", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(p.Depth); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *GetNetworkRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRelationTypes() {
+		if err = oprot.WriteFieldBegin("relationTypes", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.I32, len(p.RelationTypes)); err != nil {
+			return err
+		}
+		for _, v := range p.RelationTypes {
+			if err := oprot.WriteI32(int32(v)); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *GetNetworkRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNodeTypes() {
+		if err = oprot.WriteFieldBegin("nodeTypes", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.I32, len(p.NodeTypes)); err != nil {
+			return err
+		}
+		for _, v := range p.NodeTypes {
+			if err := oprot.WriteI32(int32(v)); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *GetNetworkRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLimit() {
+		if err = oprot.WriteFieldBegin("limit", thrift.I32, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Limit); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *GetNetworkRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOffset() {
+		if err = oprot.WriteFieldBegin("offset", thrift.I32, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Offset); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *GetNetworkRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxNodes() {
+		if err = oprot.WriteFieldBegin("max_nodes", thrift.I32, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.MaxNodes); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *GetNetworkRequest) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxRelations() {
+		if err = oprot.WriteFieldBegin("max_relations", thrift.I32, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.MaxRelations); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *GetNetworkRequest) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDirection() {
+		if err = oprot.WriteFieldBegin("direction", thrift.I32, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.Direction)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *GetNetworkRequest) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRelationDirections() {
+		if err = oprot.WriteFieldBegin("relation_directions", thrift.LIST, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.RelationDirections)); err != nil {
+			return err
+		}
+		for _, v := range p.RelationDirections {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *GetNetworkRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetNetworkRequest(%+v)", *p)
+
+}
+
+// 网络查询响应
+type GetNetworkResponse struct {
+	Success bool   `thrift:"success,1" form:"success" json:"success" query:"success"`
+	Message string `thrift:"message,2" form:"message" json:"message" query:"message"`
+	// 返回完整节点信息而非ID
+	Nodes     []*Node     `thrift:"nodes,3" form:"nodes" json:"nodes" query:"nodes"`
+	Relations []*Relation `thrift:"relations,4" form:"relations" json:"relations" query:"relations"`
+	// 图谱是否不完整 (还有更多节点或关系被上限截断)
+	Truncated bool `thrift:"truncated,5" form:"truncated" json:"truncated" query:"truncated"`
+}
+
+func NewGetNetworkResponse() *GetNetworkResponse {
+	return &GetNetworkResponse{}
+}
+
+func (p *GetNetworkResponse) InitDefault() {
+}
+
+func (p *GetNetworkResponse) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *GetNetworkResponse) GetMessage() (v string) {
+	return p.Message
+}
+
+func (p *GetNetworkResponse) GetNodes() (v []*Node) {
+	return p.Nodes
+}
+
+func (p *GetNetworkResponse) GetRelations() (v []*Relation) {
+	return p.Relations
+}
+
+func (p *GetNetworkResponse) GetTruncated() (v bool) {
+	return p.Truncated
+}
+
+var fieldIDToName_GetNetworkResponse = map[int16]string{
+	1: "success",
+	2: "message",
+	3: "nodes",
+	4: "relations",
+	5: "truncated",
+}
+
+func (p *GetNetworkResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetNetworkResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetNetworkResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Success = _field
+	return nil
+}
+func (p *GetNetworkResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Message = _field
+	return nil
+}
+func (p *GetNetworkResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Node, 0, size)
+	values := make([]Node, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Nodes = _field
+	return nil
+}
+func (p *GetNetworkResponse) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Relation, 0, size)
+	values := make([]Relation, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Relations = _field
+	return nil
+}
+func (p *GetNetworkResponse) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Truncated = _field
+	return nil
+}
+
+func (p *GetNetworkResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetNetworkResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetNetworkResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *GetNetworkResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *GetNetworkResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("nodes", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Nodes)); err != nil {
+		return err
+	}
+	for _, v := range p.Nodes {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *GetNetworkResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("relations", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Relations)); err != nil {
+		return err
+	}
+	for _, v := range p.Relations {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *GetNetworkResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("truncated", thrift.BOOL, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Truncated); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *GetNetworkResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetNetworkResponse(%+v)", *p)
+
+}
+
+// 图谱导出请求 (过滤条件与 GetNetworkRequest 相同)
+type ExportNetworkRequest struct {
+	// 用于查找起始节点的条件
+	StartNodeCriteria map[string]string `thrift:"startNodeCriteria,1,optional" form:"startNodeCriteria" json:"startNodeCriteria,omitempty" query:"startNodeCriteria"`
+	// 从起始节点扩展的深度
+	Depth int32 `thrift:"depth,2,optional" form:"depth" json:"depth,omitempty" query:"depth"`
+	// 要包含/遍历的关系类型过滤器
+	RelationTypes []RelationType `thrift:"relationTypes,3,optional" form:"relationTypes" json:"relationTypes,omitempty" query:"relationTypes"`
+	// 最终结果中要包含的节点类型过滤器
+	NodeTypes []NodeType `thrift:"nodeTypes,4,optional" form:"nodeTypes" json:"nodeTypes,omitempty" query:"nodeTypes"`
+	// 导出节点数上限
+	MaxNodes *int32 `thrift:"max_nodes,5,optional" form:"max_nodes" json:"max_nodes,omitempty" query:"max_nodes"`
+	// 导出关系数上限
+	MaxRelations *int32 `thrift:"max_relations,6,optional" form:"max_relations" json:"max_relations,omitempty" query:"max_relations"`
+	// 导出格式: graphml | gexf | jgf | cypher
+	Format string `thrift:"format,7,optional" form:"format" json:"format,omitempty" query:"format"`
+	// 遍历的关系方向 (从起始节点向外)，默认 BOTH
+	Direction *Direction `thrift:"direction,8,optional" form:"direction" json:"direction,omitempty" query:"direction"`
+	// 按关系类型覆盖 direction
+	RelationDirections []*RelationDirection `thrift:"relation_directions,9,optional" form:"relation_directions" json:"relation_directions,omitempty" query:"relation_directions"`
+}
+
+func NewExportNetworkRequest() *ExportNetworkRequest {
+	return &ExportNetworkRequest{
+
+		Depth:  1,
+		Format: "graphml",
+	}
+}
+
+func (p *ExportNetworkRequest) InitDefault() {
+	p.Depth = 1
+	p.Format = "graphml"
+}
+
+var ExportNetworkRequest_StartNodeCriteria_DEFAULT map[string]string
+
+func (p *ExportNetworkRequest) GetStartNodeCriteria() (v map[string]string) {
+	if !p.IsSetStartNodeCriteria() {
+		return ExportNetworkRequest_StartNodeCriteria_DEFAULT
+	}
+	return p.StartNodeCriteria
+}
+
+var ExportNetworkRequest_Depth_DEFAULT int32 = 1
+
+func (p *ExportNetworkRequest) GetDepth() (v int32) {
+	if !p.IsSetDepth() {
+		return ExportNetworkRequest_Depth_DEFAULT
+	}
+	return p.Depth
+}
+
+var ExportNetworkRequest_RelationTypes_DEFAULT []RelationType
+
+func (p *ExportNetworkRequest) GetRelationTypes() (v []RelationType) {
+	if !p.IsSetRelationTypes() {
+		return ExportNetworkRequest_RelationTypes_DEFAULT
+	}
+	return p.RelationTypes
+}
+
+var ExportNetworkRequest_NodeTypes_DEFAULT []NodeType
+
+func (p *ExportNetworkRequest) GetNodeTypes() (v []NodeType) {
+	if !p.IsSetNodeTypes() {
+		return ExportNetworkRequest_NodeTypes_DEFAULT
+	}
+	return p.NodeTypes
+}
+
+var ExportNetworkRequest_MaxNodes_DEFAULT int32
+
+func (p *ExportNetworkRequest) GetMaxNodes() (v int32) {
+	if !p.IsSetMaxNodes() {
+		return ExportNetworkRequest_MaxNodes_DEFAULT
+	}
+	return *p.MaxNodes
+}
+
+var ExportNetworkRequest_MaxRelations_DEFAULT int32
+
+func (p *ExportNetworkRequest) GetMaxRelations() (v int32) {
+	if !p.IsSetMaxRelations() {
+		return ExportNetworkRequest_MaxRelations_DEFAULT
+	}
+	return *p.MaxRelations
+}
+
+var ExportNetworkRequest_Format_DEFAULT string = "graphml"
+
+func (p *ExportNetworkRequest) GetFormat() (v string) {
+	if !p.IsSetFormat() {
+		return ExportNetworkRequest_Format_DEFAULT
+	}
+	return p.Format
+}
+
+var ExportNetworkRequest_Direction_DEFAULT Direction
+
+func (p *ExportNetworkRequest) GetDirection() (v Direction) {
+	if !p.IsSetDirection() {
+		return ExportNetworkRequest_Direction_DEFAULT
+	}
+	return *p.Direction
+}
+
+var ExportNetworkRequest_RelationDirections_DEFAULT []*RelationDirection
+
+func (p *ExportNetworkRequest) GetRelationDirections() (v []*RelationDirection) {
+	if !p.IsSetRelationDirections() {
+		return ExportNetworkRequest_RelationDirections_DEFAULT
+	}
+	return p.RelationDirections
+}
+
+var fieldIDToName_ExportNetworkRequest = map[int16]string{
+	1: "startNodeCriteria",
+	2: "depth",
+	3: "relationTypes",
+	4: "nodeTypes",
+	5: "max_nodes",
+	6: "max_relations",
+	7: "format",
+	8: "direction",
+	9: "relation_directions",
+}
+
+func (p *ExportNetworkRequest) IsSetStartNodeCriteria() bool {
+	return p.StartNodeCriteria != nil
+}
+
+func (p *ExportNetworkRequest) IsSetDepth() bool {
+	return p.Depth != ExportNetworkRequest_Depth_DEFAULT
+}
+
+func (p *ExportNetworkRequest) IsSetRelationTypes() bool {
+	return p.RelationTypes != nil
+}
+
+func (p *ExportNetworkRequest) IsSetNodeTypes() bool {
+	return p.NodeTypes != nil
+}
+
+func (p *ExportNetworkRequest) IsSetMaxNodes() bool {
+	return p.MaxNodes != nil
+}
+
+func (p *ExportNetworkRequest) IsSetMaxRelations() bool {
+	return p.MaxRelations != nil
+}
+
+func (p *ExportNetworkRequest) IsSetFormat() bool {
+	return p.Format != ExportNetworkRequest_Format_DEFAULT
+}
+
+func (p *ExportNetworkRequest) IsSetDirection() bool {
+	return p.Direction != nil
+}
+
+func (p *ExportNetworkRequest) IsSetRelationDirections() bool {
+	return p.RelationDirections != nil
+}
+
+func (p *ExportNetworkRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExportNetworkRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExportNetworkRequest) ReadField1(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.StartNodeCriteria = _field
+	return nil
+}
+func (p *ExportNetworkRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Depth = _field
+	return nil
+}
+func (p *ExportNetworkRequest) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]RelationType, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem RelationType
+		if v, err := iprot.ReadI32(); err != nil {
+			return err
+		} else {
+			_elem = RelationType(v)
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.RelationTypes = _field
+	return nil
+}
+func (p *ExportNetworkRequest) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]NodeType, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem NodeType
+		if v, err := iprot.ReadI32(); err != nil {
+			return err
+		} else {
+			_elem = NodeType(v)
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.NodeTypes = _field
+	return nil
+}
+func (p *ExportNetworkRequest) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MaxNodes = _field
+	return nil
+}
+func (p *ExportNetworkRequest) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MaxRelations = _field
+	return nil
+}
+func (p *ExportNetworkRequest) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Format = _field
+	return nil
+}
+
+func (p *ExportNetworkRequest) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *Direction
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := Direction(v)
+		_field = &tmp
+	}
+	p.Direction = _field
+	return nil
+}
+func (p *ExportNetworkRequest) ReadField9(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*RelationDirection, 0, size)
+	values := make([]RelationDirection, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.RelationDirections = _field
+	return nil
+}
+
+func (p *ExportNetworkRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExportNetworkRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExportNetworkRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStartNodeCriteria() {
+		if err = oprot.WriteFieldBegin("startNodeCriteria", thrift.MAP, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.StartNodeCriteria)); err != nil {
+			return err
+		}
+		for k, v := range p.StartNodeCriteria {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExportNetworkRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDepth() {
+		if err = oprot.WriteFieldBegin("depth", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(p.Depth); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExportNetworkRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRelationTypes() {
+		if err = oprot.WriteFieldBegin("relationTypes", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.I32, len(p.RelationTypes)); err != nil {
+			return err
+		}
+		for _, v := range p.RelationTypes {
+			if err := oprot.WriteI32(int32(v)); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExportNetworkRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNodeTypes() {
+		if err = oprot.WriteFieldBegin("nodeTypes", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.I32, len(p.NodeTypes)); err != nil {
+			return err
+		}
+		for _, v := range p.NodeTypes {
+			if err := oprot.WriteI32(int32(v)); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExportNetworkRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxNodes() {
+		if err = oprot.WriteFieldBegin("max_nodes", thrift.I32, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.MaxNodes); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ExportNetworkRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxRelations() {
+		if err = oprot.WriteFieldBegin("max_relations", thrift.I32, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.MaxRelations); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ExportNetworkRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFormat() {
+		if err = oprot.WriteFieldBegin("format", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(p.Format); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *ExportNetworkRequest) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDirection() {
+		if err = oprot.WriteFieldBegin("direction", thrift.I32, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.Direction)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *ExportNetworkRequest) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRelationDirections() {
+		if err = oprot.WriteFieldBegin("relation_directions", thrift.LIST, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.RelationDirections)); err != nil {
+			return err
+		}
+		for _, v := range p.RelationDirections {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *ExportNetworkRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExportNetworkRequest(%+v)", *p)
+
+}
+
+// 图谱导出结果 (Handler 按 format 编码后以文件流返回；仅出错时以 JSON 返回)
+type ExportNetworkResponse struct {
+	Success   bool        `thrift:"success,1" form:"success" json:"success" query:"success"`
+	Message   string      `thrift:"message,2" form:"message" json:"message" query:"message"`
+	Nodes     []*Node     `thrift:"nodes,3" form:"nodes" json:"nodes" query:"nodes"`
+	Relations []*Relation `thrift:"relations,4" form:"relations" json:"relations" query:"relations"`
+	// 是否因上限截断
+	Truncated bool `thrift:"truncated,5" form:"truncated" json:"truncated" query:"truncated"`
+}
+
+func NewExportNetworkResponse() *ExportNetworkResponse {
+	return &ExportNetworkResponse{}
+}
+
+func (p *ExportNetworkResponse) InitDefault() {
+}
+
+func (p *ExportNetworkResponse) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ExportNetworkResponse) GetMessage() (v string) {
+	return p.Message
+}
+
+func (p *ExportNetworkResponse) GetNodes() (v []*Node) {
+	return p.Nodes
+}
+
+func (p *ExportNetworkResponse) GetRelations() (v []*Relation) {
+	return p.Relations
+}
+
+func (p *ExportNetworkResponse) GetTruncated() (v bool) {
+	return p.Truncated
+}
+
+var fieldIDToName_ExportNetworkResponse = map[int16]string{
+	1: "success",
+	2: "message",
+	3: "nodes",
+	4: "relations",
+	5: "truncated",
+}
+
+func (p *ExportNetworkResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExportNetworkResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExportNetworkResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Success = _field
+	return nil
+}
+func (p *ExportNetworkResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Message = _field
+	return nil
+}
+func (p *ExportNetworkResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Node, 0, size)
+	values := make([]Node, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Nodes = _field
+	return nil
+}
+func (p *ExportNetworkResponse) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Relation, 0, size)
+	values := make([]Relation, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Relations = _field
+	return nil
+}
+func (p *ExportNetworkResponse) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Truncated = _field
+	return nil
+}
+
+func (p *ExportNetworkResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExportNetworkResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExportNetworkResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ExportNetworkResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ExportNetworkResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("nodes", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Nodes)); err != nil {
+		return err
+	}
+	for _, v := range p.Nodes {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ExportNetworkResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("relations", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Relations)); err != nil {
+		return err
+	}
+	for _, v := range p.Relations {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ExportNetworkResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("truncated", thrift.BOOL, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Truncated); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *ExportNetworkResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExportNetworkResponse(%+v)", *p)
+
+}
+
+// 路径查询请求
+type GetPathRequest struct {
+	// 起始节点ID
+	SourceID string `thrift:"source_id,1" form:"source_id" json:"source_id" query:"source_id"`
+	// 目标节点ID
+	TargetID string `thrift:"target_id,2" form:"target_id" json:"target_id" query:"target_id"`
+	// 最大查询深度
+	MaxDepth *int32 `thrift:"max_depth,3,optional" form:"max_depth" json:"max_depth,omitempty" query:"max_depth"`
+	// 关系类型筛选(可选)
+	Types []RelationType `thrift:"types,4,optional" form:"types" json:"types,omitempty" query:"types"`
+	// 查询模式，默认 SINGLE
+	Mode *PathMode `thrift:"mode,5,optional" form:"mode" json:"mode,omitempty" query:"mode"`
+	// K_SHORTEST 模式返回的路径数，默认 3
+	K *int32 `thrift:"k,6,optional" form:"k" json:"k,omitempty" query:"k"`
+	// WEIGHTED 模式作为代价的关系属性，默认 weight
+	WeightProperty *string `thrift:"weight_property,7,optional" form:"weight_property" json:"weight_property,omitempty" query:"weight_property"`
+	// 关系缺少该属性 (或不是非负数值) 时的代价，默认 1
+	DefaultWeight *float64 `thrift:"default_weight,8,optional" form:"default_weight" json:"default_weight,omitempty" query:"default_weight"`
+	// 关系方向 (从起点走向终点)，默认 BOTH
+	Direction *Direction `thrift:"direction,9,optional" form:"direction" json:"direction,omitempty" query:"direction"`
+	// 按关系类型覆盖 direction
+	RelationDirections []*RelationDirection `thrift:"relation_directions,10,optional" form:"relation_directions" json:"relation_directions,omitempty" query:"relation_directions"`
+	// 路径不能经过的节点ID
+	ExcludeNodeIds []string `thrift:"exclude_node_ids,11,optional" form:"exclude_node_ids" json:"exclude_node_ids,omitempty" query:"exclude_node_ids"`
+	// 路径必须经过的节点ID (顺序不限)
+	ViaNodeIds []string `thrift:"via_node_ids,12,optional" form:"via_node_ids" json:"via_node_ids,omitempty" query:"via_node_ids"`
+	// 中间节点允许的类型 (via_node_ids 中的节点除外)
+	IntermediateNodeTypes []NodeType `thrift:"intermediate_node_types,13,optional" form:"intermediate_node_types" json:"intermediate_node_types,omitempty" query:"intermediate_node_types"`
+}
+
+func NewGetPathRequest() *GetPathRequest {
+	return &GetPathRequest{}
+}
+
+func (p *GetPathRequest) InitDefault() {
+}
+
+func (p *GetPathRequest) GetSourceID() (v string) {
+	return p.SourceID
+}
+
+func (p *GetPathRequest) GetTargetID() (v string) {
+	return p.TargetID
+}
+
+var GetPathRequest_MaxDepth_DEFAULT int32
+
+func (p *GetPathRequest) GetMaxDepth() (v int32) {
+	if !p.IsSetMaxDepth() {
+		return GetPathRequest_MaxDepth_DEFAULT
+	}
+	return *p.MaxDepth
+}
+
+var GetPathRequest_Types_DEFAULT []RelationType
+
+func (p *GetPathRequest) GetTypes() (v []RelationType) {
+	if !p.IsSetTypes() {
+		return GetPathRequest_Types_DEFAULT
+	}
+	return p.Types
+}
+
+var GetPathRequest_Mode_DEFAULT PathMode
+
+func (p *GetPathRequest) GetMode() (v PathMode) {
+	if !p.IsSetMode() {
+		return GetPathRequest_Mode_DEFAULT
+	}
+	return *p.Mode
+}
+
+var GetPathRequest_K_DEFAULT int32
+
+func (p *GetPathRequest) GetK() (v int32) {
+	if !p.IsSetK() {
+		return GetPathRequest_K_DEFAULT
+	}
+	return *p.K
+}
+
+var GetPathRequest_WeightProperty_DEFAULT string
+
+func (p *GetPathRequest) GetWeightProperty() (v string) {
+	if !p.IsSetWeightProperty() {
+		return GetPathRequest_WeightProperty_DEFAULT
+	}
+	return *p.WeightProperty
+}
+
+var GetPathRequest_DefaultWeight_DEFAULT float64
+
+func (p *GetPathRequest) GetDefaultWeight() (v float64) {
+	if !p.IsSetDefaultWeight() {
+		return GetPathRequest_DefaultWeight_DEFAULT
+	}
+	return *p.DefaultWeight
+}
+
+var GetPathRequest_Direction_DEFAULT Direction
+
+func (p *GetPathRequest) GetDirection() (v Direction) {
+	if !p.IsSetDirection() {
+		return GetPathRequest_Direction_DEFAULT
+	}
+	return *p.Direction
+}
+
+var GetPathRequest_RelationDirections_DEFAULT []*RelationDirection
+
+func (p *GetPathRequest) GetRelationDirections() (v []*RelationDirection) {
+	if !p.IsSetRelationDirections() {
+		return GetPathRequest_RelationDirections_DEFAULT
+	}
+	return p.RelationDirections
+}
+
+var GetPathRequest_ExcludeNodeIds_DEFAULT []string
+
+func (p *GetPathRequest) GetExcludeNodeIds() (v []string) {
+	if !p.IsSetExcludeNodeIds() {
+		return GetPathRequest_ExcludeNodeIds_DEFAULT
+	}
+	return p.ExcludeNodeIds
+}
+
+var GetPathRequest_ViaNodeIds_DEFAULT []string
+
+func (p *GetPathRequest) GetViaNodeIds() (v []string) {
+	if !p.IsSetViaNodeIds() {
+		return GetPathRequest_ViaNodeIds_DEFAULT
+	}
+	return p.ViaNodeIds
+}
+
+var GetPathRequest_IntermediateNodeTypes_DEFAULT []NodeType
+
+func (p *GetPathRequest) GetIntermediateNodeTypes() (v []NodeType) {
+	if !p.IsSetIntermediateNodeTypes() {
+		return GetPathRequest_IntermediateNodeTypes_DEFAULT
+	}
+	return p.IntermediateNodeTypes
+}
+
+var fieldIDToName_GetPathRequest = map[int16]string{
+	1:  "source_id",
+	2:  "target_id",
+	3:  "max_depth",
+	4:  "types",
+	5:  "mode",
+	6:  "k",
+	7:  "weight_property",
+	8:  "default_weight",
+	9:  "direction",
+	10: "relation_directions",
+	11: "exclude_node_ids",
+	12: "via_node_ids",
+	13: "intermediate_node_types",
+}
+
+func (p *GetPathRequest) IsSetMaxDepth() bool {
+	return p.MaxDepth != nil
+}
+
+func (p *GetPathRequest) IsSetTypes() bool {
+	return p.Types != nil
+}
+
+func (p *GetPathRequest) IsSetMode() bool {
+	return p.Mode != nil
+}
+
+func (p *GetPathRequest) IsSetK() bool {
+	return p.K != nil
+}
+
+func (p *GetPathRequest) IsSetWeightProperty() bool {
+	return p.WeightProperty != nil
+}
+
+func (p *GetPathRequest) IsSetDefaultWeight() bool {
+	return p.DefaultWeight != nil
+}
+
+func (p *GetPathRequest) IsSetDirection() bool {
+	return p.Direction != nil
+}
+
+func (p *GetPathRequest) IsSetRelationDirections() bool {
+	return p.RelationDirections != nil
+}
+
+func (p *GetPathRequest) IsSetExcludeNodeIds() bool {
+	return p.ExcludeNodeIds != nil
+}
+
+func (p *GetPathRequest) IsSetViaNodeIds() bool {
+	return p.ViaNodeIds != nil
+}
+
+func (p *GetPathRequest) IsSetIntermediateNodeTypes() bool {
+	return p.IntermediateNodeTypes != nil
+}
+
+func (p *GetPathRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetPathRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetPathRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SourceID = _field
+	return nil
+}
+func (p *GetPathRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TargetID = _field
+	return nil
+}
+func (p *GetPathRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MaxDepth = _field
+	return nil
+}
+func (p *GetPathRequest) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]RelationType, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem RelationType
+		if v, err := iprot.ReadI32(); err != nil {
+			return err
+		} else {
+			_elem = RelationType(v)
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Types = _field
+	return nil
+}
+func (p *GetPathRequest) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *PathMode
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := PathMode(v)
+		_field = &tmp
+	}
+	p.Mode = _field
+	return nil
+}
+func (p *GetPathRequest) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.K = _field
+	return nil
+}
+func (p *GetPathRequest) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.WeightProperty = _field
+	return nil
+}
+func (p *GetPathRequest) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DefaultWeight = _field
+	return nil
+}
+func (p *GetPathRequest) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *Direction
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		tmp := Direction(v)
+		_field = &tmp
+	}
+	p.Direction = _field
+	return nil
+}
+func (p *GetPathRequest) ReadField10(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*RelationDirection, 0, size)
+	values := make([]RelationDirection, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.RelationDirections = _field
+	return nil
+}
+func (p *GetPathRequest) ReadField11(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ExcludeNodeIds = _field
+	return nil
+}
+func (p *GetPathRequest) ReadField12(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ViaNodeIds = _field
+	return nil
+}
+func (p *GetPathRequest) ReadField13(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]NodeType, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem NodeType
+		if v, err := iprot.ReadI32(); err != nil {
+			return err
+		} else {
+			_elem = NodeType(v)
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.IntermediateNodeTypes = _field
+	return nil
+}
+
+func (p *GetPathRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPathRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetPathRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("source_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.SourceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *GetPathRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("target_id", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.TargetID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *GetPathRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxDepth() {
+		if err = oprot.WriteFieldBegin("max_depth", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.MaxDepth); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *GetPathRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTypes() {
+		if err = oprot.WriteFieldBegin("types", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.I32, len(p.Types)); err != nil {
+			return err
+		}
+		for _, v := range p.Types {
+			if err := oprot.WriteI32(int32(v)); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *GetPathRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMode() {
+		if err = oprot.WriteFieldBegin("mode", thrift.I32, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.Mode)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *GetPathRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetK() {
+		if err = oprot.WriteFieldBegin("k", thrift.I32, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.K); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *GetPathRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWeightProperty() {
+		if err = oprot.WriteFieldBegin("weight_property", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.WeightProperty); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *GetPathRequest) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDefaultWeight() {
+		if err = oprot.WriteFieldBegin("default_weight", thrift.DOUBLE, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.DefaultWeight); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *GetPathRequest) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDirection() {
+		if err = oprot.WriteFieldBegin("direction", thrift.I32, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(int32(*p.Direction)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *GetPathRequest) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRelationDirections() {
+		if err = oprot.WriteFieldBegin("relation_directions", thrift.LIST, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.RelationDirections)); err != nil {
+			return err
+		}
+		for _, v := range p.RelationDirections {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *GetPathRequest) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExcludeNodeIds() {
+		if err = oprot.WriteFieldBegin("exclude_node_ids", thrift.LIST, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.ExcludeNodeIds)); err != nil {
+			return err
+		}
+		for _, v := range p.ExcludeNodeIds {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *GetPathRequest) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetViaNodeIds() {
+		if err = oprot.WriteFieldBegin("via_node_ids", thrift.LIST, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.ViaNodeIds)); err != nil {
+			return err
+		}
+		for _, v := range p.ViaNodeIds {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+func (p *GetPathRequest) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIntermediateNodeTypes() {
+		if err = oprot.WriteFieldBegin("intermediate_node_types", thrift.LIST, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.I32, len(p.IntermediateNodeTypes)); err != nil {
+			return err
+		}
+		for _, v := range p.IntermediateNodeTypes {
+			if err := oprot.WriteI32(int32(v)); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+
+func (p *GetPathRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetPathRequest(%+v)", *p)
+
+}
+
+// 一条路径
+type Path struct {
+	// 路径上的节点，从起点到终点
+	Nodes []*Node `thrift:"nodes,1" form:"nodes" json:"nodes" query:"nodes"`
+	// 路径上的关系，relations[i] 连接 nodes[i] 和 nodes[i+1]
+	Relations []*Relation `thrift:"relations,2" form:"relations" json:"relations" query:"relations"`
+	// WEIGHTED 模式下路径的总代价
+	Cost *float64 `thrift:"cost,3,optional" form:"cost" json:"cost,omitempty" query:"cost"`
+}
+
+func NewPath() *Path {
+	return &Path{}
+}
+
+func (p *Path) InitDefault() {
+}
+
+func (p *Path) GetNodes() (v []*Node) {
+	return p.Nodes
+}
+
+func (p *Path) GetRelations() (v []*Relation) {
+	return p.Relations
+}
+
+var Path_Cost_DEFAULT float64
+
+func (p *Path) GetCost() (v float64) {
+	if !p.IsSetCost() {
+		return Path_Cost_DEFAULT
+	}
+	return *p.Cost
+}
+
+var fieldIDToName_Path = map[int16]string{
+	1: "nodes",
+	2: "relations",
+	3: "cost",
+}
+
+func (p *Path) IsSetCost() bool {
+	return p.Cost != nil
+}
+
+func (p *Path) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Path[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *Path) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Node, 0, size)
+	values := make([]Node, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Nodes = _field
+	return nil
+}
+func (p *Path) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Relation, 0, size)
+	values := make([]Relation, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Relations = _field
+	return nil
+}
+func (p *Path) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Cost = _field
+	return nil
+}
+
+func (p *Path) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Path"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *Path) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("nodes", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Nodes)); err != nil {
+		return err
+	}
+	for _, v := range p.Nodes {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *Path) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("relations", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Relations)); err != nil {
+		return err
+	}
+	for _, v := range p.Relations {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *Path) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCost() {
+		if err = oprot.WriteFieldBegin("cost", thrift.DOUBLE, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.Cost); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *Path) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Path(%+v)", *p)
+
+}
+
+// 路径查询响应
+type GetPathResponse struct {
+	Success bool   `thrift:"success,1" form:"success" json:"success" query:"success"`
+	Message string `thrift:"message,2" form:"message" json:"message" query:"message"`
+	// 路径上的节点
+	Nodes []*Node `thrift:"nodes,3" form:"nodes" json:"nodes" query:"nodes"`
+	// 路径上的关系
+	Relations []*Relation `thrift:"relations,4" form:"relations" json:"relations" query:"relations"`
+	// 找到的全部路径，按长度升序；nodes 和 relations 与第一条路径相同
+	Paths []*Path `thrift:"paths,5,optional" form:"paths" json:"paths,omitempty" query:"paths"`
+	// WEIGHTED 模式下路径的总代价
+	TotalCost *float64 `thrift:"total_cost,6,optional" form:"total_cost" json:"total_cost,omitempty" query:"total_cost"`
+}
+
+func NewGetPathResponse() *GetPathResponse {
+	return &GetPathResponse{}
+}
+
+func (p *GetPathResponse) InitDefault() {
+}
+
+func (p *GetPathResponse) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *GetPathResponse) GetMessage() (v string) {
+	return p.Message
+}
+
+func (p *GetPathResponse) GetNodes() (v []*Node) {
+	return p.Nodes
+}
+
+func (p *GetPathResponse) GetRelations() (v []*Relation) {
+	return p.Relations
+}
+
+var GetPathResponse_Paths_DEFAULT []*Path
+
+func (p *GetPathResponse) GetPaths() (v []*Path) {
+	if !p.IsSetPaths() {
+		return GetPathResponse_Paths_DEFAULT
+	}
+	return p.Paths
+}
+
+var GetPathResponse_TotalCost_DEFAULT float64
+
+func (p *GetPathResponse) GetTotalCost() (v float64) {
+	if !p.IsSetTotalCost() {
+		return GetPathResponse_TotalCost_DEFAULT
+	}
+	return *p.TotalCost
+}
+
+var fieldIDToName_GetPathResponse = map[int16]string{
+	1: "success",
+	2: "message",
+	3: "nodes",
+	4: "relations",
+	5: "paths",
+	6: "total_cost",
+}
+
+func (p *GetPathResponse) IsSetPaths() bool {
+	return p.Paths != nil
+}
+
+func (p *GetPathResponse) IsSetTotalCost() bool {
+	return p.TotalCost != nil
+}
+
+func (p *GetPathResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetPathResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetPathResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Success = _field
+	return nil
+}
+func (p *GetPathResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Message = _field
+	return nil
+}
+func (p *GetPathResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Node, 0, size)
+	values := make([]Node, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Nodes = _field
+	return nil
+}
+func (p *GetPathResponse) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Relation, 0, size)
+	values := make([]Relation, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Relations = _field
+	return nil
+}
+func (p *GetPathResponse) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Path, 0, size)
+	values := make([]Path, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Paths = _field
+	return nil
+}
+func (p *GetPathResponse) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TotalCost = _field
+	return nil
+}
+
+func (p *GetPathResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPathResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetPathResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *GetPathResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *GetPathResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("nodes", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Nodes)); err != nil {
+		return err
+	}
+	for _, v := range p.Nodes {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *GetPathResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("relations", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Relations)); err != nil {
+		return err
+	}
+	for _, v := range p.Relations {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *GetPathResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPaths() {
+		if err = oprot.WriteFieldBegin("paths", thrift.LIST, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Paths)); err != nil {
+			return err
+		}
+		for _, v := range p.Paths {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *GetPathResponse) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTotalCost() {
+		if err = oprot.WriteFieldBegin("total_cost", thrift.DOUBLE, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.TotalCost); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *GetPathResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetPathResponse(%+v)", *p)
+
+}
+
+// 属性约束，对应 properties 中的一个键
+type PropertySchema struct {
+	// 属性键
+	Key string `thrift:"key,1" form:"key" json:"key" query:"key"`
+	// 值类型: string (默认)、int、date、enum、url
+	Type string `thrift:"type,2" form:"type" json:"type" query:"type"`
+	// 创建时是否必填
+	Required bool `thrift:"required,3" form:"required" json:"required" query:"required"`
+	// type 为 enum 时允许的取值
+	EnumValues []string `thrift:"enum_values,4,optional" form:"enum_values" json:"enum_values,omitempty" query:"enum_values"`
+	// 值须匹配的正则表达式
+	Pattern *string `thrift:"pattern,5,optional" form:"pattern" json:"pattern,omitempty" query:"pattern"`
+	// 最小长度 (字符数)
+	MinLength *int64 `thrift:"min_length,6,optional" form:"min_length" json:"min_length,omitempty" query:"min_length"`
+	// 最大长度 (字符数)
+	MaxLength *int64 `thrift:"max_length,7,optional" form:"max_length" json:"max_length,omitempty" query:"max_length"`
+}
+
+func NewPropertySchema() *PropertySchema {
+	return &PropertySchema{}
+}
+
+func (p *PropertySchema) InitDefault() {
+}
+
+func (p *PropertySchema) GetKey() (v string) {
+	return p.Key
+}
+
+func (p *PropertySchema) GetType() (v string) {
+	return p.Type
+}
+
+func (p *PropertySchema) GetRequired() (v bool) {
+	return p.Required
+}
+
+var PropertySchema_EnumValues_DEFAULT []string
+
+func (p *PropertySchema) GetEnumValues() (v []string) {
+	if !p.IsSetEnumValues() {
+		return PropertySchema_EnumValues_DEFAULT
+	}
+	return p.EnumValues
+}
+
+var PropertySchema_Pattern_DEFAULT string
+
+func (p *PropertySchema) GetPattern() (v string) {
+	if !p.IsSetPattern() {
+		return PropertySchema_Pattern_DEFAULT
+	}
+	return *p.Pattern
+}
+
+var PropertySchema_MinLength_DEFAULT int64
+
+func (p *PropertySchema) GetMinLength() (v int64) {
+	if !p.IsSetMinLength() {
+		return PropertySchema_MinLength_DEFAULT
+	}
+	return *p.MinLength
+}
+
+var PropertySchema_MaxLength_DEFAULT int64
+
+func (p *PropertySchema) GetMaxLength() (v int64) {
+	if !p.IsSetMaxLength() {
+		return PropertySchema_MaxLength_DEFAULT
+	}
+	return *p.MaxLength
+}
+
+var fieldIDToName_PropertySchema = map[int16]string{
+	1: "key",
+	2: "type",
+	3: "required",
+	4: "enum_values",
+	5: "pattern",
+	6: "min_length",
+	7: "max_length",
+}
+
+func (p *PropertySchema) IsSetEnumValues() bool {
+	return p.EnumValues != nil
+}
+
+func (p *PropertySchema) IsSetPattern() bool {
+	return p.Pattern != nil
+}
+
+func (p *PropertySchema) IsSetMinLength() bool {
+	return p.MinLength != nil
+}
+
+func (p *PropertySchema) IsSetMaxLength() bool {
+	return p.MaxLength != nil
+}
+
+func (p *PropertySchema) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PropertySchema[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PropertySchema) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Key = _field
+	return nil
+}
+func (p *PropertySchema) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Type = _field
+	return nil
+}
+func (p *PropertySchema) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Required = _field
+	return nil
+}
+func (p *PropertySchema) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.EnumValues = _field
+	return nil
+}
+func (p *PropertySchema) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Pattern = _field
+	return nil
+}
+func (p *PropertySchema) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MinLength = _field
+	return nil
+}
+func (p *PropertySchema) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MaxLength = _field
+	return nil
+}
+
+func (p *PropertySchema) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PropertySchema"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PropertySchema) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("key", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Key); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *PropertySchema) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("type", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Type); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *PropertySchema) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("required", thrift.BOOL, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Required); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *PropertySchema) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEnumValues() {
+		if err = oprot.WriteFieldBegin("enum_values", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.EnumValues)); err != nil {
+			return err
+		}
+		for _, v := range p.EnumValues {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *PropertySchema) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPattern() {
+		if err = oprot.WriteFieldBegin("pattern", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Pattern); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *PropertySchema) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMinLength() {
+		if err = oprot.WriteFieldBegin("min_length", thrift.I64, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.MinLength); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *PropertySchema) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxLength() {
+		if err = oprot.WriteFieldBegin("max_length", thrift.I64, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.MaxLength); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *PropertySchema) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PropertySchema(%+v)", *p)
+
+}
+
+// 属性校验错误
+type FieldError struct {
+	// 属性键
+	Field string `thrift:"field,1" form:"field" json:"field" query:"field"`
+	// 错误码: required、unknown、invalid_type、invalid_enum、pattern_mismatch、too_short、too_long
+	Code string `thrift:"code,2" form:"code" json:"code" query:"code"`
+	// 错误说明
+	Message string `thrift:"message,3" form:"message" json:"message" query:"message"`
+}
+
+func NewFieldError() *FieldError {
+	return &FieldError{}
+}
+
+func (p *FieldError) InitDefault() {
+}
+
+func (p *FieldError) GetField() (v string) {
+	return p.Field
+}
+
+func (p *FieldError) GetCode() (v string) {
+	return p.Code
+}
+
+func (p *FieldError) GetMessage() (v string) {
+	return p.Message
+}
+
+var fieldIDToName_FieldError = map[int16]string{
+	1: "field",
+	2: "code",
+	3: "message",
+}
+
+func (p *FieldError) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FieldError[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FieldError) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Field = _field
+	return nil
+}
+func (p *FieldError) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *FieldError) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Message = _field
+	return nil
+}
+
+func (p *FieldError) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("FieldError"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FieldError) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("field", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Field); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *FieldError) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *FieldError) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *FieldError) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FieldError(%+v)", *p)
+
+}
+
+// 节点类型定义
+type NodeTypeInfo struct {
+	// 类型名，即 Neo4j 标签
+	Name string `thrift:"name,1" form:"name" json:"name" query:"name"`
+	// 类型编码，作为 NodeType 的值使用
+	Code int64 `thrift:"code,2" form:"code" json:"code" query:"code"`
+	// 是否为 Thrift 枚举中的内置类型
+	Builtin bool `thrift:"builtin,3" form:"builtin" json:"builtin" query:"builtin"`
+	// 属性约束，为空表示不限制
+	Properties []*PropertySchema `thrift:"properties,4,optional" form:"properties" json:"properties,omitempty" query:"properties"`
+}
+
+func NewNodeTypeInfo() *NodeTypeInfo {
+	return &NodeTypeInfo{}
+}
+
+func (p *NodeTypeInfo) InitDefault() {
+}
+
+func (p *NodeTypeInfo) GetName() (v string) {
+	return p.Name
+}
+
+func (p *NodeTypeInfo) GetCode() (v int64) {
+	return p.Code
+}
+
+func (p *NodeTypeInfo) GetBuiltin() (v bool) {
+	return p.Builtin
+}
+
+var NodeTypeInfo_Properties_DEFAULT []*PropertySchema
+
+func (p *NodeTypeInfo) GetProperties() (v []*PropertySchema) {
+	if !p.IsSetProperties() {
+		return NodeTypeInfo_Properties_DEFAULT
+	}
+	return p.Properties
+}
+
+var fieldIDToName_NodeTypeInfo = map[int16]string{
+	1: "name",
+	2: "code",
+	3: "builtin",
+	4: "properties",
+}
+
+func (p *NodeTypeInfo) IsSetProperties() bool {
+	return p.Properties != nil
+}
+
+func (p *NodeTypeInfo) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NodeTypeInfo[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *NodeTypeInfo) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *NodeTypeInfo) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *NodeTypeInfo) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Builtin = _field
+	return nil
+}
+func (p *NodeTypeInfo) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*PropertySchema, 0, size)
+	values := make([]PropertySchema, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Properties = _field
+	return nil
+}
+
+func (p *NodeTypeInfo) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("NodeTypeInfo"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *NodeTypeInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *NodeTypeInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *NodeTypeInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("builtin", thrift.BOOL, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Builtin); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *NodeTypeInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetProperties() {
+		if err = oprot.WriteFieldBegin("properties", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Properties)); err != nil {
+			return err
+		}
+		for _, v := range p.Properties {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *NodeTypeInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NodeTypeInfo(%+v)", *p)
+
+}
+
+// 关系允许连接的节点类型组合
+type TypePair struct {
+	// 起始节点类型名
+	Source string `thrift:"source,1" form:"source" json:"source" query:"source"`
+	// 目标节点类型名
+	Target string `thrift:"target,2" form:"target" json:"target" query:"target"`
+}
+
+func NewTypePair() *TypePair {
+	return &TypePair{}
+}
+
+func (p *TypePair) InitDefault() {
+}
+
+func (p *TypePair) GetSource() (v string) {
+	return p.Source
+}
+
+func (p *TypePair) GetTarget() (v string) {
+	return p.Target
+}
+
+var fieldIDToName_TypePair = map[int16]string{
+	1: "source",
+	2: "target",
+}
+
+func (p *TypePair) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TypePair[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *TypePair) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Source = _field
+	return nil
+}
+func (p *TypePair) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Target = _field
+	return nil
+}
+
+func (p *TypePair) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TypePair"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TypePair) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("source", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Source); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *TypePair) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("target", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Target); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TypePair) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TypePair(%+v)", *p)
+
+}
+
+// 关系类型定义
+type RelationTypeInfo struct {
+	// 类型名，即 Neo4j 关系类型
+	Name string `thrift:"name,1" form:"name" json:"name" query:"name"`
+	// 类型编码，作为 RelationType 的值使用
+	Code int64 `thrift:"code,2" form:"code" json:"code" query:"code"`
+	// 是否为 Thrift 枚举中的内置类型
+	Builtin bool `thrift:"builtin,3" form:"builtin" json:"builtin" query:"builtin"`
+	// 允许的节点类型组合，为空表示不限制
+	AllowedPairs []*TypePair `thrift:"allowed_pairs,4,optional" form:"allowed_pairs" json:"allowed_pairs,omitempty" query:"allowed_pairs"`
+	// 属性约束，为空表示不限制
+	Properties []*PropertySchema `thrift:"properties,5,optional" form:"properties" json:"properties,omitempty" query:"properties"`
+}
+
+func NewRelationTypeInfo() *RelationTypeInfo {
+	return &RelationTypeInfo{}
+}
+
+func (p *RelationTypeInfo) InitDefault() {
+}
+
+func (p *RelationTypeInfo) GetName() (v string) {
+	return p.Name
+}
+
+func (p *RelationTypeInfo) GetCode() (v int64) {
+	return p.Code
+}
+
+func (p *RelationTypeInfo) GetBuiltin() (v bool) {
+	return p.Builtin
+}
+
+var RelationTypeInfo_AllowedPairs_DEFAULT []*TypePair
+
+func (p *RelationTypeInfo) GetAllowedPairs() (v []*TypePair) {
+	if !p.IsSetAllowedPairs() {
+		return RelationTypeInfo_AllowedPairs_DEFAULT
+	}
+	return p.AllowedPairs
+}
+
+var RelationTypeInfo_Properties_DEFAULT []*PropertySchema
+
+func (p *RelationTypeInfo) GetProperties() (v []*PropertySchema) {
+	if !p.IsSetProperties() {
+		return RelationTypeInfo_Properties_DEFAULT
+	}
+	return p.Properties
+}
+
+var fieldIDToName_RelationTypeInfo = map[int16]string{
+	1: "name",
+	2: "code",
+	3: "builtin",
+	4: "allowed_pairs",
+	5: "properties",
+}
+
+func (p *RelationTypeInfo) IsSetAllowedPairs() bool {
+	return p.AllowedPairs != nil
+}
+
+func (p *RelationTypeInfo) IsSetProperties() bool {
+	return p.Properties != nil
+}
+
+func (p *RelationTypeInfo) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationTypeInfo[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RelationTypeInfo) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *RelationTypeInfo) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *RelationTypeInfo) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Builtin = _field
+	return nil
+}
+func (p *RelationTypeInfo) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*TypePair, 0, size)
+	values := make([]TypePair, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.AllowedPairs = _field
+	return nil
+}
+func (p *RelationTypeInfo) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*PropertySchema, 0, size)
+	values := make([]PropertySchema, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Properties = _field
+	return nil
+}
+
+func (p *RelationTypeInfo) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RelationTypeInfo"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RelationTypeInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *RelationTypeInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *RelationTypeInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("builtin", thrift.BOOL, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Builtin); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *RelationTypeInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAllowedPairs() {
+		if err = oprot.WriteFieldBegin("allowed_pairs", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.AllowedPairs)); err != nil {
+			return err
+		}
+		for _, v := range p.AllowedPairs {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *RelationTypeInfo) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetProperties() {
+		if err = oprot.WriteFieldBegin("properties", thrift.LIST, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Properties)); err != nil {
+			return err
+		}
+		for _, v := range p.Properties {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *RelationTypeInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RelationTypeInfo(%+v)", *p)
+
+}
+
+// 获取类型列表请求
+type ListTypesRequest struct {
+}
+
+func NewListTypesRequest() *ListTypesRequest {
+	return &ListTypesRequest{}
+}
+
+func (p *ListTypesRequest) InitDefault() {
+}
+
+var fieldIDToName_ListTypesRequest = map[int16]string{}
+
+func (p *ListTypesRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListTypesRequest) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("ListTypesRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListTypesRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListTypesRequest(%+v)", *p)
+
+}
+
+// 获取类型列表响应
+type ListTypesResponse struct {
+	Success       bool                `thrift:"success,1" form:"success" json:"success" query:"success"`
+	Message       string              `thrift:"message,2" form:"message" json:"message" query:"message"`
+	NodeTypes     []*NodeTypeInfo     `thrift:"node_types,3" form:"node_types" json:"node_types" query:"node_types"`
+	RelationTypes []*RelationTypeInfo `thrift:"relation_types,4" form:"relation_types" json:"relation_types" query:"relation_types"`
+}
+
+func NewListTypesResponse() *ListTypesResponse {
+	return &ListTypesResponse{}
+}
+
+func (p *ListTypesResponse) InitDefault() {
+}
+
+func (p *ListTypesResponse) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ListTypesResponse) GetMessage() (v string) {
+	return p.Message
+}
+
+func (p *ListTypesResponse) GetNodeTypes() (v []*NodeTypeInfo) {
+	return p.NodeTypes
+}
+
+func (p *ListTypesResponse) GetRelationTypes() (v []*RelationTypeInfo) {
+	return p.RelationTypes
+}
+
+var fieldIDToName_ListTypesResponse = map[int16]string{
+	1: "success",
+	2: "message",
+	3: "node_types",
+	4: "relation_types",
+}
+
+func (p *ListTypesResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListTypesResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListTypesResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Success = _field
+	return nil
+}
+func (p *ListTypesResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Message = _field
+	return nil
+}
+func (p *ListTypesResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*NodeTypeInfo, 0, size)
+	values := make([]NodeTypeInfo, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.NodeTypes = _field
+	return nil
+}
+func (p *ListTypesResponse) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*RelationTypeInfo, 0, size)
+	values := make([]RelationTypeInfo, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.RelationTypes = _field
+	return nil
+}
+
+func (p *ListTypesResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListTypesResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListTypesResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ListTypesResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ListTypesResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("node_types", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.NodeTypes)); err != nil {
+		return err
+	}
+	for _, v := range p.NodeTypes {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ListTypesResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("relation_types", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.RelationTypes)); err != nil {
+		return err
+	}
+	for _, v := range p.RelationTypes {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ListTypesResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListTypesResponse(%+v)", *p)
+
+}
+
+// 创建节点类型请求
+type CreateNodeTypeRequest struct {
+	// 类型名 (大写字母、数字和下划线)
+	Name string `thrift:"name,1" form:"name" json:"name" query:"name"`
+	// 属性约束，为空表示不限制
+	Properties []*PropertySchema `thrift:"properties,2,optional" form:"properties" json:"properties,omitempty" query:"properties"`
+}
+
+func NewCreateNodeTypeRequest() *CreateNodeTypeRequest {
+	return &CreateNodeTypeRequest{}
+}
+
+func (p *CreateNodeTypeRequest) InitDefault() {
+}
+
+func (p *CreateNodeTypeRequest) GetName() (v string) {
+	return p.Name
+}
+
+var CreateNodeTypeRequest_Properties_DEFAULT []*PropertySchema
+
+func (p *CreateNodeTypeRequest) GetProperties() (v []*PropertySchema) {
+	if !p.IsSetProperties() {
+		return CreateNodeTypeRequest_Properties_DEFAULT
+	}
+	return p.Properties
+}
+
+var fieldIDToName_CreateNodeTypeRequest = map[int16]string{
+	1: "name",
+	2: "properties",
+}
+
+func (p *CreateNodeTypeRequest) IsSetProperties() bool {
+	return p.Properties != nil
+}
+
+func (p *CreateNodeTypeRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateNodeTypeRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CreateNodeTypeRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *CreateNodeTypeRequest) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*PropertySchema, 0, size)
+	values := make([]PropertySchema, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Properties = _field
+	return nil
+}
+
+func (p *CreateNodeTypeRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateNodeTypeRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CreateNodeTypeRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *CreateNodeTypeRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetProperties() {
+		if err = oprot.WriteFieldBegin("properties", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Properties)); err != nil {
+			return err
+		}
+		for _, v := range p.Properties {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CreateNodeTypeRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateNodeTypeRequest(%+v)", *p)
+
+}
+
+// 创建节点类型响应
+type CreateNodeTypeResponse struct {
+	Success  bool          `thrift:"success,1" form:"success" json:"success" query:"success"`
+	Message  string        `thrift:"message,2" form:"message" json:"message" query:"message"`
+	NodeType *NodeTypeInfo `thrift:"node_type,3" form:"node_type" json:"node_type" query:"node_type"`
+}
+
+func NewCreateNodeTypeResponse() *CreateNodeTypeResponse {
+	return &CreateNodeTypeResponse{}
+}
+
+func (p *CreateNodeTypeResponse) InitDefault() {
+}
+
+func (p *CreateNodeTypeResponse) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *CreateNodeTypeResponse) GetMessage() (v string) {
+	return p.Message
+}
+
+var CreateNodeTypeResponse_NodeType_DEFAULT *NodeTypeInfo
+
+func (p *CreateNodeTypeResponse) GetNodeType() (v *NodeTypeInfo) {
+	if !p.IsSetNodeType() {
+		return CreateNodeTypeResponse_NodeType_DEFAULT
+	}
+	return p.NodeType
+}
+
+var fieldIDToName_CreateNodeTypeResponse = map[int16]string{
+	1: "success",
+	2: "message",
+	3: "node_type",
+}
+
+func (p *CreateNodeTypeResponse) IsSetNodeType() bool {
+	return p.NodeType != nil
+}
+
+func (p *CreateNodeTypeResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateNodeTypeResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CreateNodeTypeResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Success = _field
+	return nil
+}
+func (p *CreateNodeTypeResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Message = _field
+	return nil
+}
+func (p *CreateNodeTypeResponse) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewNodeTypeInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.NodeType = _field
+	return nil
+}
+
+func (p *CreateNodeTypeResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateNodeTypeResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CreateNodeTypeResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *CreateNodeTypeResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *CreateNodeTypeResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("node_type", thrift.STRUCT, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.NodeType.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *CreateNodeTypeResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateNodeTypeResponse(%+v)", *p)
+
+}
+
+// 更新节点类型请求 (替换属性约束)
+type UpdateNodeTypeRequest struct {
+	// 类型名
+	Name string `thrift:"name,1" form:"name" json:"name" query:"name"`
+	// 属性约束，为空表示不限制
+	Properties []*PropertySchema `thrift:"properties,2,optional" form:"properties" json:"properties,omitempty" query:"properties"`
+}
+
+func NewUpdateNodeTypeRequest() *UpdateNodeTypeRequest {
+	return &UpdateNodeTypeRequest{}
+}
+
+func (p *UpdateNodeTypeRequest) InitDefault() {
+}
+
+func (p *UpdateNodeTypeRequest) GetName() (v string) {
+	return p.Name
+}
+
+var UpdateNodeTypeRequest_Properties_DEFAULT []*PropertySchema
+
+func (p *UpdateNodeTypeRequest) GetProperties() (v []*PropertySchema) {
+	if !p.IsSetProperties() {
+		return UpdateNodeTypeRequest_Properties_DEFAULT
+	}
+	return p.Properties
+}
+
+var fieldIDToName_UpdateNodeTypeRequest = map[int16]string{
+	1: "name",
+	2: "properties",
+}
+
+func (p *UpdateNodeTypeRequest) IsSetProperties() bool {
+	return p.Properties != nil
+}
+
+func (p *UpdateNodeTypeRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateNodeTypeRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UpdateNodeTypeRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *UpdateNodeTypeRequest) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*PropertySchema, 0, size)
+	values := make([]PropertySchema, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Properties = _field
+	return nil
+}
+
+func (p *UpdateNodeTypeRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateNodeTypeRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateNodeTypeRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *UpdateNodeTypeRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetProperties() {
+		if err = oprot.WriteFieldBegin("properties", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Properties)); err != nil {
+			return err
+		}
+		for _, v := range p.Properties {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UpdateNodeTypeRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateNodeTypeRequest(%+v)", *p)
+
+}
+
+// 更新节点类型响应
+type UpdateNodeTypeResponse struct {
+	Success  bool          `thrift:"success,1" form:"success" json:"success" query:"success"`
+	Message  string        `thrift:"message,2" form:"message" json:"message" query:"message"`
+	NodeType *NodeTypeInfo `thrift:"node_type,3" form:"node_type" json:"node_type" query:"node_type"`
+}
+
+func NewUpdateNodeTypeResponse() *UpdateNodeTypeResponse {
+	return &UpdateNodeTypeResponse{}
+}
+
+func (p *UpdateNodeTypeResponse) InitDefault() {
+}
+
+func (p *UpdateNodeTypeResponse) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *UpdateNodeTypeResponse) GetMessage() (v string) {
+	return p.Message
+}
+
+var UpdateNodeTypeResponse_NodeType_DEFAULT *NodeTypeInfo
+
+func (p *UpdateNodeTypeResponse) GetNodeType() (v *NodeTypeInfo) {
+	if !p.IsSetNodeType() {
+		return UpdateNodeTypeResponse_NodeType_DEFAULT
+	}
+	return p.NodeType
+}
+
+var fieldIDToName_UpdateNodeTypeResponse = map[int16]string{
+	1: "success",
+	2: "message",
+	3: "node_type",
+}
+
+func (p *UpdateNodeTypeResponse) IsSetNodeType() bool {
+	return p.NodeType != nil
+}
+
+func (p *UpdateNodeTypeResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateNodeTypeResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UpdateNodeTypeResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Success = _field
+	return nil
+}
+func (p *UpdateNodeTypeResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Message = _field
+	return nil
+}
+func (p *UpdateNodeTypeResponse) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewNodeTypeInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.NodeType = _field
+	return nil
+}
+
+func (p *UpdateNodeTypeResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateNodeTypeResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateNodeTypeResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *UpdateNodeTypeResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *UpdateNodeTypeResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("node_type", thrift.STRUCT, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.NodeType.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *UpdateNodeTypeResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateNodeTypeResponse(%+v)", *p)
+
+}
+
+// 创建或更新关系类型请求
+type SaveRelationTypeRequest struct {
+	// 类型名 (大写字母、数字和下划线)
+	Name string `thrift:"name,1" form:"name" json:"name" query:"name"`
+	// 允许的节点类型组合，为空表示不限制
+	AllowedPairs []*TypePair `thrift:"allowed_pairs,2,optional" form:"allowed_pairs" json:"allowed_pairs,omitempty" query:"allowed_pairs"`
+	// 属性约束，为空表示不限制
+	Properties []*PropertySchema `thrift:"properties,3,optional" form:"properties" json:"properties,omitempty" query:"properties"`
+}
+
+func NewSaveRelationTypeRequest() *SaveRelationTypeRequest {
+	return &SaveRelationTypeRequest{}
+}
+
+func (p *SaveRelationTypeRequest) InitDefault() {
+}
+
+func (p *SaveRelationTypeRequest) GetName() (v string) {
+	return p.Name
+}
+
+var SaveRelationTypeRequest_AllowedPairs_DEFAULT []*TypePair
+
+func (p *SaveRelationTypeRequest) GetAllowedPairs() (v []*TypePair) {
+	if !p.IsSetAllowedPairs() {
+		return SaveRelationTypeRequest_AllowedPairs_DEFAULT
+	}
+	return p.AllowedPairs
+}
+
+var SaveRelationTypeRequest_Properties_DEFAULT []*PropertySchema
+
+func (p *SaveRelationTypeRequest) GetProperties() (v []*PropertySchema) {
+	if !p.IsSetProperties() {
+		return SaveRelationTypeRequest_Properties_DEFAULT
+	}
+	return p.Properties
+}
+
+var fieldIDToName_SaveRelationTypeRequest = map[int16]string{
+	1: "name",
+	2: "allowed_pairs",
+	3: "properties",
+}
+
+func (p *SaveRelationTypeRequest) IsSetAllowedPairs() bool {
+	return p.AllowedPairs != nil
+}
+
+func (p *SaveRelationTypeRequest) IsSetProperties() bool {
+	return p.Properties != nil
+}
+
+func (p *SaveRelationTypeRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SaveRelationTypeRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SaveRelationTypeRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *SaveRelationTypeRequest) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*TypePair, 0, size)
+	values := make([]TypePair, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.AllowedPairs = _field
+	return nil
+}
+func (p *SaveRelationTypeRequest) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*PropertySchema, 0, size)
+	values := make([]PropertySchema, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Properties = _field
+	return nil
+}
+
+func (p *SaveRelationTypeRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SaveRelationTypeRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SaveRelationTypeRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *SaveRelationTypeRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAllowedPairs() {
+		if err = oprot.WriteFieldBegin("allowed_pairs", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.AllowedPairs)); err != nil {
+			return err
+		}
+		for _, v := range p.AllowedPairs {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *SaveRelationTypeRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetProperties() {
+		if err = oprot.WriteFieldBegin("properties", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Properties)); err != nil {
+			return err
+		}
+		for _, v := range p.Properties {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SaveRelationTypeRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SaveRelationTypeRequest(%+v)", *p)
+
+}
+
+// 创建或更新关系类型响应
+type SaveRelationTypeResponse struct {
+	Success      bool              `thrift:"success,1" form:"success" json:"success" query:"success"`
+	Message      string            `thrift:"message,2" form:"message" json:"message" query:"message"`
+	RelationType *RelationTypeInfo `thrift:"relation_type,3" form:"relation_type" json:"relation_type" query:"relation_type"`
+}
+
+func NewSaveRelationTypeResponse() *SaveRelationTypeResponse {
+	return &SaveRelationTypeResponse{}
+}
+
+func (p *SaveRelationTypeResponse) InitDefault() {
+}
+
+func (p *SaveRelationTypeResponse) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *SaveRelationTypeResponse) GetMessage() (v string) {
+	return p.Message
+}
+
+var SaveRelationTypeResponse_RelationType_DEFAULT *RelationTypeInfo
+
+func (p *SaveRelationTypeResponse) GetRelationType() (v *RelationTypeInfo) {
+	if !p.IsSetRelationType() {
+		return SaveRelationTypeResponse_RelationType_DEFAULT
+	}
+	return p.RelationType
+}
+
+var fieldIDToName_SaveRelationTypeResponse = map[int16]string{
+	1: "success",
+	2: "message",
+	3: "relation_type",
+}
+
+func (p *SaveRelationTypeResponse) IsSetRelationType() bool {
+	return p.RelationType != nil
+}
+
+func (p *SaveRelationTypeResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SaveRelationTypeResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SaveRelationTypeResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Success = _field
+	return nil
+}
+func (p *SaveRelationTypeResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Message = _field
+	return nil
+}
+func (p *SaveRelationTypeResponse) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewRelationTypeInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.RelationType = _field
+	return nil
+}
+
+func (p *SaveRelationTypeResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SaveRelationTypeResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SaveRelationTypeResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *SaveRelationTypeResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *SaveRelationTypeResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("relation_type", thrift.STRUCT, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.RelationType.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SaveRelationTypeResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SaveRelationTypeResponse(%+v)", *p)
+
+}
+
+// 关系网络服务定义
+type NetworkService interface {
+	// 网络查询
+	GetNetwork(ctx context.Context, req *GetNetworkRequest) (r *GetNetworkResponse, err error)
+	// 图谱导出 (GraphML / GEXF / JSON Graph / Cypher)
+	ExportNetwork(ctx context.Context, req *ExportNetworkRequest) (r *ExportNetworkResponse, err error)
+	// 路径查询
+	GetPath(ctx context.Context, req *GetPathRequest) (r *GetPathResponse, err error)
+	// 搜索节点
+	SearchNodes(ctx context.Context, req *SearchNodesRequest) (r *SearchNodesResponse, err error)
+	// 全文搜索节点 (相关度排序、高亮、模糊匹配)
+	FulltextSearchNodes(ctx context.Context, req *FulltextSearchNodesRequest) (r *FulltextSearchNodesResponse, err error)
+	// 节点 CRUD
+	CreateNode(ctx context.Context, req *CreateNodeRequest) (r *CreateNodeResponse, err error)
+
+	GetNode(ctx context.Context, req *GetNodeRequest) (r *GetNodeResponse, err error)
+
+	UpdateNode(ctx context.Context, req *UpdateNodeRequest) (r *UpdateNodeResponse, err error)
+
+	DeleteNode(ctx context.Context, req *DeleteNodeRequest) (r *DeleteNodeResponse, err error)
+
+	BatchCreateNodes(ctx context.Context, req *BatchCreateNodesRequest) (r *BatchCreateNodesResponse, err error)
+	// 关系 CRUD
+	CreateRelation(ctx context.Context, req *CreateRelationRequest) (r *CreateRelationResponse, err error)
+
+	GetRelation(ctx context.Context, req *GetRelationRequest) (r *GetRelationResponse, err error)
+
+	UpdateRelation(ctx context.Context, req *UpdateRelationRequest) (r *UpdateRelationResponse, err error)
+
+	DeleteRelation(ctx context.Context, req *DeleteRelationRequest) (r *DeleteRelationResponse, err error)
+
+	BatchCreateRelations(ctx context.Context, req *BatchCreateRelationsRequest) (r *BatchCreateRelationsResponse, err error)
+	// 获取节点的所有关系
+	GetNodeRelations(ctx context.Context, req *GetNodeRelationsRequest) (r *GetNodeRelationsResponse, err error)
+	// 可能认识的人 (二跳推荐)
+	GetRecommendations(ctx context.Context, req *GetRecommendationsRequest) (r *GetRecommendationsResponse, err error)
+	// 类型注册表管理
+	ListTypes(ctx context.Context, req *ListTypesRequest) (r *ListTypesResponse, err error)
+
+	CreateNodeType(ctx context.Context, req *CreateNodeTypeRequest) (r *CreateNodeTypeResponse, err error)
+
+	SaveRelationType(ctx context.Context, req *SaveRelationTypeRequest) (r *SaveRelationTypeResponse, err error)
+
+	UpdateNodeType(ctx context.Context, req *UpdateNodeTypeRequest) (r *UpdateNodeTypeResponse, err error)
+}
+
+type NetworkServiceClient struct {
+	c thrift.TClient
+}
+
+func NewNetworkServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *NetworkServiceClient {
+	return &NetworkServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewNetworkServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *NetworkServiceClient {
+	return &NetworkServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewNetworkServiceClient(c thrift.TClient) *NetworkServiceClient {
+	return &NetworkServiceClient{
+		c: c,
+	}
+}
+
+func (p *NetworkServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *NetworkServiceClient) GetNetwork(ctx context.Context, req *GetNetworkRequest) (r *GetNetworkResponse, err error) {
+	var _args NetworkServiceGetNetworkArgs
+	_args.Req = req
+	var _result NetworkServiceGetNetworkResult
+	if err = p.Client_().Call(ctx, "GetNetwork", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *NetworkServiceClient) ExportNetwork(ctx context.Context, req *ExportNetworkRequest) (r *ExportNetworkResponse, err error) {
+	var _args NetworkServiceExportNetworkArgs
+	_args.Req = req
+	var _result NetworkServiceExportNetworkResult
+	if err = p.Client_().Call(ctx, "ExportNetwork", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *NetworkServiceClient) GetPath(ctx context.Context, req *GetPathRequest) (r *GetPathResponse, err error) {
+	var _args NetworkServiceGetPathArgs
+	_args.Req = req
+	var _result NetworkServiceGetPathResult
+	if err = p.Client_().Call(ctx, "GetPath", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *NetworkServiceClient) SearchNodes(ctx context.Context, req *SearchNodesRequest) (r *SearchNodesResponse, err error) {
+	var _args NetworkServiceSearchNodesArgs
+	_args.Req = req
+	var _result NetworkServiceSearchNodesResult
+	if err = p.Client_().Call(ctx, "SearchNodes", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *NetworkServiceClient) FulltextSearchNodes(ctx context.Context, req *FulltextSearchNodesRequest) (r *FulltextSearchNodesResponse, err error) {
+	var _args NetworkServiceFulltextSearchNodesArgs
+	_args.Req = req
+	var _result NetworkServiceFulltextSearchNodesResult
+	if err = p.Client_().Call(ctx, "FulltextSearchNodes", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *NetworkServiceClient) CreateNode(ctx context.Context, req *CreateNodeRequest) (r *CreateNodeResponse, err error) {
+	var _args NetworkServiceCreateNodeArgs
+	_args.Req = req
+	var _result NetworkServiceCreateNodeResult
+	if err = p.Client_().Call(ctx, "CreateNode", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *NetworkServiceClient) GetNode(ctx context.Context, req *GetNodeRequest) (r *GetNodeResponse, err error) {
+	var _args NetworkServiceGetNodeArgs
+	_args.Req = req
+	var _result NetworkServiceGetNodeResult
+	if err = p.Client_().Call(ctx, "GetNode", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *NetworkServiceClient) UpdateNode(ctx context.Context, req *UpdateNodeRequest) (r *UpdateNodeResponse, err error) {
+	var _args NetworkServiceUpdateNodeArgs
+	_args.Req = req
+	var _result NetworkServiceUpdateNodeResult
+	if err = p.Client_().Call(ctx, "UpdateNode", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *NetworkServiceClient) DeleteNode(ctx context.Context, req *DeleteNodeRequest) (r *DeleteNodeResponse, err error) {
+	var _args NetworkServiceDeleteNodeArgs
+	_args.Req = req
+	var _result NetworkServiceDeleteNodeResult
+	if err = p.Client_().Call(ctx, "DeleteNode", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *NetworkServiceClient) BatchCreateNodes(ctx context.Context, req *BatchCreateNodesRequest) (r *BatchCreateNodesResponse, err error) {
+	var _args NetworkServiceBatchCreateNodesArgs
+	_args.Req = req
+	var _result NetworkServiceBatchCreateNodesResult
+	if err = p.Client_().Call(ctx, "BatchCreateNodes", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *NetworkServiceClient) CreateRelation(ctx context.Context, req *CreateRelationRequest) (r *CreateRelationResponse, err error) {
+	var _args NetworkServiceCreateRelationArgs
+	_args.Req = req
+	var _result NetworkServiceCreateRelationResult
+	if err = p.Client_().Call(ctx, "CreateRelation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *NetworkServiceClient) GetRelation(ctx context.Context, req *GetRelationRequest) (r *GetRelationResponse, err error) {
+	var _args NetworkServiceGetRelationArgs
+	_args.Req = req
+	var _result NetworkServiceGetRelationResult
+	if err = p.Client_().Call(ctx, "GetRelation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *NetworkServiceClient) UpdateRelation(ctx context.Context, req *UpdateRelationRequest) (r *UpdateRelationResponse, err error) {
+	var _args NetworkServiceUpdateRelationArgs
+	_args.Req = req
+	var _result NetworkServiceUpdateRelationResult
+	if err = p.Client_().Call(ctx, "UpdateRelation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *NetworkServiceClient) DeleteRelation(ctx context.Context, req *DeleteRelationRequest) (r *DeleteRelationResponse, err error) {
+	var _args NetworkServiceDeleteRelationArgs
+	_args.Req = req
+	var _result NetworkServiceDeleteRelationResult
+	if err = p.Client_().Call(ctx, "DeleteRelation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *NetworkServiceClient) BatchCreateRelations(ctx context.Context, req *BatchCreateRelationsRequest) (r *BatchCreateRelationsResponse, err error) {
+	var _args NetworkServiceBatchCreateRelationsArgs
+	_args.Req = req
+	var _result NetworkServiceBatchCreateRelationsResult
+	if err = p.Client_().Call(ctx, "BatchCreateRelations", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *NetworkServiceClient) GetNodeRelations(ctx context.Context, req *GetNodeRelationsRequest) (r *GetNodeRelationsResponse, err error) {
+	var _args NetworkServiceGetNodeRelationsArgs
+	_args.Req = req
+	var _result NetworkServiceGetNodeRelationsResult
+	if err = p.Client_().Call(ctx, "GetNodeRelations", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *NetworkServiceClient) GetRecommendations(ctx context.Context, req *GetRecommendationsRequest) (r *GetRecommendationsResponse, err error) {
+	var _args NetworkServiceGetRecommendationsArgs
+	_args.Req = req
+	var _result NetworkServiceGetRecommendationsResult
+	if err = p.Client_().Call(ctx, "GetRecommendations", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *NetworkServiceClient) ListTypes(ctx context.Context, req *ListTypesRequest) (r *ListTypesResponse, err error) {
+	var _args NetworkServiceListTypesArgs
+	_args.Req = req
+	var _result NetworkServiceListTypesResult
+	if err = p.Client_().Call(ctx, "ListTypes", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *NetworkServiceClient) CreateNodeType(ctx context.Context, req *CreateNodeTypeRequest) (r *CreateNodeTypeResponse, err error) {
+	var _args NetworkServiceCreateNodeTypeArgs
+	_args.Req = req
+	var _result NetworkServiceCreateNodeTypeResult
+	if err = p.Client_().Call(ctx, "CreateNodeType", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *NetworkServiceClient) SaveRelationType(ctx context.Context, req *SaveRelationTypeRequest) (r *SaveRelationTypeResponse, err error) {
+	var _args NetworkServiceSaveRelationTypeArgs
+	_args.Req = req
+	var _result NetworkServiceSaveRelationTypeResult
+	if err = p.Client_().Call(ctx, "SaveRelationType", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *NetworkServiceClient) UpdateNodeType(ctx context.Context, req *UpdateNodeTypeRequest) (r *UpdateNodeTypeResponse, err error) {
+	var _args NetworkServiceUpdateNodeTypeArgs
+	_args.Req = req
+	var _result NetworkServiceUpdateNodeTypeResult
+	if err = p.Client_().Call(ctx, "UpdateNodeType", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type NetworkServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      NetworkService
+}
+
+func (p *NetworkServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *NetworkServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *NetworkServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewNetworkServiceProcessor(handler NetworkService) *NetworkServiceProcessor {
+	self := &NetworkServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("GetNetwork", &networkServiceProcessorGetNetwork{handler: handler})
+	self.AddToProcessorMap("ExportNetwork", &networkServiceProcessorExportNetwork{handler: handler})
+	self.AddToProcessorMap("GetPath", &networkServiceProcessorGetPath{handler: handler})
+	self.AddToProcessorMap("SearchNodes", &networkServiceProcessorSearchNodes{handler: handler})
+	self.AddToProcessorMap("FulltextSearchNodes", &networkServiceProcessorFulltextSearchNodes{handler: handler})
+	self.AddToProcessorMap("CreateNode", &networkServiceProcessorCreateNode{handler: handler})
+	self.AddToProcessorMap("GetNode", &networkServiceProcessorGetNode{handler: handler})
+	self.AddToProcessorMap("UpdateNode", &networkServiceProcessorUpdateNode{handler: handler})
+	self.AddToProcessorMap("DeleteNode", &networkServiceProcessorDeleteNode{handler: handler})
+	self.AddToProcessorMap("BatchCreateNodes", &networkServiceProcessorBatchCreateNodes{handler: handler})
+	self.AddToProcessorMap("CreateRelation", &networkServiceProcessorCreateRelation{handler: handler})
+	self.AddToProcessorMap("GetRelation", &networkServiceProcessorGetRelation{handler: handler})
+	self.AddToProcessorMap("UpdateRelation", &networkServiceProcessorUpdateRelation{handler: handler})
+	self.AddToProcessorMap("DeleteRelation", &networkServiceProcessorDeleteRelation{handler: handler})
+	self.AddToProcessorMap("BatchCreateRelations", &networkServiceProcessorBatchCreateRelations{handler: handler})
+	self.AddToProcessorMap("GetNodeRelations", &networkServiceProcessorGetNodeRelations{handler: handler})
+	self.AddToProcessorMap("GetRecommendations", &networkServiceProcessorGetRecommendations{handler: handler})
+	self.AddToProcessorMap("ListTypes", &networkServiceProcessorListTypes{handler: handler})
+	self.AddToProcessorMap("CreateNodeType", &networkServiceProcessorCreateNodeType{handler: handler})
+	self.AddToProcessorMap("SaveRelationType", &networkServiceProcessorSaveRelationType{handler: handler})
+	self.AddToProcessorMap("UpdateNodeType", &networkServiceProcessorUpdateNodeType{handler: handler})
+	return self
+}
+func (p *NetworkServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type networkServiceProcessorGetNetwork struct {
+	handler NetworkService
+}
+
+func (p *networkServiceProcessorGetNetwork) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NetworkServiceGetNetworkArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetNetwork", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := NetworkServiceGetNetworkResult{}
+	var retval *GetNetworkResponse
+	if retval, err2 = p.handler.GetNetwork(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetNetwork: "+err2.Error())
+		oprot.WriteMessageBegin("GetNetwork", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetNetwork", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type networkServiceProcessorExportNetwork struct {
+	handler NetworkService
+}
+
+func (p *networkServiceProcessorExportNetwork) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NetworkServiceExportNetworkArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ExportNetwork", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := NetworkServiceExportNetworkResult{}
+	var retval *ExportNetworkResponse
+	if retval, err2 = p.handler.ExportNetwork(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ExportNetwork: "+err2.Error())
+		oprot.WriteMessageBegin("ExportNetwork", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ExportNetwork", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type networkServiceProcessorGetPath struct {
+	handler NetworkService
+}
+
+func (p *networkServiceProcessorGetPath) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NetworkServiceGetPathArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetPath", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := NetworkServiceGetPathResult{}
+	var retval *GetPathResponse
+	if retval, err2 = p.handler.GetPath(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetPath: "+err2.Error())
+		oprot.WriteMessageBegin("GetPath", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetPath", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type networkServiceProcessorSearchNodes struct {
+	handler NetworkService
+}
+
+func (p *networkServiceProcessorSearchNodes) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NetworkServiceSearchNodesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SearchNodes", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := NetworkServiceSearchNodesResult{}
+	var retval *SearchNodesResponse
+	if retval, err2 = p.handler.SearchNodes(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SearchNodes: "+err2.Error())
+		oprot.WriteMessageBegin("SearchNodes", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SearchNodes", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type networkServiceProcessorFulltextSearchNodes struct {
+	handler NetworkService
+}
+
+func (p *networkServiceProcessorFulltextSearchNodes) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NetworkServiceFulltextSearchNodesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("FulltextSearchNodes", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := NetworkServiceFulltextSearchNodesResult{}
+	var retval *FulltextSearchNodesResponse
+	if retval, err2 = p.handler.FulltextSearchNodes(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing FulltextSearchNodes: "+err2.Error())
+		oprot.WriteMessageBegin("FulltextSearchNodes", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("FulltextSearchNodes", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type networkServiceProcessorCreateNode struct {
+	handler NetworkService
+}
+
+func (p *networkServiceProcessorCreateNode) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NetworkServiceCreateNodeArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateNode", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := NetworkServiceCreateNodeResult{}
+	var retval *CreateNodeResponse
+	if retval, err2 = p.handler.CreateNode(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateNode: "+err2.Error())
+		oprot.WriteMessageBegin("CreateNode", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateNode", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type networkServiceProcessorGetNode struct {
+	handler NetworkService
+}
+
+func (p *networkServiceProcessorGetNode) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NetworkServiceGetNodeArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetNode", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := NetworkServiceGetNodeResult{}
+	var retval *GetNodeResponse
+	if retval, err2 = p.handler.GetNode(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetNode: "+err2.Error())
+		oprot.WriteMessageBegin("GetNode", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetNode", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type networkServiceProcessorUpdateNode struct {
+	handler NetworkService
+}
+
+func (p *networkServiceProcessorUpdateNode) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NetworkServiceUpdateNodeArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateNode", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := NetworkServiceUpdateNodeResult{}
+	var retval *UpdateNodeResponse
+	if retval, err2 = p.handler.UpdateNode(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateNode: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateNode", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateNode", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type networkServiceProcessorDeleteNode struct {
+	handler NetworkService
+}
+
+func (p *networkServiceProcessorDeleteNode) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NetworkServiceDeleteNodeArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeleteNode", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := NetworkServiceDeleteNodeResult{}
+	var retval *DeleteNodeResponse
+	if retval, err2 = p.handler.DeleteNode(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteNode: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteNode", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeleteNode", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type networkServiceProcessorBatchCreateNodes struct {
+	handler NetworkService
+}
+
+func (p *networkServiceProcessorBatchCreateNodes) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NetworkServiceBatchCreateNodesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BatchCreateNodes", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := NetworkServiceBatchCreateNodesResult{}
+	var retval *BatchCreateNodesResponse
+	if retval, err2 = p.handler.BatchCreateNodes(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchCreateNodes: "+err2.Error())
+		oprot.WriteMessageBegin("BatchCreateNodes", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("BatchCreateNodes", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type networkServiceProcessorCreateRelation struct {
+	handler NetworkService
+}
+
+func (p *networkServiceProcessorCreateRelation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NetworkServiceCreateRelationArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateRelation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := NetworkServiceCreateRelationResult{}
+	var retval *CreateRelationResponse
+	if retval, err2 = p.handler.CreateRelation(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateRelation: "+err2.Error())
+		oprot.WriteMessageBegin("CreateRelation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateRelation", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type networkServiceProcessorGetRelation struct {
+	handler NetworkService
+}
+
+func (p *networkServiceProcessorGetRelation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NetworkServiceGetRelationArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetRelation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := NetworkServiceGetRelationResult{}
+	var retval *GetRelationResponse
+	if retval, err2 = p.handler.GetRelation(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetRelation: "+err2.Error())
+		oprot.WriteMessageBegin("GetRelation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetRelation", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type networkServiceProcessorUpdateRelation struct {
+	handler NetworkService
+}
+
+func (p *networkServiceProcessorUpdateRelation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NetworkServiceUpdateRelationArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateRelation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := NetworkServiceUpdateRelationResult{}
+	var retval *UpdateRelationResponse
+	if retval, err2 = p.handler.UpdateRelation(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateRelation: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateRelation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateRelation", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type networkServiceProcessorDeleteRelation struct {
+	handler NetworkService
+}
+
+func (p *networkServiceProcessorDeleteRelation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NetworkServiceDeleteRelationArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeleteRelation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := NetworkServiceDeleteRelationResult{}
+	var retval *DeleteRelationResponse
+	if retval, err2 = p.handler.DeleteRelation(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteRelation: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteRelation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeleteRelation", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type networkServiceProcessorBatchCreateRelations struct {
+	handler NetworkService
+}
+
+func (p *networkServiceProcessorBatchCreateRelations) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NetworkServiceBatchCreateRelationsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BatchCreateRelations", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := NetworkServiceBatchCreateRelationsResult{}
+	var retval *BatchCreateRelationsResponse
+	if retval, err2 = p.handler.BatchCreateRelations(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchCreateRelations: "+err2.Error())
+		oprot.WriteMessageBegin("BatchCreateRelations", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("BatchCreateRelations", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type networkServiceProcessorGetNodeRelations struct {
+	handler NetworkService
+}
+
+func (p *networkServiceProcessorGetNodeRelations) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NetworkServiceGetNodeRelationsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetNodeRelations", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := NetworkServiceGetNodeRelationsResult{}
+	var retval *GetNodeRelationsResponse
+	if retval, err2 = p.handler.GetNodeRelations(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetNodeRelations: "+err2.Error())
+		oprot.WriteMessageBegin("GetNodeRelations", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetNodeRelations", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type networkServiceProcessorGetRecommendations struct {
+	handler NetworkService
+}
+
+func (p *networkServiceProcessorGetRecommendations) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NetworkServiceGetRecommendationsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetRecommendations", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := NetworkServiceGetRecommendationsResult{}
+	var retval *GetRecommendationsResponse
+	if retval, err2 = p.handler.GetRecommendations(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetRecommendations: "+err2.Error())
+		oprot.WriteMessageBegin("GetRecommendations", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetRecommendations", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type networkServiceProcessorListTypes struct {
+	handler NetworkService
+}
+
+func (p *networkServiceProcessorListTypes) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NetworkServiceListTypesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListTypes", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := NetworkServiceListTypesResult{}
+	var retval *ListTypesResponse
+	if retval, err2 = p.handler.ListTypes(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListTypes: "+err2.Error())
+		oprot.WriteMessageBegin("ListTypes", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListTypes", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type networkServiceProcessorCreateNodeType struct {
+	handler NetworkService
+}
+
+func (p *networkServiceProcessorCreateNodeType) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NetworkServiceCreateNodeTypeArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateNodeType", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := NetworkServiceCreateNodeTypeResult{}
+	var retval *CreateNodeTypeResponse
+	if retval, err2 = p.handler.CreateNodeType(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateNodeType: "+err2.Error())
+		oprot.WriteMessageBegin("CreateNodeType", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateNodeType", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type networkServiceProcessorSaveRelationType struct {
+	handler NetworkService
+}
+
+func (p *networkServiceProcessorSaveRelationType) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NetworkServiceSaveRelationTypeArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SaveRelationType", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := NetworkServiceSaveRelationTypeResult{}
+	var retval *SaveRelationTypeResponse
+	if retval, err2 = p.handler.SaveRelationType(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SaveRelationType: "+err2.Error())
+		oprot.WriteMessageBegin("SaveRelationType", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SaveRelationType", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type networkServiceProcessorUpdateNodeType struct {
+	handler NetworkService
+}
+
+func (p *networkServiceProcessorUpdateNodeType) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NetworkServiceUpdateNodeTypeArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateNodeType", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
 		return false, err
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := NetworkServiceUpdateNodeResult{}
-	var retval *UpdateNodeResponse
-	if retval, err2 = p.handler.UpdateNode(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateNode: "+err2.Error())
-		oprot.WriteMessageBegin("UpdateNode", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := NetworkServiceUpdateNodeTypeResult{}
+	var retval *UpdateNodeTypeResponse
+	if retval, err2 = p.handler.UpdateNodeType(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateNodeType: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateNodeType", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateNodeType", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type NetworkServiceGetNetworkArgs struct {
+	Req *GetNetworkRequest `thrift:"req,1"`
+}
+
+func NewNetworkServiceGetNetworkArgs() *NetworkServiceGetNetworkArgs {
+	return &NetworkServiceGetNetworkArgs{}
+}
+
+func (p *NetworkServiceGetNetworkArgs) InitDefault() {
+}
+
+var NetworkServiceGetNetworkArgs_Req_DEFAULT *GetNetworkRequest
+
+func (p *NetworkServiceGetNetworkArgs) GetReq() (v *GetNetworkRequest) {
+	if !p.IsSetReq() {
+		return NetworkServiceGetNetworkArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_NetworkServiceGetNetworkArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *NetworkServiceGetNetworkArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *NetworkServiceGetNetworkArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceGetNetworkArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *NetworkServiceGetNetworkArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetNetworkRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *NetworkServiceGetNetworkArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetNetwork_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *NetworkServiceGetNetworkArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *NetworkServiceGetNetworkArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NetworkServiceGetNetworkArgs(%+v)", *p)
+
+}
+
+type NetworkServiceGetNetworkResult struct {
+	Success *GetNetworkResponse `thrift:"success,0,optional"`
+}
+
+func NewNetworkServiceGetNetworkResult() *NetworkServiceGetNetworkResult {
+	return &NetworkServiceGetNetworkResult{}
+}
+
+func (p *NetworkServiceGetNetworkResult) InitDefault() {
+}
+
+var NetworkServiceGetNetworkResult_Success_DEFAULT *GetNetworkResponse
+
+func (p *NetworkServiceGetNetworkResult) GetSuccess() (v *GetNetworkResponse) {
+	if !p.IsSetSuccess() {
+		return NetworkServiceGetNetworkResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_NetworkServiceGetNetworkResult = map[int16]string{
+	0: "success",
+}
+
+func (p *NetworkServiceGetNetworkResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *NetworkServiceGetNetworkResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceGetNetworkResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *NetworkServiceGetNetworkResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetNetworkResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *NetworkServiceGetNetworkResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetNetwork_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *NetworkServiceGetNetworkResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *NetworkServiceGetNetworkResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NetworkServiceGetNetworkResult(%+v)", *p)
+
+}
+
+type NetworkServiceExportNetworkArgs struct {
+	Req *ExportNetworkRequest `thrift:"req,1"`
+}
+
+func NewNetworkServiceExportNetworkArgs() *NetworkServiceExportNetworkArgs {
+	return &NetworkServiceExportNetworkArgs{}
+}
+
+func (p *NetworkServiceExportNetworkArgs) InitDefault() {
+}
+
+var NetworkServiceExportNetworkArgs_Req_DEFAULT *ExportNetworkRequest
+
+func (p *NetworkServiceExportNetworkArgs) GetReq() (v *ExportNetworkRequest) {
+	if !p.IsSetReq() {
+		return NetworkServiceExportNetworkArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_NetworkServiceExportNetworkArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *NetworkServiceExportNetworkArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *NetworkServiceExportNetworkArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceExportNetworkArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *NetworkServiceExportNetworkArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewExportNetworkRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *NetworkServiceExportNetworkArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExportNetwork_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *NetworkServiceExportNetworkArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *NetworkServiceExportNetworkArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NetworkServiceExportNetworkArgs(%+v)", *p)
+
+}
+
+type NetworkServiceExportNetworkResult struct {
+	Success *ExportNetworkResponse `thrift:"success,0,optional"`
+}
+
+func NewNetworkServiceExportNetworkResult() *NetworkServiceExportNetworkResult {
+	return &NetworkServiceExportNetworkResult{}
+}
+
+func (p *NetworkServiceExportNetworkResult) InitDefault() {
+}
+
+var NetworkServiceExportNetworkResult_Success_DEFAULT *ExportNetworkResponse
+
+func (p *NetworkServiceExportNetworkResult) GetSuccess() (v *ExportNetworkResponse) {
+	if !p.IsSetSuccess() {
+		return NetworkServiceExportNetworkResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_NetworkServiceExportNetworkResult = map[int16]string{
+	0: "success",
+}
+
+func (p *NetworkServiceExportNetworkResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *NetworkServiceExportNetworkResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceExportNetworkResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *NetworkServiceExportNetworkResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewExportNetworkResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *NetworkServiceExportNetworkResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExportNetwork_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *NetworkServiceExportNetworkResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *NetworkServiceExportNetworkResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NetworkServiceExportNetworkResult(%+v)", *p)
+
+}
+
+type NetworkServiceGetPathArgs struct {
+	Req *GetPathRequest `thrift:"req,1"`
+}
+
+func NewNetworkServiceGetPathArgs() *NetworkServiceGetPathArgs {
+	return &NetworkServiceGetPathArgs{}
+}
+
+func (p *NetworkServiceGetPathArgs) InitDefault() {
+}
+
+var NetworkServiceGetPathArgs_Req_DEFAULT *GetPathRequest
+
+func (p *NetworkServiceGetPathArgs) GetReq() (v *GetPathRequest) {
+	if !p.IsSetReq() {
+		return NetworkServiceGetPathArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_NetworkServiceGetPathArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *NetworkServiceGetPathArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *NetworkServiceGetPathArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceGetPathArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *NetworkServiceGetPathArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetPathRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *NetworkServiceGetPathArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPath_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *NetworkServiceGetPathArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *NetworkServiceGetPathArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NetworkServiceGetPathArgs(%+v)", *p)
+
+}
+
+type NetworkServiceGetPathResult struct {
+	Success *GetPathResponse `thrift:"success,0,optional"`
+}
+
+func NewNetworkServiceGetPathResult() *NetworkServiceGetPathResult {
+	return &NetworkServiceGetPathResult{}
+}
+
+func (p *NetworkServiceGetPathResult) InitDefault() {
+}
+
+var NetworkServiceGetPathResult_Success_DEFAULT *GetPathResponse
+
+func (p *NetworkServiceGetPathResult) GetSuccess() (v *GetPathResponse) {
+	if !p.IsSetSuccess() {
+		return NetworkServiceGetPathResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_NetworkServiceGetPathResult = map[int16]string{
+	0: "success",
+}
+
+func (p *NetworkServiceGetPathResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *NetworkServiceGetPathResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceGetPathResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *NetworkServiceGetPathResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetPathResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *NetworkServiceGetPathResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPath_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *NetworkServiceGetPathResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *NetworkServiceGetPathResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NetworkServiceGetPathResult(%+v)", *p)
+
+}
+
+type NetworkServiceSearchNodesArgs struct {
+	Req *SearchNodesRequest `thrift:"req,1"`
+}
+
+func NewNetworkServiceSearchNodesArgs() *NetworkServiceSearchNodesArgs {
+	return &NetworkServiceSearchNodesArgs{}
+}
+
+func (p *NetworkServiceSearchNodesArgs) InitDefault() {
+}
+
+var NetworkServiceSearchNodesArgs_Req_DEFAULT *SearchNodesRequest
+
+func (p *NetworkServiceSearchNodesArgs) GetReq() (v *SearchNodesRequest) {
+	if !p.IsSetReq() {
+		return NetworkServiceSearchNodesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_NetworkServiceSearchNodesArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *NetworkServiceSearchNodesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *NetworkServiceSearchNodesArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceSearchNodesArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *NetworkServiceSearchNodesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSearchNodesRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *NetworkServiceSearchNodesArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SearchNodes_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *NetworkServiceSearchNodesArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *NetworkServiceSearchNodesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NetworkServiceSearchNodesArgs(%+v)", *p)
+
+}
+
+type NetworkServiceSearchNodesResult struct {
+	Success *SearchNodesResponse `thrift:"success,0,optional"`
+}
+
+func NewNetworkServiceSearchNodesResult() *NetworkServiceSearchNodesResult {
+	return &NetworkServiceSearchNodesResult{}
+}
+
+func (p *NetworkServiceSearchNodesResult) InitDefault() {
+}
+
+var NetworkServiceSearchNodesResult_Success_DEFAULT *SearchNodesResponse
+
+func (p *NetworkServiceSearchNodesResult) GetSuccess() (v *SearchNodesResponse) {
+	if !p.IsSetSuccess() {
+		return NetworkServiceSearchNodesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_NetworkServiceSearchNodesResult = map[int16]string{
+	0: "success",
+}
+
+func (p *NetworkServiceSearchNodesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *NetworkServiceSearchNodesResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceSearchNodesResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *NetworkServiceSearchNodesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSearchNodesResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *NetworkServiceSearchNodesResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SearchNodes_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *NetworkServiceSearchNodesResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *NetworkServiceSearchNodesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NetworkServiceSearchNodesResult(%+v)", *p)
+
+}
+
+type NetworkServiceCreateNodeArgs struct {
+	Req *CreateNodeRequest `thrift:"req,1"`
+}
+
+func NewNetworkServiceCreateNodeArgs() *NetworkServiceCreateNodeArgs {
+	return &NetworkServiceCreateNodeArgs{}
+}
+
+func (p *NetworkServiceCreateNodeArgs) InitDefault() {
+}
+
+var NetworkServiceCreateNodeArgs_Req_DEFAULT *CreateNodeRequest
+
+func (p *NetworkServiceCreateNodeArgs) GetReq() (v *CreateNodeRequest) {
+	if !p.IsSetReq() {
+		return NetworkServiceCreateNodeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_NetworkServiceCreateNodeArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *NetworkServiceCreateNodeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *NetworkServiceCreateNodeArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceCreateNodeArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *NetworkServiceCreateNodeArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreateNodeRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *NetworkServiceCreateNodeArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateNode_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *NetworkServiceCreateNodeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *NetworkServiceCreateNodeArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NetworkServiceCreateNodeArgs(%+v)", *p)
+
+}
+
+type NetworkServiceCreateNodeResult struct {
+	Success *CreateNodeResponse `thrift:"success,0,optional"`
+}
+
+func NewNetworkServiceCreateNodeResult() *NetworkServiceCreateNodeResult {
+	return &NetworkServiceCreateNodeResult{}
+}
+
+func (p *NetworkServiceCreateNodeResult) InitDefault() {
+}
+
+var NetworkServiceCreateNodeResult_Success_DEFAULT *CreateNodeResponse
+
+func (p *NetworkServiceCreateNodeResult) GetSuccess() (v *CreateNodeResponse) {
+	if !p.IsSetSuccess() {
+		return NetworkServiceCreateNodeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_NetworkServiceCreateNodeResult = map[int16]string{
+	0: "success",
+}
+
+func (p *NetworkServiceCreateNodeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *NetworkServiceCreateNodeResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceCreateNodeResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *NetworkServiceCreateNodeResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreateNodeResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *NetworkServiceCreateNodeResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateNode_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *NetworkServiceCreateNodeResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *NetworkServiceCreateNodeResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NetworkServiceCreateNodeResult(%+v)", *p)
+
+}
+
+type NetworkServiceGetNodeArgs struct {
+	Req *GetNodeRequest `thrift:"req,1"`
+}
+
+func NewNetworkServiceGetNodeArgs() *NetworkServiceGetNodeArgs {
+	return &NetworkServiceGetNodeArgs{}
+}
+
+func (p *NetworkServiceGetNodeArgs) InitDefault() {
+}
+
+var NetworkServiceGetNodeArgs_Req_DEFAULT *GetNodeRequest
+
+func (p *NetworkServiceGetNodeArgs) GetReq() (v *GetNodeRequest) {
+	if !p.IsSetReq() {
+		return NetworkServiceGetNodeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_NetworkServiceGetNodeArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *NetworkServiceGetNodeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *NetworkServiceGetNodeArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceGetNodeArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *NetworkServiceGetNodeArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetNodeRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *NetworkServiceGetNodeArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetNode_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *NetworkServiceGetNodeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *NetworkServiceGetNodeArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NetworkServiceGetNodeArgs(%+v)", *p)
+
+}
+
+type NetworkServiceGetNodeResult struct {
+	Success *GetNodeResponse `thrift:"success,0,optional"`
+}
+
+func NewNetworkServiceGetNodeResult() *NetworkServiceGetNodeResult {
+	return &NetworkServiceGetNodeResult{}
+}
+
+func (p *NetworkServiceGetNodeResult) InitDefault() {
+}
+
+var NetworkServiceGetNodeResult_Success_DEFAULT *GetNodeResponse
+
+func (p *NetworkServiceGetNodeResult) GetSuccess() (v *GetNodeResponse) {
+	if !p.IsSetSuccess() {
+		return NetworkServiceGetNodeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_NetworkServiceGetNodeResult = map[int16]string{
+	0: "success",
+}
+
+func (p *NetworkServiceGetNodeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *NetworkServiceGetNodeResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceGetNodeResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *NetworkServiceGetNodeResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetNodeResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *NetworkServiceGetNodeResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetNode_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *NetworkServiceGetNodeResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *NetworkServiceGetNodeResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NetworkServiceGetNodeResult(%+v)", *p)
+
+}
+
+type NetworkServiceUpdateNodeArgs struct {
+	Req *UpdateNodeRequest `thrift:"req,1"`
+}
+
+func NewNetworkServiceUpdateNodeArgs() *NetworkServiceUpdateNodeArgs {
+	return &NetworkServiceUpdateNodeArgs{}
+}
+
+func (p *NetworkServiceUpdateNodeArgs) InitDefault() {
+}
+
+var NetworkServiceUpdateNodeArgs_Req_DEFAULT *UpdateNodeRequest
+
+func (p *NetworkServiceUpdateNodeArgs) GetReq() (v *UpdateNodeRequest) {
+	if !p.IsSetReq() {
+		return NetworkServiceUpdateNodeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_NetworkServiceUpdateNodeArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *NetworkServiceUpdateNodeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *NetworkServiceUpdateNodeArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceUpdateNodeArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *NetworkServiceUpdateNodeArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateNodeRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *NetworkServiceUpdateNodeArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateNode_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *NetworkServiceUpdateNodeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *NetworkServiceUpdateNodeArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NetworkServiceUpdateNodeArgs(%+v)", *p)
+
+}
+
+type NetworkServiceUpdateNodeResult struct {
+	Success *UpdateNodeResponse `thrift:"success,0,optional"`
+}
+
+func NewNetworkServiceUpdateNodeResult() *NetworkServiceUpdateNodeResult {
+	return &NetworkServiceUpdateNodeResult{}
+}
+
+func (p *NetworkServiceUpdateNodeResult) InitDefault() {
+}
+
+var NetworkServiceUpdateNodeResult_Success_DEFAULT *UpdateNodeResponse
+
+func (p *NetworkServiceUpdateNodeResult) GetSuccess() (v *UpdateNodeResponse) {
+	if !p.IsSetSuccess() {
+		return NetworkServiceUpdateNodeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_NetworkServiceUpdateNodeResult = map[int16]string{
+	0: "success",
+}
+
+func (p *NetworkServiceUpdateNodeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *NetworkServiceUpdateNodeResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceUpdateNodeResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *NetworkServiceUpdateNodeResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateNodeResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *NetworkServiceUpdateNodeResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateNode_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *NetworkServiceUpdateNodeResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *NetworkServiceUpdateNodeResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NetworkServiceUpdateNodeResult(%+v)", *p)
+
+}
+
+type NetworkServiceDeleteNodeArgs struct {
+	Req *DeleteNodeRequest `thrift:"req,1"`
+}
+
+func NewNetworkServiceDeleteNodeArgs() *NetworkServiceDeleteNodeArgs {
+	return &NetworkServiceDeleteNodeArgs{}
+}
+
+func (p *NetworkServiceDeleteNodeArgs) InitDefault() {
+}
+
+var NetworkServiceDeleteNodeArgs_Req_DEFAULT *DeleteNodeRequest
+
+func (p *NetworkServiceDeleteNodeArgs) GetReq() (v *DeleteNodeRequest) {
+	if !p.IsSetReq() {
+		return NetworkServiceDeleteNodeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_NetworkServiceDeleteNodeArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *NetworkServiceDeleteNodeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *NetworkServiceDeleteNodeArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceDeleteNodeArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *NetworkServiceDeleteNodeArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteNodeRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *NetworkServiceDeleteNodeArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteNode_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *NetworkServiceDeleteNodeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *NetworkServiceDeleteNodeArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err2 = oprot.WriteMessageBegin("UpdateNode", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	return fmt.Sprintf("NetworkServiceDeleteNodeArgs(%+v)", *p)
+
+}
+
+type NetworkServiceDeleteNodeResult struct {
+	Success *DeleteNodeResponse `thrift:"success,0,optional"`
+}
+
+func NewNetworkServiceDeleteNodeResult() *NetworkServiceDeleteNodeResult {
+	return &NetworkServiceDeleteNodeResult{}
+}
+
+func (p *NetworkServiceDeleteNodeResult) InitDefault() {
+}
+
+var NetworkServiceDeleteNodeResult_Success_DEFAULT *DeleteNodeResponse
+
+func (p *NetworkServiceDeleteNodeResult) GetSuccess() (v *DeleteNodeResponse) {
+	if !p.IsSetSuccess() {
+		return NetworkServiceDeleteNodeResult_Success_DEFAULT
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return p.Success
+}
+
+var fieldIDToName_NetworkServiceDeleteNodeResult = map[int16]string{
+	0: "success",
+}
+
+func (p *NetworkServiceDeleteNodeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *NetworkServiceDeleteNodeResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	if err != nil {
-		return
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceDeleteNodeResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *NetworkServiceDeleteNodeResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteNodeResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
-	return true, err
+	p.Success = _field
+	return nil
 }
 
-type networkServiceProcessorDeleteNode struct {
-	handler NetworkService
+func (p *NetworkServiceDeleteNodeResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteNode_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *NetworkServiceDeleteNodeResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *NetworkServiceDeleteNodeResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NetworkServiceDeleteNodeResult(%+v)", *p)
+
+}
+
+type NetworkServiceBatchCreateNodesArgs struct {
+	Req *BatchCreateNodesRequest `thrift:"req,1"`
+}
+
+func NewNetworkServiceBatchCreateNodesArgs() *NetworkServiceBatchCreateNodesArgs {
+	return &NetworkServiceBatchCreateNodesArgs{}
+}
+
+func (p *NetworkServiceBatchCreateNodesArgs) InitDefault() {
+}
+
+var NetworkServiceBatchCreateNodesArgs_Req_DEFAULT *BatchCreateNodesRequest
+
+func (p *NetworkServiceBatchCreateNodesArgs) GetReq() (v *BatchCreateNodesRequest) {
+	if !p.IsSetReq() {
+		return NetworkServiceBatchCreateNodesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_NetworkServiceBatchCreateNodesArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *NetworkServiceBatchCreateNodesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *NetworkServiceBatchCreateNodesArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceBatchCreateNodesArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *NetworkServiceBatchCreateNodesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewBatchCreateNodesRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
 }
 
-func (p *networkServiceProcessorDeleteNode) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NetworkServiceDeleteNodeArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DeleteNode", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *NetworkServiceBatchCreateNodesArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchCreateNodes_args"); err != nil {
+		goto WriteStructBeginError
 	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := NetworkServiceDeleteNodeResult{}
-	var retval *DeleteNodeResponse
-	if retval, err2 = p.handler.DeleteNode(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteNode: "+err2.Error())
-		oprot.WriteMessageBegin("DeleteNode", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.WriteMessageBegin("DeleteNode", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *NetworkServiceBatchCreateNodesArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return true, err
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type networkServiceProcessorCreateRelation struct {
-	handler NetworkService
+func (p *NetworkServiceBatchCreateNodesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NetworkServiceBatchCreateNodesArgs(%+v)", *p)
+
 }
 
-func (p *networkServiceProcessorCreateRelation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NetworkServiceCreateRelationArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CreateRelation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+type NetworkServiceBatchCreateNodesResult struct {
+	Success *BatchCreateNodesResponse `thrift:"success,0,optional"`
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := NetworkServiceCreateRelationResult{}
-	var retval *CreateRelationResponse
-	if retval, err2 = p.handler.CreateRelation(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateRelation: "+err2.Error())
-		oprot.WriteMessageBegin("CreateRelation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("CreateRelation", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func NewNetworkServiceBatchCreateNodesResult() *NetworkServiceBatchCreateNodesResult {
+	return &NetworkServiceBatchCreateNodesResult{}
 }
 
-type networkServiceProcessorGetRelation struct {
-	handler NetworkService
+func (p *NetworkServiceBatchCreateNodesResult) InitDefault() {
 }
 
-func (p *networkServiceProcessorGetRelation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NetworkServiceGetRelationArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetRelation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var NetworkServiceBatchCreateNodesResult_Success_DEFAULT *BatchCreateNodesResponse
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := NetworkServiceGetRelationResult{}
-	var retval *GetRelationResponse
-	if retval, err2 = p.handler.GetRelation(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetRelation: "+err2.Error())
-		oprot.WriteMessageBegin("GetRelation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetRelation", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *NetworkServiceBatchCreateNodesResult) GetSuccess() (v *BatchCreateNodesResponse) {
+	if !p.IsSetSuccess() {
+		return NetworkServiceBatchCreateNodesResult_Success_DEFAULT
 	}
-	return true, err
+	return p.Success
 }
 
-type networkServiceProcessorUpdateRelation struct {
-	handler NetworkService
+var fieldIDToName_NetworkServiceBatchCreateNodesResult = map[int16]string{
+	0: "success",
 }
 
-func (p *networkServiceProcessorUpdateRelation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NetworkServiceUpdateRelationArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdateRelation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *NetworkServiceBatchCreateNodesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := NetworkServiceUpdateRelationResult{}
-	var retval *UpdateRelationResponse
-	if retval, err2 = p.handler.UpdateRelation(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateRelation: "+err2.Error())
-		oprot.WriteMessageBegin("UpdateRelation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UpdateRelation", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *NetworkServiceBatchCreateNodesResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type networkServiceProcessorDeleteRelation struct {
-	handler NetworkService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceBatchCreateNodesResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *networkServiceProcessorDeleteRelation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NetworkServiceDeleteRelationArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DeleteRelation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *NetworkServiceBatchCreateNodesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewBatchCreateNodesResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := NetworkServiceDeleteRelationResult{}
-	var retval *DeleteRelationResponse
-	if retval, err2 = p.handler.DeleteRelation(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteRelation: "+err2.Error())
-		oprot.WriteMessageBegin("DeleteRelation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("DeleteRelation", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *NetworkServiceBatchCreateNodesResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchCreateNodes_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type networkServiceProcessorGetNodeRelations struct {
-	handler NetworkService
+func (p *NetworkServiceBatchCreateNodesResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *networkServiceProcessorGetNodeRelations) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NetworkServiceGetNodeRelationsArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetNodeRelations", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *NetworkServiceBatchCreateNodesResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("NetworkServiceBatchCreateNodesResult(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := NetworkServiceGetNodeRelationsResult{}
-	var retval *GetNodeRelationsResponse
-	if retval, err2 = p.handler.GetNodeRelations(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetNodeRelations: "+err2.Error())
-		oprot.WriteMessageBegin("GetNodeRelations", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetNodeRelations", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
 }
 
-type NetworkServiceGetNetworkArgs struct {
-	Req *GetNetworkRequest `thrift:"req,1"`
+type NetworkServiceCreateRelationArgs struct {
+	Req *CreateRelationRequest `thrift:"req,1"`
 }
 
-func NewNetworkServiceGetNetworkArgs() *NetworkServiceGetNetworkArgs {
-	return &NetworkServiceGetNetworkArgs{}
+func NewNetworkServiceCreateRelationArgs() *NetworkServiceCreateRelationArgs {
+	return &NetworkServiceCreateRelationArgs{}
 }
 
-func (p *NetworkServiceGetNetworkArgs) InitDefault() {
+func (p *NetworkServiceCreateRelationArgs) InitDefault() {
 }
 
-var NetworkServiceGetNetworkArgs_Req_DEFAULT *GetNetworkRequest
+var NetworkServiceCreateRelationArgs_Req_DEFAULT *CreateRelationRequest
 
-func (p *NetworkServiceGetNetworkArgs) GetReq() (v *GetNetworkRequest) {
+func (p *NetworkServiceCreateRelationArgs) GetReq() (v *CreateRelationRequest) {
 	if !p.IsSetReq() {
-		return NetworkServiceGetNetworkArgs_Req_DEFAULT
+		return NetworkServiceCreateRelationArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_NetworkServiceGetNetworkArgs = map[int16]string{
+var fieldIDToName_NetworkServiceCreateRelationArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *NetworkServiceGetNetworkArgs) IsSetReq() bool {
+func (p *NetworkServiceCreateRelationArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NetworkServiceGetNetworkArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceCreateRelationArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -8420,7 +25886,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceGetNetworkArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceCreateRelationArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8430,8 +25896,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceGetNetworkArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetNetworkRequest()
+func (p *NetworkServiceCreateRelationArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreateRelationRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8439,9 +25905,9 @@ func (p *NetworkServiceGetNetworkArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *NetworkServiceGetNetworkArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceCreateRelationArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetNetwork_args"); err != nil {
+	if err = oprot.WriteStructBegin("CreateRelation_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8467,7 +25933,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceGetNetworkArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceCreateRelationArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8484,43 +25950,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *NetworkServiceGetNetworkArgs) String() string {
+func (p *NetworkServiceCreateRelationArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceGetNetworkArgs(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceCreateRelationArgs(%+v)", *p)
 
 }
 
-type NetworkServiceGetNetworkResult struct {
-	Success *GetNetworkResponse `thrift:"success,0,optional"`
+type NetworkServiceCreateRelationResult struct {
+	Success *CreateRelationResponse `thrift:"success,0,optional"`
 }
 
-func NewNetworkServiceGetNetworkResult() *NetworkServiceGetNetworkResult {
-	return &NetworkServiceGetNetworkResult{}
+func NewNetworkServiceCreateRelationResult() *NetworkServiceCreateRelationResult {
+	return &NetworkServiceCreateRelationResult{}
 }
 
-func (p *NetworkServiceGetNetworkResult) InitDefault() {
+func (p *NetworkServiceCreateRelationResult) InitDefault() {
 }
 
-var NetworkServiceGetNetworkResult_Success_DEFAULT *GetNetworkResponse
+var NetworkServiceCreateRelationResult_Success_DEFAULT *CreateRelationResponse
 
-func (p *NetworkServiceGetNetworkResult) GetSuccess() (v *GetNetworkResponse) {
+func (p *NetworkServiceCreateRelationResult) GetSuccess() (v *CreateRelationResponse) {
 	if !p.IsSetSuccess() {
-		return NetworkServiceGetNetworkResult_Success_DEFAULT
+		return NetworkServiceCreateRelationResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_NetworkServiceGetNetworkResult = map[int16]string{
+var fieldIDToName_NetworkServiceCreateRelationResult = map[int16]string{
 	0: "success",
 }
 
-func (p *NetworkServiceGetNetworkResult) IsSetSuccess() bool {
+func (p *NetworkServiceCreateRelationResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *NetworkServiceGetNetworkResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceCreateRelationResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -8565,7 +26031,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceGetNetworkResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceCreateRelationResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8575,8 +26041,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceGetNetworkResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetNetworkResponse()
+func (p *NetworkServiceCreateRelationResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreateRelationResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8584,9 +26050,9 @@ func (p *NetworkServiceGetNetworkResult) ReadField0(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *NetworkServiceGetNetworkResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceCreateRelationResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetNetwork_result"); err != nil {
+	if err = oprot.WriteStructBegin("CreateRelation_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8612,7 +26078,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceGetNetworkResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceCreateRelationResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8631,43 +26097,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *NetworkServiceGetNetworkResult) String() string {
+func (p *NetworkServiceCreateRelationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceGetNetworkResult(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceCreateRelationResult(%+v)", *p)
 
 }
 
-type NetworkServiceGetPathArgs struct {
-	Req *GetPathRequest `thrift:"req,1"`
+type NetworkServiceGetRelationArgs struct {
+	Req *GetRelationRequest `thrift:"req,1"`
 }
 
-func NewNetworkServiceGetPathArgs() *NetworkServiceGetPathArgs {
-	return &NetworkServiceGetPathArgs{}
+func NewNetworkServiceGetRelationArgs() *NetworkServiceGetRelationArgs {
+	return &NetworkServiceGetRelationArgs{}
 }
 
-func (p *NetworkServiceGetPathArgs) InitDefault() {
+func (p *NetworkServiceGetRelationArgs) InitDefault() {
 }
 
-var NetworkServiceGetPathArgs_Req_DEFAULT *GetPathRequest
+var NetworkServiceGetRelationArgs_Req_DEFAULT *GetRelationRequest
 
-func (p *NetworkServiceGetPathArgs) GetReq() (v *GetPathRequest) {
+func (p *NetworkServiceGetRelationArgs) GetReq() (v *GetRelationRequest) {
 	if !p.IsSetReq() {
-		return NetworkServiceGetPathArgs_Req_DEFAULT
+		return NetworkServiceGetRelationArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_NetworkServiceGetPathArgs = map[int16]string{
+var fieldIDToName_NetworkServiceGetRelationArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *NetworkServiceGetPathArgs) IsSetReq() bool {
+func (p *NetworkServiceGetRelationArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NetworkServiceGetPathArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceGetRelationArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -8712,7 +26178,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceGetPathArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceGetRelationArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8722,8 +26188,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceGetPathArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetPathRequest()
+func (p *NetworkServiceGetRelationArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetRelationRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8731,9 +26197,9 @@ func (p *NetworkServiceGetPathArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NetworkServiceGetPathArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceGetRelationArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetPath_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetRelation_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8759,7 +26225,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceGetPathArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceGetRelationArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8776,43 +26242,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *NetworkServiceGetPathArgs) String() string {
+func (p *NetworkServiceGetRelationArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceGetPathArgs(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceGetRelationArgs(%+v)", *p)
 
 }
 
-type NetworkServiceGetPathResult struct {
-	Success *GetPathResponse `thrift:"success,0,optional"`
+type NetworkServiceGetRelationResult struct {
+	Success *GetRelationResponse `thrift:"success,0,optional"`
 }
 
-func NewNetworkServiceGetPathResult() *NetworkServiceGetPathResult {
-	return &NetworkServiceGetPathResult{}
+func NewNetworkServiceGetRelationResult() *NetworkServiceGetRelationResult {
+	return &NetworkServiceGetRelationResult{}
 }
 
-func (p *NetworkServiceGetPathResult) InitDefault() {
+func (p *NetworkServiceGetRelationResult) InitDefault() {
 }
 
-var NetworkServiceGetPathResult_Success_DEFAULT *GetPathResponse
+var NetworkServiceGetRelationResult_Success_DEFAULT *GetRelationResponse
 
-func (p *NetworkServiceGetPathResult) GetSuccess() (v *GetPathResponse) {
+func (p *NetworkServiceGetRelationResult) GetSuccess() (v *GetRelationResponse) {
 	if !p.IsSetSuccess() {
-		return NetworkServiceGetPathResult_Success_DEFAULT
+		return NetworkServiceGetRelationResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_NetworkServiceGetPathResult = map[int16]string{
+var fieldIDToName_NetworkServiceGetRelationResult = map[int16]string{
 	0: "success",
 }
 
-func (p *NetworkServiceGetPathResult) IsSetSuccess() bool {
+func (p *NetworkServiceGetRelationResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *NetworkServiceGetPathResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceGetRelationResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -8857,7 +26323,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceGetPathResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceGetRelationResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8867,8 +26333,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceGetPathResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetPathResponse()
+func (p *NetworkServiceGetRelationResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetRelationResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8876,9 +26342,9 @@ func (p *NetworkServiceGetPathResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NetworkServiceGetPathResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceGetRelationResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetPath_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetRelation_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8904,7 +26370,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceGetPathResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceGetRelationResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8923,43 +26389,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *NetworkServiceGetPathResult) String() string {
+func (p *NetworkServiceGetRelationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceGetPathResult(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceGetRelationResult(%+v)", *p)
 
 }
 
-type NetworkServiceSearchNodesArgs struct {
-	Req *SearchNodesRequest `thrift:"req,1"`
+type NetworkServiceUpdateRelationArgs struct {
+	Req *UpdateRelationRequest `thrift:"req,1"`
 }
 
-func NewNetworkServiceSearchNodesArgs() *NetworkServiceSearchNodesArgs {
-	return &NetworkServiceSearchNodesArgs{}
+func NewNetworkServiceUpdateRelationArgs() *NetworkServiceUpdateRelationArgs {
+	return &NetworkServiceUpdateRelationArgs{}
 }
 
-func (p *NetworkServiceSearchNodesArgs) InitDefault() {
+func (p *NetworkServiceUpdateRelationArgs) InitDefault() {
 }
 
-var NetworkServiceSearchNodesArgs_Req_DEFAULT *SearchNodesRequest
+var NetworkServiceUpdateRelationArgs_Req_DEFAULT *UpdateRelationRequest
 
-func (p *NetworkServiceSearchNodesArgs) GetReq() (v *SearchNodesRequest) {
+func (p *NetworkServiceUpdateRelationArgs) GetReq() (v *UpdateRelationRequest) {
 	if !p.IsSetReq() {
-		return NetworkServiceSearchNodesArgs_Req_DEFAULT
+		return NetworkServiceUpdateRelationArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_NetworkServiceSearchNodesArgs = map[int16]string{
+var fieldIDToName_NetworkServiceUpdateRelationArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *NetworkServiceSearchNodesArgs) IsSetReq() bool {
+func (p *NetworkServiceUpdateRelationArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NetworkServiceSearchNodesArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceUpdateRelationArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -9004,7 +26470,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceSearchNodesArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceUpdateRelationArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9014,8 +26480,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceSearchNodesArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSearchNodesRequest()
+func (p *NetworkServiceUpdateRelationArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateRelationRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9023,9 +26489,9 @@ func (p *NetworkServiceSearchNodesArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *NetworkServiceSearchNodesArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceUpdateRelationArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SearchNodes_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateRelation_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9051,7 +26517,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceSearchNodesArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceUpdateRelationArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9068,43 +26534,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *NetworkServiceSearchNodesArgs) String() string {
+func (p *NetworkServiceUpdateRelationArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceSearchNodesArgs(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceUpdateRelationArgs(%+v)", *p)
 
 }
 
-type NetworkServiceSearchNodesResult struct {
-	Success *SearchNodesResponse `thrift:"success,0,optional"`
+type NetworkServiceUpdateRelationResult struct {
+	Success *UpdateRelationResponse `thrift:"success,0,optional"`
 }
 
-func NewNetworkServiceSearchNodesResult() *NetworkServiceSearchNodesResult {
-	return &NetworkServiceSearchNodesResult{}
+func NewNetworkServiceUpdateRelationResult() *NetworkServiceUpdateRelationResult {
+	return &NetworkServiceUpdateRelationResult{}
 }
 
-func (p *NetworkServiceSearchNodesResult) InitDefault() {
+func (p *NetworkServiceUpdateRelationResult) InitDefault() {
 }
 
-var NetworkServiceSearchNodesResult_Success_DEFAULT *SearchNodesResponse
+var NetworkServiceUpdateRelationResult_Success_DEFAULT *UpdateRelationResponse
 
-func (p *NetworkServiceSearchNodesResult) GetSuccess() (v *SearchNodesResponse) {
+func (p *NetworkServiceUpdateRelationResult) GetSuccess() (v *UpdateRelationResponse) {
 	if !p.IsSetSuccess() {
-		return NetworkServiceSearchNodesResult_Success_DEFAULT
+		return NetworkServiceUpdateRelationResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_NetworkServiceSearchNodesResult = map[int16]string{
+var fieldIDToName_NetworkServiceUpdateRelationResult = map[int16]string{
 	0: "success",
 }
 
-func (p *NetworkServiceSearchNodesResult) IsSetSuccess() bool {
+func (p *NetworkServiceUpdateRelationResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *NetworkServiceSearchNodesResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceUpdateRelationResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -9149,7 +26615,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceSearchNodesResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceUpdateRelationResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9159,8 +26625,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceSearchNodesResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSearchNodesResponse()
+func (p *NetworkServiceUpdateRelationResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateRelationResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9168,9 +26634,9 @@ func (p *NetworkServiceSearchNodesResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *NetworkServiceSearchNodesResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceUpdateRelationResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SearchNodes_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateRelation_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9196,7 +26662,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceSearchNodesResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceUpdateRelationResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9215,43 +26681,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *NetworkServiceSearchNodesResult) String() string {
+func (p *NetworkServiceUpdateRelationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceSearchNodesResult(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceUpdateRelationResult(%+v)", *p)
 
 }
 
-type NetworkServiceCreateNodeArgs struct {
-	Req *CreateNodeRequest `thrift:"req,1"`
+type NetworkServiceDeleteRelationArgs struct {
+	Req *DeleteRelationRequest `thrift:"req,1"`
 }
 
-func NewNetworkServiceCreateNodeArgs() *NetworkServiceCreateNodeArgs {
-	return &NetworkServiceCreateNodeArgs{}
+func NewNetworkServiceDeleteRelationArgs() *NetworkServiceDeleteRelationArgs {
+	return &NetworkServiceDeleteRelationArgs{}
 }
 
-func (p *NetworkServiceCreateNodeArgs) InitDefault() {
+func (p *NetworkServiceDeleteRelationArgs) InitDefault() {
 }
 
-var NetworkServiceCreateNodeArgs_Req_DEFAULT *CreateNodeRequest
+var NetworkServiceDeleteRelationArgs_Req_DEFAULT *DeleteRelationRequest
 
-func (p *NetworkServiceCreateNodeArgs) GetReq() (v *CreateNodeRequest) {
+func (p *NetworkServiceDeleteRelationArgs) GetReq() (v *DeleteRelationRequest) {
 	if !p.IsSetReq() {
-		return NetworkServiceCreateNodeArgs_Req_DEFAULT
+		return NetworkServiceDeleteRelationArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_NetworkServiceCreateNodeArgs = map[int16]string{
+var fieldIDToName_NetworkServiceDeleteRelationArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *NetworkServiceCreateNodeArgs) IsSetReq() bool {
+func (p *NetworkServiceDeleteRelationArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NetworkServiceCreateNodeArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceDeleteRelationArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -9296,7 +26762,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceCreateNodeArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceDeleteRelationArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9306,8 +26772,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceCreateNodeArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCreateNodeRequest()
+func (p *NetworkServiceDeleteRelationArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteRelationRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9315,9 +26781,9 @@ func (p *NetworkServiceCreateNodeArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *NetworkServiceCreateNodeArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceDeleteRelationArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateNode_args"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteRelation_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9343,7 +26809,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceCreateNodeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceDeleteRelationArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9360,43 +26826,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *NetworkServiceCreateNodeArgs) String() string {
+func (p *NetworkServiceDeleteRelationArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceCreateNodeArgs(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceDeleteRelationArgs(%+v)", *p)
 
 }
 
-type NetworkServiceCreateNodeResult struct {
-	Success *CreateNodeResponse `thrift:"success,0,optional"`
+type NetworkServiceDeleteRelationResult struct {
+	Success *DeleteRelationResponse `thrift:"success,0,optional"`
 }
 
-func NewNetworkServiceCreateNodeResult() *NetworkServiceCreateNodeResult {
-	return &NetworkServiceCreateNodeResult{}
+func NewNetworkServiceDeleteRelationResult() *NetworkServiceDeleteRelationResult {
+	return &NetworkServiceDeleteRelationResult{}
 }
 
-func (p *NetworkServiceCreateNodeResult) InitDefault() {
+func (p *NetworkServiceDeleteRelationResult) InitDefault() {
 }
 
-var NetworkServiceCreateNodeResult_Success_DEFAULT *CreateNodeResponse
+var NetworkServiceDeleteRelationResult_Success_DEFAULT *DeleteRelationResponse
 
-func (p *NetworkServiceCreateNodeResult) GetSuccess() (v *CreateNodeResponse) {
+func (p *NetworkServiceDeleteRelationResult) GetSuccess() (v *DeleteRelationResponse) {
 	if !p.IsSetSuccess() {
-		return NetworkServiceCreateNodeResult_Success_DEFAULT
+		return NetworkServiceDeleteRelationResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_NetworkServiceCreateNodeResult = map[int16]string{
+var fieldIDToName_NetworkServiceDeleteRelationResult = map[int16]string{
 	0: "success",
 }
 
-func (p *NetworkServiceCreateNodeResult) IsSetSuccess() bool {
+func (p *NetworkServiceDeleteRelationResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *NetworkServiceCreateNodeResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceDeleteRelationResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -9441,7 +26907,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceCreateNodeResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceDeleteRelationResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9451,8 +26917,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceCreateNodeResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCreateNodeResponse()
+func (p *NetworkServiceDeleteRelationResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteRelationResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9460,9 +26926,9 @@ func (p *NetworkServiceCreateNodeResult) ReadField0(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *NetworkServiceCreateNodeResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceDeleteRelationResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateNode_result"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteRelation_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9488,7 +26954,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceCreateNodeResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceDeleteRelationResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9507,43 +26973,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *NetworkServiceCreateNodeResult) String() string {
+func (p *NetworkServiceDeleteRelationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceCreateNodeResult(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceDeleteRelationResult(%+v)", *p)
 
 }
 
-type NetworkServiceGetNodeArgs struct {
-	Req *GetNodeRequest `thrift:"req,1"`
+type NetworkServiceBatchCreateRelationsArgs struct {
+	Req *BatchCreateRelationsRequest `thrift:"req,1"`
 }
 
-func NewNetworkServiceGetNodeArgs() *NetworkServiceGetNodeArgs {
-	return &NetworkServiceGetNodeArgs{}
+func NewNetworkServiceBatchCreateRelationsArgs() *NetworkServiceBatchCreateRelationsArgs {
+	return &NetworkServiceBatchCreateRelationsArgs{}
 }
 
-func (p *NetworkServiceGetNodeArgs) InitDefault() {
+func (p *NetworkServiceBatchCreateRelationsArgs) InitDefault() {
 }
 
-var NetworkServiceGetNodeArgs_Req_DEFAULT *GetNodeRequest
+var NetworkServiceBatchCreateRelationsArgs_Req_DEFAULT *BatchCreateRelationsRequest
 
-func (p *NetworkServiceGetNodeArgs) GetReq() (v *GetNodeRequest) {
+func (p *NetworkServiceBatchCreateRelationsArgs) GetReq() (v *BatchCreateRelationsRequest) {
 	if !p.IsSetReq() {
-		return NetworkServiceGetNodeArgs_Req_DEFAULT
+		return NetworkServiceBatchCreateRelationsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_NetworkServiceGetNodeArgs = map[int16]string{
+var fieldIDToName_NetworkServiceBatchCreateRelationsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *NetworkServiceGetNodeArgs) IsSetReq() bool {
+func (p *NetworkServiceBatchCreateRelationsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NetworkServiceGetNodeArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceBatchCreateRelationsArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -9588,7 +27054,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceGetNodeArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceBatchCreateRelationsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9598,8 +27064,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceGetNodeArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetNodeRequest()
+func (p *NetworkServiceBatchCreateRelationsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewBatchCreateRelationsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9607,9 +27073,9 @@ func (p *NetworkServiceGetNodeArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NetworkServiceGetNodeArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceBatchCreateRelationsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetNode_args"); err != nil {
+	if err = oprot.WriteStructBegin("BatchCreateRelations_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9635,7 +27101,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceGetNodeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceBatchCreateRelationsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9652,43 +27118,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *NetworkServiceGetNodeArgs) String() string {
+func (p *NetworkServiceBatchCreateRelationsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceGetNodeArgs(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceBatchCreateRelationsArgs(%+v)", *p)
 
 }
 
-type NetworkServiceGetNodeResult struct {
-	Success *GetNodeResponse `thrift:"success,0,optional"`
+type NetworkServiceBatchCreateRelationsResult struct {
+	Success *BatchCreateRelationsResponse `thrift:"success,0,optional"`
 }
 
-func NewNetworkServiceGetNodeResult() *NetworkServiceGetNodeResult {
-	return &NetworkServiceGetNodeResult{}
+func NewNetworkServiceBatchCreateRelationsResult() *NetworkServiceBatchCreateRelationsResult {
+	return &NetworkServiceBatchCreateRelationsResult{}
 }
 
-func (p *NetworkServiceGetNodeResult) InitDefault() {
+func (p *NetworkServiceBatchCreateRelationsResult) InitDefault() {
 }
 
-var NetworkServiceGetNodeResult_Success_DEFAULT *GetNodeResponse
+var NetworkServiceBatchCreateRelationsResult_Success_DEFAULT *BatchCreateRelationsResponse
 
-func (p *NetworkServiceGetNodeResult) GetSuccess() (v *GetNodeResponse) {
+func (p *NetworkServiceBatchCreateRelationsResult) GetSuccess() (v *BatchCreateRelationsResponse) {
 	if !p.IsSetSuccess() {
-		return NetworkServiceGetNodeResult_Success_DEFAULT
+		return NetworkServiceBatchCreateRelationsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_NetworkServiceGetNodeResult = map[int16]string{
+var fieldIDToName_NetworkServiceBatchCreateRelationsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *NetworkServiceGetNodeResult) IsSetSuccess() bool {
+func (p *NetworkServiceBatchCreateRelationsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *NetworkServiceGetNodeResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceBatchCreateRelationsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -9733,7 +27199,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceGetNodeResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceBatchCreateRelationsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9743,8 +27209,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceGetNodeResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetNodeResponse()
+func (p *NetworkServiceBatchCreateRelationsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewBatchCreateRelationsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9752,9 +27218,9 @@ func (p *NetworkServiceGetNodeResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NetworkServiceGetNodeResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceBatchCreateRelationsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetNode_result"); err != nil {
+	if err = oprot.WriteStructBegin("BatchCreateRelations_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9780,7 +27246,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceGetNodeResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceBatchCreateRelationsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9799,43 +27265,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *NetworkServiceGetNodeResult) String() string {
+func (p *NetworkServiceBatchCreateRelationsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceGetNodeResult(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceBatchCreateRelationsResult(%+v)", *p)
 
 }
 
-type NetworkServiceUpdateNodeArgs struct {
-	Req *UpdateNodeRequest `thrift:"req,1"`
+type NetworkServiceGetNodeRelationsArgs struct {
+	Req *GetNodeRelationsRequest `thrift:"req,1"`
 }
 
-func NewNetworkServiceUpdateNodeArgs() *NetworkServiceUpdateNodeArgs {
-	return &NetworkServiceUpdateNodeArgs{}
+func NewNetworkServiceGetNodeRelationsArgs() *NetworkServiceGetNodeRelationsArgs {
+	return &NetworkServiceGetNodeRelationsArgs{}
 }
 
-func (p *NetworkServiceUpdateNodeArgs) InitDefault() {
+func (p *NetworkServiceGetNodeRelationsArgs) InitDefault() {
 }
 
-var NetworkServiceUpdateNodeArgs_Req_DEFAULT *UpdateNodeRequest
+var NetworkServiceGetNodeRelationsArgs_Req_DEFAULT *GetNodeRelationsRequest
 
-func (p *NetworkServiceUpdateNodeArgs) GetReq() (v *UpdateNodeRequest) {
+func (p *NetworkServiceGetNodeRelationsArgs) GetReq() (v *GetNodeRelationsRequest) {
 	if !p.IsSetReq() {
-		return NetworkServiceUpdateNodeArgs_Req_DEFAULT
+		return NetworkServiceGetNodeRelationsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_NetworkServiceUpdateNodeArgs = map[int16]string{
+var fieldIDToName_NetworkServiceGetNodeRelationsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *NetworkServiceUpdateNodeArgs) IsSetReq() bool {
+func (p *NetworkServiceGetNodeRelationsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NetworkServiceUpdateNodeArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceGetNodeRelationsArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -9880,7 +27346,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceUpdateNodeArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceGetNodeRelationsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9890,8 +27356,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceUpdateNodeArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateNodeRequest()
+func (p *NetworkServiceGetNodeRelationsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetNodeRelationsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9899,9 +27365,9 @@ func (p *NetworkServiceUpdateNodeArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *NetworkServiceUpdateNodeArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceGetNodeRelationsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateNode_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetNodeRelations_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9927,7 +27393,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceUpdateNodeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceGetNodeRelationsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9944,43 +27410,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *NetworkServiceUpdateNodeArgs) String() string {
+func (p *NetworkServiceGetNodeRelationsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceUpdateNodeArgs(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceGetNodeRelationsArgs(%+v)", *p)
 
 }
 
-type NetworkServiceUpdateNodeResult struct {
-	Success *UpdateNodeResponse `thrift:"success,0,optional"`
+type NetworkServiceGetNodeRelationsResult struct {
+	Success *GetNodeRelationsResponse `thrift:"success,0,optional"`
 }
 
-func NewNetworkServiceUpdateNodeResult() *NetworkServiceUpdateNodeResult {
-	return &NetworkServiceUpdateNodeResult{}
+func NewNetworkServiceGetNodeRelationsResult() *NetworkServiceGetNodeRelationsResult {
+	return &NetworkServiceGetNodeRelationsResult{}
 }
 
-func (p *NetworkServiceUpdateNodeResult) InitDefault() {
+func (p *NetworkServiceGetNodeRelationsResult) InitDefault() {
 }
 
-var NetworkServiceUpdateNodeResult_Success_DEFAULT *UpdateNodeResponse
+var NetworkServiceGetNodeRelationsResult_Success_DEFAULT *GetNodeRelationsResponse
 
-func (p *NetworkServiceUpdateNodeResult) GetSuccess() (v *UpdateNodeResponse) {
+func (p *NetworkServiceGetNodeRelationsResult) GetSuccess() (v *GetNodeRelationsResponse) {
 	if !p.IsSetSuccess() {
-		return NetworkServiceUpdateNodeResult_Success_DEFAULT
+		return NetworkServiceGetNodeRelationsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_NetworkServiceUpdateNodeResult = map[int16]string{
+var fieldIDToName_NetworkServiceGetNodeRelationsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *NetworkServiceUpdateNodeResult) IsSetSuccess() bool {
+func (p *NetworkServiceGetNodeRelationsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *NetworkServiceUpdateNodeResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceGetNodeRelationsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -10025,7 +27491,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceUpdateNodeResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceGetNodeRelationsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10035,8 +27501,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceUpdateNodeResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateNodeResponse()
+func (p *NetworkServiceGetNodeRelationsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetNodeRelationsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10044,9 +27510,9 @@ func (p *NetworkServiceUpdateNodeResult) ReadField0(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *NetworkServiceUpdateNodeResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceGetNodeRelationsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateNode_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetNodeRelations_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10072,7 +27538,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceUpdateNodeResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceGetNodeRelationsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -10091,43 +27557,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *NetworkServiceUpdateNodeResult) String() string {
+func (p *NetworkServiceGetNodeRelationsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceUpdateNodeResult(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceGetNodeRelationsResult(%+v)", *p)
 
 }
 
-type NetworkServiceDeleteNodeArgs struct {
-	Req *DeleteNodeRequest `thrift:"req,1"`
+type NetworkServiceListTypesArgs struct {
+	Req *ListTypesRequest `thrift:"req,1"`
 }
 
-func NewNetworkServiceDeleteNodeArgs() *NetworkServiceDeleteNodeArgs {
-	return &NetworkServiceDeleteNodeArgs{}
+func NewNetworkServiceListTypesArgs() *NetworkServiceListTypesArgs {
+	return &NetworkServiceListTypesArgs{}
 }
 
-func (p *NetworkServiceDeleteNodeArgs) InitDefault() {
+func (p *NetworkServiceListTypesArgs) InitDefault() {
 }
 
-var NetworkServiceDeleteNodeArgs_Req_DEFAULT *DeleteNodeRequest
+var NetworkServiceListTypesArgs_Req_DEFAULT *ListTypesRequest
 
-func (p *NetworkServiceDeleteNodeArgs) GetReq() (v *DeleteNodeRequest) {
+func (p *NetworkServiceListTypesArgs) GetReq() (v *ListTypesRequest) {
 	if !p.IsSetReq() {
-		return NetworkServiceDeleteNodeArgs_Req_DEFAULT
+		return NetworkServiceListTypesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_NetworkServiceDeleteNodeArgs = map[int16]string{
+var fieldIDToName_NetworkServiceListTypesArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *NetworkServiceDeleteNodeArgs) IsSetReq() bool {
+func (p *NetworkServiceListTypesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NetworkServiceDeleteNodeArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceListTypesArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -10172,7 +27638,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceDeleteNodeArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceListTypesArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10182,8 +27648,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceDeleteNodeArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDeleteNodeRequest()
+func (p *NetworkServiceListTypesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListTypesRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10191,9 +27657,9 @@ func (p *NetworkServiceDeleteNodeArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *NetworkServiceDeleteNodeArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceListTypesArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteNode_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListTypes_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10219,7 +27685,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceDeleteNodeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceListTypesArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10236,43 +27702,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *NetworkServiceDeleteNodeArgs) String() string {
+func (p *NetworkServiceListTypesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceDeleteNodeArgs(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceListTypesArgs(%+v)", *p)
 
 }
 
-type NetworkServiceDeleteNodeResult struct {
-	Success *DeleteNodeResponse `thrift:"success,0,optional"`
+type NetworkServiceListTypesResult struct {
+	Success *ListTypesResponse `thrift:"success,0,optional"`
 }
 
-func NewNetworkServiceDeleteNodeResult() *NetworkServiceDeleteNodeResult {
-	return &NetworkServiceDeleteNodeResult{}
+func NewNetworkServiceListTypesResult() *NetworkServiceListTypesResult {
+	return &NetworkServiceListTypesResult{}
 }
 
-func (p *NetworkServiceDeleteNodeResult) InitDefault() {
+func (p *NetworkServiceListTypesResult) InitDefault() {
 }
 
-var NetworkServiceDeleteNodeResult_Success_DEFAULT *DeleteNodeResponse
+var NetworkServiceListTypesResult_Success_DEFAULT *ListTypesResponse
 
-func (p *NetworkServiceDeleteNodeResult) GetSuccess() (v *DeleteNodeResponse) {
+func (p *NetworkServiceListTypesResult) GetSuccess() (v *ListTypesResponse) {
 	if !p.IsSetSuccess() {
-		return NetworkServiceDeleteNodeResult_Success_DEFAULT
+		return NetworkServiceListTypesResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_NetworkServiceDeleteNodeResult = map[int16]string{
+var fieldIDToName_NetworkServiceListTypesResult = map[int16]string{
 	0: "success",
 }
 
-func (p *NetworkServiceDeleteNodeResult) IsSetSuccess() bool {
+func (p *NetworkServiceListTypesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *NetworkServiceDeleteNodeResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceListTypesResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -10317,7 +27783,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceDeleteNodeResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceListTypesResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10327,8 +27793,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceDeleteNodeResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDeleteNodeResponse()
+func (p *NetworkServiceListTypesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListTypesResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10336,9 +27802,9 @@ func (p *NetworkServiceDeleteNodeResult) ReadField0(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *NetworkServiceDeleteNodeResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceListTypesResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteNode_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListTypes_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10364,7 +27830,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceDeleteNodeResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceListTypesResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -10383,43 +27849,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *NetworkServiceDeleteNodeResult) String() string {
+func (p *NetworkServiceListTypesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceDeleteNodeResult(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceListTypesResult(%+v)", *p)
 
 }
 
-type NetworkServiceCreateRelationArgs struct {
-	Req *CreateRelationRequest `thrift:"req,1"`
+type NetworkServiceCreateNodeTypeArgs struct {
+	Req *CreateNodeTypeRequest `thrift:"req,1"`
 }
 
-func NewNetworkServiceCreateRelationArgs() *NetworkServiceCreateRelationArgs {
-	return &NetworkServiceCreateRelationArgs{}
+func NewNetworkServiceCreateNodeTypeArgs() *NetworkServiceCreateNodeTypeArgs {
+	return &NetworkServiceCreateNodeTypeArgs{}
 }
 
-func (p *NetworkServiceCreateRelationArgs) InitDefault() {
+func (p *NetworkServiceCreateNodeTypeArgs) InitDefault() {
 }
 
-var NetworkServiceCreateRelationArgs_Req_DEFAULT *CreateRelationRequest
+var NetworkServiceCreateNodeTypeArgs_Req_DEFAULT *CreateNodeTypeRequest
 
-func (p *NetworkServiceCreateRelationArgs) GetReq() (v *CreateRelationRequest) {
+func (p *NetworkServiceCreateNodeTypeArgs) GetReq() (v *CreateNodeTypeRequest) {
 	if !p.IsSetReq() {
-		return NetworkServiceCreateRelationArgs_Req_DEFAULT
+		return NetworkServiceCreateNodeTypeArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_NetworkServiceCreateRelationArgs = map[int16]string{
+var fieldIDToName_NetworkServiceCreateNodeTypeArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *NetworkServiceCreateRelationArgs) IsSetReq() bool {
+func (p *NetworkServiceCreateNodeTypeArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NetworkServiceCreateRelationArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceCreateNodeTypeArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -10464,7 +27930,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceCreateRelationArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceCreateNodeTypeArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10474,8 +27940,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceCreateRelationArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCreateRelationRequest()
+func (p *NetworkServiceCreateNodeTypeArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreateNodeTypeRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10483,9 +27949,9 @@ func (p *NetworkServiceCreateRelationArgs) ReadField1(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *NetworkServiceCreateRelationArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceCreateNodeTypeArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateRelation_args"); err != nil {
+	if err = oprot.WriteStructBegin("CreateNodeType_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10511,7 +27977,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceCreateRelationArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceCreateNodeTypeArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10528,43 +27994,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *NetworkServiceCreateRelationArgs) String() string {
+func (p *NetworkServiceCreateNodeTypeArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceCreateRelationArgs(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceCreateNodeTypeArgs(%+v)", *p)
 
 }
 
-type NetworkServiceCreateRelationResult struct {
-	Success *CreateRelationResponse `thrift:"success,0,optional"`
+type NetworkServiceCreateNodeTypeResult struct {
+	Success *CreateNodeTypeResponse `thrift:"success,0,optional"`
 }
 
-func NewNetworkServiceCreateRelationResult() *NetworkServiceCreateRelationResult {
-	return &NetworkServiceCreateRelationResult{}
+func NewNetworkServiceCreateNodeTypeResult() *NetworkServiceCreateNodeTypeResult {
+	return &NetworkServiceCreateNodeTypeResult{}
 }
 
-func (p *NetworkServiceCreateRelationResult) InitDefault() {
+func (p *NetworkServiceCreateNodeTypeResult) InitDefault() {
 }
 
-var NetworkServiceCreateRelationResult_Success_DEFAULT *CreateRelationResponse
+var NetworkServiceCreateNodeTypeResult_Success_DEFAULT *CreateNodeTypeResponse
 
-func (p *NetworkServiceCreateRelationResult) GetSuccess() (v *CreateRelationResponse) {
+func (p *NetworkServiceCreateNodeTypeResult) GetSuccess() (v *CreateNodeTypeResponse) {
 	if !p.IsSetSuccess() {
-		return NetworkServiceCreateRelationResult_Success_DEFAULT
+		return NetworkServiceCreateNodeTypeResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_NetworkServiceCreateRelationResult = map[int16]string{
+var fieldIDToName_NetworkServiceCreateNodeTypeResult = map[int16]string{
 	0: "success",
 }
 
-func (p *NetworkServiceCreateRelationResult) IsSetSuccess() bool {
+func (p *NetworkServiceCreateNodeTypeResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *NetworkServiceCreateRelationResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceCreateNodeTypeResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -10609,7 +28075,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceCreateRelationResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceCreateNodeTypeResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10619,8 +28085,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceCreateRelationResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCreateRelationResponse()
+func (p *NetworkServiceCreateNodeTypeResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreateNodeTypeResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10628,9 +28094,9 @@ func (p *NetworkServiceCreateRelationResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *NetworkServiceCreateRelationResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceCreateNodeTypeResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateRelation_result"); err != nil {
+	if err = oprot.WriteStructBegin("CreateNodeType_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10656,7 +28122,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceCreateRelationResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceCreateNodeTypeResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -10675,43 +28141,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *NetworkServiceCreateRelationResult) String() string {
+func (p *NetworkServiceCreateNodeTypeResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceCreateRelationResult(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceCreateNodeTypeResult(%+v)", *p)
 
 }
 
-type NetworkServiceGetRelationArgs struct {
-	Req *GetRelationRequest `thrift:"req,1"`
+type NetworkServiceSaveRelationTypeArgs struct {
+	Req *SaveRelationTypeRequest `thrift:"req,1"`
 }
 
-func NewNetworkServiceGetRelationArgs() *NetworkServiceGetRelationArgs {
-	return &NetworkServiceGetRelationArgs{}
+func NewNetworkServiceSaveRelationTypeArgs() *NetworkServiceSaveRelationTypeArgs {
+	return &NetworkServiceSaveRelationTypeArgs{}
 }
 
-func (p *NetworkServiceGetRelationArgs) InitDefault() {
+func (p *NetworkServiceSaveRelationTypeArgs) InitDefault() {
 }
 
-var NetworkServiceGetRelationArgs_Req_DEFAULT *GetRelationRequest
+var NetworkServiceSaveRelationTypeArgs_Req_DEFAULT *SaveRelationTypeRequest
 
-func (p *NetworkServiceGetRelationArgs) GetReq() (v *GetRelationRequest) {
+func (p *NetworkServiceSaveRelationTypeArgs) GetReq() (v *SaveRelationTypeRequest) {
 	if !p.IsSetReq() {
-		return NetworkServiceGetRelationArgs_Req_DEFAULT
+		return NetworkServiceSaveRelationTypeArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_NetworkServiceGetRelationArgs = map[int16]string{
+var fieldIDToName_NetworkServiceSaveRelationTypeArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *NetworkServiceGetRelationArgs) IsSetReq() bool {
+func (p *NetworkServiceSaveRelationTypeArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NetworkServiceGetRelationArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceSaveRelationTypeArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -10756,7 +28222,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceGetRelationArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceSaveRelationTypeArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10766,8 +28232,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceGetRelationArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetRelationRequest()
+func (p *NetworkServiceSaveRelationTypeArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSaveRelationTypeRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10775,9 +28241,9 @@ func (p *NetworkServiceGetRelationArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *NetworkServiceGetRelationArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceSaveRelationTypeArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetRelation_args"); err != nil {
+	if err = oprot.WriteStructBegin("SaveRelationType_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10803,7 +28269,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceGetRelationArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceSaveRelationTypeArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10820,43 +28286,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *NetworkServiceGetRelationArgs) String() string {
+func (p *NetworkServiceSaveRelationTypeArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceGetRelationArgs(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceSaveRelationTypeArgs(%+v)", *p)
 
 }
 
-type NetworkServiceGetRelationResult struct {
-	Success *GetRelationResponse `thrift:"success,0,optional"`
+type NetworkServiceSaveRelationTypeResult struct {
+	Success *SaveRelationTypeResponse `thrift:"success,0,optional"`
 }
 
-func NewNetworkServiceGetRelationResult() *NetworkServiceGetRelationResult {
-	return &NetworkServiceGetRelationResult{}
+func NewNetworkServiceSaveRelationTypeResult() *NetworkServiceSaveRelationTypeResult {
+	return &NetworkServiceSaveRelationTypeResult{}
 }
 
-func (p *NetworkServiceGetRelationResult) InitDefault() {
+func (p *NetworkServiceSaveRelationTypeResult) InitDefault() {
 }
 
-var NetworkServiceGetRelationResult_Success_DEFAULT *GetRelationResponse
+var NetworkServiceSaveRelationTypeResult_Success_DEFAULT *SaveRelationTypeResponse
 
-func (p *NetworkServiceGetRelationResult) GetSuccess() (v *GetRelationResponse) {
+func (p *NetworkServiceSaveRelationTypeResult) GetSuccess() (v *SaveRelationTypeResponse) {
 	if !p.IsSetSuccess() {
-		return NetworkServiceGetRelationResult_Success_DEFAULT
+		return NetworkServiceSaveRelationTypeResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_NetworkServiceGetRelationResult = map[int16]string{
+var fieldIDToName_NetworkServiceSaveRelationTypeResult = map[int16]string{
 	0: "success",
 }
 
-func (p *NetworkServiceGetRelationResult) IsSetSuccess() bool {
+func (p *NetworkServiceSaveRelationTypeResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *NetworkServiceGetRelationResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceSaveRelationTypeResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -10901,7 +28367,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceGetRelationResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceSaveRelationTypeResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10911,8 +28377,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceGetRelationResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetRelationResponse()
+func (p *NetworkServiceSaveRelationTypeResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSaveRelationTypeResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10920,9 +28386,9 @@ func (p *NetworkServiceGetRelationResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *NetworkServiceGetRelationResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceSaveRelationTypeResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetRelation_result"); err != nil {
+	if err = oprot.WriteStructBegin("SaveRelationType_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10948,7 +28414,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceGetRelationResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceSaveRelationTypeResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -10967,43 +28433,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *NetworkServiceGetRelationResult) String() string {
+func (p *NetworkServiceSaveRelationTypeResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceGetRelationResult(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceSaveRelationTypeResult(%+v)", *p)
 
 }
 
-type NetworkServiceUpdateRelationArgs struct {
-	Req *UpdateRelationRequest `thrift:"req,1"`
+type NetworkServiceUpdateNodeTypeArgs struct {
+	Req *UpdateNodeTypeRequest `thrift:"req,1"`
 }
 
-func NewNetworkServiceUpdateRelationArgs() *NetworkServiceUpdateRelationArgs {
-	return &NetworkServiceUpdateRelationArgs{}
+func NewNetworkServiceUpdateNodeTypeArgs() *NetworkServiceUpdateNodeTypeArgs {
+	return &NetworkServiceUpdateNodeTypeArgs{}
 }
 
-func (p *NetworkServiceUpdateRelationArgs) InitDefault() {
+func (p *NetworkServiceUpdateNodeTypeArgs) InitDefault() {
 }
 
-var NetworkServiceUpdateRelationArgs_Req_DEFAULT *UpdateRelationRequest
+var NetworkServiceUpdateNodeTypeArgs_Req_DEFAULT *UpdateNodeTypeRequest
 
-func (p *NetworkServiceUpdateRelationArgs) GetReq() (v *UpdateRelationRequest) {
+func (p *NetworkServiceUpdateNodeTypeArgs) GetReq() (v *UpdateNodeTypeRequest) {
 	if !p.IsSetReq() {
-		return NetworkServiceUpdateRelationArgs_Req_DEFAULT
+		return NetworkServiceUpdateNodeTypeArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_NetworkServiceUpdateRelationArgs = map[int16]string{
+var fieldIDToName_NetworkServiceUpdateNodeTypeArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *NetworkServiceUpdateRelationArgs) IsSetReq() bool {
+func (p *NetworkServiceUpdateNodeTypeArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NetworkServiceUpdateRelationArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceUpdateNodeTypeArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -11048,7 +28514,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceUpdateRelationArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceUpdateNodeTypeArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11058,8 +28524,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceUpdateRelationArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateRelationRequest()
+func (p *NetworkServiceUpdateNodeTypeArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateNodeTypeRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -11067,9 +28533,9 @@ func (p *NetworkServiceUpdateRelationArgs) ReadField1(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *NetworkServiceUpdateRelationArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceUpdateNodeTypeArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateRelation_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateNodeType_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11095,7 +28561,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceUpdateRelationArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceUpdateNodeTypeArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11112,43 +28578,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *NetworkServiceUpdateRelationArgs) String() string {
+func (p *NetworkServiceUpdateNodeTypeArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceUpdateRelationArgs(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceUpdateNodeTypeArgs(%+v)", *p)
 
 }
 
-type NetworkServiceUpdateRelationResult struct {
-	Success *UpdateRelationResponse `thrift:"success,0,optional"`
+type NetworkServiceUpdateNodeTypeResult struct {
+	Success *UpdateNodeTypeResponse `thrift:"success,0,optional"`
 }
 
-func NewNetworkServiceUpdateRelationResult() *NetworkServiceUpdateRelationResult {
-	return &NetworkServiceUpdateRelationResult{}
+func NewNetworkServiceUpdateNodeTypeResult() *NetworkServiceUpdateNodeTypeResult {
+	return &NetworkServiceUpdateNodeTypeResult{}
 }
 
-func (p *NetworkServiceUpdateRelationResult) InitDefault() {
+func (p *NetworkServiceUpdateNodeTypeResult) InitDefault() {
 }
 
-var NetworkServiceUpdateRelationResult_Success_DEFAULT *UpdateRelationResponse
+var NetworkServiceUpdateNodeTypeResult_Success_DEFAULT *UpdateNodeTypeResponse
 
-func (p *NetworkServiceUpdateRelationResult) GetSuccess() (v *UpdateRelationResponse) {
+func (p *NetworkServiceUpdateNodeTypeResult) GetSuccess() (v *UpdateNodeTypeResponse) {
 	if !p.IsSetSuccess() {
-		return NetworkServiceUpdateRelationResult_Success_DEFAULT
+		return NetworkServiceUpdateNodeTypeResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_NetworkServiceUpdateRelationResult = map[int16]string{
+var fieldIDToName_NetworkServiceUpdateNodeTypeResult = map[int16]string{
 	0: "success",
 }
 
-func (p *NetworkServiceUpdateRelationResult) IsSetSuccess() bool {
+func (p *NetworkServiceUpdateNodeTypeResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *NetworkServiceUpdateRelationResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceUpdateNodeTypeResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -11193,7 +28659,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceUpdateRelationResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceUpdateNodeTypeResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11203,8 +28669,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceUpdateRelationResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateRelationResponse()
+func (p *NetworkServiceUpdateNodeTypeResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateNodeTypeResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -11212,9 +28678,9 @@ func (p *NetworkServiceUpdateRelationResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *NetworkServiceUpdateRelationResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceUpdateNodeTypeResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateRelation_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateNodeType_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11240,7 +28706,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceUpdateRelationResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceUpdateNodeTypeResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -11259,43 +28725,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *NetworkServiceUpdateRelationResult) String() string {
+func (p *NetworkServiceUpdateNodeTypeResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceUpdateRelationResult(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceUpdateNodeTypeResult(%+v)", *p)
 
 }
 
-type NetworkServiceDeleteRelationArgs struct {
-	Req *DeleteRelationRequest `thrift:"req,1"`
+type NetworkServiceFulltextSearchNodesArgs struct {
+	Req *FulltextSearchNodesRequest `thrift:"req,1"`
 }
 
-func NewNetworkServiceDeleteRelationArgs() *NetworkServiceDeleteRelationArgs {
-	return &NetworkServiceDeleteRelationArgs{}
+func NewNetworkServiceFulltextSearchNodesArgs() *NetworkServiceFulltextSearchNodesArgs {
+	return &NetworkServiceFulltextSearchNodesArgs{}
 }
 
-func (p *NetworkServiceDeleteRelationArgs) InitDefault() {
+func (p *NetworkServiceFulltextSearchNodesArgs) InitDefault() {
 }
 
-var NetworkServiceDeleteRelationArgs_Req_DEFAULT *DeleteRelationRequest
+var NetworkServiceFulltextSearchNodesArgs_Req_DEFAULT *FulltextSearchNodesRequest
 
-func (p *NetworkServiceDeleteRelationArgs) GetReq() (v *DeleteRelationRequest) {
+func (p *NetworkServiceFulltextSearchNodesArgs) GetReq() (v *FulltextSearchNodesRequest) {
 	if !p.IsSetReq() {
-		return NetworkServiceDeleteRelationArgs_Req_DEFAULT
+		return NetworkServiceFulltextSearchNodesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_NetworkServiceDeleteRelationArgs = map[int16]string{
+var fieldIDToName_NetworkServiceFulltextSearchNodesArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *NetworkServiceDeleteRelationArgs) IsSetReq() bool {
+func (p *NetworkServiceFulltextSearchNodesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NetworkServiceDeleteRelationArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceFulltextSearchNodesArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -11340,7 +28806,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceDeleteRelationArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceFulltextSearchNodesArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11350,8 +28816,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceDeleteRelationArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDeleteRelationRequest()
+func (p *NetworkServiceFulltextSearchNodesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewFulltextSearchNodesRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -11359,9 +28825,9 @@ func (p *NetworkServiceDeleteRelationArgs) ReadField1(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *NetworkServiceDeleteRelationArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceFulltextSearchNodesArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteRelation_args"); err != nil {
+	if err = oprot.WriteStructBegin("FulltextSearchNodes_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11387,7 +28853,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceDeleteRelationArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceFulltextSearchNodesArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11404,43 +28870,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *NetworkServiceDeleteRelationArgs) String() string {
+func (p *NetworkServiceFulltextSearchNodesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceDeleteRelationArgs(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceFulltextSearchNodesArgs(%+v)", *p)
 
 }
 
-type NetworkServiceDeleteRelationResult struct {
-	Success *DeleteRelationResponse `thrift:"success,0,optional"`
+type NetworkServiceFulltextSearchNodesResult struct {
+	Success *FulltextSearchNodesResponse `thrift:"success,0,optional"`
 }
 
-func NewNetworkServiceDeleteRelationResult() *NetworkServiceDeleteRelationResult {
-	return &NetworkServiceDeleteRelationResult{}
+func NewNetworkServiceFulltextSearchNodesResult() *NetworkServiceFulltextSearchNodesResult {
+	return &NetworkServiceFulltextSearchNodesResult{}
 }
 
-func (p *NetworkServiceDeleteRelationResult) InitDefault() {
+func (p *NetworkServiceFulltextSearchNodesResult) InitDefault() {
 }
 
-var NetworkServiceDeleteRelationResult_Success_DEFAULT *DeleteRelationResponse
+var NetworkServiceFulltextSearchNodesResult_Success_DEFAULT *FulltextSearchNodesResponse
 
-func (p *NetworkServiceDeleteRelationResult) GetSuccess() (v *DeleteRelationResponse) {
+func (p *NetworkServiceFulltextSearchNodesResult) GetSuccess() (v *FulltextSearchNodesResponse) {
 	if !p.IsSetSuccess() {
-		return NetworkServiceDeleteRelationResult_Success_DEFAULT
+		return NetworkServiceFulltextSearchNodesResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_NetworkServiceDeleteRelationResult = map[int16]string{
+var fieldIDToName_NetworkServiceFulltextSearchNodesResult = map[int16]string{
 	0: "success",
 }
 
-func (p *NetworkServiceDeleteRelationResult) IsSetSuccess() bool {
+func (p *NetworkServiceFulltextSearchNodesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *NetworkServiceDeleteRelationResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceFulltextSearchNodesResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -11485,7 +28951,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceDeleteRelationResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceFulltextSearchNodesResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11495,8 +28961,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceDeleteRelationResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDeleteRelationResponse()
+func (p *NetworkServiceFulltextSearchNodesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewFulltextSearchNodesResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -11504,9 +28970,9 @@ func (p *NetworkServiceDeleteRelationResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *NetworkServiceDeleteRelationResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceFulltextSearchNodesResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteRelation_result"); err != nil {
+	if err = oprot.WriteStructBegin("FulltextSearchNodes_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11532,7 +28998,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceDeleteRelationResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceFulltextSearchNodesResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -11551,43 +29017,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *NetworkServiceDeleteRelationResult) String() string {
+func (p *NetworkServiceFulltextSearchNodesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceDeleteRelationResult(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceFulltextSearchNodesResult(%+v)", *p)
 
 }
 
-type NetworkServiceGetNodeRelationsArgs struct {
-	Req *GetNodeRelationsRequest `thrift:"req,1"`
+type NetworkServiceGetRecommendationsArgs struct {
+	Req *GetRecommendationsRequest `thrift:"req,1"`
 }
 
-func NewNetworkServiceGetNodeRelationsArgs() *NetworkServiceGetNodeRelationsArgs {
-	return &NetworkServiceGetNodeRelationsArgs{}
+func NewNetworkServiceGetRecommendationsArgs() *NetworkServiceGetRecommendationsArgs {
+	return &NetworkServiceGetRecommendationsArgs{}
 }
 
-func (p *NetworkServiceGetNodeRelationsArgs) InitDefault() {
+func (p *NetworkServiceGetRecommendationsArgs) InitDefault() {
 }
 
-var NetworkServiceGetNodeRelationsArgs_Req_DEFAULT *GetNodeRelationsRequest
+var NetworkServiceGetRecommendationsArgs_Req_DEFAULT *GetRecommendationsRequest
 
-func (p *NetworkServiceGetNodeRelationsArgs) GetReq() (v *GetNodeRelationsRequest) {
+func (p *NetworkServiceGetRecommendationsArgs) GetReq() (v *GetRecommendationsRequest) {
 	if !p.IsSetReq() {
-		return NetworkServiceGetNodeRelationsArgs_Req_DEFAULT
+		return NetworkServiceGetRecommendationsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_NetworkServiceGetNodeRelationsArgs = map[int16]string{
+var fieldIDToName_NetworkServiceGetRecommendationsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *NetworkServiceGetNodeRelationsArgs) IsSetReq() bool {
+func (p *NetworkServiceGetRecommendationsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NetworkServiceGetNodeRelationsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceGetRecommendationsArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -11632,7 +29098,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceGetNodeRelationsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceGetRecommendationsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11642,8 +29108,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceGetNodeRelationsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetNodeRelationsRequest()
+func (p *NetworkServiceGetRecommendationsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetRecommendationsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -11651,9 +29117,9 @@ func (p *NetworkServiceGetNodeRelationsArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *NetworkServiceGetNodeRelationsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceGetRecommendationsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetNodeRelations_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetRecommendations_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11679,7 +29145,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceGetNodeRelationsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceGetRecommendationsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11696,43 +29162,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *NetworkServiceGetNodeRelationsArgs) String() string {
+func (p *NetworkServiceGetRecommendationsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceGetNodeRelationsArgs(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceGetRecommendationsArgs(%+v)", *p)
 
 }
 
-type NetworkServiceGetNodeRelationsResult struct {
-	Success *GetNodeRelationsResponse `thrift:"success,0,optional"`
+type NetworkServiceGetRecommendationsResult struct {
+	Success *GetRecommendationsResponse `thrift:"success,0,optional"`
 }
 
-func NewNetworkServiceGetNodeRelationsResult() *NetworkServiceGetNodeRelationsResult {
-	return &NetworkServiceGetNodeRelationsResult{}
+func NewNetworkServiceGetRecommendationsResult() *NetworkServiceGetRecommendationsResult {
+	return &NetworkServiceGetRecommendationsResult{}
 }
 
-func (p *NetworkServiceGetNodeRelationsResult) InitDefault() {
+func (p *NetworkServiceGetRecommendationsResult) InitDefault() {
 }
 
-var NetworkServiceGetNodeRelationsResult_Success_DEFAULT *GetNodeRelationsResponse
+var NetworkServiceGetRecommendationsResult_Success_DEFAULT *GetRecommendationsResponse
 
-func (p *NetworkServiceGetNodeRelationsResult) GetSuccess() (v *GetNodeRelationsResponse) {
+func (p *NetworkServiceGetRecommendationsResult) GetSuccess() (v *GetRecommendationsResponse) {
 	if !p.IsSetSuccess() {
-		return NetworkServiceGetNodeRelationsResult_Success_DEFAULT
+		return NetworkServiceGetRecommendationsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_NetworkServiceGetNodeRelationsResult = map[int16]string{
+var fieldIDToName_NetworkServiceGetRecommendationsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *NetworkServiceGetNodeRelationsResult) IsSetSuccess() bool {
+func (p *NetworkServiceGetRecommendationsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *NetworkServiceGetNodeRelationsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceGetRecommendationsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -11777,7 +29243,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceGetNodeRelationsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NetworkServiceGetRecommendationsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11787,8 +29253,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NetworkServiceGetNodeRelationsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetNodeRelationsResponse()
+func (p *NetworkServiceGetRecommendationsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetRecommendationsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -11796,9 +29262,9 @@ func (p *NetworkServiceGetNodeRelationsResult) ReadField0(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *NetworkServiceGetNodeRelationsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceGetRecommendationsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetNodeRelations_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetRecommendations_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11824,7 +29290,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NetworkServiceGetNodeRelationsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *NetworkServiceGetRecommendationsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -11843,10 +29309,10 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *NetworkServiceGetNodeRelationsResult) String() string {
+func (p *NetworkServiceGetRecommendationsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NetworkServiceGetNodeRelationsResult(%+v)", *p)
+	return fmt.Sprintf("NetworkServiceGetRecommendationsResult(%+v)", *p)
 
 }
@@ -0,0 +1,184 @@
+package neo4jrepo
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+
+	"labelwall/biz/dal/neo4jdal"
+)
+
+// ErrInvalidCursor 表示客户端传入的分页游标无法解析，或与请求的排序方式不一致
+var ErrInvalidCursor = errors.New("repo: invalid pagination cursor")
+
+// pageCursor 是游标中编码的分页位置，即上一页最后一条记录的排序键取值。
+// 默认排序时搜索节点使用 (Name, ID)，节点关系只使用 ID；
+// 自定义排序时 Sort 记录排序键 (neo4jdal.SortSpec)，Values 记录各排序键的取值，ID 为最后一条记录的 id。
+type pageCursor struct {
+	Name   string        `json:"n,omitempty"`
+	ID     string        `json:"i"`
+	Sort   string        `json:"s,omitempty"`
+	Values []cursorValue `json:"v,omitempty"`
+}
+
+// cursorValue 是游标中的一个排序键取值，Kind 记录 Neo4j 类型以便原样还原 (JSON 无法区分整数与浮点数、字符串与时间)
+type cursorValue struct {
+	Kind  string        `json:"k"` // null, string, int, float, bool, date, datetime, localdatetime, list
+	Value string        `json:"v,omitempty"`
+	List  []cursorValue `json:"l,omitempty"`
+}
+
+// 游标中时间类型的格式
+const (
+	cursorDateTimeLayout      = time.RFC3339Nano
+	cursorLocalDateTimeLayout = "2006-01-02T15:04:05.999999999"
+)
+
+// encodeCursorValues 将记录在各排序键上的取值 (属性缺失为 nil) 转换为游标中的取值
+func encodeCursorValues(props map[string]any, keys []neo4jdal.SortKey) ([]cursorValue, error) {
+	values := make([]cursorValue, len(keys))
+	for i, k := range keys {
+		v, err := encodeCursorValue(props[k.Field])
+		if err != nil {
+			return nil, fmt.Errorf("repo: 无法为字段 '%s' 生成分页游标: %w", k.Field, err)
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+func encodeCursorValue(value any) (cursorValue, error) {
+	switch v := value.(type) {
+	case nil:
+		return cursorValue{Kind: "null"}, nil
+	case string:
+		return cursorValue{Kind: "string", Value: v}, nil
+	case int64:
+		return cursorValue{Kind: "int", Value: strconv.FormatInt(v, 10)}, nil
+	case float64:
+		return cursorValue{Kind: "float", Value: strconv.FormatFloat(v, 'g', -1, 64)}, nil
+	case bool:
+		return cursorValue{Kind: "bool", Value: strconv.FormatBool(v)}, nil
+	case dbtype.Date:
+		return cursorValue{Kind: "date", Value: v.Time().Format(time.DateOnly)}, nil
+	case time.Time:
+		return cursorValue{Kind: "datetime", Value: v.Format(cursorDateTimeLayout)}, nil
+	case dbtype.LocalDateTime:
+		return cursorValue{Kind: "localdatetime", Value: v.Time().Format(cursorLocalDateTimeLayout)}, nil
+	case []string:
+		list := make([]cursorValue, len(v))
+		for i, item := range v {
+			list[i] = cursorValue{Kind: "string", Value: item}
+		}
+		return cursorValue{Kind: "list", List: list}, nil
+	case []any:
+		list := make([]cursorValue, len(v))
+		for i, item := range v {
+			encoded, err := encodeCursorValue(item)
+			if err != nil {
+				return cursorValue{}, err
+			}
+			list[i] = encoded
+		}
+		return cursorValue{Kind: "list", List: list}, nil
+	}
+	return cursorValue{}, fmt.Errorf("不支持的排序值类型 %T", value)
+}
+
+// decodeCursorValues 还原游标中的排序键取值，格式无效时返回 ErrInvalidCursor
+func decodeCursorValues(values []cursorValue) ([]any, error) {
+	decoded := make([]any, len(values))
+	for i, v := range values {
+		value, err := decodeCursorValue(v)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		decoded[i] = value
+	}
+	return decoded, nil
+}
+
+func decodeCursorValue(v cursorValue) (any, error) {
+	switch v.Kind {
+	case "null":
+		return nil, nil
+	case "string":
+		return v.Value, nil
+	case "int":
+		return strconv.ParseInt(v.Value, 10, 64)
+	case "float":
+		return strconv.ParseFloat(v.Value, 64)
+	case "bool":
+		return strconv.ParseBool(v.Value)
+	case "date":
+		t, err := time.Parse(time.DateOnly, v.Value)
+		return dbtype.Date(t), err
+	case "datetime":
+		return time.Parse(cursorDateTimeLayout, v.Value)
+	case "localdatetime":
+		t, err := time.Parse(cursorLocalDateTimeLayout, v.Value)
+		return dbtype.LocalDateTime(t), err
+	case "list":
+		list := make([]any, len(v.List))
+		for i, item := range v.List {
+			decoded, err := decodeCursorValue(item)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = decoded
+		}
+		return list, nil
+	}
+	return nil, ErrInvalidCursor
+}
+
+// encodeCursor 将排序键位置编码为对客户端不透明的字符串
+func encodeCursor(c pageCursor) string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor 解析客户端传回的游标
+func decodeCursor(s string) (pageCursor, error) {
+	var c pageCursor
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, ErrInvalidCursor
+	}
+	if err := json.Unmarshal(data, &c); err != nil || c.ID == "" {
+		return c, ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// decodePageCursor 解析游标并校验它与本次请求的排序键 (neo4jdal.SortSpec) 一致，cursor 为空时返回 nil。
+// 自定义排序时游标必须为每个排序键记录取值。
+func decodePageCursor(cursor *string, sortSpec string, sortKeys int) (*pageCursor, error) {
+	if cursor == nil || *cursor == "" {
+		return nil, nil
+	}
+	c, err := decodeCursor(*cursor)
+	if err != nil {
+		return nil, err
+	}
+	if c.Sort != sortSpec || len(c.Values) != sortKeys {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// cursorKeyPart 生成缓存键中替代 offset 的游标部分
+func cursorKeyPart(cursor string) string {
+	sum := sha1.Sum([]byte(cursor))
+	return "c" + hex.EncodeToString(sum[:])
+}
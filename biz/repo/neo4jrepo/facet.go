@@ -0,0 +1,99 @@
+package neo4jrepo
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"sort"
+
+	"labelwall/biz/dal/neo4jdal"
+	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/propvalue"
+	"labelwall/pkg/typeregistry"
+)
+
+// 可统计分面的核心属性 (另有 neo4jdal.FacetTypeField 按节点类型统计)
+var nodeFacetCoreFields = []string{"name", "profession"}
+
+// nodeFacetRequest 校验搜索节点的分面字段。可统计的属性为 name、profession 和类型属性约束中声明的属性：
+// 指定了节点类型时使用该类型的约束，否则合并所有节点类型的约束。type 分面只统计已注册节点类型的标签。
+func nodeFacetRequest(fields []string, size *int32, nodeType *network.NodeType) (*neo4jdal.FacetRequest, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	var facetSize int32
+	if size != nil {
+		facetSize = *size
+	}
+	req, err := neo4jdal.NewFacetRequest(fields, facetSize, sortableFields(nodeFacetCoreFields, nodeTypeSchemas(nodeType)))
+	if err != nil {
+		return nil, err
+	}
+	for _, def := range typeregistry.Default().NodeTypes() {
+		req.Labels = append(req.Labels, def.Name)
+	}
+	return req, nil
+}
+
+// facetKeyPart 生成缓存键中的分面部分，未请求分面时返回空字符串 (缓存键与之前一致)
+func facetKeyPart(facets *neo4jdal.FacetRequest) string {
+	if facets == nil {
+		return ""
+	}
+	sum := sha1.Sum([]byte(facets.Key()))
+	return ":a" + hex.EncodeToString(sum[:])
+}
+
+// emptyFacets 返回各分面字段都没有取值的统计结果，未请求分面时返回 nil
+func emptyFacets(facets *neo4jdal.FacetRequest) []*network.Facet {
+	if facets == nil {
+		return nil
+	}
+	result := make([]*network.Facet, len(facets.Fields))
+	for i, field := range facets.Fields {
+		result[i] = &network.Facet{Field: field, Buckets: []*network.FacetBucket{}}
+	}
+	return result
+}
+
+// mapFacets 将 DAL 的分面统计转换为 Thrift 结构：type 分面只保留节点类型的标签 (即类型名称)，
+// 属性值转换为字符串形式，字符串形式相同的取值合并后重新排序，每个分面最多保留 req.Size 个取值。
+func mapFacets(facets []neo4jdal.Facet, req *neo4jdal.FacetRequest) []*network.Facet {
+	if facets == nil || req == nil {
+		return nil
+	}
+	registry := typeregistry.Default()
+	result := make([]*network.Facet, len(facets))
+	for i, facet := range facets {
+		buckets := make([]*network.FacetBucket, 0, len(facet.Counts))
+		index := make(map[string]*network.FacetBucket, len(facet.Counts))
+		for _, c := range facet.Counts {
+			var value string
+			if facet.Field == neo4jdal.FacetTypeField {
+				label, _ := c.Value.(string)
+				if _, ok := registry.NodeTypeByName(label); !ok {
+					continue
+				}
+				value = label
+			} else {
+				formatted, ok := propvalue.Format(c.Value)
+				if !ok {
+					continue
+				}
+				value = formatted
+			}
+			if bucket, ok := index[value]; ok {
+				bucket.Count += int32(c.Count)
+				continue
+			}
+			bucket := &network.FacetBucket{Value: value, Count: int32(c.Count)}
+			index[value] = bucket
+			buckets = append(buckets, bucket)
+		}
+		sort.SliceStable(buckets, func(a, b int) bool { return buckets[a].Count > buckets[b].Count })
+		if int64(len(buckets)) > req.Size {
+			buckets = buckets[:req.Size]
+		}
+		result[i] = &network.Facet{Field: facet.Field, Buckets: buckets}
+	}
+	return result
+}
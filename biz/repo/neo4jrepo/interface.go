@@ -4,6 +4,7 @@ import (
 	"context"
 
 	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/typeregistry"
 )
 
 // NodeRepository 定义了节点数据访问的操作接口。
@@ -19,6 +20,11 @@ type NodeRepository interface {
 	// 输出：找到的节点对象或错误（例如，未找到）。
 	GetNode(ctx context.Context, id string) (*network.Node, error)
 
+	// GetNodes 根据 ID 列表批量获取节点 (缓存批量读取 + 一次数据库查询补齐未命中)。
+	// 输入：节点 ID 列表。
+	// 输出：按 ID 索引的节点 (不存在的 ID 不在结果中) 或错误。
+	GetNodes(ctx context.Context, ids []string) (map[string]*network.Node, error)
+
 	// UpdateNode 更新一个现有节点。
 	// 输入：UpdateNodeRequest 包含节点 ID 和要更新的字段。
 	// 输出：更新后的节点对象或错误（例如，未找到）。
@@ -29,20 +35,40 @@ type NodeRepository interface {
 	// 输出：错误（例如，未找到或删除失败）。
 	DeleteNode(ctx context.Context, id string) error
 
+	// BatchCreateNodes 在一个写事务中批量创建节点，并可选地创建引用本批次节点临时键的关系。
+	// 输入：BatchCreateNodesRequest 包含节点列表和可选的关系列表。
+	// 输出：逐项的节点结果、逐项的关系结果（未请求关系时为 nil）以及错误（事务整体失败时）。
+	BatchCreateNodes(ctx context.Context, req *network.BatchCreateNodesRequest) ([]*network.BatchNodeResult, []*network.BatchRelationResult, error)
+
 	// SearchNodes 根据条件搜索节点。
-	// 输入：SearchNodesRequest 包含搜索关键字、类型、分页等信息。
-	// 输出：匹配的节点列表、符合条件的总数以及错误。
-	SearchNodes(ctx context.Context, req *network.SearchNodesRequest) ([]*network.Node, int32, error)
+	// 输入：SearchNodesRequest 包含搜索关键字、类型、分页、排序和分面字段等信息。
+	// 输出：匹配的节点列表、符合条件的总数、下一页游标（无更多结果时为空）、分面统计（未请求时为 nil）以及错误。
+	SearchNodes(ctx context.Context, req *network.SearchNodesRequest) ([]*network.Node, int32, string, []*network.Facet, error)
 
 	// GetNetwork 查询指定职业相关的网络图谱。
-	// 输入：GetNetworkRequest 包含职业、查询深度、分页等信息。
-	// 输出：网络中的节点列表、关系列表以及错误。
-	GetNetwork(ctx context.Context, req *network.GetNetworkRequest) ([]*network.Node, []*network.Relation, error)
-
-	// GetPath 查询两个节点之间的最短路径。
-	// 输入：GetPathRequest 包含起始节点 ID、目标节点 ID、最大深度、关系类型过滤等。
-	// 输出：路径上的节点列表、关系列表以及错误（例如，路径未找到）。
-	GetPath(ctx context.Context, req *network.GetPathRequest) ([]*network.Node, []*network.Relation, error)
+	// 输入：GetNetworkRequest 包含职业、查询深度、分页和数量上限等信息。
+	// 输出：网络中的节点列表、关系列表、结果是否被截断以及错误。
+	GetNetwork(ctx context.Context, req *network.GetNetworkRequest) ([]*network.Node, []*network.Relation, bool, error)
+
+	// ExportNetwork 按 GetNetwork 的过滤条件取出用于导出的完整子图（不分页、不缓存）。
+	// 输入：GetNetworkRequest，仅使用过滤条件、深度和 max_nodes/max_relations。
+	// 输出：节点列表、关系列表、结果是否被上限截断以及错误。
+	ExportNetwork(ctx context.Context, req *network.GetNetworkRequest) ([]*network.Node, []*network.Relation, bool, error)
+
+	// GetPath 查询两个节点之间的路径。
+	// 输入：GetPathRequest 包含起始节点 ID、目标节点 ID、最大深度、关系类型过滤、查询模式 (一条/全部/前 k 条最短路径) 等。
+	// 输出：按长度升序排列的路径列表 (至少一条) 以及错误（例如，路径未找到）。
+	GetPath(ctx context.Context, req *network.GetPathRequest) ([]*network.Path, error)
+
+	// FulltextSearchNodes 使用全文索引搜索节点 (不缓存，结果按相关度排序)。
+	// 输入：FulltextSearchNodesRequest 包含搜索文本、是否模糊匹配、节点类型和分页参数。
+	// 输出：命中项 (节点、得分、高亮文本)、总数或错误 (搜索文本中没有可用的词时为 fulltext.ErrEmptyQuery)。
+	FulltextSearchNodes(ctx context.Context, req *network.FulltextSearchNodesRequest) ([]*network.FulltextHit, int32, error)
+
+	// GetRecommendations 为节点推荐 "可能认识的人" (经过共同联系人或共同组织可达、尚未直接相连的 PERSON 节点)。
+	// 输入：GetRecommendationsRequest 包含节点 ID 和返回数量。
+	// 输出：按得分降序排列的推荐项 (附共同联系人和共同组织) 或错误（例如，节点不存在）。
+	GetRecommendations(ctx context.Context, req *network.GetRecommendationsRequest) ([]*network.Recommendation, error)
 }
 
 // RelationRepository 定义了关系数据访问的操作接口。
@@ -57,6 +83,11 @@ type RelationRepository interface {
 	// 输出：找到的关系对象或错误（例如，未找到）。
 	GetRelation(ctx context.Context, id string) (*network.Relation, error)
 
+	// GetRelations 根据 ID 列表批量获取关系 (缓存批量读取 + 一次数据库查询补齐未命中)。
+	// 输入：关系 ID 列表。
+	// 输出：按 ID 索引的关系 (不存在的 ID 不在结果中) 或错误。
+	GetRelations(ctx context.Context, ids []string) (map[string]*network.Relation, error)
+
 	// UpdateRelation 更新一个现有关系。
 	// 输入：UpdateRelationRequest 包含关系 ID 和要更新的字段。
 	// 输出：更新后的关系对象或错误（例如，未找到）。
@@ -67,8 +98,35 @@ type RelationRepository interface {
 	// 输出：错误（例如，未找到或删除失败）。
 	DeleteRelation(ctx context.Context, id string) error
 
+	// BatchCreateRelations 在一个写事务中批量创建关系。
+	// 输入：BatchCreateRelationsRequest 包含关系列表（源/目标为已存在节点的 ID）。
+	// 输出：逐项的关系结果以及错误（事务整体失败时）。
+	BatchCreateRelations(ctx context.Context, req *network.BatchCreateRelationsRequest) ([]*network.BatchRelationResult, error)
+
 	// GetNodeRelations 获取指定节点的所有（或部分）关系。
 	// 输入：GetNodeRelationsRequest 包含节点 ID、关系类型过滤、方向、分页等信息。
-	// 输出：匹配的关系列表、符合条件的总数以及错误。
-	GetNodeRelations(ctx context.Context, req *network.GetNodeRelationsRequest) ([]*network.Relation, int32, error)
+	// 输出：匹配的关系列表、符合条件的总数、下一页游标（无更多结果时为空）以及错误。
+	GetNodeRelations(ctx context.Context, req *network.GetNodeRelationsRequest) ([]*network.Relation, int32, string, error)
+}
+
+// TypeRepository 定义了类型注册表的管理操作。
+// 类型定义持久化在存储后端，并同步到进程内的 typeregistry 注册表供 DAL 和映射函数使用。
+type TypeRepository interface {
+	// LoadTypes 从存储加载已持久化的类型定义到注册表 (启动时调用，也用于获取其他实例新增的类型)。
+	LoadTypes(ctx context.Context) error
+
+	// ListTypes 重新加载后返回全部节点类型和关系类型定义。
+	ListTypes(ctx context.Context) ([]typeregistry.NodeTypeDef, []typeregistry.RelationTypeDef, error)
+
+	// CreateNodeType 注册一个新的节点类型并分配编码，properties 为空表示不限制节点属性。
+	// 输出：新类型的定义，或错误（例如，名称无效或已存在、属性约束无效）。
+	CreateNodeType(ctx context.Context, name string, properties []typeregistry.PropertySchema) (typeregistry.NodeTypeDef, error)
+
+	// UpdateNodeType 替换已有节点类型 (包括内置类型) 的属性约束。
+	// 输出：更新后的类型定义，或错误（例如，类型不存在、属性约束无效）。
+	UpdateNodeType(ctx context.Context, name string, properties []typeregistry.PropertySchema) (typeregistry.NodeTypeDef, error)
+
+	// SaveRelationType 创建关系类型，或替换已有关系类型 (包括内置类型) 允许的节点类型组合和属性约束。
+	// 输出：保存后的类型定义，或错误（例如，组合中引用了未注册的节点类型）。
+	SaveRelationType(ctx context.Context, name string, allowedPairs []typeregistry.TypePair, properties []typeregistry.PropertySchema) (typeregistry.RelationTypeDef, error)
 }
@@ -8,19 +8,25 @@ import (
 	"encoding/json"
 	"errors" // 用于缓存错误检查
 	"fmt"    // 用于错误检查
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/google/uuid"
-	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
 
 	"labelwall/biz/dal/neo4jdal"
+	"labelwall/biz/dal/storage"
 	network "labelwall/biz/model/relationship/network"
 	"labelwall/pkg/cache" // 引入缓存包
+	"labelwall/pkg/fulltext"
+	"labelwall/pkg/propvalue"
+	"labelwall/pkg/singleflight"
+	"labelwall/pkg/typeregistry"
 )
 
 const (
@@ -45,6 +51,16 @@ const (
 	GetNetworkEmptyPlaceholder = "__EMPTY_NETWORK__"
 	// GetNetworkEmptyTTL is the TTL for empty network results
 	GetNetworkEmptyTTL = 2 * time.Minute
+	// GetNetworkDefaultLimit is the default page size (nodes) for GetNetwork
+	GetNetworkDefaultLimit = 100
+	// GetNetworkMaxNodes is the hard cap on nodes returned by a single GetNetwork call
+	GetNetworkMaxNodes = 1000
+	// GetNetworkMaxRelations is the hard cap on relations returned by a single GetNetwork call
+	GetNetworkMaxRelations = 5000
+	// ExportNetworkMaxNodes is the hard cap on nodes written by a single ExportNetwork call
+	ExportNetworkMaxNodes = 50000
+	// ExportNetworkMaxRelations is the hard cap on relations written by a single ExportNetwork call
+	ExportNetworkMaxRelations = 200000
 
 	// GetPathCachePrefix is the prefix for get path cache keys
 	GetPathCachePrefix = "network:path:ids:"
@@ -64,12 +80,13 @@ const (
 // Define repository-level errors
 var (
 	ErrInvalidDepth = errors.New("repo: invalid depth value")
+	// ErrInvalidBatch 表示批量请求中存在未注册的节点或关系类型，整个批次被拒绝
+	ErrInvalidBatch = errors.New("repo: invalid batch")
 )
 
 // neo4jNodeRepo 实现了 NodeRepository 接口
 type neo4jNodeRepo struct {
-	driver       neo4j.DriverWithContext
-	nodeDAL      neo4jdal.NodeDAL
+	store        storage.NodeStore
 	cache        cache.NodeAndByteCache
 	relationRepo RelationRepository
 	// 添加配置字段
@@ -82,14 +99,22 @@ type neo4jNodeRepo struct {
 	getPathMaxDepthLimit    int
 	searchNodesDefaultLimit int
 	logger                  *zap.Logger
+	opts                    repoOptions
+
+	// 缓存未命中时按缓存键合并并发的数据库查询
+	nodeFlights    singleflight.Group[*network.Node]
+	searchFlights  singleflight.Group[searchNodesResult]
+	networkFlights singleflight.Group[networkResult]
+	pathFlights    singleflight.Group[pathResult]
+	// 推荐按节点合并
+	recommendationFlights singleflight.Group[recommendationsResult]
 }
 
 // NewNodeRepository 创建一个新的 NodeRepository 实例
-// 依赖注入 Neo4j 驱动、Node DAL 实现、组合缓存实现和 RelationRepository 实现
-// 添加配置参数
+// 依赖注入节点存储 (Neo4j 或内存图)、组合缓存实现和 RelationRepository 实现
+// 添加配置参数; opts 为可选行为 (例如 WithOutbox)
 func NewNodeRepository(
-	driver neo4j.DriverWithContext,
-	nodeDAL neo4jdal.NodeDAL,
+	store storage.NodeStore,
 	cache cache.NodeAndByteCache,
 	relationRepo RelationRepository,
 	defaultNodeTTLSeconds int,
@@ -101,10 +126,11 @@ func NewNodeRepository(
 	getPathMaxDepthLimit int,
 	searchNodesDefaultLimit int,
 	logger *zap.Logger,
+	opts ...RepoOption,
 ) NodeRepository {
-	return &neo4jNodeRepo{
-		driver:       driver,
-		nodeDAL:      nodeDAL,
+	o := buildRepoOptions(opts)
+	r := &neo4jNodeRepo{
+		store:        store,
 		cache:        cache,
 		relationRepo: relationRepo,
 		// 将秒转换为 time.Duration
@@ -117,7 +143,14 @@ func NewNodeRepository(
 		getPathMaxDepthLimit:    getPathMaxDepthLimit,
 		searchNodesDefaultLimit: searchNodesDefaultLimit,
 		logger:                  logger,
+		opts:                    o,
 	}
+	r.nodeFlights.Timeout = o.queryTimeout
+	r.searchFlights.Timeout = o.queryTimeout
+	r.networkFlights.Timeout = o.queryTimeout
+	r.pathFlights.Timeout = o.queryTimeout
+	r.recommendationFlights.Timeout = o.queryTimeout
+	return r
 }
 
 // CreateNode 在 Neo4j 中创建一个新节点
@@ -125,45 +158,157 @@ func NewNodeRepository(
 // 在读取数据时（GetNode）发现缓存未命中，然后从数据库加载并回填到缓存中。CreateNode 属于写操作
 // 所以createNode操作就不用处理缓存了
 func (r *neo4jNodeRepo) CreateNode(ctx context.Context, req *network.CreateNodeRequest) (*network.Node, error) {
-	session := r.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
-	defer session.Close(ctx)
-
 	// 1. 生成唯一业务 ID
 	nodeID := uuid.NewString()
 
-	// 2. 构建节点属性 Map
+	// 2. 构建节点属性 Map (带类型的属性转换为 Neo4j 原生类型)
+	typed, fieldErrs := propvalue.ToDBMap(req.TypedProperties)
+	if len(fieldErrs) > 0 {
+		return nil, fmt.Errorf("repo: 带类型的属性无效: %w", fieldErrs[0])
+	}
+	properties := BuildNodeProperties(nodeID, req.Name, req.Avatar, req.Profession, req.Properties, typed)
+
+	// 3. 调用 DAL 层执行数据库操作 (启用发件箱时同一事务写入 NodeCreated 事件)
+	dbNode, err := r.store.CreateNode(ctx, req.Type, properties, r.opts.events(nodeEvent(neo4jdal.EventNodeCreated, nodeID, req.Type))...)
+	if err != nil {
+		return nil, fmt.Errorf("repo: 调用 DAL 创建节点失败: %w", err)
+	}
+	// 新节点可能开始匹配已缓存的搜索 (没有发件箱消费者时在本地失效)
+	r.opts.applyLocal(ctx, r.logger, nodeChange(neo4jdal.EventNodeCreated, nodeID, dbNode.Labels))
+
+	// 4. 将 DAL 返回的 dbtype.Node 映射为业务模型 network.Node
+	// 注意：dbNode 可能不直接包含所有属性，映射函数需要处理
+	// 暂时假设 mapDbNodeToThriftNode 能正确处理
+	return mapDbNodeToThriftNode(dbNode, req.Type), nil
+}
+
+// BuildNodeProperties 构建写入 Neo4j 的节点属性 Map，自定义属性不会覆盖核心属性
+// typed 为已由 propvalue.ToDBMap 转换的带类型属性，与 custom 中的同名键冲突时优先。
+// 批量导入等直接走 DAL 的写入路径也复用它，保证属性格式与 CreateNode 一致。
+func BuildNodeProperties(nodeID, name string, avatar, profession *string, custom map[string]string, typed map[string]any) map[string]any {
+	now := time.Now().UTC()
 	properties := map[string]any{
 		"id":         nodeID,
-		"name":       req.Name,
-		"created_at": time.Now().UTC(),
-		"updated_at": time.Now().UTC(),
+		"name":       name,
+		"created_at": now,
+		"updated_at": now,
 	}
 	// 处理可选字段
-	if req.Avatar != nil {
-		properties["avatar"] = *req.Avatar
+	if avatar != nil {
+		properties["avatar"] = *avatar
 	}
-	if req.Profession != nil {
-		properties["profession"] = *req.Profession
+	if profession != nil {
+		properties["profession"] = *profession
 	}
 	// 合并自定义属性，避免覆盖核心属性
-	if req.Properties != nil {
-		for k, v := range req.Properties {
-			if _, exists := properties[k]; !exists {
-				properties[k] = v
+	mergeCustomProps(properties, custom, typed)
+	return properties
+}
+
+// mergeCustomProps 将字符串属性和带类型的属性合并到 properties 中，已存在的 (核心) 键不会被覆盖
+func mergeCustomProps(properties map[string]any, custom map[string]string, typed map[string]any) {
+	core := make(map[string]struct{}, len(properties))
+	for k := range properties {
+		core[k] = struct{}{}
+	}
+	for k, v := range custom {
+		if _, exists := core[k]; !exists {
+			properties[k] = v
+		}
+	}
+	for k, v := range typed {
+		if _, exists := core[k]; !exists {
+			properties[k] = v
+		}
+	}
+}
+
+// BatchCreateNodes 在一个写事务中批量创建节点，并可选地创建引用本批次节点临时键的关系
+// 存在未注册的节点或关系类型时整个批次被拒绝 (ErrInvalidBatch)；其他校验失败的项不会写入数据库，
+// 而是在对应的结果中返回错误。节点和关系在同一个事务中提交，失败时整个批次回滚。
+func (r *neo4jNodeRepo) BatchCreateNodes(ctx context.Context, req *network.BatchCreateNodesRequest) ([]*network.BatchNodeResult, []*network.BatchRelationResult, error) {
+	// 1. 整体校验类型
+	for i, item := range req.Nodes {
+		if _, ok := typeregistry.Default().NodeLabel(item.Type); !ok {
+			return nil, nil, fmt.Errorf("%w: 第 %d 个节点使用了未注册的节点类型 %d", ErrInvalidBatch, i, item.Type)
+		}
+	}
+	if err := validateBatchRelationTypes(req.Relations); err != nil {
+		return nil, nil, err
+	}
+
+	// 2. 逐项校验并构建属性，节点 ID 在写入前生成，以便关系中的临时键直接解析为 ID
+	nodeResults := make([]*network.BatchNodeResult, len(req.Nodes))
+	inputs := make([]neo4jdal.BatchNodeInput, 0, len(req.Nodes))
+	inputIdx := make([]int, 0, len(req.Nodes)) // inputs 下标 -> 请求下标
+	keyToID := make(map[string]string, len(req.Nodes))
+	for i, item := range req.Nodes {
+		result := &network.BatchNodeResult{Index: int32(i), TempKey: item.TempKey}
+		nodeResults[i] = result
+		if item.Name == "" {
+			result.Error = func(s string) *string { return &s }("节点名称不能为空")
+			continue
+		}
+		if item.TempKey != nil && *item.TempKey != "" {
+			if _, dup := keyToID[*item.TempKey]; dup {
+				result.Error = func(s string) *string { return &s }(fmt.Sprintf("临时键 %q 在本批次中重复", *item.TempKey))
+				continue
 			}
 		}
+		typed, fieldErrs := propvalue.ToDBMap(item.TypedProperties)
+		if len(fieldErrs) > 0 {
+			result.Error = func(s string) *string { return &s }(fmt.Sprintf("带类型的属性无效: %s", fieldErrs[0].Error()))
+			continue
+		}
+		if err := typeregistry.PropertiesError(typeregistry.Default().NodePropertySchema(item.Type), propvalue.StringView(item.Properties, typed), false); err != nil {
+			result.Error = func(s string) *string { return &s }(err.Error())
+			continue
+		}
+		id := uuid.NewString()
+		if item.TempKey != nil && *item.TempKey != "" {
+			keyToID[*item.TempKey] = id
+		}
+		inputs = append(inputs, neo4jdal.BatchNodeInput{
+			NodeType:   item.Type,
+			Properties: BuildNodeProperties(id, item.Name, item.Avatar, item.Profession, item.Properties, typed),
+		})
+		inputIdx = append(inputIdx, i)
+	}
+	relationResults, relInputs, relInputIdx := prepareBatchRelations(req.Relations, keyToID)
+	if len(inputs) == 0 && len(relInputs) == 0 {
+		return nodeResults, relationResultsOrNil(req, relationResults), nil
 	}
 
-	// 3. 调用 DAL 层执行数据库操作
-	dbNode, err := r.nodeDAL.ExecCreateNode(ctx, session, req.Type, properties)
+	// 3. 单个事务写入所有合法节点和关系
+	events := make([]neo4jdal.ChangeEvent, 0, len(inputs)+len(relInputs))
+	for _, input := range inputs {
+		events = append(events, nodeEvent(neo4jdal.EventNodeCreated, input.Properties["id"].(string), input.NodeType))
+	}
+	events = append(events, batchRelationEvents(relInputs)...)
+	dbNodes, dbRels, err := r.store.BatchCreateGraph(ctx, inputs, relInputs, r.opts.events(events...)...)
 	if err != nil {
-		return nil, fmt.Errorf("repo: 调用 DAL 创建节点失败: %w", err)
+		return nil, nil, fmt.Errorf("repo: 调用 DAL 批量创建节点和关系失败: %w", err)
 	}
 
-	// 4. 将 DAL 返回的 dbtype.Node 映射为业务模型 network.Node
-	// 注意：dbNode 可能不直接包含所有属性，映射函数需要处理
-	// 暂时假设 mapDbNodeToThriftNode 能正确处理
-	return mapDbNodeToThriftNode(dbNode, req.Type), nil
+	// 4. 映射结果
+	changes := make([]neo4jdal.OutboxEvent, 0, len(dbNodes)+len(dbRels))
+	for j, dbNode := range dbNodes {
+		i := inputIdx[j]
+		nodeResults[i].Success = true
+		nodeResults[i].Node = mapDbNodeToThriftNode(dbNode, inputs[j].NodeType)
+		changes = append(changes, nodeChange(neo4jdal.EventNodeCreated, nodeResults[i].Node.ID, dbNode.Labels))
+	}
+	mapBatchRelationResults(relationResults, relInputs, relInputIdx, dbRels)
+	r.opts.applyLocal(ctx, r.logger, append(changes, createdRelationChanges(relInputs, dbRels)...)...)
+	return nodeResults, relationResultsOrNil(req, relationResults), nil
+}
+
+// relationResultsOrNil 未请求关系时返回 nil
+func relationResultsOrNil(req *network.BatchCreateNodesRequest, results []*network.BatchRelationResult) []*network.BatchRelationResult {
+	if len(req.Relations) == 0 {
+		return nil
+	}
+	return results
 }
 
 // GetNode 通过 ID 获取节点，应用 Read-Aside 缓存策略
@@ -185,12 +330,21 @@ func (r *neo4jNodeRepo) GetNode(ctx context.Context, id string) (*network.Node,
 		// 如果是 cache.ErrNotFound，则继续执行数据库查询
 	}
 
-	// 2. 从数据库获取 (缓存未命中或缓存读取失败)
-	r.logger.Debug("Repo: GetNode cache miss, querying database", zap.String("id", id))
-	session := r.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
-	defer session.Close(ctx)
+	// 2. 从数据库获取 (缓存未命中或缓存读取失败)，同一节点的并发请求只查询一次
+	node, _, err := r.nodeFlights.Do(ctx, id, func(ctx context.Context) (*network.Node, error) {
+		return r.loadNode(ctx, id)
+	})
+	return node, err
+}
 
-	dbNode, labels, err := r.nodeDAL.ExecGetNodeByID(ctx, session, id)
+// loadNode 从数据库读取节点并回填缓存 (包括空值)
+func (r *neo4jNodeRepo) loadNode(ctx context.Context, id string) (*network.Node, error) {
+	r.logger.Debug("Repo: GetNode cache miss, querying database", zap.String("id", id))
+	dbNode, labels, err := r.store.GetNodeByID(ctx, id)
+	if err == nil && labels == nil {
+		// 存储层以零值节点和 nil 标签表示未找到
+		err = fmt.Errorf("repo: 节点 %s: %w", id, neo4jdal.ErrNotFound)
+	}
 	if err != nil {
 		// 处理 DAL 返回的特定错误，例如未找到
 		if isNotFoundError(err) { // 使用辅助函数检查错误
@@ -229,11 +383,77 @@ func (r *neo4jNodeRepo) GetNode(ctx context.Context, id string) (*network.Node,
 	return resultNode, nil
 }
 
+// GetNodes 批量获取节点：先用一次缓存批量读取，未命中的 ID 再用一次 DAL 查询补齐并回填缓存。
+// 数据库中也不存在的 ID 缓存空值，与 GetNode 一致。
+func (r *neo4jNodeRepo) GetNodes(ctx context.Context, ids []string) (map[string]*network.Node, error) {
+	result := make(map[string]*network.Node, len(ids))
+	misses := ids
+
+	// 1. 批量读取缓存
+	if r.cache != nil && len(ids) > 0 {
+		cached, err := r.cache.GetNodes(ctx, ids)
+		if err != nil {
+			r.logger.Warn("Repo: 缓存批量获取节点失败", zap.Int("count", len(ids)), zap.Error(err))
+		} else {
+			misses = make([]string, 0, len(ids))
+			for _, id := range ids {
+				node, ok := cached[id]
+				if !ok {
+					misses = append(misses, id)
+				} else if node != nil {
+					result[id] = node
+				}
+			}
+		}
+	}
+	if len(misses) == 0 {
+		return result, nil
+	}
+
+	// 2. 一次数据库查询补齐未命中的节点
+	r.logger.Debug("Repo: GetNodes cache miss, querying database", zap.Int("misses", len(misses)))
+	dbNodes, labelsList, err := r.store.GetNodesByIDs(ctx, misses)
+	if err != nil {
+		return nil, fmt.Errorf("repo: 调用 DAL 批量获取节点失败: %w", err)
+	}
+	loaded := make(map[string]*network.Node, len(dbNodes))
+	found := make(map[string]struct{}, len(dbNodes))
+	for i, dbNode := range dbNodes {
+		found[getStringProp(dbNode.Props, "id", "")] = struct{}{}
+		nodeType, ok := labelToNodeType(labelsList[i])
+		if !ok {
+			r.logger.Warn("Repo: 无法识别节点 的标签", zap.String("elementId", dbNode.ElementId), zap.Strings("labels", labelsList[i]))
+			continue
+		}
+		node := mapDbNodeToThriftNode(dbNode, nodeType)
+		result[node.ID] = node
+		loaded[node.ID] = node
+	}
+
+	// 3. 回填缓存 (找到的节点和数据库中不存在的空值分开写入，TTL 不同)
+	if r.cache != nil {
+		notFound := make(map[string]*network.Node)
+		for _, id := range misses {
+			if _, ok := found[id]; !ok {
+				notFound[id] = nil
+			}
+		}
+		if len(loaded) > 0 {
+			if setErr := r.cache.SetNodes(ctx, loaded, r.defaultNodeTTL); setErr != nil {
+				r.logger.Warn("Repo: 缓存批量设置节点失败", zap.Int("count", len(loaded)), zap.Error(setErr))
+			}
+		}
+		if len(notFound) > 0 {
+			if setErr := r.cache.SetNodes(ctx, notFound, cache.NilValueTTL); setErr != nil {
+				r.logger.Warn("Repo: 缓存批量设置空值节点失败", zap.Int("count", len(notFound)), zap.Error(setErr))
+			}
+		}
+	}
+	return result, nil
+}
+
 // UpdateNode 更新节点属性，应用 Write Invalidation 缓存策略
 func (r *neo4jNodeRepo) UpdateNode(ctx context.Context, req *network.UpdateNodeRequest) (*network.Node, error) {
-	session := r.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
-	defer session.Close(ctx)
-
 	// 1. 构建需要更新的属性 Map
 	updates := map[string]any{
 		"updated_at": time.Now().UTC(), // 总是更新 updated_at
@@ -248,8 +468,19 @@ func (r *neo4jNodeRepo) UpdateNode(ctx context.Context, req *network.UpdateNodeR
 	if req.Profession != nil {
 		updates["profession"] = *req.Profession
 	}
-	if req.Properties != nil {
+	typed, fieldErrs := propvalue.ToDBMap(req.TypedProperties)
+	if len(fieldErrs) > 0 {
+		return nil, fmt.Errorf("repo: 带类型的属性无效: %w", fieldErrs[0])
+	}
+	if req.Properties != nil || typed != nil {
+		custom := make(map[string]any, len(req.Properties)+len(typed))
 		for k, v := range req.Properties {
+			custom[k] = v
+		}
+		for k, v := range typed { // 带类型的值优先
+			custom[k] = v
+		}
+		for k, v := range custom {
 			// 确保不覆盖核心属性或时间戳
 			if k != "id" && k != "created_at" && k != "updated_at" {
 				updates[k] = v
@@ -259,7 +490,7 @@ func (r *neo4jNodeRepo) UpdateNode(ctx context.Context, req *network.UpdateNodeR
 	// 注意：如果需要支持删除属性，请求结构体需要增加字段，例如 `RemoveProperties []string`
 
 	// 2. 调用 DAL 层执行更新
-	dbNode, labels, err := r.nodeDAL.ExecUpdateNode(ctx, session, req.ID, updates)
+	dbNode, labels, err := r.store.UpdateNode(ctx, req.ID, updates, r.opts.events(neo4jdal.ChangeEvent{EventType: neo4jdal.EventNodeUpdated, AggregateID: req.ID})...)
 	if err != nil {
 		if isNotFoundError(err) {
 			return nil, err // 透传 Not Found
@@ -275,7 +506,8 @@ func (r *neo4jNodeRepo) UpdateNode(ctx context.Context, req *network.UpdateNodeR
 	}
 	updatedNode := mapDbNodeToThriftNode(dbNode, nodeType)
 
-	// 4. 使缓存失效 (数据库操作成功后)
+	// 4. 使缓存失效 (数据库操作成功后)，之后的读请求不再加入更新前开始的查询
+	r.nodeFlights.Forget(req.ID)
 	if r.cache != nil { // 检查缓存是否已配置
 		delErr := r.cache.DeleteNode(ctx, req.ID)
 		if delErr != nil && !errors.Is(delErr, cache.ErrNotFound) { // 忽略 NotFound 错误
@@ -283,17 +515,15 @@ func (r *neo4jNodeRepo) UpdateNode(ctx context.Context, req *network.UpdateNodeR
 			r.logger.Warn("Repo: 缓存删除节点失败", zap.String("id", req.ID), zap.Error(delErr))
 		}
 	}
+	r.opts.applyLocal(ctx, r.logger, nodeChange(neo4jdal.EventNodeUpdated, req.ID, labels))
 
 	return updatedNode, nil
 }
 
 // DeleteNode 删除节点，应用 Write Invalidation 缓存策略
 func (r *neo4jNodeRepo) DeleteNode(ctx context.Context, id string) error {
-	session := r.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
-	defer session.Close(ctx)
-
-	// 1. 调用 DAL 层执行删除
-	err := r.nodeDAL.ExecDeleteNode(ctx, session, id)
+	// 1. 调用 DAL 层执行删除 (NodeDeleted 事件在删除前记录，包含随节点删除的关系和邻居)
+	deleted, err := r.store.DeleteNode(ctx, id, r.opts.events(neo4jdal.ChangeEvent{EventType: neo4jdal.EventNodeDeleted, AggregateID: id})...)
 	if err != nil {
 		if isNotFoundError(err) {
 			// 如果 DB 中本来就不存在，对应的缓存也应该删除（或已过期）
@@ -305,6 +535,7 @@ func (r *neo4jNodeRepo) DeleteNode(ctx context.Context, id string) error {
 	}
 
 	// 2. 使缓存失效 (无论 DB 操作是否 NotFound，都尝试删除)
+	r.nodeFlights.Forget(id)
 	if r.cache != nil { // 检查缓存是否已配置
 		delErr := r.cache.DeleteNode(ctx, id)
 		if delErr != nil && !errors.Is(delErr, cache.ErrNotFound) { // 忽略 NotFound 错误
@@ -317,18 +548,22 @@ func (r *neo4jNodeRepo) DeleteNode(ctx context.Context, id string) error {
 	if isNotFoundError(err) {
 		return err
 	}
+	// 随节点删除的关系、邻居的关系列表和经过该节点的网络/路径 (没有发件箱消费者时在本地失效)
+	r.opts.applyLocal(ctx, r.logger, deleteChange(neo4jdal.EventNodeDeleted, "node", id, deleted))
 
 	return nil // DB 删除成功（或本来就不存在）且尝试删除缓存后返回 nil
 }
 
 // searchNodesCacheValue 定义了搜索结果缓存的结构
 type searchNodesCacheValue struct {
-	NodeIDs []string `json:"node_ids"`
-	Total   int32    `json:"total"`
+	NodeIDs    []string         `json:"node_ids"`
+	Total      int32            `json:"total"`
+	NextCursor string           `json:"next_cursor,omitempty"`
+	Facets     []*network.Facet `json:"facets,omitempty"`
 }
 
-// generateSearchNodesCacheKey 生成搜索节点的缓存键
-func generateSearchNodesCacheKey(req *network.SearchNodesRequest) string {
+// generateSearchNodesCacheKey 生成搜索节点的缓存键，sortKeys 和 facets 为已校验的排序键和分面请求
+func generateSearchNodesCacheKey(req *network.SearchNodesRequest, sortKeys []neo4jdal.SortKey, facets *neo4jdal.FacetRequest) string {
 	// 1. 对 criteria map 的键进行排序
 	keys := make([]string, 0, len(req.Criteria))
 	for k := range req.Criteria {
@@ -365,25 +600,62 @@ func generateSearchNodesCacheKey(req *network.SearchNodesRequest) string {
 	// 5. NodeType 也是必需的
 	var nodeTypeStr string
 	if req.Type != nil { // 检查 Type 是否为 nil
-		nodeTypeStr = req.Type.String()
+		nodeTypeStr = typeregistry.Default().NodeTypeName(*req.Type)
 	} else {
 		nodeTypeStr = "ANY" // 或者其他默认值
 	}
 
-	// 6. 格式: prefix:criteria_hash:type:limit:offset
-	return fmt.Sprintf("%s%s:%s:%d:%d", SearchNodesCachePrefix, criteriaHash, nodeTypeStr, limitVal, offsetVal)
+	// 6. 结构化过滤条件 (已规范化) 追加其哈希，无过滤条件时键与之前一致
+	var filterPart string
+	if filterKey := neo4jdal.FilterKey(req.Filter); filterKey != "" {
+		filterHash := sha1.Sum([]byte(filterKey))
+		filterPart = ":f" + hex.EncodeToString(filterHash[:])
+	}
+
+	// 7. 自定义排序追加排序键的哈希，请求分面时追加分面字段的哈希 (分面与 ID 列表缓存在同一个值中)
+	filterPart += sortKeyPart(sortKeys) + facetKeyPart(facets)
+
+	// 8. 游标模式下用游标哈希代替 offset
+	if req.Cursor != nil && *req.Cursor != "" {
+		return fmt.Sprintf("%s%s:%s:%d:%s%s", SearchNodesCachePrefix, criteriaHash, nodeTypeStr, limitVal, cursorKeyPart(*req.Cursor), filterPart)
+	}
+
+	// 9. 格式: prefix:criteria_hash:type:limit:offset[:f<filter_hash>][:s<sort_hash>][:a<facet_hash>]
+	return fmt.Sprintf("%s%s:%s:%d:%d%s", SearchNodesCachePrefix, criteriaHash, nodeTypeStr, limitVal, offsetVal, filterPart)
 }
 
 // SearchNodes 搜索节点 (带缓存)
-func (r *neo4jNodeRepo) SearchNodes(ctx context.Context, req *network.SearchNodesRequest) ([]*network.Node, int32, error) {
-	// 0. 检查缓存是否可用
+func (r *neo4jNodeRepo) SearchNodes(ctx context.Context, req *network.SearchNodesRequest) ([]*network.Node, int32, string, []*network.Facet, error) {
+	// 0. 提前校验排序键、分面字段和游标，避免非法请求写入缓存
+	sortKeys, err := nodeSortKeys(req.Sort, req.Type)
+	if err != nil {
+		return nil, 0, "", nil, err
+	}
+	facets, err := nodeFacetRequest(req.Facets, req.FacetSize, req.Type)
+	if err != nil {
+		return nil, 0, "", nil, err
+	}
+	if _, err := decodePageCursor(req.Cursor, neo4jdal.SortSpec(sortKeys), len(sortKeys)); err != nil {
+		return nil, 0, "", nil, err
+	}
+	// 0.1 校验并规范化过滤表达式，语义相同的写法共用缓存键
+	if err := neo4jdal.ValidateNodeFilter(req.Filter); err != nil {
+		return nil, 0, "", nil, err
+	}
+	if req.Filter != nil {
+		normalized := *req
+		normalized.Filter = neo4jdal.NormalizeNodeFilter(req.Filter)
+		req = &normalized
+	}
+
+	// 0.2 检查缓存是否可用
 	if r.cache == nil {
 		r.logger.Warn("Repo: Cache 未初始化，跳过 SearchNodes 缓存")
 		return r.searchNodesDirect(ctx, req)
 	}
 
 	// 1. 生成缓存键
-	cacheKey := generateSearchNodesCacheKey(req)
+	cacheKey := generateSearchNodesCacheKey(req, sortKeys, facets)
 
 	// 2. 尝试从缓存获取
 	cachedData, err := r.cache.Get(ctx, cacheKey)
@@ -391,7 +663,7 @@ func (r *neo4jNodeRepo) SearchNodes(ctx context.Context, req *network.SearchNode
 		// 2.0 检查是否是空结果标记
 		if bytes.Equal(cachedData, []byte(SearchEmptyPlaceholder)) {
 			r.logger.Info("Repo: SearchNodes 缓存命中空标记", zap.String("cacheKey", cacheKey))
-			return []*network.Node{}, 0, nil // 返回空结果
+			return []*network.Node{}, 0, "", emptyFacets(facets), nil // 返回空结果
 		}
 
 		// 2.1 尝试解析缓存的 ID 列表和总数
@@ -399,26 +671,23 @@ func (r *neo4jNodeRepo) SearchNodes(ctx context.Context, req *network.SearchNode
 		if err := json.NewDecoder(bytes.NewReader(cachedData)).Decode(&cachedValue); err == nil {
 			// 2.1 缓存命中且解析成功
 			r.logger.Info("Repo: SearchNodes 缓存命中", zap.String("cacheKey", cacheKey))
+			// 2.2 使用 GetNodes 批量获取节点详情 (GetNodes 会处理自己的缓存)
+			nodesByID, getNodesErr := r.GetNodes(ctx, cachedValue.NodeIDs)
+			if getNodesErr != nil {
+				r.logger.Error("Repo: SearchNodes 缓存命中，但 GetNodes 失败", zap.String("cacheKey", cacheKey), zap.Error(getNodesErr))
+				return nil, 0, "", nil, getNodesErr
+			}
 			resultNodes := make([]*network.Node, 0, len(cachedValue.NodeIDs))
 			for _, nodeID := range cachedValue.NodeIDs {
-				// 2.2 使用 GetNode 获取节点详情 (GetNode 会处理自己的缓存)
-				node, getNodeErr := r.GetNode(ctx, nodeID) // 注意：这里可能需要处理 GetNode 返回的错误
-				if getNodeErr != nil {
-					// 如果节点未找到（可能在缓存结果生成后被删除），可以选择跳过或记录日志
-					if errors.Is(getNodeErr, cache.ErrNotFound) || isNotFoundError(getNodeErr) {
-						r.logger.Warn("Repo: SearchNodes 缓存命中，但 GetNode 未找到节点 (可能已被删除)", zap.String("nodeID", nodeID))
-						continue
-					}
-					// 其他 GetNode 错误，可能需要中断或返回错误
-					r.logger.Error("Repo: SearchNodes 缓存命中，但 GetNode 失败", zap.String("nodeID", nodeID), zap.Error(getNodeErr))
-					// 暂定策略：跳过此节点，继续处理其他节点
+				node, ok := nodesByID[nodeID]
+				if !ok {
+					// 节点未找到（可能在缓存结果生成后被删除），跳过
+					r.logger.Warn("Repo: SearchNodes 缓存命中，但 GetNodes 未找到节点 (可能已被删除)", zap.String("nodeID", nodeID))
 					continue
 				}
-				if node != nil { // 确保 GetNode 返回了有效的节点
-					resultNodes = append(resultNodes, node)
-				}
+				resultNodes = append(resultNodes, node)
 			}
-			return resultNodes, cachedValue.Total, nil
+			return resultNodes, cachedValue.Total, cachedValue.NextCursor, cachedValue.Facets, nil
 		}
 		// 缓存数据解析失败，当作缓存未命中处理
 		r.logger.Error("Repo: SearchNodes 缓存数据解析失败", zap.String("cacheKey", cacheKey), zap.Error(err))
@@ -430,10 +699,32 @@ func (r *neo4jNodeRepo) SearchNodes(ctx context.Context, req *network.SearchNode
 		r.logger.Info("Repo: SearchNodes 缓存未命中", zap.String("cacheKey", cacheKey))
 	}
 
-	// 3. 缓存未命中或出错，直接查询数据库
-	resultNodes, total, err := r.searchNodesDirect(ctx, req)
+	// 3. 缓存未命中或出错，查询数据库并回填缓存，同一缓存键的并发请求只查询一次
+	result, shared, err := r.searchFlights.Do(ctx, cacheKey, func(ctx context.Context) (searchNodesResult, error) {
+		return r.loadSearchNodes(ctx, req, cacheKey)
+	})
 	if err != nil {
-		return nil, 0, err // 直接返回数据库查询错误
+		return nil, 0, "", nil, err
+	}
+	if shared {
+		result.nodes = slices.Clone(result.nodes)
+	}
+	return result.nodes, result.total, result.nextCursor, result.facets, nil
+}
+
+// searchNodesResult 是 SearchNodes 数据库查询的结果
+type searchNodesResult struct {
+	nodes      []*network.Node
+	total      int32
+	nextCursor string
+	facets     []*network.Facet
+}
+
+// loadSearchNodes 查询数据库并将结果 (或空标记) 写入缓存
+func (r *neo4jNodeRepo) loadSearchNodes(ctx context.Context, req *network.SearchNodesRequest, cacheKey string) (searchNodesResult, error) {
+	resultNodes, total, nextCursor, facets, err := r.searchNodesDirect(ctx, req)
+	if err != nil {
+		return searchNodesResult{}, err // 直接返回数据库查询错误
 	}
 
 	// 4. 缓存结果 (如果查询成功且有结果)
@@ -444,8 +735,10 @@ func (r *neo4jNodeRepo) SearchNodes(ctx context.Context, req *network.SearchNode
 		}
 
 		cacheValue := searchNodesCacheValue{
-			NodeIDs: nodeIDs,
-			Total:   total,
+			NodeIDs:    nodeIDs,
+			Total:      total,
+			NextCursor: nextCursor,
+			Facets:     facets,
 		}
 
 		var buffer bytes.Buffer
@@ -456,6 +749,7 @@ func (r *neo4jNodeRepo) SearchNodes(ctx context.Context, req *network.SearchNode
 				r.logger.Error("Repo: SearchNodes 缓存写入失败", zap.String("cacheKey", cacheKey), zap.Error(setErr))
 			} else {
 				r.logger.Info("Repo: SearchNodes 结果已写入缓存", zap.String("cacheKey", cacheKey))
+				indexDerivedKey(ctx, r.cache, r.logger, cacheKey, append(nodeIDs, searchNodesTag(req)), r.searchNodesTTL)
 			}
 		} else {
 			r.logger.Error("Repo: SearchNodes 缓存值序列化失败", zap.String("cacheKey", cacheKey), zap.Error(err))
@@ -467,24 +761,50 @@ func (r *neo4jNodeRepo) SearchNodes(ctx context.Context, req *network.SearchNode
 			r.logger.Error("Repo: SearchNodes 缓存空标记写入失败", zap.String("cacheKey", cacheKey), zap.Error(setErr))
 		} else {
 			r.logger.Info("Repo: SearchNodes 空结果已写入缓存标记", zap.String("cacheKey", cacheKey))
+			// 同类型 (未指定类型时为任何) 节点的变更都可能让节点开始匹配
+			indexDerivedKey(ctx, r.cache, r.logger, cacheKey, []string{searchNodesTag(req)}, cache.NilValueTTL)
 		}
 	}
 
 	// 5. 返回从数据库获取的结果
-	return resultNodes, total, nil
+	return searchNodesResult{nodes: resultNodes, total: total, nextCursor: nextCursor, facets: facets}, nil
+}
+
+// searchNodesTag 返回搜索结果依赖的标签: 指定类型时只有该类型节点的变更会改变匹配结果
+func searchNodesTag(req *network.SearchNodesRequest) string {
+	if req.Type != nil {
+		return cache.NodeTypeTag(typeregistry.Default().NodeTypeName(*req.Type))
+	}
+	return cache.AnyNodeTag
 }
 
 // searchNodesDirect 是实际执行数据库查询的逻辑 (从原 SearchNodes 提取)
-func (r *neo4jNodeRepo) searchNodesDirect(ctx context.Context, req *network.SearchNodesRequest) ([]*network.Node, int32, error) {
+// 当本页结果已填满 limit 时生成下一页游标：默认排序时记录最后一个节点的 (name, id)，
+// 自定义排序时记录最后一个节点的各排序键取值和 id。
+func (r *neo4jNodeRepo) searchNodesDirect(ctx context.Context, req *network.SearchNodesRequest) ([]*network.Node, int32, string, []*network.Facet, error) {
 	// --- 添加日志：打印接收到的请求参数 ---
 	r.logger.Debug("Repo: searchNodesDirect called with Request",
 		zap.Any("type", req.Type),
 		zap.Any("limit", req.Limit),
 		zap.Any("offset", req.Offset),
-		zap.Any("criteria", req.Criteria))
+		zap.Any("cursor", req.Cursor),
+		zap.Any("criteria", req.Criteria),
+		zap.String("filter", neo4jdal.FilterKey(req.Filter)),
+		zap.Any("sort", req.Sort))
 
-	session := r.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
-	defer session.Close(ctx)
+	sortKeys, err := nodeSortKeys(req.Sort, req.Type)
+	if err != nil {
+		return nil, 0, "", nil, err
+	}
+	facets, err := nodeFacetRequest(req.Facets, req.FacetSize, req.Type)
+	if err != nil {
+		return nil, 0, "", nil, err
+	}
+	sortSpec := neo4jdal.SortSpec(sortKeys)
+	cursor, err := decodePageCursor(req.Cursor, sortSpec, len(sortKeys))
+	if err != nil {
+		return nil, 0, "", nil, err
+	}
 
 	// 直接使用传入的 criteria，如果为 nil 则初始化为空 map
 	criteria := req.Criteria
@@ -505,6 +825,16 @@ func (r *neo4jNodeRepo) searchNodesDirect(ctx context.Context, req *network.Sear
 		offset = 0 // 默认偏移
 	}
 
+	// 游标优先于 offset：从上一页最后一个节点的排序键位置继续 (keyset 分页)
+	var after *neo4jdal.NodeKeyset
+	if cursor != nil {
+		values, err := decodeCursorValues(cursor.Values)
+		if err != nil {
+			return nil, 0, "", nil, err
+		}
+		after = &neo4jdal.NodeKeyset{Name: cursor.Name, ID: cursor.ID, Values: values}
+	}
+
 	// nodeType 从 req.Type 获取，已经是 *network.NodeType
 	nodeTypePtr := req.Type
 
@@ -517,12 +847,29 @@ func (r *neo4jNodeRepo) searchNodesDirect(ctx context.Context, req *network.Sear
 
 	// 调用 DAL 层执行搜索
 	// 确保 DAL 的 ExecSearchNodes 接受 map[string]string 作为 criteria 和 *network.NodeType 作为类型
-	dbNodes, labelsList, total, err := r.nodeDAL.ExecSearchNodes(ctx, session, criteria, nodeTypePtr, limit, offset)
+	dbNodes, labelsList, total, dbFacets, err := r.store.SearchNodes(ctx, criteria, req.Filter, sortKeys, facets, nodeTypePtr, limit, offset, after)
 	if err != nil {
 		// 注意：这里不需要检查 isNotFoundError，因为搜索本身找不到是正常情况，DAL应返回空列表和0 total
 		// --- 添加日志：DAL 调用出错 ---
 		r.logger.Error("Repo: DAL ExecSearchNodes failed", zap.Error(err))
-		return nil, 0, fmt.Errorf("repo: 调用 DAL 搜索节点失败: %w", err)
+		return nil, 0, "", nil, fmt.Errorf("repo: 调用 DAL 搜索节点失败: %w", err)
+	}
+
+	// 生成下一页游标 (使用原始 DB 结果，避免被过滤的节点影响位置)
+	var nextCursor string
+	if limit > 0 && int64(len(dbNodes)) == limit {
+		last := dbNodes[len(dbNodes)-1]
+		if lastID := getStringProp(last.Props, "id", ""); lastID != "" {
+			if sortSpec == "" {
+				nextCursor = encodeCursor(pageCursor{Name: getStringProp(last.Props, "name", ""), ID: lastID})
+			} else {
+				values, err := encodeCursorValues(last.Props, sortKeys)
+				if err != nil {
+					return nil, 0, "", nil, err
+				}
+				nextCursor = encodeCursor(pageCursor{ID: lastID, Sort: sortSpec, Values: values})
+			}
+		}
 	}
 
 	// --- 添加日志：打印 DAL 返回结果 ---
@@ -544,17 +891,68 @@ func (r *neo4jNodeRepo) searchNodesDirect(ctx context.Context, req *network.Sear
 		}
 	}
 
-	return resultNodes, int32(total), nil
+	return resultNodes, int32(total), nextCursor, mapFacets(dbFacets, facets), nil
+}
+
+// FulltextSearchNodes 全文搜索节点。相关度随数据变化，且高亮依赖查询文本，因此不缓存结果。
+func (r *neo4jNodeRepo) FulltextSearchNodes(ctx context.Context, req *network.FulltextSearchNodesRequest) ([]*network.FulltextHit, int32, error) {
+	limit := int64(r.searchNodesDefaultLimit)
+	if req.Limit != nil {
+		limit = int64(*req.Limit)
+	}
+	var offset int64
+	if req.Offset != nil {
+		offset = int64(*req.Offset)
+	}
+	fuzzy := req.GetFuzzy()
+
+	dbHits, total, err := r.store.FulltextSearchNodes(ctx, req.Query, fuzzy, req.Type, limit, offset)
+	if err != nil {
+		if errors.Is(err, fulltext.ErrEmptyQuery) {
+			return nil, 0, err
+		}
+		r.logger.Error("Repo: 全文搜索失败", zap.String("query", req.Query), zap.Error(err))
+		return nil, 0, fmt.Errorf("repo: 全文搜索节点失败: %w", err)
+	}
+
+	// 高亮索引覆盖的文本属性中与查询词匹配的部分
+	terms := fulltext.Terms(req.Query)
+	_, indexedProps := neo4jdal.NodeFulltextIndexSpec(typeregistry.Default().NodeTypes())
+	hits := make([]*network.FulltextHit, 0, len(dbHits))
+	for _, dbHit := range dbHits {
+		nodeType, ok := labelToNodeType(dbHit.Labels)
+		if !ok {
+			r.logger.Warn("Repo: 全文搜索结果中无法识别节点的标签", zap.String("nodeID", getStringProp(dbHit.Node.Props, "id", "[未知ID]")), zap.Strings("labels", dbHit.Labels))
+			continue
+		}
+		node := mapDbNodeToThriftNode(dbHit.Node, nodeType)
+		if node == nil {
+			continue
+		}
+		highlights := make(map[string]string)
+		for _, key := range indexedProps {
+			value, ok := dbHit.Node.Props[key].(string)
+			if !ok {
+				continue
+			}
+			if highlighted, ok := fulltext.Highlight(value, terms, fuzzy); ok {
+				highlights[key] = highlighted
+			}
+		}
+		hits = append(hits, &network.FulltextHit{Node: node, Score: dbHit.Score, Highlights: highlights})
+	}
+	return hits, int32(total), nil
 }
 
 // getNetworkCacheValue 定义了 GetNetwork 结果缓存的结构
 type getNetworkCacheValue struct {
 	NodeIDs     []string `json:"node_ids"`
 	RelationIDs []string `json:"relation_ids"`
+	Truncated   bool     `json:"truncated,omitempty"`
 }
 
 // generateGetNetworkCacheKey 生成 GetNetwork 的缓存键
-func generateGetNetworkCacheKey(req *network.GetNetworkRequest, maxDepth int32, limit, offset int64) string {
+func generateGetNetworkCacheKey(req *network.GetNetworkRequest, maxDepth int32, limit, offset, maxRelations int64) string {
 	// 1. 对 criteria map 的键进行排序
 	criteriaKeys := make([]string, 0, len(req.StartNodeCriteria))
 	for k := range req.StartNodeCriteria {
@@ -578,7 +976,7 @@ func generateGetNetworkCacheKey(req *network.GetNetworkRequest, maxDepth int32,
 	relTypesStr := make([]string, 0, len(req.RelationTypes))
 	if req.IsSetRelationTypes() {
 		for _, rt := range req.RelationTypes {
-			relTypesStr = append(relTypesStr, rt.String())
+			relTypesStr = append(relTypesStr, typeregistry.Default().RelationTypeName(rt))
 		}
 	}
 	sort.Strings(relTypesStr)
@@ -588,7 +986,7 @@ func generateGetNetworkCacheKey(req *network.GetNetworkRequest, maxDepth int32,
 	nodeTypesStr := make([]string, 0, len(req.NodeTypes))
 	if req.IsSetNodeTypes() {
 		for _, nt := range req.NodeTypes {
-			nodeTypesStr = append(nodeTypesStr, nt.String())
+			nodeTypesStr = append(nodeTypesStr, typeregistry.Default().NodeTypeName(nt))
 		}
 	}
 	sort.Strings(nodeTypesStr)
@@ -601,27 +999,55 @@ func generateGetNetworkCacheKey(req *network.GetNetworkRequest, maxDepth int32,
 	hasher.Write([]byte(nodeTypesKeyPart))
 	combinedHash := hex.EncodeToString(hasher.Sum(nil))
 
-	// 6. 格式: prefix:combined_hash:depth:limit:offset
-	return fmt.Sprintf("%s%s:%d:%d:%d", GetNetworkCachePrefix, combinedHash, maxDepth, limit, offset)
+	// 6. 格式: prefix:combined_hash:depth:limit:offset:maxRelations[:d<directions>]
+	return fmt.Sprintf("%s%s:%d:%d:%d:%d%s", GetNetworkCachePrefix, combinedHash, maxDepth, limit, offset, maxRelations,
+		traversalKeyPart(requestTraversal(req.Direction, req.RelationDirections)))
+}
+
+// resolveGetNetworkPaging 根据请求计算 GetNetwork 的分页参数和上限
+// limit 取 limit/max_nodes 中较小者，并受 GetNetworkMaxNodes 硬上限约束；
+// maxRelations 默认为 GetNetworkMaxRelations，且不能超过该值。
+func resolveGetNetworkPaging(req *network.GetNetworkRequest) (limit, offset, maxRelations int64) {
+	limit = GetNetworkDefaultLimit
+	if req.IsSetLimit() && *req.Limit > 0 {
+		limit = int64(*req.Limit)
+	}
+	if req.IsSetMaxNodes() && *req.MaxNodes > 0 && int64(*req.MaxNodes) < limit {
+		limit = int64(*req.MaxNodes)
+	}
+	if limit > GetNetworkMaxNodes {
+		limit = GetNetworkMaxNodes
+	}
+	if req.IsSetOffset() && *req.Offset > 0 {
+		offset = int64(*req.Offset)
+	}
+	maxRelations = GetNetworkMaxRelations
+	if req.IsSetMaxRelations() && *req.MaxRelations > 0 && int64(*req.MaxRelations) < maxRelations {
+		maxRelations = int64(*req.MaxRelations)
+	}
+	return limit, offset, maxRelations
 }
 
 // GetNetwork 获取网络图谱 (节点和关系)，带缓存
+// 返回的 truncated 表示图谱不完整 (还有更多节点可分页获取，或关系被上限截断)。
 // TODO: 从config文件中读取maxDepth
-func (r *neo4jNodeRepo) GetNetwork(ctx context.Context, req *network.GetNetworkRequest) ([]*network.Node, []*network.Relation, error) {
+func (r *neo4jNodeRepo) GetNetwork(ctx context.Context, req *network.GetNetworkRequest) ([]*network.Node, []*network.Relation, bool, error) {
 	// --- Handle Depth < 0 --- (New)
 	if req.Depth < 0 {
-		return nil, nil, ErrInvalidDepth // Return specific error for negative depth
+		return nil, nil, false, ErrInvalidDepth // Return specific error for negative depth
 	}
 
+	limit, offset, maxRelations := resolveGetNetworkPaging(req)
+
 	// --- Handle Depth == 0 --- (New)
 	if req.Depth == 0 {
 		r.logger.Info("Repo: GetNetwork called with Depth 0, finding start nodes only.")
 		// Directly find start nodes using SearchNodes logic
 		searchReq := &network.SearchNodesRequest{
 			Criteria: req.StartNodeCriteria,
-			Type:     nil,                                      // SearchNodes DAL/Repo handles type matching if needed based on criteria or labels
-			Limit:    func(i int32) *int32 { return &i }(1000), // Use a reasonable limit for start nodes
-			Offset:   func(i int32) *int32 { return &i }(0),
+			Type:     nil, // SearchNodes DAL/Repo handles type matching if needed based on criteria or labels
+			Limit:    func(i int32) *int32 { return &i }(int32(limit)),
+			Offset:   func(i int32) *int32 { return &i }(int32(offset)),
 		}
 		// If specific node types are provided in GetNetwork request, set them in SearchNodes request
 		if req.IsSetNodeTypes() && len(req.NodeTypes) > 0 {
@@ -630,20 +1056,20 @@ func (r *neo4jNodeRepo) GetNetwork(ctx context.Context, req *network.GetNetworkR
 			// A more robust solution might involve modifying SearchNodes or using a different DAL method.
 			if len(req.NodeTypes) > 1 {
 				r.logger.Warn("Repo: GetNetwork with Depth 0 received multiple NodeTypes, using only the first for start node search.",
-					zap.String("firstNodeType", req.NodeTypes[0].String()))
+					zap.String("firstNodeType", typeregistry.Default().NodeTypeName(req.NodeTypes[0])))
 			}
 			typePtr := req.NodeTypes[0] // Take the first type
 			searchReq.Type = &typePtr
 		}
 
 		// Call SearchNodes to find the start nodes.
-		// We ignore the 'total' count here.
-		startNodes, _, err := r.SearchNodes(ctx, searchReq)
+		// The 'total' count tells us whether more start nodes exist beyond this page.
+		startNodes, total, _, _, err := r.SearchNodes(ctx, searchReq)
 		if err != nil {
-			return nil, nil, fmt.Errorf("repo: failed to find start nodes for GetNetwork(Depth 0): %w", err)
+			return nil, nil, false, fmt.Errorf("repo: failed to find start nodes for GetNetwork(Depth 0): %w", err)
 		}
 		// Return only the found start nodes and an empty relation slice.
-		return startNodes, []*network.Relation{}, nil
+		return startNodes, []*network.Relation{}, offset+int64(len(startNodes)) < int64(total), nil
 	}
 
 	// --- Handle Depth > 0 (Existing Logic) ---
@@ -656,17 +1082,14 @@ func (r *neo4jNodeRepo) GetNetwork(ctx context.Context, req *network.GetNetworkR
 		maxDepth = 5 // Reset to max allowed depth (e.g., 5)
 	}
 
-	var limit int64 = 100 // 默认限制
-	var offset int64 = 0  // 默认偏移
-
 	// 2. 检查缓存和 RelationRepository 是否可用
 	if r.cache == nil || r.relationRepo == nil {
 		r.logger.Warn("Repo: GetNetwork cache or relationRepo not initialized, skipping cache.")
-		return r.getNetworkDirect(ctx, req, maxDepth, limit, offset)
+		return r.getNetworkDirect(ctx, req, maxDepth, limit, offset, maxRelations)
 	}
 
 	// 3. 生成缓存键
-	cacheKey := generateGetNetworkCacheKey(req, maxDepth, limit, offset)
+	cacheKey := generateGetNetworkCacheKey(req, maxDepth, limit, offset, maxRelations)
 
 	// 4. 尝试从缓存获取
 	cachedData, err := r.cache.Get(ctx, cacheKey)
@@ -674,57 +1097,25 @@ func (r *neo4jNodeRepo) GetNetwork(ctx context.Context, req *network.GetNetworkR
 		// 4.1 检查空标记
 		if bytes.Equal(cachedData, []byte(GetNetworkEmptyPlaceholder)) {
 			r.logger.Info("Repo: GetNetwork cache hit empty placeholder", zap.String("cacheKey", cacheKey))
-			return []*network.Node{}, []*network.Relation{}, nil
+			return []*network.Node{}, []*network.Relation{}, false, nil
 		}
 
 		// 4.2 解析缓存的 ID 列表
 		var cachedValue getNetworkCacheValue
 		if err := json.NewDecoder(bytes.NewReader(cachedData)).Decode(&cachedValue); err == nil {
 			r.logger.Info("Repo: GetNetwork cache hit, fetching details", zap.String("cacheKey", cacheKey))
-			resultNodes := make([]*network.Node, 0, len(cachedValue.NodeIDs))
-			resultRelations := make([]*network.Relation, 0, len(cachedValue.RelationIDs))
-			failedFetches := 0
-
-			// 4.3 使用 GetNode 获取节点
-			for _, nodeID := range cachedValue.NodeIDs {
-				node, getNodeErr := r.GetNode(ctx, nodeID)
-				if getNodeErr != nil {
-					failedFetches++
-					if errors.Is(getNodeErr, cache.ErrNotFound) || isNotFoundError(getNodeErr) || errors.Is(getNodeErr, cache.ErrNilValue) {
-						r.logger.Warn("Repo: GetNetwork cache hit, but GetNode couldn't find node (may be deleted or nil cached)", zap.String("nodeID", nodeID))
-					} else {
-						r.logger.Error("Repo: GetNetwork cache hit, but GetNode failed", zap.String("nodeID", nodeID), zap.Error(getNodeErr))
-					}
-					continue // 跳过获取失败的节点
-				}
-				if node != nil {
-					resultNodes = append(resultNodes, node)
-				}
+			// 4.3 批量获取节点和关系详情，跳过已被删除的
+			resultNodes, resultRelations, missing, hydrateErr := r.hydrateGraph(ctx, cachedValue.NodeIDs, cachedValue.RelationIDs)
+			if hydrateErr != nil {
+				r.logger.Error("Repo: GetNetwork cache hit, but hydrating nodes/relations failed", zap.String("cacheKey", cacheKey), zap.Error(hydrateErr))
+				return nil, nil, false, hydrateErr
 			}
-
-			// 4.4 使用 GetRelation 获取关系
-			for _, relationID := range cachedValue.RelationIDs {
-				relation, getRelErr := r.relationRepo.GetRelation(ctx, relationID)
-				if getRelErr != nil {
-					failedFetches++
-					if errors.Is(getRelErr, cache.ErrNotFound) || isNotFoundError(getRelErr) || errors.Is(getRelErr, cache.ErrNilValue) {
-						r.logger.Warn("Repo: GetNetwork cache hit, but GetRelation couldn't find relation (may be deleted or nil cached)", zap.String("relationID", relationID))
-					} else {
-						r.logger.Error("Repo: GetNetwork cache hit, but GetRelation failed", zap.String("relationID", relationID), zap.Error(getRelErr))
-					}
-					continue // 跳过获取失败的关系
-				}
-				if relation != nil {
-					resultRelations = append(resultRelations, relation)
-				}
-			}
-
-			if failedFetches > 0 {
-				r.logger.Warn("Repo: GetNetwork cache hit, but nodes/relations failed to fetch from detail cache/DB",
-					zap.Int("failedFetches", failedFetches),
+			if missing > 0 {
+				r.logger.Warn("Repo: GetNetwork cache hit, but nodes/relations were not found (may be deleted)",
+					zap.Int("missing", missing),
 					zap.String("cacheKey", cacheKey))
 			}
-			return resultNodes, resultRelations, nil
+			return resultNodes, resultRelations, cachedValue.Truncated, nil
 		}
 		// 缓存数据解析失败，当作未命中
 		r.logger.Error("Repo: GetNetwork cache data decode failed", zap.String("cacheKey", cacheKey), zap.Error(err))
@@ -736,11 +1127,64 @@ func (r *neo4jNodeRepo) GetNetwork(ctx context.Context, req *network.GetNetworkR
 		r.logger.Info("Repo: GetNetwork cache miss", zap.String("cacheKey", cacheKey))
 	}
 
-	// 5. 缓存未命中或出错，直接查询数据库
-	resultNodes, resultRelations, dbNodes, dbRelations, err := r.getNetworkDirectAndRaw(ctx, req, maxDepth, limit, offset)
+	// 5. 缓存未命中或出错，查询数据库并回填缓存，同一缓存键的并发请求只查询一次
+	result, shared, err := r.networkFlights.Do(ctx, cacheKey, func(ctx context.Context) (networkResult, error) {
+		return r.loadNetwork(ctx, req, cacheKey, maxDepth, limit, offset, maxRelations)
+	})
+	if err != nil {
+		return nil, nil, false, err
+	}
+	if shared {
+		result.nodes, result.relations = slices.Clone(result.nodes), slices.Clone(result.relations)
+	}
+	return result.nodes, result.relations, result.truncated, nil
+}
+
+// hydrateGraph 按缓存的 ID 列表顺序批量取回节点和关系 (各一次批量读取)，
+// 返回未找到的节点/关系数量；找不到的元素不出现在结果中。
+func (r *neo4jNodeRepo) hydrateGraph(ctx context.Context, nodeIDs, relationIDs []string) ([]*network.Node, []*network.Relation, int, error) {
+	nodesByID, err := r.GetNodes(ctx, nodeIDs)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	relationsByID, err := r.relationRepo.GetRelations(ctx, relationIDs)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	missing := 0
+	resultNodes := make([]*network.Node, 0, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		if node, ok := nodesByID[nodeID]; ok {
+			resultNodes = append(resultNodes, node)
+		} else {
+			missing++
+		}
+	}
+	resultRelations := make([]*network.Relation, 0, len(relationIDs))
+	for _, relationID := range relationIDs {
+		if relation, ok := relationsByID[relationID]; ok {
+			resultRelations = append(resultRelations, relation)
+		} else {
+			missing++
+		}
+	}
+	return resultNodes, resultRelations, missing, nil
+}
+
+// networkResult 是 GetNetwork 数据库查询的结果
+type networkResult struct {
+	nodes     []*network.Node
+	relations []*network.Relation
+	truncated bool
+}
+
+// loadNetwork 查询数据库并将结果 ID 列表 (或空标记) 写入缓存
+func (r *neo4jNodeRepo) loadNetwork(ctx context.Context, req *network.GetNetworkRequest, cacheKey string, maxDepth int32, limit, offset, maxRelations int64) (networkResult, error) {
+	resultNodes, resultRelations, truncated, dbNodes, dbRelations, err := r.getNetworkDirectAndRaw(ctx, req, maxDepth, limit, offset, maxRelations)
 	if err != nil {
 		// 如果 DAL 层出错，不进行缓存，直接返回错误
-		return nil, nil, err
+		return networkResult{}, err
 	}
 
 	// 6. 缓存结果 (只有在 DAL 没有错误时才执行)
@@ -754,6 +1198,7 @@ func (r *neo4jNodeRepo) GetNetwork(ctx context.Context, req *network.GetNetworkR
 			r.logger.Error("Repo: GetNetwork cache set empty placeholder failed", zap.String("cacheKey", cacheKey), zap.Error(setErr))
 		} else {
 			r.logger.Info("Repo: GetNetwork set empty placeholder to cache", zap.String("cacheKey", cacheKey))
+			indexDerivedKey(ctx, r.cache, r.logger, cacheKey, networkTags(req, offset, truncated), cache.NilValueTTL)
 		}
 	} else {
 		// 6.2 提取 ID 并缓存实际结果
@@ -783,6 +1228,7 @@ func (r *neo4jNodeRepo) GetNetwork(ctx context.Context, req *network.GetNetworkR
 		cacheValue := getNetworkCacheValue{
 			NodeIDs:     nodeIDs,
 			RelationIDs: relationIDs,
+			Truncated:   truncated,
 		}
 		var buffer bytes.Buffer
 		if encErr := json.NewEncoder(&buffer).Encode(cacheValue); encErr == nil {
@@ -791,6 +1237,7 @@ func (r *neo4jNodeRepo) GetNetwork(ctx context.Context, req *network.GetNetworkR
 				r.logger.Error("Repo: GetNetwork cache set failed", zap.String("cacheKey", cacheKey), zap.Error(setErr))
 			} else {
 				r.logger.Info("Repo: GetNetwork set data to cache", zap.String("cacheKey", cacheKey))
+				indexDerivedKey(ctx, r.cache, r.logger, cacheKey, append(nodeIDs, networkTags(req, offset, truncated)...), r.getNetworkTTL)
 			}
 		} else {
 			r.logger.Error("Repo: GetNetwork cache value encode failed", zap.String("cacheKey", cacheKey), zap.Error(encErr))
@@ -799,32 +1246,46 @@ func (r *neo4jNodeRepo) GetNetwork(ctx context.Context, req *network.GetNetworkR
 
 SkipCache:
 	// 7. 返回从数据库获取并映射的结果
-	return resultNodes, resultRelations, nil
+	return networkResult{nodes: resultNodes, relations: resultRelations, truncated: truncated}, nil
+}
+
+// networkTags 返回网络结果除结果节点外依赖的节点 ID 和标签。
+// 起始节点按 id 指定时只依赖该节点，否则任何节点变更都可能改变匹配的起始节点。
+// 结果完整 (未截断、未分页、不按节点类型过滤) 时，能改变结果的关系变更必有一端在结果中，按结果节点失效即可；
+// 否则遍历经过了结果之外的节点，任何关系变更都可能改变结果。
+func networkTags(req *network.GetNetworkRequest, offset int64, truncated bool) []string {
+	tags := []string{cache.AnyNodeTag}
+	if id := req.StartNodeCriteria["id"]; id != "" {
+		tags[0] = id
+	}
+	if truncated || offset > 0 || len(req.NodeTypes) > 0 {
+		tags = append(tags, cache.AnyRelationTag)
+	}
+	return tags
 }
 
 // getNetworkDirect 是实际执行数据库查询和映射的逻辑 (从原 GetNetwork 提取)
 // 为了缓存，我们需要同时返回映射后的结果和原始的 DB 结果以提取 ID
 // 因此创建一个新的内部函数 getNetworkDirectAndRaw
-func (r *neo4jNodeRepo) getNetworkDirectAndRaw(ctx context.Context, req *network.GetNetworkRequest, maxDepth int32, limit, offset int64) (
+func (r *neo4jNodeRepo) getNetworkDirectAndRaw(ctx context.Context, req *network.GetNetworkRequest, maxDepth int32, limit, offset, maxRelations int64) (
 	resultNodes []*network.Node,
 	resultRelations []*network.Relation,
+	truncated bool,
 	dbNodes []dbtype.Node,
 	dbRelations []dbtype.Relationship,
 	err error,
 ) {
-	session := r.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
-	defer session.Close(ctx)
-
 	// 调用 DAL 层获取网络数据 - 使用新的参数
-	dbNodes, dbRelations, err = r.nodeDAL.ExecGetNetwork(
+	dbNodes, dbRelations, truncated, err = r.store.GetNetwork(
 		ctx,
-		session,
 		req.StartNodeCriteria, // 使用 StartNodeCriteria
 		maxDepth,
 		limit,
 		offset,
+		maxRelations,
 		req.RelationTypes, // 传递 RelationTypes
 		req.NodeTypes,     // 传递 NodeTypes
+		requestTraversal(req.Direction, req.RelationDirections),
 	)
 	if err != nil {
 		// GetNetwork 通常不认为"未找到匹配 profession 的节点"是错误，DAL 应返回空列表
@@ -856,13 +1317,35 @@ func (r *neo4jNodeRepo) getNetworkDirectAndRaw(ctx context.Context, req *network
 		}
 	}
 
+	// 关系的另一端可能在之前的页中，这些关系的端点 ID 通过 RelationRepository 批量取回
+	var crossPage map[string]*network.Relation
+	var crossPageIDs []string
+	for _, dbRel := range dbRelations {
+		_, sourceExists := nodesMap[dbRel.StartId]
+		_, targetExists := nodesMap[dbRel.EndId]
+		if relID := getStringProp(dbRel.Props, "id", ""); (!sourceExists || !targetExists) && relID != "" {
+			crossPageIDs = append(crossPageIDs, relID)
+		}
+	}
+	if len(crossPageIDs) > 0 && r.relationRepo != nil {
+		crossPage, err = r.relationRepo.GetRelations(ctx, crossPageIDs)
+		if err != nil {
+			err = fmt.Errorf("repo: 获取跨页关系的端点失败: %w", err)
+			return
+		}
+	}
+
 	// 映射关系
 	resultRelations = make([]*network.Relation, 0, len(dbRelations))
 	for _, dbRel := range dbRelations {
 		sourceNode, sourceExists := nodesMap[dbRel.StartId]
 		targetNode, targetExists := nodesMap[dbRel.EndId]
 		if !sourceExists || !targetExists {
-			r.logger.Warn("Repo: GetNetwork 中关系 的源或目标节点不在返回的节点列表中",
+			if relation, ok := crossPage[getStringProp(dbRel.Props, "id", "")]; ok {
+				resultRelations = append(resultRelations, relation)
+				continue
+			}
+			r.logger.Warn("Repo: GetNetwork 中关系 的源或目标节点无法确定",
 				zap.String("elementId", dbRel.ElementId),
 				zap.Int64("startId", dbRel.StartId),
 				zap.Int64("endId", dbRel.EndId))
@@ -885,22 +1368,117 @@ func (r *neo4jNodeRepo) getNetworkDirectAndRaw(ctx context.Context, req *network
 }
 
 // getNetworkDirect (旧版，仅用于在缓存未初始化时调用)
-func (r *neo4jNodeRepo) getNetworkDirect(ctx context.Context, req *network.GetNetworkRequest, maxDepth int32, limit, offset int64) ([]*network.Node, []*network.Relation, error) {
-	rn, rr, _, _, err := r.getNetworkDirectAndRaw(ctx, req, maxDepth, limit, offset)
-	return rn, rr, err
+func (r *neo4jNodeRepo) getNetworkDirect(ctx context.Context, req *network.GetNetworkRequest, maxDepth int32, limit, offset, maxRelations int64) ([]*network.Node, []*network.Relation, bool, error) {
+	rn, rr, truncated, _, _, err := r.getNetworkDirectAndRaw(ctx, req, maxDepth, limit, offset, maxRelations)
+	return rn, rr, truncated, err
 }
 
-// getPathCacheValue 定义了 GetPath 结果缓存的结构 (保持顺序)
-type getPathCacheValue struct {
+// ExportNetwork 按 GetNetwork 的过滤条件取出完整子图用于导出 (不分页、不走缓存)
+// 节点和关系数受 max_nodes/max_relations 与 ExportNetworkMax* 硬上限约束，超出时 truncated 为 true。
+func (r *neo4jNodeRepo) ExportNetwork(ctx context.Context, req *network.GetNetworkRequest) ([]*network.Node, []*network.Relation, bool, error) {
+	if req.Depth < 0 {
+		return nil, nil, false, ErrInvalidDepth
+	}
+	maxDepth := req.Depth
+	if maxDepth > 5 {
+		r.logger.Warn("Repo: Requested ExportNetwork depth too high, resetting to 5",
+			zap.Int32("requestedDepth", req.Depth))
+		maxDepth = 5
+	}
+
+	var limit int64 = ExportNetworkMaxNodes
+	if req.IsSetMaxNodes() && *req.MaxNodes > 0 && int64(*req.MaxNodes) < limit {
+		limit = int64(*req.MaxNodes)
+	}
+	var maxRelations int64 = ExportNetworkMaxRelations
+	if req.IsSetMaxRelations() && *req.MaxRelations > 0 && int64(*req.MaxRelations) < maxRelations {
+		maxRelations = int64(*req.MaxRelations)
+	}
+
+	return r.getNetworkDirect(ctx, req, maxDepth, limit, 0, maxRelations)
+}
+
+// pathIDs 是缓存中的一条路径 (保持顺序)，Cost 只在 WEIGHTED 模式下设置
+type pathIDs struct {
 	NodeIDs     []string `json:"node_ids"`
 	RelationIDs []string `json:"relation_ids"`
+	Cost        *float64 `json:"cost,omitempty"`
+}
+
+// getPathCacheValue 定义了 GetPath 结果缓存的结构，Paths 按查询返回的顺序排列
+type getPathCacheValue struct {
+	Paths []pathIDs `json:"paths"`
+}
+
+// applyPathMode 将请求的路径查询模式 (默认 SINGLE) 及其参数写入 query:
+// K_SHORTEST 模式的路径数，WEIGHTED 模式的代价属性和缺省代价
+func applyPathMode(req *network.GetPathRequest, query *neo4jdal.PathQuery) {
+	query.Mode = network.PathMode_SINGLE
+	if req.IsSetMode() {
+		query.Mode = *req.Mode
+	}
+	switch query.Mode {
+	case network.PathMode_K_SHORTEST:
+		query.K = neo4jdal.DefaultPathK
+		if req.IsSetK() && *req.K > 0 {
+			query.K = min(int(*req.K), neo4jdal.MaxPathK)
+		}
+	case network.PathMode_WEIGHTED:
+		query.WeightProperty = neo4jdal.DefaultWeightProperty
+		if req.IsSetWeightProperty() && *req.WeightProperty != "" {
+			query.WeightProperty = *req.WeightProperty
+		}
+		query.DefaultWeight = neo4jdal.DefaultRelationWeight
+		if req.IsSetDefaultWeight() && *req.DefaultWeight >= 0 {
+			query.DefaultWeight = *req.DefaultWeight
+		}
+	}
+}
+
+// pathModeKeyPart 生成缓存键中的模式部分，SINGLE 模式返回空字符串 (缓存键与之前一致)
+func pathModeKeyPart(query neo4jdal.PathQuery) string {
+	switch query.Mode {
+	case network.PathMode_ALL_SHORTEST:
+		return ":all"
+	case network.PathMode_K_SHORTEST:
+		return fmt.Sprintf(":k%d", query.K)
+	case network.PathMode_WEIGHTED:
+		// 属性名可能包含 ':'，与缺省代价一起哈希
+		hasher := sha1.New()
+		hasher.Write([]byte(query.WeightProperty + "\x00" + strconv.FormatFloat(query.DefaultWeight, 'g', -1, 64)))
+		return ":w" + hex.EncodeToString(hasher.Sum(nil))
+	}
+	return ""
+}
+
+// applyPathConstraints 将请求中的节点约束写入 query，节点类型转换为标签
+func applyPathConstraints(req *network.GetPathRequest, query *neo4jdal.PathQuery) {
+	query.ExcludeNodeIDs = req.ExcludeNodeIds
+	query.ViaNodeIDs = req.ViaNodeIds
+	for _, nt := range req.IntermediateNodeTypes {
+		query.IntermediateTypes = append(query.IntermediateTypes, typeregistry.Default().NodeTypeName(nt))
+	}
+}
+
+// pathConstraintsKeyPart 生成缓存键中的节点约束部分 (":c<哈希>")，没有约束时返回空字符串 (缓存键与之前一致)
+func pathConstraintsKeyPart(query neo4jdal.PathQuery) string {
+	if len(query.ExcludeNodeIDs) == 0 && len(query.ViaNodeIDs) == 0 && len(query.IntermediateTypes) == 0 {
+		return ""
+	}
+	hasher := sha1.New()
+	for _, ids := range [][]string{query.ExcludeNodeIDs, query.ViaNodeIDs, query.IntermediateTypes} {
+		sorted := slices.Clone(ids)
+		slices.Sort(sorted)
+		hasher.Write([]byte(strings.Join(slices.Compact(sorted), ",") + "\x00"))
+	}
+	return ":c" + hex.EncodeToString(hasher.Sum(nil))
 }
 
 // generateGetPathCacheKey 生成 GetPath 的缓存键
-func generateGetPathCacheKey(req *network.GetPathRequest, maxDepth int32, relationTypesStr []string) string {
+func generateGetPathCacheKey(query neo4jdal.PathQuery) string {
 	// 对关系类型字符串进行排序，确保顺序无关性
-	sortedTypes := make([]string, len(relationTypesStr))
-	copy(sortedTypes, relationTypesStr)
+	sortedTypes := make([]string, len(query.RelTypes))
+	copy(sortedTypes, query.RelTypes)
 	sort.Strings(sortedTypes)
 	typesKeyPart := strings.Join(sortedTypes, ",")
 
@@ -909,13 +1487,14 @@ func generateGetPathCacheKey(req *network.GetPathRequest, maxDepth int32, relati
 	hasher.Write([]byte(typesKeyPart))
 	typesHash := hex.EncodeToString(hasher.Sum(nil))
 
-	// 格式: prefix:sourceID:targetID:maxDepth:typesHash
-	return fmt.Sprintf("%s%s:%s:%d:%s", GetPathCachePrefix, req.SourceID, req.TargetID, maxDepth, typesHash)
+	// 格式: prefix:sourceID:targetID:maxDepth:typesHash[:all|:k<k>|:w<weightHash>][:d<directions>][:c<constraintsHash>]
+	return fmt.Sprintf("%s%s:%s:%d:%s%s%s%s", GetPathCachePrefix, query.SourceID, query.TargetID, query.MaxDepth, typesHash,
+		pathModeKeyPart(query), traversalKeyPart(query.Traversal), pathConstraintsKeyPart(query))
 }
 
-// GetPath 获取两个节点之间的最短路径 (带缓存)
+// GetPath 按请求的模式获取两个节点之间的路径 (带缓存)，每种模式 (以及 K_SHORTEST 的每个 k、WEIGHTED 的每组代价参数) 使用各自的缓存键
 // TODO:从config文件中读取maxDepth
-func (r *neo4jNodeRepo) GetPath(ctx context.Context, req *network.GetPathRequest) ([]*network.Node, []*network.Relation, error) {
+func (r *neo4jNodeRepo) GetPath(ctx context.Context, req *network.GetPathRequest) ([]*network.Path, error) {
 	// 1. 处理参数 (与缓存键生成相关)
 	var maxDepth int32 = 3
 	if req.IsSetMaxDepth() {
@@ -928,18 +1507,28 @@ func (r *neo4jNodeRepo) GetPath(ctx context.Context, req *network.GetPathRequest
 	if req.IsSetTypes() && len(req.Types) > 0 {
 		relationTypesStr = make([]string, 0, len(req.Types))
 		for _, rt := range req.Types {
-			relationTypesStr = append(relationTypesStr, rt.String())
+			relationTypesStr = append(relationTypesStr, typeregistry.Default().RelationTypeName(rt))
 		}
 	}
+	query := neo4jdal.PathQuery{
+		SourceID:  req.SourceID,
+		TargetID:  req.TargetID,
+		MaxDepth:  maxDepth,
+		RelTypes:  relationTypesStr,
+		Traversal: requestTraversal(req.Direction, req.RelationDirections),
+	}
+	applyPathMode(req, &query)
+	applyPathConstraints(req, &query)
 
 	// 2. 检查缓存和 RelationRepository 是否可用
 	if r.cache == nil || r.relationRepo == nil {
 		r.logger.Warn("Repo: GetPath cache or relationRepo not initialized, skipping cache.")
-		return r.getPathDirect(ctx, req, maxDepth, relationTypesStr)
+		paths, _, err := r.getPathsDirectAndRaw(ctx, query)
+		return paths, err
 	}
 
 	// 3. 生成缓存键
-	cacheKey := generateGetPathCacheKey(req, maxDepth, relationTypesStr)
+	cacheKey := generateGetPathCacheKey(query)
 
 	// 4. 尝试从缓存获取
 	cachedData, err := r.cache.Get(ctx, cacheKey)
@@ -947,56 +1536,28 @@ func (r *neo4jNodeRepo) GetPath(ctx context.Context, req *network.GetPathRequest
 		// 4.1 检查空标记
 		if bytes.Equal(cachedData, []byte(GetPathEmptyPlaceholder)) {
 			r.logger.Info("Repo: GetPath cache hit empty placeholder", zap.String("cacheKey", cacheKey))
-			// 路径未找到，根据接口约定，可能需要返回特定错误而非空列表
-			// return []*network.Node{}, []*network.Relation{}, nil // 或者返回原始的 not found 错误?
-			return nil, nil, fmt.Errorf("repo: path not found (cached empty)") // 返回一个表示未找到的错误
+			return nil, fmt.Errorf("repo: path not found (cached empty): %w", ErrPathNotFound)
 		}
 
-		// 4.2 解析缓存的 ID 列表
+		// 4.2 解析缓存的 ID 列表 (旧格式的缓存值没有 paths，当作未命中)
 		var cachedValue getPathCacheValue
-		if err := json.NewDecoder(bytes.NewReader(cachedData)).Decode(&cachedValue); err == nil {
+		if err = json.NewDecoder(bytes.NewReader(cachedData)).Decode(&cachedValue); err == nil && len(cachedValue.Paths) == 0 {
+			err = errors.New("no paths in cache value")
+		}
+		if err == nil {
 			r.logger.Info("Repo: GetPath cache hit, fetching details", zap.String("cacheKey", cacheKey))
-			resultNodes := make([]*network.Node, 0, len(cachedValue.NodeIDs))
-			resultRelations := make([]*network.Relation, 0, len(cachedValue.RelationIDs))
-			failedFetches := 0
-
-			// 4.3 按顺序获取节点
-			for _, nodeID := range cachedValue.NodeIDs {
-				node, getNodeErr := r.GetNode(ctx, nodeID)
-				if getNodeErr != nil {
-					failedFetches++
-					if errors.Is(getNodeErr, cache.ErrNotFound) || isNotFoundError(getNodeErr) || errors.Is(getNodeErr, cache.ErrNilValue) {
-						r.logger.Warn("Repo: GetPath cache hit, but GetNode couldn't find node in path", zap.String("nodeID", nodeID))
-					} else {
-						r.logger.Error("Repo: GetPath cache hit, but GetNode failed", zap.String("nodeID", nodeID), zap.Error(getNodeErr))
-					}
-					// 如果路径中的节点获取失败，整个路径可能无效，中断处理
-					return nil, nil, fmt.Errorf("repo: failed to reconstruct path from cache, node %s fetch failed: %w", nodeID, getNodeErr)
-				}
-				if node != nil {
-					resultNodes = append(resultNodes, node)
-				}
+			// 4.3 批量获取节点和关系，任一路径中有元素缺失则整个结果无效
+			paths, missing, hydrateErr := r.hydratePaths(ctx, cachedValue.Paths)
+			if hydrateErr != nil {
+				r.logger.Error("Repo: GetPath cache hit, but hydrating nodes/relations failed", zap.String("cacheKey", cacheKey), zap.Error(hydrateErr))
+				return nil, fmt.Errorf("repo: failed to reconstruct path from cache: %w", hydrateErr)
 			}
-
-			// 4.4 按顺序获取关系
-			for _, relationID := range cachedValue.RelationIDs {
-				relation, getRelErr := r.relationRepo.GetRelation(ctx, relationID)
-				if getRelErr != nil {
-					failedFetches++
-					if errors.Is(getRelErr, cache.ErrNotFound) || isNotFoundError(getRelErr) || errors.Is(getRelErr, cache.ErrNilValue) {
-						r.logger.Warn("Repo: GetPath cache hit, but GetRelation couldn't find relation in path", zap.String("relationID", relationID))
-					} else {
-						r.logger.Error("Repo: GetPath cache hit, but GetRelation failed", zap.String("relationID", relationID), zap.Error(getRelErr))
-					}
-					// 如果路径中的关系获取失败，整个路径可能无效，中断处理
-					return nil, nil, fmt.Errorf("repo: failed to reconstruct path from cache, relation %s fetch failed: %w", relationID, getRelErr)
-				}
-				if relation != nil {
-					resultRelations = append(resultRelations, relation)
-				}
+			if missing > 0 {
+				r.logger.Warn("Repo: GetPath cache hit, but nodes/relations in path were not found", zap.Int("missing", missing), zap.String("cacheKey", cacheKey))
+				return nil, fmt.Errorf("repo: failed to reconstruct path from cache, %d nodes/relations not found", missing)
 			}
 
-			return resultNodes, resultRelations, nil
+			return paths, nil
 		}
 		// 缓存数据解析失败，当作未命中
 		r.logger.Error("Repo: GetPath cache data decode failed", zap.String("cacheKey", cacheKey), zap.Error(err))
@@ -1007,8 +1568,72 @@ func (r *neo4jNodeRepo) GetPath(ctx context.Context, req *network.GetPathRequest
 		r.logger.Info("Repo: GetPath cache miss", zap.String("cacheKey", cacheKey))
 	}
 
-	// 5. 缓存未命中或出错，直接查询数据库
-	resultNodes, resultRelations, dbNodes, dbRelations, err := r.getPathDirectAndRaw(ctx, req, maxDepth, relationTypesStr)
+	// 5. 缓存未命中或出错，查询数据库并回填缓存，同一缓存键的并发请求只查询一次
+	result, shared, err := r.pathFlights.Do(ctx, cacheKey, func(ctx context.Context) (pathResult, error) {
+		return r.loadPath(ctx, query, cacheKey)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if shared {
+		result.paths = slices.Clone(result.paths)
+	}
+	return result.paths, nil
+}
+
+// hydratePaths 批量取回缓存路径中的节点和关系 (各一次批量读取)，返回未找到的节点/关系数量
+func (r *neo4jNodeRepo) hydratePaths(ctx context.Context, cached []pathIDs) ([]*network.Path, int, error) {
+	var nodeIDs, relationIDs []string
+	for _, p := range cached {
+		nodeIDs = append(nodeIDs, p.NodeIDs...)
+		relationIDs = append(relationIDs, p.RelationIDs...)
+	}
+	slices.Sort(nodeIDs)
+	slices.Sort(relationIDs)
+	nodesByID, err := r.GetNodes(ctx, slices.Compact(nodeIDs))
+	if err != nil {
+		return nil, 0, err
+	}
+	relationsByID, err := r.relationRepo.GetRelations(ctx, slices.Compact(relationIDs))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	missing := 0
+	paths := make([]*network.Path, len(cached))
+	for i, p := range cached {
+		path := &network.Path{
+			Nodes:     make([]*network.Node, 0, len(p.NodeIDs)),
+			Relations: make([]*network.Relation, 0, len(p.RelationIDs)),
+			Cost:      p.Cost,
+		}
+		for _, nodeID := range p.NodeIDs {
+			if node, ok := nodesByID[nodeID]; ok {
+				path.Nodes = append(path.Nodes, node)
+			} else {
+				missing++
+			}
+		}
+		for _, relationID := range p.RelationIDs {
+			if relation, ok := relationsByID[relationID]; ok {
+				path.Relations = append(path.Relations, relation)
+			} else {
+				missing++
+			}
+		}
+		paths[i] = path
+	}
+	return paths, missing, nil
+}
+
+// pathResult 是 GetPath 数据库查询的结果
+type pathResult struct {
+	paths []*network.Path
+}
+
+// loadPath 查询数据库并将路径 ID 列表 (或未找到时的空标记) 写入缓存
+func (r *neo4jNodeRepo) loadPath(ctx context.Context, query neo4jdal.PathQuery, cacheKey string) (pathResult, error) {
+	paths, dbPaths, err := r.getPathsDirectAndRaw(ctx, query)
 	if err != nil {
 		// 5.1 处理错误和缓存空占位符
 		if isNotFoundError(err) { // 确保 isNotFoundError 能识别 DAL 的错误和 Repo 包装的错误
@@ -1020,121 +1645,120 @@ func (r *neo4jNodeRepo) GetPath(ctx context.Context, req *network.GetPathRequest
 				r.logger.Error("Repo: GetPath cache set empty placeholder failed", zap.String("cacheKey", cacheKey), zap.Error(setErr))
 			} else {
 				r.logger.Info("Repo: GetPath set empty placeholder to cache", zap.String("cacheKey", cacheKey))
+				// 任何关系变化都可能使路径出现
+				indexDerivedKey(ctx, r.cache, r.logger, cacheKey, []string{query.SourceID, query.TargetID, cache.AnyRelationTag}, cache.NilValueTTL)
 			}
 			// 返回原始的 Not Found 错误给调用者
-			return nil, nil, err
-		} else {
-			// 其他数据库错误，直接返回，不缓存占位符
-			r.logger.Error("Repo: GetPath query failed", zap.String("cacheKey", cacheKey), zap.Error(err))
-			return nil, nil, err
+			return pathResult{}, err
 		}
+		// 其他数据库错误，直接返回，不缓存占位符
+		r.logger.Error("Repo: GetPath query failed", zap.String("cacheKey", cacheKey), zap.Error(err))
+		return pathResult{}, err
 	}
 
-	// 6. 缓存结果 (查询成功且找到路径)
-	// 注意：之前的逻辑是 (len(dbNodes) > 0 || len(dbRelations) > 0)，对于只有单个节点路径可能不适用。
-	// 只要 err == nil 并且不是 not found，就认为路径找到了（即使只有一个节点）
-	if len(dbNodes) > 0 { // 至少要有一个节点才算有效路径
-		// 6.1 按顺序提取 ID
-		nodeIDs := make([]string, 0, len(dbNodes))
-		for _, dbNode := range dbNodes {
-			if nodeID := getStringProp(dbNode.Props, "id", ""); nodeID != "" {
-				nodeIDs = append(nodeIDs, nodeID)
-			} else {
+	// 6. 缓存结果: 按顺序提取每条路径的 ID，节点或关系缺少 id 时无法缓存
+	cacheValue := getPathCacheValue{Paths: make([]pathIDs, 0, len(dbPaths))}
+	var indexIDs []string
+	for _, dbPath := range dbPaths {
+		ids := pathIDs{
+			NodeIDs:     make([]string, 0, len(dbPath.Nodes)),
+			RelationIDs: make([]string, 0, len(dbPath.Relationships)),
+		}
+		for _, dbNode := range dbPath.Nodes {
+			nodeID := getStringProp(dbNode.Props, "id", "")
+			if nodeID == "" {
 				r.logger.Error("Repo: GetPath DB result node missing 'id' property", zap.String("elementId", dbNode.ElementId))
-				// 如果节点没有ID，无法缓存，可能返回错误或跳过缓存
-				goto SkipCache // 跳到缓存步骤之后
+				return pathResult{paths: paths}, nil
 			}
+			ids.NodeIDs = append(ids.NodeIDs, nodeID)
 		}
-		relationIDs := make([]string, 0, len(dbRelations))
-		for _, dbRel := range dbRelations {
-			if relID := getStringProp(dbRel.Props, "id", ""); relID != "" {
-				relationIDs = append(relationIDs, relID)
-			} else {
+		for _, dbRel := range dbPath.Relationships {
+			relID := getStringProp(dbRel.Props, "id", "")
+			if relID == "" {
 				r.logger.Error("Repo: GetPath DB result relation missing 'id' property", zap.String("elementId", dbRel.ElementId))
-				// 如果关系没有ID，无法缓存
-				goto SkipCache
+				return pathResult{paths: paths}, nil
 			}
+			ids.RelationIDs = append(ids.RelationIDs, relID)
 		}
-
-		// 6.2 序列化并缓存
-		cacheValue := getPathCacheValue{
-			NodeIDs:     nodeIDs,
-			RelationIDs: relationIDs,
+		if query.Mode == network.PathMode_WEIGHTED {
+			ids.Cost = &dbPath.Cost
 		}
-		var buffer bytes.Buffer
-		if encErr := json.NewEncoder(&buffer).Encode(cacheValue); encErr == nil {
-			setErr := r.cache.Set(ctx, cacheKey, buffer.Bytes(), r.getPathTTL)
-			if setErr != nil {
-				r.logger.Error("Repo: GetPath cache set failed", zap.String("cacheKey", cacheKey), zap.Error(setErr))
-			} else {
-				r.logger.Info("Repo: GetPath set data to cache", zap.String("cacheKey", cacheKey))
-			}
+		cacheValue.Paths = append(cacheValue.Paths, ids)
+		indexIDs = append(indexIDs, ids.NodeIDs...)
+	}
+	slices.Sort(indexIDs)
+	indexIDs = slices.Compact(indexIDs)
+
+	var buffer bytes.Buffer
+	if encErr := json.NewEncoder(&buffer).Encode(cacheValue); encErr == nil {
+		setErr := r.cache.Set(ctx, cacheKey, buffer.Bytes(), r.getPathTTL)
+		if setErr != nil {
+			r.logger.Error("Repo: GetPath cache set failed", zap.String("cacheKey", cacheKey), zap.Error(setErr))
 		} else {
-			r.logger.Error("Repo: GetPath cache value encode failed", zap.String("cacheKey", cacheKey), zap.Error(encErr))
+			r.logger.Info("Repo: GetPath set data to cache", zap.String("cacheKey", cacheKey))
+			// 任何关系变化都可能产生更短 (或更便宜) 的路径
+			indexDerivedKey(ctx, r.cache, r.logger, cacheKey, append(indexIDs, cache.AnyRelationTag), r.getPathTTL)
 		}
+	} else {
+		r.logger.Error("Repo: GetPath cache value encode failed", zap.String("cacheKey", cacheKey), zap.Error(encErr))
 	}
 
-SkipCache:
 	// 7. 返回从数据库获取并映射的结果
-	return resultNodes, resultRelations, nil
+	return pathResult{paths: paths}, nil
 }
 
-// getPathDirectAndRaw 是实际执行 GetPath 数据库查询和映射的逻辑
-func (r *neo4jNodeRepo) getPathDirectAndRaw(ctx context.Context, req *network.GetPathRequest, maxDepth int32, relationTypesStr []string) (
-	resultNodes []*network.Node,
-	resultRelations []*network.Relation,
-	dbNodes []dbtype.Node,
-	dbRelations []dbtype.Relationship,
-	err error,
-) {
-	session := r.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
-	defer session.Close(ctx)
-
+// getPathsDirectAndRaw 是实际执行 GetPath 数据库查询和映射的逻辑，同时返回 DAL 的原始路径
+func (r *neo4jNodeRepo) getPathsDirectAndRaw(ctx context.Context, query neo4jdal.PathQuery) ([]*network.Path, []neo4jdal.Path, error) {
 	// 调用 DAL 层获取路径数据
-	dbNodes, dbRelations, err = r.nodeDAL.ExecGetPath(ctx, session, req.SourceID, req.TargetID, maxDepth, relationTypesStr)
+	dbPaths, err := r.store.GetPaths(ctx, query)
 	if err != nil {
-		// 直接将 DAL 错误（包括 Not Found）向上传递
-		// 在 GetPath 方法中处理 Not Found 的缓存逻辑和错误返回
-		// 包装一下错误，以便上层识别来源
-		if isNotFoundError(err) {
-			// 保持原始错误类型，但添加上下文
-			err = fmt.Errorf("repo: path not found between %s and %s (depth %d, types %v): %w", req.SourceID, req.TargetID, maxDepth, relationTypesStr, err)
-		} else {
-			err = fmt.Errorf("repo: 调用 DAL 获取路径失败: %w", err)
-		}
-		return // 返回错误和空的 slices
+		return nil, nil, fmt.Errorf("repo: 调用 DAL 获取路径失败: %w", err)
 	}
 
-	// 如果 DAL 层没有返回错误，但返回了空节点，也视为未找到路径
-	if len(dbNodes) == 0 {
-		// 显式返回一个可被 isNotFoundError 识别的错误
-		// err = neo4jdal.ErrNotFound // Cannot use this as it's not exported
-		err = fmt.Errorf("repo: path not found between %s and %s (empty result from DAL): %w", req.SourceID, req.TargetID, ErrPathNotFound)
-		return // 返回错误和空的 slices
+	// DAL 没有返回路径时视为未找到，返回可被 isNotFoundError 识别的错误
+	if len(dbPaths) == 0 {
+		return nil, nil, fmt.Errorf("repo: path not found between %s and %s (depth %d, types %v, mode %s): %w",
+			query.SourceID, query.TargetID, query.MaxDepth, query.RelTypes, query.Mode, ErrPathNotFound)
+	}
+
+	paths := make([]*network.Path, len(dbPaths))
+	for i, dbPath := range dbPaths {
+		path, err := r.mapPath(dbPath)
+		if err != nil {
+			return nil, nil, err
+		}
+		if query.Mode == network.PathMode_WEIGHTED {
+			path.Cost = &dbPath.Cost
+		}
+		paths[i] = path
 	}
+	return paths, dbPaths, nil
+}
 
-	// 映射节点 (保持顺序)
+// mapPath 将 DAL 返回的一条路径映射为 Thrift 结构 (保持顺序)
+func (r *neo4jNodeRepo) mapPath(dbPath neo4jdal.Path) (*network.Path, error) {
+	// 映射节点
 	nodesMap := make(map[int64]*network.Node) // 仍然需要 Map 来查找关系端点
-	resultNodes = make([]*network.Node, len(dbNodes))
-	for i, dbNode := range dbNodes {
+	path := &network.Path{
+		Nodes:     make([]*network.Node, len(dbPath.Nodes)),
+		Relations: make([]*network.Relation, len(dbPath.Relationships)),
+	}
+	for i, dbNode := range dbPath.Nodes {
 		nodeType, ok := labelToNodeType(dbNode.Labels)
 		if !ok {
 			r.logger.Warn("Repo: GetPath 中无法识别节点 的标签", zap.String("elementId", dbNode.ElementId), zap.Strings("labels", dbNode.Labels))
-			err = fmt.Errorf("repo: 路径中节点类型未知 (%v)", dbNode.Labels)
-			return // 映射失败，返回错误
+			return nil, fmt.Errorf("repo: 路径中节点类型未知 (%v)", dbNode.Labels)
 		}
 		thriftNode := mapDbNodeToThriftNode(dbNode, nodeType)
 		if thriftNode == nil {
-			err = fmt.Errorf("repo: 路径节点映射失败 (elementId: %s)", dbNode.ElementId)
-			return // 映射失败
+			return nil, fmt.Errorf("repo: 路径节点映射失败 (elementId: %s)", dbNode.ElementId)
 		}
-		resultNodes[i] = thriftNode
+		path.Nodes[i] = thriftNode
 		nodesMap[dbNode.Id] = thriftNode
 	}
 
-	// 映射关系 (保持顺序)
-	resultRelations = make([]*network.Relation, len(dbRelations))
-	for i, dbRel := range dbRelations {
+	// 映射关系
+	for i, dbRel := range dbPath.Relationships {
 		sourceNode, sourceExists := nodesMap[dbRel.StartId]
 		targetNode, targetExists := nodesMap[dbRel.EndId]
 		if !sourceExists || !targetExists {
@@ -1142,30 +1766,20 @@ func (r *neo4jNodeRepo) getPathDirectAndRaw(ctx context.Context, req *network.Ge
 				zap.String("elementId", dbRel.ElementId),
 				zap.Int64("startId", dbRel.StartId),
 				zap.Int64("endId", dbRel.EndId))
-			err = fmt.Errorf("repo: 路径中关系端点查找失败")
-			return // 映射失败
+			return nil, fmt.Errorf("repo: 路径中关系端点查找失败")
 		}
 
 		relType, ok := stringToRelationType(dbRel.Type)
 		if !ok {
 			r.logger.Warn("Repo: GetPath 中无法识别关系 的类型", zap.String("elementId", dbRel.ElementId), zap.String("type", dbRel.Type))
-			err = fmt.Errorf("repo: 路径中关系类型未知 (%s)", dbRel.Type)
-			return // 映射失败
+			return nil, fmt.Errorf("repo: 路径中关系类型未知 (%s)", dbRel.Type)
 		}
 
 		thriftRelation := mapDbRelationshipToThriftRelation(dbRel, relType, sourceNode.ID, targetNode.ID)
 		if thriftRelation == nil {
-			err = fmt.Errorf("repo: 路径关系映射失败 (elementId: %s)", dbRel.ElementId)
-			return // 映射失败
+			return nil, fmt.Errorf("repo: 路径关系映射失败 (elementId: %s)", dbRel.ElementId)
 		}
-		resultRelations[i] = thriftRelation
+		path.Relations[i] = thriftRelation
 	}
-
-	return // 返回映射结果、原始 DB 结果和 nil 错误
-}
-
-// getPathDirect (旧版，仅用于在缓存未初始化时调用)
-func (r *neo4jNodeRepo) getPathDirect(ctx context.Context, req *network.GetPathRequest, maxDepth int32, relationTypesStr []string) ([]*network.Node, []*network.Relation, error) {
-	rn, rr, _, _, err := r.getPathDirectAndRaw(ctx, req, maxDepth, relationTypesStr)
-	return rn, rr, err
+	return path, nil
 }
@@ -191,8 +191,8 @@ func setupLargeNetworkForBenchmark(ctx context.Context, b *testing.B, driver neo
 
 // BenchmarkGetNetwork_LargeGraph benchmarks the GetNetwork method with a large graph.
 func BenchmarkGetNetwork_LargeGraph(b *testing.B) {
-	// Ensure testRepo and testDriver are initialized (from TestMain in node_repo_test.go)
-	if testRepo == nil || testDriver == nil {
+	// Ensure benchRepo and testDriver are initialized (from TestMain in node_repo_test.go, requires NEO4J_URI)
+	if benchRepo == nil || testDriver == nil {
 		b.Skip("Skipping benchmark, test environment not initialized (run tests in package mode: go test ./... -bench=.)")
 		return
 	}
@@ -218,7 +218,7 @@ func BenchmarkGetNetwork_LargeGraph(b *testing.B) {
 	// Run the GetNetwork function b.N times
 	for i := 0; i < b.N; i++ {
 		// Execute the actual function being benchmarked
-		nodes, relations, err := testRepo.GetNetwork(ctx, req)
+		nodes, relations, _, err := benchRepo.GetNetwork(ctx, req)
 		if err != nil {
 			// Stop benchmark if a call fails during the run
 			b.Fatalf("GetNetwork failed during benchmark run (iteration %d): %v", i, err)
@@ -236,8 +236,8 @@ func BenchmarkGetNetwork_LargeGraph(b *testing.B) {
 
 // BenchmarkGetNetwork_Concurrent100 benchmarks 100 concurrent GetNetwork calls.
 func BenchmarkGetNetwork_Concurrent1000(b *testing.B) {
-	// Ensure testRepo and testDriver are initialized (from TestMain in node_repo_test.go)
-	if testRepo == nil || testDriver == nil {
+	// Ensure benchRepo and testDriver are initialized (from TestMain in node_repo_test.go, requires NEO4J_URI)
+	if benchRepo == nil || testDriver == nil {
 		b.Skip("Skipping benchmark, test environment not initialized (run tests in package mode: go test ./... -bench=.)")
 		return
 	}
@@ -270,7 +270,7 @@ func BenchmarkGetNetwork_Concurrent1000(b *testing.B) {
 			go func() {
 				defer wg.Done()
 				// Execute the actual function being benchmarked in each goroutine
-				nodes, relations, err := testRepo.GetNetwork(ctx, req)
+				nodes, relations, _, err := benchRepo.GetNetwork(ctx, req)
 				if err != nil {
 					// Use b.Error for non-fatal errors in goroutines
 					// Using b.Fatal might stop the entire benchmark prematurely
@@ -291,7 +291,7 @@ func BenchmarkGetNetwork_Concurrent1000(b *testing.B) {
 
 // BenchmarkCreateNode_LargeData benchmarks creating new nodes.
 func BenchmarkCreateNode_LargeData(b *testing.B) {
-	if testRepo == nil || testDriver == nil {
+	if benchRepo == nil || testDriver == nil {
 		b.Skip("Skipping benchmark, test environment not initialized")
 		return
 	}
@@ -310,7 +310,7 @@ func BenchmarkCreateNode_LargeData(b *testing.B) {
 			Properties: map[string]string{"iter": strconv.Itoa(i)},
 		}
 
-		createdNode, err := testRepo.CreateNode(ctx, req)
+		createdNode, err := benchRepo.CreateNode(ctx, req)
 		if err != nil {
 			b.Fatalf("CreateNode failed during benchmark (iteration %d): %v", i, err)
 		}
@@ -322,7 +322,7 @@ func BenchmarkCreateNode_LargeData(b *testing.B) {
 
 // BenchmarkGetNode_LargeData_Miss benchmarks getting nodes that are not in the cache.
 func BenchmarkGetNode_LargeData_Miss(b *testing.B) {
-	if testRepo == nil || testDriver == nil || testCache == nil {
+	if benchRepo == nil || testDriver == nil || benchCache == nil {
 		b.Skip("Skipping benchmark, test environment not initialized")
 		return
 	}
@@ -344,7 +344,7 @@ func BenchmarkGetNode_LargeData_Miss(b *testing.B) {
 
 	// Ensure cache is clear before starting the timed portion
 	b.StopTimer() // Stop timer for cache clearing
-	clearCacheForIDs(ctx, b, testCache, nodeIDs, "node")
+	clearCacheForIDs(ctx, b, benchCache, nodeIDs, "node")
 	b.StartTimer() // Restart timer for the actual benchmark loop
 	b.ResetTimer()
 
@@ -353,7 +353,7 @@ func BenchmarkGetNode_LargeData_Miss(b *testing.B) {
 		fetchID := nodeIDs[i%numNodesToFetch]
 
 		// Get the node (expecting DB query + cache set)
-		node, err := testRepo.GetNode(ctx, fetchID)
+		node, err := benchRepo.GetNode(ctx, fetchID)
 		if err != nil {
 			// If setup guarantees IDs exist, this is an error
 			b.Errorf("GetNode failed for existing ID %s (iteration %d): %v", fetchID, i, err)
@@ -376,7 +376,7 @@ func BenchmarkGetNode_LargeData_Miss(b *testing.B) {
 
 // BenchmarkGetNode_LargeData_Hit benchmarks getting nodes that are already in the cache.
 func BenchmarkGetNode_LargeData_Hit(b *testing.B) {
-	if testRepo == nil || testDriver == nil || testCache == nil {
+	if benchRepo == nil || testDriver == nil || benchCache == nil {
 		b.Skip("Skipping benchmark, test environment not initialized")
 		return
 	}
@@ -403,7 +403,7 @@ func BenchmarkGetNode_LargeData_Hit(b *testing.B) {
 	b.Logf("Pre-caching %d nodes for hit benchmark...", numNodesToPreCache)
 	b.StopTimer() // Stop timer for pre-caching
 	for _, id := range preCachedIDs {
-		_, err := testRepo.GetNode(ctx, id) // Call GetNode to populate cache
+		_, err := benchRepo.GetNode(ctx, id) // Call GetNode to populate cache
 		if err != nil {
 			b.Logf("Warning: Failed to pre-cache node %s: %v", id, err)
 			// Decide if this should be fatal? For now, log and continue.
@@ -419,7 +419,7 @@ func BenchmarkGetNode_LargeData_Hit(b *testing.B) {
 		fetchID := preCachedIDs[i%numNodesToPreCache]
 
 		// Get the node (expecting cache hit)
-		node, err := testRepo.GetNode(ctx, fetchID)
+		node, err := benchRepo.GetNode(ctx, fetchID)
 		if err != nil {
 			b.Errorf("GetNode failed for cached ID %s (iteration %d): %v", fetchID, i, err)
 		}
@@ -436,7 +436,7 @@ func BenchmarkGetNode_LargeData_Hit(b *testing.B) {
 
 // BenchmarkUpdateNode_LargeData benchmarks updating existing nodes.
 func BenchmarkUpdateNode_LargeData(b *testing.B) {
-	if testRepo == nil || testDriver == nil {
+	if benchRepo == nil || testDriver == nil {
 		b.Skip("Skipping benchmark, test environment not initialized")
 		return
 	}
@@ -474,7 +474,7 @@ func BenchmarkUpdateNode_LargeData(b *testing.B) {
 		}
 
 		// Update the node (includes DB update + cache invalidation)
-		updatedNode, err := testRepo.UpdateNode(ctx, req)
+		updatedNode, err := benchRepo.UpdateNode(ctx, req)
 		if err != nil {
 			// If setup guarantees IDs exist, this is an error
 			b.Errorf("UpdateNode failed for existing ID %s (iteration %d): %v", updateID, i, err)
@@ -494,7 +494,7 @@ func BenchmarkUpdateNode_LargeData(b *testing.B) {
 // BenchmarkDeleteNode_LargeData benchmarks deleting existing nodes.
 // This benchmark creates the node to be deleted within the loop to ensure it exists.
 func BenchmarkDeleteNode_LargeData(b *testing.B) {
-	if testRepo == nil || testDriver == nil {
+	if benchRepo == nil || testDriver == nil {
 		b.Skip("Skipping benchmark, test environment not initialized")
 		return
 	}
@@ -517,7 +517,7 @@ func BenchmarkDeleteNode_LargeData(b *testing.B) {
 			Profession: &prof,
 			Properties: map[string]string{"iter": strconv.Itoa(i), "uuid": uuid.NewString()},
 		}
-		createdNode, errCreate := testRepo.CreateNode(ctx, createReq)
+		createdNode, errCreate := benchRepo.CreateNode(ctx, createReq)
 		if errCreate != nil {
 			b.Fatalf("Failed to create node for delete benchmark (iteration %d): %v", i, errCreate)
 		}
@@ -526,7 +526,7 @@ func BenchmarkDeleteNode_LargeData(b *testing.B) {
 		b.StartTimer() // Start timer ONLY for the DeleteNode call
 
 		// Delete the node (includes DB delete + cache invalidation)
-		err := testRepo.DeleteNode(ctx, deleteID)
+		err := benchRepo.DeleteNode(ctx, deleteID)
 
 		b.StopTimer() // Stop timer immediately after DeleteNode
 
@@ -545,7 +545,7 @@ const defaultConcurrencyLevel = 10000 // Default number of goroutines for concur
 
 // BenchmarkCreateNode_Concurrent benchmarks concurrent node creation.
 func BenchmarkCreateNode_Concurrent(b *testing.B) {
-	if testRepo == nil || testDriver == nil {
+	if benchRepo == nil || testDriver == nil {
 		b.Skip("Skipping benchmark, test environment not initialized")
 		return
 	}
@@ -574,7 +574,7 @@ func BenchmarkCreateNode_Concurrent(b *testing.B) {
 					Properties: map[string]string{"goroutine": strconv.Itoa(goRoutineID)},
 				}
 
-				createdNode, err := testRepo.CreateNode(ctx, req)
+				createdNode, err := benchRepo.CreateNode(ctx, req)
 				if err != nil {
 					b.Errorf("Concurrent CreateNode failed: %v", err)
 					return
@@ -590,7 +590,7 @@ func BenchmarkCreateNode_Concurrent(b *testing.B) {
 
 // BenchmarkGetNode_Concurrent_Miss benchmarks concurrent cache misses.
 func BenchmarkGetNode_Concurrent_Miss(b *testing.B) {
-	if testRepo == nil || testDriver == nil || testCache == nil {
+	if benchRepo == nil || testDriver == nil || benchCache == nil {
 		b.Skip("Skipping benchmark, test environment not initialized")
 		return
 	}
@@ -615,7 +615,7 @@ func BenchmarkGetNode_Concurrent_Miss(b *testing.B) {
 
 	// Clear cache right before the timed section
 	b.StopTimer()
-	clearCacheForIDs(ctx, b, testCache, nodeIDs, "node")
+	clearCacheForIDs(ctx, b, benchCache, nodeIDs, "node")
 	b.StartTimer()
 	b.ResetTimer()
 
@@ -629,7 +629,7 @@ func BenchmarkGetNode_Concurrent_Miss(b *testing.B) {
 				// Pick a unique ID for this goroutine in the batch
 				fetchID := nodeIDs[goRoutineID%numNodesToFetch]
 
-				node, err := testRepo.GetNode(ctx, fetchID)
+				node, err := benchRepo.GetNode(ctx, fetchID)
 				if err != nil && !errors.Is(err, cache.ErrNilValue) { // Allow ErrNilValue if DB was cleared but placeholder remains
 					b.Errorf("Concurrent GetNode (miss) failed for ID %s: %v", fetchID, err)
 				}
@@ -642,7 +642,7 @@ func BenchmarkGetNode_Concurrent_Miss(b *testing.B) {
 
 				// To ensure subsequent iterations are also misses, optionally clear the cache for this ID here.
 				// This adds overhead but makes it a pure miss test.
-				// _ = testCache.DeleteNode(ctx, fetchID)
+				// _ = benchCache.DeleteNode(ctx, fetchID)
 			}(j)
 		}
 		wg.Wait()
@@ -653,7 +653,7 @@ func BenchmarkGetNode_Concurrent_Miss(b *testing.B) {
 
 // BenchmarkGetNode_Concurrent_Hit benchmarks concurrent cache hits.
 func BenchmarkGetNode_Concurrent_Hit(b *testing.B) {
-	if testRepo == nil || testDriver == nil || testCache == nil {
+	if benchRepo == nil || testDriver == nil || benchCache == nil {
 		b.Skip("Skipping benchmark, test environment not initialized")
 		return
 	}
@@ -680,7 +680,7 @@ func BenchmarkGetNode_Concurrent_Hit(b *testing.B) {
 	b.Logf("Pre-caching %d nodes for concurrent hit benchmark...", numNodesToPreCache)
 	b.StopTimer() // Stop timer for pre-caching
 	for _, id := range preCachedIDs {
-		_, err := testRepo.GetNode(ctx, id)
+		_, err := benchRepo.GetNode(ctx, id)
 		if err != nil {
 			b.Logf("Warning: Failed to pre-cache node %s: %v", id, err)
 		}
@@ -698,7 +698,7 @@ func BenchmarkGetNode_Concurrent_Hit(b *testing.B) {
 				// Pick an ID known to be cached - cycle through the pre-cached list
 				fetchID := preCachedIDs[goRoutineID%numNodesToPreCache]
 
-				node, err := testRepo.GetNode(ctx, fetchID)
+				node, err := benchRepo.GetNode(ctx, fetchID)
 				if err != nil {
 					b.Errorf("Concurrent GetNode (hit) failed for cached ID %s: %v", fetchID, err)
 				}
@@ -718,7 +718,7 @@ func BenchmarkGetNode_Concurrent_Hit(b *testing.B) {
 
 // BenchmarkUpdateNode_Concurrent benchmarks concurrent node updates.
 func BenchmarkUpdateNode_Concurrent(b *testing.B) {
-	if testRepo == nil || testDriver == nil {
+	if benchRepo == nil || testDriver == nil {
 		b.Skip("Skipping benchmark, test environment not initialized")
 		return
 	}
@@ -764,7 +764,7 @@ func BenchmarkUpdateNode_Concurrent(b *testing.B) {
 					},
 				}
 
-				updatedNode, err := testRepo.UpdateNode(ctx, req)
+				updatedNode, err := benchRepo.UpdateNode(ctx, req)
 				if err != nil {
 					b.Errorf("Concurrent UpdateNode failed for ID %s: %v", updateID, err)
 				}
@@ -790,7 +790,7 @@ func clearCacheForIDs(ctx context.Context, b *testing.B, c cache.NodeAndByteCach
 	// and we can infer the method based on keyType. A more robust solution might
 	// require separate cache interfaces or type assertions.
 
-	// testCache is likely *RedisCache, which implements both NodeCache and RelationCache
+	// benchCache is likely *RedisCache, which implements both NodeCache and RelationCache
 	// We need access to the specific Delete* methods.
 	nodeCache, okNode := c.(cache.NodeCache)
 	// relCache, okRel := c.(cache.RelationCache) // Assuming we might need this later
@@ -5,47 +5,43 @@ import (
 	"crypto/sha1"
 	"encoding/hex"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
-	"github.com/redis/go-redis/v9" // Or your chosen Redis client library
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap" // <<< 添加 zap 导入
 
 	"labelwall/biz/dal/neo4jdal" // Import the DAL implementation package
+	"labelwall/biz/dal/storage"
 	"labelwall/biz/model/relationship/network"
 	"labelwall/biz/repo/neo4jrepo"
 	"labelwall/pkg/cache" // Assuming RedisCache implementation here
+	"labelwall/pkg/typeregistry"
 )
 
 var (
-	testRepo    neo4jrepo.NodeRepository
-	testDriver  neo4j.DriverWithContext
-	testCache   cache.NodeAndByteCache
-	redisClient *redis.Client
+	testRepo  neo4jrepo.NodeRepository
+	testStore storage.GraphStore
+	testCache cache.NodeAndByteCache
+
+	// Neo4j-backed environment, only initialized when NEO4J_URI is set.
+	// Used by the outbox test and the benchmarks; the repository tests run on the in-memory store.
+	testDriver     neo4j.DriverWithContext
+	neo4jTestStore storage.GraphStore
+	benchRepo      neo4jrepo.NodeRepository
+	benchCache     cache.NodeAndByteCache
 )
 
-// RelTest variables needed by relation_repo_test.go are declared in that file.
-// They are initialized here in TestMain using the same resources.
-/*
-var (
-	relTestRelRepo       neo4jrepo.RelationRepository
-	relTestNodeRepo      neo4jrepo.NodeRepository
-	relTestDriver        neo4j.DriverWithContext
-	relTestCacheClient   *redis.Client
-	relTestRelByteCache  cache.RelationAndByteCache
-	relTestNodeByteCache cache.NodeAndByteCache
-)
-*/
-
 // Helper function to get pointer to NodeType
 func nodeTypePtr(t network.NodeType) *network.NodeType {
 	return &t
@@ -56,156 +52,87 @@ func relationTypePtr(t network.RelationType) *network.RelationType {
 	return &t
 }
 
-// TestMain sets up the database and cache connection for all tests in the package
+// TestMain sets up the in-memory graph store and cache shared by the repository tests,
+// plus the optional Neo4j environment.
 func TestMain(m *testing.M) {
-	// --- Setup Neo4j ---
-	// Read connection details from environment variables or config
-	neo4jURI := os.Getenv("NEO4J_URI") // e.g., "neo4j://localhost:7687"
-	neo4jUser := os.Getenv("NEO4J_USER")
-	neo4jPass := os.Getenv("NEO4J_PASS")
-	if neo4jURI == "" {
-		neo4jURI = "neo4j://localhost:7687" // Default fallback
-	}
-	if neo4jUser == "" {
-		neo4jUser = "neo4j"
-	}
-	if neo4jPass == "" {
-		neo4jPass = "password"
-	}
+	clearTestData(context.Background())
 
-	var err error
-	testDriver, err = neo4j.NewDriverWithContext(neo4jURI, neo4j.BasicAuth(neo4jUser, neo4jPass, ""))
-	if err != nil {
-		panic("Failed to connect to Neo4j: " + err.Error())
-	}
-	// Optional: Verify connectivity
-	err = testDriver.VerifyConnectivity(context.Background())
-	if err != nil {
-		panic("Neo4j connectivity verification failed: " + err.Error())
-	}
+	if neo4jURI := os.Getenv("NEO4J_URI"); neo4jURI != "" { // e.g., "neo4j://localhost:7687"
+		neo4jUser := os.Getenv("NEO4J_USER")
+		neo4jPass := os.Getenv("NEO4J_PASS")
+		if neo4jUser == "" {
+			neo4jUser = "neo4j"
+		}
+		if neo4jPass == "" {
+			neo4jPass = "password"
+		}
 
-	// --- Setup Redis ---
-	redisAddr := os.Getenv("REDIS_ADDR")
-	if redisAddr == "" {
-		redisAddr = "localhost:6381" // Default fallback
-	}
-	redisClient = redis.NewClient(&redis.Options{
-		Addr:     redisAddr,
-		Password: "", // no password set
-		DB:       0,  // use default DB
-	})
-	_, err = redisClient.Ping(context.Background()).Result()
-	if err != nil {
-		testDriver.Close(context.Background()) // Close Neo4j driver if Redis fails
-		panic("Failed to connect to Redis: " + err.Error())
+		var err error
+		testDriver, err = neo4j.NewDriverWithContext(neo4jURI, neo4j.BasicAuth(neo4jUser, neo4jPass, ""))
+		if err != nil {
+			panic("Failed to connect to Neo4j: " + err.Error())
+		}
+		if err := testDriver.VerifyConnectivity(context.Background()); err != nil {
+			panic("Neo4j connectivity verification failed: " + err.Error())
+		}
+		neo4jTestStore = storage.NewNeo4jStore(testDriver, neo4jdal.NewNodeDAL(), neo4jdal.NewRelationDAL(), neo4jdal.NewTypeDAL())
+		benchCacheImpl := cache.NewMemoryCache()
+		benchCache = benchCacheImpl
+		benchRelRepo := neo4jrepo.NewRelationRepository(neo4jTestStore, benchCacheImpl, 300, 1000, zap.NewNop())
+		benchRepo = neo4jrepo.NewNodeRepository(neo4jTestStore, benchCacheImpl, benchRelRepo, 300, 100, 500, 100, 100, 3, 5, 1000, zap.NewNop())
 	}
-	// Instantiate your Redis cache implementation using the correct constructor
-	// Provide estimatedKeys (e.g., 10000) and fpRate (e.g., 0.01) for the Bloom filter.
-	testCacheImpl, err := cache.NewRedisCache(redisClient, "testprefix:", 10000, 0.01) // Use correct constructor and add a test prefix
-	if err != nil {
+
+	exitCode := m.Run()
+
+	if testDriver != nil {
 		testDriver.Close(context.Background())
-		redisClient.Close()
-		panic("Failed to create Redis cache: " + err.Error())
 	}
-	testCache = testCacheImpl // Assign to the interface variable
+	os.Exit(exitCode)
+}
 
-	// Use the same cache implementation for both node and relation tests
-	relationCacheImpl := testCacheImpl // Assuming RelationAndByteCache is compatible or the same impl
+// setupTestRepos wires fresh repositories to store and cacheImpl and assigns the
+// globals used by node_repo_test.go and relation_repo_test.go.
+func setupTestRepos(store storage.GraphStore, cacheImpl *cache.MemoryCache) {
+	testLogger := zap.NewNop()
 
-	// --- Setup DAL ---
-	nodeDal := neo4jdal.NewNodeDAL()
-	relationDal := neo4jdal.NewRelationDAL() // Instantiate RelationDAL
+	testStore = store
+	testCache = cacheImpl
 
-	// --- Setup Repositories ---
 	// Create RelationRepo first as NodeRepo depends on it
-	testLogger, _ := zap.NewDevelopment() // 或者 zap.NewNop() 如果不希望看到任何测试日志
-	defer testLogger.Sync()               // S इंपॉर्टेंट: Sync flushes any buffered log entries
-
-	relationRepoInstance := neo4jrepo.NewRelationRepository(testDriver, relationDal, relationCacheImpl, 300, 1000, testLogger) // Use the specific cache impl, add default params
-	// Create NodeRepo, injecting the created RelationRepo
-	nodeRepoInstance := neo4jrepo.NewNodeRepository(testDriver, nodeDal, testCache, relationRepoInstance, 300, 100, 500, 100, 100, 3, 5, 1000, testLogger) // Add default params and logger
-
-	// --- Assign to Global Test Variables (for node_repo_test.go) ---
-	testRepo = nodeRepoInstance
+	relationRepoInstance := neo4jrepo.NewRelationRepository(store, cacheImpl, 300, 1000, testLogger)
+	testRepo = neo4jrepo.NewNodeRepository(store, cacheImpl, relationRepoInstance, 300, 100, 500, 100, 100, 3, 5, 1000, testLogger)
 
-	// --- Assign to Global RelTest Variables (for relation_repo_test.go) ---
-	// These variables are declared in relation_repo_test.go
 	relTestRelRepo = relationRepoInstance
-	relTestNodeRepo = nodeRepoInstance      // Use the same node repo instance
-	relTestDriver = testDriver              // Use the same driver
-	relTestCacheClient = redisClient        // Use the same redis client
-	relTestRelByteCache = relationCacheImpl // Use the relation cache implementation
-	relTestNodeByteCache = testCache        // Use the node cache implementation
-
-	// --- Clean Database & Cache Before Running ---
-	clearTestData(context.Background())
-
-	// --- Run Tests ---
-	exitCode := m.Run()
-
-	// --- Teardown ---
-	testDriver.Close(context.Background())
-	redisClient.Close()
-	os.Exit(exitCode)
+	relTestNodeRepo = testRepo
+	relTestRelByteCache = cacheImpl
+	relTestNodeByteCache = cacheImpl
 }
 
-// clearTestData cleans Neo4j and Redis
+// clearTestData replaces the graph store and the cache with empty ones
 func clearTestData(ctx context.Context) {
-	// Clear Neo4j
-	session := testDriver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
-
-	// Clear relationships first, then nodes
-	_, errRel := session.Run(ctx, "MATCH ()-[r]->() DELETE r", nil)
-	if errRel != nil {
-		fmt.Printf("Warning: Failed to clear Neo4j relationships: %v\n", errRel)
-	}
-	_, errNode := session.Run(ctx, "MATCH (n) DELETE n", nil)
-	if errNode != nil {
-		fmt.Printf("Warning: Failed to clear Neo4j nodes: %v\n", errNode)
-	}
-	session.Close(ctx)
-
-	// Clear Redis using known prefixes
-	clearRedisCache(ctx) // Call the new helper
+	setupTestRepos(storage.NewMemoryStore(), cache.NewMemoryCache())
 }
 
-// clearRedisCache helper function to clear specific test prefixes from Redis.
-func clearRedisCache(ctx context.Context) {
-	if redisClient == nil {
-		fmt.Println("Warning: redisClient not initialized, cannot clear Redis cache.")
-		return
-	}
-	// Use the EXPORTED constants from neo4jrepo package
-	basePrefix := "testprefix:"
-	prefixesToClear := []string{
-		basePrefix + neo4jrepo.NodeCachePrefix + "*",          // Node details
-		basePrefix + neo4jrepo.RelationCachePrefix + "*",      // Relation details
-		basePrefix + neo4jrepo.SearchNodesCachePrefix + "*",   // Search results IDs
-		basePrefix + neo4jrepo.GetNetworkCachePrefix + "*",    // Network graph IDs
-		basePrefix + neo4jrepo.GetPathCachePrefix + "*",       // Path IDs
-		basePrefix + neo4jrepo.NodeRelationsCachePrefix + "*", // NodeRelations IDs
-	}
-
-	for _, prefix := range prefixesToClear {
-		keys, err := redisClient.Keys(ctx, prefix).Result()
-		if err == nil && len(keys) > 0 {
-			pipe := redisClient.Pipeline()
-			pipe.Del(ctx, keys...)
-			_, err := pipe.Exec(ctx)
-			if err != nil && !errors.Is(err, redis.Nil) { // Ignore nil error if keys expired between KEYS and DEL
-				fmt.Printf("Warning: Failed to clear Redis keys with prefix %s: %v\n", prefix, err)
-			}
-		} else if err != nil && !errors.Is(err, redis.Nil) {
-			fmt.Printf("Warning: Failed to get Redis keys with prefix %s: %v\n", prefix, err)
-		}
-	}
+// clearTestCache replaces the cache with an empty one, keeping the stored graph.
+func clearTestCache(ctx context.Context) {
+	setupTestRepos(testStore, cache.NewMemoryCache())
 }
 
-// Helper function to create a node directly in DB for setup purposes
-func createNodeDirectly(ctx context.Context, node *network.Node) error {
+// clearNeo4jTestData deletes every node and relationship (including outbox events) from Neo4j
+func clearNeo4jTestData(ctx context.Context) {
 	session := testDriver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
 	defer session.Close(ctx)
+	if _, err := session.Run(ctx, "MATCH (n) DETACH DELETE n", nil); err != nil {
+		fmt.Printf("Warning: Failed to clear Neo4j: %v\n", err)
+	}
+}
 
+// Helper function to create a node directly in the store for setup purposes
+func createNodeDirectly(ctx context.Context, node *network.Node) error {
+	// Ensure ID is set if not provided
+	if node.ID == "" {
+		node.ID = uuid.NewString()
+	}
 	properties := map[string]any{
 		"id":         node.ID,
 		"name":       node.Name,
@@ -218,62 +145,38 @@ func createNodeDirectly(ctx context.Context, node *network.Node) error {
 	if node.Profession != nil {
 		properties["profession"] = *node.Profession
 	}
-	if node.Properties != nil {
-		for k, v := range node.Properties {
-			if _, exists := properties[k]; !exists {
-				properties[k] = v
-			}
+	for k, v := range node.Properties {
+		if _, exists := properties[k]; !exists {
+			properties[k] = v
 		}
 	}
 
-	// Ensure ID is set if not provided
-	if node.ID == "" {
-		node.ID = uuid.NewString()
-	}
-	properties["id"] = node.ID // Make sure ID from struct is used
-
-	cypher := fmt.Sprintf("CREATE (n:%s $props) RETURN n", node.Type.String()) // Assuming NodeType.String() returns the label
-	_, err := session.Run(ctx, cypher, map[string]any{"props": properties})
-	if err != nil {
+	if _, err := testStore.CreateNode(ctx, node.Type, properties); err != nil {
 		return fmt.Errorf("failed to create node directly: %w", err)
 	}
 	return nil
 }
 
-// Helper function to create a relation directly in DB for setup purposes
+// Helper function to create a relation directly in the store for setup purposes
 func createRelationDirectly(ctx context.Context, sourceID, targetID string, rel *network.Relation) error {
-	session := testDriver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
-	defer session.Close(ctx)
-
+	if rel.ID == "" {
+		rel.ID = uuid.NewString() // Generate ID if not provided
+	}
 	properties := map[string]any{
+		"id":         rel.ID,
 		"created_at": time.Now().UTC(),
 		"updated_at": time.Now().UTC(),
 	}
-	if rel.ID != "" {
-		properties["id"] = rel.ID
-	} else {
-		properties["id"] = uuid.NewString() // Generate ID if not provided
+	if rel.Label != nil {
+		properties["label"] = *rel.Label
 	}
-	if rel.Properties != nil {
-		for k, v := range rel.Properties {
-			if _, exists := properties[k]; !exists {
-				properties[k] = v
-			}
+	for k, v := range rel.Properties {
+		if _, exists := properties[k]; !exists {
+			properties[k] = v
 		}
 	}
 
-	cypher := fmt.Sprintf(`
-		MATCH (a {id: $sourceId}), (b {id: $targetId})
-		CREATE (a)-[r:%s $props]->(b)
-		RETURN r
-	`, rel.Type.String()) // Assuming RelationType.String() returns the label
-
-	_, err := session.Run(ctx, cypher, map[string]any{
-		"sourceId": sourceID,
-		"targetId": targetID,
-		"props":    properties,
-	})
-	if err != nil {
+	if _, err := testStore.CreateRelation(ctx, sourceID, targetID, rel.Type, properties); err != nil {
 		return fmt.Errorf("failed to create relation directly (%s)-[%s]->(%s): %w", sourceID, rel.Type.String(), targetID, err)
 	}
 	return nil
@@ -333,21 +236,65 @@ func TestCreateAndGetNode_Integration(t *testing.T) {
 	// Or add logging within GetNode to confirm hit/miss
 	// assert.Same(t, retrievedNode1, retrievedNode2, "Second GetNode should return the same cached instance") // This depends on cache implementation
 
-	// 5. Verify in Neo4j Directly (Optional)
-	session := testDriver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
-	defer session.Close(ctx)
-	result, err := session.Run(ctx, "MATCH (c:COMPANY {id: $id}) RETURN c.name AS name", map[string]any{"id": nodeID})
-	require.NoError(t, err, "Direct Neo4j query failed")
-	record, err := result.Single(ctx)
-	require.NoError(t, err, "Failed to get single record from Neo4j")
-	dbName, ok := record.Get("name")
-	assert.True(t, ok, "Name property not found in DB")
-	assert.Equal(t, createReq.Name, dbName.(string), "Name in DB doesn't match")
+	// 5. Verify in the store directly (Optional)
+	dbNode, labels, err := testStore.GetNodeByID(ctx, nodeID)
+	require.NoError(t, err, "Direct store lookup failed")
+	assert.Equal(t, []string{"COMPANY"}, labels)
+	assert.Equal(t, createReq.Name, dbNode.Props["name"], "Name in DB doesn't match")
 
 	// Teardown for this test case (optional if TestMain handles cleanup)
 	// clearTestData(ctx)
 }
 
+// TestTypedProperties_Integration verifies typed values are stored natively and round-trip through the cache
+func TestTypedProperties_Integration(t *testing.T) {
+	ctx := context.Background()
+	require.NotNil(t, testRepo, "Repository should be initialized")
+	clearTestData(ctx)
+
+	age := int64(30)
+	salary := 12345.5
+	createReq := &network.CreateNodeRequest{
+		Type:       network.NodeType_PERSON,
+		Name:       "Typed Person",
+		Properties: map[string]string{"city": "Beijing", "age": "ignored"},
+		TypedProperties: map[string]*network.PropertyValue{
+			"age":      {Kind: network.PropertyValueKind_INT, IntValue: &age},
+			"salary":   {Kind: network.PropertyValueKind_DOUBLE, DoubleValue: &salary},
+			"birthday": {Kind: network.PropertyValueKind_DATE, DateValue: func(s string) *string { return &s }("1994-05-06")},
+			"skills":   {Kind: network.PropertyValueKind_LIST, ListValue: []string{"go", "neo4j"}},
+		},
+	}
+	created, err := testRepo.CreateNode(ctx, createReq)
+	require.NoError(t, err)
+	assert.Equal(t, "30", created.Properties["age"], "typed value wins over the string map")
+	assert.Equal(t, "1994-05-06", created.Properties["birthday"])
+	assert.Equal(t, `["go","neo4j"]`, created.Properties["skills"])
+	assert.Equal(t, network.PropertyValueKind_STRING, created.TypedProperties["city"].Kind)
+
+	for i := 0; i < 2; i++ { // cache miss, then cache hit
+		got, err := testRepo.GetNode(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, age, got.TypedProperties["age"].GetIntValue())
+		assert.Equal(t, salary, got.TypedProperties["salary"].GetDoubleValue())
+		assert.Equal(t, "1994-05-06", got.TypedProperties["birthday"].GetDateValue())
+		assert.Equal(t, []string{"go", "neo4j"}, got.TypedProperties["skills"].GetListValue())
+	}
+
+	// Values are stored as native Neo4j types, so range predicates work
+	dbNode, _, err := testStore.GetNodeByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, age, dbNode.Props["age"])
+	assert.IsType(t, neo4j.Date{}, dbNode.Props["birthday"])
+
+	_, err = testRepo.CreateNode(ctx, &network.CreateNodeRequest{
+		Type:            network.NodeType_PERSON,
+		Name:            "Invalid Typed",
+		TypedProperties: map[string]*network.PropertyValue{"age": {Kind: network.PropertyValueKind_INT}},
+	})
+	assert.Error(t, err, "kind without the matching value is rejected")
+}
+
 // TestUpdateNode_Integration tests the UpdateNode method
 func TestUpdateNode_Integration(t *testing.T) {
 	ctx := context.Background()
@@ -438,6 +385,134 @@ func TestUpdateNode_Integration(t *testing.T) {
 	// assert.True(t, isNotFoundError(err), "Error should indicate 'not found'")
 }
 
+// TestBatchCreateNodes_Integration tests BatchCreateNodes with temp-key relations and per-item errors
+func TestBatchCreateNodes_Integration(t *testing.T) {
+	ctx := context.Background()
+	require.NotNil(t, testRepo, "Repository should be initialized")
+	clearTestData(ctx)
+
+	strPtr := func(s string) *string { return &s }
+	req := &network.BatchCreateNodesRequest{
+		Nodes: []*network.BatchNodeItem{
+			{TempKey: strPtr("alice"), Type: network.NodeType_PERSON, Name: "Batch Alice"},
+			{TempKey: strPtr("acme"), Type: network.NodeType_COMPANY, Name: "Batch Acme", Properties: map[string]string{"hq": "Test City"}},
+			{TempKey: strPtr("empty"), Type: network.NodeType_PERSON, Name: ""},    // invalid: empty name
+			{TempKey: strPtr("alice"), Type: network.NodeType_PERSON, Name: "Dup"}, // invalid: duplicate temp_key
+		},
+		Relations: []*network.BatchRelationItem{
+			{SourceKey: strPtr("alice"), TargetKey: strPtr("acme"), Type: network.RelationType_COLLEAGUE, Label: strPtr("works at")},
+			{SourceKey: strPtr("alice"), TargetKey: strPtr("missing"), Type: network.RelationType_FRIEND}, // invalid: unknown key
+		},
+	}
+
+	nodeResults, relResults, err := testRepo.BatchCreateNodes(ctx, req)
+	require.NoError(t, err, "BatchCreateNodes failed")
+	require.Len(t, nodeResults, 4)
+	require.Len(t, relResults, 2)
+
+	// 1. Node results are reported in input order
+	assert.True(t, nodeResults[0].Success)
+	require.NotNil(t, nodeResults[0].Node)
+	assert.Equal(t, "Batch Alice", nodeResults[0].Node.Name)
+	assert.True(t, nodeResults[1].Success)
+	require.NotNil(t, nodeResults[1].Node)
+	assert.Equal(t, "Test City", nodeResults[1].Node.Properties["hq"])
+	assert.False(t, nodeResults[2].Success, "Empty name should fail")
+	assert.NotNil(t, nodeResults[2].Error)
+	assert.False(t, nodeResults[3].Success, "Duplicate temp_key should fail")
+	for i, r := range nodeResults {
+		assert.Equal(t, int32(i), r.Index)
+	}
+
+	// 2. Relation referencing temp keys is created between the new nodes
+	assert.True(t, relResults[0].Success)
+	require.NotNil(t, relResults[0].Relation)
+	assert.Equal(t, nodeResults[0].Node.ID, relResults[0].Relation.Source)
+	assert.Equal(t, nodeResults[1].Node.ID, relResults[0].Relation.Target)
+	assert.False(t, relResults[1].Success, "Unknown temp_key should fail")
+
+	// 3. Created nodes are readable through the repository
+	got, err := testRepo.GetNode(ctx, nodeResults[0].Node.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "Batch Alice", got.Name)
+
+	// 4. An unregistered type anywhere in the batch rejects the whole batch before writing
+	rejected := &network.BatchCreateNodesRequest{
+		Nodes: []*network.BatchNodeItem{
+			{TempKey: strPtr("bob"), Type: network.NodeType_PERSON, Name: "Batch Bob"},
+		},
+		Relations: []*network.BatchRelationItem{
+			{SourceKey: strPtr("bob"), Target: &nodeResults[1].Node.ID, Type: network.RelationType(999)},
+		},
+	}
+	_, _, err = testRepo.BatchCreateNodes(ctx, rejected)
+	require.ErrorIs(t, err, neo4jrepo.ErrInvalidBatch)
+	found, _, _, _, err := testRepo.SearchNodes(ctx, &network.SearchNodesRequest{Criteria: map[string]string{"name": "Batch Bob"}})
+	require.NoError(t, err)
+	assert.Empty(t, found, "Rejected batch must not create nodes")
+}
+
+// TestBatchCreate_PropertySchema_Integration checks that batch items violating the type's
+// property constraints fail individually without affecting the rest of the batch.
+func TestBatchCreate_PropertySchema_Integration(t *testing.T) {
+	ctx := context.Background()
+	require.NotNil(t, testRepo, "Repository should be initialized")
+	clearTestData(ctx)
+
+	registry := typeregistry.Default()
+	person := registry.NodeTypes()[slices.IndexFunc(registry.NodeTypes(), func(d typeregistry.NodeTypeDef) bool { return d.Code == network.NodeType_PERSON })]
+	friend := registry.RelationTypes()[slices.IndexFunc(registry.RelationTypes(), func(d typeregistry.RelationTypeDef) bool { return d.Code == network.RelationType_FRIEND })]
+	constrainedPerson, constrainedFriend := person, friend
+	constrainedPerson.Properties = []typeregistry.PropertySchema{{Key: "age", Type: typeregistry.ValueInt}}
+	constrainedFriend.Properties = []typeregistry.PropertySchema{{Key: "since", Type: typeregistry.ValueInt, Required: true}}
+	require.NoError(t, registry.PutNodeType(constrainedPerson))
+	require.NoError(t, registry.PutRelationType(constrainedFriend))
+	t.Cleanup(func() {
+		require.NoError(t, registry.PutNodeType(person))
+		require.NoError(t, registry.PutRelationType(friend))
+	})
+
+	strPtr := func(s string) *string { return &s }
+	nodeResults, relResults, err := testRepo.BatchCreateNodes(ctx, &network.BatchCreateNodesRequest{
+		Nodes: []*network.BatchNodeItem{
+			{TempKey: strPtr("alice"), Type: network.NodeType_PERSON, Name: "Schema Alice", Properties: map[string]string{"age": "30"}},
+			{TempKey: strPtr("bob"), Type: network.NodeType_PERSON, Name: "Schema Bob", Properties: map[string]string{"age": "thirty"}},     // invalid: not an int
+			{TempKey: strPtr("carol"), Type: network.NodeType_PERSON, Name: "Schema Carol", Properties: map[string]string{"nickname": "c"}}, // invalid: undeclared key
+		},
+		Relations: []*network.BatchRelationItem{
+			{SourceKey: strPtr("alice"), TargetKey: strPtr("alice"), Type: network.RelationType_FRIEND, Properties: map[string]string{"since": "2020"}},
+			{SourceKey: strPtr("alice"), TargetKey: strPtr("alice"), Type: network.RelationType_FRIEND}, // invalid: missing required key
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, nodeResults, 3)
+	require.Len(t, relResults, 2)
+
+	assert.True(t, nodeResults[0].Success)
+	assert.False(t, nodeResults[1].Success)
+	require.NotNil(t, nodeResults[1].Error)
+	assert.Contains(t, *nodeResults[1].Error, "age")
+	assert.False(t, nodeResults[2].Success)
+	require.NotNil(t, nodeResults[2].Error)
+	assert.Contains(t, *nodeResults[2].Error, "nickname")
+
+	assert.True(t, relResults[0].Success)
+	assert.False(t, relResults[1].Success)
+	require.NotNil(t, relResults[1].Error)
+	assert.Contains(t, *relResults[1].Error, "since")
+
+	// The same constraints apply to BatchCreateRelations
+	results, err := relTestRelRepo.BatchCreateRelations(ctx, &network.BatchCreateRelationsRequest{
+		Relations: []*network.BatchRelationItem{
+			{Source: &nodeResults[0].Node.ID, Target: &nodeResults[0].Node.ID, Type: network.RelationType_FRIEND, Properties: map[string]string{"since": "long ago"}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.False(t, results[0].Success)
+}
+
 // TestDeleteNode_Integration tests the DeleteNode method
 func TestDeleteNode_Integration(t *testing.T) {
 	ctx := context.Background()
@@ -664,7 +739,7 @@ func TestSearchNodes_Integration(t *testing.T) {
 		assert.ErrorIs(t, err, cache.ErrNotFound, "Cache should be empty before first search")
 
 		// Execute search
-		nodes, total, err := testRepo.SearchNodes(ctx, searchReq)
+		nodes, total, _, _, err := testRepo.SearchNodes(ctx, searchReq)
 		require.NoError(t, err, "SearchNodes failed")
 		assert.EqualValues(t, 1, total, "Expected 1 total result")
 		require.Len(t, nodes, 1, "Expected 1 node in results")
@@ -704,7 +779,7 @@ func TestSearchNodes_Integration(t *testing.T) {
 		// Execute search again
 		// Add logging in SearchNodes repo method to confirm cache hit if needed
 		t.Log("Expecting SearchNodes cache hit...")
-		nodes, total, err := testRepo.SearchNodes(ctx, searchReq)
+		nodes, total, _, _, err := testRepo.SearchNodes(ctx, searchReq)
 		require.NoError(t, err, "SearchNodes (cache hit) failed")
 		assert.EqualValues(t, 1, total, "Expected 1 total result (cache hit)")
 		require.Len(t, nodes, 1, "Expected 1 node in results (cache hit)")
@@ -723,7 +798,7 @@ func TestSearchNodes_Integration(t *testing.T) {
 			Limit:    func(i int32) *int32 { return &i }(1),
 			Offset:   func(i int32) *int32 { return &i }(0),
 		}
-		nodes1, total1, err1 := testRepo.SearchNodes(ctx, searchReq1)
+		nodes1, total1, _, _, err1 := testRepo.SearchNodes(ctx, searchReq1)
 		require.NoError(t, err1)
 		assert.EqualValues(t, 2, total1, "Expected 2 total Engineers")
 		require.Len(t, nodes1, 1, "Expected 1 node on page 1")
@@ -739,7 +814,7 @@ func TestSearchNodes_Integration(t *testing.T) {
 			Limit:    func(i int32) *int32 { return &i }(1),
 			Offset:   func(i int32) *int32 { return &i }(1),
 		}
-		nodes2, total2, err2 := testRepo.SearchNodes(ctx, searchReq2)
+		nodes2, total2, _, _, err2 := testRepo.SearchNodes(ctx, searchReq2)
 		require.NoError(t, err2)
 		assert.EqualValues(t, 2, total2, "Expected 2 total Engineers (page 2)")
 		require.Len(t, nodes2, 1, "Expected 1 node on page 2")
@@ -771,6 +846,55 @@ func TestSearchNodes_Integration(t *testing.T) {
 
 	})
 
+	// --- Test Case 3.1: Search with Cursor Pagination (PERSON, "Engineer") ---
+	t.Run("Search with Cursor Pagination", func(t *testing.T) {
+		searchReq1 := &network.SearchNodesRequest{
+			Type:     nodeTypePtr(network.NodeType_PERSON),
+			Criteria: map[string]string{"profession": "Engineer"},
+			Limit:    func(i int32) *int32 { return &i }(1),
+		}
+		nodes1, total1, cursor1, _, err1 := testRepo.SearchNodes(ctx, searchReq1)
+		require.NoError(t, err1)
+		assert.EqualValues(t, 2, total1)
+		require.Len(t, nodes1, 1)
+		require.NotEmpty(t, cursor1, "Expected next cursor when page is full")
+
+		searchReq2 := &network.SearchNodesRequest{
+			Type:     nodeTypePtr(network.NodeType_PERSON),
+			Criteria: map[string]string{"profession": "Engineer"},
+			Limit:    func(i int32) *int32 { return &i }(1),
+			Cursor:   &cursor1,
+		}
+		nodes2, total2, cursor2, _, err2 := testRepo.SearchNodes(ctx, searchReq2)
+		require.NoError(t, err2)
+		assert.EqualValues(t, 2, total2, "Total should not be affected by cursor")
+		require.Len(t, nodes2, 1)
+		assert.NotEqual(t, nodes1[0].ID, nodes2[0].ID, "Cursor page should continue after page 1")
+		require.NotEmpty(t, cursor2)
+
+		searchReq3 := &network.SearchNodesRequest{
+			Type:     nodeTypePtr(network.NodeType_PERSON),
+			Criteria: map[string]string{"profession": "Engineer"},
+			Limit:    func(i int32) *int32 { return &i }(1),
+			Cursor:   &cursor2,
+		}
+		nodes3, _, cursor3, _, err3 := testRepo.SearchNodes(ctx, searchReq3)
+		require.NoError(t, err3)
+		assert.Len(t, nodes3, 0, "Expected no nodes after the last page")
+		assert.Empty(t, cursor3, "Expected no next cursor on the last page")
+
+		// Cached cursor page must return the same next cursor
+		nodes2Hit, _, cursor2Hit, _, errHit := testRepo.SearchNodes(ctx, searchReq2)
+		require.NoError(t, errHit)
+		require.Len(t, nodes2Hit, 1)
+		assert.Equal(t, nodes2[0].ID, nodes2Hit[0].ID)
+		assert.Equal(t, cursor2, cursor2Hit)
+
+		badCursor := "not-a-cursor"
+		_, _, _, _, errBad := testRepo.SearchNodes(ctx, &network.SearchNodesRequest{Cursor: &badCursor})
+		assert.ErrorIs(t, errBad, neo4jrepo.ErrInvalidCursor)
+	})
+
 	// --- Test Case 4: Search with No Results (PERSON, "Unknown") ---
 	t.Run("Search No Results", func(t *testing.T) {
 		searchReq := &network.SearchNodesRequest{
@@ -782,7 +906,7 @@ func TestSearchNodes_Integration(t *testing.T) {
 		}
 		cacheKey := generateSearchNodesCacheKeyForTest(searchReq)
 
-		nodes, total, err := testRepo.SearchNodes(ctx, searchReq)
+		nodes, total, _, _, err := testRepo.SearchNodes(ctx, searchReq)
 		require.NoError(t, err)
 		assert.EqualValues(t, 0, total, "Expected 0 total results")
 		assert.Len(t, nodes, 0, "Expected 0 nodes in results")
@@ -794,7 +918,7 @@ func TestSearchNodes_Integration(t *testing.T) {
 		assert.Equal(t, []byte(neo4jrepo.SearchEmptyPlaceholder), cachedData, "Cache should contain empty placeholder")
 
 		// Search again (Cache Hit for empty)
-		nodesHit, totalHit, errHit := testRepo.SearchNodes(ctx, searchReq)
+		nodesHit, totalHit, _, _, errHit := testRepo.SearchNodes(ctx, searchReq)
 		require.NoError(t, errHit)
 		assert.EqualValues(t, 0, totalHit, "Expected 0 total results (empty cache hit)")
 		assert.Len(t, nodesHit, 0, "Expected 0 nodes in results (empty cache hit)")
@@ -810,13 +934,127 @@ func TestSearchNodes_Integration(t *testing.T) {
 			Limit:    func(i int32) *int32 { return &i }(10),
 			Offset:   func(i int32) *int32 { return &i }(0),
 		}
-		nodes, total, err := testRepo.SearchNodes(ctx, searchReq)
+		nodes, total, _, _, err := testRepo.SearchNodes(ctx, searchReq)
 		require.NoError(t, err)
 		assert.EqualValues(t, 1, total)
 		require.Len(t, nodes, 1)
 		assert.Equal(t, "search-c1", nodes[0].ID)
 		assert.Equal(t, "Alpha Corp", nodes[0].Name)
 	})
+
+	// --- Test Case 6: Structured filter (OR group, prefix, case-insensitive) ---
+	t.Run("Search With Filter", func(t *testing.T) {
+		str := func(s string) *network.PropertyValue {
+			return &network.PropertyValue{Kind: network.PropertyValueKind_STRING, StringValue: &s}
+		}
+		ci := true
+		or := network.FilterLogic_OR
+		searchReq := &network.SearchNodesRequest{
+			Type: nodeTypePtr(network.NodeType_PERSON),
+			Filter: &network.FilterExpr{Logic: &or, Conditions: []*network.FilterCondition{
+				{Field: "name", Op: network.FilterOp_STARTS_WITH, Value: str("alice"), CaseInsensitive: &ci},
+				{Field: "profession", Op: network.FilterOp_EQ, Value: str("Designer")},
+			}},
+			Limit: func(i int32) *int32 { return &i }(10),
+		}
+		nodes, total, _, _, err := testRepo.SearchNodes(ctx, searchReq)
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, total)
+		require.Len(t, nodes, 2)
+		assert.Equal(t, "search-p1", nodes[0].ID)
+		assert.Equal(t, "search-p3", nodes[1].ID)
+
+		// 条件顺序不同的同一表达式命中同一缓存键
+		reordered := *searchReq
+		reordered.Filter = &network.FilterExpr{Logic: &or, Conditions: []*network.FilterCondition{
+			searchReq.Filter.Conditions[1], searchReq.Filter.Conditions[0],
+		}}
+		nodesHit, totalHit, _, _, errHit := testRepo.SearchNodes(ctx, &reordered)
+		require.NoError(t, errHit)
+		assert.Equal(t, total, totalHit)
+		assert.Len(t, nodesHit, 2)
+
+		badField := &network.SearchNodesRequest{Filter: &network.FilterExpr{Conditions: []*network.FilterCondition{
+			{Field: "bad field", Op: network.FilterOp_EXISTS},
+		}}}
+		_, _, _, _, errBad := testRepo.SearchNodes(ctx, badField)
+		assert.ErrorIs(t, errBad, neo4jdal.ErrInvalidFilter)
+	})
+
+	// --- Test Case 7: Custom sort with keyset cursor ---
+	t.Run("Search With Sort", func(t *testing.T) {
+		desc := network.SortOrder_DESC
+		byProfession := []*network.SortField{{Field: "profession"}, {Field: "name", Order: &desc}}
+		limit := int32(2)
+		searchReq := &network.SearchNodesRequest{Type: nodeTypePtr(network.NodeType_PERSON), Sort: byProfession, Limit: &limit}
+		nodes, total, cursor, _, err := testRepo.SearchNodes(ctx, searchReq)
+		require.NoError(t, err)
+		assert.EqualValues(t, 3, total)
+		require.Len(t, nodes, 2)
+		assert.Equal(t, "search-p3", nodes[0].ID) // Designer
+		assert.Equal(t, "search-p2", nodes[1].ID) // Engineer, name DESC
+		require.NotEmpty(t, cursor)
+
+		// A node inserted before the cursor position must not shift the next page
+		inserted, err := testRepo.CreateNode(ctx, &network.CreateNodeRequest{
+			Type: network.NodeType_PERSON, Name: "Early Bird", Profession: func(s string) *string { return &s }("Accountant"),
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = testRepo.DeleteNode(ctx, inserted.ID) })
+
+		nextReq := &network.SearchNodesRequest{Type: nodeTypePtr(network.NodeType_PERSON), Sort: byProfession, Limit: &limit, Cursor: &cursor}
+		nodes, _, next, _, err := testRepo.SearchNodes(ctx, nextReq)
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+		assert.Equal(t, "search-p1", nodes[0].ID)
+		assert.Empty(t, next)
+
+		// 缺少排序键取值的游标 (例如旧的按偏移量记录的游标) 无效
+		legacy := "eyJpIjoic2VhcmNoLXAyIiwicyI6InByb2Zlc3Npb246YXNjLG5hbWU6ZGVzYyIsIm8iOjJ9"
+		_, _, _, _, err = testRepo.SearchNodes(ctx, &network.SearchNodesRequest{Type: nodeTypePtr(network.NodeType_PERSON), Sort: byProfession, Limit: &limit, Cursor: &legacy})
+		assert.ErrorIs(t, err, neo4jrepo.ErrInvalidCursor)
+
+		// 游标只能用于生成它的排序方式
+		_, _, _, _, err = testRepo.SearchNodes(ctx, &network.SearchNodesRequest{Type: nodeTypePtr(network.NodeType_PERSON), Limit: &limit, Cursor: &cursor})
+		assert.ErrorIs(t, err, neo4jrepo.ErrInvalidCursor)
+
+		_, _, _, _, err = testRepo.SearchNodes(ctx, &network.SearchNodesRequest{Sort: []*network.SortField{{Field: "avatar"}}})
+		assert.ErrorIs(t, err, neo4jdal.ErrInvalidSort)
+	})
+
+	// --- Test Case 8: Facet counts over all matches, cached with the page ---
+	t.Run("Search With Facets", func(t *testing.T) {
+		limit := int32(1)
+		searchReq := &network.SearchNodesRequest{
+			Type:   nodeTypePtr(network.NodeType_PERSON),
+			Facets: []string{"type", "profession"},
+			Limit:  &limit,
+		}
+		nodes, total, _, facets, err := testRepo.SearchNodes(ctx, searchReq)
+		require.NoError(t, err)
+		assert.EqualValues(t, 3, total)
+		assert.Len(t, nodes, 1)
+		want := []*network.Facet{
+			{Field: "type", Buckets: []*network.FacetBucket{{Value: "PERSON", Count: 3}}},
+			{Field: "profession", Buckets: []*network.FacetBucket{{Value: "Engineer", Count: 2}, {Value: "Designer", Count: 1}}},
+		}
+		assert.Equal(t, want, facets)
+
+		// 第二次请求命中缓存，分面与列表一起返回
+		_, _, _, facetsHit, errHit := testRepo.SearchNodes(ctx, searchReq)
+		require.NoError(t, errHit)
+		assert.Equal(t, want, facetsHit)
+
+		// 没有匹配时每个分面都是空列表
+		none := &network.SearchNodesRequest{Criteria: map[string]string{"name": "Nobody"}, Facets: []string{"profession"}}
+		_, total, _, facets, err = testRepo.SearchNodes(ctx, none)
+		require.NoError(t, err)
+		assert.Zero(t, total)
+		assert.Equal(t, []*network.Facet{{Field: "profession", Buckets: []*network.FacetBucket{}}}, facets)
+
+		_, _, _, _, err = testRepo.SearchNodes(ctx, &network.SearchNodesRequest{Facets: []string{"avatar"}})
+		assert.ErrorIs(t, err, neo4jdal.ErrInvalidFacet)
+	})
 }
 
 // --- Integration Test for GetNetwork ---
@@ -842,7 +1080,7 @@ func findRelationByID(relations []*network.Relation, id string) *network.Relatio
 }
 
 // Helper function to generate cache key for GetNetwork (mirroring repo logic)
-func generateGetNetworkCacheKeyForTest(req *network.GetNetworkRequest, maxDepth int32, limit, offset int64) string {
+func generateGetNetworkCacheKeyForTest(req *network.GetNetworkRequest, maxDepth int32, limit, offset, maxRelations int64) string {
 	// Mirror the logic from neo4jNodeRepo.generateGetNetworkCacheKey (updated)
 	criteriaKeys := make([]string, 0, len(req.StartNodeCriteria))
 	for k := range req.StartNodeCriteria {
@@ -885,21 +1123,21 @@ func generateGetNetworkCacheKeyForTest(req *network.GetNetworkRequest, maxDepth
 	hasher.Write([]byte(nodeTypesKeyPart))
 	combinedHash := hex.EncodeToString(hasher.Sum(nil))
 
-	return fmt.Sprintf("%s%s:%d:%d:%d", neo4jrepo.GetNetworkCachePrefix, combinedHash, maxDepth, limit, offset)
+	return fmt.Sprintf("%s%s:%d:%d:%d:%d", neo4jrepo.GetNetworkCachePrefix, combinedHash, maxDepth, limit, offset, maxRelations)
 }
 
 // Define a local struct matching the unexported one for unmarshalling cache data
 type getNetworkCacheValueForTest struct {
 	NodeIDs     []string `json:"node_ids"`
 	RelationIDs []string `json:"relation_ids"`
+	Truncated   bool     `json:"truncated,omitempty"`
 }
 
 func TestGetNetwork_Integration(t *testing.T) {
 	ctx := context.Background()
 	require.NotNil(t, testRepo, "Repository should be initialized")
 	require.NotNil(t, testCache, "Cache should be initialized")
-	require.NotNil(t, redisClient, "redisClient should be initialized") // Ensure redisClient is available
-	clearTestData(ctx)                                                  // <<< Added: Ensure clean state for this test function
+	clearTestData(ctx) // <<< Added: Ensure clean state for this test function
 
 	// Setup initial data (as TestMain clears everything once)
 	p1 := &network.Node{ID: "net-p1", Type: network.NodeType_PERSON, Name: "Net Alice", Profession: func(s string) *string { return &s }("Engineer"), Properties: map[string]string{"city": "A"}}
@@ -924,12 +1162,12 @@ func TestGetNetwork_Integration(t *testing.T) {
 
 	// --- Test Case: Depth 0 --- (Modified)
 	t.Run("Get_Network_Depth_0", func(t *testing.T) {
-		clearRedisCache(ctx) // Clear cache before test
+		clearTestCache(ctx) // Clear cache before test
 		req := &network.GetNetworkRequest{
 			StartNodeCriteria: map[string]string{"profession": "Engineer"},
 			Depth:             0, // Explicitly set depth to 0
 		}
-		nodes, relations, err := testRepo.GetNetwork(ctx, req)
+		nodes, relations, _, err := testRepo.GetNetwork(ctx, req)
 
 		// Assertions for Depth 0
 		assert.NoError(t, err, "GetNetwork(Depth 0) failed")
@@ -959,12 +1197,12 @@ func TestGetNetwork_Integration(t *testing.T) {
 
 	// --- Test Case: Depth 1 (Cache Miss) --- (Modified)
 	t.Run("Get_Network_Depth_1_Cache_Miss", func(t *testing.T) {
-		clearRedisCache(ctx) // Clear cache before test
+		clearTestCache(ctx) // Clear cache before test
 		req := &network.GetNetworkRequest{
 			StartNodeCriteria: map[string]string{"profession": "Engineer"},
 			Depth:             1, // Explicitly set depth to 1
 		}
-		nodes, relations, err := testRepo.GetNetwork(ctx, req)
+		nodes, relations, _, err := testRepo.GetNetwork(ctx, req)
 
 		// Assertions for Depth 1
 		require.NoError(t, err, "GetNetwork(Depth 1) failed")
@@ -1013,7 +1251,7 @@ func TestGetNetwork_Integration(t *testing.T) {
 		}
 
 		// Call GetNetwork again
-		nodes, relations, err := testRepo.GetNetwork(ctx, req)
+		nodes, relations, _, err := testRepo.GetNetwork(ctx, req)
 
 		require.NoError(t, err, "GetNetwork(Depth 1, Cache Hit) failed")
 		require.NotNil(t, nodes, "Nodes should not be nil (cache hit)")
@@ -1025,13 +1263,137 @@ func TestGetNetwork_Integration(t *testing.T) {
 		// ...
 	})
 
+	// --- Test Case: Depth 1 with paging and caps ---
+	t.Run("Get_Network_Depth_1_Paging_Truncated", func(t *testing.T) {
+		clearTestCache(ctx)
+		limit := int32(2)
+		req := &network.GetNetworkRequest{
+			StartNodeCriteria: map[string]string{"profession": "Engineer"},
+			Depth:             1,
+			Limit:             &limit,
+		}
+		nodes, relations, truncated, err := testRepo.GetNetwork(ctx, req)
+		require.NoError(t, err)
+		assert.True(t, truncated, "Expected truncated=true when more nodes exist beyond the page")
+		require.Len(t, nodes, 2, "Expected first page of 2 nodes (ordered by id)")
+		assert.Equal(t, c1.ID, nodes[0].ID)
+		assert.Equal(t, p1.ID, nodes[1].ID)
+		require.Len(t, relations, 1, "Relations to later pages are returned with the later page")
+		assert.Equal(t, r1.ID, relations[0].ID)
+
+		// Second page returns the remaining nodes and every relation whose later endpoint is on it,
+		// including the ones crossing back to the first page
+		offset := int32(2)
+		req.Offset = &offset
+		nodes2, relations2, truncated2, err := testRepo.GetNetwork(ctx, req)
+		require.NoError(t, err)
+		assert.False(t, truncated2, "Last page should not be truncated")
+		require.Len(t, nodes2, 2)
+		assert.Equal(t, p2.ID, nodes2[0].ID)
+		assert.Equal(t, p3.ID, nodes2[1].ID)
+		relIDs := make([]string, 0, len(relations2))
+		for _, rel := range relations2 {
+			relIDs = append(relIDs, rel.ID)
+		}
+		assert.ElementsMatch(t, []string{r2.ID, r3.ID, r4.ID, r5.ID}, relIDs)
+		for _, rel := range relations2 {
+			if rel.ID == r4.ID {
+				assert.Equal(t, p3.ID, rel.Source, "Cross-page relation keeps its endpoints")
+				assert.Equal(t, p1.ID, rel.Target)
+			}
+		}
+
+		// max_relations caps relations and marks the result as truncated
+		maxRelations := int32(1)
+		capReq := &network.GetNetworkRequest{
+			StartNodeCriteria: map[string]string{"profession": "Engineer"},
+			Depth:             1,
+			MaxRelations:      &maxRelations,
+		}
+		capNodes, capRelations, capTruncated, err := testRepo.GetNetwork(ctx, capReq)
+		require.NoError(t, err)
+		assert.Len(t, capNodes, 4)
+		assert.Len(t, capRelations, 1)
+		assert.True(t, capTruncated)
+
+		// Cached result keeps the truncated flag
+		_, capRelationsHit, capTruncatedHit, err := testRepo.GetNetwork(ctx, capReq)
+		require.NoError(t, err)
+		assert.Len(t, capRelationsHit, 1)
+		assert.True(t, capTruncatedHit)
+	})
+
+	// --- Test Case: ExportNetwork returns the whole subgraph in one call ---
+	t.Run("Export_Network_Depth_1", func(t *testing.T) {
+		clearTestCache(ctx)
+		req := &network.GetNetworkRequest{
+			StartNodeCriteria: map[string]string{"profession": "Engineer"},
+			Depth:             1,
+		}
+		nodes, relations, truncated, err := testRepo.ExportNetwork(ctx, req)
+		require.NoError(t, err)
+		assert.False(t, truncated)
+		assert.Len(t, nodes, 4, "All nodes of the 1-hop network should be exported")
+		assert.NotEmpty(t, relations)
+
+		// max_nodes caps the export and marks it truncated
+		maxNodes := int32(2)
+		req.MaxNodes = &maxNodes
+		capNodes, _, capTruncated, err := testRepo.ExportNetwork(ctx, req)
+		require.NoError(t, err)
+		assert.Len(t, capNodes, 2)
+		assert.True(t, capTruncated)
+
+		_, _, _, err = testRepo.ExportNetwork(ctx, &network.GetNetworkRequest{Depth: -1})
+		assert.ErrorIs(t, err, neo4jrepo.ErrInvalidDepth)
+	})
+
+	// --- Test Case: Direction (who B follows vs. who follows B) ---
+	t.Run("Get_Network_Direction", func(t *testing.T) {
+		clearTestCache(ctx)
+		relationIDs := func(relations []*network.Relation) []string {
+			ids := make([]string, len(relations))
+			for i, r := range relations {
+				ids[i] = r.ID
+			}
+			sort.Strings(ids)
+			return ids
+		}
+
+		outgoing := network.Direction_OUTGOING
+		req := &network.GetNetworkRequest{StartNodeCriteria: map[string]string{"name": "Net Bob"}, Depth: 1, Direction: &outgoing}
+		nodes, relations, _, err := testRepo.GetNetwork(ctx, req)
+		require.NoError(t, err)
+		assert.Len(t, nodes, 3, "B, C and Corp")
+		assert.Equal(t, []string{r2.ID, r3.ID}, relationIDs(relations))
+
+		time.Sleep(50 * time.Millisecond)
+		limit, offset, maxRelations := int64(neo4jrepo.GetNetworkDefaultLimit), int64(0), int64(neo4jrepo.GetNetworkMaxRelations)
+		_, err = testCache.Get(ctx, generateGetNetworkCacheKeyForTest(req, 1, limit, offset, maxRelations)+":dOUTGOING")
+		assert.NoError(t, err, "direction is part of the cache key")
+
+		incoming := network.Direction_INCOMING
+		req.Direction = &incoming
+		nodes, relations, _, err = testRepo.GetNetwork(ctx, req)
+		require.NoError(t, err)
+		assert.Len(t, nodes, 2, "B and Corp")
+		assert.Equal(t, []string{r5.ID}, relationIDs(relations))
+
+		// Per-type override: FOLLOWING only outgoing, the rest undirected
+		req.Direction = nil
+		req.RelationDirections = []*network.RelationDirection{{Type: network.RelationType_FOLLOWING, Direction: network.Direction_OUTGOING}}
+		_, relations, _, err = testRepo.GetNetwork(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, []string{r2.ID, r3.ID}, relationIDs(relations))
+	})
+
 	// --- Test Case: Invalid Depth (< 0) --- (Modified)
 	t.Run("Get_Network_Invalid_Depth", func(t *testing.T) {
 		req := &network.GetNetworkRequest{
 			StartNodeCriteria: map[string]string{"profession": "Engineer"},
 			Depth:             -1, // Invalid depth
 		}
-		_, _, err := testRepo.GetNetwork(ctx, req)
+		_, _, _, err := testRepo.GetNetwork(ctx, req)
 		assert.ErrorIs(t, err, neo4jrepo.ErrInvalidDepth, "Expected ErrInvalidDepth for negative depth") // Use exported error
 	})
 }
@@ -1055,8 +1417,10 @@ func generateGetPathCacheKeyForTest(req *network.GetPathRequest, maxDepth int32,
 
 // Define a local struct matching the unexported one for unmarshalling cache data
 type getPathCacheValueForTest struct {
-	NodeIDs     []string `json:"node_ids"`
-	RelationIDs []string `json:"relation_ids"`
+	Paths []struct {
+		NodeIDs     []string `json:"node_ids"`
+		RelationIDs []string `json:"relation_ids"`
+	} `json:"paths"`
 }
 
 func TestGetPath_Integration(t *testing.T) {
@@ -1083,7 +1447,7 @@ func TestGetPath_Integration(t *testing.T) {
 	rAB := &network.Relation{ID: "path-rab", Type: network.RelationType_FRIEND}
 	rBC := &network.Relation{ID: "path-rbc", Type: network.RelationType_COLLEAGUE}
 	rCD := &network.Relation{ID: "path-rcd", Type: network.RelationType_SCHOOLMATE}
-	rAC := &network.Relation{ID: "path-rac", Type: network.RelationType_VISITED} // Alternate path A->C
+	rAC := &network.Relation{ID: "path-rac", Type: network.RelationType_VISITED, Properties: map[string]string{"weight": "5"}} // Alternate path A->C, expensive in WEIGHTED mode
 
 	require.NoError(t, createRelationDirectly(ctx, nA.ID, nB.ID, rAB))
 	require.NoError(t, createRelationDirectly(ctx, nB.ID, nC.ID, rBC))
@@ -1111,8 +1475,10 @@ func TestGetPath_Integration(t *testing.T) {
 		assert.ErrorIs(t, err, cache.ErrNotFound, "Cache should be empty before first GetPath")
 
 		// Execute GetPath
-		nodes, relations, err := testRepo.GetPath(ctx, req)
+		paths, err := testRepo.GetPath(ctx, req)
 		require.NoError(t, err, "GetPath failed for A->D")
+		require.Len(t, paths, 1, "SINGLE 模式只返回一条路径")
+		nodes, relations := paths[0].Nodes, paths[0].Relations
 
 		// Verify results (Shortest path is now A->C->D due to direct A->C link)
 		require.Len(t, nodes, 3, "Expected 3 nodes in path A->C->D")
@@ -1134,8 +1500,8 @@ func TestGetPath_Integration(t *testing.T) {
 		var cachedValue getPathCacheValueForTest
 		err = json.Unmarshal(cachedData, &cachedValue)
 		require.NoError(t, err, "Failed to unmarshal cached path data")
-		assert.Equal(t, []string{nA.ID, nC.ID, nD.ID}, cachedValue.NodeIDs) // Adjusted expected nodes
-		assert.Equal(t, []string{rAC.ID, rCD.ID}, cachedValue.RelationIDs)  // Adjusted expected relations
+		assert.Equal(t, []string{nA.ID, nC.ID, nD.ID}, cachedValue.Paths[0].NodeIDs) // Adjusted expected nodes
+		assert.Equal(t, []string{rAC.ID, rCD.ID}, cachedValue.Paths[0].RelationIDs)  // Adjusted expected relations
 
 		// Also verify relation details are cached
 		relDetailAB, errRelCacheAB := relTestRelRepo.GetRelation(ctx, rAB.ID)
@@ -1160,8 +1526,10 @@ func TestGetPath_Integration(t *testing.T) {
 
 		// Execute GetPath again
 		t.Log("Expecting GetPath cache hit...")
-		nodes, relations, err := testRepo.GetPath(ctx, req)
+		paths, err := testRepo.GetPath(ctx, req)
 		require.NoError(t, err, "GetPath (cache hit) failed for A->D")
+		require.Len(t, paths, 1, "SINGLE 模式只返回一条路径")
+		nodes, relations := paths[0].Nodes, paths[0].Relations
 
 		// Verify results again (should use cached IDs and fetch details)
 		require.Len(t, nodes, 3)                 // Adjusted expectation
@@ -1196,8 +1564,10 @@ func TestGetPath_Integration(t *testing.T) {
 		cacheKey := generateGetPathCacheKeyForTest(req, depth, typesStr)
 
 		// Execute GetPath
-		nodes, relations, err := testRepo.GetPath(ctx, req)
+		paths, err := testRepo.GetPath(ctx, req)
 		require.NoError(t, err, "GetPath failed for A->C (Depth 1, VISITED)")
+		require.Len(t, paths, 1, "SINGLE 模式只返回一条路径")
+		nodes, relations := paths[0].Nodes, paths[0].Relations
 
 		// Verify results (A -[VISITED]-> C)
 		require.Len(t, nodes, 2, "Expected 2 nodes")
@@ -1213,8 +1583,8 @@ func TestGetPath_Integration(t *testing.T) {
 		require.NoError(t, err)
 		var cachedValue getPathCacheValueForTest
 		require.NoError(t, json.Unmarshal(cachedData, &cachedValue))
-		assert.Equal(t, []string{nA.ID, nC.ID}, cachedValue.NodeIDs)
-		assert.Equal(t, []string{rAC.ID}, cachedValue.RelationIDs)
+		assert.Equal(t, []string{nA.ID, nC.ID}, cachedValue.Paths[0].NodeIDs)
+		assert.Equal(t, []string{rAC.ID}, cachedValue.Paths[0].RelationIDs)
 	})
 
 	// --- Test Case 4: Find path A->D (MaxDepth 2) - Path Found Within Limit ---
@@ -1229,8 +1599,10 @@ func TestGetPath_Integration(t *testing.T) {
 
 		// Execute GetPath
 		// Expect NoError because path A->C->D (2 relations) IS within maxDepth 2.
-		nodes, relations, err := testRepo.GetPath(ctx, req)
+		paths, err := testRepo.GetPath(ctx, req)
 		require.NoError(t, err, "GetPath failed for A->D within MaxDepth 2")
+		require.Len(t, paths, 1, "SINGLE 模式只返回一条路径")
+		nodes, relations := paths[0].Nodes, paths[0].Relations
 
 		// Verify results (A->C->D)
 		require.Len(t, nodes, 3, "Expected 3 nodes in path A->C->D")
@@ -1247,12 +1619,14 @@ func TestGetPath_Integration(t *testing.T) {
 		require.NoError(t, errCacheGet, "Cache should contain data for path found within depth")
 		var cachedValue getPathCacheValueForTest
 		require.NoError(t, json.Unmarshal(cachedData, &cachedValue), "Failed to unmarshal cached path data")
-		assert.Equal(t, []string{nA.ID, nC.ID, nD.ID}, cachedValue.NodeIDs)
-		assert.Equal(t, []string{rAC.ID, rCD.ID}, cachedValue.RelationIDs)
+		assert.Equal(t, []string{nA.ID, nC.ID, nD.ID}, cachedValue.Paths[0].NodeIDs)
+		assert.Equal(t, []string{rAC.ID, rCD.ID}, cachedValue.Paths[0].RelationIDs)
 
 		// Execute again (cache hit)
-		nodesHit, relationsHit, errHit := testRepo.GetPath(ctx, req)
+		pathsHit, errHit := testRepo.GetPath(ctx, req)
 		require.NoError(t, errHit, "Expected NoError on second call (cache hit)")
+		require.Len(t, pathsHit, 1)
+		nodesHit, relationsHit := pathsHit[0].Nodes, pathsHit[0].Relations
 		require.Len(t, nodesHit, 3)
 		require.Len(t, relationsHit, 2)
 		assert.Equal(t, nD.ID, nodesHit[2].ID)
@@ -1267,11 +1641,10 @@ func TestGetPath_Integration(t *testing.T) {
 		cacheKey := generateGetPathCacheKeyForTest(req, 3, []string{})
 
 		// Execute GetPath
-		nodes, relations, err := testRepo.GetPath(ctx, req)
+		paths, err := testRepo.GetPath(ctx, req)
 		require.Error(t, err, "Expected an error for path not found to unconnected node")
 		// assert.True(t, isNotFoundError(err), "Error should be a 'not found' error")
-		assert.Nil(t, nodes)
-		assert.Nil(t, relations)
+		assert.Nil(t, paths)
 
 		// Verify empty placeholder caching
 		time.Sleep(50 * time.Millisecond)
@@ -1280,10 +1653,9 @@ func TestGetPath_Integration(t *testing.T) {
 		assert.Equal(t, []byte(neo4jrepo.GetPathEmptyPlaceholder), cachedData)
 
 		// Execute again (cache hit for empty)
-		nodesHit, relationsHit, errHit := testRepo.GetPath(ctx, req)
+		pathsHit, errHit := testRepo.GetPath(ctx, req)
 		require.Error(t, errHit, "Expected error on cache hit for empty path (unconnected)")
-		assert.Nil(t, nodesHit)
-		assert.Nil(t, relationsHit)
+		assert.Nil(t, pathsHit)
 	})
 
 	// --- Test Case 6: Find path A->D (Type FRIEND|COLLEAGUE) ---
@@ -1298,11 +1670,10 @@ func TestGetPath_Integration(t *testing.T) {
 		cacheKey := generateGetPathCacheKeyForTest(req, 3, typesStr)
 
 		// Execute GetPath
-		nodes, relations, err := testRepo.GetPath(ctx, req)
+		paths, err := testRepo.GetPath(ctx, req)
 		require.Error(t, err, "Expected an error for path not found with type filter")
 		// assert.True(t, isNotFoundError(err), "Error should be a 'not found' error")
-		assert.Nil(t, nodes)
-		assert.Nil(t, relations)
+		assert.Nil(t, paths)
 
 		// Verify empty placeholder caching
 		time.Sleep(50 * time.Millisecond)
@@ -1311,10 +1682,285 @@ func TestGetPath_Integration(t *testing.T) {
 		assert.Equal(t, []byte(neo4jrepo.GetPathEmptyPlaceholder), cachedData)
 
 		// Execute again (cache hit for empty)
-		nodesHit, relationsHit, errHit := testRepo.GetPath(ctx, req)
+		pathsHit, errHit := testRepo.GetPath(ctx, req)
 		require.Error(t, errHit, "Expected error on cache hit for empty path (type filter)")
-		assert.Nil(t, nodesHit)
-		assert.Nil(t, relationsHit)
+		assert.Nil(t, pathsHit)
+	})
+
+	// --- Test Case 7: K shortest and all shortest paths, cached under their own keys ---
+	t.Run("Get Path Modes", func(t *testing.T) {
+		mode, k := network.PathMode_K_SHORTEST, int32(2)
+		req := &network.GetPathRequest{SourceID: nA.ID, TargetID: nD.ID, Mode: &mode, K: &k}
+		paths, err := testRepo.GetPath(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, paths, 2)
+		assert.Equal(t, []string{nA.ID, nC.ID, nD.ID}, []string{paths[0].Nodes[0].ID, paths[0].Nodes[1].ID, paths[0].Nodes[2].ID})
+		require.Len(t, paths[1].Nodes, 4, "第二条路径经过 B")
+		assert.Equal(t, nB.ID, paths[1].Nodes[1].ID)
+		assert.Equal(t, []string{rAB.ID, rBC.ID, rCD.ID}, []string{paths[1].Relations[0].ID, paths[1].Relations[1].ID, paths[1].Relations[2].ID})
+
+		time.Sleep(50 * time.Millisecond)
+		cacheKey := generateGetPathCacheKeyForTest(req, 3, []string{}) + ":k2"
+		cachedData, err := testCache.Get(ctx, cacheKey)
+		require.NoError(t, err, "K_SHORTEST 使用单独的缓存键")
+		var cachedValue getPathCacheValueForTest
+		require.NoError(t, json.Unmarshal(cachedData, &cachedValue))
+		require.Len(t, cachedValue.Paths, 2)
+
+		pathsHit, errHit := testRepo.GetPath(ctx, req)
+		require.NoError(t, errHit)
+		require.Len(t, pathsHit, 2)
+		assert.Equal(t, paths[1].Relations[0].ID, pathsHit[1].Relations[0].ID)
+
+		all := network.PathMode_ALL_SHORTEST
+		paths, err = testRepo.GetPath(ctx, &network.GetPathRequest{SourceID: nA.ID, TargetID: nD.ID, Mode: &all})
+		require.NoError(t, err)
+		require.Len(t, paths, 1, "A->D 只有一条两跳的最短路径")
+		assert.Len(t, paths[0].Relations, 2)
+
+		// WEIGHTED: A-C 的 weight 为 5，绕行 A-B-C-D (3) 比 A-C-D (6) 便宜
+		weighted := network.PathMode_WEIGHTED
+		weightedReq := &network.GetPathRequest{SourceID: nA.ID, TargetID: nD.ID, Mode: &weighted}
+		paths, err = testRepo.GetPath(ctx, weightedReq)
+		require.NoError(t, err)
+		require.Len(t, paths, 1)
+		assert.Len(t, paths[0].Relations, 3)
+		require.NotNil(t, paths[0].Cost)
+		assert.Equal(t, 3.0, *paths[0].Cost)
+
+		time.Sleep(50 * time.Millisecond)
+		pathsHit, errHit = testRepo.GetPath(ctx, weightedReq)
+		require.NoError(t, errHit)
+		require.Len(t, pathsHit, 1)
+		require.NotNil(t, pathsHit[0].Cost, "缓存中保留总代价")
+		assert.Equal(t, 3.0, *pathsHit[0].Cost)
+	})
+
+	// --- Test Case 8: Directed paths, all relations point from A towards D ---
+	t.Run("Get Path Direction", func(t *testing.T) {
+		outgoing, incoming := network.Direction_OUTGOING, network.Direction_INCOMING
+		paths, err := testRepo.GetPath(ctx, &network.GetPathRequest{SourceID: nA.ID, TargetID: nD.ID, Direction: &outgoing})
+		require.NoError(t, err)
+		require.Len(t, paths, 1)
+		assert.Len(t, paths[0].Relations, 2)
+
+		_, err = testRepo.GetPath(ctx, &network.GetPathRequest{SourceID: nA.ID, TargetID: nD.ID, Direction: &incoming})
+		assert.ErrorIs(t, err, neo4jrepo.ErrPathNotFound, "A 不能逆着关系方向到达 D")
+
+		paths, err = testRepo.GetPath(ctx, &network.GetPathRequest{SourceID: nD.ID, TargetID: nA.ID, Direction: &incoming})
+		require.NoError(t, err)
+		require.Len(t, paths, 1)
+		assert.Equal(t, nA.ID, paths[0].Nodes[2].ID)
+	})
+
+	t.Run("Get Path Constraints", func(t *testing.T) {
+		paths, err := testRepo.GetPath(ctx, &network.GetPathRequest{SourceID: nA.ID, TargetID: nD.ID, ViaNodeIds: []string{nB.ID}})
+		require.NoError(t, err)
+		require.Len(t, paths, 1)
+		assert.Equal(t, []string{rAB.ID, rBC.ID, rCD.ID}, []string{paths[0].Relations[0].ID, paths[0].Relations[1].ID, paths[0].Relations[2].ID}, "Must pass through B")
+
+		_, err = testRepo.GetPath(ctx, &network.GetPathRequest{SourceID: nA.ID, TargetID: nD.ID, ExcludeNodeIds: []string{nC.ID}})
+		assert.ErrorIs(t, err, neo4jrepo.ErrPathNotFound, "Every path from A to D passes through C")
+
+		_, err = testRepo.GetPath(ctx, &network.GetPathRequest{SourceID: nA.ID, TargetID: nD.ID, IntermediateNodeTypes: []network.NodeType{network.NodeType_COMPANY}})
+		assert.ErrorIs(t, err, neo4jrepo.ErrPathNotFound, "Intermediate nodes are all PERSON")
 	})
 
 }
+
+// TestDerivedKeyInvalidation_Integration verifies derived cache keys are indexed by node and removed by InvalidateNodes
+func TestDerivedKeyInvalidation_Integration(t *testing.T) {
+	ctx := context.Background()
+	clearTestData(ctx)
+	index, ok := testCache.(cache.NodeKeyIndex)
+	require.True(t, ok, "RedisCache should implement NodeKeyIndex")
+
+	p1 := &network.Node{ID: "inv-p1", Type: network.NodeType_PERSON, Name: "Inv Alice"}
+	c1 := &network.Node{ID: "inv-c1", Type: network.NodeType_COMPANY, Name: "Inv Corp"}
+	other := &network.Node{ID: "inv-x1", Type: network.NodeType_PERSON, Name: "Inv Other"}
+	require.NoError(t, createNodeDirectly(ctx, p1))
+	require.NoError(t, createNodeDirectly(ctx, c1))
+	require.NoError(t, createNodeDirectly(ctx, other))
+	require.NoError(t, createRelationDirectly(ctx, p1.ID, c1.ID, &network.Relation{ID: "inv-r1", Source: p1.ID, Target: c1.ID, Type: network.RelationType_COLLEAGUE}))
+
+	req := &network.GetNetworkRequest{StartNodeCriteria: map[string]string{"name": "Inv Alice"}, Depth: 1}
+	_, _, _, err := testRepo.GetNetwork(ctx, req)
+	require.NoError(t, err)
+	limit, offset, maxRelations := int64(neo4jrepo.GetNetworkDefaultLimit), int64(0), int64(neo4jrepo.GetNetworkMaxRelations)
+	cacheKey := generateGetNetworkCacheKeyForTest(req, req.Depth, limit, offset, maxRelations)
+	_, err = testCache.Get(ctx, cacheKey)
+	require.NoError(t, err, "GetNetwork result should be cached")
+
+	// Unrelated node leaves the key alone
+	deleted, err := index.InvalidateNodes(ctx, []string{other.ID})
+	require.NoError(t, err)
+	assert.Zero(t, deleted)
+	_, err = testCache.Get(ctx, cacheKey)
+	assert.NoError(t, err)
+
+	// Any node in the result invalidates it
+	deleted, err = index.InvalidateNodes(ctx, []string{c1.ID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	_, err = testCache.Get(ctx, cacheKey)
+	assert.ErrorIs(t, err, cache.ErrNotFound)
+}
+
+// TestGetRecommendations_Integration verifies ranking, explanations, exclusion of existing connections and caching
+func TestGetRecommendations_Integration(t *testing.T) {
+	ctx := context.Background()
+	clearTestData(ctx)
+	index, ok := testCache.(cache.NodeKeyIndex)
+	require.True(t, ok, "RedisCache should implement NodeKeyIndex")
+
+	nodes := []*network.Node{
+		{ID: "rec-me", Type: network.NodeType_PERSON, Name: "Rec Me"},
+		{ID: "rec-f1", Type: network.NodeType_PERSON, Name: "Rec Friend 1"},
+		{ID: "rec-f2", Type: network.NodeType_PERSON, Name: "Rec Friend 2"},
+		{ID: "rec-a", Type: network.NodeType_PERSON, Name: "Rec A"},
+		{ID: "rec-b", Type: network.NodeType_PERSON, Name: "Rec B"},
+		{ID: "rec-c1", Type: network.NodeType_COMPANY, Name: "Rec Corp"},
+	}
+	for _, n := range nodes {
+		require.NoError(t, createNodeDirectly(ctx, n))
+	}
+	rels := []*network.Relation{
+		{ID: "rec-r1", Source: "rec-me", Target: "rec-f1", Type: network.RelationType_FRIEND},
+		{ID: "rec-r2", Source: "rec-me", Target: "rec-f2", Type: network.RelationType_SCHOOLMATE},
+		{ID: "rec-r3", Source: "rec-f1", Target: "rec-a", Type: network.RelationType_FRIEND},
+		{ID: "rec-r4", Source: "rec-f2", Target: "rec-a", Type: network.RelationType_COLLEAGUE},
+		{ID: "rec-r5", Source: "rec-me", Target: "rec-c1", Type: network.RelationType_COLLEAGUE},
+		{ID: "rec-r6", Source: "rec-b", Target: "rec-c1", Type: network.RelationType_COLLEAGUE},
+		{ID: "rec-r7", Source: "rec-f1", Target: "rec-f2", Type: network.RelationType_FRIEND},
+	}
+	for _, r := range rels {
+		require.NoError(t, createRelationDirectly(ctx, r.Source, r.Target, r))
+	}
+
+	recs, err := testRepo.GetRecommendations(ctx, &network.GetRecommendationsRequest{NodeID: "rec-me"})
+	require.NoError(t, err)
+	require.Len(t, recs, 2, "f1 and f2 are already connected")
+	assert.Equal(t, "rec-a", recs[0].Node.ID)
+	assert.Equal(t, int32(2), recs[0].MutualCount)
+	assert.Equal(t, []string{"rec-f1", "rec-f2"}, []string{recs[0].MutualNodes[0].ID, recs[0].MutualNodes[1].ID})
+	assert.Empty(t, recs[0].SharedOrganizations)
+	assert.Equal(t, "rec-b", recs[1].Node.ID)
+	assert.Zero(t, recs[1].MutualCount)
+	require.Len(t, recs[1].SharedOrganizations, 1)
+	assert.Equal(t, "rec-c1", recs[1].SharedOrganizations[0].ID)
+	assert.Greater(t, recs[0].Score, recs[1].Score)
+
+	limit := int32(1)
+	recs, err = testRepo.GetRecommendations(ctx, &network.GetRecommendationsRequest{NodeID: "rec-me", Limit: &limit})
+	require.NoError(t, err)
+	require.Len(t, recs, 1, "Limit applies to the cached list")
+
+	cacheKey := neo4jrepo.RecommendationsCachePrefix + "rec-me"
+	_, err = testCache.Get(ctx, cacheKey)
+	require.NoError(t, err, "Recommendations should be cached")
+	// A relation change of the node itself invalidates its recommendations
+	deleted, err := index.InvalidateNodes(ctx, []string{"rec-me"})
+	require.NoError(t, err)
+	assert.Positive(t, deleted)
+	_, err = testCache.Get(ctx, cacheKey)
+	assert.ErrorIs(t, err, cache.ErrNotFound)
+
+	_, err = testRepo.GetRecommendations(ctx, &network.GetRecommendationsRequest{NodeID: "rec-missing"})
+	assert.Error(t, err)
+}
+
+// slowCountingNodeStore 统计 GetNodeByID 的调用次数，并放慢查询以制造并发未命中
+type slowCountingNodeStore struct {
+	storage.NodeStore
+	calls atomic.Int32
+}
+
+func (d *slowCountingNodeStore) GetNodeByID(ctx context.Context, id string) (neo4j.Node, []string, error) {
+	d.calls.Add(1)
+	time.Sleep(50 * time.Millisecond)
+	return d.NodeStore.GetNodeByID(ctx, id)
+}
+
+// TestGetNode_Coalescing_Integration 验证同一节点的并发缓存未命中只查询一次数据库
+func TestGetNode_Coalescing_Integration(t *testing.T) {
+	ctx := context.Background()
+	clearTestData(ctx)
+
+	node := &network.Node{ID: "sf-p1", Type: network.NodeType_PERSON, Name: "Flight Alice"}
+	require.NoError(t, createNodeDirectly(ctx, node))
+
+	dal := &slowCountingNodeStore{NodeStore: testStore}
+	repo := neo4jrepo.NewNodeRepository(dal, testCache, relTestRelRepo, 300, 100, 500, 100, 100, 3, 5, 1000, zap.NewNop())
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := repo.GetNode(ctx, node.ID)
+			if err == nil && got.Name != node.Name {
+				err = fmt.Errorf("unexpected node %q", got.Name)
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), dal.calls.Load(), "并发未命中应合并为一次数据库查询")
+}
+
+// countingBatchNodeStore 统计 GetNodeByID 和 GetNodesByIDs 的调用次数
+type countingBatchNodeStore struct {
+	storage.NodeStore
+	singleCalls atomic.Int32
+	batchCalls  atomic.Int32
+}
+
+func (d *countingBatchNodeStore) GetNodeByID(ctx context.Context, id string) (neo4j.Node, []string, error) {
+	d.singleCalls.Add(1)
+	return d.NodeStore.GetNodeByID(ctx, id)
+}
+
+func (d *countingBatchNodeStore) GetNodesByIDs(ctx context.Context, ids []string) ([]neo4j.Node, [][]string, error) {
+	d.batchCalls.Add(1)
+	return d.NodeStore.GetNodesByIDs(ctx, ids)
+}
+
+// TestGetNodes_BatchHydration_Integration 验证批量获取只对未命中的节点执行一次数据库查询，并回填缓存
+func TestGetNodes_BatchHydration_Integration(t *testing.T) {
+	ctx := context.Background()
+	clearTestData(ctx)
+
+	ids := make([]string, 0, 5)
+	for i := 0; i < 5; i++ {
+		node := &network.Node{ID: fmt.Sprintf("batch-p%d", i), Type: network.NodeType_PERSON, Name: fmt.Sprintf("Batch %d", i)}
+		require.NoError(t, createNodeDirectly(ctx, node))
+		ids = append(ids, node.ID)
+	}
+
+	dal := &countingBatchNodeStore{NodeStore: testStore}
+	repo := neo4jrepo.NewNodeRepository(dal, testCache, relTestRelRepo, 300, 100, 500, 100, 100, 3, 5, 1000, zap.NewNop())
+
+	// 预热其中一个节点，其余节点和不存在的 ID 由一次批量查询补齐
+	_, err := repo.GetNode(ctx, ids[0])
+	require.NoError(t, err)
+
+	nodes, err := repo.GetNodes(ctx, append(ids, "batch-missing"))
+	require.NoError(t, err)
+	assert.Len(t, nodes, len(ids))
+	for _, id := range ids {
+		assert.Equal(t, id, nodes[id].ID)
+	}
+	assert.NotContains(t, nodes, "batch-missing")
+	assert.Equal(t, int32(1), dal.singleCalls.Load())
+	assert.Equal(t, int32(1), dal.batchCalls.Load(), "未命中的节点应合并为一次数据库查询")
+
+	// 第二次全部命中缓存 (包括空值)
+	_, err = repo.GetNodes(ctx, append(ids, "batch-missing"))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), dal.batchCalls.Load(), "第二次应全部由缓存返回")
+}
@@ -0,0 +1,192 @@
+package neo4jrepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"go.uber.org/zap"
+
+	"labelwall/biz/dal/neo4jdal"
+	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/typeregistry"
+)
+
+// repoOptions 是 NodeRepository / RelationRepository 的可选配置
+type repoOptions struct {
+	outbox       bool
+	queryTimeout time.Duration
+	local        ChangeApplier
+}
+
+// RepoOption 配置 Repository 的可选行为
+type RepoOption func(*repoOptions)
+
+// WithOutbox 让写操作在同一事务中写入变更事件到发件箱，由 outbox relay 发布到 RabbitMQ
+func WithOutbox() RepoOption {
+	return func(o *repoOptions) {
+		o.outbox = true
+	}
+}
+
+// ChangeApplier 在写操作提交后同步应用一条变更，用于没有发件箱消费者时在本实例内使缓存失效。
+// invalidation.Handler 实现了该接口，对同一变更的处理与消费者收到事件时一致。
+type ChangeApplier interface {
+	ApplyEvent(ctx context.Context, event neo4jdal.OutboxEvent) error
+}
+
+// WithLocalInvalidation 让写操作提交后把变更直接交给 applier，使本实例的实体缓存和派生缓存失效。
+// 用于没有发件箱 relay 和失效消费者的部署 (未启用 RabbitMQ 或使用内存图后端)，其他实例的缓存只随 TTL 过期。
+func WithLocalInvalidation(applier ChangeApplier) RepoOption {
+	return func(o *repoOptions) {
+		o.local = applier
+	}
+}
+
+// WithQueryTimeout 设置缓存未命中时合并执行的数据库查询的时限。
+// 合并的查询不随单个调用者取消，也不继承调用者的截止时间，<= 0 时使用 singleflight.DefaultTimeout。
+func WithQueryTimeout(d time.Duration) RepoOption {
+	return func(o *repoOptions) {
+		o.queryTimeout = d
+	}
+}
+
+func buildRepoOptions(opts []RepoOption) repoOptions {
+	var o repoOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// events 在启用发件箱时返回需要与写操作在同一事务中提交的事件，未启用时返回 nil
+func (o repoOptions) events(events ...neo4jdal.ChangeEvent) []neo4jdal.ChangeEvent {
+	if !o.outbox {
+		return nil
+	}
+	return events
+}
+
+// applyLocal 在配置了本地失效时同步应用 changes，失败只记录日志，缓存最终随 TTL 过期
+func (o repoOptions) applyLocal(ctx context.Context, logger *zap.Logger, changes ...neo4jdal.OutboxEvent) {
+	if o.local == nil {
+		return
+	}
+	for _, change := range changes {
+		if err := o.local.ApplyEvent(ctx, change); err != nil {
+			logger.Warn("Repo: 本地缓存失效失败",
+				zap.String("eventType", change.EventType),
+				zap.String("aggregateId", change.AggregateID),
+				zap.Error(err))
+		}
+	}
+}
+
+// nodeChange 构建本地失效使用的节点变更，内容与发件箱为同一写操作记录的事件一致
+func nodeChange(eventType, id string, labels []string) neo4jdal.OutboxEvent {
+	return neo4jdal.OutboxEvent{EventType: eventType, AggregateType: "node", AggregateID: id, NodeIDs: []string{id}, NodeLabels: labels}
+}
+
+// relationChange 构建本地失效使用的关系变更
+func relationChange(eventType, id, sourceID, targetID string) neo4jdal.OutboxEvent {
+	return neo4jdal.OutboxEvent{EventType: eventType, AggregateType: "relation", AggregateID: id, NodeIDs: []string{sourceID, targetID}, RelationIDs: []string{id}}
+}
+
+// deleteChange 根据存储层返回的删除结果构建本地失效使用的删除变更
+func deleteChange(eventType, aggregateType, id string, deleted neo4jdal.DeleteResult) neo4jdal.OutboxEvent {
+	return neo4jdal.OutboxEvent{
+		EventType:     eventType,
+		AggregateType: aggregateType,
+		AggregateID:   id,
+		NodeIDs:       deleted.NodeIDs,
+		RelationIDs:   deleted.RelationIDs,
+		NodeLabels:    deleted.NodeLabels,
+	}
+}
+
+// nodeEvent 构建节点变更事件，附带节点标签以便发件箱按标签索引查找节点
+func nodeEvent(eventType, id string, nodeType network.NodeType) neo4jdal.ChangeEvent {
+	label, _ := typeregistry.Default().NodeLabel(nodeType)
+	return neo4jdal.ChangeEvent{EventType: eventType, AggregateID: id, Label: label}
+}
+
+// relationEvent 构建关系变更事件，附带关系类型
+func relationEvent(eventType, id string, relType network.RelationType) neo4jdal.ChangeEvent {
+	label, _ := typeregistry.Default().RelationLabel(relType)
+	return neo4jdal.ChangeEvent{EventType: eventType, AggregateID: id, Label: label}
+}
+
+// OutboxRepository 定义了 outbox relay 使用的发件箱操作
+type OutboxRepository interface {
+	// FetchPending 按写入顺序返回最多 limit 条未发布的事件
+	FetchPending(ctx context.Context, limit int64) ([]neo4jdal.OutboxEvent, error)
+	// MarkPublished 将事件标记为已发布
+	MarkPublished(ctx context.Context, eventIDs []string) error
+	// MarkFailed 记录一次发布失败
+	MarkFailed(ctx context.Context, eventID string, lastError string) error
+	// PurgePublished 删除 before 之前已发布的事件
+	PurgePublished(ctx context.Context, before time.Time) (int64, error)
+}
+
+// neo4jOutboxRepo 实现了 OutboxRepository 接口
+type neo4jOutboxRepo struct {
+	driver    neo4j.DriverWithContext
+	outboxDAL neo4jdal.OutboxDAL
+	logger    *zap.Logger
+}
+
+// NewOutboxRepository 创建一个新的 OutboxRepository 实例
+func NewOutboxRepository(driver neo4j.DriverWithContext, outboxDAL neo4jdal.OutboxDAL, logger *zap.Logger) OutboxRepository {
+	return &neo4jOutboxRepo{
+		driver:    driver,
+		outboxDAL: outboxDAL,
+		logger:    logger,
+	}
+}
+
+// FetchPending 读取未发布的事件
+func (r *neo4jOutboxRepo) FetchPending(ctx context.Context, limit int64) ([]neo4jdal.OutboxEvent, error) {
+	session := r.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+
+	events, err := r.outboxDAL.ExecFetchPendingEvents(ctx, session, limit)
+	if err != nil {
+		return nil, fmt.Errorf("repo: 调用 DAL 读取发件箱失败: %w", err)
+	}
+	return events, nil
+}
+
+// MarkPublished 标记事件已发布
+func (r *neo4jOutboxRepo) MarkPublished(ctx context.Context, eventIDs []string) error {
+	session := r.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+
+	if err := r.outboxDAL.ExecMarkEventsPublished(ctx, session, eventIDs); err != nil {
+		return fmt.Errorf("repo: 调用 DAL 标记事件已发布失败: %w", err)
+	}
+	return nil
+}
+
+// MarkFailed 记录发布失败
+func (r *neo4jOutboxRepo) MarkFailed(ctx context.Context, eventID string, lastError string) error {
+	session := r.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+
+	if err := r.outboxDAL.ExecMarkEventFailed(ctx, session, eventID, lastError); err != nil {
+		return fmt.Errorf("repo: 调用 DAL 标记事件失败失败: %w", err)
+	}
+	return nil
+}
+
+// PurgePublished 清理已发布的旧事件
+func (r *neo4jOutboxRepo) PurgePublished(ctx context.Context, before time.Time) (int64, error) {
+	session := r.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close(ctx)
+
+	deleted, err := r.outboxDAL.ExecPurgePublishedEvents(ctx, session, before)
+	if err != nil {
+		return 0, fmt.Errorf("repo: 调用 DAL 清理发件箱失败: %w", err)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,128 @@
+package neo4jrepo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"labelwall/biz/dal/neo4jdal"
+	"labelwall/biz/dal/storage"
+	"labelwall/biz/model/relationship/network"
+	"labelwall/biz/repo/neo4jrepo"
+	"labelwall/internal/invalidation"
+	"labelwall/pkg/cache"
+)
+
+// TestOutbox_Integration 验证启用发件箱后写操作在同一事务中写入变更事件
+func TestOutbox_Integration(t *testing.T) {
+	if neo4jTestStore == nil {
+		t.Skip("outbox events are stored in Neo4j; set NEO4J_URI to run")
+	}
+	ctx := context.Background()
+	clearNeo4jTestData(ctx)
+	logger := zap.NewNop()
+
+	relRepo := neo4jrepo.NewRelationRepository(neo4jTestStore, nil, 300, 1000, logger, neo4jrepo.WithOutbox())
+	nodeRepo := neo4jrepo.NewNodeRepository(neo4jTestStore, nil, relRepo, 300, 100, 500, 100, 100, 3, 5, 1000, logger, neo4jrepo.WithOutbox())
+	outboxRepo := neo4jrepo.NewOutboxRepository(testDriver, neo4jdal.NewOutboxDAL(), logger)
+
+	alice, err := nodeRepo.CreateNode(ctx, &network.CreateNodeRequest{Type: network.NodeType_PERSON, Name: "Outbox Alice"})
+	require.NoError(t, err)
+	acme, err := nodeRepo.CreateNode(ctx, &network.CreateNodeRequest{Type: network.NodeType_COMPANY, Name: "Outbox Acme"})
+	require.NoError(t, err)
+	newName := "Outbox Alice 2"
+	_, err = nodeRepo.UpdateNode(ctx, &network.UpdateNodeRequest{ID: alice.ID, Name: &newName})
+	require.NoError(t, err)
+	rel, err := relRepo.CreateRelation(ctx, &network.CreateRelationRequest{Source: alice.ID, Target: acme.ID, Type: network.RelationType_COLLEAGUE})
+	require.NoError(t, err)
+
+	// 未命中数据的写操作不产生事件
+	_, err = relRepo.CreateRelation(ctx, &network.CreateRelationRequest{Source: alice.ID, Target: "missing", Type: network.RelationType_FRIEND})
+	require.Error(t, err)
+	require.Error(t, nodeRepo.DeleteNode(ctx, "missing"))
+
+	require.NoError(t, nodeRepo.DeleteNode(ctx, acme.ID))
+
+	events, err := outboxRepo.FetchPending(ctx, 100)
+	require.NoError(t, err)
+	var types []string
+	for _, e := range events {
+		types = append(types, e.EventType)
+	}
+	assert.Equal(t, []string{
+		neo4jdal.EventNodeCreated, neo4jdal.EventNodeCreated, neo4jdal.EventNodeUpdated,
+		neo4jdal.EventRelationCreated, neo4jdal.EventNodeDeleted,
+	}, types)
+
+	require.Len(t, events, 5)
+	assert.Equal(t, []string{"PERSON"}, events[0].NodeLabels, "节点事件记录节点标签")
+	assert.ElementsMatch(t, []string{alice.ID, acme.ID}, events[3].NodeIDs, "关系事件包含两端节点")
+	deleted := events[4]
+	assert.Equal(t, acme.ID, deleted.AggregateID)
+	assert.ElementsMatch(t, []string{acme.ID, alice.ID}, deleted.NodeIDs, "删除事件包含邻居节点")
+	assert.Equal(t, []string{rel.ID}, deleted.RelationIDs, "删除事件包含随节点删除的关系")
+
+	// 标记已发布后不再返回
+	ids := make([]string, len(events))
+	for i, e := range events {
+		ids[i] = e.EventID
+	}
+	require.NoError(t, outboxRepo.MarkPublished(ctx, ids))
+	events, err = outboxRepo.FetchPending(ctx, 100)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+// TestLocalInvalidation 验证没有发件箱消费者时写操作在本地同步使派生缓存失效
+func TestLocalInvalidation(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewNop()
+	store := storage.NewMemoryStore()
+	c := cache.NewMemoryCache()
+	local := neo4jrepo.WithLocalInvalidation(invalidation.NewHandler(c, c, c, logger))
+	relRepo := neo4jrepo.NewRelationRepository(store, c, 300, 1000, logger, local)
+	nodeRepo := neo4jrepo.NewNodeRepository(store, c, relRepo, 300, 100, 500, 100, 100, 3, 5, 1000, logger, local)
+
+	personType := network.NodeType_PERSON
+	search := &network.SearchNodesRequest{Criteria: map[string]string{"profession": "pilot"}, Type: &personType}
+	alice, err := nodeRepo.CreateNode(ctx, &network.CreateNodeRequest{Type: network.NodeType_PERSON, Name: "Local Alice", Profession: func(s string) *string { return &s }("pilot")})
+	require.NoError(t, err)
+	nodes, _, _, _, err := nodeRepo.SearchNodes(ctx, search)
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+
+	// 新节点开始匹配已缓存的搜索
+	bob, err := nodeRepo.CreateNode(ctx, &network.CreateNodeRequest{Type: network.NodeType_PERSON, Name: "Local Bob", Profession: func(s string) *string { return &s }("pilot")})
+	require.NoError(t, err)
+	nodes, _, _, _, err = nodeRepo.SearchNodes(ctx, search)
+	require.NoError(t, err)
+	assert.Len(t, nodes, 2, "创建节点后搜索缓存应失效")
+
+	// 新关系使缓存的空路径失效，删除关系后路径再次消失
+	pathReq := &network.GetPathRequest{SourceID: alice.ID, TargetID: bob.ID}
+	_, err = nodeRepo.GetPath(ctx, pathReq)
+	require.Error(t, err, "两个节点之间还没有路径")
+	rel, err := relRepo.CreateRelation(ctx, &network.CreateRelationRequest{Source: alice.ID, Target: bob.ID, Type: network.RelationType_FRIEND})
+	require.NoError(t, err)
+	paths, err := nodeRepo.GetPath(ctx, pathReq)
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+	require.NoError(t, relRepo.DeleteRelation(ctx, rel.ID))
+	_, err = nodeRepo.GetPath(ctx, pathReq)
+	assert.Error(t, err, "删除关系后路径缓存应失效")
+
+	// 删除节点使邻居的关系列表失效
+	_, err = relRepo.CreateRelation(ctx, &network.CreateRelationRequest{Source: alice.ID, Target: bob.ID, Type: network.RelationType_FRIEND})
+	require.NoError(t, err)
+	relsReq := &network.GetNodeRelationsRequest{NodeID: alice.ID}
+	rels, _, _, err := relRepo.GetNodeRelations(ctx, relsReq)
+	require.NoError(t, err)
+	require.Len(t, rels, 1)
+	require.NoError(t, nodeRepo.DeleteNode(ctx, bob.ID))
+	rels, _, _, err = relRepo.GetNodeRelations(ctx, relsReq)
+	require.NoError(t, err)
+	assert.Empty(t, rels, "删除节点后邻居的关系列表缓存应失效")
+}
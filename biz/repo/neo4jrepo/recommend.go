@@ -0,0 +1,212 @@
+package neo4jrepo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	"labelwall/biz/dal/neo4jdal"
+	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/cache"
+	"labelwall/pkg/recommend"
+	"labelwall/pkg/typeregistry"
+)
+
+const (
+	// RecommendationsCachePrefix is the prefix for recommendation cache keys (one key per node)
+	RecommendationsCachePrefix = "recommend:ids:"
+	// RecommendationsCacheTTL is the TTL for recommendation cache
+	RecommendationsCacheTTL = 10 * time.Minute
+	// RecommendationsDefaultLimit is the default number of recommendations returned
+	RecommendationsDefaultLimit = 10
+	// RecommendationsMaxLimit is the hard cap on recommendations returned (and cached) per node
+	RecommendationsMaxLimit = 50
+)
+
+// recommendationIDs 是缓存中的一个推荐项，节点以 ID 保存，读取时批量取回
+type recommendationIDs struct {
+	NodeID     string   `json:"node_id"`
+	Score      float64  `json:"score"`
+	AdamicAdar float64  `json:"adamic_adar"`
+	Jaccard    float64  `json:"jaccard"`
+	MutualIDs  []string `json:"mutual_ids"`
+	OrgIDs     []string `json:"org_ids"`
+}
+
+// getRecommendationsCacheValue 是推荐结果的缓存值，保存前 RecommendationsMaxLimit 个推荐项
+type getRecommendationsCacheValue struct {
+	Recommendations []recommendationIDs `json:"recommendations"`
+}
+
+// recommendationsResult 是 GetRecommendations 数据库查询的结果
+type recommendationsResult struct {
+	items []recommendationIDs
+}
+
+// recommendationQuery 返回节点的推荐查询参数: 联系关系为 FRIEND、COLLEAGUE 和 SCHOOLMATE，组织为 COMPANY 和 SCHOOL
+func recommendationQuery(nodeID string) neo4jdal.RecommendationQuery {
+	registry := typeregistry.Default()
+	return neo4jdal.RecommendationQuery{
+		NodeID: nodeID,
+		ConnectionTypes: []string{
+			registry.RelationTypeName(network.RelationType_FRIEND),
+			registry.RelationTypeName(network.RelationType_COLLEAGUE),
+			registry.RelationTypeName(network.RelationType_SCHOOLMATE),
+		},
+		PersonLabel: registry.NodeTypeName(network.NodeType_PERSON),
+		OrgLabels: []string{
+			registry.NodeTypeName(network.NodeType_COMPANY),
+			registry.NodeTypeName(network.NodeType_SCHOOL),
+		},
+	}
+}
+
+// GetRecommendations 为节点推荐 "可能认识的人"，按得分降序返回前 limit 个 (默认 10，最多 50)。
+// 每个节点缓存一份完整的推荐列表，缓存键登记在该节点、它的联系人和所属组织下，这些节点的关系变化时失效。
+func (r *neo4jNodeRepo) GetRecommendations(ctx context.Context, req *network.GetRecommendationsRequest) ([]*network.Recommendation, error) {
+	limit := RecommendationsDefaultLimit
+	if req.IsSetLimit() && *req.Limit > 0 {
+		limit = min(int(*req.Limit), RecommendationsMaxLimit)
+	}
+
+	if r.cache == nil {
+		r.logger.Warn("Repo: GetRecommendations cache not initialized, skipping cache.")
+		items, _, err := r.getRecommendationsDirect(ctx, req.NodeID)
+		if err != nil {
+			return nil, err
+		}
+		return r.hydrateRecommendations(ctx, items[:min(limit, len(items))])
+	}
+
+	cacheKey := RecommendationsCachePrefix + req.NodeID
+	cachedData, err := r.cache.Get(ctx, cacheKey)
+	if err == nil {
+		var cachedValue getRecommendationsCacheValue
+		if err = json.NewDecoder(bytes.NewReader(cachedData)).Decode(&cachedValue); err == nil {
+			r.logger.Info("Repo: GetRecommendations cache hit, fetching details", zap.String("cacheKey", cacheKey))
+			items := cachedValue.Recommendations
+			return r.hydrateRecommendations(ctx, items[:min(limit, len(items))])
+		}
+		// 缓存数据解析失败，当作未命中
+		r.logger.Error("Repo: GetRecommendations cache data decode failed", zap.String("cacheKey", cacheKey), zap.Error(err))
+	} else if !errors.Is(err, cache.ErrNotFound) {
+		r.logger.Error("Repo: GetRecommendations cache get failed", zap.String("cacheKey", cacheKey), zap.Error(err))
+	} else {
+		r.logger.Info("Repo: GetRecommendations cache miss", zap.String("cacheKey", cacheKey))
+	}
+
+	// 缓存未命中或出错，查询数据库并回填缓存，同一节点的并发请求只查询一次
+	result, _, err := r.recommendationFlights.Do(ctx, cacheKey, func(ctx context.Context) (recommendationsResult, error) {
+		return r.loadRecommendations(ctx, req.NodeID, cacheKey)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.hydrateRecommendations(ctx, result.items[:min(limit, len(result.items))])
+}
+
+// loadRecommendations 查询数据库并将推荐列表写入缓存
+func (r *neo4jNodeRepo) loadRecommendations(ctx context.Context, nodeID, cacheKey string) (recommendationsResult, error) {
+	items, indexIDs, err := r.getRecommendationsDirect(ctx, nodeID)
+	if err != nil {
+		r.logger.Error("Repo: GetRecommendations query failed", zap.String("cacheKey", cacheKey), zap.Error(err))
+		return recommendationsResult{}, err
+	}
+
+	var buffer bytes.Buffer
+	if encErr := json.NewEncoder(&buffer).Encode(getRecommendationsCacheValue{Recommendations: items}); encErr == nil {
+		setErr := r.cache.Set(ctx, cacheKey, buffer.Bytes(), RecommendationsCacheTTL)
+		if setErr != nil {
+			r.logger.Error("Repo: GetRecommendations cache set failed", zap.String("cacheKey", cacheKey), zap.Error(setErr))
+		} else {
+			r.logger.Info("Repo: GetRecommendations set data to cache", zap.String("cacheKey", cacheKey))
+			indexDerivedKey(ctx, r.cache, r.logger, cacheKey, indexIDs, RecommendationsCacheTTL)
+		}
+	} else {
+		r.logger.Error("Repo: GetRecommendations cache value encode failed", zap.String("cacheKey", cacheKey), zap.Error(encErr))
+	}
+	return recommendationsResult{items: items}, nil
+}
+
+// getRecommendationsDirect 查询候选人并打分，返回前 RecommendationsMaxLimit 个推荐项，
+// 以及关系变化会影响结果的节点 (目标节点、它的联系人和所属组织)
+func (r *neo4jNodeRepo) getRecommendationsDirect(ctx context.Context, nodeID string) ([]recommendationIDs, []string, error) {
+	neighborhood, err := r.store.GetRecommendationCandidates(ctx, recommendationQuery(nodeID))
+	if err != nil {
+		return nil, nil, fmt.Errorf("repo: 调用 DAL 获取推荐候选人失败: %w", err)
+	}
+
+	ranked := recommend.Rank(len(neighborhood.Contacts), neighborhood.Candidates, RecommendationsMaxLimit)
+	items := make([]recommendationIDs, len(ranked))
+	for i, s := range ranked {
+		item := recommendationIDs{
+			NodeID:     s.ID,
+			Score:      s.Score,
+			AdamicAdar: s.AdamicAdar,
+			Jaccard:    s.Jaccard,
+			MutualIDs:  make([]string, len(s.Mutuals)),
+			OrgIDs:     make([]string, len(s.SharedOrgs)),
+		}
+		for j, m := range s.Mutuals {
+			item.MutualIDs[j] = m.ID
+		}
+		for j, o := range s.SharedOrgs {
+			item.OrgIDs[j] = o.ID
+		}
+		items[i] = item
+	}
+
+	indexIDs := append([]string{nodeID}, neighborhood.Contacts...)
+	indexIDs = append(indexIDs, neighborhood.Orgs...)
+	return items, indexIDs, nil
+}
+
+// hydrateRecommendations 批量取回推荐项中的节点 (一次批量读取)，已被删除的候选人和共同邻居不出现在结果中
+func (r *neo4jNodeRepo) hydrateRecommendations(ctx context.Context, items []recommendationIDs) ([]*network.Recommendation, error) {
+	var nodeIDs []string
+	for _, item := range items {
+		nodeIDs = append(nodeIDs, item.NodeID)
+		nodeIDs = append(nodeIDs, item.MutualIDs...)
+		nodeIDs = append(nodeIDs, item.OrgIDs...)
+	}
+	slices.Sort(nodeIDs)
+	nodesByID, err := r.GetNodes(ctx, slices.Compact(nodeIDs))
+	if err != nil {
+		return nil, fmt.Errorf("repo: 获取推荐节点失败: %w", err)
+	}
+
+	collect := func(ids []string) []*network.Node {
+		nodes := make([]*network.Node, 0, len(ids))
+		for _, id := range ids {
+			if node, ok := nodesByID[id]; ok {
+				nodes = append(nodes, node)
+			}
+		}
+		return nodes
+	}
+	recommendations := make([]*network.Recommendation, 0, len(items))
+	for _, item := range items {
+		node, ok := nodesByID[item.NodeID]
+		if !ok {
+			r.logger.Warn("Repo: GetRecommendations candidate not found, skipping", zap.String("nodeID", item.NodeID))
+			continue
+		}
+		mutuals := collect(item.MutualIDs)
+		recommendations = append(recommendations, &network.Recommendation{
+			Node:                node,
+			Score:               item.Score,
+			MutualCount:         int32(len(mutuals)),
+			MutualNodes:         mutuals,
+			SharedOrganizations: collect(item.OrgIDs),
+			AdamicAdar:          item.AdamicAdar,
+			Jaccard:             item.Jaccard,
+		})
+	}
+	return recommendations, nil
+}
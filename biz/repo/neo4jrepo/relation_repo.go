@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -15,12 +16,15 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/google/uuid"
-	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
 
 	"labelwall/biz/dal/neo4jdal"
+	"labelwall/biz/dal/storage"
 	network "labelwall/biz/model/relationship/network"
 	"labelwall/pkg/cache" // 引入缓存包
+	"labelwall/pkg/propvalue"
+	"labelwall/pkg/singleflight"
+	"labelwall/pkg/typeregistry"
 )
 
 // 保留与 TTL 无关的常量
@@ -31,74 +35,212 @@ const (
 
 // neo4jRelationRepo 实现了 RelationRepository 接口
 type neo4jRelationRepo struct {
-	driver      neo4j.DriverWithContext
-	relationDAL neo4jdal.RelationDAL
-	cache       cache.RelationAndByteCache
+	store storage.RelationStore
+	cache cache.RelationAndByteCache
 	// 添加配置字段
 	defaultTTL          time.Duration
 	getNodeRelationsTTL time.Duration
 	logger              *zap.Logger
+	opts                repoOptions
+
+	// 缓存未命中时按缓存键合并并发的数据库查询
+	nodeRelationsFlights singleflight.Group[nodeRelationsResult]
 }
 
 // NewRelationRepository 创建一个新的 RelationRepository 实例
-// 添加 TTL 参数 (秒)
+// 添加 TTL 参数 (秒); opts 为可选行为 (例如 WithOutbox)
 func NewRelationRepository(
-	driver neo4j.DriverWithContext,
-	relationDAL neo4jdal.RelationDAL,
+	store storage.RelationStore,
 	cache cache.RelationAndByteCache,
 	defaultTTLSeconds int,
 	getNodeRelationsTTLSeconds int,
 	logger *zap.Logger,
+	opts ...RepoOption,
 ) RelationRepository {
-	return &neo4jRelationRepo{
-		driver:      driver,
-		relationDAL: relationDAL,
-		cache:       cache,
+	o := buildRepoOptions(opts)
+	r := &neo4jRelationRepo{
+		store: store,
+		cache: cache,
 		// 将秒转换为 time.Duration
 		defaultTTL:          time.Duration(defaultTTLSeconds) * time.Second,
 		getNodeRelationsTTL: time.Duration(getNodeRelationsTTLSeconds) * time.Second,
 		logger:              logger,
+		opts:                o,
 	}
+	r.nodeRelationsFlights.Timeout = o.queryTimeout
+	return r
 }
 
 // CreateRelation 创建一个新的关系
 // 通常不直接影响基于 ID 的缓存
 func (r *neo4jRelationRepo) CreateRelation(ctx context.Context, req *network.CreateRelationRequest) (*network.Relation, error) {
-	session := r.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
-	defer session.Close(ctx)
-
 	// 1. 生成唯一业务 ID
 	relationID := uuid.NewString()
 
 	// 2. 构建关系属性 Map
-	properties := map[string]any{
-		"id":         relationID,
-		"created_at": time.Now().UTC(),
-		"updated_at": time.Now().UTC(),
-	}
-	if req.Label != nil {
-		properties["label"] = *req.Label
-	}
-	if req.Properties != nil {
-		for k, v := range req.Properties {
-			if _, exists := properties[k]; !exists {
-				properties[k] = v
-			}
-		}
+	typed, fieldErrs := propvalue.ToDBMap(req.TypedProperties)
+	if len(fieldErrs) > 0 {
+		return nil, fmt.Errorf("repo: 带类型的属性无效: %w", fieldErrs[0])
 	}
+	properties := BuildRelationProperties(relationID, req.Label, req.Properties, typed)
 
 	// 3. 调用 DAL 层执行创建
 	// ExecCreateRelation 期望返回创建的关系及其类型
-	dbRel, err := r.relationDAL.ExecCreateRelation(ctx, session, req.Source, req.Target, req.Type, properties)
+	// 启用发件箱时同一事务写入 RelationCreated 事件 (源/目标不存在时不会产生事件)
+	events := r.opts.events(relationEvent(neo4jdal.EventRelationCreated, relationID, req.Type))
+	dbRel, err := r.store.CreateRelation(ctx, req.Source, req.Target, req.Type, properties, events...)
 	if err != nil {
 		return nil, fmt.Errorf("repo: 调用 DAL 创建关系失败: %w", err)
 	}
+	r.opts.applyLocal(ctx, r.logger, relationChange(neo4jdal.EventRelationCreated, relationID, req.Source, req.Target))
 
 	// 4. 映射结果
 	// mapDbRelationshipToThriftRelation 需要源和目标 ID，这里直接用请求里的
 	return mapDbRelationshipToThriftRelation(dbRel, req.Type, req.Source, req.Target), nil
 }
 
+// BuildRelationProperties 构建写入 Neo4j 的关系属性 Map，自定义属性不会覆盖核心属性
+// typed 为已由 propvalue.ToDBMap 转换的带类型属性，与 custom 中的同名键冲突时优先。
+// 批量导入等直接走 DAL 的写入路径也复用它，保证属性格式与 CreateRelation 一致。
+func BuildRelationProperties(relationID string, label *string, custom map[string]string, typed map[string]any) map[string]any {
+	now := time.Now().UTC()
+	properties := map[string]any{
+		"id":         relationID,
+		"created_at": now,
+		"updated_at": now,
+	}
+	if label != nil {
+		properties["label"] = *label
+	}
+	mergeCustomProps(properties, custom, typed)
+	return properties
+}
+
+// BatchCreateRelations 在一个写事务中批量创建关系
+// 存在未注册关系类型时整个批次被拒绝 (ErrInvalidBatch)；其他校验失败的项不会写入数据库，而是在对应的结果中返回错误。
+func (r *neo4jRelationRepo) BatchCreateRelations(ctx context.Context, req *network.BatchCreateRelationsRequest) ([]*network.BatchRelationResult, error) {
+	// 1. 整体校验类型，再逐项校验并构建属性
+	if err := validateBatchRelationTypes(req.Relations); err != nil {
+		return nil, err
+	}
+	results, inputs, inputIdx := prepareBatchRelations(req.Relations, nil)
+	if len(inputs) == 0 {
+		return results, nil
+	}
+
+	// 2. 单个事务写入所有合法关系
+	created, err := r.store.BatchCreateRelations(ctx, inputs, r.opts.events(batchRelationEvents(inputs)...)...)
+	if err != nil {
+		return nil, fmt.Errorf("repo: 调用 DAL 批量创建关系失败: %w", err)
+	}
+
+	// 3. 映射结果
+	mapBatchRelationResults(results, inputs, inputIdx, created)
+	r.opts.applyLocal(ctx, r.logger, createdRelationChanges(inputs, created)...)
+	return results, nil
+}
+
+// validateBatchRelationTypes 检查批次中每一项的关系类型都已注册，否则返回 ErrInvalidBatch
+func validateBatchRelationTypes(items []*network.BatchRelationItem) error {
+	for i, item := range items {
+		if _, ok := typeregistry.Default().RelationLabel(item.Type); !ok {
+			return fmt.Errorf("%w: 第 %d 条关系使用了未注册的关系类型 %d", ErrInvalidBatch, i, item.Type)
+		}
+	}
+	return nil
+}
+
+// prepareBatchRelations 逐项校验关系并构建写入参数，临时键通过 keyToID 解析为本批次新建节点的 ID。
+// 返回逐项结果 (校验失败的项已填写错误)、合法项的写入参数，以及写入参数下标到请求下标的映射。
+func prepareBatchRelations(items []*network.BatchRelationItem, keyToID map[string]string) ([]*network.BatchRelationResult, []neo4jdal.BatchRelationInput, []int) {
+	results := make([]*network.BatchRelationResult, len(items))
+	inputs := make([]neo4jdal.BatchRelationInput, 0, len(items))
+	inputIdx := make([]int, 0, len(items)) // inputs 下标 -> 请求下标
+	setError := func(i int, msg string) {
+		results[i].Error = &msg
+	}
+	resolve := func(id, key *string) (string, bool) {
+		if key != nil && *key != "" {
+			resolved, ok := keyToID[*key]
+			return resolved, ok
+		}
+		if id == nil {
+			return "", true
+		}
+		return *id, true
+	}
+
+	for i, item := range items {
+		results[i] = &network.BatchRelationResult{Index: int32(i)}
+		source, ok := resolve(item.Source, item.SourceKey)
+		if !ok {
+			setError(i, fmt.Sprintf("临时键 %q 未在本批次中定义", *item.SourceKey))
+			continue
+		}
+		target, ok := resolve(item.Target, item.TargetKey)
+		if !ok {
+			setError(i, fmt.Sprintf("临时键 %q 未在本批次中定义", *item.TargetKey))
+			continue
+		}
+		if source == "" || target == "" {
+			setError(i, "源节点和目标节点 ID 不能为空")
+			continue
+		}
+		typed, fieldErrs := propvalue.ToDBMap(item.TypedProperties)
+		if len(fieldErrs) > 0 {
+			setError(i, fmt.Sprintf("带类型的属性无效: %s", fieldErrs[0].Error()))
+			continue
+		}
+		if err := typeregistry.PropertiesError(typeregistry.Default().RelationPropertySchema(item.Type), propvalue.StringView(item.Properties, typed), false); err != nil {
+			setError(i, err.Error())
+			continue
+		}
+		inputs = append(inputs, neo4jdal.BatchRelationInput{
+			SourceID:   source,
+			TargetID:   target,
+			RelType:    item.Type,
+			Properties: BuildRelationProperties(uuid.NewString(), item.Label, item.Properties, typed),
+		})
+		inputIdx = append(inputIdx, i)
+	}
+	return results, inputs, inputIdx
+}
+
+// batchRelationEvents 为每条待创建的关系生成 relation.created 事件
+func batchRelationEvents(inputs []neo4jdal.BatchRelationInput) []neo4jdal.ChangeEvent {
+	events := make([]neo4jdal.ChangeEvent, len(inputs))
+	for j, input := range inputs {
+		events[j] = relationEvent(neo4jdal.EventRelationCreated, input.Properties["id"].(string), input.RelType)
+	}
+	return events
+}
+
+// mapBatchRelationResults 将写入结果填入逐项结果，未创建的项 (两端不存在或类型组合不被允许) 填写错误
+func mapBatchRelationResults(results []*network.BatchRelationResult, inputs []neo4jdal.BatchRelationInput, inputIdx []int, created map[int]dbtype.Relationship) {
+	for j, input := range inputs {
+		i := inputIdx[j]
+		dbRel, ok := created[j]
+		if !ok {
+			msg := "源节点或目标节点不存在，或节点类型组合不被该关系类型允许"
+			results[i].Error = &msg
+			continue
+		}
+		results[i].Success = true
+		results[i].Relation = mapDbRelationshipToThriftRelation(dbRel, input.RelType, input.SourceID, input.TargetID)
+	}
+}
+
+// createdRelationChanges 返回批量写入中真正创建的关系对应的本地失效变更
+func createdRelationChanges(inputs []neo4jdal.BatchRelationInput, created map[int]dbtype.Relationship) []neo4jdal.OutboxEvent {
+	changes := make([]neo4jdal.OutboxEvent, 0, len(created))
+	for j, input := range inputs {
+		if _, ok := created[j]; ok {
+			changes = append(changes, relationChange(neo4jdal.EventRelationCreated, input.Properties["id"].(string), input.SourceID, input.TargetID))
+		}
+	}
+	return changes
+}
+
 // GetRelation 通过 ID 获取关系，应用 Read-Aside 缓存策略
 func (r *neo4jRelationRepo) GetRelation(ctx context.Context, id string) (*network.Relation, error) {
 	// 1. 尝试从缓存获取 (使用 r.cache)
@@ -118,11 +260,8 @@ func (r *neo4jRelationRepo) GetRelation(ctx context.Context, id string) (*networ
 
 	// 2. 从数据库获取
 	r.logger.Info("Repo: GetRelation cache miss, querying database", zap.String("id", id))
-	session := r.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
-	defer session.Close(ctx)
-
 	// ExecGetRelationByID 期望返回关系、类型字符串、源节点ID、目标节点ID
-	dbRel, relTypeStr, sourceID, targetID, err := r.relationDAL.ExecGetRelationByID(ctx, session, id)
+	dbRel, relTypeStr, sourceID, targetID, err := r.store.GetRelationByID(ctx, id)
 	if err != nil {
 		if isNotFoundError(err) {
 			// DB 未找到，不缓存空值（符合 redis_cache.go 里的 SetRelation 逻辑）
@@ -151,11 +290,62 @@ func (r *neo4jRelationRepo) GetRelation(ctx context.Context, id string) (*networ
 	return resultRel, nil
 }
 
+// GetRelations 批量获取关系：先用一次缓存批量读取，未命中的 ID 再用一次 DAL 查询补齐并回填缓存。
+// 与 GetRelation 一致，数据库中不存在的关系不缓存空值。
+func (r *neo4jRelationRepo) GetRelations(ctx context.Context, ids []string) (map[string]*network.Relation, error) {
+	result := make(map[string]*network.Relation, len(ids))
+	misses := ids
+
+	// 1. 批量读取缓存
+	if r.cache != nil && len(ids) > 0 {
+		cached, err := r.cache.GetRelations(ctx, ids)
+		if err != nil {
+			r.logger.Warn("Repo: 缓存批量获取关系失败", zap.Int("count", len(ids)), zap.Error(err))
+		} else {
+			misses = make([]string, 0, len(ids))
+			for _, id := range ids {
+				rel, ok := cached[id]
+				if !ok {
+					misses = append(misses, id)
+				} else if rel != nil {
+					result[id] = rel
+				}
+			}
+		}
+	}
+	if len(misses) == 0 {
+		return result, nil
+	}
+
+	// 2. 一次数据库查询补齐未命中的关系
+	r.logger.Info("Repo: GetRelations cache miss, querying database", zap.Int("misses", len(misses)))
+	dbRels, relTypeStrs, sourceIDs, targetIDs, err := r.store.GetRelationsByIDs(ctx, misses)
+	if err != nil {
+		return nil, fmt.Errorf("repo: 调用 DAL 批量获取关系失败: %w", err)
+	}
+	loaded := make(map[string]*network.Relation, len(dbRels))
+	for i, dbRel := range dbRels {
+		relType, ok := stringToRelationType(relTypeStrs[i])
+		if !ok {
+			r.logger.Warn("Repo: 无法识别关系 的类型", zap.String("elementId", dbRel.ElementId), zap.String("relationType", relTypeStrs[i]))
+			continue
+		}
+		rel := mapDbRelationshipToThriftRelation(dbRel, relType, sourceIDs[i], targetIDs[i])
+		result[rel.ID] = rel
+		loaded[rel.ID] = rel
+	}
+
+	// 3. 回填缓存
+	if r.cache != nil && len(loaded) > 0 {
+		if setErr := r.cache.SetRelations(ctx, loaded, r.defaultTTL); setErr != nil {
+			r.logger.Warn("Repo: 缓存批量设置关系失败", zap.Int("count", len(loaded)), zap.Error(setErr))
+		}
+	}
+	return result, nil
+}
+
 // UpdateRelation 更新关系属性，应用 Write Invalidation 缓存策略
 func (r *neo4jRelationRepo) UpdateRelation(ctx context.Context, req *network.UpdateRelationRequest) (*network.Relation, error) {
-	session := r.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
-	defer session.Close(ctx)
-
 	// 1. 构建更新 Map
 	updates := map[string]any{
 		"updated_at": time.Now().UTC(),
@@ -163,18 +353,26 @@ func (r *neo4jRelationRepo) UpdateRelation(ctx context.Context, req *network.Upd
 	if req.Label != nil {
 		updates["label"] = *req.Label
 	}
-	if req.Properties != nil {
-		for k, v := range req.Properties {
-			if k != "id" && k != "created_at" && k != "updated_at" {
-				updates[k] = v
-			}
+	typed, fieldErrs := propvalue.ToDBMap(req.TypedProperties)
+	if len(fieldErrs) > 0 {
+		return nil, fmt.Errorf("repo: 带类型的属性无效: %w", fieldErrs[0])
+	}
+	for k, v := range req.Properties {
+		if k != "id" && k != "created_at" && k != "updated_at" {
+			updates[k] = v
+		}
+	}
+	for k, v := range typed { // 带类型的值优先
+		if k != "id" && k != "created_at" && k != "updated_at" {
+			updates[k] = v
 		}
 	}
 
 	// 2. 调用 DAL 层执行更新
 	// ExecUpdateRelation 返回更新后的关系、类型字符串、源和目标 ID
 	// 注意：DAL 层不接受类型更新作为参数
-	dbRel, relTypeStr, sourceID, targetID, err := r.relationDAL.ExecUpdateRelation(ctx, session, req.ID, updates)
+	events := r.opts.events(neo4jdal.ChangeEvent{EventType: neo4jdal.EventRelationUpdated, AggregateID: req.ID})
+	dbRel, relTypeStr, sourceID, targetID, err := r.store.UpdateRelation(ctx, req.ID, updates, events...)
 	if err != nil {
 		if isNotFoundError(err) {
 			return nil, err // 透传 Not Found
@@ -197,17 +395,15 @@ func (r *neo4jRelationRepo) UpdateRelation(ctx context.Context, req *network.Upd
 			r.logger.Warn("Repo: 缓存删除关系失败", zap.String("id", req.ID), zap.Error(delErr))
 		}
 	}
+	r.opts.applyLocal(ctx, r.logger, relationChange(neo4jdal.EventRelationUpdated, req.ID, sourceID, targetID))
 
 	return updatedRel, nil
 }
 
 // DeleteRelation 删除关系，应用 Write Invalidation 缓存策略
 func (r *neo4jRelationRepo) DeleteRelation(ctx context.Context, id string) error {
-	session := r.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
-	defer session.Close(ctx)
-
 	// 1. 调用 DAL 层执行删除
-	err := r.relationDAL.ExecDeleteRelation(ctx, session, id)
+	deleted, err := r.store.DeleteRelation(ctx, id, r.opts.events(neo4jdal.ChangeEvent{EventType: neo4jdal.EventRelationDeleted, AggregateID: id})...)
 	if err != nil {
 		if isNotFoundError(err) {
 			// DB 中不存在，仍然尝试删除缓存
@@ -229,18 +425,19 @@ func (r *neo4jRelationRepo) DeleteRelation(ctx context.Context, id string) error
 	if isNotFoundError(err) {
 		return err
 	}
+	r.opts.applyLocal(ctx, r.logger, deleteChange(neo4jdal.EventRelationDeleted, "relation", id, deleted))
 
 	return nil // DB 删除成功（或本来就不存在）且尝试删除缓存后返回 nil
 }
 
 // GetNodeRelations 获取节点的关系列表 (带缓存)
-func (r *neo4jRelationRepo) GetNodeRelations(ctx context.Context, req *network.GetNodeRelationsRequest) ([]*network.Relation, int32, error) {
+func (r *neo4jRelationRepo) GetNodeRelations(ctx context.Context, req *network.GetNodeRelationsRequest) ([]*network.Relation, int32, string, error) {
 	// 1. 处理参数 (与缓存键生成相关)
 	var relTypesStr []string
 	if req.IsSetTypes() && len(req.Types) > 0 {
 		relTypesStr = make([]string, 0, len(req.Types))
 		for _, rt := range req.Types {
-			relTypesStr = append(relTypesStr, rt.String())
+			relTypesStr = append(relTypesStr, typeregistry.Default().RelationTypeName(rt))
 		}
 	}
 	outgoing := true
@@ -262,15 +459,33 @@ func (r *neo4jRelationRepo) GetNodeRelations(ctx context.Context, req *network.G
 	} else {
 		offset = 0
 	}
+	sortKeys, err := relationSortKeys(req.Sort, req.Types)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	// 游标优先于 offset：从上一页最后一条关系的排序键位置继续 (keyset 分页)
+	var after *neo4jdal.RelationKeyset
+	cursor, err := decodePageCursor(req.Cursor, neo4jdal.SortSpec(sortKeys), len(sortKeys))
+	if err != nil {
+		return nil, 0, "", err
+	}
+	if cursor != nil {
+		values, err := decodeCursorValues(cursor.Values)
+		if err != nil {
+			return nil, 0, "", err
+		}
+		after = &neo4jdal.RelationKeyset{ID: cursor.ID, Values: values}
+		offset = 0
+	}
 
 	// 2. 检查缓存是否可用
 	if r.cache == nil {
 		r.logger.Warn("Repo: GetNodeRelations cache not initialized, skipping cache.")
-		return r.getNodeRelationsDirect(ctx, req, relTypesStr, outgoing, incoming, limit, offset)
+		return r.getNodeRelationsDirect(ctx, req, relTypesStr, outgoing, incoming, sortKeys, limit, offset, after)
 	}
 
 	// 3. 生成缓存键
-	cacheKey := generateGetNodeRelationsCacheKey(req, relTypesStr, outgoing, incoming, limit, offset)
+	cacheKey := generateGetNodeRelationsCacheKey(req, relTypesStr, outgoing, incoming, sortKeys, limit, offset)
 
 	// 4. 尝试从缓存获取 (使用通用的 Get)
 	cachedData, err := r.cache.Get(ctx, cacheKey)
@@ -278,36 +493,29 @@ func (r *neo4jRelationRepo) GetNodeRelations(ctx context.Context, req *network.G
 		// 4.1 检查空标记
 		if bytes.Equal(cachedData, []byte(getNodeRelationsEmptyPlaceholder)) {
 			r.logger.Info("Repo: GetNodeRelations cache hit empty placeholder", zap.String("cacheKey", cacheKey))
-			return []*network.Relation{}, 0, nil
+			return []*network.Relation{}, 0, "", nil
 		}
 
 		// 4.2 解析缓存的 ID 列表和总数
 		var cachedValue getNodeRelationsCacheValue
 		if err := json.NewDecoder(bytes.NewReader(cachedData)).Decode(&cachedValue); err == nil {
 			r.logger.Info("Repo: GetNodeRelations cache hit, fetching details", zap.String("cacheKey", cacheKey))
+			// 4.3 使用 GetRelations 批量获取关系详情
+			relationsByID, getErr := r.GetRelations(ctx, cachedValue.RelationIDs)
+			if getErr != nil {
+				r.logger.Error("Repo: GetNodeRelations cache hit, but GetRelations failed", zap.String("cacheKey", cacheKey), zap.Error(getErr))
+				return nil, 0, "", getErr
+			}
 			resultRelations := make([]*network.Relation, 0, len(cachedValue.RelationIDs))
-			failedFetches := 0
-
-			// 4.3 使用 GetRelation 获取关系详情
 			for _, relID := range cachedValue.RelationIDs {
-				relation, getErr := r.GetRelation(ctx, relID)
-				if getErr != nil {
-					failedFetches++
-					if errors.Is(getErr, cache.ErrNotFound) || isNotFoundError(getErr) || errors.Is(getErr, cache.ErrNilValue) {
-						r.logger.Warn("Repo: GetNodeRelations cache hit, but GetRelation couldn't find relation", zap.String("relationID", relID))
-					} else {
-						r.logger.Error("Repo: GetNodeRelations cache hit, but GetRelation failed", zap.String("relationID", relID), zap.Error(getErr))
-					}
-					continue // 跳过获取失败的关系
+				relation, ok := relationsByID[relID]
+				if !ok {
+					r.logger.Warn("Repo: GetNodeRelations cache hit, but GetRelations couldn't find relation", zap.String("relationID", relID))
+					continue // 跳过已被删除的关系
 				}
-				if relation != nil {
-					resultRelations = append(resultRelations, relation)
-				}
-			}
-			if failedFetches > 0 {
-				r.logger.Warn("Repo: GetNodeRelations cache hit, but relations failed to fetch", zap.Int("failedFetches", failedFetches), zap.String("cacheKey", cacheKey))
+				resultRelations = append(resultRelations, relation)
 			}
-			return resultRelations, cachedValue.Total, nil
+			return resultRelations, cachedValue.Total, cachedValue.NextCursor, nil
 		}
 		// 缓存数据解析失败，当作未命中
 		r.logger.Error("Repo: GetNodeRelations cache data decode failed", zap.String("cacheKey", cacheKey), zap.Error(err))
@@ -317,10 +525,31 @@ func (r *neo4jRelationRepo) GetNodeRelations(ctx context.Context, req *network.G
 		r.logger.Info("Repo: GetNodeRelations cache miss", zap.String("cacheKey", cacheKey))
 	}
 
-	// 5. 缓存未命中或出错，直接查询数据库
-	resultRelations, total, dbRels, err := r.getNodeRelationsDirectAndRaw(ctx, req, relTypesStr, outgoing, incoming, limit, offset)
+	// 5. 缓存未命中或出错，查询数据库并回填缓存，同一缓存键的并发请求只查询一次
+	result, shared, err := r.nodeRelationsFlights.Do(ctx, cacheKey, func(ctx context.Context) (nodeRelationsResult, error) {
+		return r.loadNodeRelations(ctx, req, cacheKey, relTypesStr, outgoing, incoming, sortKeys, limit, offset, after)
+	})
+	if err != nil {
+		return nil, 0, "", err
+	}
+	if shared {
+		result.relations = slices.Clone(result.relations)
+	}
+	return result.relations, result.total, result.nextCursor, nil
+}
+
+// nodeRelationsResult 是 GetNodeRelations 数据库查询的结果
+type nodeRelationsResult struct {
+	relations  []*network.Relation
+	total      int32
+	nextCursor string
+}
+
+// loadNodeRelations 查询数据库并将关系 ID 列表 (或空标记) 写入缓存
+func (r *neo4jRelationRepo) loadNodeRelations(ctx context.Context, req *network.GetNodeRelationsRequest, cacheKey string, relTypesStr []string, outgoing, incoming bool, sortKeys []neo4jdal.SortKey, limit, offset int64, after *neo4jdal.RelationKeyset) (nodeRelationsResult, error) {
+	resultRelations, total, nextCursor, dbRels, err := r.getNodeRelationsDirectAndRaw(ctx, req, relTypesStr, outgoing, incoming, sortKeys, limit, offset, after)
 	if err != nil {
-		return nil, 0, err // 直接返回数据库错误
+		return nodeRelationsResult{}, err // 直接返回数据库错误
 	}
 
 	// 6. 缓存结果
@@ -334,6 +563,7 @@ func (r *neo4jRelationRepo) GetNodeRelations(ctx context.Context, req *network.G
 				r.logger.Error("Repo: GetNodeRelations cache set empty placeholder failed", zap.String("cacheKey", cacheKey), zap.Error(setErr))
 			} else {
 				r.logger.Info("Repo: GetNodeRelations set empty placeholder to cache", zap.String("cacheKey", cacheKey))
+				indexDerivedKey(ctx, r.cache, r.logger, cacheKey, []string{req.NodeID}, cache.NilValueTTL)
 			}
 		} else {
 			// 6.2 提取 ID 并缓存 (使用配置的 TTL)
@@ -350,6 +580,7 @@ func (r *neo4jRelationRepo) GetNodeRelations(ctx context.Context, req *network.G
 			cacheValue := getNodeRelationsCacheValue{
 				RelationIDs: relationIDs,
 				Total:       int32(total),
+				NextCursor:  nextCursor,
 			}
 			var buffer bytes.Buffer
 			if encErr := json.NewEncoder(&buffer).Encode(cacheValue); encErr == nil {
@@ -359,6 +590,8 @@ func (r *neo4jRelationRepo) GetNodeRelations(ctx context.Context, req *network.G
 					r.logger.Error("Repo: GetNodeRelations cache set failed", zap.String("cacheKey", cacheKey), zap.Error(setErr))
 				} else {
 					r.logger.Info("Repo: GetNodeRelations set data to cache", zap.String("cacheKey", cacheKey))
+					// 关系事件总是包含两端节点，因此只需登记被查询的节点
+					indexDerivedKey(ctx, r.cache, r.logger, cacheKey, []string{req.NodeID}, r.getNodeRelationsTTL)
 				}
 			} else {
 				r.logger.Error("Repo: GetNodeRelations cache value encode failed", zap.String("cacheKey", cacheKey), zap.Error(encErr))
@@ -368,27 +601,37 @@ func (r *neo4jRelationRepo) GetNodeRelations(ctx context.Context, req *network.G
 
 SkipCache:
 	// 7. 返回从数据库获取并映射的结果
-	return resultRelations, total, nil
+	return nodeRelationsResult{relations: resultRelations, total: total, nextCursor: nextCursor}, nil
 }
 
 // getNodeRelationsDirectAndRaw 封装了直接的数据库查询和映射逻辑
-func (r *neo4jRelationRepo) getNodeRelationsDirectAndRaw(ctx context.Context, req *network.GetNodeRelationsRequest, relTypesStr []string, outgoing, incoming bool, limit, offset int64) (
+// 当本页结果已填满 limit 时生成下一页游标：默认排序时记录最后一条关系的 id，自定义排序时还记录它的各排序键取值。
+func (r *neo4jRelationRepo) getNodeRelationsDirectAndRaw(ctx context.Context, req *network.GetNodeRelationsRequest, relTypesStr []string, outgoing, incoming bool, sortKeys []neo4jdal.SortKey, limit, offset int64, after *neo4jdal.RelationKeyset) (
 	resultRelations []*network.Relation,
 	total int32,
+	nextCursor string,
 	dbRels []dbtype.Relationship,
 	err error,
 ) {
-	session := r.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
-	defer session.Close(ctx)
-
 	var dbTotal int64 // DAL 返回 int64
-	dbRels, relTypeStrs, sourceIDs, targetIDs, dbTotal, err := r.relationDAL.ExecGetNodeRelations(ctx, session, req.NodeID, relTypesStr, outgoing, incoming, limit, offset)
+	dbRels, relTypeStrs, sourceIDs, targetIDs, dbTotal, err := r.store.GetNodeRelations(ctx, req.NodeID, relTypesStr, outgoing, incoming, sortKeys, limit, offset, after)
 	if err != nil {
 		err = fmt.Errorf("repo: 调用 DAL 获取节点关系失败: %w", err)
 		return
 	}
 	total = int32(dbTotal) // 类型转换
 
+	if limit > 0 && int64(len(dbRels)) == limit {
+		if lastID := getStringProp(dbRels[len(dbRels)-1].Props, "id", ""); lastID != "" {
+			values, valuesErr := encodeCursorValues(dbRels[len(dbRels)-1].Props, sortKeys)
+			if valuesErr != nil {
+				err = valuesErr
+				return
+			}
+			nextCursor = encodeCursor(pageCursor{ID: lastID, Sort: neo4jdal.SortSpec(sortKeys), Values: values})
+		}
+	}
+
 	resultRelations = make([]*network.Relation, 0, len(dbRels))
 	for i, dbRel := range dbRels {
 		relTypeStr := relTypeStrs[i]
@@ -408,13 +651,13 @@ func (r *neo4jRelationRepo) getNodeRelationsDirectAndRaw(ctx context.Context, re
 		}
 	}
 
-	return // 返回映射结果、总数、原始关系和 nil 错误
+	return // 返回映射结果、总数、下一页游标、原始关系和 nil 错误
 }
 
 // getNodeRelationsDirect (旧版，仅用于在缓存未初始化时调用)
-func (r *neo4jRelationRepo) getNodeRelationsDirect(ctx context.Context, req *network.GetNodeRelationsRequest, relTypesStr []string, outgoing, incoming bool, limit, offset int64) ([]*network.Relation, int32, error) {
-	rr, total, _, err := r.getNodeRelationsDirectAndRaw(ctx, req, relTypesStr, outgoing, incoming, limit, offset)
-	return rr, total, err
+func (r *neo4jRelationRepo) getNodeRelationsDirect(ctx context.Context, req *network.GetNodeRelationsRequest, relTypesStr []string, outgoing, incoming bool, sortKeys []neo4jdal.SortKey, limit, offset int64, after *neo4jdal.RelationKeyset) ([]*network.Relation, int32, string, error) {
+	rr, total, nextCursor, _, err := r.getNodeRelationsDirectAndRaw(ctx, req, relTypesStr, outgoing, incoming, sortKeys, limit, offset, after)
+	return rr, total, nextCursor, err
 }
 
 // --- GetNodeRelations Caching --- //
@@ -423,10 +666,11 @@ func (r *neo4jRelationRepo) getNodeRelationsDirect(ctx context.Context, req *net
 type getNodeRelationsCacheValue struct {
 	RelationIDs []string `json:"relation_ids"`
 	Total       int32    `json:"total"`
+	NextCursor  string   `json:"next_cursor,omitempty"`
 }
 
 // generateGetNodeRelationsCacheKey 生成 GetNodeRelations 的缓存键
-func generateGetNodeRelationsCacheKey(req *network.GetNodeRelationsRequest, relTypesStr []string, outgoing, incoming bool, limit, offset int64) string {
+func generateGetNodeRelationsCacheKey(req *network.GetNodeRelationsRequest, relTypesStr []string, outgoing, incoming bool, sortKeys []neo4jdal.SortKey, limit, offset int64) string {
 	// 对关系类型字符串进行排序，确保顺序无关性
 	sortedTypes := make([]string, len(relTypesStr))
 	copy(sortedTypes, relTypesStr)
@@ -446,7 +690,16 @@ func generateGetNodeRelationsCacheKey(req *network.GetNodeRelationsRequest, relT
 		direction = "in"
 	} // else if !outgoing && !incoming? -> DAL 应该处理，这里当作 "any"
 
-	// 格式: prefix:nodeID:direction:typesHash:limit:offset
-	return fmt.Sprintf("%s%s:%s:%s:%d:%d",
-		getNodeRelationsCachePrefix, req.NodeID, direction, typesHash, limit, offset)
+	// 自定义排序追加排序键的哈希，默认排序时键与之前一致
+	sortPart := sortKeyPart(sortKeys)
+
+	// 游标模式下用游标哈希代替 offset
+	if req.IsSetCursor() && *req.Cursor != "" {
+		return fmt.Sprintf("%s%s:%s:%s:%d:%s%s",
+			getNodeRelationsCachePrefix, req.NodeID, direction, typesHash, limit, cursorKeyPart(*req.Cursor), sortPart)
+	}
+
+	// 格式: prefix:nodeID:direction:typesHash:limit:offset[:s<sort_hash>]
+	return fmt.Sprintf("%s%s:%s:%s:%d:%d%s",
+		getNodeRelationsCachePrefix, req.NodeID, direction, typesHash, limit, offset, sortPart)
 }
@@ -12,9 +12,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/google/uuid"
-	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
-	"github.com/redis/go-redis/v9" // Or your chosen Redis client library
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -27,12 +24,10 @@ import (
 // Define a specific error for relation not found in tests
 var ErrRelationNotFound = errors.New("test: relation not found")
 
-// Global variables for relation testing setup
+// Global variables for relation testing setup, assigned by setupTestRepos in node_repo_test.go
 var (
 	relTestRelRepo       neo4jrepo.RelationRepository
-	relTestNodeRepo      neo4jrepo.NodeRepository // Needed for creating nodes
-	relTestDriver        neo4j.DriverWithContext
-	relTestCacheClient   *redis.Client
+	relTestNodeRepo      neo4jrepo.NodeRepository   // Needed for creating nodes
 	relTestRelByteCache  cache.RelationAndByteCache // Specific cache type for relations
 	relTestNodeByteCache cache.NodeAndByteCache     // Needed for Node Repo
 )
@@ -42,172 +37,32 @@ func nodeTypePtrRelTest(t network.NodeType) *network.NodeType {
 	return &t
 }
 
-// clearRelationTestData cleans Neo4j and Redis for relation tests
+// clearRelationTestData resets the store and cache for relation tests
 func clearRelationTestData(ctx context.Context) {
-	// Clear Neo4j (same as node tests)
-	if relTestDriver == nil {
-		fmt.Println("Warning: relTestDriver not initialized in clearRelationTestData")
-		return
-	}
-	session := relTestDriver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
-	_, _ = session.Run(ctx, "MATCH ()-[r]->() DELETE r", nil)
-	_, _ = session.Run(ctx, "MATCH (n) DELETE n", nil)
-	session.Close(ctx)
-
-	// --- Use the same Redis clearing logic as node_repo_test --- VVV
-	if relTestCacheClient == nil {
-		fmt.Println("Warning: relTestCacheClient not initialized, cannot clear Redis cache.")
-		return
-	}
-	// Use the EXPORTED constants from neo4jrepo package and the correct prefix
-	basePrefix := "testprefix:" // Assuming relation tests use the same prefix as node tests
-	prefixesToClear := []string{
-		basePrefix + neo4jrepo.NodeCachePrefix + "*",          // Node details
-		basePrefix + neo4jrepo.RelationCachePrefix + "*",      // Relation details
-		basePrefix + neo4jrepo.SearchNodesCachePrefix + "*",   // Search results IDs (might be set by node repo)
-		basePrefix + neo4jrepo.GetNetworkCachePrefix + "*",    // Network graph IDs (might be set by node repo)
-		basePrefix + neo4jrepo.GetPathCachePrefix + "*",       // Path IDs (might be set by node repo)
-		basePrefix + neo4jrepo.NodeRelationsCachePrefix + "*", // NodeRelations IDs (set by relation repo)
-		// Add specific prefixes for relation repo if any others exist
-	}
-
-	for _, prefix := range prefixesToClear {
-		keys, err := relTestCacheClient.Keys(ctx, prefix).Result()
-		if err == nil && len(keys) > 0 {
-			pipe := relTestCacheClient.Pipeline()
-			pipe.Del(ctx, keys...)
-			_, err := pipe.Exec(ctx)
-			if err != nil && !errors.Is(err, redis.Nil) { // Ignore nil error if keys expired between KEYS and DEL
-				fmt.Printf("Warning: Failed to clear Redis keys with prefix %s: %v\n", prefix, err)
-			}
-		} else if err != nil && !errors.Is(err, redis.Nil) {
-			fmt.Printf("Warning: Failed to get Redis keys with prefix %s: %v\n", prefix, err)
-		}
-	}
-	// --- End Redis clearing logic ---
-
-	/* // Original FlushDB logic commented out
-	err := relTestCacheClient.FlushDB(ctx).Err()
-	if err != nil {
-		fmt.Printf("Warning: Failed to flush Redis DB 1: %v\n", err)
-	}
-	*/
+	clearTestData(ctx)
 }
 
-// --- Helper Functions (Copied/Adapted from node_repo_test.go) ---
+// --- Helper Functions (shared with node_repo_test.go) ---
 
-// Helper function to create a node directly in DB
+// Helper function to create a node directly in the store
 func createNodeDirectlyRelTest(ctx context.Context, node *network.Node) error {
-	session := relTestDriver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
-	defer session.Close(ctx)
-
-	properties := map[string]any{
-		"name":       node.Name,
-		"created_at": time.Now().UTC(),
-		"updated_at": time.Now().UTC(),
-	}
-	if node.ID == "" {
-		node.ID = uuid.NewString() // Generate ID if not provided
-	}
-	properties["id"] = node.ID
-
-	if node.Avatar != nil {
-		properties["avatar"] = *node.Avatar
-	}
-	if node.Profession != nil {
-		properties["profession"] = *node.Profession
-	}
-	if node.Properties != nil {
-		for k, v := range node.Properties {
-			if _, exists := properties[k]; !exists {
-				properties[k] = v
-			}
-		}
-	}
-
-	cypher := fmt.Sprintf("MERGE (n:%s {id: $props.id}) SET n += $props RETURN n", node.Type.String())
-	_, err := session.Run(ctx, cypher, map[string]any{"props": properties})
-	if err != nil {
-		return fmt.Errorf("failed to create node directly: %w", err)
-	}
-	return nil
+	return createNodeDirectly(ctx, node)
 }
 
-// Helper function to create a relation directly in DB
+// Helper function to create a relation directly in the store
 func createRelationDirectlyRelTest(ctx context.Context, sourceID, targetID string, rel *network.Relation) error {
-	session := relTestDriver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
-	defer session.Close(ctx)
-
-	properties := map[string]any{
-		"created_at": time.Now().UTC(),
-		"updated_at": time.Now().UTC(),
-	}
-	if rel.ID == "" {
-		rel.ID = uuid.NewString() // Generate ID if not provided
-	}
-	properties["id"] = rel.ID
-
-	if rel.Label != nil {
-		properties["label"] = *rel.Label
-	}
-	if rel.Properties != nil {
-		for k, v := range rel.Properties {
-			if _, exists := properties[k]; !exists {
-				properties[k] = v
-			}
-		}
-	}
-
-	cypher := fmt.Sprintf(`
-		MATCH (a {id: $sourceId}), (b {id: $targetId})
-		MERGE (a)-[r:%s {id: $props.id}]->(b)
-		SET r += $props
-		RETURN r
-	`, rel.Type.String())
-
-	_, err := session.Run(ctx, cypher, map[string]any{
-		"sourceId": sourceID,
-		"targetId": targetID,
-		"props":    properties,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to create relation directly (%s)-[%s {%s}]->(%s): %w", sourceID, rel.Type.String(), rel.ID, targetID, err)
-	}
-	return nil
+	return createRelationDirectly(ctx, sourceID, targetID, rel)
 }
 
-// Helper function to get a relation directly from DB
+// Helper function to get a relation's properties directly from the store
 func getRelationDirectlyRelTest(ctx context.Context, id string) (map[string]interface{}, error) {
-	session := relTestDriver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
-	defer session.Close(ctx)
-
-	result, err := session.Run(ctx, "MATCH ()-[r {id: $id}]->() RETURN r", map[string]any{"id": id})
+	dbRel, _, _, _, err := testStore.GetRelationByID(ctx, id)
 	if err != nil {
-		return nil, fmt.Errorf("direct DB query for relation failed: %w", err)
-	}
-	record, err := result.Single(ctx)
-	if err != nil {
-		// Check if it's a Neo4j "no records" error, signifying not found
-		if neo4j.IsNeo4jError(err) && strings.Contains(err.Error(), "Result contains no more records") {
+		if errors.Is(err, neo4jdal.ErrNotFound) {
 			return nil, fmt.Errorf("relation %s not found in DB: %w", id, ErrRelationNotFound) // Use test-specific error
 		}
-		return nil, fmt.Errorf("failed to get single record for relation from DB: %w", err)
-	}
-
-	relValue, ok := record.Get("r")
-	if !ok {
-		return nil, fmt.Errorf("relation 'r' not found in DB record")
+		return nil, fmt.Errorf("direct store lookup for relation failed: %w", err)
 	}
-	dbRel, ok := relValue.(neo4j.Relationship)
-	if !ok {
-		// Try dbtype.Relationship as returned by some queries
-		dbRelType, okType := relValue.(neo4j.Relationship) // Adjusted to neo4j.Relationship
-		if !okType {
-			return nil, fmt.Errorf("unexpected relation type in DB result: %T", relValue)
-		}
-		dbRel = dbRelType // Assign if it's the correct type
-	}
-
 	return dbRel.Props, nil
 }
 
@@ -647,7 +502,7 @@ func TestGetNodeRelations_Integration(t *testing.T) {
 		assert.ErrorIs(t, err, cache.ErrNotFound, "Cache should be empty before first GetNodeRelations")
 
 		// Execute GetNodeRelations
-		relations, total, err := relTestRelRepo.GetNodeRelations(ctx, req)
+		relations, total, _, err := relTestRelRepo.GetNodeRelations(ctx, req)
 		require.NoError(t, err, "GetNodeRelations failed for center node")
 
 		// Verify results (2 outgoing + 3 incoming = 5 total)
@@ -693,7 +548,7 @@ func TestGetNodeRelations_Integration(t *testing.T) {
 
 		// Execute search again
 		t.Log("Expecting GetNodeRelations cache hit...")
-		relations, total, err := relTestRelRepo.GetNodeRelations(ctx, req)
+		relations, total, _, err := relTestRelRepo.GetNodeRelations(ctx, req)
 		require.NoError(t, err, "GetNodeRelations (cache hit) failed")
 		assert.EqualValues(t, 5, total, "Expected 5 total relations (cache hit)")
 		require.Len(t, relations, 5, "Expected 5 relations in results (cache hit)")
@@ -720,7 +575,7 @@ func TestGetNodeRelations_Integration(t *testing.T) {
 		}
 		cacheKey := generateGetNodeRelationsCacheKeyForTest(req)
 
-		relations, total, err := relTestRelRepo.GetNodeRelations(ctx, req)
+		relations, total, _, err := relTestRelRepo.GetNodeRelations(ctx, req)
 		require.NoError(t, err)
 		assert.EqualValues(t, 2, total, "Expected 2 total outgoing relations")
 		require.Len(t, relations, 2, "Expected 2 relations in results")
@@ -754,7 +609,7 @@ func TestGetNodeRelations_Integration(t *testing.T) {
 		}
 		cacheKey := generateGetNodeRelationsCacheKeyForTest(req)
 
-		relations, total, err := relTestRelRepo.GetNodeRelations(ctx, req)
+		relations, total, _, err := relTestRelRepo.GetNodeRelations(ctx, req)
 		require.NoError(t, err)
 		// Expected: relIn1 (FRIEND), relIn3 (COLLEAGUE) = 2
 		assert.EqualValues(t, 2, total, "Expected 2 total incoming FRIEND/COLLEAGUE relations")
@@ -788,7 +643,7 @@ func TestGetNodeRelations_Integration(t *testing.T) {
 		}
 		cacheKey := generateGetNodeRelationsCacheKeyForTest(req)
 
-		relations, total, err := relTestRelRepo.GetNodeRelations(ctx, req)
+		relations, total, _, err := relTestRelRepo.GetNodeRelations(ctx, req)
 		require.NoError(t, err)
 		assert.EqualValues(t, 5, total, "Expected 5 total relations (pagination)")
 		require.Len(t, relations, 2, "Expected 2 relations on page 2 (limit 2, offset 1)")
@@ -805,6 +660,62 @@ func TestGetNodeRelations_Integration(t *testing.T) {
 		// Cannot assert specific IDs in cache content either
 	})
 
+	// --- Test Case 5.1: Cursor pagination (ordered by relation id) ---
+	t.Run("Get All Cursor Pagination", func(t *testing.T) {
+		limit := int32(2)
+		var seen []string
+		var cursor *string
+		for page := 0; page < 3; page++ {
+			req := &network.GetNodeRelationsRequest{
+				NodeID: centerNode.ID,
+				Limit:  &limit,
+				Cursor: cursor,
+			}
+			relations, total, next, err := relTestRelRepo.GetNodeRelations(ctx, req)
+			require.NoError(t, err)
+			assert.EqualValues(t, 5, total, "Total should not be affected by cursor")
+			for _, rel := range relations {
+				seen = append(seen, rel.ID)
+			}
+			if page < 2 {
+				require.Len(t, relations, 2)
+				require.NotEmpty(t, next, "Expected next cursor on full page %d", page)
+			} else {
+				require.Len(t, relations, 1)
+				assert.Empty(t, next, "Expected no next cursor on the last page")
+			}
+			cursor = &next
+		}
+		assert.Equal(t, []string{"gnr-rel-in1", "gnr-rel-in2", "gnr-rel-in3", "gnr-rel-out1", "gnr-rel-out2"}, seen)
+
+		badCursor := "%%%"
+		_, _, _, err := relTestRelRepo.GetNodeRelations(ctx, &network.GetNodeRelationsRequest{NodeID: centerNode.ID, Cursor: &badCursor})
+		assert.ErrorIs(t, err, neo4jrepo.ErrInvalidCursor)
+	})
+
+	// --- Test Case 5.2: Custom sort with positional cursor ---
+	t.Run("Get All Sorted Pagination", func(t *testing.T) {
+		limit := int32(2)
+		desc := network.SortOrder_DESC
+		sortByID := []*network.SortField{{Field: "id", Order: &desc}}
+		var seen []string
+		var cursor *string
+		for page := 0; page < 3; page++ {
+			req := &network.GetNodeRelationsRequest{NodeID: centerNode.ID, Limit: &limit, Cursor: cursor, Sort: sortByID}
+			relations, total, next, err := relTestRelRepo.GetNodeRelations(ctx, req)
+			require.NoError(t, err)
+			assert.EqualValues(t, 5, total)
+			for _, rel := range relations {
+				seen = append(seen, rel.ID)
+			}
+			cursor = &next
+		}
+		assert.Equal(t, []string{"gnr-rel-out2", "gnr-rel-out1", "gnr-rel-in3", "gnr-rel-in2", "gnr-rel-in1"}, seen)
+
+		_, _, _, err := relTestRelRepo.GetNodeRelations(ctx, &network.GetNodeRelationsRequest{NodeID: centerNode.ID, Sort: []*network.SortField{{Field: "name"}}})
+		assert.ErrorIs(t, err, neo4jdal.ErrInvalidSort)
+	})
+
 	// --- Test Case 6: No relations found (unrelated node) ---
 	t.Run("Get Relations No Results", func(t *testing.T) {
 		req := &network.GetNodeRelationsRequest{
@@ -812,7 +723,7 @@ func TestGetNodeRelations_Integration(t *testing.T) {
 		}
 		cacheKey := generateGetNodeRelationsCacheKeyForTest(req)
 
-		relations, total, err := relTestRelRepo.GetNodeRelations(ctx, req)
+		relations, total, _, err := relTestRelRepo.GetNodeRelations(ctx, req)
 		require.NoError(t, err)
 		assert.EqualValues(t, 0, total, "Expected 0 total relations")
 		assert.Len(t, relations, 0, "Expected 0 relations in results")
@@ -826,7 +737,7 @@ func TestGetNodeRelations_Integration(t *testing.T) {
 		assert.Equal(t, []byte(getNodeRelationsEmptyPlaceholder), cachedData, "Cache should contain empty placeholder")
 
 		// Search again (Cache Hit for empty)
-		relationsHit, totalHit, errHit := relTestRelRepo.GetNodeRelations(ctx, req)
+		relationsHit, totalHit, _, errHit := relTestRelRepo.GetNodeRelations(ctx, req)
 		require.NoError(t, errHit)
 		assert.EqualValues(t, 0, totalHit, "Expected 0 total results (empty cache hit)")
 		assert.Len(t, relationsHit, 0, "Expected 0 relations in results (empty cache hit)")
@@ -836,7 +747,7 @@ func TestGetNodeRelations_Integration(t *testing.T) {
 	t.Run("Get Relations Cache Hit With Deleted Relation", func(t *testing.T) {
 		// 7.1. Cache the full list first (using a request that includes relOut2)
 		reqAll := &network.GetNodeRelationsRequest{NodeID: centerNode.ID}
-		_, _, err := relTestRelRepo.GetNodeRelations(ctx, reqAll) // Populate cache
+		_, _, _, err := relTestRelRepo.GetNodeRelations(ctx, reqAll) // Populate cache
 		require.NoError(t, err)
 		time.Sleep(50 * time.Millisecond) // Ensure cache write
 
@@ -849,7 +760,7 @@ func TestGetNodeRelations_Integration(t *testing.T) {
 
 		// 7.3. Call GetNodeRelations again (should hit the list cache)
 		t.Log("Expecting GetNodeRelations cache hit, but one relation is deleted...")
-		relations, total, err := relTestRelRepo.GetNodeRelations(ctx, reqAll)
+		relations, total, _, err := relTestRelRepo.GetNodeRelations(ctx, reqAll)
 		require.NoError(t, err, "GetNodeRelations (cache hit with deleted item) failed")
 
 		// 7.4. Verify results: Total is from cache (5), but list excludes the deleted one (4)
@@ -0,0 +1,81 @@
+package neo4jrepo
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+
+	"labelwall/biz/dal/neo4jdal"
+	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/typeregistry"
+)
+
+// 可直接排序的核心属性 (avatar 等不适合排序的核心属性除外)
+var (
+	nodeSortCoreFields     = []string{"id", "name", "profession", "created_at", "updated_at"}
+	relationSortCoreFields = []string{"id", "label", "created_at", "updated_at"}
+)
+
+// nodeSortKeys 校验搜索节点的排序键。可排序的属性为核心属性和类型属性约束中声明的属性：
+// 指定了节点类型时使用该类型的约束，否则合并所有节点类型的约束。
+func nodeSortKeys(fields []*network.SortField, nodeType *network.NodeType) ([]neo4jdal.SortKey, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return neo4jdal.SortKeys(fields, sortableFields(nodeSortCoreFields, nodeTypeSchemas(nodeType)))
+}
+
+// nodeTypeSchemas 返回节点类型的属性约束，nodeType 为 nil 时返回所有节点类型的约束
+func nodeTypeSchemas(nodeType *network.NodeType) [][]typeregistry.PropertySchema {
+	registry := typeregistry.Default()
+	if nodeType != nil {
+		return [][]typeregistry.PropertySchema{registry.NodePropertySchema(*nodeType)}
+	}
+	var schemas [][]typeregistry.PropertySchema
+	for _, def := range registry.NodeTypes() {
+		schemas = append(schemas, def.Properties)
+	}
+	return schemas
+}
+
+// relationSortKeys 校验节点关系的排序键。可排序的属性为核心属性和关系类型属性约束中声明的属性：
+// 指定了关系类型时使用这些类型的约束，否则合并所有关系类型的约束。
+func relationSortKeys(fields []*network.SortField, types []network.RelationType) ([]neo4jdal.SortKey, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	registry := typeregistry.Default()
+	var schemas [][]typeregistry.PropertySchema
+	if len(types) > 0 {
+		for _, t := range types {
+			schemas = append(schemas, registry.RelationPropertySchema(t))
+		}
+	} else {
+		for _, def := range registry.RelationTypes() {
+			schemas = append(schemas, def.Properties)
+		}
+	}
+	return neo4jdal.SortKeys(fields, sortableFields(relationSortCoreFields, schemas))
+}
+
+// sortableFields 返回判断字段是否可排序的函数
+func sortableFields(core []string, schemas [][]typeregistry.PropertySchema) func(string) bool {
+	allowed := make(map[string]bool)
+	for _, f := range core {
+		allowed[f] = true
+	}
+	for _, schema := range schemas {
+		for _, p := range schema {
+			allowed[p.Key] = true
+		}
+	}
+	return func(field string) bool { return allowed[field] }
+}
+
+// sortKeyPart 生成缓存键中的排序部分，未设置排序时返回空字符串 (缓存键与之前一致)
+func sortKeyPart(keys []neo4jdal.SortKey) string {
+	if len(keys) == 0 {
+		return ""
+	}
+	sum := sha1.Sum([]byte(neo4jdal.SortSpec(keys)))
+	return ":s" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,45 @@
+package neo4jrepo
+
+import (
+	"sort"
+	"strings"
+
+	"labelwall/biz/dal/neo4jdal"
+	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/typeregistry"
+)
+
+// requestTraversal 将请求中的 direction 和按关系类型覆盖的方向转换为 DAL 的遍历方向
+func requestTraversal(direction *network.Direction, overrides []*network.RelationDirection) neo4jdal.Traversal {
+	t := neo4jdal.Traversal{Direction: network.Direction_BOTH}
+	if direction != nil {
+		t.Direction = *direction
+	}
+	for _, o := range overrides {
+		if o == nil {
+			continue
+		}
+		if t.TypeDirections == nil {
+			t.TypeDirections = make(map[string]network.Direction, len(overrides))
+		}
+		t.TypeDirections[typeregistry.Default().RelationTypeName(o.Type)] = o.Direction
+	}
+	return t
+}
+
+// traversalKeyPart 生成缓存键中的方向部分，格式为 ":d<方向>[,<关系类型>=<方向>...]"；
+// 不限制方向时返回空字符串 (缓存键与之前一致)。与默认方向相同的覆盖不影响结果，不计入键。
+func traversalKeyPart(t neo4jdal.Traversal) string {
+	base := t.DirectionOf("")
+	var overrides []string
+	for typ, d := range t.TypeDirections {
+		if d != 0 && d != base {
+			overrides = append(overrides, typ+"="+d.String())
+		}
+	}
+	if base == network.Direction_BOTH && len(overrides) == 0 {
+		return ""
+	}
+	sort.Strings(overrides)
+	return ":d" + strings.Join(append([]string{base.String()}, overrides...), ",")
+}
@@ -0,0 +1,195 @@
+package neo4jrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"labelwall/biz/dal/neo4jdal"
+	"labelwall/biz/dal/storage"
+	"labelwall/pkg/typeregistry"
+)
+
+var (
+	// ErrTypeExists 表示同名的类型已经注册
+	ErrTypeExists = errors.New("repo: type already exists")
+	// ErrTypeNotFound 表示要更新的类型未注册
+	ErrTypeNotFound = errors.New("repo: type not found")
+	// ErrInvalidTypeDef 表示类型定义无效 (名称不合法、组合中引用了未注册的节点类型、属性约束无效等)
+	ErrInvalidTypeDef = errors.New("repo: invalid type definition")
+)
+
+// typeRepo 实现了 TypeRepository 接口
+type typeRepo struct {
+	store    storage.TypeStore
+	registry *typeregistry.Registry
+	logger   *zap.Logger
+	mu       sync.Mutex // 串行化本实例内的类型变更; 跨实例的编码分配由存储原子地完成
+}
+
+// NewTypeRepository 创建一个新的 TypeRepository 实例
+func NewTypeRepository(store storage.TypeStore, registry *typeregistry.Registry, logger *zap.Logger) TypeRepository {
+	return &typeRepo{
+		store:    store,
+		registry: registry,
+		logger:   logger,
+	}
+}
+
+func (r *typeRepo) LoadTypes(ctx context.Context) error {
+	nodeDefs, relDefs, err := r.store.ListTypeDefs(ctx)
+	if err != nil {
+		return fmt.Errorf("repo: 读取类型定义失败: %w", err)
+	}
+	if err := r.registry.Load(nodeDefs, relDefs); err != nil {
+		return fmt.Errorf("repo: 加载类型定义到注册表失败: %w", err)
+	}
+	r.logger.Debug("Repo: 类型定义已加载", zap.Int("nodeTypes", len(nodeDefs)), zap.Int("relationTypes", len(relDefs)))
+	return nil
+}
+
+func (r *typeRepo) ListTypes(ctx context.Context) ([]typeregistry.NodeTypeDef, []typeregistry.RelationTypeDef, error) {
+	if err := r.LoadTypes(ctx); err != nil {
+		return nil, nil, err
+	}
+	return r.registry.NodeTypes(), r.registry.RelationTypes(), nil
+}
+
+func (r *typeRepo) CreateNodeType(ctx context.Context, name string, properties []typeregistry.PropertySchema) (typeregistry.NodeTypeDef, error) {
+	if err := typeregistry.ValidateName(name); err != nil {
+		return typeregistry.NodeTypeDef{}, fmt.Errorf("%w: %v", ErrInvalidTypeDef, err)
+	}
+	if err := typeregistry.ValidateSchema(properties); err != nil {
+		return typeregistry.NodeTypeDef{}, fmt.Errorf("%w: %v", ErrInvalidTypeDef, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// 1. 先同步其他实例新增的类型，再检查重名
+	if err := r.LoadTypes(ctx); err != nil {
+		return typeregistry.NodeTypeDef{}, err
+	}
+	if _, exists := r.registry.NodeTypeByName(name); exists {
+		return typeregistry.NodeTypeDef{}, fmt.Errorf("%w: 节点类型 %s", ErrTypeExists, name)
+	}
+
+	// 2. 持久化 (同时创建新标签的约束和索引)，编码由存储在同一事务中分配，
+	// 本地的下一个编码只作为下限。其他实例抢先创建同名类型时返回 ErrTypeExists
+	def, err := r.store.CreateNodeTypeDef(ctx, typeregistry.NodeTypeDef{Name: name, Code: r.registry.NextNodeTypeCode(), Properties: properties})
+	if errors.Is(err, neo4jdal.ErrTypeDefExists) {
+		return typeregistry.NodeTypeDef{}, fmt.Errorf("%w: 节点类型 %s", ErrTypeExists, name)
+	}
+	if err != nil {
+		return typeregistry.NodeTypeDef{}, fmt.Errorf("repo: 保存节点类型定义失败: %w", err)
+	}
+	if err := r.registry.PutNodeType(def); err != nil {
+		return typeregistry.NodeTypeDef{}, fmt.Errorf("repo: 注册节点类型失败: %w", err)
+	}
+	r.logger.Info("Repo: 节点类型已创建", zap.String("name", def.Name), zap.Int64("code", int64(def.Code)))
+	return def, nil
+}
+
+func (r *typeRepo) UpdateNodeType(ctx context.Context, name string, properties []typeregistry.PropertySchema) (typeregistry.NodeTypeDef, error) {
+	if err := typeregistry.ValidateSchema(properties); err != nil {
+		return typeregistry.NodeTypeDef{}, fmt.Errorf("%w: %v", ErrInvalidTypeDef, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.LoadTypes(ctx); err != nil {
+		return typeregistry.NodeTypeDef{}, err
+	}
+	code, exists := r.registry.NodeTypeByName(name)
+	if !exists {
+		return typeregistry.NodeTypeDef{}, fmt.Errorf("%w: 节点类型 %s", ErrTypeNotFound, name)
+	}
+	def := typeregistry.NodeTypeDef{Name: name, Code: code, Properties: properties}
+	for _, d := range r.registry.NodeTypes() {
+		if d.Name == name {
+			def.Builtin = d.Builtin
+		}
+	}
+
+	if err := r.store.SaveNodeTypeDef(ctx, def); err != nil {
+		return typeregistry.NodeTypeDef{}, fmt.Errorf("repo: 保存节点类型定义失败: %w", err)
+	}
+	if err := r.registry.PutNodeType(def); err != nil {
+		return typeregistry.NodeTypeDef{}, fmt.Errorf("repo: 注册节点类型失败: %w", err)
+	}
+	r.logger.Info("Repo: 节点类型已更新", zap.String("name", def.Name), zap.Int("properties", len(def.Properties)))
+	return def, nil
+}
+
+func (r *typeRepo) SaveRelationType(ctx context.Context, name string, allowedPairs []typeregistry.TypePair, properties []typeregistry.PropertySchema) (typeregistry.RelationTypeDef, error) {
+	if err := typeregistry.ValidateName(name); err != nil {
+		return typeregistry.RelationTypeDef{}, fmt.Errorf("%w: %v", ErrInvalidTypeDef, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	def, err := r.saveRelationType(ctx, name, allowedPairs, properties)
+	if errors.Is(err, neo4jdal.ErrTypeDefExists) {
+		// 其他实例在同步之后创建了同名类型: 重新同步后按更新处理
+		def, err = r.saveRelationType(ctx, name, allowedPairs, properties)
+	}
+	if err != nil {
+		return typeregistry.RelationTypeDef{}, err
+	}
+	r.logger.Info("Repo: 关系类型已保存", zap.String("name", def.Name), zap.Int64("code", int64(def.Code)), zap.Int("allowedPairs", len(def.AllowedPairs)))
+	return def, nil
+}
+
+// saveRelationType 同步注册表后更新已有的关系类型或创建新类型 (调用方持有 mu)。
+// 创建时同名定义已被其他实例写入则返回 neo4jdal.ErrTypeDefExists
+func (r *typeRepo) saveRelationType(ctx context.Context, name string, allowedPairs []typeregistry.TypePair, properties []typeregistry.PropertySchema) (typeregistry.RelationTypeDef, error) {
+	// 1. 同步后沿用已有编码，新类型以本地的下一个编码作为下限由存储分配
+	if err := r.LoadTypes(ctx); err != nil {
+		return typeregistry.RelationTypeDef{}, err
+	}
+	code, exists := r.registry.RelationTypeByName(name)
+	if !exists {
+		code = r.registry.NextRelationTypeCode()
+	}
+	def := typeregistry.RelationTypeDef{Name: name, Code: code, AllowedPairs: allowedPairs, Properties: properties}
+
+	// 2. 在副本上校验 (组合中的节点类型必须已注册、属性约束有效)，避免写入无效定义
+	candidate := typeregistry.New()
+	if err := candidate.Load(r.registry.NodeTypes(), nil); err != nil {
+		return typeregistry.RelationTypeDef{}, fmt.Errorf("repo: 复制注册表失败: %w", err)
+	}
+	if err := candidate.PutRelationType(def); err != nil {
+		return typeregistry.RelationTypeDef{}, fmt.Errorf("%w: %v", ErrInvalidTypeDef, err)
+	}
+
+	for _, d := range candidate.RelationTypes() {
+		if d.Name == name {
+			def.Builtin = d.Builtin
+		}
+	}
+
+	// 3. 持久化后更新本实例的注册表
+	if exists {
+		if err := r.store.SaveRelationTypeDef(ctx, def); err != nil {
+			return typeregistry.RelationTypeDef{}, fmt.Errorf("repo: 保存关系类型定义失败: %w", err)
+		}
+	} else {
+		created, err := r.store.CreateRelationTypeDef(ctx, def)
+		if errors.Is(err, neo4jdal.ErrTypeDefExists) {
+			return typeregistry.RelationTypeDef{}, err
+		}
+		if err != nil {
+			return typeregistry.RelationTypeDef{}, fmt.Errorf("repo: 保存关系类型定义失败: %w", err)
+		}
+		def = created
+	}
+	if err := r.registry.PutRelationType(def); err != nil {
+		return typeregistry.RelationTypeDef{}, fmt.Errorf("repo: 注册关系类型失败: %w", err)
+	}
+	return def, nil
+}
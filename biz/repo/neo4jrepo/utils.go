@@ -1,12 +1,18 @@
 package neo4jrepo
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/cache"
+	"labelwall/pkg/propvalue"
+	"labelwall/pkg/typeregistry"
 	"strings"
+	"time"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+	"go.uber.org/zap"
 )
 
 // Define repository-level error for path not found HERE
@@ -44,23 +50,14 @@ func isNotFoundError(err error) bool {
 	return false
 }
 
-// labelToNodeType 从 Neo4j 标签列表推断 Thrift NodeType
+// labelToNodeType 从 Neo4j 标签列表推断 NodeType (通过类型注册表，包括运行时新增的类型)
 func labelToNodeType(labels []string) (network.NodeType, bool) {
-	for _, label := range labels {
-		// 使用 Thrift 生成的 FromString 函数进行查找
-		if nodeType, err := network.NodeTypeFromString(label); err == nil {
-			return nodeType, true
-		}
-	}
-	return network.NodeType(0), false // 未找到匹配的标签
+	return typeregistry.Default().NodeTypeByLabels(labels)
 }
 
-// stringToRelationType 从 Neo4j 关系类型字符串推断 Thrift RelationType
+// stringToRelationType 从 Neo4j 关系类型字符串推断 RelationType (通过类型注册表)
 func stringToRelationType(relTypeStr string) (network.RelationType, bool) {
-	if relType, err := network.RelationTypeFromString(relTypeStr); err == nil {
-		return relType, true
-	}
-	return network.RelationType(0), false
+	return typeregistry.Default().RelationTypeByName(relTypeStr)
 }
 
 // mapDbNodeToThriftNode 将 Neo4j 节点对象转换为 Thrift Node 对象
@@ -72,22 +69,12 @@ func mapDbNodeToThriftNode(dbNode dbtype.Node, nodeType network.NodeType) *netwo
 		Name:       getStringProp(props, "name", ""),
 		Avatar:     getOptionalStringProp(props, "avatar"),
 		Profession: getOptionalStringProp(props, "profession"),
-		Properties: make(map[string]string),
 	}
 
 	coreProps := map[string]struct{}{ // 核心和通用字段
 		"id": {}, "name": {}, "avatar": {}, "profession": {}, "created_at": {}, "updated_at": {},
 	}
-	for key, val := range props {
-		if _, isCore := coreProps[key]; !isCore {
-			if strVal, ok := val.(string); ok {
-				node.Properties[key] = strVal
-			}
-		}
-	}
-	if len(node.Properties) == 0 {
-		node.Properties = nil
-	}
+	node.Properties, node.TypedProperties = mapCustomProps(props, coreProps)
 
 	return node
 }
@@ -105,36 +92,51 @@ func mapDbRelationshipToThriftRelation(dbRel any, relType network.RelationType,
 	}
 
 	relation := &network.Relation{
-		Type:       relType,
-		Source:     sourceID,
-		Target:     targetID,
-		ID:         getStringProp(props, "id", ""),
-		Label:      getOptionalStringProp(props, "label"),
-		Properties: make(map[string]string),
+		Type:   relType,
+		Source: sourceID,
+		Target: targetID,
+		ID:     getStringProp(props, "id", ""),
+		Label:  getOptionalStringProp(props, "label"),
 	}
 
 	coreProps := map[string]struct{}{ // 核心和通用字段
 		"id": {}, "label": {}, "created_at": {}, "updated_at": {},
 	}
+	relation.Properties, relation.TypedProperties = mapCustomProps(props, coreProps)
+
+	return relation
+}
+
+// mapCustomProps 将非核心属性同时转换为字符串形式 (兼容旧客户端) 和带类型的形式，没有自定义属性时均返回 nil
+func mapCustomProps(props map[string]any, coreProps map[string]struct{}) (map[string]string, map[string]*network.PropertyValue) {
+	strProps := make(map[string]string)
+	typedProps := make(map[string]*network.PropertyValue)
 	for key, val := range props {
-		if _, isCore := coreProps[key]; !isCore {
-			if strVal, ok := val.(string); ok {
-				relation.Properties[key] = strVal
-			}
+		if _, isCore := coreProps[key]; isCore {
+			continue
+		}
+		if strVal, ok := propvalue.Format(val); ok {
+			strProps[key] = strVal
+		}
+		if typedVal, ok := propvalue.FromDB(val); ok {
+			typedProps[key] = typedVal
 		}
 	}
-	if len(relation.Properties) == 0 {
-		relation.Properties = nil
+	if len(strProps) == 0 {
+		strProps = nil
 	}
-
-	return relation
+	if len(typedProps) == 0 {
+		typedProps = nil
+	}
+	return strProps, typedProps
 }
 
+// getStringProp 读取属性的字符串形式，非字符串的值 (数字、布尔、日期、列表) 按 propvalue.Format 格式化
 func getStringProp(props map[string]any, key string, defaultValue string) string {
 	if props == nil {
 		return defaultValue
 	}
-	if val, ok := props[key].(string); ok {
+	if val, ok := propvalue.Format(props[key]); ok {
 		return val
 	}
 	return defaultValue
@@ -151,3 +153,15 @@ func getOptionalStringProp(props map[string]any, key string) *string {
 	}
 	return nil
 }
+
+// indexDerivedKey 在缓存支持反向索引时登记派生缓存键引用的节点，
+// 节点或关系变更事件到达时据此删除该键 (见 internal/invalidation)。
+func indexDerivedKey(ctx context.Context, c any, logger *zap.Logger, key string, nodeIDs []string, ttl time.Duration) {
+	index, ok := c.(cache.NodeKeyIndex)
+	if !ok || len(nodeIDs) == 0 {
+		return
+	}
+	if err := index.IndexKey(ctx, key, nodeIDs, ttl); err != nil {
+		logger.Warn("Repo: 登记派生缓存键索引失败", zap.String("cacheKey", key), zap.Error(err))
+	}
+}
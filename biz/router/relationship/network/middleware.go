@@ -16,10 +16,12 @@
 // - _nodesMw():      /api/v1/nodes 端点组中间件
 // - _createnodeMw(): POST /api/v1/nodes 创建节点
 // - _searchnodesMw(): GET /api/v1/nodes/search 搜索节点
+// - _fulltextsearchnodesMw(): GET /api/v1/nodes/fulltext 全文搜索节点
 // - _getnodeMw():    GET /api/v1/nodes/:id 获取单个节点
 // - _updatenodeMw(): PUT /api/v1/nodes/:id 更新节点
 // - _deletenodeMw(): DELETE /api/v1/nodes/:id 删除节点
 // - _getnoderelationsMw(): GET /api/v1/nodes/:node_id/relations 获取节点关系
+// - _getrecommendationsMw(): GET /api/v1/nodes/:node_id/recommendations 可能认识的人
 //
 // 关系相关路由中间件:
 // - _relationsMw():     /api/v1/relations 端点组中间件
@@ -132,6 +134,11 @@ func _getnoderelationsMw() []app.HandlerFunc {
 	return nil
 }
 
+func _getrecommendationsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
 func _relationsMw() []app.HandlerFunc {
 	// your code...
 	return nil
@@ -166,3 +173,68 @@ func _searchnodesMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _fulltextsearchnodesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _batchcreatenodesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _batchcreaterelationsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _networkMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _exportnetworkMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _adminMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listtypesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _typesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createnodetypeMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _relations0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _saverelationtypeMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _nodes1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatenodetypeMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
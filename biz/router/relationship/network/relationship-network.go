@@ -21,19 +21,39 @@ func Register(r *server.Hertz) {
 		_api := root.Group("/api", _apiMw()...)
 		{
 			_v1 := _api.Group("/v1", _v1Mw()...)
+			{
+				_admin := _v1.Group("/admin", _adminMw()...)
+				_admin.GET("/types", append(_listtypesMw(), network.ListTypes)...)
+				_types := _admin.Group("/types", _typesMw()...)
+				_types.POST("/nodes", append(_createnodetypeMw(), network.CreateNodeType)...)
+				{
+					_nodes1 := _types.Group("/nodes", _nodes1Mw()...)
+					_nodes1.PUT("/:name", append(_updatenodetypeMw(), network.UpdateNodeType)...)
+				}
+				{
+					_relations0 := _types.Group("/relations", _relations0Mw()...)
+					_relations0.PUT("/:name", append(_saverelationtypeMw(), network.SaveRelationType)...)
+				}
+			}
 			_v1.GET("/network", append(_getnetworkMw(), network.GetNetwork)...)
+			_network := _v1.Group("/network", _networkMw()...)
+			_network.GET("/export", append(_exportnetworkMw(), network.ExportNetwork)...)
 			_v1.POST("/nodes", append(_createnodeMw(), network.CreateNode)...)
 			_nodes := _v1.Group("/nodes", _nodesMw()...)
+			_nodes.POST("/batch", append(_batchcreatenodesMw(), network.BatchCreateNodes)...)
 			_nodes.DELETE("/:id", append(_deletenodeMw(), network.DeleteNode)...)
+			_nodes.GET("/fulltext", append(_fulltextsearchnodesMw(), network.FulltextSearchNodes)...)
 			_nodes.GET("/:id", append(_getnodeMw(), network.GetNode)...)
 			_nodes.PUT("/:id", append(_updatenodeMw(), network.UpdateNode)...)
 			{
 				_node_id := _nodes.Group("/:node_id", _node_idMw()...)
+				_node_id.GET("/recommendations", append(_getrecommendationsMw(), network.GetRecommendations)...)
 				_node_id.GET("/relations", append(_getnoderelationsMw(), network.GetNodeRelations)...)
 			}
 			_v1.GET("/path", append(_getpathMw(), network.GetPath)...)
 			_v1.POST("/relations", append(_createrelationMw(), network.CreateRelation)...)
 			_relations := _v1.Group("/relations", _relationsMw()...)
+			_relations.POST("/batch", append(_batchcreaterelationsMw(), network.BatchCreateRelations)...)
 			_relations.DELETE("/:id", append(_deleterelationMw(), network.DeleteRelation)...)
 			_relations.GET("/:id", append(_getrelationMw(), network.GetRelation)...)
 			_relations.PUT("/:id", append(_updaterelationMw(), network.UpdateRelation)...)
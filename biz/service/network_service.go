@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors" // Import errors package
 	"fmt"
+	"slices"
 	"strings" // Import strings package
 
 	"go.uber.org/zap"
@@ -12,6 +13,10 @@ import (
 	network "labelwall/biz/model/relationship/network"
 	neo4jrepo "labelwall/biz/repo/neo4jrepo" // 导入数据访问层
 	"labelwall/pkg/cache"                    // Import for cache errors
+	"labelwall/pkg/fulltext"
+	"labelwall/pkg/graphexport"
+	"labelwall/pkg/propvalue"
+	"labelwall/pkg/typeregistry"
 )
 
 // Helper function to check for various "not found" errors
@@ -36,36 +41,51 @@ func isNotFoundError(err error) bool {
 	return false
 }
 
+// maxBatchCreateItems 单次批量创建请求允许的最大条目数 (节点与关系分别计算)
+const maxBatchCreateItems = 1000
+
 // NetworkService 定义了关系网络服务的业务逻辑接口
 // 这些方法对应 Thrift service 中的定义
 type NetworkService interface {
 	GetNetwork(ctx context.Context, req *network.GetNetworkRequest) (*network.GetNetworkResponse, error)
+	ExportNetwork(ctx context.Context, req *network.ExportNetworkRequest) (*network.ExportNetworkResponse, error)
 	GetPath(ctx context.Context, req *network.GetPathRequest) (*network.GetPathResponse, error)
 	SearchNodes(ctx context.Context, req *network.SearchNodesRequest) (*network.SearchNodesResponse, error)
+	FulltextSearchNodes(ctx context.Context, req *network.FulltextSearchNodesRequest) (*network.FulltextSearchNodesResponse, error)
 
 	CreateNode(ctx context.Context, req *network.CreateNodeRequest) (*network.CreateNodeResponse, error)
 	GetNode(ctx context.Context, req *network.GetNodeRequest) (*network.GetNodeResponse, error)
 	UpdateNode(ctx context.Context, req *network.UpdateNodeRequest) (*network.UpdateNodeResponse, error)
 	DeleteNode(ctx context.Context, req *network.DeleteNodeRequest) (*network.DeleteNodeResponse, error)
+	BatchCreateNodes(ctx context.Context, req *network.BatchCreateNodesRequest) (*network.BatchCreateNodesResponse, error)
 
 	CreateRelation(ctx context.Context, req *network.CreateRelationRequest) (*network.CreateRelationResponse, error)
 	GetRelation(ctx context.Context, req *network.GetRelationRequest) (*network.GetRelationResponse, error)
 	UpdateRelation(ctx context.Context, req *network.UpdateRelationRequest) (*network.UpdateRelationResponse, error)
 	DeleteRelation(ctx context.Context, req *network.DeleteRelationRequest) (*network.DeleteRelationResponse, error)
+	BatchCreateRelations(ctx context.Context, req *network.BatchCreateRelationsRequest) (*network.BatchCreateRelationsResponse, error)
 
 	GetNodeRelations(ctx context.Context, req *network.GetNodeRelationsRequest) (*network.GetNodeRelationsResponse, error)
+	GetRecommendations(ctx context.Context, req *network.GetRecommendationsRequest) (*network.GetRecommendationsResponse, error)
+
+	ListTypes(ctx context.Context, req *network.ListTypesRequest) (*network.ListTypesResponse, error)
+	CreateNodeType(ctx context.Context, req *network.CreateNodeTypeRequest) (*network.CreateNodeTypeResponse, error)
+	SaveRelationType(ctx context.Context, req *network.SaveRelationTypeRequest) (*network.SaveRelationTypeResponse, error)
+	UpdateNodeType(ctx context.Context, req *network.UpdateNodeTypeRequest) (*network.UpdateNodeTypeResponse, error)
 }
 
 type networkService struct {
 	nodeRepo     neo4jrepo.NodeRepository
 	relationRepo neo4jrepo.RelationRepository
+	typeRepo     neo4jrepo.TypeRepository
 	logger       *zap.Logger
 }
 
-func NewNetworkService(nodeRepo neo4jrepo.NodeRepository, relationRepo neo4jrepo.RelationRepository, logger *zap.Logger) NetworkService {
+func NewNetworkService(nodeRepo neo4jrepo.NodeRepository, relationRepo neo4jrepo.RelationRepository, typeRepo neo4jrepo.TypeRepository, logger *zap.Logger) NetworkService {
 	return &networkService{
 		nodeRepo:     nodeRepo,
 		relationRepo: relationRepo,
+		typeRepo:     typeRepo,
 		logger:       logger,
 	}
 }
@@ -76,6 +96,13 @@ func (s *networkService) CreateNode(ctx context.Context, req *network.CreateNode
 	if req.Name == "" {
 		return &network.CreateNodeResponse{Success: false, Message: "节点名称不能为空"}, nil
 	}
+	props, fieldErrs := mergeProperties(req.Properties, req.TypedProperties)
+	if len(fieldErrs) == 0 {
+		fieldErrs = typeregistry.ValidateProperties(typeregistry.Default().NodePropertySchema(req.Type), props, false)
+	}
+	if len(fieldErrs) > 0 {
+		return &network.CreateNodeResponse{Success: false, Message: fieldErrorsMessage("节点属性", fieldErrs), FieldErrors: toFieldErrors(fieldErrs)}, nil
+	}
 
 	// 2. 调用 repo 层创建节点
 	node, err := s.nodeRepo.CreateNode(ctx, req)
@@ -92,6 +119,42 @@ func (s *networkService) CreateNode(ctx context.Context, req *network.CreateNode
 	}, nil
 }
 
+// BatchCreateNodes 处理批量创建节点的业务逻辑
+// 存在未注册的类型时整个批次被拒绝；其他单项失败不会影响其他项，Success 仅表示批次已被处理；逐项结果见 NodeResults/RelationResults。
+func (s *networkService) BatchCreateNodes(ctx context.Context, req *network.BatchCreateNodesRequest) (*network.BatchCreateNodesResponse, error) {
+	// 1. 输入验证
+	if len(req.Nodes) == 0 {
+		return &network.BatchCreateNodesResponse{Success: false, Message: "节点列表不能为空"}, nil
+	}
+	if len(req.Nodes) > maxBatchCreateItems || len(req.Relations) > maxBatchCreateItems {
+		return &network.BatchCreateNodesResponse{Success: false, Message: fmt.Sprintf("单次批量创建最多 %d 个节点和 %d 条关系", maxBatchCreateItems, maxBatchCreateItems)}, nil
+	}
+
+	// 2. 调用 repo 层批量创建
+	nodeResults, relationResults, err := s.nodeRepo.BatchCreateNodes(ctx, req)
+	if err != nil {
+		if errors.Is(err, neo4jrepo.ErrInvalidBatch) {
+			return &network.BatchCreateNodesResponse{Success: false, Message: err.Error()}, nil
+		}
+		s.logger.Error("Service: BatchCreateNodes failed", zap.Int("nodes", len(req.Nodes)), zap.Int("relations", len(req.Relations)), zap.Error(err))
+		return nil, fmt.Errorf("批量创建节点失败: %w", err)
+	}
+
+	// 3. 构建响应
+	nodeOK := countBatchNodeSuccess(nodeResults)
+	resp := &network.BatchCreateNodesResponse{
+		Success:     true,
+		Message:     fmt.Sprintf("批量创建完成: 节点成功 %d 个，失败 %d 个", nodeOK, len(nodeResults)-nodeOK),
+		NodeResults: nodeResults,
+	}
+	if relationResults != nil {
+		relOK := countBatchRelationSuccess(relationResults)
+		resp.RelationResults = relationResults
+		resp.Message += fmt.Sprintf("; 关系成功 %d 条，失败 %d 条", relOK, len(relationResults)-relOK)
+	}
+	return resp, nil
+}
+
 // GetNode 处理获取节点的业务逻辑
 func (s *networkService) GetNode(ctx context.Context, req *network.GetNodeRequest) (*network.GetNodeResponse, error) {
 	node, err := s.nodeRepo.GetNode(ctx, req.ID)
@@ -114,6 +177,25 @@ func (s *networkService) GetNode(ctx context.Context, req *network.GetNodeReques
 
 // UpdateNode 处理更新节点的业务逻辑
 func (s *networkService) UpdateNode(ctx context.Context, req *network.UpdateNodeRequest) (*network.UpdateNodeResponse, error) {
+	// 更新属性时按节点当前类型的属性约束校验，只校验请求中出现的键
+	props, fieldErrs := mergeProperties(req.Properties, req.TypedProperties)
+	if len(fieldErrs) > 0 {
+		return &network.UpdateNodeResponse{Success: false, Message: fieldErrorsMessage("节点属性", fieldErrs), FieldErrors: toFieldErrors(fieldErrs)}, nil
+	}
+	if len(props) > 0 {
+		current, err := s.nodeRepo.GetNode(ctx, req.ID)
+		if err != nil {
+			if isNotFoundError(err) {
+				return &network.UpdateNodeResponse{Success: false, Message: fmt.Sprintf("要更新的节点未找到: ID=%s", req.ID)}, nil
+			}
+			s.logger.Error("Service: UpdateNode failed to load node for validation", zap.String("ID", req.ID), zap.Error(err))
+			return nil, fmt.Errorf("更新节点失败: %w", err)
+		}
+		if fieldErrs := typeregistry.ValidateProperties(typeregistry.Default().NodePropertySchema(current.Type), props, true); len(fieldErrs) > 0 {
+			return &network.UpdateNodeResponse{Success: false, Message: fieldErrorsMessage("节点属性", fieldErrs), FieldErrors: toFieldErrors(fieldErrs)}, nil
+		}
+	}
+
 	node, err := s.nodeRepo.UpdateNode(ctx, req)
 	if err != nil {
 		if isNotFoundError(err) {
@@ -158,6 +240,13 @@ func (s *networkService) CreateRelation(ctx context.Context, req *network.Create
 	if req.Source == "" || req.Target == "" {
 		return &network.CreateRelationResponse{Success: false, Message: "源节点和目标节点 ID 不能为空"}, nil
 	}
+	props, fieldErrs := mergeProperties(req.Properties, req.TypedProperties)
+	if len(fieldErrs) == 0 {
+		fieldErrs = typeregistry.ValidateProperties(typeregistry.Default().RelationPropertySchema(req.Type), props, false)
+	}
+	if len(fieldErrs) > 0 {
+		return &network.CreateRelationResponse{Success: false, Message: fieldErrorsMessage("关系属性", fieldErrs), FieldErrors: toFieldErrors(fieldErrs)}, nil
+	}
 
 	// 2. 调用 repo 层创建关系
 	relation, err := s.relationRepo.CreateRelation(ctx, req)
@@ -175,6 +264,56 @@ func (s *networkService) CreateRelation(ctx context.Context, req *network.Create
 	}, nil
 }
 
+// BatchCreateRelations 处理批量创建关系的业务逻辑
+// 存在未注册的关系类型时整个批次被拒绝；其他单项失败不会影响其他项，Success 仅表示批次已被处理；逐项结果见 Results。
+func (s *networkService) BatchCreateRelations(ctx context.Context, req *network.BatchCreateRelationsRequest) (*network.BatchCreateRelationsResponse, error) {
+	// 1. 输入验证
+	if len(req.Relations) == 0 {
+		return &network.BatchCreateRelationsResponse{Success: false, Message: "关系列表不能为空"}, nil
+	}
+	if len(req.Relations) > maxBatchCreateItems {
+		return &network.BatchCreateRelationsResponse{Success: false, Message: fmt.Sprintf("单次批量创建最多 %d 条关系", maxBatchCreateItems)}, nil
+	}
+
+	// 2. 调用 repo 层批量创建
+	results, err := s.relationRepo.BatchCreateRelations(ctx, req)
+	if err != nil {
+		if errors.Is(err, neo4jrepo.ErrInvalidBatch) {
+			return &network.BatchCreateRelationsResponse{Success: false, Message: err.Error()}, nil
+		}
+		s.logger.Error("Service: BatchCreateRelations failed", zap.Int("relations", len(req.Relations)), zap.Error(err))
+		return nil, fmt.Errorf("批量创建关系失败: %w", err)
+	}
+
+	// 3. 构建响应
+	ok := countBatchRelationSuccess(results)
+	return &network.BatchCreateRelationsResponse{
+		Success: true,
+		Message: fmt.Sprintf("批量创建完成: 关系成功 %d 条，失败 %d 条", ok, len(results)-ok),
+		Results: results,
+	}, nil
+}
+
+func countBatchNodeSuccess(results []*network.BatchNodeResult) int {
+	n := 0
+	for _, r := range results {
+		if r.Success {
+			n++
+		}
+	}
+	return n
+}
+
+func countBatchRelationSuccess(results []*network.BatchRelationResult) int {
+	n := 0
+	for _, r := range results {
+		if r.Success {
+			n++
+		}
+	}
+	return n
+}
+
 // GetRelation 处理获取关系的业务逻辑
 func (s *networkService) GetRelation(ctx context.Context, req *network.GetRelationRequest) (*network.GetRelationResponse, error) {
 	relation, err := s.relationRepo.GetRelation(ctx, req.ID)
@@ -197,6 +336,25 @@ func (s *networkService) GetRelation(ctx context.Context, req *network.GetRelati
 
 // UpdateRelation 处理更新关系的业务逻辑
 func (s *networkService) UpdateRelation(ctx context.Context, req *network.UpdateRelationRequest) (*network.UpdateRelationResponse, error) {
+	// 更新属性时按关系类型的属性约束校验，只校验请求中出现的键
+	props, fieldErrs := mergeProperties(req.Properties, req.TypedProperties)
+	if len(fieldErrs) > 0 {
+		return &network.UpdateRelationResponse{Success: false, Message: fieldErrorsMessage("关系属性", fieldErrs), FieldErrors: toFieldErrors(fieldErrs)}, nil
+	}
+	if len(props) > 0 {
+		current, err := s.relationRepo.GetRelation(ctx, req.ID)
+		if err != nil {
+			if isNotFoundError(err) {
+				return &network.UpdateRelationResponse{Success: false, Message: fmt.Sprintf("要更新的关系未找到: ID=%s", req.ID)}, nil
+			}
+			s.logger.Error("Service: UpdateRelation failed to load relation for validation", zap.String("ID", req.ID), zap.Error(err))
+			return nil, fmt.Errorf("更新关系失败: %w", err)
+		}
+		if fieldErrs := typeregistry.ValidateProperties(typeregistry.Default().RelationPropertySchema(current.Type), props, true); len(fieldErrs) > 0 {
+			return &network.UpdateRelationResponse{Success: false, Message: fieldErrorsMessage("关系属性", fieldErrs), FieldErrors: toFieldErrors(fieldErrs)}, nil
+		}
+	}
+
 	relation, err := s.relationRepo.UpdateRelation(ctx, req)
 	if err != nil {
 		if isNotFoundError(err) {
@@ -237,8 +395,23 @@ func (s *networkService) DeleteRelation(ctx context.Context, req *network.Delete
 // SearchNodes 处理搜索节点的业务逻辑
 func (s *networkService) SearchNodes(ctx context.Context, req *network.SearchNodesRequest) (*network.SearchNodesResponse, error) {
 	s.logger.Debug("Service: SearchNodes function entered")
-	nodes, total, err := s.nodeRepo.SearchNodes(ctx, req)
+	nodes, total, nextCursor, facets, err := s.nodeRepo.SearchNodes(ctx, req)
 	if err != nil {
+		if errors.Is(err, neo4jrepo.ErrInvalidCursor) {
+			return &network.SearchNodesResponse{Success: false, Message: "无效的分页游标"}, nil
+		}
+		if errors.Is(err, neo4jdal.ErrInvalidFilter) {
+			detail := strings.TrimPrefix(err.Error(), neo4jdal.ErrInvalidFilter.Error()+": ")
+			return &network.SearchNodesResponse{Success: false, Message: "无效的过滤条件: " + detail}, nil
+		}
+		if errors.Is(err, neo4jdal.ErrInvalidSort) {
+			detail := strings.TrimPrefix(err.Error(), neo4jdal.ErrInvalidSort.Error()+": ")
+			return &network.SearchNodesResponse{Success: false, Message: "无效的排序条件: " + detail}, nil
+		}
+		if errors.Is(err, neo4jdal.ErrInvalidFacet) {
+			detail := strings.TrimPrefix(err.Error(), neo4jdal.ErrInvalidFacet.Error()+": ")
+			return &network.SearchNodesResponse{Success: false, Message: "无效的分面字段: " + detail}, nil
+		}
 		// 搜索失败通常不认为是致命错误，除非是底层连接问题
 		s.logger.Error("Service: SearchNodes failed", zap.Any("criteria", req.Criteria), zap.Error(err))
 		// 可以选择返回空结果或错误
@@ -246,33 +419,112 @@ func (s *networkService) SearchNodes(ctx context.Context, req *network.SearchNod
 	}
 
 	// 即使找不到结果 (len(nodes) == 0)，也视为成功执行了搜索
-	return &network.SearchNodesResponse{
+	resp := &network.SearchNodesResponse{
 		Success: true,
 		Message: fmt.Sprintf("搜索完成，找到 %d 个节点", total),
 		Nodes:   nodes,
 		Total:   total,
+		Facets:  facets,
+	}
+	if nextCursor != "" {
+		resp.NextCursor = &nextCursor
+	}
+	return resp, nil
+}
+
+// FulltextSearchNodes 处理全文搜索节点的业务逻辑
+func (s *networkService) FulltextSearchNodes(ctx context.Context, req *network.FulltextSearchNodesRequest) (*network.FulltextSearchNodesResponse, error) {
+	if strings.TrimSpace(req.Query) == "" {
+		return &network.FulltextSearchNodesResponse{Success: false, Message: "搜索文本不能为空"}, nil
+	}
+	if (req.Limit != nil && *req.Limit < 0) || (req.Offset != nil && *req.Offset < 0) {
+		return &network.FulltextSearchNodesResponse{Success: false, Message: "limit 和 offset 不能为负数"}, nil
+	}
+	hits, total, err := s.nodeRepo.FulltextSearchNodes(ctx, req)
+	if err != nil {
+		if errors.Is(err, fulltext.ErrEmptyQuery) {
+			return &network.FulltextSearchNodesResponse{Success: false, Message: "搜索文本中没有可搜索的词"}, nil
+		}
+		s.logger.Error("Service: FulltextSearchNodes failed", zap.String("query", req.Query), zap.Error(err))
+		return nil, fmt.Errorf("全文搜索节点失败: %w", err)
+	}
+	return &network.FulltextSearchNodesResponse{
+		Success: true,
+		Message: fmt.Sprintf("搜索完成，找到 %d 个节点", total),
+		Hits:    hits,
+		Total:   total,
 	}, nil
 }
 
 // GetNodeRelations 处理获取节点关系的业务逻辑
 func (s *networkService) GetNodeRelations(ctx context.Context, req *network.GetNodeRelationsRequest) (*network.GetNodeRelationsResponse, error) {
-	relations, total, err := s.relationRepo.GetNodeRelations(ctx, req)
+	relations, total, nextCursor, err := s.relationRepo.GetNodeRelations(ctx, req)
 	if err != nil {
+		if errors.Is(err, neo4jrepo.ErrInvalidCursor) {
+			return &network.GetNodeRelationsResponse{Success: false, Message: "无效的分页游标"}, nil
+		}
+		if errors.Is(err, neo4jdal.ErrInvalidSort) {
+			detail := strings.TrimPrefix(err.Error(), neo4jdal.ErrInvalidSort.Error()+": ")
+			return &network.GetNodeRelationsResponse{Success: false, Message: "无效的排序条件: " + detail}, nil
+		}
 		s.logger.Error("Service: GetNodeRelations failed", zap.String("nodeID", req.NodeID), zap.Error(err))
 		return nil, fmt.Errorf("获取节点关系失败: %w", err)
 	}
 
-	return &network.GetNodeRelationsResponse{
+	resp := &network.GetNodeRelationsResponse{
 		Success:   true,
 		Message:   fmt.Sprintf("获取关系完成，找到 %d 个关系", total),
 		Relations: relations,
 		Total:     total,
+	}
+	if nextCursor != "" {
+		resp.NextCursor = &nextCursor
+	}
+	return resp, nil
+}
+
+// GetRecommendations 处理 "可能认识的人" 推荐的业务逻辑，只为 PERSON 节点推荐
+func (s *networkService) GetRecommendations(ctx context.Context, req *network.GetRecommendationsRequest) (*network.GetRecommendationsResponse, error) {
+	if req.IsSetLimit() && (*req.Limit < 1 || *req.Limit > neo4jrepo.RecommendationsMaxLimit) {
+		return &network.GetRecommendationsResponse{Success: false, Message: fmt.Sprintf("limit 必须在 1 到 %d 之间", neo4jrepo.RecommendationsMaxLimit)}, nil
+	}
+	node, err := s.nodeRepo.GetNode(ctx, req.NodeID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return &network.GetRecommendationsResponse{Success: false, Message: fmt.Sprintf("节点未找到: ID=%s", req.NodeID)}, nil
+		}
+		s.logger.Error("Service: GetRecommendations get node failed", zap.String("nodeID", req.NodeID), zap.Error(err))
+		return nil, fmt.Errorf("获取节点失败: %w", err)
+	}
+	if node.Type != network.NodeType_PERSON {
+		return &network.GetRecommendationsResponse{Success: false, Message: fmt.Sprintf("只能为 PERSON 节点推荐，节点 %s 的类型为 %s", req.NodeID, typeregistry.Default().NodeTypeName(node.Type))}, nil
+	}
+
+	recommendations, err := s.nodeRepo.GetRecommendations(ctx, req)
+	if err != nil {
+		if isNotFoundError(err) {
+			return &network.GetRecommendationsResponse{Success: false, Message: fmt.Sprintf("节点未找到: ID=%s", req.NodeID)}, nil
+		}
+		if errors.Is(err, neo4jdal.ErrNotPerson) {
+			// 读取节点之后类型被修改
+			return &network.GetRecommendationsResponse{Success: false, Message: fmt.Sprintf("只能为 PERSON 节点推荐，节点 %s 不是 PERSON", req.NodeID)}, nil
+		}
+		s.logger.Error("Service: GetRecommendations failed", zap.String("nodeID", req.NodeID), zap.Error(err))
+		return nil, fmt.Errorf("获取推荐失败: %w", err)
+	}
+	return &network.GetRecommendationsResponse{
+		Success:         true,
+		Message:         fmt.Sprintf("推荐完成，找到 %d 个可能认识的人", len(recommendations)),
+		Recommendations: recommendations,
 	}, nil
 }
 
 // GetNetwork 处理网络查询的业务逻辑
 func (s *networkService) GetNetwork(ctx context.Context, req *network.GetNetworkRequest) (*network.GetNetworkResponse, error) {
-	nodes, relations, err := s.nodeRepo.GetNetwork(ctx, req)
+	if msg := validateDirections(req.Direction, req.RelationDirections); msg != "" {
+		return &network.GetNetworkResponse{Success: false, Message: msg}, nil
+	}
+	nodes, relations, truncated, err := s.nodeRepo.GetNetwork(ctx, req)
 	if err != nil {
 		s.logger.Error("Service: GetNetwork failed", // 使用注入的 logger
 			zap.Any("startCriteria", req.StartNodeCriteria),
@@ -283,19 +535,91 @@ func (s *networkService) GetNetwork(ctx context.Context, req *network.GetNetwork
 	// GetNetwork 找不到匹配通常返回空列表，不视为错误
 	s.logger.Info("Service: GetNetwork successful", // 使用注入的 logger
 		zap.Int("nodesFound", len(nodes)),
-		zap.Int("relationsFound", len(relations)))
+		zap.Int("relationsFound", len(relations)),
+		zap.Bool("truncated", truncated))
 	return &network.GetNetworkResponse{
 		Success:   true,
 		Message:   fmt.Sprintf("获取网络图谱完成，找到 %d 个节点，%d 条关系", len(nodes), len(relations)),
 		Nodes:     nodes,
 		Relations: relations,
+		Truncated: truncated,
+	}, nil
+}
+
+// ExportNetwork 处理图谱导出的业务逻辑，返回的子图由调用方按 req.Format 编码
+func (s *networkService) ExportNetwork(ctx context.Context, req *network.ExportNetworkRequest) (*network.ExportNetworkResponse, error) {
+	// 1. 输入验证
+	if _, err := graphexport.ParseFormat(req.GetFormat()); err != nil {
+		return &network.ExportNetworkResponse{Success: false, Message: err.Error()}, nil
+	}
+	if req.Depth < 0 {
+		return &network.ExportNetworkResponse{Success: false, Message: "查询深度不能为负数"}, nil
+	}
+	if msg := validateDirections(req.Direction, req.RelationDirections); msg != "" {
+		return &network.ExportNetworkResponse{Success: false, Message: msg}, nil
+	}
+
+	// 2. 使用与 GetNetwork 相同的过滤条件查询
+	nodes, relations, truncated, err := s.nodeRepo.ExportNetwork(ctx, &network.GetNetworkRequest{
+		StartNodeCriteria:  req.StartNodeCriteria,
+		Depth:              req.Depth,
+		RelationTypes:      req.RelationTypes,
+		NodeTypes:          req.NodeTypes,
+		MaxNodes:           req.MaxNodes,
+		MaxRelations:       req.MaxRelations,
+		Direction:          req.Direction,
+		RelationDirections: req.RelationDirections,
+	})
+	if err != nil {
+		s.logger.Error("Service: ExportNetwork failed",
+			zap.Any("startCriteria", req.StartNodeCriteria),
+			zap.Error(err))
+		return nil, fmt.Errorf("导出网络图谱失败: %w", err)
+	}
+
+	s.logger.Info("Service: ExportNetwork successful",
+		zap.String("format", req.GetFormat()),
+		zap.Int("nodes", len(nodes)),
+		zap.Int("relations", len(relations)),
+		zap.Bool("truncated", truncated))
+	return &network.ExportNetworkResponse{
+		Success:   true,
+		Message:   fmt.Sprintf("导出网络图谱完成，共 %d 个节点，%d 条关系", len(nodes), len(relations)),
+		Nodes:     nodes,
+		Relations: relations,
+		Truncated: truncated,
 	}, nil
 }
 
 // GetPath 处理路径查询的业务逻辑
 func (s *networkService) GetPath(ctx context.Context, req *network.GetPathRequest) (*network.GetPathResponse, error) {
-	nodes, relations, err := s.nodeRepo.GetPath(ctx, req)
+	if req.IsSetMode() {
+		switch *req.Mode {
+		case network.PathMode_SINGLE, network.PathMode_ALL_SHORTEST, network.PathMode_K_SHORTEST, network.PathMode_WEIGHTED:
+		default:
+			return &network.GetPathResponse{Success: false, Message: fmt.Sprintf("无效的路径查询模式: %d", *req.Mode)}, nil
+		}
+	}
+	if req.IsSetK() && (*req.K < 1 || *req.K > neo4jdal.MaxPathK) {
+		return &network.GetPathResponse{Success: false, Message: fmt.Sprintf("k 必须在 1 到 %d 之间", neo4jdal.MaxPathK)}, nil
+	}
+	if req.IsSetWeightProperty() && strings.TrimSpace(*req.WeightProperty) == "" {
+		return &network.GetPathResponse{Success: false, Message: "weight_property 不能为空"}, nil
+	}
+	if req.IsSetDefaultWeight() && !(*req.DefaultWeight >= 0) {
+		return &network.GetPathResponse{Success: false, Message: "default_weight 不能为负数"}, nil
+	}
+	if msg := validateDirections(req.Direction, req.RelationDirections); msg != "" {
+		return &network.GetPathResponse{Success: false, Message: msg}, nil
+	}
+	if msg := validatePathConstraints(req); msg != "" {
+		return &network.GetPathResponse{Success: false, Message: msg}, nil
+	}
+	paths, err := s.nodeRepo.GetPath(ctx, req)
 	if err != nil {
+		if errors.Is(err, neo4jdal.ErrPathSearchTooLarge) {
+			return &network.GetPathResponse{Success: false, Message: fmt.Sprintf("路径搜索范围过大，请减小 max_depth 或增加约束: %v", err)}, nil
+		}
 		// GetPath 对于路径不存在会返回错误，我们需要检查这种特定情况
 		// 使用 isNotFoundError，因为它应该能捕捉到 Repo 层包装的 Path Not Found 错误
 		if isNotFoundError(err) {
@@ -309,11 +633,242 @@ func (s *networkService) GetPath(ctx context.Context, req *network.GetPathReques
 		return nil, fmt.Errorf("查询路径失败: %w", err)
 	}
 
-	// 成功找到路径
+	// 成功找到路径，nodes 和 relations 保持为第一条路径
 	return &network.GetPathResponse{
 		Success:   true,
-		Message:   "路径查询成功",
-		Nodes:     nodes,
-		Relations: relations,
+		Message:   fmt.Sprintf("路径查询成功，共 %d 条路径", len(paths)),
+		Nodes:     paths[0].Nodes,
+		Relations: paths[0].Relations,
+		Paths:     paths,
+		TotalCost: paths[0].Cost,
+	}, nil
+}
+
+// ListTypes 返回当前注册的全部节点类型和关系类型
+func (s *networkService) ListTypes(ctx context.Context, req *network.ListTypesRequest) (*network.ListTypesResponse, error) {
+	nodeDefs, relDefs, err := s.typeRepo.ListTypes(ctx)
+	if err != nil {
+		s.logger.Error("Service: ListTypes failed", zap.Error(err))
+		return nil, fmt.Errorf("查询类型列表失败: %w", err)
+	}
+
+	resp := &network.ListTypesResponse{
+		Success:       true,
+		Message:       fmt.Sprintf("共 %d 种节点类型，%d 种关系类型", len(nodeDefs), len(relDefs)),
+		NodeTypes:     make([]*network.NodeTypeInfo, 0, len(nodeDefs)),
+		RelationTypes: make([]*network.RelationTypeInfo, 0, len(relDefs)),
+	}
+	for _, def := range nodeDefs {
+		resp.NodeTypes = append(resp.NodeTypes, toNodeTypeInfo(def))
+	}
+	for _, def := range relDefs {
+		resp.RelationTypes = append(resp.RelationTypes, toRelationTypeInfo(def))
+	}
+	return resp, nil
+}
+
+// CreateNodeType 注册新的节点类型，名称会被规范为大写
+func (s *networkService) CreateNodeType(ctx context.Context, req *network.CreateNodeTypeRequest) (*network.CreateNodeTypeResponse, error) {
+	// 1. 输入验证
+	name := normalizeTypeName(req.Name)
+	if name == "" {
+		return &network.CreateNodeTypeResponse{Success: false, Message: "节点类型名称不能为空"}, nil
+	}
+
+	// 2. 调用 repo 层注册
+	def, err := s.typeRepo.CreateNodeType(ctx, name, fromPropertySchemas(req.Properties))
+	if err != nil {
+		if errors.Is(err, neo4jrepo.ErrTypeExists) || errors.Is(err, neo4jrepo.ErrInvalidTypeDef) {
+			return &network.CreateNodeTypeResponse{Success: false, Message: fmt.Sprintf("创建节点类型失败: %v", err)}, nil
+		}
+		s.logger.Error("Service: CreateNodeType failed", zap.String("name", name), zap.Error(err))
+		return nil, fmt.Errorf("创建节点类型失败: %w", err)
+	}
+
+	return &network.CreateNodeTypeResponse{
+		Success:  true,
+		Message:  "节点类型创建成功",
+		NodeType: toNodeTypeInfo(def),
+	}, nil
+}
+
+// UpdateNodeType 替换节点类型 (包括内置类型) 的属性约束，Properties 为空表示不限制
+func (s *networkService) UpdateNodeType(ctx context.Context, req *network.UpdateNodeTypeRequest) (*network.UpdateNodeTypeResponse, error) {
+	name := normalizeTypeName(req.Name)
+	if name == "" {
+		return &network.UpdateNodeTypeResponse{Success: false, Message: "节点类型名称不能为空"}, nil
+	}
+
+	def, err := s.typeRepo.UpdateNodeType(ctx, name, fromPropertySchemas(req.Properties))
+	if err != nil {
+		if errors.Is(err, neo4jrepo.ErrTypeNotFound) || errors.Is(err, neo4jrepo.ErrInvalidTypeDef) {
+			return &network.UpdateNodeTypeResponse{Success: false, Message: fmt.Sprintf("更新节点类型失败: %v", err)}, nil
+		}
+		s.logger.Error("Service: UpdateNodeType failed", zap.String("name", name), zap.Error(err))
+		return nil, fmt.Errorf("更新节点类型失败: %w", err)
+	}
+
+	return &network.UpdateNodeTypeResponse{
+		Success:  true,
+		Message:  "节点类型更新成功",
+		NodeType: toNodeTypeInfo(def),
+	}, nil
+}
+
+// SaveRelationType 注册关系类型或替换其允许连接的节点类型组合和属性约束，AllowedPairs / Properties 为空表示不限制
+func (s *networkService) SaveRelationType(ctx context.Context, req *network.SaveRelationTypeRequest) (*network.SaveRelationTypeResponse, error) {
+	// 1. 输入验证
+	name := normalizeTypeName(req.Name)
+	if name == "" {
+		return &network.SaveRelationTypeResponse{Success: false, Message: "关系类型名称不能为空"}, nil
+	}
+	pairs := make([]typeregistry.TypePair, 0, len(req.AllowedPairs))
+	for _, p := range req.AllowedPairs {
+		if p == nil {
+			continue
+		}
+		pairs = append(pairs, typeregistry.TypePair{Source: normalizeTypeName(p.Source), Target: normalizeTypeName(p.Target)})
+	}
+
+	// 2. 调用 repo 层保存
+	def, err := s.typeRepo.SaveRelationType(ctx, name, pairs, fromPropertySchemas(req.Properties))
+	if err != nil {
+		if errors.Is(err, neo4jrepo.ErrInvalidTypeDef) {
+			return &network.SaveRelationTypeResponse{Success: false, Message: fmt.Sprintf("保存关系类型失败: %v", err)}, nil
+		}
+		s.logger.Error("Service: SaveRelationType failed", zap.String("name", name), zap.Error(err))
+		return nil, fmt.Errorf("保存关系类型失败: %w", err)
+	}
+
+	return &network.SaveRelationTypeResponse{
+		Success:      true,
+		Message:      "关系类型保存成功",
+		RelationType: toRelationTypeInfo(def),
 	}, nil
 }
+
+// validateDirections 检查遍历方向及按关系类型的覆盖，返回错误提示 (合法时为空字符串)
+func validateDirections(direction *network.Direction, overrides []*network.RelationDirection) string {
+	validDirection := func(d network.Direction) bool {
+		return d == network.Direction_BOTH || d == network.Direction_OUTGOING || d == network.Direction_INCOMING
+	}
+	if direction != nil && !validDirection(*direction) {
+		return fmt.Sprintf("无效的关系方向: %d", *direction)
+	}
+	for _, o := range overrides {
+		if o == nil {
+			continue
+		}
+		if _, ok := typeregistry.Default().RelationLabel(o.Type); !ok {
+			return fmt.Sprintf("relation_directions 中的关系类型无效: %d", o.Type)
+		}
+		if !validDirection(o.Direction) {
+			return fmt.Sprintf("关系类型 %s 的方向无效: %d", typeregistry.Default().RelationTypeName(o.Type), o.Direction)
+		}
+	}
+	return ""
+}
+
+// validatePathConstraints 检查路径查询的节点约束，返回错误提示 (合法时为空字符串)
+func validatePathConstraints(req *network.GetPathRequest) string {
+	if len(req.ViaNodeIds) > neo4jdal.MaxViaNodes {
+		return fmt.Sprintf("via_node_ids 最多 %d 个", neo4jdal.MaxViaNodes)
+	}
+	if slices.Contains(req.ExcludeNodeIds, "") || slices.Contains(req.ViaNodeIds, "") {
+		return "exclude_node_ids 和 via_node_ids 中的节点ID不能为空"
+	}
+	for _, id := range req.ViaNodeIds {
+		if slices.Contains(req.ExcludeNodeIds, id) {
+			return fmt.Sprintf("节点 %s 不能同时被排除和必须经过", id)
+		}
+	}
+	for _, nt := range req.IntermediateNodeTypes {
+		if _, ok := typeregistry.Default().NodeLabel(nt); !ok {
+			return fmt.Sprintf("intermediate_node_types 中的节点类型无效: %d", nt)
+		}
+	}
+	return ""
+}
+
+func normalizeTypeName(name string) string {
+	return strings.ToUpper(strings.TrimSpace(name))
+}
+
+func toNodeTypeInfo(def typeregistry.NodeTypeDef) *network.NodeTypeInfo {
+	return &network.NodeTypeInfo{Name: def.Name, Code: int64(def.Code), Builtin: def.Builtin, Properties: toPropertySchemas(def.Properties)}
+}
+
+func toRelationTypeInfo(def typeregistry.RelationTypeDef) *network.RelationTypeInfo {
+	info := &network.RelationTypeInfo{Name: def.Name, Code: int64(def.Code), Builtin: def.Builtin, Properties: toPropertySchemas(def.Properties)}
+	for _, p := range def.AllowedPairs {
+		info.AllowedPairs = append(info.AllowedPairs, &network.TypePair{Source: p.Source, Target: p.Target})
+	}
+	return info
+}
+
+// fromPropertySchemas 将请求中的属性约束转换为注册表结构，值类型不区分大小写
+func fromPropertySchemas(in []*network.PropertySchema) []typeregistry.PropertySchema {
+	var out []typeregistry.PropertySchema
+	for _, p := range in {
+		if p == nil {
+			continue
+		}
+		out = append(out, typeregistry.PropertySchema{
+			Key:        strings.TrimSpace(p.Key),
+			Type:       strings.ToLower(strings.TrimSpace(p.Type)),
+			Required:   p.Required,
+			EnumValues: p.EnumValues,
+			Pattern:    p.GetPattern(),
+			MinLength:  int(p.GetMinLength()),
+			MaxLength:  int(p.GetMaxLength()),
+		})
+	}
+	return out
+}
+
+func toPropertySchemas(in []typeregistry.PropertySchema) []*network.PropertySchema {
+	var out []*network.PropertySchema
+	for _, p := range in {
+		schema := &network.PropertySchema{Key: p.Key, Type: p.Type, Required: p.Required, EnumValues: p.EnumValues}
+		if schema.Type == "" {
+			schema.Type = typeregistry.ValueString
+		}
+		if p.Pattern != "" {
+			schema.Pattern = &p.Pattern
+		}
+		if p.MinLength > 0 {
+			schema.MinLength = func(i int64) *int64 { return &i }(int64(p.MinLength))
+		}
+		if p.MaxLength > 0 {
+			schema.MaxLength = func(i int64) *int64 { return &i }(int64(p.MaxLength))
+		}
+		out = append(out, schema)
+	}
+	return out
+}
+
+func toFieldErrors(errs []typeregistry.FieldError) []*network.FieldError {
+	out := make([]*network.FieldError, len(errs))
+	for i, e := range errs {
+		out[i] = &network.FieldError{Field: e.Field, Code: e.Code, Message: e.Message}
+	}
+	return out
+}
+
+// mergeProperties 校验带类型的属性值，并与字符串属性合并为字符串形式供属性约束校验 (同名键以带类型的值为准)
+func mergeProperties(props map[string]string, typed map[string]*network.PropertyValue) (map[string]string, []typeregistry.FieldError) {
+	values, fieldErrs := propvalue.ToDBMap(typed)
+	if len(fieldErrs) > 0 {
+		return nil, fieldErrs
+	}
+	return propvalue.StringView(props, values), nil
+}
+
+// fieldErrorsMessage 将字段错误汇总为一行说明，逐项错误见响应的 field_errors
+func fieldErrorsMessage(subject string, errs []typeregistry.FieldError) string {
+	parts := make([]string, len(errs))
+	for i, e := range errs {
+		parts[i] = e.Error()
+	}
+	return fmt.Sprintf("%s不符合类型约束: %s", subject, strings.Join(parts, "; "))
+}
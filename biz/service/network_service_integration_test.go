@@ -2,7 +2,6 @@ package service_test
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"os"
 	"sort"
@@ -10,26 +9,29 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
-	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
 	"labelwall/biz/dal/neo4jdal"
+	"labelwall/biz/dal/storage"
 	"labelwall/biz/model/relationship/network"
 	"labelwall/biz/repo/neo4jrepo"
 	"labelwall/biz/service" // Import the service package
 	"labelwall/pkg/cache"
+	"labelwall/pkg/typeregistry"
 )
 
 var (
 	testService  service.NetworkService
 	testNodeRepo neo4jrepo.NodeRepository
 	testRelRepo  neo4jrepo.RelationRepository
-	testDriver   neo4j.DriverWithContext
+	testStore    storage.GraphStore
 	testCache    cache.NodeAndByteCache
-	redisClient  *redis.Client
 	testLogger   *zap.Logger
+
+	// Neo4j driver, only initialized when NEO4J_URI is set
+	testDriver neo4j.DriverWithContext
 )
 
 // Helper function to create a node using the service and return its ID
@@ -79,70 +81,30 @@ func sortRelationsByID(relations []*network.Relation) {
 	})
 }
 
-// TestMain sets up the database, cache, repos, and service for integration tests
+// TestMain sets up the in-memory graph store and cache shared by the service tests.
+// When NEO4J_URI is set the tests run against Neo4j instead.
 func TestMain(m *testing.M) {
-	// --- Setup Neo4j ---
-	neo4jURI := os.Getenv("NEO4J_URI")
-	neo4jUser := os.Getenv("NEO4J_USER")
-	neo4jPass := os.Getenv("NEO4J_PASS")
-	if neo4jURI == "" {
-		neo4jURI = "neo4j://localhost:7687"
-	}
-	if neo4jUser == "" {
-		neo4jUser = "neo4j"
-	}
-	if neo4jPass == "" {
-		neo4jPass = "password"
-	}
+	testLogger = zap.NewNop()
 
-	var err error
-	testDriver, err = neo4j.NewDriverWithContext(neo4jURI, neo4j.BasicAuth(neo4jUser, neo4jPass, ""))
-	if err != nil {
-		panic(fmt.Sprintf("Failed to connect to Neo4j: %v", err))
-	}
-	err = testDriver.VerifyConnectivity(context.Background())
-	if err != nil {
-		panic(fmt.Sprintf("Neo4j connectivity verification failed: %v", err))
-	}
+	if neo4jURI := os.Getenv("NEO4J_URI"); neo4jURI != "" { // e.g., "neo4j://localhost:7687"
+		neo4jUser := os.Getenv("NEO4J_USER")
+		neo4jPass := os.Getenv("NEO4J_PASS")
+		if neo4jUser == "" {
+			neo4jUser = "neo4j"
+		}
+		if neo4jPass == "" {
+			neo4jPass = "password"
+		}
 
-	// --- Setup Redis ---
-	redisAddr := os.Getenv("REDIS_ADDR")
-	if redisAddr == "" {
-		redisAddr = "localhost:6381"
-	}
-	redisClient = redis.NewClient(&redis.Options{
-		Addr:     redisAddr,
-		Password: "",
-		DB:       0,
-	})
-	_, err = redisClient.Ping(context.Background()).Result()
-	if err != nil {
-		testDriver.Close(context.Background())
-		panic(fmt.Sprintf("Failed to connect to Redis: %v", err))
-	}
-	// Use estimated keys and FP rate suitable for testing
-	redisCacheImpl, err := cache.NewRedisCache(redisClient, "svc_test:", 10000, 0.01)
-	if err != nil {
-		testDriver.Close(context.Background())
-		redisClient.Close()
-		panic(fmt.Sprintf("Failed to create Redis cache: %v", err))
+		var err error
+		testDriver, err = neo4j.NewDriverWithContext(neo4jURI, neo4j.BasicAuth(neo4jUser, neo4jPass, ""))
+		if err != nil {
+			panic(fmt.Sprintf("Failed to connect to Neo4j: %v", err))
+		}
+		if err := testDriver.VerifyConnectivity(context.Background()); err != nil {
+			panic(fmt.Sprintf("Neo4j connectivity verification failed: %v", err))
+		}
 	}
-	testCache = redisCacheImpl // Assign to the combined interface
-
-	// --- Setup DAL ---
-	nodeDal := neo4jdal.NewNodeDAL()
-	relationDal := neo4jdal.NewRelationDAL()
-
-	// --- Create a logger for tests ---
-	testLogger, _ = zap.NewDevelopment() // Or zap.NewNop() for no test output
-	defer testLogger.Sync()
-
-	// --- Setup Repositories ---
-	testRelRepo = neo4jrepo.NewRelationRepository(testDriver, relationDal, redisCacheImpl, 300, 1000, testLogger)
-	testNodeRepo = neo4jrepo.NewNodeRepository(testDriver, nodeDal, redisCacheImpl, testRelRepo, 300, 100, 500, 100, 100, 3, 5, 1000, testLogger)
-
-	// --- Setup Service ---
-	testService = service.NewNetworkService(testNodeRepo, testRelRepo, testLogger) // Inject real repos and logger
 
 	// --- Clean Database & Cache Before Running ---
 	clearTestData(context.Background())
@@ -151,29 +113,36 @@ func TestMain(m *testing.M) {
 	exitCode := m.Run()
 
 	// --- Teardown ---
-	testDriver.Close(context.Background())
-	redisClient.Close()
+	if testDriver != nil {
+		testDriver.Close(context.Background())
+	}
 	os.Exit(exitCode)
 }
 
-// clearTestData cleans Neo4j and Redis using the test prefix
+// setupTestService wires fresh repositories and a service to store and cacheImpl
+func setupTestService(store storage.GraphStore, cacheImpl *cache.MemoryCache) {
+	testStore = store
+	testCache = cacheImpl
+
+	testRelRepo = neo4jrepo.NewRelationRepository(store, cacheImpl, 300, 1000, testLogger)
+	testNodeRepo = neo4jrepo.NewNodeRepository(store, cacheImpl, testRelRepo, 300, 100, 500, 100, 100, 3, 5, 1000, testLogger)
+	testService = service.NewNetworkService(testNodeRepo, testRelRepo, neo4jrepo.NewTypeRepository(store, typeregistry.Default(), testLogger), testLogger)
+}
+
+// clearTestData empties the graph and replaces the cache with an empty one.
+// On the in-memory backend the store itself is replaced; on Neo4j every node and relationship is deleted.
 func clearTestData(ctx context.Context) {
-	// Clear Neo4j
+	if testDriver == nil {
+		setupTestService(storage.NewMemoryStore(), cache.NewMemoryCache())
+		return
+	}
+
 	session := testDriver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
 	defer session.Close(ctx)
-	_, _ = session.Run(ctx, "MATCH ()-[r]->() DELETE r", nil) // Ignore errors for cleanup
-	_, _ = session.Run(ctx, "MATCH (n) DELETE n", nil)        // Ignore errors for cleanup
-
-	// Clear Redis using the specific test prefix
-	testPrefix := "svc_test:*"
-	keys, err := redisClient.Keys(ctx, testPrefix).Result()
-	if err == nil && len(keys) > 0 {
-		pipe := redisClient.Pipeline()
-		pipe.Del(ctx, keys...)
-		_, _ = pipe.Exec(ctx) // Ignore errors for cleanup
-	} else if err != nil && !errors.Is(err, redis.Nil) {
-		fmt.Printf("Warning: Failed to get Redis keys with prefix %s: %v\n", testPrefix, err)
+	if _, err := session.Run(ctx, "MATCH (n) DETACH DELETE n", nil); err != nil {
+		fmt.Printf("Warning: Failed to clear Neo4j: %v\n", err)
 	}
+	setupTestService(storage.NewNeo4jStore(testDriver, neo4jdal.NewNodeDAL(), neo4jdal.NewRelationDAL(), neo4jdal.NewTypeDAL()), cache.NewMemoryCache())
 }
 
 // --- Service Integration Tests ---
@@ -206,17 +175,12 @@ func TestCreateNode_Service_Integration(t *testing.T) {
 	assert.Equal(t, *createReq.Profession, *resp.Node.Profession)
 	assert.Equal(t, createReq.Properties["domain"], resp.Node.Properties["domain"])
 
-	// Optional: Verify directly in DB
-	session := testDriver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
-	defer session.Close(ctx)
-	dbResult, errDb := session.Run(ctx, "MATCH (p:COMPANY {id: $id}) RETURN p.name AS name, p.domain AS domain", map[string]any{"id": resp.Node.ID})
-	require.NoError(t, errDb, "Failed to query DB after service CreateNode")
-	record, errDbSingle := dbResult.Single(ctx)
-	require.NoError(t, errDbSingle, "Failed to get single record from DB")
-	dbName, _ := record.Get("name")
-	dbDomain, _ := record.Get("domain")
-	assert.Equal(t, createReq.Name, dbName.(string))
-	assert.Equal(t, createReq.Properties["domain"], dbDomain.(string))
+	// Optional: Verify directly in the store
+	dbNode, labels, errDb := testStore.GetNodeByID(ctx, resp.Node.ID)
+	require.NoError(t, errDb, "Failed to read node from store after service CreateNode")
+	assert.Contains(t, labels, "COMPANY")
+	assert.Equal(t, createReq.Name, dbNode.Props["name"])
+	assert.Equal(t, createReq.Properties["domain"], dbNode.Props["domain"])
 }
 
 // TestGetNode_Service_Integration tests the GetNode service method
@@ -601,16 +565,10 @@ func TestSearchNodes_Service_Integration(t *testing.T) {
 
 	// --- Test Case 3: Search by type (COMPANY) ---
 	t.Run("Search by Type", func(t *testing.T) {
-		// Add direct DB check
-		session := testDriver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
-		debugResult, errDb := session.Run(ctx, "MATCH (c:COMPANY {id: $id}) RETURN count(c) as cnt", map[string]any{"id": c1ID})
-		require.NoError(t, errDb, "Direct DB check query failed")
-		record, errDbSingle := debugResult.Single(ctx)
-		require.NoError(t, errDbSingle, "Direct DB check single failed")
-		count, ok := record.Get("cnt")
-		require.True(t, ok, "Direct DB check count not found")
-		assert.EqualValues(t, 1, count.(int64), "Direct DB check failed: COMPANY node with ID %s not found before service call", c1ID)
-		session.Close(ctx)
+		// Add direct store check
+		_, labels, errDb := testStore.GetNodeByID(ctx, c1ID)
+		require.NoError(t, errDb, "Direct store check failed")
+		assert.Contains(t, labels, "COMPANY", "Direct store check failed: COMPANY node with ID %s not found before service call", c1ID)
 
 		searchReq := &network.SearchNodesRequest{
 			Type: network.NodeTypePtr(network.NodeType_COMPANY),
@@ -1047,4 +1005,91 @@ func TestGetPath_Service_Integration(t *testing.T) {
 		assert.Nil(t, resp.Nodes)
 		assert.Nil(t, resp.Relations)
 	})
+
+	// --- Test Case 6: K shortest paths and invalid mode parameters ---
+	t.Run("Find K Shortest Paths A->D", func(t *testing.T) {
+		mode, k := network.PathMode_K_SHORTEST, int32(3)
+		resp, err := testService.GetPath(ctx, &network.GetPathRequest{SourceID: aID, TargetID: dID, Mode: &mode, K: &k})
+		require.NoError(t, err)
+		assert.True(t, resp.Success)
+		require.Len(t, resp.Paths, 2, "A->D 只有两条无环路径")
+		assert.Equal(t, resp.Paths[0].Nodes, resp.Nodes, "nodes 与第一条路径相同")
+		assert.Len(t, resp.Paths[1].Relations, 3)
+
+		tooMany := int32(neo4jdal.MaxPathK + 1)
+		resp, err = testService.GetPath(ctx, &network.GetPathRequest{SourceID: aID, TargetID: dID, Mode: &mode, K: &tooMany})
+		require.NoError(t, err)
+		assert.False(t, resp.Success)
+
+		unknown := network.PathMode(9)
+		resp, err = testService.GetPath(ctx, &network.GetPathRequest{SourceID: aID, TargetID: dID, Mode: &unknown})
+		require.NoError(t, err)
+		assert.False(t, resp.Success)
+	})
+
+	t.Run("Find Weighted Path A->D", func(t *testing.T) {
+		mode := network.PathMode_WEIGHTED
+		resp, err := testService.GetPath(ctx, &network.GetPathRequest{SourceID: aID, TargetID: dID, Mode: &mode})
+		require.NoError(t, err)
+		assert.True(t, resp.Success)
+		require.NotNil(t, resp.TotalCost)
+		assert.Equal(t, float64(len(resp.Relations)), *resp.TotalCost, "没有 weight 属性时每跳代价为 1")
+
+		negative := -1.0
+		resp, err = testService.GetPath(ctx, &network.GetPathRequest{SourceID: aID, TargetID: dID, Mode: &mode, DefaultWeight: &negative})
+		require.NoError(t, err)
+		assert.False(t, resp.Success)
+	})
+
+	t.Run("Invalid Path Constraints", func(t *testing.T) {
+		resp, err := testService.GetPath(ctx, &network.GetPathRequest{SourceID: aID, TargetID: dID, ExcludeNodeIds: []string{bID}, ViaNodeIds: []string{bID}})
+		require.NoError(t, err)
+		assert.False(t, resp.Success)
+		assert.Contains(t, resp.Message, "不能同时被排除和必须经过")
+
+		resp, err = testService.GetPath(ctx, &network.GetPathRequest{SourceID: aID, TargetID: dID, ViaNodeIds: []string{"v1", "v2", "v3", "v4", "v5", "v6"}})
+		require.NoError(t, err)
+		assert.False(t, resp.Success)
+	})
+}
+
+func TestGetRecommendations_Service_Integration(t *testing.T) {
+	ctx := context.Background()
+	require.NotNil(t, testService, "Service should be initialized")
+	clearTestData(ctx)
+
+	meID := createTestNode(ctx, t, "Rec Me", network.NodeType_PERSON)
+	friendID := createTestNode(ctx, t, "Rec Friend", network.NodeType_PERSON)
+	candidateID := createTestNode(ctx, t, "Rec Candidate", network.NodeType_PERSON)
+	companyID := createTestNode(ctx, t, "Rec Corp", network.NodeType_COMPANY)
+	createTestRelation(ctx, t, meID, friendID, network.RelationType_FRIEND)
+	createTestRelation(ctx, t, friendID, candidateID, network.RelationType_COLLEAGUE)
+
+	t.Run("Recommend Friend Of Friend", func(t *testing.T) {
+		resp, err := testService.GetRecommendations(ctx, &network.GetRecommendationsRequest{NodeID: meID})
+		require.NoError(t, err)
+		require.True(t, resp.Success, resp.Message)
+		require.Len(t, resp.Recommendations, 1, "The direct friend is not recommended")
+		rec := resp.Recommendations[0]
+		assert.Equal(t, candidateID, rec.Node.ID)
+		assert.Equal(t, int32(1), rec.MutualCount)
+		require.Len(t, rec.MutualNodes, 1)
+		assert.Equal(t, friendID, rec.MutualNodes[0].ID)
+	})
+
+	t.Run("Invalid Requests", func(t *testing.T) {
+		limit := int32(100)
+		resp, err := testService.GetRecommendations(ctx, &network.GetRecommendationsRequest{NodeID: meID, Limit: &limit})
+		require.NoError(t, err)
+		assert.False(t, resp.Success)
+
+		resp, err = testService.GetRecommendations(ctx, &network.GetRecommendationsRequest{NodeID: companyID})
+		require.NoError(t, err)
+		assert.False(t, resp.Success, "Only PERSON nodes get recommendations")
+
+		resp, err = testService.GetRecommendations(ctx, &network.GetRecommendationsRequest{NodeID: "non-existent-id"})
+		require.NoError(t, err)
+		assert.False(t, resp.Success)
+		assert.Contains(t, resp.Message, "未找到")
+	})
 }
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"labelwall/biz/dal/neo4jdal"
+	"labelwall/biz/dal/storage"
+	"labelwall/biz/model/relationship/network"
+	"labelwall/biz/repo/neo4jrepo"
+	"labelwall/biz/service"
+	"labelwall/internal/bootstrap"
+	"labelwall/pkg/config"
+	"labelwall/pkg/graphexport"
+	"labelwall/pkg/typeregistry"
+)
+
+// runExport 实现 `labelwall export` 子命令，过滤条件与 GET /api/v1/network 相同，返回进程退出码
+func runExport(args []string) int {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	configPath := fs.String("config", "./config.yaml", "配置文件路径 (使用其中的 Neo4j 连接配置)")
+	formatName := fs.String("format", "graphml", "导出格式: graphml, gexf, jgf, cypher")
+	output := fs.String("o", "", "输出文件，默认写到标准输出")
+	criteria := fs.String("criteria", "", "起始节点条件，如 name=张三,profession=工程师")
+	depth := fs.Int("depth", 1, "从起始节点扩展的深度")
+	relationTypes := fs.String("relation-types", "", "关系类型过滤，如 FRIEND,COLLEAGUE")
+	nodeTypes := fs.String("node-types", "", "节点类型过滤，如 PERSON,COMPANY")
+	direction := fs.String("direction", "both", "遍历的关系方向: both, outgoing, incoming")
+	relationDirections := fs.String("relation-directions", "", "按关系类型覆盖方向，如 FOLLOWING=outgoing,VISITED=incoming")
+	maxNodes := fs.Int("max-nodes", 0, "导出节点数上限，0 表示使用服务端上限")
+	maxRelations := fs.Int("max-relations", 0, "导出关系数上限，0 表示使用服务端上限")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "用法: labelwall export [选项] -criteria name=张三 -depth 2 -format gexf -o network.gexf")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	format, err := graphexport.ParseFormat(*formatName)
+	if err != nil {
+		log.Printf("Error: %v", err)
+		return 2
+	}
+	req := &network.ExportNetworkRequest{
+		Depth:  int32(*depth),
+		Format: string(format),
+	}
+	if req.StartNodeCriteria, err = parseCriteria(*criteria); err != nil {
+		log.Printf("Error: %v", err)
+		return 2
+	}
+	dir, err := parseDirection(*direction)
+	if err != nil {
+		log.Printf("Error: %v", err)
+		return 2
+	}
+	req.Direction = &dir
+	if *maxNodes > 0 {
+		req.MaxNodes = func(i int32) *int32 { return &i }(int32(*maxNodes))
+	}
+	if *maxRelations > 0 {
+		req.MaxRelations = func(i int32) *int32 { return &i }(int32(*maxRelations))
+	}
+
+	cfg, err := config.InitConfig(*configPath)
+	if err != nil {
+		log.Printf("Error: 加载配置失败: %v", err)
+		return 1
+	}
+	logger := bootstrap.InitLogger(cfg.Logging.Level)
+	defer logger.Sync()
+
+	driver, err := bootstrap.InitDatabase(logger, &cfg.Database.Neo4j)
+	if err != nil {
+		log.Printf("Error: 初始化 Neo4j 失败: %v", err)
+		return 1
+	}
+	defer driver.Close(context.Background())
+	nodeDAL, relationDAL := bootstrap.InitDALs(logger)
+	store := storage.NewNeo4jStore(driver, nodeDAL, relationDAL, neo4jdal.NewTypeDAL())
+
+	// 类型过滤可能引用动态类型，需在加载注册表后解析
+	typeRepo, err := bootstrap.InitTypeRegistry(logger, store)
+	if err != nil {
+		log.Printf("Error: 加载类型注册表失败: %v", err)
+		return 1
+	}
+	if req.RelationTypes, err = parseRelationTypes(*relationTypes); err != nil {
+		log.Printf("Error: %v", err)
+		return 2
+	}
+	if req.NodeTypes, err = parseNodeTypes(*nodeTypes); err != nil {
+		log.Printf("Error: %v", err)
+		return 2
+	}
+	if req.RelationDirections, err = parseRelationDirections(*relationDirections); err != nil {
+		log.Printf("Error: %v", err)
+		return 2
+	}
+
+	// 导出不走缓存，NodeRepository 不需要缓存和 RelationRepository
+	nodeRepo := neo4jrepo.NewNodeRepository(store, nil, nil, 0, 0, 0, 0,
+		cfg.Repo.QueryParams.GetNetworkMaxDepth, cfg.Repo.QueryParams.GetPathMaxDepth,
+		cfg.Repo.QueryParams.GetPathMaxDepthLimit, cfg.Repo.QueryParams.SearchNodesDefaultLimit, logger)
+	svc := service.NewNetworkService(nodeRepo, nil, typeRepo, logger)
+
+	resp, err := svc.ExportNetwork(context.Background(), req)
+	if err != nil {
+		log.Printf("Error: %v", err)
+		return 1
+	}
+	if !resp.Success {
+		log.Printf("Error: %s", resp.Message)
+		return 2
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			log.Printf("Error: 创建输出文件失败: %v", err)
+			return 1
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := graphexport.Write(w, format, &graphexport.Graph{Nodes: resp.Nodes, Relations: resp.Relations, Truncated: resp.Truncated}); err != nil {
+		log.Printf("Error: 写入导出结果失败: %v", err)
+		return 1
+	}
+	log.Printf("Info: 导出完成: %d 个节点，%d 条关系 (truncated=%t)", len(resp.Nodes), len(resp.Relations), resp.Truncated)
+	return 0
+}
+
+// parseCriteria 解析 key=value,key2=value2 形式的起始节点条件
+func parseCriteria(s string) (map[string]string, error) {
+	criteria := map[string]string{}
+	if strings.TrimSpace(s) == "" {
+		return criteria, nil
+	}
+	for _, pair := range strings.Split(s, ",") {
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(k) == "" {
+			return nil, fmt.Errorf("无效的起始节点条件 %q (格式: key=value)", pair)
+		}
+		criteria[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	return criteria, nil
+}
+
+// parseNodeTypes 解析逗号分隔的节点类型名
+func parseNodeTypes(s string) ([]network.NodeType, error) {
+	var types []network.NodeType
+	for _, name := range strings.Split(s, ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		t, ok := typeregistry.Default().NodeTypeByName(strings.ToUpper(name))
+		if !ok {
+			return nil, fmt.Errorf("无效的节点类型 %q", name)
+		}
+		types = append(types, t)
+	}
+	return types, nil
+}
+
+// parseRelationTypes 解析逗号分隔的关系类型名
+func parseRelationTypes(s string) ([]network.RelationType, error) {
+	var types []network.RelationType
+	for _, name := range strings.Split(s, ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		t, ok := typeregistry.Default().RelationTypeByName(strings.ToUpper(name))
+		if !ok {
+			return nil, fmt.Errorf("无效的关系类型 %q", name)
+		}
+		types = append(types, t)
+	}
+	return types, nil
+}
+
+// parseDirection 解析关系方向名 (不区分大小写)
+func parseDirection(s string) (network.Direction, error) {
+	d, err := network.DirectionFromString(strings.ToUpper(strings.TrimSpace(s)))
+	if err != nil {
+		return 0, fmt.Errorf("无效的关系方向 %q (可选: both, outgoing, incoming)", s)
+	}
+	return d, nil
+}
+
+// parseRelationDirections 解析 TYPE=direction,TYPE2=direction2 形式的按关系类型覆盖的方向
+func parseRelationDirections(s string) ([]*network.RelationDirection, error) {
+	var out []*network.RelationDirection
+	for _, pair := range strings.Split(s, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		name, dirName, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("无效的关系方向覆盖 %q (格式: TYPE=direction)", pair)
+		}
+		t, ok := typeregistry.Default().RelationTypeByName(strings.ToUpper(strings.TrimSpace(name)))
+		if !ok {
+			return nil, fmt.Errorf("无效的关系类型 %q", name)
+		}
+		d, err := parseDirection(dirName)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &network.RelationDirection{Type: t, Direction: d})
+	}
+	return out, nil
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"labelwall/biz/dal/neo4jdal"
+	"labelwall/biz/dal/storage"
+	"labelwall/internal/bootstrap"
+	"labelwall/internal/importer"
+	"labelwall/pkg/config"
+
+	"go.uber.org/zap"
+)
+
+// runImport 实现 `labelwall import` 子命令，返回进程退出码
+func runImport(args []string) int {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	configPath := fs.String("config", "./config.yaml", "配置文件路径 (使用其中的 Neo4j 连接配置)")
+	nodesPath := fs.String("nodes", "", "节点文件 (.csv / .jsonl)")
+	relationsPath := fs.String("relations", "", "关系文件 (.csv / .jsonl)，在节点之后导入")
+	formatName := fs.String("format", "", "输入格式 csv 或 jsonl，默认按扩展名识别")
+	nodeMap := fs.String("node-map", "", "节点字段到列名的映射，如 name=full_name,type=kind")
+	relationMap := fs.String("relation-map", "", "关系字段到列名的映射，如 source=from,target=to")
+	batchSize := fs.Int("batch-size", importer.DefaultBatchSize, "每个写事务的记录数")
+	checkpointPath := fs.String("checkpoint", ".labelwall-import.checkpoint.json", "检查点文件路径，为空则不记录")
+	restart := fs.Bool("restart", false, "忽略已有检查点，从头导入")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "用法: labelwall import [选项] -nodes nodes.csv -relations relations.jsonl")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	format, err := importer.ParseFormat(*formatName)
+	if err != nil {
+		log.Printf("Error: %v", err)
+		return 2
+	}
+	nodeMapping, err := importer.ParseNodeMapping(*nodeMap)
+	if err != nil {
+		log.Printf("Error: %v", err)
+		return 2
+	}
+	relationMapping, err := importer.ParseRelationMapping(*relationMap)
+	if err != nil {
+		log.Printf("Error: %v", err)
+		return 2
+	}
+
+	cfg, err := config.InitConfig(*configPath)
+	if err != nil {
+		log.Printf("Error: 加载配置失败: %v", err)
+		return 1
+	}
+	logger := bootstrap.InitLogger(cfg.Logging.Level)
+	defer logger.Sync()
+
+	driver, err := bootstrap.InitDatabase(logger, &cfg.Database.Neo4j)
+	if err != nil {
+		log.Printf("Error: 初始化 Neo4j 失败: %v", err)
+		return 1
+	}
+	defer driver.Close(context.Background())
+	nodeDAL, relationDAL := bootstrap.InitDALs(logger)
+
+	// 映射中的类型可能是动态类型，导入前先加载类型注册表
+	store := storage.NewNeo4jStore(driver, nodeDAL, relationDAL, neo4jdal.NewTypeDAL())
+	if _, err := bootstrap.InitTypeRegistry(logger, store); err != nil {
+		log.Printf("Error: 加载类型注册表失败: %v", err)
+		return 1
+	}
+
+	// Ctrl+C 时停止读取新批次，已提交的批次保留在检查点中
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	stats, err := importer.NewImporter(driver, nodeDAL, relationDAL, logger).Run(ctx, importer.Options{
+		NodesPath:       *nodesPath,
+		RelationsPath:   *relationsPath,
+		Format:          format,
+		NodeMapping:     nodeMapping,
+		RelationMapping: relationMapping,
+		BatchSize:       *batchSize,
+		CheckpointPath:  *checkpointPath,
+		Restart:         *restart,
+		// 与服务相同: 启用 RabbitMQ 时写入发件箱，由服务的 relay 发布，各实例据此使缓存失效
+		Outbox: cfg.RabbitMQ.Enabled,
+	})
+	if stats != nil {
+		logger.Info("导入统计",
+			zap.Int64("nodesCreated", stats.NodesCreated), zap.Int64("nodesSkipped", stats.NodesSkipped),
+			zap.Int64("relationsCreated", stats.RelationsCreated), zap.Int64("relationsSkipped", stats.RelationsSkipped))
+		log.Printf("Info: 节点创建 %d 个 (跳过 %d)，关系创建 %d 条 (跳过 %d)",
+			stats.NodesCreated, stats.NodesSkipped, stats.RelationsCreated, stats.RelationsSkipped)
+	}
+	if err != nil {
+		log.Printf("Error: 导入失败: %v (重新运行相同命令即可从检查点继续)", err)
+		return 1
+	}
+	log.Println("Info: 导入完成.")
+	return 0
+}
@@ -6,8 +6,11 @@ package database
 import (
 	"context"
 	"fmt"
+	"labelwall/biz/dal/neo4jdal"
 	"labelwall/pkg/config" // 确保导入我们修改的配置包
+	"labelwall/pkg/typeregistry"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -80,26 +83,70 @@ func InitNeo4j(cfg *config.Neo4jConfig) (neo4j.DriverWithContext, error) {
 	return driver, nil
 }
 
-// InitRedis 初始化 Redis 客户端连接
-func InitRedis(cfg *config.RedisConfig) (*redis.Client, error) {
+// NewRedisClient 按 cfg.Mode 创建 Redis 客户端 (单机、Sentinel 或 Cluster)，不检查连接
+func NewRedisClient(cfg *config.RedisConfig) (redis.UniversalClient, error) {
 	if cfg == nil {
 		return nil, fmt.Errorf("Redis 配置不能为空")
 	}
-	rdb := redis.NewClient(&redis.Options{
-		Addr:     cfg.Addr,
-		Password: cfg.Password, // no password set
-		DB:       cfg.DB,       // use default DB
-	})
+	switch cfg.Mode {
+	case "", config.RedisModeStandalone:
+		return redis.NewClient(&redis.Options{
+			Addr:     cfg.Addr,
+			Password: cfg.Password,
+			DB:       cfg.DB,
+		}), nil
+	case config.RedisModeSentinel:
+		if cfg.MasterName == "" || len(cfg.Addrs) == 0 {
+			return nil, fmt.Errorf("Redis sentinel 模式需要配置 master_name 和 addrs")
+		}
+		return redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:       cfg.MasterName,
+			SentinelAddrs:    cfg.Addrs,
+			SentinelPassword: cfg.SentinelPassword,
+			Password:         cfg.Password,
+			DB:               cfg.DB,
+		}), nil
+	case config.RedisModeCluster:
+		if len(cfg.Addrs) == 0 {
+			return nil, fmt.Errorf("Redis cluster 模式需要配置 addrs")
+		}
+		if cfg.DB != 0 {
+			return nil, fmt.Errorf("Redis cluster 模式不支持 db %d", cfg.DB)
+		}
+		return redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:    cfg.Addrs,
+			Password: cfg.Password,
+		}), nil
+	default:
+		return nil, fmt.Errorf("未知的 Redis 模式: %q", cfg.Mode)
+	}
+}
+
+// RedisEndpoint 返回用于日志的 Redis 地址描述
+func RedisEndpoint(cfg *config.RedisConfig) string {
+	if cfg.Mode == config.RedisModeSentinel || cfg.Mode == config.RedisModeCluster {
+		return cfg.Mode + ":" + strings.Join(cfg.Addrs, ",")
+	}
+	return cfg.Addr
+}
+
+// InitRedis 初始化 Redis 客户端连接
+func InitRedis(cfg *config.RedisConfig) (redis.UniversalClient, error) {
+	rdb, err := NewRedisClient(cfg)
+	if err != nil {
+		return nil, err
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	status := rdb.Ping(ctx)
 	if err := status.Err(); err != nil {
-		return nil, fmt.Errorf("无法连接到 Redis (%s): %w", cfg.Addr, err)
+		_ = rdb.Close()
+		return nil, fmt.Errorf("无法连接到 Redis (%s): %w", RedisEndpoint(cfg), err)
 	}
 
-	fmt.Printf("成功连接到 Redis (%s)\n", cfg.Addr) // 保留 fmt.Printf
+	fmt.Printf("成功连接到 Redis (%s)\n", RedisEndpoint(cfg)) // 保留 fmt.Printf
 	return rdb, nil
 }
 
@@ -145,21 +192,28 @@ func applyNeo4jSchemaLegacy(ctx context.Context, driver neo4j.DriverWithContext)
 func applyNeo4jSchema(ctx context.Context, session neo4j.SessionWithContext, logger *zap.Logger) error {
 	// 定义要应用的 Schema 查询语句
 	queries := []string{
-		// 节点 ID 唯一性约束 (如果节点类型确定，可以为特定类型创建)
-		// "CREATE CONSTRAINT node_id_unique IF NOT EXISTS FOR (n) REQUIRE n.id IS UNIQUE", // 适用于所有节点
-		"CREATE CONSTRAINT person_id_unique IF NOT EXISTS FOR (p:PERSON) REQUIRE p.id IS UNIQUE",
-		"CREATE CONSTRAINT company_id_unique IF NOT EXISTS FOR (c:COMPANY) REQUIRE c.id IS UNIQUE",
-		"CREATE CONSTRAINT school_id_unique IF NOT EXISTS FOR (s:SCHOOL) REQUIRE s.id IS UNIQUE",
-
-		// 关系 ID 唯一性约束 (Neo4j 默认不支持直接对关系属性加唯一约束，通常关系ID业务生成并确保唯一)
-		// 可以为关系属性创建索引以加速查找
-		"CREATE INDEX relation_id_index IF NOT EXISTS FOR ()-[r:RELATIONSHIP]-() ON (r.id)",
-
 		// 为常用查询字段创建索引
-		"CREATE INDEX person_name_index IF NOT EXISTS FOR (p:PERSON) ON (p.name)",
 		"CREATE INDEX person_profession_index IF NOT EXISTS FOR (p:PERSON) ON (p.profession)",
-		"CREATE INDEX company_name_index IF NOT EXISTS FOR (c:COMPANY) ON (c.name)",
-		"CREATE INDEX school_name_index IF NOT EXISTS FOR (s:SCHOOL) ON (s.name)",
+
+		// 发件箱事件 (relay 按 created_at 顺序读取未发布事件)
+		"CREATE CONSTRAINT outbox_event_id_unique IF NOT EXISTS FOR (o:OutboxEvent) REQUIRE o.event_id IS UNIQUE",
+		"CREATE INDEX outbox_event_created_at_index IF NOT EXISTS FOR (o:OutboxEvent) ON (o.created_at)",
+
+		// 类型注册表 (类型定义按 name 合并写入，编码由计数器节点分配且不能重复)
+		"CREATE CONSTRAINT node_type_def_name_unique IF NOT EXISTS FOR (t:" + neo4jdal.NodeTypeDefLabel + ") REQUIRE t.name IS UNIQUE",
+		"CREATE CONSTRAINT relation_type_def_name_unique IF NOT EXISTS FOR (t:" + neo4jdal.RelationTypeDefLabel + ") REQUIRE t.name IS UNIQUE",
+		"CREATE CONSTRAINT node_type_def_code_unique IF NOT EXISTS FOR (t:" + neo4jdal.NodeTypeDefLabel + ") REQUIRE t.code IS UNIQUE",
+		"CREATE CONSTRAINT relation_type_def_code_unique IF NOT EXISTS FOR (t:" + neo4jdal.RelationTypeDefLabel + ") REQUIRE t.code IS UNIQUE",
+		"CREATE CONSTRAINT type_code_counter_kind_unique IF NOT EXISTS FOR (c:" + neo4jdal.TypeCodeCounterLabel + ") REQUIRE c.kind IS UNIQUE",
+	}
+	// 节点 ID 唯一性约束和 name 索引按已注册的节点类型生成 (动态类型在注册时单独创建)
+	for _, def := range typeregistry.Default().NodeTypes() {
+		queries = append(queries, neo4jdal.NodeLabelSchemaQueries(def.Name)...)
+	}
+	// 关系 ID 索引 (Neo4j 默认不支持直接对关系属性加唯一约束，关系 ID 由业务生成并确保唯一)。
+	// 关系属性索引只服务于带类型的模式，按已注册的关系类型各建一个 (动态类型在注册时单独创建)
+	for _, def := range typeregistry.Default().RelationTypes() {
+		queries = append(queries, neo4jdal.RelationTypeSchemaQueries(def.Name)...)
 	}
 
 	logger.Info("开始应用 Neo4j schema...") // 使用 zap logger
@@ -188,6 +242,28 @@ func applyNeo4jSchema(ctx context.Context, session neo4j.SessionWithContext, log
 		appliedCount++
 	}
 
+	// 全文索引覆盖内置类型和已持久化的动态类型，定义不同时重建 (之后由 SaveNodeTypeDef 维护)
+	typeDAL := neo4jdal.NewTypeDAL()
+	persisted, _, err := typeDAL.ExecListTypeDefs(ctx, session)
+	if err != nil {
+		logger.Error("读取类型定义失败，无法创建全文索引", zap.Error(err))
+		return fmt.Errorf("读取类型定义失败: %w", err)
+	}
+	defs := typeregistry.Default().NodeTypes()
+	for _, def := range persisted {
+		if i := slices.IndexFunc(defs, func(d typeregistry.NodeTypeDef) bool { return d.Name == def.Name }); i >= 0 {
+			defs[i] = def
+		} else {
+			defs = append(defs, def)
+		}
+	}
+	if err := typeDAL.ExecEnsureNodeFulltextIndex(ctx, session, defs); err != nil {
+		logger.Error("创建全文索引失败", zap.Error(err))
+		return err
+	}
+	labels, props := neo4jdal.NodeFulltextIndexSpec(defs)
+	logger.Info("全文索引已就绪", zap.String("index", neo4jdal.NodeFulltextIndex), zap.Strings("labels", labels), zap.Strings("properties", props))
+
 	// 使用 zap logger 替换 fmt.Println
 	logger.Info("Neo4j schema 应用完成", zap.Int("applied_count", appliedCount))
 	return nil
@@ -3,6 +3,7 @@ package rabbitmq
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	amqp "github.com/rabbitmq/amqp091-go"
@@ -29,15 +30,16 @@ type Consumer struct {
 
 // ConsumerOptions 用于配置 Consumer
 type ConsumerOptions struct {
-	ExchangeName string // 必须: 绑定的交换机名称
-	ExchangeType string // 可选: 交换机类型 (direct, topic, fanout, headers), 默认为 "direct"
-	QueueName    string // 必须: 队列名称 (如果为空，将生成一个临时队列名)
-	RoutingKey   string // 必须: 绑定队列到交换机的路由键
-	ConsumerTag  string // 可选: 消费者标签 (如果为空，将生成一个)
-	AutoAck      bool   // 可选: 是否自动确认消息，默认为 false (手动确认)
-	DurableQueue bool   // 可选: 队列是否持久化，默认为 true
-	Exclusive    bool   // 可选: 是否为排他队列，默认为 false
-	NoWait       bool   // 可选: 声明队列和绑定时不等待服务器确认，默认为 false
+	ExchangeName string   // 必须: 绑定的交换机名称
+	ExchangeType string   // 可选: 交换机类型 (direct, topic, fanout, headers), 默认为 "direct"
+	QueueName    string   // 必须: 队列名称 (如果为空，将生成一个临时队列名)
+	RoutingKey   string   // 必须: 绑定队列到交换机的路由键 (与 RoutingKeys 二选一)
+	RoutingKeys  []string // 可选: 需要绑定多个路由键时使用，与 RoutingKey 合并
+	ConsumerTag  string   // 可选: 消费者标签 (如果为空，将生成一个)
+	AutoAck      bool     // 可选: 是否自动确认消息，默认为 false (手动确认)
+	DurableQueue bool     // 可选: 队列是否持久化，默认为 true
+	Exclusive    bool     // 可选: 是否为排他队列，默认为 false
+	NoWait       bool     // 可选: 声明队列和绑定时不等待服务器确认，默认为 false
 }
 
 // NewConsumer 创建一个新的 Consumer 实例并开始消费消息
@@ -97,30 +99,36 @@ func NewConsumer(amqpURL string, handler MessageHandler, opts ConsumerOptions, l
 	actualQueueName := q.Name // 获取实际的队列名 (如果 opts.QueueName 为空，则由服务器生成)
 	logger.Info("RabbitMQ 队列声明成功", zap.String("queue", actualQueueName), zap.Bool("durable", opts.DurableQueue))
 
-	// 将队列绑定到交换机
-	err = ch.QueueBind(
-		actualQueueName,   // queue name
-		opts.RoutingKey,   // routing key
-		opts.ExchangeName, // exchange
-		opts.NoWait,       // no-wait
-		nil,               // arguments
-	)
-	if err != nil {
-		ch.Close()
-		conn.Close()
-		logger.Error("无法将队列绑定到交换机",
+	// 将队列绑定到交换机 (每个路由键绑定一次)
+	routingKeys := opts.RoutingKeys
+	if opts.RoutingKey != "" {
+		routingKeys = append([]string{opts.RoutingKey}, routingKeys...)
+	}
+	for _, routingKey := range routingKeys {
+		err = ch.QueueBind(
+			actualQueueName,   // queue name
+			routingKey,        // routing key
+			opts.ExchangeName, // exchange
+			opts.NoWait,       // no-wait
+			nil,               // arguments
+		)
+		if err != nil {
+			ch.Close()
+			conn.Close()
+			logger.Error("无法将队列绑定到交换机",
+				zap.String("queue", actualQueueName),
+				zap.String("exchange", opts.ExchangeName),
+				zap.String("routingKey", routingKey),
+				zap.Error(err),
+			)
+			return nil, fmt.Errorf("failed to bind queue '%s' to exchange '%s' with key '%s': %w", actualQueueName, opts.ExchangeName, routingKey, err)
+		}
+		logger.Info("RabbitMQ 队列成功绑定到交换机",
 			zap.String("queue", actualQueueName),
 			zap.String("exchange", opts.ExchangeName),
-			zap.String("routingKey", opts.RoutingKey),
-			zap.Error(err),
+			zap.String("routingKey", routingKey),
 		)
-		return nil, fmt.Errorf("failed to bind queue '%s' to exchange '%s' with key '%s': %w", actualQueueName, opts.ExchangeName, opts.RoutingKey, err)
 	}
-	logger.Info("RabbitMQ 队列成功绑定到交换机",
-		zap.String("queue", actualQueueName),
-		zap.String("exchange", opts.ExchangeName),
-		zap.String("routingKey", opts.RoutingKey),
-	)
 
 	consumerTag := opts.ConsumerTag
 	if consumerTag == "" {
@@ -135,7 +143,7 @@ func NewConsumer(amqpURL string, handler MessageHandler, opts ConsumerOptions, l
 		handler:      handler,
 		autoAck:      opts.AutoAck,
 		exchangeName: opts.ExchangeName,
-		routingKey:   opts.RoutingKey,
+		routingKey:   strings.Join(routingKeys, ","),
 		logger:       logger.Named("rabbitmq_consumer").With(zap.String("queue", actualQueueName), zap.String("tag", consumerTag)),
 		done:         make(chan error),
 	}
@@ -11,10 +11,13 @@ import (
 
 // Publisher 结构体用于发布消息
 type Publisher struct {
-	conn         *amqp.Connection
-	channel      *amqp.Channel
-	exchangeName string
-	logger       *zap.Logger
+	conn    *amqp.Connection
+	channel *amqp.Channel
+	// confirmChannel 是 PublishConfirmed 专用的 confirm 模式通道，与 Publish 使用的通道分开，
+	// 避免普通发布占用 confirm 序号、也避免 confirm 模式影响普通发布
+	confirmChannel *amqp.Channel
+	exchangeName   string
+	logger         *zap.Logger
 }
 
 // NewPublisher 创建一个新的 Publisher 实例
@@ -54,11 +57,28 @@ func NewPublisher(amqpURL string, exchangeName string, logger *zap.Logger) (*Pub
 	}
 	logger.Info("RabbitMQ 交换机声明成功", zap.String("exchange", exchangeName), zap.String("type", "direct"))
 
+	// 为 PublishConfirmed 单独打开一个通道并开启 publisher confirms，依赖 broker 的 ack 判断消息是否已持久化
+	confirmCh, err := conn.Channel()
+	if err != nil {
+		ch.Close()
+		conn.Close()
+		logger.Error("无法打开 RabbitMQ confirm 通道", zap.Error(err))
+		return nil, fmt.Errorf("failed to open a confirm channel: %w", err)
+	}
+	if err := confirmCh.Confirm(false); err != nil {
+		confirmCh.Close()
+		ch.Close()
+		conn.Close()
+		logger.Error("无法开启 RabbitMQ publisher confirms", zap.Error(err))
+		return nil, fmt.Errorf("failed to put channel into confirm mode: %w", err)
+	}
+
 	return &Publisher{
-		conn:         conn,
-		channel:      ch,
-		exchangeName: exchangeName,
-		logger:       logger.Named("rabbitmq_publisher"), // 给 logger 一个名字以区分
+		conn:           conn,
+		channel:        ch,
+		confirmChannel: confirmCh,
+		exchangeName:   exchangeName,
+		logger:         logger.Named("rabbitmq_publisher"), // 给 logger 一个名字以区分
 	}, nil
 }
 
@@ -100,8 +120,53 @@ func (p *Publisher) Publish(ctx context.Context, routingKey string, messageBody
 	return nil
 }
 
+// PublishConfirmed 在专用的 confirm 通道上发布已序列化的消息并等待 broker 确认 (publisher confirm)。
+// 只有收到 ack 才返回 nil；nack、通道关闭或 ctx 取消都会返回错误，调用方应重试。
+// messageID 写入 AMQP message-id，消费者可据此去重 (投递语义为至少一次)。
+func (p *Publisher) PublishConfirmed(ctx context.Context, routingKey string, messageID string, body []byte) error {
+	confirmation, err := p.confirmChannel.PublishWithDeferredConfirmWithContext(ctx,
+		p.exchangeName, // exchange
+		routingKey,     // routing key
+		false,          // mandatory
+		false,          // immediate
+		amqp.Publishing{
+			ContentType:  "application/json",
+			MessageId:    messageID,
+			Body:         body,
+			DeliveryMode: amqp.Persistent,
+		},
+	)
+	if err != nil {
+		p.logger.Error("发布消息到 RabbitMQ 失败",
+			zap.String("exchange", p.exchangeName),
+			zap.String("routingKey", routingKey),
+			zap.String("messageId", messageID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to publish message: %w", err)
+	}
+
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to wait for publisher confirm: %w", err)
+	}
+	if !acked {
+		p.logger.Warn("RabbitMQ 拒绝了消息 (nack)",
+			zap.String("routingKey", routingKey),
+			zap.String("messageId", messageID),
+		)
+		return fmt.Errorf("message %s was nacked by broker", messageID)
+	}
+	return nil
+}
+
 // Close 关闭 Publisher 的通道和连接
 func (p *Publisher) Close() {
+	if p.confirmChannel != nil {
+		if err := p.confirmChannel.Close(); err != nil {
+			p.logger.Error("关闭 RabbitMQ confirm 通道失败", zap.Error(err))
+		}
+	}
 	if p.channel != nil {
 		err := p.channel.Close()
 		if err != nil {
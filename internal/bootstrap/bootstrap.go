@@ -7,22 +7,30 @@ import (
 	"time"
 
 	"labelwall/biz/dal/neo4jdal"
+	"labelwall/biz/dal/storage"
 	"labelwall/biz/handler/relationship/network" // 导入 handler 包
 	"labelwall/biz/repo/neo4jrepo"
 	"labelwall/biz/service"
 	dbInfra "labelwall/infrastructure/database" // Alias database package
 	"labelwall/infrastructure/rabbitmq"         // <--- 新增 RabbitMQ 包导入
+	"labelwall/internal/invalidation"
+	"labelwall/internal/outbox"
 	"labelwall/pkg/cache"
 	"labelwall/pkg/config" // 导入配置包
+	"labelwall/pkg/typeregistry"
 
 	"github.com/cloudwego/hertz/pkg/app/server"
 	prometheus "github.com/hertz-contrib/monitor-prometheus" // 新增 Prometheus 监控包导入
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	prom "github.com/prometheus/client_golang/prometheus"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"         // 添加 zap 导入
 	"go.uber.org/zap/zapcore" // <-- Import zapcore for level constants
 )
 
+// 暂时使用一个硬编码的 exchange name，后续可以考虑配置化
+const publisherExchange = "labelwall_exchange"
+
 // Init 函数执行所有应用程序的初始化步骤
 // 返回 Hertz 实例、RabbitMQ Publisher (如果启用) 和错误
 func Init(configPath string) (*server.Hertz, *rabbitmq.Publisher, error) {
@@ -34,30 +42,7 @@ func Init(configPath string) (*server.Hertz, *rabbitmq.Publisher, error) {
 	}
 	log.Println("Info: 配置加载完成.")
 
-	var logger *zap.Logger
-	logLevel := zapcore.InfoLevel
-	switch cfg.Logging.Level {
-	case "debug":
-		logLevel = zapcore.DebugLevel
-	case "info":
-		logLevel = zapcore.InfoLevel
-	case "warn":
-		logLevel = zapcore.WarnLevel
-	case "error":
-		logLevel = zapcore.ErrorLevel
-	default:
-		log.Printf("Warning: 无效的日志级别 '%s' 在配置中，将使用 'info'", cfg.Logging.Level) // 标准 log
-	}
-
-	// 可以根据需要选择 Production 或 Development 配置
-	// Development 模式更适合开发，输出更易读，包括调用者信息
-	encoderConfig := zap.NewDevelopmentEncoderConfig() // Or zap.NewProductionEncoderConfig()
-	core := zapcore.NewCore(
-		zapcore.NewJSONEncoder(encoderConfig),   // Or NewConsoleEncoder
-		zapcore.AddSync(log.Default().Writer()), // Write to standard log output
-		logLevel,
-	)
-	logger = zap.New(core, zap.AddCaller()) // 添加 AddCaller 来显示文件名和行号
+	logger := InitLogger(cfg.Logging.Level)
 
 	defer func(logger *zap.Logger) {
 		err := logger.Sync()
@@ -79,29 +64,29 @@ func Init(configPath string) (*server.Hertz, *rabbitmq.Publisher, error) {
 		// 例如: amqpURL := fmt.Sprintf("%s%s", cfg.RabbitMQ.URL, cfg.RabbitMQ.VHost)
 		// 这里我们假设 cfg.RabbitMQ.URL 已经包含了 VHost (如果需要) 或者 VHost 是默认的 "/"
 
-		// 暂时使用一个硬编码的 exchange name，后续可以考虑配置化
-		exchangeName := "labelwall_exchange"
-
-		publisher, err = rabbitmq.NewPublisher(cfg.RabbitMQ.URL, exchangeName, logger)
+		publisher, err = rabbitmq.NewPublisher(cfg.RabbitMQ.URL, publisherExchange, logger)
 		if err != nil {
 			logger.Error("初始化 RabbitMQ Publisher 失败", zap.Error(err))
 			// 根据策略，这里可以选择返回错误，或者仅记录并继续 (如果 MQ 不是严格必需的)
 			// 为了安全起见，我们返回错误
 			return nil, nil, fmt.Errorf("初始化 RabbitMQ Publisher 失败: %w", err)
 		}
-		logger.Info("RabbitMQ Publisher 初始化成功", zap.String("exchange", exchangeName))
+		logger.Info("RabbitMQ Publisher 初始化成功", zap.String("exchange", publisherExchange))
 	} else {
 		logger.Info("RabbitMQ 未在配置中启用。")
 	}
 
-	// 3.连接数据库
-	driver, err := InitDatabase(logger, &cfg.Database.Neo4j)
-	if err != nil {
-		logger.Error("初始化 Neo4j 失败", zap.Error(err))
-		if publisher != nil { // 如果 publisher 已初始化，尝试关闭
-			publisher.Close()
+	// 3.连接数据库 (内存图后端不需要 Neo4j)
+	var driver neo4j.DriverWithContext
+	if cfg.Database.Backend != storage.BackendMemory {
+		driver, err = InitDatabase(logger, &cfg.Database.Neo4j)
+		if err != nil {
+			logger.Error("初始化 Neo4j 失败", zap.Error(err))
+			if publisher != nil { // 如果 publisher 已初始化，尝试关闭
+				publisher.Close()
+			}
+			return nil, nil, fmt.Errorf("初始化 Neo4j 失败: %w", err)
 		}
-		return nil, nil, fmt.Errorf("初始化 Neo4j 失败: %w", err)
 	}
 	redisClient, err := InitRedis(logger, &cfg.Database.Redis)
 	if err != nil {
@@ -124,16 +109,56 @@ func Init(configPath string) (*server.Hertz, *rabbitmq.Publisher, error) {
 	}
 	logger.Info("缓存初始化完成.")
 
-	// 5. 初始化 DAL
-	nodeDAL, relationDAL := InitDALs(logger)
-	logger.Info("DAL 初始化完成.")
+	// 5. 初始化存储后端
+	store, err := InitStore(logger, cfg.Database.Backend, driver)
+	if err != nil {
+		logger.Error("初始化存储后端失败", zap.Error(err))
+		if publisher != nil {
+			publisher.Close()
+		}
+		return nil, nil, fmt.Errorf("初始化存储后端失败: %w", err)
+	}
+	logger.Info("存储后端初始化完成.")
 
-	// 6. 初始化 Repositories
-	nodeRepo, relationRepo := InitRepositories(logger, driver, appCache, nodeDAL, relationDAL, &cfg.Cache, &cfg.Repo)
+	// 5.1 加载类型注册表 (Thrift 内置类型之外的动态类型保存在存储后端)
+	typeRepo, err := InitTypeRegistry(logger, store)
+	if err != nil {
+		logger.Error("加载类型注册表失败", zap.Error(err))
+		if publisher != nil {
+			publisher.Close()
+		}
+		return nil, nil, fmt.Errorf("加载类型注册表失败: %w", err)
+	}
+
+	// 6. 初始化 Repositories (启用 RabbitMQ 时写操作同事务写入发件箱，发件箱依赖 Neo4j)
+	outboxEnabled := publisher != nil && driver != nil
+	if publisher != nil && !outboxEnabled {
+		logger.Warn("内存图后端不支持发件箱，变更事件不会发布到 RabbitMQ")
+	}
+	var repoOpts []neo4jrepo.RepoOption
+	if outboxEnabled {
+		repoOpts = append(repoOpts, neo4jrepo.WithOutbox())
+	} else {
+		// 没有发件箱 relay 和失效消费者: 写操作提交后在本实例内同步使缓存失效
+		handler, err := newInvalidationHandler(logger, appCache)
+		if err != nil {
+			logger.Error("初始化本地缓存失效失败", zap.Error(err))
+			if publisher != nil {
+				publisher.Close()
+			}
+			return nil, nil, fmt.Errorf("初始化本地缓存失效失败: %w", err)
+		}
+		repoOpts = append(repoOpts, neo4jrepo.WithLocalInvalidation(handler))
+		logger.Info("未启用发件箱，写操作在本地同步使缓存失效 (其他实例的缓存随 TTL 过期)")
+	}
+	if seconds := cfg.Repo.QueryParams.QueryTimeoutSeconds; seconds > 0 {
+		repoOpts = append(repoOpts, neo4jrepo.WithQueryTimeout(time.Duration(seconds)*time.Second))
+	}
+	nodeRepo, relationRepo := InitRepositories(logger, store, appCache, &cfg.Cache, &cfg.Repo, repoOpts...)
 	logger.Info("Repositories 初始化完成.")
 
 	// 7. 初始化 Service
-	networkSvc := InitService(logger, nodeRepo, relationRepo)
+	networkSvc := InitService(logger, nodeRepo, relationRepo, typeRepo)
 	logger.Info("Service 初始化完成.")
 
 	// 8. 注入依赖到 Handler
@@ -141,18 +166,88 @@ func Init(configPath string) (*server.Hertz, *rabbitmq.Publisher, error) {
 	logger.Info("依赖注入 Handler 完成.")
 
 	// 9. 初始化 Hertz 服务器 (不包括路由注册)
+	registry := prom.NewRegistry()
+	RegisterCacheMetrics(registry, appCache)
 	h := server.New(
 		server.WithHostPorts(cfg.Server.Address),
-		// 添加 Prometheus Tracer
-		server.WithTracer(prometheus.NewServerTracer(":9091", "/metrics")),
+		// 添加 Prometheus Tracer (与缓存指标共用同一个 registry)
+		server.WithTracer(prometheus.NewServerTracer(":9091", "/metrics", prometheus.WithRegistry(registry))),
 		// 添加其他 Hertz 服务器配置 (例如 From կոնֆիգ)
 	)
 	logger.Info("Hertz 服务器实例创建完成.")
 	logger.Info("Prometheus metrics 将在 :9091/metrics 路径暴露.")
 
+	// 9.1 定期重新加载类型注册表，同步其他实例上的类型变更，服务器关闭时停止
+	stopTypeReload := StartTypeRegistryReload(logger, typeRepo, time.Duration(cfg.Repo.TypeReloadIntervalSeconds)*time.Second)
+	h.OnShutdown = append(h.OnShutdown, func(ctx context.Context) {
+		stopTypeReload()
+	})
+
+	// 10. 定期同步/重建共享布隆过滤器，服务器关闭时停止
+	remoteCache := appCache
+	if layeredCache, ok := appCache.(*cache.LayeredCache); ok {
+		remoteCache = layeredCache.Remote()
+	}
+	if redisCache, ok := remoteCache.(*cache.RedisCache); ok {
+		stopBloom := StartBloomMaintenance(logger, redisCache, &cfg.Cache.Bloom)
+		h.OnShutdown = append(h.OnShutdown, func(ctx context.Context) {
+			stopBloom()
+		})
+	}
+
+	// 11. 启动发件箱 relay (仅在 RabbitMQ 启用且使用 Neo4j 时)，服务器关闭时停止
+	if outboxEnabled {
+		relay := InitOutboxRelay(logger, driver, publisher, &cfg.RabbitMQ.Outbox)
+		relay.Start()
+		h.OnShutdown = append(h.OnShutdown, func(ctx context.Context) {
+			relay.Stop()
+		})
+
+		// 12. 消费变更事件，使本实例可见的缓存失效
+		consumer, err := InitCacheInvalidation(logger, cfg.RabbitMQ.URL, publisherExchange, appCache)
+		if err != nil {
+			logger.Error("初始化缓存失效消费者失败", zap.Error(err))
+			relay.Stop()
+			publisher.Close()
+			return nil, nil, fmt.Errorf("初始化缓存失效消费者失败: %w", err)
+		}
+		h.OnShutdown = append(h.OnShutdown, func(ctx context.Context) {
+			if err := consumer.Shutdown(); err != nil {
+				logger.Warn("关闭缓存失效消费者失败", zap.Error(err))
+			}
+		})
+	}
+
 	return h, publisher, nil // 返回 Hertz 实例、publisher 和 nil 错误
 }
 
+// InitLogger 根据配置的日志级别创建 zap Logger，无效级别回退为 info
+func InitLogger(level string) *zap.Logger {
+	logLevel := zapcore.InfoLevel
+	switch level {
+	case "debug":
+		logLevel = zapcore.DebugLevel
+	case "info":
+		logLevel = zapcore.InfoLevel
+	case "warn":
+		logLevel = zapcore.WarnLevel
+	case "error":
+		logLevel = zapcore.ErrorLevel
+	default:
+		log.Printf("Warning: 无效的日志级别 '%s' 在配置中，将使用 'info'", level) // 标准 log
+	}
+
+	// 可以根据需要选择 Production 或 Development 配置
+	// Development 模式更适合开发，输出更易读，包括调用者信息
+	encoderConfig := zap.NewDevelopmentEncoderConfig() // Or zap.NewProductionEncoderConfig()
+	core := zapcore.NewCore(
+		zapcore.NewJSONEncoder(encoderConfig),   // Or NewConsoleEncoder
+		zapcore.AddSync(log.Default().Writer()), // Write to standard log output
+		logLevel,
+	)
+	return zap.New(core, zap.AddCaller()) // 添加 AddCaller 来显示文件名和行号
+}
+
 // InitDatabase 初始化 Neo4j 数据库连接
 func InitDatabase(logger *zap.Logger, cfg *config.Neo4jConfig) (neo4j.DriverWithContext, error) {
 	driver, err := neo4j.NewDriverWithContext(
@@ -186,30 +281,157 @@ func InitDatabase(logger *zap.Logger, cfg *config.Neo4jConfig) (neo4j.DriverWith
 	return driver, nil
 }
 
-// InitRedis 初始化 Redis 连接
-func InitRedis(logger *zap.Logger, cfg *config.RedisConfig) (*redis.Client, error) {
-	redisClient := redis.NewClient(&redis.Options{
-		Addr:     cfg.Addr,
-		Password: cfg.Password,
-		DB:       cfg.DB,
-	})
+// InitRedis 初始化 Redis 连接，按配置使用单机、Sentinel 或 Cluster 客户端
+func InitRedis(logger *zap.Logger, cfg *config.RedisConfig) (redis.UniversalClient, error) {
+	redisClient, err := dbInfra.NewRedisClient(cfg)
+	if err != nil {
+		return nil, err
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	if _, err := redisClient.Ping(ctx).Result(); err != nil {
+		_ = redisClient.Close()
 		return nil, fmt.Errorf("Redis 连接 Ping 失败: %w", err)
 	}
-	logger.Info("成功连接到 Redis", zap.String("address", cfg.Addr))
+	logger.Info("成功连接到 Redis", zap.String("mode", cfg.Mode), zap.String("address", dbInfra.RedisEndpoint(cfg)))
 	return redisClient, nil
 }
 
 // InitCache 初始化应用缓存
-func InitCache(logger *zap.Logger, redisClient *redis.Client, cfg *config.CacheConfig) (cache.NodeAndByteCache, error) {
+func InitCache(logger *zap.Logger, redisClient redis.UniversalClient, cfg *config.CacheConfig) (cache.NodeAndByteCache, error) {
 	redisCache, err := cache.NewRedisCache(redisClient, cfg.Prefix, cfg.EstimatedKeys, cfg.FpRate)
 	if err != nil {
 		return nil, fmt.Errorf("创建 Redis 缓存实例失败: %w", err)
 	}
 	logger.Info("Redis 缓存实例创建成功")
-	return redisCache, nil
+
+	// 恢复其他实例或重启前写入 Redis 的布隆过滤器位图。失败时从空过滤器开始，只会多查询数据库。
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := redisCache.SyncBloomFilter(ctx); err != nil {
+		logger.Warn("加载共享布隆过滤器失败，使用空过滤器", zap.Error(err))
+	} else {
+		logger.Info("共享布隆过滤器已加载")
+	}
+
+	if !cfg.L1.Enabled {
+		return redisCache, nil
+	}
+	layeredCache, err := cache.NewLayeredCache(redisCache, cfg.L1.MaxEntries, time.Duration(cfg.L1.TTLSeconds)*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("创建 L1 缓存失败: %w", err)
+	}
+	logger.Info("L1 缓存已启用", zap.Int("maxEntries", cfg.L1.MaxEntries), zap.Int("ttlSeconds", cfg.L1.TTLSeconds))
+	return layeredCache, nil
+}
+
+// RegisterCacheMetrics 将 L1 缓存的命中统计注册到 Prometheus，未启用 L1 时不注册
+func RegisterCacheMetrics(registry *prom.Registry, appCache cache.NodeAndByteCache) {
+	layeredCache, ok := appCache.(*cache.LayeredCache)
+	if !ok {
+		return
+	}
+	stat := func(f func(cache.L1Stats) float64) func() float64 {
+		return func() float64 { return f(layeredCache.Stats()) }
+	}
+	registry.MustRegister(
+		prom.NewCounterFunc(prom.CounterOpts{Name: "labelwall_cache_l1_hits_total", Help: "L1 cache hits."},
+			stat(func(s cache.L1Stats) float64 { return float64(s.Hits) })),
+		prom.NewCounterFunc(prom.CounterOpts{Name: "labelwall_cache_l1_misses_total", Help: "L1 cache misses."},
+			stat(func(s cache.L1Stats) float64 { return float64(s.Misses) })),
+		prom.NewCounterFunc(prom.CounterOpts{Name: "labelwall_cache_l1_evictions_total", Help: "L1 cache entries evicted by the size limit."},
+			stat(func(s cache.L1Stats) float64 { return float64(s.Evictions) })),
+		prom.NewGaugeFunc(prom.GaugeOpts{Name: "labelwall_cache_l1_entries", Help: "L1 cache entries."},
+			stat(func(s cache.L1Stats) float64 { return float64(s.Entries) })),
+	)
+}
+
+const (
+	defaultBloomSyncInterval    = 30 * time.Second
+	defaultBloomRebuildInterval = time.Hour
+)
+
+// StartBloomMaintenance 在后台定期同步和重建共享布隆过滤器，返回停止函数。
+// 重建通过 Redis 锁保证同一时间只有一个实例执行，其他实例在下次同步时获得新位图。
+func StartBloomMaintenance(logger *zap.Logger, redisCache *cache.RedisCache, cfg *config.BloomConfig) (stop func()) {
+	syncInterval := time.Duration(cfg.SyncIntervalSeconds) * time.Second
+	if syncInterval <= 0 {
+		syncInterval = defaultBloomSyncInterval
+	}
+	rebuildInterval := time.Duration(cfg.RebuildIntervalMinutes) * time.Minute
+	if rebuildInterval <= 0 {
+		rebuildInterval = defaultBloomRebuildInterval
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		syncTicker := time.NewTicker(syncInterval)
+		defer syncTicker.Stop()
+		rebuildTicker := time.NewTicker(rebuildInterval)
+		defer rebuildTicker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-syncTicker.C:
+				if err := redisCache.SyncBloomFilter(ctx); err != nil && ctx.Err() == nil {
+					logger.Warn("同步共享布隆过滤器失败", zap.Error(err))
+				}
+			case <-rebuildTicker.C:
+				start := time.Now()
+				rebuilt, err := redisCache.RebuildBloomFilter(ctx, rebuildInterval/2)
+				switch {
+				case err != nil && ctx.Err() == nil:
+					logger.Warn("重建共享布隆过滤器失败", zap.Error(err))
+				case rebuilt:
+					logger.Info("共享布隆过滤器已重建", zap.Duration("elapsed", time.Since(start)))
+				}
+			}
+		}
+	}()
+	logger.Info("共享布隆过滤器维护已启动",
+		zap.Duration("syncInterval", syncInterval),
+		zap.Duration("rebuildInterval", rebuildInterval),
+	)
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+const defaultTypeReloadInterval = 30 * time.Second
+
+// StartTypeRegistryReload 在后台定期从存储重新加载类型注册表，返回停止函数。
+// 类型变更只写入发起请求的实例的注册表，其他实例在下次加载时看到新类型。
+func StartTypeRegistryReload(logger *zap.Logger, typeRepo neo4jrepo.TypeRepository, interval time.Duration) (stop func()) {
+	if interval <= 0 {
+		interval = defaultTypeReloadInterval
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := typeRepo.LoadTypes(ctx); err != nil && ctx.Err() == nil {
+					logger.Warn("重新加载类型注册表失败", zap.Error(err))
+				}
+			}
+		}
+	}()
+	logger.Info("类型注册表定期加载已启动", zap.Duration("interval", interval))
+	return func() {
+		cancel()
+		<-done
+	}
 }
 
 // InitDALs 初始化数据访问层
@@ -220,15 +442,44 @@ func InitDALs(logger *zap.Logger) (neo4jdal.NodeDAL, neo4jdal.RelationDAL) {
 	return nodeDAL, relationDAL
 }
 
+// InitStore 按配置创建图存储后端，backend 为空时使用 Neo4j
+func InitStore(logger *zap.Logger, backend string, driver neo4j.DriverWithContext) (storage.GraphStore, error) {
+	switch backend {
+	case "", storage.BackendNeo4j:
+		nodeDAL, relationDAL := InitDALs(logger)
+		logger.Info("使用 Neo4j 存储后端")
+		return storage.NewNeo4jStore(driver, nodeDAL, relationDAL, neo4jdal.NewTypeDAL()), nil
+	case storage.BackendMemory:
+		logger.Warn("使用内存图存储后端，数据仅保存在进程内，重启后丢失")
+		return storage.NewMemoryStore(), nil
+	default:
+		return nil, fmt.Errorf("未知的存储后端: %s", backend)
+	}
+}
+
+// InitTypeRegistry 从存储后端加载类型定义到进程级注册表，返回用于管理接口的 TypeRepository
+func InitTypeRegistry(logger *zap.Logger, store storage.TypeStore) (neo4jrepo.TypeRepository, error) {
+	typeRepo := neo4jrepo.NewTypeRepository(store, typeregistry.Default(), logger)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := typeRepo.LoadTypes(ctx); err != nil {
+		return nil, err
+	}
+	registry := typeregistry.Default()
+	logger.Info("类型注册表加载完成",
+		zap.Int("nodeTypes", len(registry.NodeTypes())),
+		zap.Int("relationTypes", len(registry.RelationTypes())))
+	return typeRepo, nil
+}
+
 // InitRepositories 初始化仓库层
 func InitRepositories(
 	logger *zap.Logger, // 添加 logger 参数
-	driver neo4j.DriverWithContext,
+	store storage.GraphStore,
 	appCache cache.NodeAndByteCache,
-	nodeDAL neo4jdal.NodeDAL,
-	relationDAL neo4jdal.RelationDAL,
 	cacheCfg *config.CacheConfig,
 	repoCfg *config.RepoConfig,
+	repoOpts ...neo4jrepo.RepoOption,
 ) (neo4jrepo.NodeRepository, neo4jrepo.RelationRepository) {
 	relationCache, okRel := appCache.(cache.RelationAndByteCache)
 	if !okRel {
@@ -240,18 +491,17 @@ func InitRepositories(
 	}
 
 	relationRepo := neo4jrepo.NewRelationRepository(
-		driver,
-		relationDAL,
+		store,
 		relationCache,
 		cacheCfg.TTL.DefaultRelation,
 		cacheCfg.TTL.GetNodeRelations,
 		logger,
+		repoOpts...,
 	)
 	logger.Info("RelationRepository 创建成功")
 
 	nodeRepo := neo4jrepo.NewNodeRepository(
-		driver,
-		nodeDAL,
+		store,
 		nodeCache,
 		relationRepo,
 		cacheCfg.TTL.DefaultNode,
@@ -263,15 +513,59 @@ func InitRepositories(
 		repoCfg.QueryParams.GetPathMaxDepthLimit,
 		repoCfg.QueryParams.SearchNodesDefaultLimit,
 		logger,
+		repoOpts...,
 	)
 	logger.Info("NodeRepository 创建成功")
 
 	return nodeRepo, relationRepo
 }
 
+// InitOutboxRelay 创建发件箱 relay，将变更事件发布到 RabbitMQ
+func InitOutboxRelay(logger *zap.Logger, driver neo4j.DriverWithContext, publisher *rabbitmq.Publisher, cfg *config.OutboxConfig) *outbox.Relay {
+	outboxRepo := neo4jrepo.NewOutboxRepository(driver, neo4jdal.NewOutboxDAL(), logger)
+	relay := outbox.NewRelay(outboxRepo, publisher, outbox.Options{
+		PollInterval: time.Duration(cfg.PollIntervalMs) * time.Millisecond,
+		BatchSize:    int64(cfg.BatchSize),
+		MaxBackoff:   time.Duration(cfg.MaxBackoffSeconds) * time.Second,
+		Retention:    time.Duration(cfg.RetentionHours) * time.Hour,
+	}, logger)
+	logger.Info("Outbox relay 创建成功")
+	return relay
+}
+
+// InitCacheInvalidation 启动缓存失效消费者。
+// 每个实例声明一个服务器命名的排他队列并绑定全部变更事件，连接关闭时队列自动删除。
+func InitCacheInvalidation(logger *zap.Logger, amqpURL string, exchangeName string, appCache cache.NodeAndByteCache) (*rabbitmq.Consumer, error) {
+	handler, err := newInvalidationHandler(logger, appCache)
+	if err != nil {
+		return nil, err
+	}
+	consumer, err := rabbitmq.NewConsumer(amqpURL, handler.Handle, rabbitmq.ConsumerOptions{
+		ExchangeName: exchangeName,
+		RoutingKeys:  invalidation.RoutingKeys,
+		DurableQueue: false,
+		Exclusive:    true,
+	}, logger)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("缓存失效消费者启动成功", zap.Strings("routingKeys", invalidation.RoutingKeys))
+	return consumer, nil
+}
+
+// newInvalidationHandler 创建缓存失效处理器，失效消费者和本地同步失效共用
+func newInvalidationHandler(logger *zap.Logger, appCache cache.NodeAndByteCache) (*invalidation.Handler, error) {
+	relationCache, okRel := appCache.(cache.RelationCache)
+	index, okIndex := appCache.(cache.NodeKeyIndex)
+	if !okRel || !okIndex {
+		return nil, fmt.Errorf("缓存实现不支持 RelationCache 或 NodeKeyIndex 接口")
+	}
+	return invalidation.NewHandler(appCache, relationCache, index, logger), nil
+}
+
 // InitService 初始化服务层
-func InitService(logger *zap.Logger, nodeRepo neo4jrepo.NodeRepository, relationRepo neo4jrepo.RelationRepository) service.NetworkService {
-	networkSvc := service.NewNetworkService(nodeRepo, relationRepo, logger)
+func InitService(logger *zap.Logger, nodeRepo neo4jrepo.NodeRepository, relationRepo neo4jrepo.RelationRepository, typeRepo neo4jrepo.TypeRepository) service.NetworkService {
+	networkSvc := service.NewNetworkService(nodeRepo, relationRepo, typeRepo, logger)
 	logger.Info("NetworkService 创建成功")
 	return networkSvc
 }
@@ -0,0 +1,98 @@
+package importer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Checkpoint 记录每个输入文件已提交的记录数，导入失败后可从断点继续。
+// 每个批次的写事务提交后才更新检查点，因此重新运行不会重复处理已记录的批次；
+// 提交后、写检查点前中断的批次会被再次写入，由按 id 的 MERGE 保证不产生重复数据。
+type Checkpoint struct {
+	path  string
+	Files map[string]*FileProgress `json:"files"`
+}
+
+// FileProgress 单个输入文件的导入进度
+type FileProgress struct {
+	Size    int64 `json:"size"`    // 文件大小，用于发现文件在两次运行之间被修改
+	Records int64 `json:"records"` // 已处理 (写入或跳过) 的记录数
+	Done    bool  `json:"done"`
+}
+
+// LoadCheckpoint 读取检查点文件，文件不存在时返回空检查点
+func LoadCheckpoint(path string) (*Checkpoint, error) {
+	cp := &Checkpoint{path: path, Files: map[string]*FileProgress{}}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return cp, nil
+		}
+		return nil, fmt.Errorf("读取检查点文件失败: %w", err)
+	}
+	if err := json.Unmarshal(data, cp); err != nil {
+		return nil, fmt.Errorf("解析检查点文件失败: %w", err)
+	}
+	if cp.Files == nil {
+		cp.Files = map[string]*FileProgress{}
+	}
+	return cp, nil
+}
+
+// progress 返回指定输入文件的进度，key 由文件角色和绝对路径组成。
+// 文件大小与记录不一致时返回错误，避免从错误的位置继续。
+func (c *Checkpoint) progress(key string, size int64) (*FileProgress, error) {
+	p, ok := c.Files[key]
+	if !ok {
+		p = &FileProgress{Size: size}
+		c.Files[key] = p
+		return p, nil
+	}
+	if p.Size != size {
+		return nil, fmt.Errorf("输入文件 %s 自上次导入后已被修改 (检查点大小 %d，当前 %d)，请使用 -restart 重新导入", key, p.Size, size)
+	}
+	return p, nil
+}
+
+// Save 原子地写入检查点文件 (先写临时文件再重命名)
+func (c *Checkpoint) Save() error {
+	if c.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("序列化检查点失败: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(c.path), filepath.Base(c.path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("创建临时检查点文件失败: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("写入检查点失败: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("写入检查点失败: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), c.path); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("替换检查点文件失败: %w", err)
+	}
+	return nil
+}
+
+// Remove 删除检查点文件，导入全部完成后调用
+func (c *Checkpoint) Remove() error {
+	if c.path == "" {
+		return nil
+	}
+	if err := os.Remove(c.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("删除检查点文件失败: %w", err)
+	}
+	return nil
+}
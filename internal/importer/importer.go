@@ -0,0 +1,347 @@
+// Package importer 实现 `labelwall import` 子命令：从 CSV / JSON Lines 文件批量导入节点和关系。
+//
+// 节点文件列: id, type, name, avatar, profession，其余列 (或 properties.<key> 列、JSONL 中的 properties 对象)
+// 写入节点 properties。关系文件列: id, source, target, type, label，其余同上。
+// 写入直接通过 DAL 的批量接口按 id MERGE 并按批提交，每批提交后更新检查点，失败后重新运行即可从断点继续；
+// 提交后、写检查点前中断时重新运行会再次写入最后一批，MERGE 保证不会产生重复数据。
+// 启用发件箱时，每批在同一事务中为本次新建的节点和关系写入 created 事件，与 API 写入一样触发缓存失效。
+package importer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"labelwall/biz/dal/neo4jdal"
+
+	"github.com/google/uuid"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"go.uber.org/zap"
+)
+
+// DefaultBatchSize 默认每个写事务包含的记录数
+const DefaultBatchSize = 500
+
+// Options 导入参数
+type Options struct {
+	NodesPath       string        // 节点文件路径，可为空
+	RelationsPath   string        // 关系文件路径，可为空
+	Format          Format        // 为空时按扩展名识别
+	NodeMapping     ColumnMapping // 节点字段 -> 列名
+	RelationMapping ColumnMapping // 关系字段 -> 列名
+	BatchSize       int
+	CheckpointPath  string // 为空时不记录检查点
+	Restart         bool   // 忽略已有检查点，从头导入
+	Outbox          bool   // 为新建的节点和关系写入发件箱事件 (服务启用 RabbitMQ 时需要)
+}
+
+// Stats 导入结果统计，已存在 (之前的运行中已写入) 的记录计入 Skipped
+type Stats struct {
+	NodesCreated     int64
+	NodesSkipped     int64
+	RelationsCreated int64
+	RelationsSkipped int64
+}
+
+// Importer 负责将输入文件写入 Neo4j
+type Importer struct {
+	driver      neo4j.DriverWithContext
+	nodeDAL     neo4jdal.NodeDAL
+	relationDAL neo4jdal.RelationDAL
+	logger      *zap.Logger
+}
+
+// NewImporter 创建 Importer 实例
+func NewImporter(driver neo4j.DriverWithContext, nodeDAL neo4jdal.NodeDAL, relationDAL neo4jdal.RelationDAL, logger *zap.Logger) *Importer {
+	return &Importer{
+		driver:      driver,
+		nodeDAL:     nodeDAL,
+		relationDAL: relationDAL,
+		logger:      logger,
+	}
+}
+
+// batchWriter 写入一批记录，返回成功创建与跳过的数量。
+// 返回 error 表示整批未提交，导入中止。
+type batchWriter func(ctx context.Context, records []numberedRecord) (created, skipped int64, err error)
+
+// numberedRecord 输入记录及其在文件中的序号 (从 1 开始，不含表头和空行)
+type numberedRecord struct {
+	file   string // 文件角色和绝对路径，与检查点中的键相同
+	num    int64
+	fields map[string]string
+}
+
+// fallbackID 返回记录缺少 id 列时使用的 ID，由文件和记录序号确定，重新运行同一文件时保持不变
+func (r numberedRecord) fallbackID() string {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(fmt.Sprintf("%s#%d", r.file, r.num))).String()
+}
+
+// Run 先导入节点文件，再导入关系文件 (关系通常引用节点文件中的 id)。
+// 全部完成后删除检查点文件。
+func (im *Importer) Run(ctx context.Context, opts Options) (*Stats, error) {
+	if opts.NodesPath == "" && opts.RelationsPath == "" {
+		return nil, fmt.Errorf("至少需要指定节点文件或关系文件")
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultBatchSize
+	}
+
+	cp := &Checkpoint{path: opts.CheckpointPath, Files: map[string]*FileProgress{}}
+	if opts.CheckpointPath != "" && !opts.Restart {
+		loaded, err := LoadCheckpoint(opts.CheckpointPath)
+		if err != nil {
+			return nil, err
+		}
+		cp = loaded
+	}
+
+	stats := &Stats{}
+	if opts.NodesPath != "" {
+		created, skipped, err := processFile(ctx, im.logger, cp, "nodes", opts.NodesPath, opts.Format, opts.BatchSize, im.nodeWriter(opts.NodeMapping, opts.Outbox))
+		stats.NodesCreated, stats.NodesSkipped = created, skipped
+		if err != nil {
+			return stats, err
+		}
+	}
+	if opts.RelationsPath != "" {
+		created, skipped, err := processFile(ctx, im.logger, cp, "relations", opts.RelationsPath, opts.Format, opts.BatchSize, im.relationWriter(opts.RelationMapping, opts.Outbox))
+		stats.RelationsCreated, stats.RelationsSkipped = created, skipped
+		if err != nil {
+			return stats, err
+		}
+	}
+
+	if err := cp.Remove(); err != nil {
+		im.logger.Warn("导入已完成，但删除检查点文件失败", zap.String("checkpoint", opts.CheckpointPath), zap.Error(err))
+	}
+	return stats, nil
+}
+
+// nodeWriter 返回将记录批量写入为节点的 batchWriter，无效记录被跳过并记录日志。
+// outbox 为 true 时为新建的节点写入 node.created 事件。
+func (im *Importer) nodeWriter(mapping ColumnMapping, outbox bool) batchWriter {
+	return func(ctx context.Context, records []numberedRecord) (int64, int64, error) {
+		inputs := make([]neo4jdal.BatchNodeInput, 0, len(records))
+		inputNums := make([]int64, 0, len(records))
+		var skipped int64
+		for _, rec := range records {
+			input, err := nodeInputFromRecord(rec.fields, mapping, rec.fallbackID())
+			if err != nil {
+				im.logger.Warn("跳过无效的节点记录", zap.Int64("record", rec.num), zap.Error(err))
+				skipped++
+				continue
+			}
+			inputs = append(inputs, input)
+			inputNums = append(inputNums, rec.num)
+		}
+		if len(inputs) == 0 {
+			return 0, skipped, nil
+		}
+
+		var session neo4j.SessionWithContext = im.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+		defer session.Close(ctx)
+		if outbox {
+			session = neo4jdal.WithDerivedOutbox(session, func(result any) []neo4jdal.ChangeEvent {
+				return createdNodeEvents(inputs, result)
+			})
+		}
+		merged, err := im.nodeDAL.ExecBatchMergeNodes(ctx, session, inputs)
+		if err != nil {
+			return 0, 0, fmt.Errorf("批量写入节点失败: %w", err)
+		}
+		var created int64
+		for i, isNew := range merged {
+			if !isNew {
+				im.logger.Info("跳过节点记录: 节点已存在", zap.Int64("record", inputNums[i]), zap.Any("id", inputs[i].Properties["id"]))
+				skipped++
+				continue
+			}
+			created++
+		}
+		return created, skipped, nil
+	}
+}
+
+// relationWriter 返回将记录批量写入为关系的 batchWriter。
+// 无效记录、源/目标节点不存在或节点类型组合不被允许的记录被跳过并记录日志。
+// outbox 为 true 时为新建的关系写入 relation.created 事件。
+func (im *Importer) relationWriter(mapping ColumnMapping, outbox bool) batchWriter {
+	return func(ctx context.Context, records []numberedRecord) (int64, int64, error) {
+		inputs := make([]neo4jdal.BatchRelationInput, 0, len(records))
+		inputNums := make([]int64, 0, len(records))
+		var skipped int64
+		for _, rec := range records {
+			input, err := relationInputFromRecord(rec.fields, mapping, rec.fallbackID())
+			if err != nil {
+				im.logger.Warn("跳过无效的关系记录", zap.Int64("record", rec.num), zap.Error(err))
+				skipped++
+				continue
+			}
+			inputs = append(inputs, input)
+			inputNums = append(inputNums, rec.num)
+		}
+		if len(inputs) == 0 {
+			return 0, skipped, nil
+		}
+
+		var session neo4j.SessionWithContext = im.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+		defer session.Close(ctx)
+		if outbox {
+			session = neo4jdal.WithDerivedOutbox(session, func(result any) []neo4jdal.ChangeEvent {
+				return createdRelationEvents(inputs, result)
+			})
+		}
+		merged, err := im.relationDAL.ExecBatchMergeRelations(ctx, session, inputs)
+		if err != nil {
+			return 0, 0, fmt.Errorf("批量写入关系失败: %w", err)
+		}
+		var created int64
+		for i, input := range inputs {
+			isNew, ok := merged[i]
+			switch {
+			case !ok:
+				im.logger.Warn("跳过关系记录: 源节点或目标节点不存在，或节点类型组合不被允许",
+					zap.Int64("record", inputNums[i]), zap.String("source", input.SourceID), zap.String("target", input.TargetID))
+				skipped++
+			case !isNew:
+				im.logger.Info("跳过关系记录: 关系已存在", zap.Int64("record", inputNums[i]), zap.Any("id", input.Properties["id"]))
+				skipped++
+			default:
+				created++
+			}
+		}
+		return created, skipped, nil
+	}
+}
+
+// createdNodeEvents 根据 ExecBatchMergeNodes 的结果 (按输入下标是否新建) 为新建的节点构建 created 事件
+func createdNodeEvents(inputs []neo4jdal.BatchNodeInput, result any) []neo4jdal.ChangeEvent {
+	merged, _ := result.([]bool)
+	var events []neo4jdal.ChangeEvent
+	for i, isNew := range merged {
+		if !isNew || i >= len(inputs) {
+			continue
+		}
+		label, _ := neo4jdal.NodeLabel(inputs[i].NodeType)
+		id, _ := inputs[i].Properties["id"].(string)
+		events = append(events, neo4jdal.ChangeEvent{EventType: neo4jdal.EventNodeCreated, AggregateID: id, Label: label})
+	}
+	return events
+}
+
+// createdRelationEvents 根据 ExecBatchMergeRelations 的结果为新建的关系构建 created 事件，按输入顺序排列
+func createdRelationEvents(inputs []neo4jdal.BatchRelationInput, result any) []neo4jdal.ChangeEvent {
+	merged, _ := result.(map[int]bool)
+	var events []neo4jdal.ChangeEvent
+	for i, input := range inputs {
+		if !merged[i] {
+			continue
+		}
+		label, _ := neo4jdal.RelationLabel(input.RelType)
+		id, _ := input.Properties["id"].(string)
+		events = append(events, neo4jdal.ChangeEvent{EventType: neo4jdal.EventRelationCreated, AggregateID: id, Label: label})
+	}
+	return events
+}
+
+// processFile 按批读取并写入一个输入文件，每批提交后更新检查点。
+// 已记录在检查点中的记录会被跳过；文件已完成时直接返回。
+func processFile(ctx context.Context, logger *zap.Logger, cp *Checkpoint, role, path string, format Format, batchSize int, write batchWriter) (int64, int64, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return 0, 0, fmt.Errorf("解析文件路径失败: %w", err)
+	}
+	info, err := os.Stat(absPath)
+	if err != nil {
+		return 0, 0, fmt.Errorf("读取输入文件信息失败: %w", err)
+	}
+	if format == "" {
+		if format, err = DetectFormat(absPath); err != nil {
+			return 0, 0, err
+		}
+	}
+	fileKey := role + ":" + absPath
+	progress, err := cp.progress(fileKey, info.Size())
+	if err != nil {
+		return 0, 0, err
+	}
+	if progress.Done {
+		logger.Info("输入文件已在之前的运行中导入完成，跳过", zap.String("role", role), zap.String("file", absPath))
+		return 0, 0, nil
+	}
+
+	reader, err := openReader(absPath, format)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer reader.Close()
+
+	// 跳过检查点中已处理的记录
+	var num int64
+	for num < progress.Records {
+		if _, err := reader.Next(); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return 0, 0, fmt.Errorf("恢复检查点时读取第 %d 条记录失败: %w", num+1, err)
+		}
+		num++
+	}
+	if num > 0 {
+		logger.Info("从检查点继续导入", zap.String("role", role), zap.String("file", absPath), zap.Int64("resumeAfter", num))
+	}
+
+	var created, skipped int64
+	batch := make([]numberedRecord, 0, batchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		c, s, err := write(ctx, batch)
+		if err != nil {
+			return fmt.Errorf("导入第 %d-%d 条记录失败: %w", batch[0].num, batch[len(batch)-1].num, err)
+		}
+		created += c
+		skipped += s
+		progress.Records = batch[len(batch)-1].num
+		if err := cp.Save(); err != nil {
+			return err
+		}
+		logger.Info("批次已提交", zap.String("role", role), zap.Int64("records", progress.Records), zap.Int64("created", created), zap.Int64("skipped", skipped))
+		batch = batch[:0]
+		return nil
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return created, skipped, err
+		}
+		fields, err := reader.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return created, skipped, fmt.Errorf("读取第 %d 条记录失败: %w", num+1, err)
+		}
+		num++
+		batch = append(batch, numberedRecord{file: fileKey, num: num, fields: fields})
+		if len(batch) >= batchSize {
+			if err := flush(); err != nil {
+				return created, skipped, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return created, skipped, err
+	}
+
+	progress.Done = true
+	if err := cp.Save(); err != nil {
+		return created, skipped, err
+	}
+	return created, skipped, nil
+}
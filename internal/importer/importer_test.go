@@ -0,0 +1,249 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"labelwall/biz/dal/neo4jdal"
+	"labelwall/biz/model/relationship/network"
+	"labelwall/pkg/typeregistry"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func readAll(t *testing.T, r recordReader) []map[string]string {
+	t.Helper()
+	defer r.Close()
+	var records []map[string]string
+	for {
+		rec, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return records
+		}
+		require.NoError(t, err)
+		records = append(records, rec)
+	}
+}
+
+func TestReaders(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("CSV 表头映射与空值", func(t *testing.T) {
+		path := writeFile(t, dir, "nodes.csv", "\ufeffid,type,name,city\nn1,PERSON,张三,北京\nn2,COMPANY,ABC,\n")
+		r, err := openReader(path, FormatCSV)
+		require.NoError(t, err)
+		records := readAll(t, r)
+		require.Len(t, records, 2)
+		assert.Equal(t, map[string]string{"id": "n1", "type": "PERSON", "name": "张三", "city": "北京"}, records[0])
+		_, hasCity := records[1]["city"]
+		assert.False(t, hasCity, "空单元格不应出现在记录中")
+	})
+
+	t.Run("JSONL 展开 properties 并转换非字符串值", func(t *testing.T) {
+		path := writeFile(t, dir, "nodes.jsonl", `{"id":"n1","type":1,"name":"张三","properties":{"age":30,"vip":true}}
+
+{"id":"n2","type":"COMPANY","name":"ABC","note":null}
+`)
+		r, err := openReader(path, FormatJSONL)
+		require.NoError(t, err)
+		records := readAll(t, r)
+		require.Len(t, records, 2, "空行应被跳过")
+		assert.Equal(t, map[string]string{"id": "n1", "type": "1", "name": "张三", "properties.age": "30", "properties.vip": "true"}, records[0])
+		assert.Equal(t, map[string]string{"id": "n2", "type": "COMPANY", "name": "ABC"}, records[1])
+	})
+
+	t.Run("JSONL 非法行", func(t *testing.T) {
+		path := writeFile(t, dir, "bad.jsonl", "{\"id\":\"n1\"}\nnot-json\n")
+		r, err := openReader(path, FormatJSONL)
+		require.NoError(t, err)
+		defer r.Close()
+		_, err = r.Next()
+		require.NoError(t, err)
+		_, err = r.Next()
+		assert.ErrorContains(t, err, "第 2 行")
+	})
+
+	t.Run("按扩展名识别格式", func(t *testing.T) {
+		f, err := DetectFormat("a/b/rels.NDJSON")
+		require.NoError(t, err)
+		assert.Equal(t, FormatJSONL, f)
+		_, err = DetectFormat("data.txt")
+		assert.Error(t, err)
+	})
+}
+
+func TestRecordMapping(t *testing.T) {
+	t.Run("节点列映射与自定义属性", func(t *testing.T) {
+		m, err := ParseNodeMapping("name=full_name, type=kind")
+		require.NoError(t, err)
+		input, err := nodeInputFromRecord(map[string]string{
+			"id": "n1", "kind": "school", "full_name": "清华大学", "city": "北京", "properties.rank": "1",
+		}, m, "fallback")
+		require.NoError(t, err)
+		assert.Equal(t, network.NodeType_SCHOOL, input.NodeType)
+		assert.Equal(t, "n1", input.Properties["id"])
+		assert.Equal(t, "清华大学", input.Properties["name"])
+		assert.Equal(t, "北京", input.Properties["city"])
+		assert.Equal(t, "1", input.Properties["rank"])
+		_, hasKind := input.Properties["kind"]
+		assert.False(t, hasKind, "已映射的列不应写入 properties")
+	})
+
+	t.Run("节点缺少 id 时使用由文件和序号确定的 ID", func(t *testing.T) {
+		rec := numberedRecord{file: "nodes:/data/nodes.csv", num: 7, fields: map[string]string{"type": "1", "name": "张三"}}
+		input, err := nodeInputFromRecord(rec.fields, ColumnMapping{}, rec.fallbackID())
+		require.NoError(t, err)
+		assert.Equal(t, rec.fallbackID(), input.Properties["id"])
+		assert.Equal(t, network.NodeType_PERSON, input.NodeType)
+
+		// 重新运行时同一条记录得到相同的 ID，MERGE 不会重复写入；其他记录的 ID 不同
+		again := numberedRecord{file: rec.file, num: rec.num}
+		assert.Equal(t, rec.fallbackID(), again.fallbackID())
+		assert.NotEqual(t, rec.fallbackID(), numberedRecord{file: rec.file, num: 8}.fallbackID())
+	})
+
+	t.Run("无效节点记录", func(t *testing.T) {
+		_, err := nodeInputFromRecord(map[string]string{"type": "PERSON"}, ColumnMapping{}, "")
+		assert.Error(t, err, "缺少名称")
+		_, err = nodeInputFromRecord(map[string]string{"type": "ALIEN", "name": "x"}, ColumnMapping{}, "")
+		assert.Error(t, err, "无效类型")
+		_, err = nodeInputFromRecord(map[string]string{"type": "0", "name": "x"}, ColumnMapping{}, "")
+		assert.Error(t, err, "未定义的枚举值")
+	})
+
+	t.Run("关系记录", func(t *testing.T) {
+		m, err := ParseRelationMapping("source=from,target=to")
+		require.NoError(t, err)
+		input, err := relationInputFromRecord(map[string]string{
+			"from": "n1", "to": "n2", "type": "friend", "label": "老友", "since": "2010",
+		}, m, "r1")
+		require.NoError(t, err)
+		assert.Equal(t, "n1", input.SourceID)
+		assert.Equal(t, "n2", input.TargetID)
+		assert.Equal(t, network.RelationType_FRIEND, input.RelType)
+		assert.Equal(t, "老友", input.Properties["label"])
+		assert.Equal(t, "2010", input.Properties["since"])
+		assert.Equal(t, "r1", input.Properties["id"], "缺少 id 列时使用 fallbackID")
+
+		_, err = relationInputFromRecord(map[string]string{"from": "n1", "type": "FRIEND"}, m, "")
+		assert.Error(t, err, "缺少目标节点")
+	})
+
+	t.Run("不符合属性约束的记录", func(t *testing.T) {
+		registry := typeregistry.Default()
+		var school typeregistry.NodeTypeDef
+		for _, d := range registry.NodeTypes() {
+			if d.Code == network.NodeType_SCHOOL {
+				school = d
+			}
+		}
+		constrained := school
+		constrained.Properties = []typeregistry.PropertySchema{{Key: "rank", Type: typeregistry.ValueInt}}
+		require.NoError(t, registry.PutNodeType(constrained))
+		t.Cleanup(func() { require.NoError(t, registry.PutNodeType(school)) })
+
+		_, err := nodeInputFromRecord(map[string]string{"type": "SCHOOL", "name": "x", "rank": "1"}, ColumnMapping{}, "n1")
+		assert.NoError(t, err)
+		_, err = nodeInputFromRecord(map[string]string{"type": "SCHOOL", "name": "x", "rank": "first"}, ColumnMapping{}, "n1")
+		assert.ErrorContains(t, err, "rank", "值类型不符")
+		_, err = nodeInputFromRecord(map[string]string{"type": "SCHOOL", "name": "x", "city": "北京"}, ColumnMapping{}, "n1")
+		assert.ErrorContains(t, err, "city", "未声明的属性")
+	})
+
+	t.Run("无效映射", func(t *testing.T) {
+		_, err := ParseNodeMapping("nickname=nick")
+		assert.Error(t, err)
+		_, err = ParseRelationMapping("source")
+		assert.Error(t, err)
+	})
+}
+
+func TestCreatedEvents(t *testing.T) {
+	nodes := []neo4jdal.BatchNodeInput{
+		{NodeType: network.NodeType_PERSON, Properties: map[string]any{"id": "n1"}},
+		{NodeType: network.NodeType_COMPANY, Properties: map[string]any{"id": "n2"}},
+		{NodeType: network.NodeType_COMPANY, Properties: map[string]any{"id": "n3"}},
+	}
+	assert.Equal(t, []neo4jdal.ChangeEvent{
+		{EventType: neo4jdal.EventNodeCreated, AggregateID: "n1", Label: "PERSON"},
+		{EventType: neo4jdal.EventNodeCreated, AggregateID: "n3", Label: "COMPANY"},
+	}, createdNodeEvents(nodes, []bool{true, false, true}), "已存在的节点不产生事件")
+
+	rels := []neo4jdal.BatchRelationInput{
+		{SourceID: "n1", TargetID: "n2", RelType: network.RelationType_COLLEAGUE, Properties: map[string]any{"id": "r1"}},
+		{SourceID: "n1", TargetID: "x", RelType: network.RelationType_FRIEND, Properties: map[string]any{"id": "r2"}},
+		{SourceID: "n1", TargetID: "n3", RelType: network.RelationType_VISITED, Properties: map[string]any{"id": "r3"}},
+	}
+	assert.Equal(t, []neo4jdal.ChangeEvent{
+		{EventType: neo4jdal.EventRelationCreated, AggregateID: "r3", Label: "VISITED"},
+	}, createdRelationEvents(rels, map[int]bool{0: false, 2: true}), "已存在或被跳过的关系不产生事件")
+}
+
+func TestProcessFileCheckpoint(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+	logger := zap.NewNop()
+	path := writeFile(t, dir, "nodes.csv", "id,type,name\nn1,1,a\nn2,1,b\nn3,1,c\nn4,1,d\nn5,1,e\n")
+	cpPath := filepath.Join(dir, "import.checkpoint.json")
+
+	// 第一次运行: 第二批写入失败
+	var written []int64
+	failing := func(_ context.Context, records []numberedRecord) (int64, int64, error) {
+		if records[0].num > 2 {
+			return 0, 0, errors.New("neo4j unavailable")
+		}
+		for _, r := range records {
+			written = append(written, r.num)
+		}
+		return int64(len(records)), 0, nil
+	}
+	cp, err := LoadCheckpoint(cpPath)
+	require.NoError(t, err)
+	_, _, err = processFile(ctx, logger, cp, "nodes", path, "", 2, failing)
+	require.Error(t, err)
+	assert.Equal(t, []int64{1, 2}, written)
+
+	// 第二次运行: 从检查点继续，只写入剩余记录
+	written = nil
+	ok := func(_ context.Context, records []numberedRecord) (int64, int64, error) {
+		for _, r := range records {
+			written = append(written, r.num)
+		}
+		return int64(len(records)), 0, nil
+	}
+	cp, err = LoadCheckpoint(cpPath)
+	require.NoError(t, err)
+	created, skipped, err := processFile(ctx, logger, cp, "nodes", path, "", 2, ok)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 4, 5}, written)
+	assert.Equal(t, int64(3), created)
+	assert.Equal(t, int64(0), skipped)
+
+	// 第三次运行: 文件已完成，不再写入
+	written = nil
+	cp, err = LoadCheckpoint(cpPath)
+	require.NoError(t, err)
+	_, _, err = processFile(ctx, logger, cp, "nodes", path, "", 2, ok)
+	require.NoError(t, err)
+	assert.Empty(t, written)
+
+	// 文件被修改后拒绝从检查点继续
+	writeFile(t, dir, "nodes.csv", "id,type,name\nn1,1,a\n")
+	cp, err = LoadCheckpoint(cpPath)
+	require.NoError(t, err)
+	_, _, err = processFile(ctx, logger, cp, "nodes", path, "", 2, ok)
+	assert.ErrorContains(t, err, "-restart")
+}
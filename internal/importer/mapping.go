@@ -0,0 +1,171 @@
+package importer
+
+import (
+	"fmt"
+	"strings"
+
+	"labelwall/biz/dal/neo4jdal"
+	"labelwall/biz/model/relationship/network"
+	"labelwall/biz/repo/neo4jrepo"
+	"labelwall/pkg/typeregistry"
+)
+
+// 节点与关系的内置字段名，其余列写入 properties
+var (
+	nodeFields     = []string{"id", "type", "name", "avatar", "profession"}
+	relationFields = []string{"id", "source", "target", "type", "label"}
+)
+
+// ColumnMapping 将 Node/Relation 字段名映射到输入文件的列名，未映射的字段使用同名列
+type ColumnMapping map[string]string
+
+// ParseColumnMapping 解析形如 "name=full_name,type=kind" 的映射，allowed 为可映射的字段
+func ParseColumnMapping(s string, allowed []string) (ColumnMapping, error) {
+	m := ColumnMapping{}
+	if strings.TrimSpace(s) == "" {
+		return m, nil
+	}
+	for _, pair := range strings.Split(s, ",") {
+		field, column, ok := strings.Cut(pair, "=")
+		field, column = strings.TrimSpace(field), strings.TrimSpace(column)
+		if !ok || field == "" || column == "" {
+			return nil, fmt.Errorf("无效的列映射 %q (格式: 字段=列名)", pair)
+		}
+		if !containsString(allowed, field) {
+			return nil, fmt.Errorf("未知的映射字段 %q (可选: %s)", field, strings.Join(allowed, ", "))
+		}
+		m[field] = column
+	}
+	return m, nil
+}
+
+// ParseNodeMapping 解析节点文件的列映射
+func ParseNodeMapping(s string) (ColumnMapping, error) { return ParseColumnMapping(s, nodeFields) }
+
+// ParseRelationMapping 解析关系文件的列映射
+func ParseRelationMapping(s string) (ColumnMapping, error) {
+	return ParseColumnMapping(s, relationFields)
+}
+
+// column 返回字段对应的列名
+func (m ColumnMapping) column(field string) string {
+	if col, ok := m[field]; ok {
+		return col
+	}
+	return field
+}
+
+// get 读取字段值，空字符串视为缺失
+func (m ColumnMapping) get(record map[string]string, field string) (string, bool) {
+	v, ok := record[m.column(field)]
+	v = strings.TrimSpace(v)
+	return v, ok && v != ""
+}
+
+// optional 读取可选字段，缺失时返回 nil
+func (m ColumnMapping) optional(record map[string]string, field string) *string {
+	if v, ok := m.get(record, field); ok {
+		return &v
+	}
+	return nil
+}
+
+// properties 收集不属于内置字段的列作为自定义属性，properties.<key> 列去掉前缀
+func (m ColumnMapping) properties(record map[string]string, fields []string) map[string]string {
+	used := make(map[string]struct{}, len(fields))
+	for _, f := range fields {
+		used[m.column(f)] = struct{}{}
+	}
+	props := make(map[string]string)
+	for col, v := range record {
+		if _, ok := used[col]; ok {
+			continue
+		}
+		key := strings.TrimPrefix(col, propertiesPrefix)
+		if key == "" {
+			continue
+		}
+		props[key] = v
+	}
+	return props
+}
+
+// nodeInputFromRecord 将一条输入记录转换为 DAL 批量写入节点的输入。
+// 未提供 id 列时使用 fallbackID；返回的 ID 供关系文件引用。
+func nodeInputFromRecord(record map[string]string, m ColumnMapping, fallbackID string) (neo4jdal.BatchNodeInput, error) {
+	name, ok := m.get(record, "name")
+	if !ok {
+		return neo4jdal.BatchNodeInput{}, fmt.Errorf("节点名称不能为空")
+	}
+	typeStr, ok := m.get(record, "type")
+	if !ok {
+		return neo4jdal.BatchNodeInput{}, fmt.Errorf("节点类型不能为空")
+	}
+	nodeType, err := parseNodeType(typeStr)
+	if err != nil {
+		return neo4jdal.BatchNodeInput{}, err
+	}
+	props := m.properties(record, nodeFields)
+	if err := typeregistry.PropertiesError(typeregistry.Default().NodePropertySchema(nodeType), props, false); err != nil {
+		return neo4jdal.BatchNodeInput{}, err
+	}
+	nodeID, ok := m.get(record, "id")
+	if !ok {
+		nodeID = fallbackID
+	}
+	return neo4jdal.BatchNodeInput{
+		NodeType: nodeType,
+		Properties: neo4jrepo.BuildNodeProperties(nodeID, name,
+			m.optional(record, "avatar"), m.optional(record, "profession"), props, nil),
+	}, nil
+}
+
+// relationInputFromRecord 将一条输入记录转换为 DAL 批量写入关系的输入，未提供 id 列时使用 fallbackID
+func relationInputFromRecord(record map[string]string, m ColumnMapping, fallbackID string) (neo4jdal.BatchRelationInput, error) {
+	source, okSource := m.get(record, "source")
+	target, okTarget := m.get(record, "target")
+	if !okSource || !okTarget {
+		return neo4jdal.BatchRelationInput{}, fmt.Errorf("源节点和目标节点 ID 不能为空")
+	}
+	typeStr, ok := m.get(record, "type")
+	if !ok {
+		return neo4jdal.BatchRelationInput{}, fmt.Errorf("关系类型不能为空")
+	}
+	relType, err := parseRelationType(typeStr)
+	if err != nil {
+		return neo4jdal.BatchRelationInput{}, err
+	}
+	props := m.properties(record, relationFields)
+	if err := typeregistry.PropertiesError(typeregistry.Default().RelationPropertySchema(relType), props, false); err != nil {
+		return neo4jdal.BatchRelationInput{}, err
+	}
+	relationID, ok := m.get(record, "id")
+	if !ok {
+		relationID = fallbackID
+	}
+	return neo4jdal.BatchRelationInput{
+		SourceID:   source,
+		TargetID:   target,
+		RelType:    relType,
+		Properties: neo4jrepo.BuildRelationProperties(relationID, m.optional(record, "label"), props, nil),
+	}, nil
+}
+
+// parseNodeType 同时接受类型名 (PERSON，不区分大小写) 和类型编码 (1)，包括已注册的动态类型
+func parseNodeType(s string) (network.NodeType, error) {
+	return typeregistry.Default().ParseNodeType(s)
+}
+
+// parseRelationType 同时接受类型名 (FRIEND，不区分大小写) 和类型编码 (3)，包括已注册的动态类型
+func parseRelationType(s string) (network.RelationType, error) {
+	return typeregistry.Default().ParseRelationType(s)
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
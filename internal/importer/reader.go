@@ -0,0 +1,201 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Format 输入文件格式
+type Format string
+
+const (
+	FormatCSV   Format = "csv"
+	FormatJSONL Format = "jsonl"
+)
+
+// maxJSONLLineSize 单行 JSONL 记录允许的最大字节数
+const maxJSONLLineSize = 16 * 1024 * 1024
+
+// propertiesPrefix 以该前缀开头的列 (如 properties.city) 写入 properties
+const propertiesPrefix = "properties."
+
+// ParseFormat 解析命令行传入的格式名，空字符串表示按扩展名识别
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(s) {
+	case "":
+		return "", nil
+	case "csv":
+		return FormatCSV, nil
+	case "jsonl", "ndjson":
+		return FormatJSONL, nil
+	}
+	return "", fmt.Errorf("不支持的文件格式 %q (可选: csv, jsonl)", s)
+}
+
+// DetectFormat 根据文件扩展名识别格式
+func DetectFormat(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return FormatCSV, nil
+	case ".jsonl", ".ndjson":
+		return FormatJSONL, nil
+	}
+	return "", fmt.Errorf("无法根据扩展名识别文件格式: %s (请使用 -format 指定)", path)
+}
+
+// recordReader 逐条读取输入记录，每条记录是 列名 -> 值 的映射。
+// 读取完毕时 Next 返回 io.EOF。
+type recordReader interface {
+	Next() (map[string]string, error)
+	Close() error
+}
+
+// openReader 按格式打开输入文件
+func openReader(path string, format Format) (recordReader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("打开输入文件失败: %w", err)
+	}
+	switch format {
+	case FormatCSV:
+		r, err := newCSVReader(f)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		return r, nil
+	case FormatJSONL:
+		return newJSONLReader(f), nil
+	}
+	f.Close()
+	return nil, fmt.Errorf("不支持的文件格式 %q", format)
+}
+
+// csvReader 读取带表头的 CSV，表头即列名
+type csvReader struct {
+	closer io.Closer
+	r      *csv.Reader
+	header []string
+}
+
+func newCSVReader(rc io.ReadCloser) (*csvReader, error) {
+	r := csv.NewReader(rc)
+	r.TrimLeadingSpace = true
+	header, err := r.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, fmt.Errorf("CSV 文件为空，缺少表头")
+		}
+		return nil, fmt.Errorf("读取 CSV 表头失败: %w", err)
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+	// 去掉 UTF-8 BOM (Excel 导出的 CSV 常见)
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+	return &csvReader{closer: rc, r: r, header: header}, nil
+}
+
+func (c *csvReader) Next() (map[string]string, error) {
+	row, err := c.r.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("读取 CSV 行失败: %w", err)
+	}
+	record := make(map[string]string, len(c.header))
+	for i, col := range c.header {
+		if i < len(row) && row[i] != "" {
+			record[col] = row[i]
+		}
+	}
+	return record, nil
+}
+
+func (c *csvReader) Close() error { return c.closer.Close() }
+
+// jsonlReader 读取每行一个 JSON 对象的文件，空行会被跳过。
+// 嵌套的 properties 对象被展开为 properties.<key> 列，其余非字符串值转换为字符串。
+type jsonlReader struct {
+	closer  io.Closer
+	scanner *bufio.Scanner
+	line    int
+}
+
+func newJSONLReader(rc io.ReadCloser) *jsonlReader {
+	scanner := bufio.NewScanner(rc)
+	scanner.Buffer(make([]byte, 64*1024), maxJSONLLineSize)
+	return &jsonlReader{closer: rc, scanner: scanner}
+}
+
+func (j *jsonlReader) Next() (map[string]string, error) {
+	for j.scanner.Scan() {
+		j.line++
+		line := bytes.TrimSpace(j.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		dec := json.NewDecoder(bytes.NewReader(line))
+		dec.UseNumber()
+		var obj map[string]any
+		if err := dec.Decode(&obj); err != nil {
+			return nil, fmt.Errorf("第 %d 行不是合法的 JSON 对象: %w", j.line, err)
+		}
+		record := make(map[string]string, len(obj))
+		for k, v := range obj {
+			if k == "properties" {
+				if props, ok := v.(map[string]any); ok {
+					for pk, pv := range props {
+						if s, ok := stringifyValue(pv); ok {
+							record[propertiesPrefix+pk] = s
+						}
+					}
+					continue
+				}
+			}
+			if s, ok := stringifyValue(v); ok {
+				record[k] = s
+			}
+		}
+		return record, nil
+	}
+	if err := j.scanner.Err(); err != nil {
+		return nil, fmt.Errorf("读取 JSONL 第 %d 行失败: %w", j.line+1, err)
+	}
+	return nil, io.EOF
+}
+
+func (j *jsonlReader) Close() error { return j.closer.Close() }
+
+// stringifyValue 将 JSON 值转换为属性字符串，null 返回 false
+func stringifyValue(v any) (string, bool) {
+	switch val := v.(type) {
+	case nil:
+		return "", false
+	case string:
+		return val, true
+	case json.Number:
+		return val.String(), true
+	case bool:
+		if val {
+			return "true", true
+		}
+		return "false", true
+	default:
+		data, err := json.Marshal(val)
+		if err != nil {
+			return "", false
+		}
+		return string(data), true
+	}
+}
@@ -0,0 +1,138 @@
+// Package invalidation 消费发件箱发布的变更事件，删除受影响的缓存。
+//
+// 每个实例使用自己的排他队列绑定全部事件类型，因此任一实例上的写操作都会让所有实例的缓存失效。
+// 删除包括实体缓存 (node:, relation:) 和通过节点反向索引登记的派生缓存
+// (搜索、网络、路径、节点关系列表)。除受影响的节点外，节点事件还会使 cache.AnyNodeTag 和节点类型的
+// cache.NodeTypeTag 下的键失效，关系事件和节点删除会使 cache.AnyRelationTag 下的键失效。
+// 失效是幂等的，重复投递的事件无需去重。
+package invalidation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.uber.org/zap"
+
+	"labelwall/biz/dal/neo4jdal"
+	"labelwall/internal/outbox"
+	"labelwall/pkg/cache"
+	"labelwall/pkg/typeregistry"
+)
+
+// RoutingKeys 是失效消费者需要绑定的全部事件类型
+var RoutingKeys = []string{
+	neo4jdal.EventNodeCreated,
+	neo4jdal.EventNodeUpdated,
+	neo4jdal.EventNodeDeleted,
+	neo4jdal.EventRelationCreated,
+	neo4jdal.EventRelationUpdated,
+	neo4jdal.EventRelationDeleted,
+}
+
+// Handler 根据变更事件删除缓存
+type Handler struct {
+	nodeCache     cache.NodeCache
+	relationCache cache.RelationCache
+	index         cache.NodeKeyIndex
+	logger        *zap.Logger
+}
+
+// NewHandler 创建一个新的 Handler 实例
+func NewHandler(nodeCache cache.NodeCache, relationCache cache.RelationCache, index cache.NodeKeyIndex, logger *zap.Logger) *Handler {
+	return &Handler{
+		nodeCache:     nodeCache,
+		relationCache: relationCache,
+		index:         index,
+		logger:        logger.Named("cache_invalidation"),
+	}
+}
+
+// Handle 实现 rabbitmq.MessageHandler
+func (h *Handler) Handle(ctx context.Context, delivery amqp.Delivery) error {
+	var msg outbox.Message
+	if err := json.Unmarshal(delivery.Body, &msg); err != nil {
+		h.logger.Error("无法解析变更事件", zap.String("messageId", delivery.MessageId), zap.Error(err))
+		return fmt.Errorf("invalid change event: %w", err)
+	}
+	return h.Apply(ctx, msg)
+}
+
+// Apply 删除事件影响的实体缓存和派生缓存。
+// 某一步失败不会中断其余删除，所有错误合并后返回。
+func (h *Handler) Apply(ctx context.Context, msg outbox.Message) error {
+	var errs []error
+
+	// 1. 实体缓存
+	switch msg.AggregateType {
+	case "node":
+		errs = append(errs, ignoreNotFound(h.nodeCache.DeleteNode(ctx, msg.AggregateID)))
+	case "relation":
+		errs = append(errs, ignoreNotFound(h.relationCache.DeleteRelation(ctx, msg.AggregateID)))
+	default:
+		h.logger.Warn("未知的聚合类型", zap.String("eventId", msg.EventID), zap.String("aggregateType", msg.AggregateType))
+	}
+	if msg.EventType == neo4jdal.EventNodeDeleted {
+		// 随节点一起删除的关系
+		for _, relID := range msg.RelationIDs {
+			errs = append(errs, ignoreNotFound(h.relationCache.DeleteRelation(ctx, relID)))
+		}
+	}
+
+	// 2. 引用受影响节点或相应标签的派生缓存
+	deleted, err := h.index.InvalidateNodes(ctx, invalidationRefs(msg))
+	errs = append(errs, err)
+
+	if err := errors.Join(errs...); err != nil {
+		h.logger.Error("缓存失效失败", zap.String("eventId", msg.EventID), zap.String("eventType", msg.EventType), zap.Error(err))
+		return err
+	}
+	h.logger.Debug("缓存已失效",
+		zap.String("eventId", msg.EventID),
+		zap.String("eventType", msg.EventType),
+		zap.Int("nodes", len(msg.NodeIDs)),
+		zap.Int64("derivedKeys", deleted),
+	)
+	return nil
+}
+
+// ApplyEvent 删除一条变更影响的缓存，与消费者收到对应事件时的处理一致。
+// 没有失效消费者时 (未启用 RabbitMQ 或使用内存图后端) 由 Repo 在写操作提交后同步调用，见 neo4jrepo.WithLocalInvalidation。
+func (h *Handler) ApplyEvent(ctx context.Context, event neo4jdal.OutboxEvent) error {
+	return h.Apply(ctx, outbox.NewMessage(event))
+}
+
+// invalidationRefs 返回事件需要失效的反向索引成员: 受影响的节点和按事件类型确定的标签
+func invalidationRefs(msg outbox.Message) []string {
+	refs := slices.Clone(msg.NodeIDs)
+	switch msg.AggregateType {
+	case "node":
+		refs = append(refs, cache.AnyNodeTag)
+		labels := msg.NodeLabels
+		if len(labels) == 0 {
+			// 不带标签的旧事件: 无法确定节点类型，使所有类型的搜索失效
+			for _, def := range typeregistry.Default().NodeTypes() {
+				labels = append(labels, def.Name)
+			}
+		}
+		for _, label := range labels {
+			refs = append(refs, cache.NodeTypeTag(label))
+		}
+		if msg.EventType == neo4jdal.EventNodeDeleted {
+			refs = append(refs, cache.AnyRelationTag) // 随节点一起删除了关系
+		}
+	case "relation":
+		refs = append(refs, cache.AnyRelationTag)
+	}
+	return refs
+}
+
+func ignoreNotFound(err error) error {
+	if errors.Is(err, cache.ErrNotFound) {
+		return nil
+	}
+	return err
+}
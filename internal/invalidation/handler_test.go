@@ -0,0 +1,179 @@
+package invalidation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	network "labelwall/biz/model/relationship/network"
+	"labelwall/internal/outbox"
+	"labelwall/pkg/cache"
+)
+
+// fakeCache 记录删除的实体并模拟反向索引
+type fakeCache struct {
+	deletedNodes     []string
+	deletedRelations []string
+	index            map[string][]string // nodeID -> derived keys
+	deletedKeys      []string
+	indexErr         error
+}
+
+func (c *fakeCache) GetNode(ctx context.Context, id string) (*network.Node, error) { return nil, nil }
+func (c *fakeCache) SetNode(ctx context.Context, id string, node *network.Node, ttl time.Duration) error {
+	return nil
+}
+func (c *fakeCache) DeleteNode(ctx context.Context, id string) error {
+	c.deletedNodes = append(c.deletedNodes, id)
+	return nil
+}
+func (c *fakeCache) GetRelation(ctx context.Context, id string) (*network.Relation, error) {
+	return nil, nil
+}
+func (c *fakeCache) SetRelation(ctx context.Context, id string, relation *network.Relation, ttl time.Duration) error {
+	return nil
+}
+func (c *fakeCache) DeleteRelation(ctx context.Context, id string) error {
+	c.deletedRelations = append(c.deletedRelations, id)
+	return nil
+}
+func (c *fakeCache) GetNodes(ctx context.Context, ids []string) (map[string]*network.Node, error) {
+	return map[string]*network.Node{}, nil
+}
+func (c *fakeCache) SetNodes(ctx context.Context, nodes map[string]*network.Node, ttl time.Duration) error {
+	return nil
+}
+func (c *fakeCache) GetRelations(ctx context.Context, ids []string) (map[string]*network.Relation, error) {
+	return map[string]*network.Relation{}, nil
+}
+func (c *fakeCache) SetRelations(ctx context.Context, relations map[string]*network.Relation, ttl time.Duration) error {
+	return nil
+}
+func (c *fakeCache) IndexKey(ctx context.Context, key string, nodeIDs []string, ttl time.Duration) error {
+	for _, id := range nodeIDs {
+		c.index[id] = append(c.index[id], key)
+	}
+	return nil
+}
+func (c *fakeCache) InvalidateNodes(ctx context.Context, nodeIDs []string) (int64, error) {
+	if c.indexErr != nil {
+		return 0, c.indexErr
+	}
+	seen := map[string]bool{}
+	for _, id := range nodeIDs {
+		for _, key := range c.index[id] {
+			if !seen[key] {
+				seen[key] = true
+				c.deletedKeys = append(c.deletedKeys, key)
+			}
+		}
+		delete(c.index, id)
+	}
+	sort.Strings(c.deletedKeys)
+	return int64(len(seen)), nil
+}
+
+func newFakeCache() *fakeCache {
+	return &fakeCache{index: map[string][]string{}}
+}
+
+func TestHandlerApply(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("节点删除使邻居和随之删除的关系失效", func(t *testing.T) {
+		c := newFakeCache()
+		_ = c.IndexKey(ctx, "network:graph:ids:a", []string{"n1", "n2"}, time.Minute)
+		_ = c.IndexKey(ctx, "network:path:ids:b", []string{"n2", "n3"}, time.Minute)
+		_ = c.IndexKey(ctx, "search:nodes:ids:c", []string{"n9"}, time.Minute)
+		h := NewHandler(c, c, c, zap.NewNop())
+
+		err := h.Apply(ctx, outbox.Message{
+			EventID: "e1", EventType: "node.deleted", AggregateType: "node", AggregateID: "n1",
+			NodeIDs: []string{"n1", "n2"}, RelationIDs: []string{"r1"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"n1"}, c.deletedNodes)
+		assert.Equal(t, []string{"r1"}, c.deletedRelations)
+		assert.Equal(t, []string{"network:graph:ids:a", "network:path:ids:b"}, c.deletedKeys)
+		assert.Contains(t, c.index, "n9", "无关节点的派生缓存不受影响")
+	})
+
+	t.Run("关系事件只删除关系实体", func(t *testing.T) {
+		c := newFakeCache()
+		h := NewHandler(c, c, c, zap.NewNop())
+		require.NoError(t, h.Apply(ctx, outbox.Message{
+			EventType: "relation.updated", AggregateType: "relation", AggregateID: "r1", NodeIDs: []string{"n1", "n2"}, RelationIDs: []string{"r1"},
+		}))
+		assert.Empty(t, c.deletedNodes)
+		assert.Equal(t, []string{"r1"}, c.deletedRelations)
+	})
+
+	t.Run("新节点使全部搜索缓存失效", func(t *testing.T) {
+		c := newFakeCache()
+		_ = c.IndexKey(ctx, "search:nodes:ids:empty", []string{cache.AnyNodeTag}, time.Minute)
+		_ = c.IndexKey(ctx, "search:nodes:ids:c", []string{"n9", cache.AnyNodeTag}, time.Minute)
+		_ = c.IndexKey(ctx, "network:path:ids:b", []string{"n2", "n3", cache.AnyRelationTag}, time.Minute)
+		h := NewHandler(c, c, c, zap.NewNop())
+
+		require.NoError(t, h.Apply(ctx, outbox.Message{
+			EventType: "node.created", AggregateType: "node", AggregateID: "n1", NodeIDs: []string{"n1"},
+		}))
+		assert.Equal(t, []string{"search:nodes:ids:c", "search:nodes:ids:empty"}, c.deletedKeys)
+		assert.Contains(t, c.index, cache.AnyRelationTag, "新节点不影响路径")
+	})
+
+	t.Run("节点事件只使同类型的搜索缓存失效", func(t *testing.T) {
+		c := newFakeCache()
+		_ = c.IndexKey(ctx, "search:nodes:ids:person", []string{cache.NodeTypeTag("PERSON")}, time.Minute)
+		_ = c.IndexKey(ctx, "search:nodes:ids:company", []string{cache.NodeTypeTag("COMPANY")}, time.Minute)
+		h := NewHandler(c, c, c, zap.NewNop())
+
+		require.NoError(t, h.Apply(ctx, outbox.Message{
+			EventType: "node.created", AggregateType: "node", AggregateID: "n1", NodeIDs: []string{"n1"}, NodeLabels: []string{"PERSON"},
+		}))
+		assert.Equal(t, []string{"search:nodes:ids:person"}, c.deletedKeys)
+		assert.Contains(t, c.index, cache.NodeTypeTag("COMPANY"), "其他类型的搜索不受影响")
+	})
+
+	t.Run("新关系使全部路径和网络缓存失效", func(t *testing.T) {
+		c := newFakeCache()
+		_ = c.IndexKey(ctx, "network:path:ids:b", []string{"n2", "n3", cache.AnyRelationTag}, time.Minute)
+		_ = c.IndexKey(ctx, "network:graph:ids:a", []string{"n4", cache.AnyNodeTag, cache.AnyRelationTag}, time.Minute)
+		_ = c.IndexKey(ctx, "search:nodes:ids:c", []string{"n9", cache.AnyNodeTag}, time.Minute)
+		h := NewHandler(c, c, c, zap.NewNop())
+
+		require.NoError(t, h.Apply(ctx, outbox.Message{
+			EventType: "relation.created", AggregateType: "relation", AggregateID: "r1", NodeIDs: []string{"n1", "n5"}, RelationIDs: []string{"r1"},
+		}))
+		assert.Equal(t, []string{"network:graph:ids:a", "network:path:ids:b"}, c.deletedKeys)
+		assert.Contains(t, c.index, "n9", "关系变更不影响搜索")
+	})
+
+	t.Run("索引失败时返回错误但仍删除实体", func(t *testing.T) {
+		c := newFakeCache()
+		c.indexErr = errors.New("redis down")
+		h := NewHandler(c, c, c, zap.NewNop())
+		err := h.Apply(ctx, outbox.Message{EventType: "node.updated", AggregateType: "node", AggregateID: "n1", NodeIDs: []string{"n1"}})
+		assert.Error(t, err)
+		assert.Equal(t, []string{"n1"}, c.deletedNodes)
+	})
+}
+
+func TestHandlerHandle(t *testing.T) {
+	c := newFakeCache()
+	h := NewHandler(c, c, c, zap.NewNop())
+
+	body, _ := json.Marshal(outbox.Message{EventType: "node.updated", AggregateType: "node", AggregateID: "n1", NodeIDs: []string{"n1"}})
+	require.NoError(t, h.Handle(context.Background(), amqp.Delivery{Body: body}))
+	assert.Equal(t, []string{"n1"}, c.deletedNodes)
+
+	assert.Error(t, h.Handle(context.Background(), amqp.Delivery{Body: []byte("not json")}))
+}
@@ -0,0 +1,238 @@
+// Package outbox 实现发件箱 relay: 轮询 Neo4j 中未发布的变更事件，
+// 按写入顺序发布到 RabbitMQ (publisher confirms)，确认后标记为已发布。
+//
+// 事件与业务数据在同一个事务中写入 (见 neo4jdal.WithOutbox)，relay 只负责投递，
+// 因此投递语义是至少一次: 消费者应按 Message.EventID (AMQP message-id) 去重。
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"labelwall/biz/dal/neo4jdal"
+	"labelwall/biz/repo/neo4jrepo"
+)
+
+// Message 是发布到 RabbitMQ 的变更事件消息体，routing key 为 EventType
+type Message struct {
+	EventID       string    `json:"event_id"`
+	EventType     string    `json:"event_type"`     // node.created / node.updated / node.deleted / relation.*
+	AggregateType string    `json:"aggregate_type"` // node 或 relation
+	AggregateID   string    `json:"aggregate_id"`
+	NodeIDs       []string  `json:"node_ids"`     // 受影响的节点 ID
+	RelationIDs   []string  `json:"relation_ids"` // 受影响的关系 ID
+	NodeLabels    []string  `json:"node_labels"`  // 节点事件中聚合节点的标签
+	OccurredAt    time.Time `json:"occurred_at"`
+}
+
+// Publisher 是 relay 依赖的发布接口，由 rabbitmq.Publisher 实现
+type Publisher interface {
+	PublishConfirmed(ctx context.Context, routingKey string, messageID string, body []byte) error
+}
+
+// Options 配置 relay 的轮询、重试和清理行为，零值字段使用默认值
+type Options struct {
+	PollInterval  time.Duration // 没有积压时的轮询间隔
+	BatchSize     int64         // 每次读取的事件数
+	MinBackoff    time.Duration // 发布失败后的首次重试间隔
+	MaxBackoff    time.Duration // 重试间隔上限
+	Retention     time.Duration // 已发布事件的保留时间
+	PurgeInterval time.Duration // 清理已发布事件的间隔
+}
+
+const (
+	defaultPollInterval  = 500 * time.Millisecond
+	defaultBatchSize     = 100
+	defaultMinBackoff    = 1 * time.Second
+	defaultMaxBackoff    = 1 * time.Minute
+	defaultRetention     = 24 * time.Hour
+	defaultPurgeInterval = 10 * time.Minute
+)
+
+func (o Options) withDefaults() Options {
+	if o.PollInterval <= 0 {
+		o.PollInterval = defaultPollInterval
+	}
+	if o.BatchSize <= 0 {
+		o.BatchSize = defaultBatchSize
+	}
+	if o.MinBackoff <= 0 {
+		o.MinBackoff = defaultMinBackoff
+	}
+	if o.MaxBackoff < o.MinBackoff {
+		o.MaxBackoff = defaultMaxBackoff
+		if o.MaxBackoff < o.MinBackoff {
+			o.MaxBackoff = o.MinBackoff
+		}
+	}
+	if o.Retention <= 0 {
+		o.Retention = defaultRetention
+	}
+	if o.PurgeInterval <= 0 {
+		o.PurgeInterval = defaultPurgeInterval
+	}
+	return o
+}
+
+// Relay 在后台 goroutine 中把发件箱事件发布到 RabbitMQ
+type Relay struct {
+	store     neo4jrepo.OutboxRepository
+	publisher Publisher
+	opts      Options
+	logger    *zap.Logger
+
+	cancel   context.CancelFunc
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewRelay 创建一个新的 Relay 实例，调用 Start 后开始工作
+func NewRelay(store neo4jrepo.OutboxRepository, publisher Publisher, opts Options, logger *zap.Logger) *Relay {
+	return &Relay{
+		store:     store,
+		publisher: publisher,
+		opts:      opts.withDefaults(),
+		logger:    logger.Named("outbox_relay"),
+	}
+}
+
+// Start 启动后台轮询 goroutine
+func (r *Relay) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.done = make(chan struct{})
+	go r.run(ctx)
+	r.logger.Info("Outbox relay 已启动",
+		zap.Duration("pollInterval", r.opts.PollInterval),
+		zap.Int64("batchSize", r.opts.BatchSize),
+	)
+}
+
+// Stop 停止后台 goroutine 并等待其退出；正在等待确认的发布会被取消，事件保持未发布状态
+func (r *Relay) Stop() {
+	r.stopOnce.Do(func() {
+		if r.cancel == nil {
+			return
+		}
+		r.cancel()
+		<-r.done
+		r.logger.Info("Outbox relay 已停止")
+	})
+}
+
+func (r *Relay) run(ctx context.Context) {
+	defer close(r.done)
+
+	failures := 0
+	lastPurge := time.Now()
+	for {
+		published, err := r.RunOnce(ctx)
+		wait := r.opts.PollInterval
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+			failures++
+			wait = backoff(r.opts.MinBackoff, r.opts.MaxBackoff, failures)
+			r.logger.Warn("Outbox relay 发布失败，稍后重试", zap.Int("failures", failures), zap.Duration("retryIn", wait), zap.Error(err))
+		default:
+			failures = 0
+			if int64(published) == r.opts.BatchSize {
+				wait = 0 // 还有积压，立即处理下一批
+			}
+		}
+
+		if time.Since(lastPurge) >= r.opts.PurgeInterval {
+			lastPurge = time.Now()
+			if deleted, err := r.store.PurgePublished(ctx, lastPurge.Add(-r.opts.Retention)); err != nil {
+				r.logger.Warn("Outbox relay 清理已发布事件失败", zap.Error(err))
+			} else if deleted > 0 {
+				r.logger.Info("Outbox relay 清理已发布事件", zap.Int64("deleted", deleted))
+			}
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// RunOnce 读取一批未发布事件并按顺序发布，返回成功发布的数量。
+// 遇到第一个失败即停止 (保证顺序)，之前已确认的事件仍会被标记为已发布。
+func (r *Relay) RunOnce(ctx context.Context) (int, error) {
+	events, err := r.store.FetchPending(ctx, r.opts.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	publishedIDs := make([]string, 0, len(events))
+	var publishErr error
+	for _, event := range events {
+		if err := r.publish(ctx, event); err != nil {
+			publishErr = fmt.Errorf("发布事件 %s (%s) 失败: %w", event.EventID, event.EventType, err)
+			if ctx.Err() == nil {
+				if markErr := r.store.MarkFailed(ctx, event.EventID, err.Error()); markErr != nil {
+					r.logger.Warn("Outbox relay 记录发布失败出错", zap.String("eventId", event.EventID), zap.Error(markErr))
+				}
+			}
+			break
+		}
+		publishedIDs = append(publishedIDs, event.EventID)
+	}
+
+	if len(publishedIDs) > 0 {
+		// 标记失败时事件会被再次发布 (至少一次)，所以这里只记录错误
+		if err := r.store.MarkPublished(ctx, publishedIDs); err != nil {
+			r.logger.Warn("Outbox relay 标记事件已发布失败", zap.Int("count", len(publishedIDs)), zap.Error(err))
+			if publishErr == nil {
+				publishErr = err
+			}
+		} else {
+			r.logger.Debug("Outbox relay 发布事件", zap.Int("count", len(publishedIDs)))
+		}
+	}
+	return len(publishedIDs), publishErr
+}
+
+func (r *Relay) publish(ctx context.Context, event neo4jdal.OutboxEvent) error {
+	body, err := json.Marshal(NewMessage(event))
+	if err != nil {
+		return fmt.Errorf("序列化事件失败: %w", err)
+	}
+	return r.publisher.PublishConfirmed(ctx, event.EventType, event.EventID, body)
+}
+
+// NewMessage 将发件箱事件转换为消息体
+func NewMessage(event neo4jdal.OutboxEvent) Message {
+	return Message{
+		EventID:       event.EventID,
+		EventType:     event.EventType,
+		AggregateType: event.AggregateType,
+		AggregateID:   event.AggregateID,
+		NodeIDs:       event.NodeIDs,
+		RelationIDs:   event.RelationIDs,
+		NodeLabels:    event.NodeLabels,
+		OccurredAt:    event.CreatedAt,
+	}
+}
+
+// backoff 返回第 failures 次失败后的重试间隔: min * 2^(failures-1)，不超过 max
+func backoff(min, max time.Duration, failures int) time.Duration {
+	d := min
+	for i := 1; i < failures && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	return d
+}
@@ -0,0 +1,152 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"labelwall/biz/dal/neo4jdal"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// fakeStore 是内存中的发件箱
+type fakeStore struct {
+	mu        sync.Mutex
+	events    []neo4jdal.OutboxEvent
+	published map[string]bool
+	failed    map[string]int
+}
+
+func newFakeStore(events ...neo4jdal.OutboxEvent) *fakeStore {
+	return &fakeStore{events: events, published: map[string]bool{}, failed: map[string]int{}}
+}
+
+func (s *fakeStore) FetchPending(ctx context.Context, limit int64) ([]neo4jdal.OutboxEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []neo4jdal.OutboxEvent
+	for _, e := range s.events {
+		if !s.published[e.EventID] && int64(len(out)) < limit {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+func (s *fakeStore) MarkPublished(ctx context.Context, ids []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, id := range ids {
+		s.published[id] = true
+	}
+	return nil
+}
+
+func (s *fakeStore) MarkFailed(ctx context.Context, id string, lastError string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failed[id]++
+	return nil
+}
+
+func (s *fakeStore) PurgePublished(ctx context.Context, before time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (s *fakeStore) isPublished(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.published[id]
+}
+
+// fakePublisher 记录发布的消息，failOn 中的事件发布失败
+type fakePublisher struct {
+	mu       sync.Mutex
+	messages []Message
+	keys     []string
+	failOn   map[string]bool
+}
+
+func (p *fakePublisher) PublishConfirmed(ctx context.Context, routingKey string, messageID string, body []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.failOn[messageID] {
+		return errors.New("nack")
+	}
+	var msg Message
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return err
+	}
+	p.messages = append(p.messages, msg)
+	p.keys = append(p.keys, routingKey)
+	return nil
+}
+
+func sampleEvents() []neo4jdal.OutboxEvent {
+	return []neo4jdal.OutboxEvent{
+		{EventID: "e1", EventType: neo4jdal.EventNodeCreated, AggregateType: "node", AggregateID: "n1", NodeIDs: []string{"n1"}},
+		{EventID: "e2", EventType: neo4jdal.EventRelationCreated, AggregateType: "relation", AggregateID: "r1", NodeIDs: []string{"n1", "n2"}, RelationIDs: []string{"r1"}},
+		{EventID: "e3", EventType: neo4jdal.EventNodeDeleted, AggregateType: "node", AggregateID: "n2", NodeIDs: []string{"n2", "n1"}, RelationIDs: []string{"r1"}},
+	}
+}
+
+func TestRelayRunOnce(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("按顺序发布并标记", func(t *testing.T) {
+		store := newFakeStore(sampleEvents()...)
+		pub := &fakePublisher{}
+		relay := NewRelay(store, pub, Options{}, zap.NewNop())
+
+		n, err := relay.RunOnce(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 3, n)
+		assert.Equal(t, []string{"node.created", "relation.created", "node.deleted"}, pub.keys, "routing key 为事件类型")
+		assert.Equal(t, []string{"n2", "n1"}, pub.messages[2].NodeIDs)
+		for _, id := range []string{"e1", "e2", "e3"} {
+			assert.True(t, store.isPublished(id), id)
+		}
+	})
+
+	t.Run("遇到失败即停止，之前的事件仍标记为已发布", func(t *testing.T) {
+		store := newFakeStore(sampleEvents()...)
+		pub := &fakePublisher{failOn: map[string]bool{"e2": true}}
+		relay := NewRelay(store, pub, Options{}, zap.NewNop())
+
+		n, err := relay.RunOnce(ctx)
+		assert.Error(t, err)
+		assert.Equal(t, 1, n)
+		assert.True(t, store.isPublished("e1"))
+		assert.False(t, store.isPublished("e2"))
+		assert.False(t, store.isPublished("e3"), "失败事件之后的事件不能越过它发布")
+		assert.Equal(t, 1, store.failed["e2"])
+
+		// 恢复后从失败的事件继续
+		pub.failOn = nil
+		n, err = relay.RunOnce(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+		assert.Equal(t, "e3", pub.messages[len(pub.messages)-1].EventID)
+	})
+}
+
+func TestRelayStartStop(t *testing.T) {
+	store := newFakeStore(sampleEvents()...)
+	relay := NewRelay(store, &fakePublisher{}, Options{PollInterval: 10 * time.Millisecond}, zap.NewNop())
+	relay.Start()
+	require.Eventually(t, func() bool { return store.isPublished("e3") }, time.Second, 10*time.Millisecond)
+	relay.Stop()
+	relay.Stop() // 重复调用是安全的
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, time.Second, backoff(time.Second, time.Minute, 1))
+	assert.Equal(t, 4*time.Second, backoff(time.Second, time.Minute, 3))
+	assert.Equal(t, time.Minute, backoff(time.Second, time.Minute, 30))
+}
@@ -13,6 +13,17 @@ import (
 )
 
 func main() {
+	// 子命令: labelwall import ... (批量导入 CSV / JSONL)
+	//         labelwall export ... (导出 GraphML / GEXF / JSON Graph / Cypher)
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "import":
+			os.Exit(runImport(os.Args[2:]))
+		case "export":
+			os.Exit(runExport(os.Args[2:]))
+		}
+	}
+
 	configPath := "./config.yaml"
 
 	h, publisher, err := bootstrap.Init(configPath) // 接收 publisher
@@ -0,0 +1,364 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/willf/bloom"
+)
+
+// sharedBloom 是节点/关系缓存键的布隆过滤器，位图同时保存在本地和 Redis 中。
+//
+//   - 查询只读本地位图，不增加 Redis 往返；
+//   - 添加时本地置位；只有本地出现新的置位时，才把一次原子的 "SETBIT + 版本号自增 + 记录置位日志"
+//     与缓存写入放在同一个 pipeline 中发送到 Redis；
+//   - Sync 先读取代数和版本号。版本号变化时只读取置位日志中本地尚未合并的条目并应用到本地；
+//     只有首次同步、代数变化 (其他实例完成了重建) 或所需的日志条目已被裁剪时才读取整个位图；
+//   - Rebuild 扫描现存的 node:/relation: 键生成新位图，清除已过期或已删除键留下的位。
+//
+// 本地位图的位序与 Redis SETBIT 一致 (第 i 位位于第 i/8 字节的高位起第 i%8 位)，
+// 因此 Redis 位图可以直接按字节合并。
+// 过滤器只会产生假阴性导致的多余数据库查询 (例如重建期间写入的键，或本地已置位而重建后的
+// Redis 位图中没有的键)，不会返回错误数据。
+//
+// 位图、代数、版本号、锁和重建用的临时键共用同一个 hash tag，在 Redis Cluster 中位于同一个槽，
+// 因此 Sync 的事务和 Rebuild 的 RENAME 在 Cluster 模式下同样可用。
+type sharedBloom struct {
+	client redis.UniversalClient
+	m, k   uint
+
+	bitsKey string // Redis 中的位图
+	genKey  string // 位图代数，每次重建加一
+	verKey  string // 位图版本号，每次有新的置位写入时加一
+	logKey  string // 置位日志: 有序集合，分数为版本号，成员为 "版本号:偏移,偏移,..."，只保留最近 bloomLogSize 条
+	lockKey string // 重建锁，保证同一时间只有一个实例重建
+
+	mu     sync.RWMutex
+	bits   []byte
+	gen    int64
+	ver    int64 // 上次合并时 Redis 中的版本号
+	loaded bool  // 是否已完整读取过一次 Redis 位图
+}
+
+// newSharedBloom 按预估键数量和误判率创建过滤器。
+// Redis 键名包含 m 和 k，参数不同的实例不会共用同一个位图；{m:k} 同时作为 hash tag。
+func newSharedBloom(client redis.UniversalClient, prefix string, estimatedKeys uint, fpRate float64) *sharedBloom {
+	m, k := bloom.EstimateParameters(estimatedKeys, fpRate)
+	base := fmt.Sprintf("%sbloom:{%d:%d}", prefix, m, k)
+	return &sharedBloom{
+		client:  client,
+		m:       m,
+		k:       k,
+		bitsKey: base,
+		genKey:  base + ":gen",
+		verKey:  base + ":ver",
+		logKey:  base + ":log",
+		lockKey: base + ":lock",
+		bits:    make([]byte, (m+7)/8),
+	}
+}
+
+// bloomLogSize 是置位日志保留的条目数。
+// 两次 Sync 之间其他实例新增的置位超过这个数量时，Sync 退回为读取整个位图。
+const bloomLogSize = 10000
+
+// bloomAddScript 原子地置位、自增版本号并记录置位日志，日志只保留最近 ARGV[1] 条。
+// KEYS: 位图、版本号、日志；ARGV: 日志保留条数、位偏移...
+var bloomAddScript = redis.NewScript(`
+for i = 2, #ARGV do
+	redis.call("SETBIT", KEYS[1], ARGV[i], 1)
+end
+local ver = redis.call("INCR", KEYS[2])
+redis.call("ZADD", KEYS[3], ver, ver .. ":" .. table.concat(ARGV, ",", 2))
+redis.call("ZREMRANGEBYRANK", KEYS[3], 0, -tonumber(ARGV[1]) - 1)
+return ver`)
+
+// locations 返回 key 对应的 k 个位偏移
+func (b *sharedBloom) locations(key string) []int64 {
+	locs := bloom.Locations([]byte(key), b.k)
+	offsets := make([]int64, len(locs))
+	for i, loc := range locs {
+		offsets[i] = int64(loc % uint64(b.m))
+	}
+	return offsets
+}
+
+// TestString 返回 key 是否可能存在
+func (b *sharedBloom) TestString(key string) bool {
+	offsets := b.locations(key)
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, off := range offsets {
+		if b.bits[off/8]&(0x80>>(off%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Add 在本地置位。key 的位在本地已全部置位时不写 Redis，
+// 否则在 pipe 中加入一次 bloomAddScript 调用 (由调用方执行)。
+// 脚本原子执行，读到新版本号的实例一定能读到这些位和对应的日志条目。
+// pipeline 中的命令在执行前无法处理 NOSCRIPT，因此使用 EVAL 而不是 EVALSHA。
+func (b *sharedBloom) Add(ctx context.Context, pipe redis.Pipeliner, key string) {
+	offsets := b.locations(key)
+	fresh := false
+	b.mu.Lock()
+	for _, off := range offsets {
+		mask := byte(0x80 >> (off % 8))
+		if b.bits[off/8]&mask == 0 {
+			b.bits[off/8] |= mask
+			fresh = true
+		}
+	}
+	b.mu.Unlock()
+	if !fresh {
+		return
+	}
+	args := make([]any, 0, len(offsets)+1)
+	args = append(args, bloomLogSize)
+	for _, off := range offsets {
+		args = append(args, off)
+	}
+	bloomAddScript.Eval(ctx, pipe, []string{b.bitsKey, b.verKey, b.logKey}, args...)
+}
+
+// Sync 将 Redis 中的位图合并到本地。
+// 先读取代数和版本号，二者都未变化时直接返回。代数未变而版本号前进时，
+// 从置位日志读取 (本地版本号, 远端版本号] 之间的条目并置位，不读取位图；
+// 首次同步、代数变化或日志已被裁剪 (条目不连续) 时读取整个位图 (m/8 字节，默认配置下约 12MB)。
+func (b *sharedBloom) Sync(ctx context.Context) error {
+	gen, ver, err := b.readVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if !b.changed(gen, ver) {
+		return nil
+	}
+	if b.incremental(gen) {
+		applied, err := b.syncLog(ctx, ver)
+		if err != nil {
+			return err
+		}
+		if applied {
+			return nil
+		}
+	}
+	return b.syncFull(ctx)
+}
+
+// incremental 判断能否只通过置位日志同步: 已完整读取过位图且代数未变化
+func (b *sharedBloom) incremental(gen int64) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.loaded && gen == b.gen
+}
+
+// syncLog 读取本地版本号之后、ver 之前 (含) 的置位日志并应用到本地。
+// 返回 false 表示日志不完整 (已被裁剪)，需要读取整个位图。
+func (b *sharedBloom) syncLog(ctx context.Context, ver int64) (bool, error) {
+	b.mu.RLock()
+	from := b.ver
+	b.mu.RUnlock()
+	entries, err := b.client.ZRangeByScore(ctx, b.logKey, &redis.ZRangeBy{
+		Min: "(" + strconv.FormatInt(from, 10),
+		Max: strconv.FormatInt(ver, 10),
+	}).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return false, fmt.Errorf("redis read bloom filter log failed: %w", err)
+	}
+	return b.applyLog(from, ver, entries), nil
+}
+
+// applyLog 将版本号 (from, to] 的日志条目应用到本地位图。
+// 条目不是恰好覆盖这些版本号时 (部分已被裁剪或无法解析) 不做任何修改并返回 false。
+func (b *sharedBloom) applyLog(from, to int64, entries []string) bool {
+	if int64(len(entries)) != to-from {
+		return false
+	}
+	offsets := make([]int64, 0, len(entries)*int(b.k))
+	for i, entry := range entries {
+		ver, offs, ok := parseBloomLogEntry(entry)
+		if !ok || ver != from+int64(i)+1 {
+			return false
+		}
+		offsets = append(offsets, offs...)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.ver != from {
+		return false // 期间已被其他同步更新
+	}
+	for _, off := range offsets {
+		if off >= 0 && off < int64(b.m) {
+			b.bits[off/8] |= 0x80 >> (off % 8)
+		}
+	}
+	b.ver = to
+	return true
+}
+
+// parseBloomLogEntry 解析 "版本号:偏移,偏移,..." 格式的置位日志条目
+func parseBloomLogEntry(entry string) (int64, []int64, bool) {
+	verPart, offsetPart, ok := strings.Cut(entry, ":")
+	if !ok {
+		return 0, nil, false
+	}
+	ver, err := strconv.ParseInt(verPart, 10, 64)
+	if err != nil {
+		return 0, nil, false
+	}
+	if offsetPart == "" {
+		return ver, nil, true
+	}
+	fields := strings.Split(offsetPart, ",")
+	offsets := make([]int64, len(fields))
+	for i, field := range fields {
+		if offsets[i], err = strconv.ParseInt(field, 10, 64); err != nil {
+			return 0, nil, false
+		}
+	}
+	return ver, offsets, true
+}
+
+// syncFull 在一个事务中读取代数、版本号和整个位图，按代数合并或替换本地位图
+func (b *sharedBloom) syncFull(ctx context.Context) error {
+	pipe := b.client.TxPipeline()
+	genCmd := pipe.Get(ctx, b.genKey)
+	verCmd := pipe.Get(ctx, b.verKey)
+	bitsCmd := pipe.Get(ctx, b.bitsKey)
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return fmt.Errorf("redis read bloom filter failed: %w", err)
+	}
+	gen, _ := genCmd.Int64() // 不存在时为 0
+	ver, _ := verCmd.Int64()
+	remote, _ := bitsCmd.Bytes()
+	b.merge(gen, remote)
+
+	b.mu.Lock()
+	b.ver = ver
+	b.loaded = true
+	b.mu.Unlock()
+	return nil
+}
+
+// readVersion 读取 Redis 中位图的代数和版本号，不存在时为 0
+func (b *sharedBloom) readVersion(ctx context.Context) (gen, ver int64, err error) {
+	pipe := b.client.Pipeline()
+	genCmd := pipe.Get(ctx, b.genKey)
+	verCmd := pipe.Get(ctx, b.verKey)
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return 0, 0, fmt.Errorf("redis read bloom filter version failed: %w", err)
+	}
+	gen, _ = genCmd.Int64()
+	ver, _ = verCmd.Int64()
+	return gen, ver, nil
+}
+
+// changed 判断 Redis 位图自上次合并后是否可能有变化
+func (b *sharedBloom) changed(gen, ver int64) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return !b.loaded || gen != b.gen || ver != b.ver
+}
+
+// merge 按代数合并或替换本地位图。Redis 位图可能比 m 短 (只写到最高的置位字节)。
+func (b *sharedBloom) merge(gen int64, remote []byte) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if gen != b.gen {
+		fresh := make([]byte, len(b.bits))
+		copy(fresh, remote)
+		b.bits = fresh
+		b.gen = gen
+		return
+	}
+	for i := 0; i < len(remote) && i < len(b.bits); i++ {
+		b.bits[i] |= remote[i]
+	}
+}
+
+// Rebuild 扫描 Redis 中现存的缓存键重新生成位图，原子替换 Redis 位图并增加代数。
+// 其他实例正在重建时直接返回 false。patterns 为完整的 SCAN 匹配模式，skip 返回 true 的键不加入过滤器。
+func (b *sharedBloom) Rebuild(ctx context.Context, lockTTL time.Duration, patterns []string, skip func(key string) bool) (bool, error) {
+	token := strconv.FormatInt(time.Now().UnixNano(), 36)
+	locked, err := b.client.SetNX(ctx, b.lockKey, token, lockTTL).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis acquire bloom rebuild lock failed: %w", err)
+	}
+	if !locked {
+		return false, nil
+	}
+	defer b.releaseLock(context.WithoutCancel(ctx), token)
+
+	fresh := make([]byte, len(b.bits))
+	var freshMu sync.Mutex
+	for _, pattern := range patterns {
+		err := scanKeys(ctx, b.client, pattern, func(key string) {
+			if skip != nil && skip(key) {
+				return
+			}
+			offsets := b.locations(key)
+			freshMu.Lock()
+			for _, off := range offsets {
+				fresh[off/8] |= 0x80 >> (off % 8)
+			}
+			freshMu.Unlock()
+		})
+		if err != nil {
+			return false, fmt.Errorf("redis scan %s failed: %w", pattern, err)
+		}
+	}
+
+	tmpKey := b.bitsKey + ":tmp:" + token
+	pipe := b.client.TxPipeline()
+	pipe.Set(ctx, tmpKey, fresh, 0)
+	pipe.Rename(ctx, tmpKey, b.bitsKey)
+	genCmd := pipe.Incr(ctx, b.genKey)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, fmt.Errorf("redis replace bloom filter failed: %w", err)
+	}
+
+	b.merge(genCmd.Val(), fresh)
+	return true, nil
+}
+
+// scanKeys 对匹配 pattern 的每个键调用 fn。
+// Cluster 模式下 SCAN 只遍历单个节点，因此并发扫描每个主节点，fn 可能被并发调用。
+func scanKeys(ctx context.Context, client redis.UniversalClient, pattern string, fn func(key string)) error {
+	scan := func(ctx context.Context, node redis.Cmdable) error {
+		iter := node.Scan(ctx, 0, pattern, 1000).Iterator()
+		for iter.Next(ctx) {
+			fn(iter.Val())
+		}
+		return iter.Err()
+	}
+	if cluster, ok := client.(*redis.ClusterClient); ok {
+		return cluster.ForEachMaster(ctx, func(ctx context.Context, node *redis.Client) error {
+			return scan(ctx, node)
+		})
+	}
+	return scan(ctx, client)
+}
+
+// releaseLockScript 只删除自己持有的锁
+var releaseLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+func (b *sharedBloom) releaseLock(ctx context.Context, token string) {
+	_ = releaseLockScript.Run(ctx, b.client, []string{b.lockKey}, token).Err()
+}
+
+// isDerivedRelationKey 判断 relation: 前缀下的键是否为关系列表等派生缓存，而非关系实体
+func isDerivedRelationKey(prefix, key string) bool {
+	return strings.HasPrefix(key, prefix+"relation:list:")
+}
@@ -0,0 +1,146 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestBloom 创建一个不连接 Redis 的过滤器；pipeline 只排队命令，不会执行
+func newTestBloom(t *testing.T) (*sharedBloom, redis.Pipeliner) {
+	t.Helper()
+	client := redis.NewClient(&redis.Options{Addr: "localhost:0"})
+	t.Cleanup(func() { _ = client.Close() })
+	return newSharedBloom(client, "test:", 1000, 0.01), client.Pipeline()
+}
+
+func TestSharedBloomAddAndTest(t *testing.T) {
+	ctx := context.Background()
+	b, pipe := newTestBloom(t)
+
+	assert.False(t, b.TestString("test:node:1"))
+	b.Add(ctx, pipe, "test:node:1")
+	assert.True(t, b.TestString("test:node:1"))
+
+	// 置位、版本号自增和置位日志在一次脚本调用中原子执行
+	assert.Equal(t, 1, pipe.Len())
+	assert.Equal(t, "test:bloom:{"+fmt.Sprint(b.m)+":"+fmt.Sprint(b.k)+"}", b.bitsKey)
+	// 代数、版本号和锁与位图共用 hash tag，Cluster 模式下位于同一个槽
+	assert.Equal(t, b.bitsKey+":gen", b.genKey)
+	assert.Equal(t, b.bitsKey+":ver", b.verKey)
+	assert.Equal(t, b.bitsKey+":log", b.logKey)
+	assert.Equal(t, b.bitsKey+":lock", b.lockKey)
+
+	// 位已全部置位的键不再写 Redis
+	b.Add(ctx, pipe, "test:node:1")
+	assert.Equal(t, 1, pipe.Len())
+}
+
+func TestSharedBloomChanged(t *testing.T) {
+	b, _ := newTestBloom(t)
+	assert.True(t, b.changed(0, 0), "首次同步总是读取位图")
+
+	b.merge(1, nil)
+	b.ver = 5
+	b.loaded = true
+	assert.False(t, b.changed(1, 5), "代数和版本号都未变化时跳过位图")
+	assert.True(t, b.changed(1, 6), "其他实例写入了新的位")
+	assert.True(t, b.changed(2, 5), "其他实例完成了重建")
+}
+
+func TestSharedBloomBitOrderMatchesRedis(t *testing.T) {
+	b, _ := newTestBloom(t)
+
+	// Redis SETBIT 0 将第一个字节置为 0x80，SETBIT 9 将第二个字节置为 0x40
+	b.merge(0, []byte{0x80, 0x40})
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	assert.Equal(t, byte(0x80), b.bits[0])
+	assert.Equal(t, byte(0x40), b.bits[1])
+}
+
+func TestSharedBloomMerge(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("同一代数按位合并，保留本地已添加的键", func(t *testing.T) {
+		b, pipe := newTestBloom(t)
+		b.Add(ctx, pipe, "test:node:local")
+
+		other, otherPipe := newTestBloom(t)
+		other.Add(ctx, otherPipe, "test:node:remote")
+
+		b.merge(0, other.bits)
+		assert.True(t, b.TestString("test:node:local"))
+		assert.True(t, b.TestString("test:node:remote"))
+	})
+
+	t.Run("代数变化时替换本地位图", func(t *testing.T) {
+		b, pipe := newTestBloom(t)
+		b.Add(ctx, pipe, "test:node:stale")
+
+		rebuilt, rebuiltPipe := newTestBloom(t)
+		rebuilt.Add(ctx, rebuiltPipe, "test:node:live")
+
+		b.merge(1, rebuilt.bits)
+		assert.False(t, b.TestString("test:node:stale"), "重建后已不存在的键被清除")
+		assert.True(t, b.TestString("test:node:live"))
+		assert.Equal(t, int64(1), b.gen)
+	})
+
+	t.Run("Redis 位图短于本地位图", func(t *testing.T) {
+		b, _ := newTestBloom(t)
+		require.NotPanics(t, func() { b.merge(2, []byte{0xff}) })
+		assert.Len(t, b.bits, int((b.m+7)/8))
+	})
+}
+
+func TestSharedBloomApplyLog(t *testing.T) {
+	// logEntry 按 bloomAddScript 的格式生成 key 对应的日志条目
+	logEntry := func(b *sharedBloom, ver int64, key string) string {
+		offsets := b.locations(key)
+		fields := make([]string, len(offsets))
+		for i, off := range offsets {
+			fields[i] = fmt.Sprint(off)
+		}
+		return fmt.Sprintf("%d:%s", ver, strings.Join(fields, ","))
+	}
+
+	t.Run("连续的日志条目增量应用", func(t *testing.T) {
+		b, _ := newTestBloom(t)
+		b.ver = 3
+		entries := []string{logEntry(b, 4, "test:node:a"), logEntry(b, 5, "test:node:b")}
+		assert.True(t, b.applyLog(3, 5, entries))
+		assert.True(t, b.TestString("test:node:a"))
+		assert.True(t, b.TestString("test:node:b"))
+		assert.Equal(t, int64(5), b.ver)
+	})
+
+	t.Run("日志已被裁剪时不修改本地位图", func(t *testing.T) {
+		b, _ := newTestBloom(t)
+		b.ver = 3
+		// 版本号 4 的条目已被裁剪
+		assert.False(t, b.applyLog(3, 5, []string{logEntry(b, 5, "test:node:b")}))
+		assert.False(t, b.TestString("test:node:b"))
+		assert.Equal(t, int64(3), b.ver)
+
+		// 条目数正确但版本号不连续
+		assert.False(t, b.applyLog(3, 5, []string{logEntry(b, 5, "test:node:b"), logEntry(b, 6, "test:node:c")}))
+		assert.Equal(t, int64(3), b.ver)
+	})
+
+	t.Run("无法解析的条目", func(t *testing.T) {
+		b, _ := newTestBloom(t)
+		assert.False(t, b.applyLog(0, 1, []string{"1:x"}))
+		assert.False(t, b.applyLog(0, 1, []string{"garbage"}))
+	})
+}
+
+func TestIsDerivedRelationKey(t *testing.T) {
+	assert.True(t, isDerivedRelationKey("p:", "p:relation:list:ids:n1:OUT"))
+	assert.False(t, isDerivedRelationKey("p:", "p:relation:r1"))
+}
@@ -22,6 +22,13 @@ type NodeCache interface {
 	// DeleteNode 从缓存中删除节点信息。
 	// 通常在数据库更新或删除后调用，以保证缓存失效。
 	DeleteNode(ctx context.Context, id string) error
+
+	// GetNodes 批量获取节点，一次往返完成。
+	// 返回的 map 只包含命中的 ID：值为 nil 表示缓存了空值；不在 map 中的 ID 为未命中。
+	GetNodes(ctx context.Context, ids []string) (map[string]*network.Node, error)
+
+	// SetNodes 批量写入节点，值为 nil 的 ID 缓存为空值。
+	SetNodes(ctx context.Context, nodes map[string]*network.Node, ttl time.Duration) error
 }
 
 // RelationCache 定义关系缓存操作接口
@@ -37,6 +44,12 @@ type RelationCache interface {
 
 	// DeleteRelation 从缓存中删除关系信息。
 	DeleteRelation(ctx context.Context, id string) error
+
+	// GetRelations 批量获取关系，语义同 NodeCache.GetNodes。
+	GetRelations(ctx context.Context, ids []string) (map[string]*network.Relation, error)
+
+	// SetRelations 批量写入关系，值为 nil 的 ID 缓存为空值。
+	SetRelations(ctx context.Context, relations map[string]*network.Relation, ttl time.Duration) error
 }
 
 // Cache 定义了一个通用的缓存操作接口，支持泛型。
@@ -67,3 +80,31 @@ type RelationAndByteCache interface {
 	RelationCache
 	Cache[[]byte] // 嵌入通用字节缓存接口
 }
+
+// 反向索引中的标签，与节点 ID 一起传给 IndexKey / InvalidateNodes。
+// 标签登记无法只按结果中的节点失效的派生缓存: 新节点可能开始匹配某个搜索，
+// 任何新关系都可能产生更短的路径。派生缓存应登记到它实际依赖的最窄的标签上
+// (指定类型的搜索登记 NodeTypeTag 而不是 AnyNodeTag)。节点 ID 不应以 '#' 开头。
+const (
+	// AnyNodeTag 在任何节点变更时失效 (未指定类型的搜索、按属性条件查找起始节点的网络)
+	AnyNodeTag = "#nodes"
+	// AnyRelationTag 在任何关系变更或节点删除时失效 (路径和分页/截断的网络结果)
+	AnyRelationTag = "#relations"
+)
+
+// NodeTypeTag 返回在 nodeType 类型的节点变更时失效的标签 (指定类型的搜索)
+func NodeTypeTag(nodeType string) string {
+	return AnyNodeTag + ":" + nodeType
+}
+
+// NodeKeyIndex 维护 "节点 -> 引用该节点的派生缓存键" 的反向索引。
+// 派生缓存 (搜索、网络、路径、节点关系列表) 写入后登记其引用的节点和标签，
+// 节点或关系变更时按受影响的节点和标签删除所有相关的派生缓存。
+type NodeKeyIndex interface {
+	// IndexKey 记录派生缓存键 key 引用了 nodeIDs，ttl 与 key 本身的 TTL 一致。
+	// 登记随 ttl 过期，实现应清理过期的登记，使标签下的索引大小只与仍然存在的键有关。
+	IndexKey(ctx context.Context, key string, nodeIDs []string, ttl time.Duration) error
+
+	// InvalidateNodes 删除引用任一节点的派生缓存键，返回删除的键数量。
+	InvalidateNodes(ctx context.Context, nodeIDs []string) (int64, error)
+}
@@ -0,0 +1,395 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	network "labelwall/biz/model/relationship/network"
+)
+
+// RemoteCache 是 LayeredCache 的二级缓存 (通常为 RedisCache)
+type RemoteCache interface {
+	NodeAndByteCache
+	RelationCache
+	NodeKeyIndex
+}
+
+// LayeredCache 在 RemoteCache 前增加一层进程内 LRU 缓存 (L1)。
+//
+//   - 读: 先查 L1，未命中再查 RemoteCache，节点和关系命中后回填 L1；
+//   - 写: 同时写 L1 和 RemoteCache；
+//   - 删除 / InvalidateNodes: 先删 L1 再删 RemoteCache。
+//     缓存失效消费者使用同一个实例，因此其他实例上的写操作也会删除本实例的 L1。
+//
+// L1 条目的 TTL 不超过构造时指定的 ttl，以此限制未收到失效事件时 (例如未启用 RabbitMQ) 的陈旧时间。
+// L1 中的节点和关系对象在调用方之间共享，调用方不能修改返回的对象。
+type LayeredCache struct {
+	remote RemoteCache
+	ttl    time.Duration
+
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List               // 最近使用的在前
+	entries  map[string]*list.Element // L1 键 -> 链表元素
+	keyNodes map[string][]string      // 派生缓存键 -> 引用的节点 ID
+	nodeKeys map[string]map[string]struct{}
+
+	hits      atomic.Uint64
+	misses    atomic.Uint64
+	evictions atomic.Uint64
+}
+
+// Ensure LayeredCache implements all required interfaces.
+var _ NodeAndByteCache = (*LayeredCache)(nil)
+var _ RelationAndByteCache = (*LayeredCache)(nil)
+var _ NodeKeyIndex = (*LayeredCache)(nil)
+
+// l1Entry 是 L1 中的一个条目，value 为 *network.Node、*network.Relation 或 []byte，
+// isNil 表示缓存的是空值占位符
+type l1Entry struct {
+	key       string
+	value     any
+	isNil     bool
+	expiresAt time.Time
+}
+
+// L1Stats 是 L1 的命中统计
+type L1Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Entries   int
+}
+
+// NewLayeredCache 创建一个新的 LayeredCache 实例。
+// maxEntries: L1 最多保存的条目数 (节点、关系和派生缓存合计)。
+// ttl: L1 条目的最长存活时间。
+func NewLayeredCache(remote RemoteCache, maxEntries int, ttl time.Duration) (*LayeredCache, error) {
+	if remote == nil {
+		return nil, errors.New("remote cache cannot be nil")
+	}
+	if maxEntries <= 0 {
+		return nil, errors.New("L1 max entries must be positive")
+	}
+	if ttl <= 0 {
+		return nil, errors.New("L1 ttl must be positive")
+	}
+	return &LayeredCache{
+		remote:   remote,
+		ttl:      ttl,
+		capacity: maxEntries,
+		ll:       list.New(),
+		entries:  make(map[string]*list.Element),
+		keyNodes: make(map[string][]string),
+		nodeKeys: make(map[string]map[string]struct{}),
+	}, nil
+}
+
+// Remote 返回被包装的二级缓存
+func (c *LayeredCache) Remote() RemoteCache {
+	return c.remote
+}
+
+// Stats 返回 L1 的命中统计
+func (c *LayeredCache) Stats() L1Stats {
+	c.mu.Lock()
+	entries := c.ll.Len()
+	c.mu.Unlock()
+	return L1Stats{
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Evictions: c.evictions.Load(),
+		Entries:   entries,
+	}
+}
+
+// --- NodeCache Implementation ---
+
+func l1NodeKey(id string) string     { return "node:" + id }
+func l1RelationKey(id string) string { return "relation:" + id }
+func l1ByteKey(key string) string    { return "key:" + key }
+
+// GetNode retrieves a node from L1, falling back to the remote cache.
+func (c *LayeredCache) GetNode(ctx context.Context, id string) (*network.Node, error) {
+	key := l1NodeKey(id)
+	if entry, ok := c.get(key); ok {
+		if entry.isNil {
+			return nil, ErrNilValue
+		}
+		return entry.value.(*network.Node), nil
+	}
+
+	node, err := c.remote.GetNode(ctx, id)
+	switch {
+	case err == nil:
+		c.set(key, node, false, c.ttl)
+	case errors.Is(err, ErrNilValue):
+		c.set(key, nil, true, min(c.ttl, NilValueTTL))
+	}
+	return node, err
+}
+
+// SetNode stores a node in both layers. A nil node is cached as a nil placeholder.
+func (c *LayeredCache) SetNode(ctx context.Context, id string, node *network.Node, ttl time.Duration) error {
+	if err := c.remote.SetNode(ctx, id, node, ttl); err != nil {
+		c.remove(l1NodeKey(id))
+		return err
+	}
+	c.set(l1NodeKey(id), node, node == nil, min(c.ttl, ttl))
+	return nil
+}
+
+// DeleteNode removes a node from both layers.
+func (c *LayeredCache) DeleteNode(ctx context.Context, id string) error {
+	c.remove(l1NodeKey(id))
+	return c.remote.DeleteNode(ctx, id)
+}
+
+// --- RelationCache Implementation ---
+
+// GetRelation retrieves a relation from L1, falling back to the remote cache.
+func (c *LayeredCache) GetRelation(ctx context.Context, id string) (*network.Relation, error) {
+	key := l1RelationKey(id)
+	if entry, ok := c.get(key); ok {
+		if entry.isNil {
+			return nil, ErrNilValue
+		}
+		return entry.value.(*network.Relation), nil
+	}
+
+	relation, err := c.remote.GetRelation(ctx, id)
+	switch {
+	case err == nil:
+		c.set(key, relation, false, c.ttl)
+	case errors.Is(err, ErrNilValue):
+		c.set(key, nil, true, min(c.ttl, NilValueTTL))
+	}
+	return relation, err
+}
+
+// SetRelation stores a relation in both layers.
+func (c *LayeredCache) SetRelation(ctx context.Context, id string, relation *network.Relation, ttl time.Duration) error {
+	if err := c.remote.SetRelation(ctx, id, relation, ttl); err != nil {
+		c.remove(l1RelationKey(id))
+		return err
+	}
+	c.set(l1RelationKey(id), relation, relation == nil, min(c.ttl, ttl))
+	return nil
+}
+
+// DeleteRelation removes a relation from both layers.
+func (c *LayeredCache) DeleteRelation(ctx context.Context, id string) error {
+	c.remove(l1RelationKey(id))
+	return c.remote.DeleteRelation(ctx, id)
+}
+
+// --- Multi-key Implementation ---
+
+// GetNodes serves hits from L1 and fetches the rest from the remote cache in one call.
+func (c *LayeredCache) GetNodes(ctx context.Context, ids []string) (map[string]*network.Node, error) {
+	return layeredGetMulti(ctx, c, ids, l1NodeKey, c.remote.GetNodes)
+}
+
+// SetNodes stores several nodes in both layers.
+func (c *LayeredCache) SetNodes(ctx context.Context, nodes map[string]*network.Node, ttl time.Duration) error {
+	return layeredSetMulti(ctx, c, nodes, ttl, l1NodeKey, c.remote.SetNodes)
+}
+
+// GetRelations serves hits from L1 and fetches the rest from the remote cache in one call.
+func (c *LayeredCache) GetRelations(ctx context.Context, ids []string) (map[string]*network.Relation, error) {
+	return layeredGetMulti(ctx, c, ids, l1RelationKey, c.remote.GetRelations)
+}
+
+// SetRelations stores several relations in both layers.
+func (c *LayeredCache) SetRelations(ctx context.Context, relations map[string]*network.Relation, ttl time.Duration) error {
+	return layeredSetMulti(ctx, c, relations, ttl, l1RelationKey, c.remote.SetRelations)
+}
+
+func layeredGetMulti[T any](ctx context.Context, c *LayeredCache, ids []string, keyFn func(string) string,
+	remoteGet func(context.Context, []string) (map[string]*T, error)) (map[string]*T, error) {
+	result := make(map[string]*T, len(ids))
+	misses := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if entry, ok := c.get(keyFn(id)); ok {
+			if entry.isNil {
+				result[id] = nil
+			} else {
+				result[id] = entry.value.(*T)
+			}
+			continue
+		}
+		misses = append(misses, id)
+	}
+	if len(misses) == 0 {
+		return result, nil
+	}
+
+	remote, err := remoteGet(ctx, misses)
+	if err != nil {
+		return nil, err
+	}
+	for id, value := range remote {
+		result[id] = value
+		if value == nil {
+			c.set(keyFn(id), nil, true, min(c.ttl, NilValueTTL))
+		} else {
+			c.set(keyFn(id), value, false, c.ttl)
+		}
+	}
+	return result, nil
+}
+
+func layeredSetMulti[T any](ctx context.Context, c *LayeredCache, items map[string]*T, ttl time.Duration, keyFn func(string) string,
+	remoteSet func(context.Context, map[string]*T, time.Duration) error) error {
+	if err := remoteSet(ctx, items, ttl); err != nil {
+		for id := range items {
+			c.remove(keyFn(id))
+		}
+		return err
+	}
+	for id, value := range items {
+		c.set(keyFn(id), value, value == nil, min(c.ttl, ttl))
+	}
+	return nil
+}
+
+// --- ByteCache Implementation ---
+
+// Get retrieves generic byte data from L1, falling back to the remote cache.
+// 只有本实例写入的键会出现在 L1 中。
+func (c *LayeredCache) Get(ctx context.Context, key string) ([]byte, error) {
+	l1Key := l1ByteKey(key)
+	if entry, ok := c.get(l1Key); ok {
+		if entry.isNil {
+			return nil, ErrNilValue
+		}
+		return entry.value.([]byte), nil
+	}
+
+	// 从 RemoteCache 读到的派生缓存不回填 L1: 本实例不知道它引用了哪些节点，无法在节点变更时删除
+	return c.remote.Get(ctx, key)
+}
+
+// Set stores generic byte data in both layers.
+func (c *LayeredCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if err := c.remote.Set(ctx, key, value, ttl); err != nil {
+		c.remove(l1ByteKey(key))
+		return err
+	}
+	c.set(l1ByteKey(key), value, value == nil, min(c.ttl, ttl))
+	return nil
+}
+
+// Delete removes generic byte data from both layers.
+func (c *LayeredCache) Delete(ctx context.Context, key string) error {
+	c.remove(l1ByteKey(key))
+	return c.remote.Delete(ctx, key)
+}
+
+// --- NodeKeyIndex Implementation ---
+
+// IndexKey records the reference locally (for L1 invalidation) and in the remote index.
+func (c *LayeredCache) IndexKey(ctx context.Context, key string, nodeIDs []string, ttl time.Duration) error {
+	c.mu.Lock()
+	if _, ok := c.entries[l1ByteKey(key)]; ok {
+		c.keyNodes[key] = append(c.keyNodes[key], nodeIDs...)
+		for _, id := range nodeIDs {
+			keys, ok := c.nodeKeys[id]
+			if !ok {
+				keys = make(map[string]struct{})
+				c.nodeKeys[id] = keys
+			}
+			keys[key] = struct{}{}
+		}
+	}
+	c.mu.Unlock()
+	return c.remote.IndexKey(ctx, key, nodeIDs, ttl)
+}
+
+// InvalidateNodes removes derived keys referencing nodeIDs from L1, then from the remote cache.
+func (c *LayeredCache) InvalidateNodes(ctx context.Context, nodeIDs []string) (int64, error) {
+	c.mu.Lock()
+	for _, id := range nodeIDs {
+		for key := range c.nodeKeys[id] {
+			if elem, ok := c.entries[l1ByteKey(key)]; ok {
+				c.removeElement(elem)
+			}
+		}
+	}
+	c.mu.Unlock()
+	return c.remote.InvalidateNodes(ctx, nodeIDs)
+}
+
+// --- LRU ---
+
+// get 返回未过期的 L1 条目并将其移到最前，同时记录命中/未命中
+func (c *LayeredCache) get(key string) (*l1Entry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		c.misses.Add(1)
+		return nil, false
+	}
+	entry := elem.Value.(*l1Entry)
+	if time.Now().After(entry.expiresAt) {
+		c.removeElement(elem)
+		c.misses.Add(1)
+		return nil, false
+	}
+	c.ll.MoveToFront(elem)
+	c.hits.Add(1)
+	return entry, true
+}
+
+// set 写入或替换 L1 条目，超出容量时淘汰最久未使用的条目
+func (c *LayeredCache) set(key string, value any, isNil bool, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		// 值变化后派生键登记的节点可能不同，由随后的 IndexKey 重新登记
+		c.removeElement(elem)
+	}
+	c.entries[key] = c.ll.PushFront(&l1Entry{key: key, value: value, isNil: isNil, expiresAt: time.Now().Add(ttl)})
+	for c.ll.Len() > c.capacity {
+		c.removeElement(c.ll.Back())
+		c.evictions.Add(1)
+	}
+}
+
+func (c *LayeredCache) remove(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		c.removeElement(elem)
+	}
+}
+
+// removeElement 删除条目及其本地索引，调用方必须持有 c.mu
+func (c *LayeredCache) removeElement(elem *list.Element) {
+	entry := elem.Value.(*l1Entry)
+	c.ll.Remove(elem)
+	delete(c.entries, entry.key)
+
+	const bytePrefix = "key:"
+	if len(entry.key) <= len(bytePrefix) || entry.key[:len(bytePrefix)] != bytePrefix {
+		return
+	}
+	key := entry.key[len(bytePrefix):]
+	for _, id := range c.keyNodes[key] {
+		if keys, ok := c.nodeKeys[id]; ok {
+			delete(keys, key)
+			if len(keys) == 0 {
+				delete(c.nodeKeys, id)
+			}
+		}
+	}
+	delete(c.keyNodes, key)
+}
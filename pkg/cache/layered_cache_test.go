@@ -0,0 +1,265 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	network "labelwall/biz/model/relationship/network"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRemote 是内存中的 RemoteCache，记录每种操作的调用次数
+type fakeRemote struct {
+	nodes     map[string]*network.Node
+	relations map[string]*network.Relation
+	values    map[string][]byte
+	index     map[string][]string
+	gets      int
+}
+
+func newFakeRemote() *fakeRemote {
+	return &fakeRemote{
+		nodes:     map[string]*network.Node{},
+		relations: map[string]*network.Relation{},
+		values:    map[string][]byte{},
+		index:     map[string][]string{},
+	}
+}
+
+func (f *fakeRemote) GetNode(ctx context.Context, id string) (*network.Node, error) {
+	f.gets++
+	node, ok := f.nodes[id]
+	switch {
+	case !ok:
+		return nil, ErrNotFound
+	case node == nil:
+		return nil, ErrNilValue
+	}
+	return node, nil
+}
+func (f *fakeRemote) SetNode(ctx context.Context, id string, node *network.Node, ttl time.Duration) error {
+	f.nodes[id] = node
+	return nil
+}
+func (f *fakeRemote) DeleteNode(ctx context.Context, id string) error {
+	delete(f.nodes, id)
+	return nil
+}
+func (f *fakeRemote) GetRelation(ctx context.Context, id string) (*network.Relation, error) {
+	f.gets++
+	rel, ok := f.relations[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return rel, nil
+}
+func (f *fakeRemote) SetRelation(ctx context.Context, id string, relation *network.Relation, ttl time.Duration) error {
+	f.relations[id] = relation
+	return nil
+}
+func (f *fakeRemote) DeleteRelation(ctx context.Context, id string) error {
+	delete(f.relations, id)
+	return nil
+}
+func (f *fakeRemote) GetNodes(ctx context.Context, ids []string) (map[string]*network.Node, error) {
+	f.gets++
+	result := map[string]*network.Node{}
+	for _, id := range ids {
+		if node, ok := f.nodes[id]; ok {
+			result[id] = node
+		}
+	}
+	return result, nil
+}
+func (f *fakeRemote) SetNodes(ctx context.Context, nodes map[string]*network.Node, ttl time.Duration) error {
+	for id, node := range nodes {
+		f.nodes[id] = node
+	}
+	return nil
+}
+func (f *fakeRemote) GetRelations(ctx context.Context, ids []string) (map[string]*network.Relation, error) {
+	f.gets++
+	result := map[string]*network.Relation{}
+	for _, id := range ids {
+		if rel, ok := f.relations[id]; ok {
+			result[id] = rel
+		}
+	}
+	return result, nil
+}
+func (f *fakeRemote) SetRelations(ctx context.Context, relations map[string]*network.Relation, ttl time.Duration) error {
+	for id, rel := range relations {
+		f.relations[id] = rel
+	}
+	return nil
+}
+func (f *fakeRemote) Get(ctx context.Context, key string) ([]byte, error) {
+	f.gets++
+	v, ok := f.values[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return v, nil
+}
+func (f *fakeRemote) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	f.values[key] = value
+	return nil
+}
+func (f *fakeRemote) Delete(ctx context.Context, key string) error {
+	delete(f.values, key)
+	return nil
+}
+func (f *fakeRemote) IndexKey(ctx context.Context, key string, nodeIDs []string, ttl time.Duration) error {
+	for _, id := range nodeIDs {
+		f.index[id] = append(f.index[id], key)
+	}
+	return nil
+}
+func (f *fakeRemote) InvalidateNodes(ctx context.Context, nodeIDs []string) (int64, error) {
+	var n int64
+	for _, id := range nodeIDs {
+		for _, key := range f.index[id] {
+			if _, ok := f.values[key]; ok {
+				delete(f.values, key)
+				n++
+			}
+		}
+		delete(f.index, id)
+	}
+	return n, nil
+}
+
+func TestLayeredCache_NodeReadThrough(t *testing.T) {
+	ctx := context.Background()
+	remote := newFakeRemote()
+	remote.nodes["n1"] = &network.Node{ID: "n1", Name: "Alice"}
+	c, err := NewLayeredCache(remote, 10, time.Minute)
+	require.NoError(t, err)
+
+	node, err := c.GetNode(ctx, "n1")
+	require.NoError(t, err)
+	assert.Equal(t, "Alice", node.Name)
+	node, err = c.GetNode(ctx, "n1")
+	require.NoError(t, err)
+	assert.Equal(t, "Alice", node.Name)
+	assert.Equal(t, 1, remote.gets, "第二次读取由 L1 返回")
+
+	_, err = c.GetNode(ctx, "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	stats := c.Stats()
+	assert.Equal(t, uint64(1), stats.Hits)
+	assert.Equal(t, uint64(2), stats.Misses)
+	assert.Equal(t, 1, stats.Entries)
+}
+
+func TestLayeredCache_NilPlaceholder(t *testing.T) {
+	ctx := context.Background()
+	remote := newFakeRemote()
+	c, err := NewLayeredCache(remote, 10, time.Minute)
+	require.NoError(t, err)
+
+	require.NoError(t, c.SetNode(ctx, "gone", nil, NilValueTTL))
+	_, err = c.GetNode(ctx, "gone")
+	assert.ErrorIs(t, err, ErrNilValue)
+	assert.Equal(t, 0, remote.gets)
+}
+
+func TestLayeredCache_MultiGet(t *testing.T) {
+	ctx := context.Background()
+	remote := newFakeRemote()
+	remote.nodes["n1"] = &network.Node{ID: "n1", Name: "Alice"}
+	remote.nodes["gone"] = nil
+	c, err := NewLayeredCache(remote, 10, time.Minute)
+	require.NoError(t, err)
+
+	_, err = c.GetNode(ctx, "n1")
+	require.NoError(t, err)
+	require.NoError(t, c.SetNodes(ctx, map[string]*network.Node{"n2": {ID: "n2", Name: "Bob"}}, time.Minute))
+	remote.gets = 0
+
+	nodes, err := c.GetNodes(ctx, []string{"n1", "n2", "gone", "missing"})
+	require.NoError(t, err)
+	assert.Equal(t, "Alice", nodes["n1"].Name)
+	assert.Equal(t, "Bob", nodes["n2"].Name)
+	assert.Contains(t, nodes, "gone", "空值占位符视为命中")
+	assert.Nil(t, nodes["gone"])
+	assert.NotContains(t, nodes, "missing")
+	assert.Equal(t, 1, remote.gets, "L1 未命中的键一次批量读取远程缓存")
+
+	remote.gets = 0
+	_, err = c.GetNodes(ctx, []string{"n1", "n2", "gone"})
+	require.NoError(t, err)
+	assert.Zero(t, remote.gets, "全部由 L1 返回")
+}
+
+func TestLayeredCache_WriteInvalidation(t *testing.T) {
+	ctx := context.Background()
+	remote := newFakeRemote()
+	c, err := NewLayeredCache(remote, 10, time.Minute)
+	require.NoError(t, err)
+
+	require.NoError(t, c.SetRelation(ctx, "r1", &network.Relation{ID: "r1"}, time.Hour))
+	require.NoError(t, c.DeleteRelation(ctx, "r1"))
+	_, err = c.GetRelation(ctx, "r1")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, 1, remote.gets, "删除后 L1 不再返回旧值")
+}
+
+func TestLayeredCache_TTLAndEviction(t *testing.T) {
+	ctx := context.Background()
+	remote := newFakeRemote()
+	c, err := NewLayeredCache(remote, 2, time.Minute)
+	require.NoError(t, err)
+
+	for _, id := range []string{"a", "b", "c"} {
+		require.NoError(t, c.SetNode(ctx, id, &network.Node{ID: id}, time.Hour))
+	}
+	assert.Equal(t, uint64(1), c.Stats().Evictions)
+	assert.Equal(t, 2, c.Stats().Entries)
+	_, err = c.GetNode(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, 1, remote.gets, "最久未使用的条目被淘汰，需要回源")
+
+	// 条目 TTL 取较短的一方
+	require.NoError(t, c.SetNode(ctx, "short", &network.Node{ID: "short"}, time.Millisecond))
+	time.Sleep(5 * time.Millisecond)
+	_, err = c.GetNode(ctx, "short")
+	require.NoError(t, err)
+	assert.Equal(t, 2, remote.gets)
+}
+
+func TestLayeredCache_InvalidateNodes(t *testing.T) {
+	ctx := context.Background()
+	remote := newFakeRemote()
+	c, err := NewLayeredCache(remote, 10, time.Minute)
+	require.NoError(t, err)
+
+	require.NoError(t, c.Set(ctx, "network:graph:ids:x", []byte(`{"node_ids":["n1","n2"]}`), time.Hour))
+	require.NoError(t, c.IndexKey(ctx, "network:graph:ids:x", []string{"n1", "n2"}, time.Hour))
+	require.NoError(t, c.Set(ctx, "search:nodes:ids:y", []byte(`{"node_ids":["n3"]}`), time.Hour))
+	require.NoError(t, c.IndexKey(ctx, "search:nodes:ids:y", []string{"n3"}, time.Hour))
+
+	_, err = c.Get(ctx, "network:graph:ids:x")
+	require.NoError(t, err)
+	assert.Equal(t, 0, remote.gets)
+
+	deleted, err := c.InvalidateNodes(ctx, []string{"n2"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	_, err = c.Get(ctx, "network:graph:ids:x")
+	assert.ErrorIs(t, err, ErrNotFound, "L1 和远程的派生缓存都被删除")
+	_, err = c.Get(ctx, "search:nodes:ids:y")
+	require.NoError(t, err)
+	assert.Equal(t, 1, remote.gets, "无关的派生缓存仍由 L1 返回")
+
+	// 本地索引随条目一起清理
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	assert.NotContains(t, c.keyNodes, "network:graph:ids:x")
+	assert.NotContains(t, c.nodeKeys, "n1")
+}
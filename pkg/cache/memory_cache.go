@@ -0,0 +1,244 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	network "labelwall/biz/model/relationship/network"
+)
+
+// MemoryCache 是进程内的 RemoteCache 实现，键空间和语义与 RedisCache 一致
+// (空值占位符、ErrNotFound / ErrNilValue、派生缓存的节点反向索引)，但不在实例间共享。
+// 用于不依赖 Redis 的测试，也可以作为 LayeredCache 的二级缓存。
+//
+// 值按 JSON 序列化保存，调用方修改返回的对象不会影响缓存。过期的条目在读取时删除。
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]memoryEntry
+	index   map[string]map[string]time.Time // 节点 ID 或标签 -> 派生缓存键 -> 登记的过期时间
+}
+
+// Ensure MemoryCache implements all required interfaces.
+var _ RemoteCache = (*MemoryCache)(nil)
+var _ RelationAndByteCache = (*MemoryCache)(nil)
+
+type memoryEntry struct {
+	value     []byte
+	expiresAt time.Time // 零值表示不过期
+}
+
+// NewMemoryCache 创建一个空的 MemoryCache
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{
+		entries: make(map[string]memoryEntry),
+		index:   make(map[string]map[string]time.Time),
+	}
+}
+
+// --- NodeCache / RelationCache Implementation ---
+
+// GetNode retrieves a node from the cache.
+func (c *MemoryCache) GetNode(ctx context.Context, id string) (*network.Node, error) {
+	return getValue[network.Node](c, "node:"+id)
+}
+
+// SetNode stores a node in the cache. A nil node is stored as the nil placeholder.
+func (c *MemoryCache) SetNode(ctx context.Context, id string, node *network.Node, ttl time.Duration) error {
+	return setValue(c, "node:"+id, node, ttl)
+}
+
+// DeleteNode removes a node from the cache.
+func (c *MemoryCache) DeleteNode(ctx context.Context, id string) error {
+	return c.Delete(ctx, "node:"+id)
+}
+
+// GetNodes retrieves several nodes; missing IDs are left out of the result.
+func (c *MemoryCache) GetNodes(ctx context.Context, ids []string) (map[string]*network.Node, error) {
+	return getValues[network.Node](c, ids, "node:")
+}
+
+// SetNodes stores several nodes.
+func (c *MemoryCache) SetNodes(ctx context.Context, nodes map[string]*network.Node, ttl time.Duration) error {
+	return setValues(c, nodes, ttl, "node:")
+}
+
+// GetRelation retrieves a relation from the cache.
+func (c *MemoryCache) GetRelation(ctx context.Context, id string) (*network.Relation, error) {
+	return getValue[network.Relation](c, "relation:"+id)
+}
+
+// SetRelation stores a relation in the cache. A nil relation is stored as the nil placeholder.
+func (c *MemoryCache) SetRelation(ctx context.Context, id string, relation *network.Relation, ttl time.Duration) error {
+	return setValue(c, "relation:"+id, relation, ttl)
+}
+
+// DeleteRelation removes a relation from the cache.
+func (c *MemoryCache) DeleteRelation(ctx context.Context, id string) error {
+	return c.Delete(ctx, "relation:"+id)
+}
+
+// GetRelations retrieves several relations; missing IDs are left out of the result.
+func (c *MemoryCache) GetRelations(ctx context.Context, ids []string) (map[string]*network.Relation, error) {
+	return getValues[network.Relation](c, ids, "relation:")
+}
+
+// SetRelations stores several relations.
+func (c *MemoryCache) SetRelations(ctx context.Context, relations map[string]*network.Relation, ttl time.Duration) error {
+	return setValues(c, relations, ttl, "relation:")
+}
+
+func getValue[T any](c *MemoryCache, key string) (*T, error) {
+	raw, err := c.load(key)
+	if err != nil {
+		return nil, err
+	}
+	var value T
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s: %w", key, err)
+	}
+	return &value, nil
+}
+
+func setValue[T any](c *MemoryCache, key string, value *T, ttl time.Duration) error {
+	raw := []byte(NilValuePlaceholder)
+	if value != nil {
+		var err error
+		if raw, err = json.Marshal(value); err != nil {
+			return fmt.Errorf("failed to marshal %s: %w", key, err)
+		}
+	}
+	c.store(key, raw, ttl)
+	return nil
+}
+
+func getValues[T any](c *MemoryCache, ids []string, prefix string) (map[string]*T, error) {
+	result := make(map[string]*T, len(ids))
+	for _, id := range ids {
+		value, err := getValue[T](c, prefix+id)
+		switch {
+		case err == nil:
+			result[id] = value
+		case errors.Is(err, ErrNilValue):
+			result[id] = nil
+		}
+	}
+	return result, nil
+}
+
+func setValues[T any](c *MemoryCache, items map[string]*T, ttl time.Duration, prefix string) error {
+	for id, item := range items {
+		if err := setValue(c, prefix+id, item, ttl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// --- ByteCache Implementation ---
+
+// Get retrieves generic byte data from the cache.
+func (c *MemoryCache) Get(ctx context.Context, key string) ([]byte, error) {
+	raw, err := c.load(key)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.Clone(raw), nil
+}
+
+// Set stores generic byte data in the cache. A nil value is stored as the nil placeholder.
+func (c *MemoryCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if value == nil {
+		value = []byte(NilValuePlaceholder)
+	}
+	c.store(key, bytes.Clone(value), ttl)
+	return nil
+}
+
+// Delete removes generic byte data from the cache.
+func (c *MemoryCache) Delete(ctx context.Context, key string) error {
+	c.mu.Lock()
+	delete(c.entries, key)
+	c.mu.Unlock()
+	return nil
+}
+
+// load 返回未过期的条目，空值占位符返回 ErrNilValue
+func (c *MemoryCache) load(key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, ErrNotFound
+	}
+	if bytes.Equal(entry.value, []byte(NilValuePlaceholder)) {
+		return nil, ErrNilValue
+	}
+	return entry.value, nil
+}
+
+// store 保存条目，ttl <= 0 表示不过期 (与 Redis SET 一致)
+func (c *MemoryCache) store(key string, value []byte, ttl time.Duration) {
+	entry := memoryEntry{value: value}
+	if ttl > 0 {
+		entry.expiresAt = time.Now().Add(ttl)
+	}
+	c.mu.Lock()
+	c.entries[key] = entry
+	c.mu.Unlock()
+}
+
+// --- NodeKeyIndex Implementation ---
+
+// IndexKey records that key references nodeIDs until ttl elapses.
+// 登记时顺带清理同一节点/标签下已过期的登记，标签下的成员数量不会随历史键无限增长。
+func (c *MemoryCache) IndexKey(ctx context.Context, key string, nodeIDs []string, ttl time.Duration) error {
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, id := range nodeIDs {
+		if id == "" {
+			continue
+		}
+		keys, ok := c.index[id]
+		if !ok {
+			keys = make(map[string]time.Time)
+			c.index[id] = keys
+		}
+		for indexed, at := range keys {
+			if !at.After(now) {
+				delete(keys, indexed)
+			}
+		}
+		if expiresAt.After(keys[key]) {
+			keys[key] = expiresAt
+		}
+	}
+	return nil
+}
+
+// InvalidateNodes deletes every derived key indexed under nodeIDs.
+func (c *MemoryCache) InvalidateNodes(ctx context.Context, nodeIDs []string) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var deleted int64
+	for _, id := range nodeIDs {
+		for key := range c.index[id] {
+			if _, ok := c.entries[key]; ok {
+				delete(c.entries, key)
+				deleted++
+			}
+		}
+		delete(c.index, id)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,86 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	network "labelwall/biz/model/relationship/network"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryCacheNodes(t *testing.T) {
+	ctx := context.Background()
+	c := NewMemoryCache()
+
+	_, err := c.GetNode(ctx, "n1")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, c.SetNode(ctx, "n1", &network.Node{ID: "n1", Name: "Alice"}, time.Minute))
+	require.NoError(t, c.SetNode(ctx, "n2", nil, time.Minute))
+
+	got, err := c.GetNode(ctx, "n1")
+	require.NoError(t, err)
+	got.Name = "changed"
+	again, _ := c.GetNode(ctx, "n1")
+	assert.Equal(t, "Alice", again.Name, "返回的对象是副本")
+
+	_, err = c.GetNode(ctx, "n2")
+	assert.ErrorIs(t, err, ErrNilValue)
+
+	nodes, err := c.GetNodes(ctx, []string{"n1", "n2", "n3"})
+	require.NoError(t, err)
+	assert.Len(t, nodes, 2)
+	assert.Nil(t, nodes["n2"], "空值占位符以 nil 返回")
+
+	require.NoError(t, c.DeleteNode(ctx, "n1"))
+	_, err = c.GetNode(ctx, "n1")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestMemoryCacheExpiry(t *testing.T) {
+	ctx := context.Background()
+	c := NewMemoryCache()
+
+	require.NoError(t, c.Set(ctx, "k", []byte("v"), time.Millisecond))
+	require.NoError(t, c.Set(ctx, "forever", []byte("v"), 0))
+	time.Sleep(5 * time.Millisecond)
+
+	_, err := c.Get(ctx, "k")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = c.Get(ctx, "forever")
+	assert.NoError(t, err)
+}
+
+func TestMemoryCacheInvalidateNodes(t *testing.T) {
+	ctx := context.Background()
+	c := NewMemoryCache()
+
+	require.NoError(t, c.Set(ctx, "search:a", []byte("[]"), time.Minute))
+	require.NoError(t, c.Set(ctx, "path:b", []byte("[]"), time.Minute))
+	require.NoError(t, c.IndexKey(ctx, "search:a", []string{"n1", AnyNodeTag}, time.Minute))
+	require.NoError(t, c.IndexKey(ctx, "path:b", []string{"n2", AnyRelationTag}, time.Minute))
+
+	deleted, err := c.InvalidateNodes(ctx, []string{AnyNodeTag})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	_, err = c.Get(ctx, "search:a")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = c.Get(ctx, "path:b")
+	assert.NoError(t, err)
+}
+
+func TestMemoryCacheIndexKeyPrunesExpired(t *testing.T) {
+	ctx := context.Background()
+	c := NewMemoryCache()
+
+	require.NoError(t, c.IndexKey(ctx, "search:old", []string{AnyNodeTag}, time.Millisecond))
+	time.Sleep(5 * time.Millisecond)
+	require.NoError(t, c.IndexKey(ctx, "search:new", []string{AnyNodeTag}, time.Minute))
+
+	assert.Len(t, c.index[AnyNodeTag], 1, "过期的登记应在下一次登记时清理")
+	assert.Contains(t, c.index[AnyNodeTag], "search:new")
+}
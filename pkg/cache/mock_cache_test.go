@@ -34,6 +34,17 @@ func (m *MockNodeCache) DeleteNode(ctx context.Context, id string) error {
 	return args.Error(0)
 }
 
+func (m *MockNodeCache) GetNodes(ctx context.Context, ids []string) (map[string]*network.Node, error) {
+	args := m.Called(ctx, ids)
+	nodes, _ := args.Get(0).(map[string]*network.Node)
+	return nodes, args.Error(1)
+}
+
+func (m *MockNodeCache) SetNodes(ctx context.Context, nodes map[string]*network.Node, ttl time.Duration) error {
+	args := m.Called(ctx, nodes, ttl)
+	return args.Error(0)
+}
+
 // --- Mock RelationCache ---
 
 type MockRelationCache struct {
@@ -56,6 +67,17 @@ func (m *MockRelationCache) DeleteRelation(ctx context.Context, id string) error
 	return args.Error(0)
 }
 
+func (m *MockRelationCache) GetRelations(ctx context.Context, ids []string) (map[string]*network.Relation, error) {
+	args := m.Called(ctx, ids)
+	rels, _ := args.Get(0).(map[string]*network.Relation)
+	return rels, args.Error(1)
+}
+
+func (m *MockRelationCache) SetRelations(ctx context.Context, relations map[string]*network.Relation, ttl time.Duration) error {
+	args := m.Called(ctx, relations, ttl)
+	return args.Error(0)
+}
+
 // --- Mock Generic Cache[[]byte] ---
 // We need a specific mock for Cache[[]byte] to be embedded
 type MockByteCache struct {
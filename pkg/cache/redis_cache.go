@@ -1,7 +1,6 @@
 package cache
 
 //TODO: 使用日志库
-//TODO: 使用布隆过滤器
 
 import (
 	"bytes"
@@ -10,12 +9,12 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"strconv"
 	"time"
 
 	network "labelwall/biz/model/relationship/network"
 
 	"github.com/redis/go-redis/v9"
-	"github.com/willf/bloom" // Import Bloom filter library
 )
 
 const (
@@ -29,10 +28,12 @@ const (
 
 // redisCache implements the Cache interface using Redis.
 // It includes NodeCache, RelationCache, and generic ByteCache functionality.
+// The client may be a single-node, Sentinel (failover) or Cluster client.
 type RedisCache struct {
-	client *redis.Client
-	prefix string             // Prefix for all keys managed by this cache instance
-	filter *bloom.BloomFilter // Bloom filter instance
+	client  redis.UniversalClient
+	cluster bool         // Cluster mode: multi-key commands must not span hash slots
+	prefix  string       // Prefix for all keys managed by this cache instance
+	filter  *sharedBloom // Bloom filter of node/relation keys, shared through Redis
 }
 
 // Ensure RedisCache implements all required interfaces.
@@ -40,21 +41,25 @@ var _ NodeCache = (*RedisCache)(nil)
 var _ RelationCache = (*RedisCache)(nil)
 var _ NodeAndByteCache = (*RedisCache)(nil)
 var _ RelationAndByteCache = (*RedisCache)(nil)
+var _ NodeKeyIndex = (*RedisCache)(nil)
 
 // NewRedisCache creates a new RedisCache instance.
+// client: a *redis.Client, a Sentinel failover client or a *redis.ClusterClient.
 // estimatedKeys: Estimated number of unique items (nodes + relations + other keys) the cache will hold.
 // fpRate: Desired false positive rate for the Bloom filter (e.g., 0.01 for 1%).
-func NewRedisCache(client *redis.Client, prefix string, estimatedKeys uint, fpRate float64) (*RedisCache, error) {
+func NewRedisCache(client redis.UniversalClient, prefix string, estimatedKeys uint, fpRate float64) (*RedisCache, error) {
 	if client == nil {
 		return nil, errors.New("redis client cannot be nil")
 	}
-	// Initialize Bloom filter
-	filter := bloom.NewWithEstimates(estimatedKeys, fpRate)
+	// Initialize Bloom filter. It starts empty; call SyncBloomFilter to load the shared bitmap.
+	filter := newSharedBloom(client, prefix, estimatedKeys, fpRate)
 
+	_, cluster := client.(*redis.ClusterClient)
 	return &RedisCache{
-		client: client,
-		prefix: prefix,
-		filter: filter,
+		client:  client,
+		cluster: cluster,
+		prefix:  prefix,
+		filter:  filter,
 	}, nil
 }
 
@@ -114,14 +119,16 @@ func (c *RedisCache) SetNode(ctx context.Context, id string, node *network.Node,
 		// Add the key to the Bloom filter *only* if storing a real node
 	}
 
-	// Add the key to the Bloom filter regardless of whether it's a placeholder or real node.
-	// This allows GetNode to find nil placeholders after passing the filter check.
-	c.filter.AddString(key)
-
 	// Apply jitter to TTL before setting
 	ttlWithJitter := addJitter(ttl)
 
-	if err := c.client.Set(ctx, key, valBytes, ttlWithJitter).Err(); err != nil {
+	// Add the key to the Bloom filter regardless of whether it's a placeholder or real node.
+	// This allows GetNode to find nil placeholders after passing the filter check.
+	// The shared bitmap is updated in the same pipeline as the value, so no extra round-trip.
+	pipe := c.client.Pipeline()
+	c.filter.Add(ctx, pipe, key)
+	pipe.Set(ctx, key, valBytes, ttlWithJitter)
+	if _, err := pipe.Exec(ctx); err != nil {
 		// Consider if we should attempt to remove from filter if Set fails? Might be overly complex.
 		return fmt.Errorf("redis Set failed for node %s: %w", id, err)
 	}
@@ -134,6 +141,7 @@ func (c *RedisCache) DeleteNode(ctx context.Context, id string) error {
 	// Note: Standard Bloom filters don't support deletion easily.
 	// We simply delete from Redis. If the item is re-added later, the filter
 	// might already contain it (which is acceptable). False negatives are avoided.
+	// Stale bits are cleared by the periodic RebuildBloomFilter.
 	if err := c.client.Del(ctx, key).Err(); err != nil {
 		if errors.Is(err, redis.Nil) {
 			return ErrNotFound // Or return nil, as deleting non-existent is often okay
@@ -196,13 +204,14 @@ func (c *RedisCache) SetRelation(ctx context.Context, id string, relation *netwo
 		// Add the key to the Bloom filter *only* if storing a real relation
 	}
 
-	// Add the key to the Bloom filter regardless of whether it's a placeholder or real relation.
-	c.filter.AddString(key)
-
 	// Apply jitter to TTL before setting
 	ttlWithJitter := addJitter(ttl)
 
-	if err := c.client.Set(ctx, key, valBytes, ttlWithJitter).Err(); err != nil {
+	// Add the key to the Bloom filter regardless of whether it's a placeholder or real relation.
+	pipe := c.client.Pipeline()
+	c.filter.Add(ctx, pipe, key)
+	pipe.Set(ctx, key, valBytes, ttlWithJitter)
+	if _, err := pipe.Exec(ctx); err != nil {
 		return fmt.Errorf("redis Set failed for relation %s: %w", id, err)
 	}
 	return nil
@@ -221,6 +230,121 @@ func (c *RedisCache) DeleteRelation(ctx context.Context, id string) error {
 	return nil
 }
 
+// --- Multi-key Implementation ---
+
+// GetNodes retrieves several nodes with a single MGET.
+func (c *RedisCache) GetNodes(ctx context.Context, ids []string) (map[string]*network.Node, error) {
+	return getMulti[network.Node](ctx, c, ids, c.nodeKey)
+}
+
+// SetNodes stores several nodes in one pipeline.
+func (c *RedisCache) SetNodes(ctx context.Context, nodes map[string]*network.Node, ttl time.Duration) error {
+	return setMulti(ctx, c, nodes, ttl, c.nodeKey)
+}
+
+// GetRelations retrieves several relations with a single MGET.
+func (c *RedisCache) GetRelations(ctx context.Context, ids []string) (map[string]*network.Relation, error) {
+	return getMulti[network.Relation](ctx, c, ids, c.relationKey)
+}
+
+// SetRelations stores several relations in one pipeline.
+func (c *RedisCache) SetRelations(ctx context.Context, relations map[string]*network.Relation, ttl time.Duration) error {
+	return setMulti(ctx, c, relations, ttl, c.relationKey)
+}
+
+// getMulti skips keys rejected by the Bloom filter and fetches the rest with one mget.
+// Values that fail to unmarshal are treated as misses so the caller reloads them.
+func getMulti[T any](ctx context.Context, c *RedisCache, ids []string, keyFn func(string) string) (map[string]*T, error) {
+	result := make(map[string]*T, len(ids))
+	keys := make([]string, 0, len(ids))
+	keyIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		key := keyFn(id)
+		if c.filter.TestString(key) {
+			keys = append(keys, key)
+			keyIDs = append(keyIDs, id)
+		}
+	}
+	if len(keys) == 0 {
+		return result, nil
+	}
+
+	values, err := c.mget(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	for i, raw := range values {
+		str, ok := raw.(string)
+		if !ok {
+			continue // redis.Nil
+		}
+		if str == NilValuePlaceholder {
+			result[keyIDs[i]] = nil
+			continue
+		}
+		var value T
+		if err := json.Unmarshal([]byte(str), &value); err != nil {
+			continue
+		}
+		result[keyIDs[i]] = &value
+	}
+	return result, nil
+}
+
+// mget reads several keys in one round-trip. Node and relation keys are spread over hash slots,
+// so in Cluster mode a single MGET would fail with CROSSSLOT; the keys are read with pipelined GETs
+// instead, which the cluster client splits per node and sends concurrently.
+// Missing keys yield nil, as with MGET.
+func (c *RedisCache) mget(ctx context.Context, keys []string) ([]any, error) {
+	if !c.cluster {
+		values, err := c.client.MGet(ctx, keys...).Result()
+		if err != nil {
+			return nil, fmt.Errorf("redis MGet failed for %d keys: %w", len(keys), err)
+		}
+		return values, nil
+	}
+
+	pipe := c.client.Pipeline()
+	cmds := make([]*redis.StringCmd, len(keys))
+	for i, key := range keys {
+		cmds[i] = pipe.Get(ctx, key)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, fmt.Errorf("redis pipeline Get failed for %d keys: %w", len(keys), err)
+	}
+	values := make([]any, len(keys))
+	for i, cmd := range cmds {
+		if val, err := cmd.Result(); err == nil {
+			values[i] = val
+		}
+	}
+	return values, nil
+}
+
+// setMulti writes every value (nil as the placeholder) and its Bloom filter bits in one pipeline.
+func setMulti[T any](ctx context.Context, c *RedisCache, items map[string]*T, ttl time.Duration, keyFn func(string) string) error {
+	if len(items) == 0 {
+		return nil
+	}
+	pipe := c.client.Pipeline()
+	for id, item := range items {
+		valBytes := []byte(NilValuePlaceholder)
+		if item != nil {
+			var err error
+			if valBytes, err = json.Marshal(item); err != nil {
+				return fmt.Errorf("failed to marshal %s: %w", id, err)
+			}
+		}
+		key := keyFn(id)
+		c.filter.Add(ctx, pipe, key)
+		pipe.Set(ctx, key, valBytes, addJitter(ttl))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("redis pipeline Set failed for %d keys: %w", len(items), err)
+	}
+	return nil
+}
+
 // --- ByteCache Implementation ---
 
 // Get retrieves generic byte data from the cache.
@@ -261,10 +385,8 @@ func (c *RedisCache) Set(ctx context.Context, key string, value []byte, ttl time
 		// addKeyToFilter = false // Don't add nil placeholders to filter // <<< Logic removed
 	}
 
-	// Add to Bloom filter regardless of nil placeholder.
-	// Decide if *all* generic keys should be added or only specific ones based on usage patterns.
-	// For now, adding all keys prefixed by this cache instance.
-	c.filter.AddString(fullKey)
+	// Generic keys are not added to the Bloom filter: Get does not check it, and
+	// keeping them out keeps the shared bitmap (and its rebuild scan) to node/relation keys.
 
 	// Apply jitter to TTL before setting
 	ttlWithJitter := addJitter(ttl)
@@ -273,11 +395,6 @@ func (c *RedisCache) Set(ctx context.Context, key string, value []byte, ttl time
 		return fmt.Errorf("redis Set failed for key %s: %w", key, err)
 	}
 
-	// Add to Bloom filter only if it wasn't a nil placeholder // <<< Logic removed and moved up
-	// Consider if *all* generic keys should be added or only specific ones.
-	// if addKeyToFilter {
-	//     c.filter.AddString(fullKey) // Decide if generic keys go into the filter
-	// }
 	return nil
 }
 
@@ -293,6 +410,133 @@ func (c *RedisCache) Delete(ctx context.Context, key string) error {
 	return nil
 }
 
+// --- Bloom Filter Maintenance ---
+
+// SyncBloomFilter merges the shared Bloom filter bitmap stored in Redis into the local copy.
+// 启动时调用一次以恢复重启前的过滤器，之后定期调用以获得其他实例写入的键。
+func (c *RedisCache) SyncBloomFilter(ctx context.Context) error {
+	return c.filter.Sync(ctx)
+}
+
+// RebuildBloomFilter regenerates the shared bitmap from the node/relation keys currently in Redis,
+// dropping bits left by expired or deleted keys. 返回 false 表示其他实例正在重建。
+// lockTTL 应大于一次重建 (SCAN 全部缓存键) 所需的时间。
+func (c *RedisCache) RebuildBloomFilter(ctx context.Context, lockTTL time.Duration) (bool, error) {
+	patterns := []string{c.prefix + "node:*", c.prefix + "relation:*"}
+	return c.filter.Rebuild(ctx, lockTTL, patterns, func(key string) bool {
+		return isDerivedRelationKey(c.prefix, key)
+	})
+}
+
+// --- NodeKeyIndex Implementation ---
+
+// nodeIndexKey generates the Redis key of the reverse index for a node or tag.
+// 索引是有序集合，分数为登记的过期时间 (Unix 毫秒)。
+// 旧版本使用 idx:node: 前缀的 SET，换用新前缀避免类型冲突，旧集合到期后自然消失。
+func (c *RedisCache) nodeIndexKey(id string) string {
+	return c.prefix + "idx:nodekeys:" + id
+}
+
+// indexReadBatch 是 InvalidateNodes 每轮从一个索引中读取的成员数上限
+const indexReadBatch = 1000
+
+// IndexKey adds key to the reverse index of every node or tag in nodeIDs.
+// 每个成员的分数是它的过期时间，登记时顺带删除同一索引中已过期的成员，
+// 因此 AnyNodeTag 这类热点标签下的成员数只与仍然存在的键有关。
+// 索引本身的 TTL 只会延长不会缩短 (EXPIRE NX + GT，需要 Redis 7)，
+// 并比被索引的键多保留 jitter 上限加一分钟，保证键存在期间登记一定存在。
+func (c *RedisCache) IndexKey(ctx context.Context, key string, nodeIDs []string, ttl time.Duration) error {
+	if len(nodeIDs) == 0 {
+		return nil
+	}
+	indexTTL := ttl + time.Duration(DefaultTTLJitterPercent*float64(ttl)) + time.Minute
+	now := time.Now()
+	member := redis.Z{Score: float64(now.Add(indexTTL).UnixMilli()), Member: key}
+	expired := strconv.FormatInt(now.UnixMilli(), 10)
+
+	seen := make(map[string]struct{}, len(nodeIDs))
+	pipe := c.client.Pipeline()
+	for _, id := range nodeIDs {
+		if _, dup := seen[id]; dup || id == "" {
+			continue
+		}
+		seen[id] = struct{}{}
+		indexKey := c.nodeIndexKey(id)
+		pipe.ZRemRangeByScore(ctx, indexKey, "-inf", expired)
+		pipe.ZAddGT(ctx, indexKey, member)
+		pipe.ExpireNX(ctx, indexKey, indexTTL)
+		pipe.ExpireGT(ctx, indexKey, indexTTL)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("redis index failed for key %s: %w", key, err)
+	}
+	return nil
+}
+
+// InvalidateNodes deletes every derived key indexed under nodeIDs.
+// 每轮每个索引只读取 indexReadBatch 个未过期的成员，删除对应的键后从索引中移除，直到索引读空；
+// 过期的成员不再读取，直接按分数删除。只移除本次读到的成员，期间新登记的键不受影响。
+func (c *RedisCache) InvalidateNodes(ctx context.Context, nodeIDs []string) (int64, error) {
+	pending := make([]string, 0, len(nodeIDs))
+	seen := make(map[string]struct{}, len(nodeIDs))
+	for _, id := range nodeIDs {
+		if _, dup := seen[id]; dup || id == "" {
+			continue
+		}
+		seen[id] = struct{}{}
+		pending = append(pending, id)
+	}
+
+	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	live := &redis.ZRangeBy{Min: "(" + now, Max: "+inf", Count: indexReadBatch}
+	var deleted int64
+	for len(pending) > 0 {
+		// 1. 读取每个索引中的一批未过期成员
+		readPipe := c.client.Pipeline()
+		members := make([]*redis.StringSliceCmd, len(pending))
+		for i, id := range pending {
+			members[i] = readPipe.ZRangeByScore(ctx, c.nodeIndexKey(id), live)
+		}
+		if _, err := readPipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+			return deleted, fmt.Errorf("redis read index failed: %w", err)
+		}
+
+		// 2. 删除派生键并从索引中移除
+		keys := make(map[string]struct{})
+		writePipe := c.client.Pipeline()
+		var next []string
+		for i, id := range pending {
+			indexKey := c.nodeIndexKey(id)
+			writePipe.ZRemRangeByScore(ctx, indexKey, "-inf", now)
+			indexed := members[i].Val()
+			if len(indexed) == 0 {
+				continue
+			}
+			removed := make([]any, len(indexed))
+			for j, key := range indexed {
+				keys[key] = struct{}{}
+				removed[j] = key
+			}
+			writePipe.ZRem(ctx, indexKey, removed...)
+			if len(indexed) == indexReadBatch {
+				next = append(next, id) // 可能还有未读取的成员
+			}
+		}
+		dels := make([]*redis.IntCmd, 0, len(keys))
+		for key := range keys {
+			dels = append(dels, writePipe.Del(ctx, c.prefix+key))
+		}
+		if _, err := writePipe.Exec(ctx); err != nil {
+			return deleted, fmt.Errorf("redis invalidate failed: %w", err)
+		}
+		for _, cmd := range dels {
+			deleted += cmd.Val()
+		}
+		pending = next
+	}
+	return deleted, nil
+}
+
 // --- 辅助函数 ---
 
 // addJitter 为 TTL 增加随机偏移，防止缓存雪崩
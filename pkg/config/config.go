@@ -25,8 +25,9 @@ type ServerConfig struct {
 
 // DatabaseConfig 包含所有数据库的配置
 type DatabaseConfig struct {
-	Neo4j Neo4jConfig `mapstructure:"neo4j"`
-	Redis RedisConfig `mapstructure:"redis"`
+	Backend string      `mapstructure:"backend"` // 图存储后端: neo4j (默认) / memory (进程内内存图，重启后数据丢失)
+	Neo4j   Neo4jConfig `mapstructure:"neo4j"`
+	Redis   RedisConfig `mapstructure:"redis"`
 }
 
 // Neo4jConfig Neo4j 连接配置
@@ -39,11 +40,22 @@ type Neo4jConfig struct {
 	MaxConnectionLifetime        int    `mapstructure:"max_connection_lifetime_seconds"`        // 连接最大生命周期（秒）
 }
 
+// Redis 部署模式
+const (
+	RedisModeStandalone = "standalone" // 单机 (默认)
+	RedisModeSentinel   = "sentinel"   // Sentinel 主从自动故障转移
+	RedisModeCluster    = "cluster"    // Redis Cluster
+)
+
 // RedisConfig Redis 连接配置
 type RedisConfig struct {
-	Addr     string `mapstructure:"addr"`
-	Password string `mapstructure:"password"`
-	DB       int    `mapstructure:"db"`
+	Mode             string   `mapstructure:"mode"`              // standalone (默认) / sentinel / cluster
+	Addr             string   `mapstructure:"addr"`              // standalone 模式的地址
+	Addrs            []string `mapstructure:"addrs"`             // sentinel 模式的 Sentinel 地址，或 cluster 模式的种子节点地址
+	MasterName       string   `mapstructure:"master_name"`       // sentinel 模式监控的主节点名称
+	SentinelPassword string   `mapstructure:"sentinel_password"` // Sentinel 自身的密码 (如果需要)
+	Password         string   `mapstructure:"password"`
+	DB               int      `mapstructure:"db"` // cluster 模式只支持 0
 }
 
 // CacheConfig 缓存相关配置
@@ -51,9 +63,24 @@ type CacheConfig struct {
 	Prefix        string         `mapstructure:"prefix"`
 	EstimatedKeys uint           `mapstructure:"estimated_keys"`
 	FpRate        float64        `mapstructure:"fp_rate"`
+	Bloom         BloomConfig    `mapstructure:"bloom"`
+	L1            L1CacheConfig  `mapstructure:"l1"`
 	TTL           CacheTTLConfig `mapstructure:"ttl"`
 }
 
+// L1CacheConfig 进程内 L1 缓存配置
+type L1CacheConfig struct {
+	Enabled    bool `mapstructure:"enabled"`
+	MaxEntries int  `mapstructure:"max_entries"` // 最多缓存的条目数
+	TTLSeconds int  `mapstructure:"ttl_seconds"` // 条目最长存活时间 (秒)，限制未收到失效事件时的陈旧时间
+}
+
+// BloomConfig 共享布隆过滤器的同步与重建配置
+type BloomConfig struct {
+	SyncIntervalSeconds    int `mapstructure:"sync_interval_seconds"`    // 从 Redis 合并位图的间隔，<= 0 使用默认值
+	RebuildIntervalMinutes int `mapstructure:"rebuild_interval_minutes"` // 扫描缓存键重建位图的间隔，<= 0 使用默认值
+}
+
 // CacheTTLConfig 缓存过期时间配置 (单位：秒)
 type CacheTTLConfig struct {
 	DefaultNode      int `mapstructure:"default_node"`
@@ -67,7 +94,8 @@ type CacheTTLConfig struct {
 
 // RepoConfig 仓库层相关配置
 type RepoConfig struct {
-	QueryParams RepoQueryConfig `mapstructure:"query_params"`
+	QueryParams               RepoQueryConfig `mapstructure:"query_params"`
+	TypeReloadIntervalSeconds int             `mapstructure:"type_reload_interval_seconds"` // 从存储重新加载类型注册表的间隔，<= 0 使用默认值 (30 秒)
 }
 
 // RepoQueryConfig 仓库查询参数配置
@@ -77,6 +105,7 @@ type RepoQueryConfig struct {
 	GetPathMaxDepthLimit         int `mapstructure:"get_path_max_depth_limit"`
 	SearchNodesDefaultLimit      int `mapstructure:"search_nodes_default_limit"`
 	GetNodeRelationsDefaultLimit int `mapstructure:"get_node_relations_default_limit"`
+	QueryTimeoutSeconds          int `mapstructure:"query_timeout_seconds"` // 缓存未命中时合并执行的查询的时限，<= 0 使用默认值 (30 秒)
 }
 
 // LoggingConfig 日志相关配置
@@ -90,6 +119,15 @@ type RabbitMQConfig struct {
 	URL     string `mapstructure:"url"`
 	VHost   string `mapstructure:"vhost"`
 	// ConnectionPoolSize int `mapstructure:"connection_pool_size"` // 可选，未来可添加
+	Outbox OutboxConfig `mapstructure:"outbox"`
+}
+
+// OutboxConfig 发件箱 relay 配置 (0 表示使用默认值)
+type OutboxConfig struct {
+	PollIntervalMs    int `mapstructure:"poll_interval_ms"`    // 轮询间隔（毫秒）
+	BatchSize         int `mapstructure:"batch_size"`          // 每批发布的事件数
+	MaxBackoffSeconds int `mapstructure:"max_backoff_seconds"` // 发布失败后的最大重试间隔（秒）
+	RetentionHours    int `mapstructure:"retention_hours"`     // 已发布事件的保留时间（小时）
 }
 
 // GlobalConfig 是全局配置实例
@@ -0,0 +1,191 @@
+// Package fulltext 构造 Neo4j 全文索引 (Lucene) 查询并生成搜索结果的高亮文本。
+//
+// 切词规则与全文索引默认的 standard 分析器一致：连续的字母和数字为一个词，汉字逐字成词，统一转为小写。
+// 查询只由切出的词组成，不会把用户输入中的 Lucene 语法 (引号、通配符、AND/OR 等) 传给索引。
+package fulltext
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxTerms 单次查询最多使用的词数，超出部分忽略
+const MaxTerms = 16
+
+// 高亮标记
+const (
+	HighlightPre  = "<em>"
+	HighlightPost = "</em>"
+)
+
+// ErrEmptyQuery 表示搜索文本中没有可用的词
+var ErrEmptyQuery = errors.New("fulltext: empty query")
+
+// Token 是文本中的一个词，Start/End 为原文中的字节偏移
+type Token struct {
+	Term       string // 小写形式
+	Start, End int
+}
+
+// Tokenize 按全文索引的规则切词
+func Tokenize(text string) []Token {
+	var tokens []Token
+	start := -1
+	flush := func(end int) {
+		if start >= 0 {
+			tokens = append(tokens, Token{Term: strings.ToLower(text[start:end]), Start: start, End: end})
+			start = -1
+		}
+	}
+	for i, r := range text {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flush(i)
+			size := utf8.RuneLen(r)
+			tokens = append(tokens, Token{Term: text[i : i+size], Start: i, End: i + size})
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if start < 0 {
+				start = i
+			}
+		default:
+			flush(i)
+		}
+	}
+	flush(len(text))
+	return tokens
+}
+
+// Terms 返回搜索文本中去重后的词 (保持出现顺序，最多 MaxTerms 个)
+func Terms(text string) []string {
+	seen := make(map[string]bool)
+	var terms []string
+	for _, t := range Tokenize(text) {
+		if seen[t.Term] {
+			continue
+		}
+		seen[t.Term] = true
+		terms = append(terms, t.Term)
+		if len(terms) == MaxTerms {
+			break
+		}
+	}
+	return terms
+}
+
+// MaxEdits 返回模糊匹配时词允许的编辑距离：少于 3 个字符的词 (包括单个汉字) 不做模糊匹配，
+// 3-5 个字符允许 1 处编辑，更长的词允许 2 处 (Lucene 的上限)。
+func MaxEdits(term string) int {
+	switch n := utf8.RuneCountInString(term); {
+	case n < 3:
+		return 0
+	case n <= 5:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// BuildQuery 将搜索文本转换为 Lucene 查询，各词之间为 OR，匹配的词越多得分越高。
+// fuzzy 为 true 时按 MaxEdits 为词加上 ~N。文本中没有可用的词时返回 ErrEmptyQuery。
+func BuildQuery(text string, fuzzy bool) (string, []string, error) {
+	terms := Terms(text)
+	if len(terms) == 0 {
+		return "", nil, ErrEmptyQuery
+	}
+	parts := make([]string, len(terms))
+	for i, term := range terms {
+		parts[i] = term
+		if edits := MaxEdits(term); fuzzy && edits > 0 {
+			parts[i] += "~" + strconv.Itoa(edits)
+		}
+	}
+	return strings.Join(parts, " "), terms, nil
+}
+
+// Match 判断文本中的词 token 是否与查询词 term 匹配 (均为小写)
+func Match(term, token string, fuzzy bool) bool {
+	if term == token {
+		return true
+	}
+	if !fuzzy {
+		return false
+	}
+	edits := MaxEdits(term)
+	return edits > 0 && editDistance(term, token, edits) <= edits
+}
+
+// Highlight 用 HighlightPre/HighlightPost 包裹 value 中与查询词匹配的词，相邻的命中合并为一段。
+// 没有命中时返回 false。
+func Highlight(value string, terms []string, fuzzy bool) (string, bool) {
+	var b strings.Builder
+	last, open, hit := 0, false, false
+	for _, tok := range Tokenize(value) {
+		matched := false
+		for _, term := range terms {
+			if Match(term, tok.Term, fuzzy) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+		hit = true
+		if open && tok.Start == last {
+			b.WriteString(value[tok.Start:tok.End])
+		} else {
+			if open {
+				b.WriteString(HighlightPost)
+			}
+			b.WriteString(value[last:tok.Start])
+			b.WriteString(HighlightPre)
+			b.WriteString(value[tok.Start:tok.End])
+			open = true
+		}
+		last = tok.End
+	}
+	if !hit {
+		return "", false
+	}
+	b.WriteString(HighlightPost)
+	b.WriteString(value[last:])
+	return b.String(), true
+}
+
+// editDistance 计算两个词的 Damerau-Levenshtein 距离 (相邻交换算一次编辑，与 Lucene 一致)，
+// 超过 limit 时提前返回 limit+1。
+func editDistance(a, b string, limit int) int {
+	ra, rb := []rune(a), []rune(b)
+	if d := len(ra) - len(rb); d > limit || -d > limit {
+		return limit + 1
+	}
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				curr[j] = min(curr[j], prev2[j-2]+1)
+			}
+			rowMin = min(rowMin, curr[j])
+		}
+		if rowMin > limit {
+			return limit + 1
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,57 @@
+package fulltext
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	var terms []string
+	for _, tok := range Tokenize("Go 工程师, NEO4J-dev!") {
+		terms = append(terms, tok.Term)
+	}
+	assert.Equal(t, []string{"go", "工", "程", "师", "neo4j", "dev"}, terms)
+
+	toks := Tokenize("张三 Smith")
+	require.Len(t, toks, 3)
+	assert.Equal(t, "Smith", "张三 Smith"[toks[2].Start:toks[2].End], "偏移指向原文")
+}
+
+func TestBuildQuery(t *testing.T) {
+	query, terms, err := BuildQuery(`alice "OR" name:* engineer AND engineer`, false)
+	require.NoError(t, err)
+	assert.Equal(t, "alice or name engineer and", query, "Lucene 语法字符被丢弃，运算符转为小写的普通词")
+	assert.Equal(t, []string{"alice", "or", "name", "engineer", "and"}, terms)
+
+	query, _, err = BuildQuery("张三 bob alicia engineering", true)
+	require.NoError(t, err)
+	assert.Equal(t, "张 三 bob~1 alicia~2 engineering~2", query)
+
+	_, _, err = BuildQuery(" *?! ", false)
+	assert.ErrorIs(t, err, ErrEmptyQuery)
+}
+
+func TestMatch(t *testing.T) {
+	assert.True(t, Match("alice", "alice", false))
+	assert.False(t, Match("alice", "alcie", false))
+	assert.True(t, Match("alice", "alcie", true), "相邻交换算一次编辑")
+	assert.False(t, Match("alice", "alcei", true), "5 个字符只允许 1 处编辑")
+	assert.True(t, Match("engineer", "enginer", true))
+	assert.True(t, Match("engineer", "enjineerr", true))
+	assert.False(t, Match("go", "to", true), "短词不做模糊匹配")
+}
+
+func TestHighlight(t *testing.T) {
+	got, ok := Highlight("张三丰 (Zhang San)", []string{"张", "三", "zhang"}, false)
+	require.True(t, ok)
+	assert.Equal(t, "<em>张三</em>丰 (<em>Zhang</em> San)", got, "相邻命中合并")
+
+	got, ok = Highlight("Senior Enginer", []string{"engineer"}, true)
+	require.True(t, ok)
+	assert.Equal(t, "Senior <em>Enginer</em>", got)
+
+	_, ok = Highlight("Designer", []string{"engineer"}, false)
+	assert.False(t, ok)
+}
@@ -0,0 +1,100 @@
+package graphexport
+
+import (
+	"bufio"
+	"regexp"
+	"sort"
+	"strings"
+
+	"labelwall/pkg/typeregistry"
+)
+
+// identifierPattern 无需反引号的 Cypher 标识符
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// writeCypher 输出可重新导入的 Cypher 语句，每行一条，以分号结尾。
+// 节点和关系都按业务 id MERGE，重复执行不会产生重复数据；created_at 仅在首次创建时设置。
+func writeCypher(w *bufio.Writer, g *Graph) error {
+	ew := &errWriter{w: w}
+	ew.printf("// labelwall export: %d nodes, %d relations, truncated=%t\n", len(g.Nodes), len(g.Relations), g.Truncated)
+
+	for _, n := range g.Nodes {
+		props := map[string]string{"name": n.Name}
+		if n.Avatar != nil {
+			props["avatar"] = *n.Avatar
+		}
+		if n.Profession != nil {
+			props["profession"] = *n.Profession
+		}
+		mergeCustom(props, n.Properties)
+		ew.printf("MERGE (n:%s {id: %s}) ON CREATE SET n.created_at = datetime() SET n += %s, n.updated_at = datetime();\n",
+			cypherIdentifier(typeregistry.Default().NodeTypeName(n.Type)), cypherString(n.ID), cypherMap(props))
+	}
+
+	for _, r := range g.Relations {
+		props := map[string]string{}
+		if r.Label != nil {
+			props["label"] = *r.Label
+		}
+		mergeCustom(props, r.Properties)
+		ew.printf("MATCH (s {id: %s}), (t {id: %s}) MERGE (s)-[r:%s {id: %s}]->(t) ON CREATE SET r.created_at = datetime() SET r += %s, r.updated_at = datetime();\n",
+			cypherString(r.Source), cypherString(r.Target), cypherIdentifier(typeregistry.Default().RelationTypeName(r.Type)), cypherString(r.ID), cypherMap(props))
+	}
+	return ew.err
+}
+
+// mergeCustom 合并自定义属性，不覆盖内置字段 (与 Repo 写入时的规则一致)
+func mergeCustom(props, custom map[string]string) {
+	for k, v := range custom {
+		if _, exists := props[k]; !exists && k != "id" {
+			props[k] = v
+		}
+	}
+}
+
+// cypherString 将字符串编码为 Cypher 字符串字面量
+func cypherString(s string) string {
+	var b strings.Builder
+	b.Grow(len(s) + 2)
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '\\':
+			b.WriteString(`\\`)
+		case '"':
+			b.WriteString(`\"`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// cypherIdentifier 必要时用反引号包裹标识符 (属性键、标签、关系类型)
+func cypherIdentifier(s string) string {
+	if identifierPattern.MatchString(s) {
+		return s
+	}
+	return "`" + strings.ReplaceAll(s, "`", "``") + "`"
+}
+
+// cypherMap 将属性编码为 Cypher map 字面量，键按字典序输出
+func cypherMap(props map[string]string) string {
+	keys := make([]string, 0, len(props))
+	for k := range props {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = cypherIdentifier(k) + ": " + cypherString(props[k])
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
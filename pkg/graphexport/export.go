@@ -0,0 +1,166 @@
+// Package graphexport 将 API 模型 (network.Node / network.Relation) 编码为常见的图交换格式，
+// 供 Gephi 等分析工具使用。编码器直接写入 io.Writer，不在内存中拼接完整文档。
+package graphexport
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/typeregistry"
+)
+
+// Format 导出格式
+type Format string
+
+const (
+	FormatGraphML Format = "graphml"
+	FormatGEXF    Format = "gexf"
+	FormatJGF     Format = "jgf"    // JSON Graph Format
+	FormatCypher  Format = "cypher" // 可重新导入的 Cypher 语句
+)
+
+// Graph 待导出的子图
+type Graph struct {
+	Nodes     []*network.Node
+	Relations []*network.Relation
+	Truncated bool // 结果是否因上限被截断，写入各格式的元数据
+}
+
+// ParseFormat 解析格式名 (不区分大小写)，空字符串默认为 GraphML
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "graphml":
+		return FormatGraphML, nil
+	case "gexf":
+		return FormatGEXF, nil
+	case "jgf", "json":
+		return FormatJGF, nil
+	case "cypher", "cql":
+		return FormatCypher, nil
+	}
+	return "", fmt.Errorf("不支持的导出格式 %q (可选: graphml, gexf, jgf, cypher)", s)
+}
+
+// ContentType 返回格式对应的 HTTP Content-Type
+func (f Format) ContentType() string {
+	switch f {
+	case FormatGraphML, FormatGEXF:
+		return "application/xml; charset=utf-8"
+	case FormatJGF:
+		return "application/json; charset=utf-8"
+	}
+	return "text/plain; charset=utf-8"
+}
+
+// Extension 返回格式对应的文件扩展名 (不含点)
+func (f Format) Extension() string {
+	switch f {
+	case FormatJGF:
+		return "json"
+	case FormatCypher:
+		return "cypher"
+	}
+	return string(f)
+}
+
+// Write 按指定格式将子图编码写入 w
+func Write(w io.Writer, format Format, g *Graph) error {
+	bw := bufio.NewWriter(w)
+	var err error
+	switch format {
+	case FormatGraphML:
+		err = writeGraphML(bw, g)
+	case FormatGEXF:
+		err = writeGEXF(bw, g)
+	case FormatJGF:
+		err = writeJGF(bw, g)
+	case FormatCypher:
+		err = writeCypher(bw, g)
+	default:
+		return fmt.Errorf("不支持的导出格式 %q", format)
+	}
+	if err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// nodeFields 节点的内置字段值 (顺序固定)，缺失的可选字段为空字符串
+func nodeFields(n *network.Node) [][2]string {
+	return [][2]string{
+		{"type", typeregistry.Default().NodeTypeName(n.Type)},
+		{"name", n.Name},
+		{"avatar", derefString(n.Avatar)},
+		{"profession", derefString(n.Profession)},
+	}
+}
+
+// relationFields 关系的内置字段值 (顺序固定)
+func relationFields(r *network.Relation) [][2]string {
+	return [][2]string{
+		{"type", typeregistry.Default().RelationTypeName(r.Type)},
+		{"label", derefString(r.Label)},
+	}
+}
+
+// nodePropertyKeys 收集所有节点自定义属性的键 (排序后)，用于声明属性列
+func nodePropertyKeys(nodes []*network.Node) []string {
+	seen := map[string]struct{}{}
+	for _, n := range nodes {
+		for k := range n.Properties {
+			seen[k] = struct{}{}
+		}
+	}
+	return sortedKeys(seen)
+}
+
+// relationPropertyKeys 收集所有关系自定义属性的键 (排序后)
+func relationPropertyKeys(relations []*network.Relation) []string {
+	seen := map[string]struct{}{}
+	for _, r := range relations {
+		for k := range r.Properties {
+			seen[k] = struct{}{}
+		}
+	}
+	return sortedKeys(seen)
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// errWriter 记录第一次写入错误，之后的写入被忽略，便于顺序输出后统一检查
+type errWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (ew *errWriter) printf(format string, args ...any) {
+	if ew.err != nil {
+		return
+	}
+	_, ew.err = fmt.Fprintf(ew.w, format, args...)
+}
+
+func (ew *errWriter) write(s string) {
+	if ew.err != nil {
+		return
+	}
+	_, ew.err = ew.w.WriteString(s)
+}
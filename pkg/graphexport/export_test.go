@@ -0,0 +1,121 @@
+package graphexport
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	network "labelwall/biz/model/relationship/network"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func strPtr(s string) *string { return &s }
+
+func sampleGraph() *Graph {
+	return &Graph{
+		Nodes: []*network.Node{
+			{ID: "p1", Type: network.NodeType_PERSON, Name: `张三 "Zhang" <CEO>`, Profession: strPtr("工程师"), Properties: map[string]string{"city": "北京"}},
+			{ID: "c1", Type: network.NodeType_COMPANY, Name: "ABC & Co", Properties: map[string]string{"hq-city": "上海"}},
+		},
+		Relations: []*network.Relation{
+			{ID: "r1", Source: "p1", Target: "c1", Type: network.RelationType_COLLEAGUE, Label: strPtr("就职\n于"), Properties: map[string]string{"since": "2020"}},
+		},
+		Truncated: true,
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	cases := map[string]Format{"": FormatGraphML, "GraphML": FormatGraphML, "gexf": FormatGEXF, "json": FormatJGF, "jgf": FormatJGF, "cypher": FormatCypher}
+	for in, want := range cases {
+		got, err := ParseFormat(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+	_, err := ParseFormat("dot")
+	assert.Error(t, err)
+}
+
+// TestWriteXMLFormats 验证 GraphML / GEXF 输出是合法 XML 且转义正确
+func TestWriteXMLFormats(t *testing.T) {
+	for _, format := range []Format{FormatGraphML, FormatGEXF} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, Write(&buf, format, sampleGraph()))
+
+			dec := xml.NewDecoder(bytes.NewReader(buf.Bytes()))
+			var nodes, edges int
+			var texts []string
+			for {
+				tok, err := dec.Token()
+				if err != nil {
+					require.Equal(t, "EOF", err.Error(), "输出必须是合法 XML")
+					break
+				}
+				switch el := tok.(type) {
+				case xml.StartElement:
+					switch el.Name.Local {
+					case "node":
+						nodes++
+						for _, a := range el.Attr {
+							texts = append(texts, a.Value)
+						}
+					case "edge":
+						edges++
+					case "attvalue":
+						for _, a := range el.Attr {
+							texts = append(texts, a.Value)
+						}
+					}
+				case xml.CharData:
+					texts = append(texts, string(el))
+				}
+			}
+			assert.Equal(t, 2, nodes)
+			assert.Equal(t, 1, edges)
+			assert.Contains(t, texts, `张三 "Zhang" <CEO>`, "名称应在解码后还原")
+			assert.Contains(t, texts, "北京")
+		})
+	}
+}
+
+func TestWriteJGF(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, FormatJGF, sampleGraph()))
+
+	var doc struct {
+		Graph struct {
+			Directed bool                       `json:"directed"`
+			Metadata map[string]any             `json:"metadata"`
+			Nodes    map[string]json.RawMessage `json:"nodes"`
+			Edges    []map[string]any           `json:"edges"`
+		} `json:"graph"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	assert.True(t, doc.Graph.Directed)
+	assert.Equal(t, true, doc.Graph.Metadata["truncated"])
+	assert.Len(t, doc.Graph.Nodes, 2)
+	require.Len(t, doc.Graph.Edges, 1)
+	assert.Equal(t, "COLLEAGUE", doc.Graph.Edges[0]["relation"])
+	assert.Equal(t, "p1", doc.Graph.Edges[0]["source"])
+}
+
+func TestWriteJGFEmptyGraph(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, FormatJGF, &Graph{}))
+	assert.True(t, json.Valid(buf.Bytes()), buf.String())
+}
+
+func TestWriteCypher(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, FormatCypher, sampleGraph()))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 4, "注释 + 2 个节点 + 1 个关系")
+
+	assert.Equal(t, `MERGE (n:PERSON {id: "p1"}) ON CREATE SET n.created_at = datetime() SET n += {city: "北京", name: "张三 \"Zhang\" <CEO>", profession: "工程师"}, n.updated_at = datetime();`, lines[1])
+	assert.Contains(t, lines[2], "`hq-city`: \"上海\"", "非法标识符应使用反引号")
+	assert.Equal(t, `MATCH (s {id: "p1"}), (t {id: "c1"}) MERGE (s)-[r:COLLEAGUE {id: "r1"}]->(t) ON CREATE SET r.created_at = datetime() SET r += {label: "就职\n于", since: "2020"}, r.updated_at = datetime();`, lines[3])
+}
@@ -0,0 +1,81 @@
+package graphexport
+
+import (
+	"bufio"
+	"encoding/xml"
+
+	"labelwall/pkg/typeregistry"
+)
+
+// writeGEXF 输出 GEXF 1.3 (https://gexf.net/)，节点 label 为名称，边 label 为关系类型。
+// 内置字段和自定义属性都声明为 string 类型的 attribute。
+func writeGEXF(w *bufio.Writer, g *Graph) error {
+	ew := &errWriter{w: w}
+	nodeProps := nodePropertyKeys(g.Nodes)
+	relProps := relationPropertyKeys(g.Relations)
+
+	ew.write(xml.Header)
+	ew.write(`<gexf xmlns="http://gexf.net/1.3" version="1.3">` + "\n")
+	ew.printf("  <meta>\n    <creator>labelwall</creator>\n    <description>truncated=%t</description>\n  </meta>\n", g.Truncated)
+	ew.write(`  <graph defaultedgetype="directed" mode="static">` + "\n")
+
+	// 1. 声明属性列，attribute id 按声明顺序编号
+	nodeAttrs := append([]string{"type", "avatar", "profession"}, prefixed("prop.", nodeProps)...)
+	edgeAttrs := append([]string{"type", "label"}, prefixed("prop.", relProps)...)
+	declare := func(class string, titles []string) {
+		ew.printf(`    <attributes class="%s">`+"\n", class)
+		for i, title := range titles {
+			ew.printf(`      <attribute id="%d" title="%s" type="string"/>`+"\n", i, xmlEscape(title))
+		}
+		ew.write("    </attributes>\n")
+	}
+	declare("node", nodeAttrs)
+	declare("edge", edgeAttrs)
+
+	attValues := func(values []string) {
+		ew.write("        <attvalues>\n")
+		for i, v := range values {
+			if v == "" {
+				continue
+			}
+			ew.printf(`          <attvalue for="%d" value="%s"/>`+"\n", i, xmlEscape(v))
+		}
+		ew.write("        </attvalues>\n")
+	}
+
+	// 2. 节点
+	ew.write("    <nodes>\n")
+	for _, n := range g.Nodes {
+		ew.printf(`      <node id="%s" label="%s">`+"\n", xmlEscape(n.ID), xmlEscape(n.Name))
+		values := []string{typeregistry.Default().NodeTypeName(n.Type), derefString(n.Avatar), derefString(n.Profession)}
+		for _, k := range nodeProps {
+			values = append(values, n.Properties[k])
+		}
+		attValues(values)
+		ew.write("      </node>\n")
+	}
+	ew.write("    </nodes>\n")
+
+	// 3. 边
+	ew.write("    <edges>\n")
+	for _, r := range g.Relations {
+		ew.printf(`      <edge id="%s" source="%s" target="%s" label="%s">`+"\n",
+			xmlEscape(r.ID), xmlEscape(r.Source), xmlEscape(r.Target), xmlEscape(typeregistry.Default().RelationTypeName(r.Type)))
+		values := []string{typeregistry.Default().RelationTypeName(r.Type), derefString(r.Label)}
+		for _, k := range relProps {
+			values = append(values, r.Properties[k])
+		}
+		attValues(values)
+		ew.write("      </edge>\n")
+	}
+	ew.write("    </edges>\n  </graph>\n</gexf>\n")
+	return ew.err
+}
+
+func prefixed(prefix string, keys []string) []string {
+	out := make([]string, len(keys))
+	for i, k := range keys {
+		out[i] = prefix + k
+	}
+	return out
+}
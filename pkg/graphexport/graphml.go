@@ -0,0 +1,77 @@
+package graphexport
+
+import (
+	"bufio"
+	"encoding/xml"
+	"strings"
+)
+
+// xmlEscape 转义 XML 文本和属性值
+func xmlEscape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// writeGraphML 输出 GraphML (http://graphml.graphdrawing.org/)。
+// 内置字段和自定义属性都声明为 string 类型的 <key>，自定义属性的键名为 prop.<key>。
+func writeGraphML(w *bufio.Writer, g *Graph) error {
+	ew := &errWriter{w: w}
+	nodeProps := nodePropertyKeys(g.Nodes)
+	relProps := relationPropertyKeys(g.Relations)
+
+	ew.write(xml.Header)
+	ew.write(`<graphml xmlns="http://graphml.graphdrawing.org/xmlns">` + "\n")
+
+	// 1. 声明属性列 (GraphML 要求 key 在 graph 之前)
+	declare := func(id, domain, name string) {
+		ew.printf(`  <key id="%s" for="%s" attr.name="%s" attr.type="string"/>`+"\n", xmlEscape(id), domain, xmlEscape(name))
+	}
+	for _, f := range []string{"type", "name", "avatar", "profession"} {
+		declare("n_"+f, "node", f)
+	}
+	for _, k := range nodeProps {
+		declare("np_"+k, "node", "prop."+k)
+	}
+	for _, f := range []string{"type", "label"} {
+		declare("e_"+f, "edge", f)
+	}
+	for _, k := range relProps {
+		declare("ep_"+k, "edge", "prop."+k)
+	}
+	declare("g_truncated", "graph", "truncated")
+
+	// 2. 节点与边
+	ew.write(`  <graph id="G" edgedefault="directed">` + "\n")
+	if g.Truncated {
+		ew.write(`    <data key="g_truncated">true</data>` + "\n")
+	}
+	data := func(key, value string) {
+		if value == "" {
+			return
+		}
+		ew.printf(`      <data key="%s">%s</data>`+"\n", xmlEscape(key), xmlEscape(value))
+	}
+	for _, n := range g.Nodes {
+		ew.printf(`    <node id="%s">`+"\n", xmlEscape(n.ID))
+		for _, f := range nodeFields(n) {
+			data("n_"+f[0], f[1])
+		}
+		for _, k := range nodeProps {
+			data("np_"+k, n.Properties[k])
+		}
+		ew.write("    </node>\n")
+	}
+	for _, r := range g.Relations {
+		ew.printf(`    <edge id="%s" source="%s" target="%s">`+"\n", xmlEscape(r.ID), xmlEscape(r.Source), xmlEscape(r.Target))
+		for _, f := range relationFields(r) {
+			data("e_"+f[0], f[1])
+		}
+		for _, k := range relProps {
+			data("ep_"+k, r.Properties[k])
+		}
+		ew.write("    </edge>\n")
+	}
+	ew.write("  </graph>\n</graphml>\n")
+	return ew.err
+}
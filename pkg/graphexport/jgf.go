@@ -0,0 +1,89 @@
+package graphexport
+
+import (
+	"bufio"
+	"encoding/json"
+
+	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/typeregistry"
+)
+
+// jgfNode JSON Graph Format 中的节点 (nodes 以 id 为键)
+type jgfNode struct {
+	Label    string         `json:"label"`
+	Metadata map[string]any `json:"metadata"`
+}
+
+// jgfEdge JSON Graph Format 中的边
+type jgfEdge struct {
+	ID       string         `json:"id"`
+	Source   string         `json:"source"`
+	Target   string         `json:"target"`
+	Relation string         `json:"relation"`
+	Label    string         `json:"label,omitempty"`
+	Directed bool           `json:"directed"`
+	Metadata map[string]any `json:"metadata,omitempty"`
+}
+
+// writeJGF 输出 JSON Graph Format v2 (https://jsongraphformat.info/)。
+// 节点逐个编码后写入，避免一次性序列化整个图。
+func writeJGF(w *bufio.Writer, g *Graph) error {
+	ew := &errWriter{w: w}
+	encode := func(v any) {
+		if ew.err != nil {
+			return
+		}
+		data, err := json.Marshal(v)
+		if err != nil {
+			ew.err = err
+			return
+		}
+		_, ew.err = w.Write(data)
+	}
+
+	ew.write(`{"graph":{"directed":true,"type":"labelwall","metadata":{"truncated":`)
+	encode(g.Truncated)
+	ew.write(`},"nodes":{`)
+	for i, n := range g.Nodes {
+		if i > 0 {
+			ew.write(",")
+		}
+		encode(n.ID)
+		ew.write(":")
+		encode(jgfNode{Label: n.Name, Metadata: jgfNodeMetadata(n)})
+	}
+	ew.write(`},"edges":[`)
+	for i, r := range g.Relations {
+		if i > 0 {
+			ew.write(",")
+		}
+		edge := jgfEdge{
+			ID:       r.ID,
+			Source:   r.Source,
+			Target:   r.Target,
+			Relation: typeregistry.Default().RelationTypeName(r.Type),
+			Label:    derefString(r.Label),
+			Directed: true,
+		}
+		if len(r.Properties) > 0 {
+			edge.Metadata = map[string]any{"properties": r.Properties}
+		}
+		encode(edge)
+	}
+	ew.write("]}}\n")
+	return ew.err
+}
+
+func jgfNodeMetadata(n *network.Node) map[string]any {
+	metadata := map[string]any{"type": typeregistry.Default().NodeTypeName(n.Type)}
+	if n.Avatar != nil {
+		metadata["avatar"] = *n.Avatar
+	}
+	if n.Profession != nil {
+		metadata["profession"] = *n.Profession
+	}
+	if len(n.Properties) > 0 {
+		metadata["properties"] = n.Properties
+	}
+	return metadata
+}
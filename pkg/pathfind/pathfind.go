@@ -0,0 +1,357 @@
+// Package pathfind 实现与存储无关的路径搜索：无权图上的 BFS 最短路径和全部最短路径，
+// 带权图上限制跳数的 Dijkstra 最小代价路径 (可要求经过指定节点)，以及在任意最短路径函数之上运行的 Yen k 最短无环路径算法。
+// 节点和边都以字符串 ID 表示，边的方向由调用方的 NeighborsFunc / ExpandFunc 决定。
+package pathfind
+
+import (
+	"container/heap"
+	"errors"
+	"slices"
+)
+
+// ErrBudgetExceeded 表示搜索的工作量超过了 Budget 的限制
+var ErrBudgetExceeded = errors.New("pathfind: search budget exceeded")
+
+// Path 是一条无环路径，Edges[i] 连接 Nodes[i] 和 Nodes[i+1]
+type Path struct {
+	Nodes []string
+	Edges []string
+	Cost  float64 // 边代价之和，只有 Cheapest 会设置
+}
+
+// Hops 返回路径的跳数
+func (p Path) Hops() int {
+	return len(p.Edges)
+}
+
+// Edge 是从某个节点出发可以经过的一条边
+type Edge struct {
+	ID   string
+	To   string
+	Cost float64 // 经过这条边的代价 (非负)，只有 Cheapest 使用
+}
+
+// NeighborsFunc 返回从 node 出发可以经过的边 (顺序决定相同长度路径的先后)
+type NeighborsFunc func(node string) []Edge
+
+// ExpandFunc 与 NeighborsFunc 相同，但允许出错 (例如按需从数据库读取邻居)
+type ExpandFunc func(node string) ([]Edge, error)
+
+// Budget 限制一次搜索的工作量，字段 <= 0 表示不限制
+type Budget struct {
+	MaxExpansions int // 展开次数上限 (CheapestVia 中同一节点在不同搜索状态下可能展开多次)
+	MaxNodes      int // 读取邻居的不同节点数上限
+}
+
+// CachedExpand 包装 expand：搜索期间同一节点的邻居只读取一次，展开次数或读取的节点数超出 budget 时返回 ErrBudgetExceeded。
+// 返回的函数不是并发安全的，每次搜索创建一个。
+func CachedExpand(expand ExpandFunc, budget Budget) ExpandFunc {
+	cache := make(map[string][]Edge)
+	expansions := 0
+	return func(node string) ([]Edge, error) {
+		expansions++
+		if budget.MaxExpansions > 0 && expansions > budget.MaxExpansions {
+			return nil, ErrBudgetExceeded
+		}
+		if edges, ok := cache[node]; ok {
+			return edges, nil
+		}
+		if budget.MaxNodes > 0 && len(cache) >= budget.MaxNodes {
+			return nil, ErrBudgetExceeded
+		}
+		edges, err := expand(node)
+		if err != nil {
+			return nil, err
+		}
+		cache[node] = edges
+		return edges, nil
+	}
+}
+
+// Exclusion 是搜索时需要避开的节点和边，nil 表示不排除
+type Exclusion struct {
+	Nodes map[string]bool
+	Edges map[string]bool
+}
+
+// ShortestFunc 返回从 from 到目标节点、不超过 maxHops 跳且避开 excl 的一条最短路径，没有时返回 false。
+// KShortest 调用时 excl.Nodes 正好是偏离点之前的 root 节点，路径必须经过指定节点时可以据此扣除 root 已经经过的节点。
+type ShortestFunc func(from string, maxHops int, excl Exclusion) (Path, bool, error)
+
+// Shortest 用 BFS 查找从 source 到 target、不超过 maxHops 跳且避开 excl 的一条最短路径。
+// source 与 target 相同时返回 false (路径至少一跳)。
+func Shortest(source, target string, maxHops int, neighbors NeighborsFunc, excl Exclusion) (Path, bool) {
+	if source == target {
+		return Path{}, false
+	}
+	type step struct{ edge, from string }
+	via := map[string]step{source: {}}
+	frontier := []string{source}
+	for hop := 0; hop < maxHops && len(frontier) > 0; hop++ {
+		var next []string
+		for _, node := range frontier {
+			for _, e := range neighbors(node) {
+				if excl.Edges[e.ID] || excl.Nodes[e.To] {
+					continue
+				}
+				if _, visited := via[e.To]; visited {
+					continue
+				}
+				via[e.To] = step{edge: e.ID, from: node}
+				if e.To == target {
+					p := Path{Nodes: []string{target}}
+					for cur := target; cur != source; cur = via[cur].from {
+						p.Edges = append(p.Edges, via[cur].edge)
+						p.Nodes = append(p.Nodes, via[cur].from)
+					}
+					slices.Reverse(p.Nodes)
+					slices.Reverse(p.Edges)
+					return p, true
+				}
+				next = append(next, e.To)
+			}
+		}
+		frontier = next
+	}
+	return Path{}, false
+}
+
+// Cheapest 用 Dijkstra 查找从 source 到 target、不超过 maxHops 跳且总代价最小的路径，边的代价必须非负。
+// 代价相同时选择跳数较少的路径，再按边的展开顺序。搜索状态是 (节点, 跳数)：跳数更少且代价不更高的
+// 状态支配其他状态，因此每个节点最多展开 maxHops 次，得到的路径也总是无环的。
+func Cheapest(source, target string, maxHops int, expand ExpandFunc) (Path, bool, error) {
+	return CheapestVia(source, target, maxHops, nil, expand)
+}
+
+// CheapestVia 与 Cheapest 相同，但路径必须经过 via 中的全部节点 (顺序不限，最多 64 个)。
+// 扩展时跳过当前路径上已有的节点以保证路径无环。via 非空时不按 (节点, 跳数) 剪枝：代价更低的前缀可能占用了
+// 之后必须经过的节点，剪掉避开它的前缀会漏掉有效路径，因此会枚举所有无环前缀，调用方应使用 CachedExpand 限制工作量。
+func CheapestVia(source, target string, maxHops int, via []string, expand ExpandFunc) (Path, bool, error) {
+	if source == target {
+		return Path{}, false, nil
+	}
+	viaBit := make(map[string]uint64, len(via))
+	var all uint64
+	for _, v := range via {
+		if _, ok := viaBit[v]; !ok && len(viaBit) < 64 {
+			viaBit[v] = 1 << len(viaBit)
+			all |= viaBit[v]
+		}
+	}
+	type state struct {
+		node string
+		seen uint64
+	}
+	prune := len(viaBit) == 0
+	q := &labelQueue{labels: []label{{node: source, parent: -1, seen: viaBit[source]}}, items: []int{0}}
+	expandedHops := make(map[state]int) // 每个状态已展开的最少跳数，只在 prune 时使用
+	for q.Len() > 0 {
+		i := heap.Pop(q).(int)
+		l := q.labels[i]
+		key := state{l.node, l.seen}
+		if hops, ok := expandedHops[key]; prune && ok && hops <= l.hops {
+			continue
+		}
+		expandedHops[key] = l.hops
+		if l.node == target {
+			if l.seen != all {
+				continue // 路径不能越过终点继续前进
+			}
+			p := Path{Nodes: []string{target}, Cost: l.cost}
+			for cur := l; cur.parent >= 0; cur = q.labels[cur.parent] {
+				p.Edges = append(p.Edges, cur.edge)
+				p.Nodes = append(p.Nodes, q.labels[cur.parent].node)
+			}
+			slices.Reverse(p.Nodes)
+			slices.Reverse(p.Edges)
+			return p, true, nil
+		}
+		if l.hops == maxHops {
+			continue
+		}
+		edges, err := expand(l.node)
+		if err != nil {
+			return Path{}, false, err
+		}
+		for _, e := range edges {
+			next := state{e.To, l.seen | viaBit[e.To]}
+			if hops, ok := expandedHops[next]; prune && ok && hops <= l.hops+1 {
+				continue
+			}
+			if q.onPath(i, e.To) {
+				continue
+			}
+			q.labels = append(q.labels, label{node: e.To, edge: e.ID, parent: i, cost: l.cost + e.Cost, hops: l.hops + 1, seen: next.seen})
+			heap.Push(q, len(q.labels)-1)
+		}
+	}
+	return Path{}, false, nil
+}
+
+// label 是 Cheapest 的一个搜索状态，parent 是前一个状态在 labelQueue.labels 中的下标
+type label struct {
+	node, edge string
+	parent     int
+	cost       float64
+	hops       int
+	seen       uint64 // 已经过的 via 节点
+}
+
+// labelQueue 是按 (代价, 跳数, 创建顺序) 排序的最小堆，items 为 labels 的下标
+type labelQueue struct {
+	labels []label
+	items  []int
+}
+
+// onPath 判断 node 是否在以 labels[i] 结尾的路径上
+func (q *labelQueue) onPath(i int, node string) bool {
+	for ; i >= 0; i = q.labels[i].parent {
+		if q.labels[i].node == node {
+			return true
+		}
+	}
+	return false
+}
+
+func (q *labelQueue) Len() int { return len(q.items) }
+
+func (q *labelQueue) Less(i, j int) bool {
+	a, b := q.labels[q.items[i]], q.labels[q.items[j]]
+	if a.cost != b.cost {
+		return a.cost < b.cost
+	}
+	if a.hops != b.hops {
+		return a.hops < b.hops
+	}
+	return q.items[i] < q.items[j]
+}
+
+func (q *labelQueue) Swap(i, j int) { q.items[i], q.items[j] = q.items[j], q.items[i] }
+
+func (q *labelQueue) Push(x any) { q.items = append(q.items, x.(int)) }
+
+func (q *labelQueue) Pop() any {
+	last := q.items[len(q.items)-1]
+	q.items = q.items[:len(q.items)-1]
+	return last
+}
+
+// AllShortest 返回从 source 到 target、不超过 maxHops 跳的全部最短路径，最多 limit 条
+func AllShortest(source, target string, maxHops, limit int, neighbors NeighborsFunc) []Path {
+	if source == target || limit <= 0 {
+		return nil
+	}
+	// BFS 逐层记录每个节点在上一层的全部前驱 (同一对节点间的多条边分别记录)
+	type step struct{ edge, from string }
+	depth := map[string]int{source: 0}
+	preds := make(map[string][]step)
+	frontier := []string{source}
+	for hop := 0; hop < maxHops && len(frontier) > 0; hop++ {
+		var next []string
+		for _, node := range frontier {
+			for _, e := range neighbors(node) {
+				d, seen := depth[e.To]
+				if seen && d != hop+1 {
+					continue
+				}
+				if !seen {
+					depth[e.To] = hop + 1
+					next = append(next, e.To)
+				}
+				preds[e.To] = append(preds[e.To], step{edge: e.ID, from: node})
+			}
+		}
+		if _, found := depth[target]; found {
+			break
+		}
+		frontier = next
+	}
+	if _, found := depth[target]; !found {
+		return nil
+	}
+
+	// 从 target 沿前驱回溯到 source
+	var paths []Path
+	nodes, edges := []string{target}, []string(nil)
+	var walk func(node string)
+	walk = func(node string) {
+		if len(paths) == limit {
+			return
+		}
+		if node == source {
+			p := Path{Nodes: slices.Clone(nodes), Edges: slices.Clone(edges)}
+			slices.Reverse(p.Nodes)
+			slices.Reverse(p.Edges)
+			paths = append(paths, p)
+			return
+		}
+		for _, s := range preds[node] {
+			nodes, edges = append(nodes, s.from), append(edges, s.edge)
+			walk(s.from)
+			nodes, edges = nodes[:len(nodes)-1], edges[:len(edges)-1]
+		}
+	}
+	walk(target)
+	return paths
+}
+
+// KShortest 用 Yen 算法返回从 source 出发的前 k 条最短无环路径，按跳数升序 (跳数相同时按找到的先后)。
+// shortest 负责查找到目标节点的最短路径，每条新路径最多调用它 Hops 次。
+func KShortest(source string, k, maxHops int, shortest ShortestFunc) ([]Path, error) {
+	if k <= 0 {
+		return nil, nil
+	}
+	first, ok, err := shortest(source, maxHops, Exclusion{})
+	if err != nil || !ok {
+		return nil, err
+	}
+	found := []Path{first}
+	var candidates []Path
+	for len(found) < k {
+		prev := found[len(found)-1]
+		for i := 0; i < prev.Hops(); i++ {
+			// 偏离点 spur 之前的部分 (root) 保持不变，禁止 spur 沿已有路径的下一条边离开，也禁止回到 root 上的节点
+			rootNodes, rootEdges := prev.Nodes[:i+1], prev.Edges[:i]
+			excl := Exclusion{Nodes: make(map[string]bool, i), Edges: make(map[string]bool)}
+			for _, p := range found {
+				if p.Hops() > i && slices.Equal(p.Edges[:i], rootEdges) && p.Nodes[i] == rootNodes[i] {
+					excl.Edges[p.Edges[i]] = true
+				}
+			}
+			for _, n := range rootNodes[:i] {
+				excl.Nodes[n] = true
+			}
+			spur, ok, err := shortest(rootNodes[i], maxHops-i, excl)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			candidate := Path{
+				Nodes: append(slices.Clone(rootNodes[:i]), spur.Nodes...),
+				Edges: append(slices.Clone(rootEdges), spur.Edges...),
+			}
+			if !containsPath(found, candidate) && !containsPath(candidates, candidate) {
+				candidates = append(candidates, candidate)
+			}
+		}
+		if len(candidates) == 0 {
+			break
+		}
+		best := 0
+		for j := range candidates {
+			if candidates[j].Hops() < candidates[best].Hops() {
+				best = j
+			}
+		}
+		found = append(found, candidates[best])
+		candidates = slices.Delete(candidates, best, best+1)
+	}
+	return found, nil
+}
+
+// containsPath 判断 paths 中是否已有经过相同边序列的路径 (起点相同时边序列唯一确定路径)
+func containsPath(paths []Path, p Path) bool {
+	return slices.ContainsFunc(paths, func(q Path) bool { return slices.Equal(q.Edges, p.Edges) })
+}
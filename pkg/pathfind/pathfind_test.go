@@ -0,0 +1,218 @@
+package pathfind
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// undirected 由 "边ID:起点-终点" 构造无向图的 NeighborsFunc
+func undirected(edges ...[3]string) NeighborsFunc {
+	adj := make(map[string][]Edge)
+	for _, e := range edges {
+		adj[e[1]] = append(adj[e[1]], Edge{ID: e[0], To: e[2]})
+		adj[e[2]] = append(adj[e[2]], Edge{ID: e[0], To: e[1]})
+	}
+	return func(node string) []Edge { return adj[node] }
+}
+
+// 菱形图加一条绕行边:
+//
+//	a -e1- b -e2- d
+//	a -e3- c -e4- d
+//	c -e5- e -e6- d
+var diamond = undirected(
+	[3]string{"e1", "a", "b"}, [3]string{"e2", "b", "d"},
+	[3]string{"e3", "a", "c"}, [3]string{"e4", "c", "d"},
+	[3]string{"e5", "c", "e"}, [3]string{"e6", "e", "d"},
+)
+
+func TestShortest(t *testing.T) {
+	p, ok := Shortest("a", "d", 3, diamond, Exclusion{})
+	require.True(t, ok)
+	assert.Equal(t, Path{Nodes: []string{"a", "b", "d"}, Edges: []string{"e1", "e2"}}, p)
+	assert.Equal(t, 2, p.Hops())
+
+	p, ok = Shortest("a", "d", 3, diamond, Exclusion{Nodes: map[string]bool{"b": true}, Edges: map[string]bool{"e4": true}})
+	require.True(t, ok)
+	assert.Equal(t, []string{"a", "c", "e", "d"}, p.Nodes)
+
+	_, ok = Shortest("a", "d", 1, diamond, Exclusion{})
+	assert.False(t, ok, "超过最大跳数")
+	_, ok = Shortest("a", "a", 3, diamond, Exclusion{})
+	assert.False(t, ok)
+}
+
+// weighted 由 "两个单字母节点组成的边ID -> 代价" 构造无向带权图的 ExpandFunc，边按 ID 排序
+func weighted(costs map[string]float64) ExpandFunc {
+	adj := make(map[string][]Edge)
+	for id, cost := range costs {
+		adj[id[:1]] = append(adj[id[:1]], Edge{ID: id, To: id[1:], Cost: cost})
+		adj[id[1:]] = append(adj[id[1:]], Edge{ID: id, To: id[:1], Cost: cost})
+	}
+	for _, edges := range adj {
+		slices.SortFunc(edges, func(a, b Edge) int { return strings.Compare(a.ID, b.ID) })
+	}
+	return func(node string) ([]Edge, error) { return adj[node], nil }
+}
+
+func TestCheapest(t *testing.T) {
+	// 直连 a-d 代价高，绕行 a-b-c-d 代价低但跳数多
+	costs := map[string]float64{"ab": 1, "bc": 1, "cd": 1, "ad": 5, "ac": 2.5}
+	p, ok, err := Cheapest("a", "d", 3, weighted(costs))
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, Path{Nodes: []string{"a", "b", "c", "d"}, Edges: []string{"ab", "bc", "cd"}, Cost: 3}, p)
+
+	// 跳数限制内的最小代价: a-c-d (3.5) 优于 a-d (5)
+	p, ok, err = Cheapest("a", "d", 2, weighted(costs))
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, []string{"ac", "cd"}, p.Edges)
+	assert.InDelta(t, 3.5, p.Cost, 1e-9)
+
+	p, _, err = Cheapest("a", "d", 1, weighted(costs))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ad"}, p.Edges)
+
+	// 代价相同时选择跳数较少的路径
+	costs["ad"] = 3
+	p, _, err = Cheapest("a", "d", 3, weighted(costs))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ad"}, p.Edges)
+
+	_, ok, err = Cheapest("a", "a", 3, weighted(costs))
+	require.NoError(t, err)
+	assert.False(t, ok)
+	_, ok, err = Cheapest("a", "x", 3, weighted(costs))
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, _, err = Cheapest("a", "d", 3, func(string) ([]Edge, error) { return nil, errors.New("boom") })
+	assert.Error(t, err)
+}
+
+func TestCheapestVia(t *testing.T) {
+	// x 只与 b 相连，经过 x 的无环路径不存在
+	costs := map[string]float64{"ab": 1, "bd": 1, "ac": 1, "cd": 1, "ce": 1, "de": 5, "bx": 1}
+	p, ok, err := CheapestVia("a", "d", 3, []string{"e"}, weighted(costs))
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, Path{Nodes: []string{"a", "c", "e", "d"}, Edges: []string{"ac", "ce", "de"}, Cost: 7}, p)
+
+	_, ok, err = CheapestVia("a", "d", 2, []string{"e"}, weighted(costs))
+	require.NoError(t, err)
+	assert.False(t, ok, "超过最大跳数")
+
+	_, ok, err = CheapestVia("a", "d", 5, []string{"x"}, weighted(costs))
+	require.NoError(t, err)
+	assert.False(t, ok, "不能原路返回")
+	_, ok, err = CheapestVia("a", "d", 5, []string{"b", "c"}, weighted(costs))
+	require.NoError(t, err)
+	assert.False(t, ok, "不能越过终点")
+
+	// 起点本身算作已经过
+	p, _, err = CheapestVia("a", "d", 3, []string{"a"}, weighted(costs))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ab", "bd"}, p.Edges)
+
+	// 更便宜的前缀 s-y-x 已经占用了 y，不能剪掉避开 y 的前缀 s-a-b-x
+	loop := map[string]float64{"sy": 1, "yx": 1, "xv": 1, "vy": 1, "yt": 1, "sa": 1, "ab": 1, "bx": 1}
+	p, ok, err = CheapestVia("s", "t", 8, []string{"v"}, weighted(loop))
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, []string{"s", "a", "b", "x", "v", "y", "t"}, p.Nodes)
+}
+
+func TestCachedExpand(t *testing.T) {
+	calls := make(map[string]int)
+	expand := func(node string) ([]Edge, error) {
+		calls[node]++
+		return diamond(node), nil
+	}
+
+	// 经过 e 的搜索会在不同状态下多次展开同一节点，邻居只读取一次
+	p, ok, err := CheapestVia("a", "d", 4, []string{"e"}, CachedExpand(expand, Budget{}))
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, []string{"a", "c", "e", "d"}, p.Nodes)
+	for node, n := range calls {
+		assert.Equal(t, 1, n, node)
+	}
+
+	_, _, err = CheapestVia("a", "d", 4, []string{"e"}, CachedExpand(expand, Budget{MaxNodes: 2}))
+	assert.ErrorIs(t, err, ErrBudgetExceeded, "读取的节点数超限")
+	_, _, err = CheapestVia("a", "d", 4, []string{"e"}, CachedExpand(expand, Budget{MaxExpansions: 3}))
+	assert.ErrorIs(t, err, ErrBudgetExceeded, "展开次数超限")
+
+	_, _, err = Cheapest("a", "d", 3, CachedExpand(func(string) ([]Edge, error) { return nil, errors.New("boom") }, Budget{}))
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrBudgetExceeded)
+}
+
+func TestAllShortest(t *testing.T) {
+	paths := AllShortest("a", "d", 3, 10, diamond)
+	assert.Equal(t, []Path{
+		{Nodes: []string{"a", "b", "d"}, Edges: []string{"e1", "e2"}},
+		{Nodes: []string{"a", "c", "d"}, Edges: []string{"e3", "e4"}},
+	}, paths)
+
+	assert.Len(t, AllShortest("a", "d", 3, 1, diamond), 1, "limit")
+	assert.Nil(t, AllShortest("a", "d", 1, 10, diamond))
+
+	// 两个节点之间的平行边是不同的路径
+	multi := undirected([3]string{"r1", "x", "y"}, [3]string{"r2", "x", "y"})
+	assert.Len(t, AllShortest("x", "y", 1, 10, multi), 2)
+}
+
+func TestKShortest(t *testing.T) {
+	calls := 0
+	shortest := func(from string, maxHops int, excl Exclusion) (Path, bool, error) {
+		calls++
+		p, ok := Shortest(from, "d", maxHops, diamond, excl)
+		return p, ok, nil
+	}
+	paths, err := KShortest("a", 5, 3, shortest)
+	require.NoError(t, err)
+	require.Len(t, paths, 3, "图中只有 3 条无环路径")
+	assert.Equal(t, []string{"a", "b", "d"}, paths[0].Nodes)
+	assert.Equal(t, []string{"a", "c", "d"}, paths[1].Nodes)
+	assert.Equal(t, []string{"a", "c", "e", "d"}, paths[2].Nodes)
+	assert.Positive(t, calls)
+
+	paths, err = KShortest("a", 5, 2, shortest)
+	require.NoError(t, err)
+	assert.Len(t, paths, 2, "超过最大跳数的路径不返回")
+
+	paths, err = KShortest("a", 2, 3, shortest)
+	require.NoError(t, err)
+	assert.Len(t, paths, 2)
+
+	// 偏离路径不能回到 root 上的节点: b -> a 之后 a 不能再出现
+	paths, err = KShortest("b", 3, 4, func(from string, maxHops int, excl Exclusion) (Path, bool, error) {
+		p, ok := Shortest(from, "e", maxHops, diamond, excl)
+		return p, ok, nil
+	})
+	require.NoError(t, err)
+	require.Len(t, paths, 3)
+	assert.Equal(t, []string{"b", "d", "e"}, paths[0].Nodes)
+	for _, p := range paths {
+		assert.Len(t, p.Nodes, len(uniq(p.Nodes)), "路径无环: %v", p.Nodes)
+	}
+
+	paths, err = KShortest("a", 3, 3, func(string, int, Exclusion) (Path, bool, error) { return Path{}, false, nil })
+	require.NoError(t, err)
+	assert.Empty(t, paths)
+}
+
+func uniq(s []string) map[string]bool {
+	m := make(map[string]bool, len(s))
+	for _, v := range s {
+		m[v] = true
+	}
+	return m
+}
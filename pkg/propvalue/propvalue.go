@@ -0,0 +1,183 @@
+// Package propvalue 在 Thrift 的带类型属性值 (PropertyValue) 与 Neo4j 原生属性值之间转换。
+//
+// 写入时 INT、DOUBLE、BOOL、DATE、LIST 分别保存为 Neo4j 的整数、浮点数、布尔值、Date/DateTime 和字符串列表，
+// 因此可以按范围过滤和正确排序；读取时同时生成兼容旧客户端的字符串形式。
+package propvalue
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+
+	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/typeregistry"
+)
+
+// localDateTimeLayout Neo4j LocalDateTime 的字符串形式 (不带时区)
+const localDateTimeLayout = "2006-01-02T15:04:05.999999999"
+
+// ToDB 将带类型的属性值转换为写入 Neo4j 的值。kind 对应的字段必须设置，DATE 须为 YYYY-MM-DD 或 RFC 3339。
+func ToDB(v *network.PropertyValue) (any, error) {
+	if v == nil {
+		return nil, fmt.Errorf("属性值不能为空")
+	}
+	switch v.Kind {
+	case network.PropertyValueKind_STRING:
+		if v.StringValue == nil {
+			return nil, fmt.Errorf("kind 为 STRING 时须设置 string_value")
+		}
+		return *v.StringValue, nil
+	case network.PropertyValueKind_INT:
+		if v.IntValue == nil {
+			return nil, fmt.Errorf("kind 为 INT 时须设置 int_value")
+		}
+		return *v.IntValue, nil
+	case network.PropertyValueKind_DOUBLE:
+		if v.DoubleValue == nil {
+			return nil, fmt.Errorf("kind 为 DOUBLE 时须设置 double_value")
+		}
+		return *v.DoubleValue, nil
+	case network.PropertyValueKind_BOOL:
+		if v.BoolValue == nil {
+			return nil, fmt.Errorf("kind 为 BOOL 时须设置 bool_value")
+		}
+		return *v.BoolValue, nil
+	case network.PropertyValueKind_DATE:
+		if v.DateValue == nil {
+			return nil, fmt.Errorf("kind 为 DATE 时须设置 date_value")
+		}
+		if d, err := time.Parse(time.DateOnly, *v.DateValue); err == nil {
+			return dbtype.Date(d), nil
+		}
+		t, err := time.Parse(time.RFC3339, *v.DateValue)
+		if err != nil {
+			return nil, fmt.Errorf("date_value 须为 YYYY-MM-DD 或 RFC 3339 时间")
+		}
+		return t, nil
+	case network.PropertyValueKind_LIST:
+		if v.ListValue == nil {
+			return nil, fmt.Errorf("kind 为 LIST 时须设置 list_value")
+		}
+		return append([]string{}, v.ListValue...), nil
+	default:
+		return nil, fmt.Errorf("无效的属性值类型 %d", v.Kind)
+	}
+}
+
+// ToDBMap 转换一组带类型的属性值，无效的项按键排序后以字段错误返回 (此时不返回转换结果)。
+func ToDBMap(typed map[string]*network.PropertyValue) (map[string]any, []typeregistry.FieldError) {
+	if len(typed) == 0 {
+		return nil, nil
+	}
+	out := make(map[string]any, len(typed))
+	var errs []typeregistry.FieldError
+	for key, v := range typed {
+		dbValue, err := ToDB(v)
+		if err != nil {
+			errs = append(errs, typeregistry.FieldError{Field: key, Code: typeregistry.CodeInvalidType, Message: err.Error()})
+			continue
+		}
+		out[key] = dbValue
+	}
+	if len(errs) > 0 {
+		sort.Slice(errs, func(i, j int) bool { return errs[i].Field < errs[j].Field })
+		return nil, errs
+	}
+	return out, nil
+}
+
+// FromDB 将 Neo4j 属性值转换为带类型的属性值，不支持的类型 (如 Point、Duration) 返回 false。
+func FromDB(value any) (*network.PropertyValue, bool) {
+	switch v := value.(type) {
+	case string:
+		return &network.PropertyValue{Kind: network.PropertyValueKind_STRING, StringValue: &v}, true
+	case int64:
+		return &network.PropertyValue{Kind: network.PropertyValueKind_INT, IntValue: &v}, true
+	case float64:
+		return &network.PropertyValue{Kind: network.PropertyValueKind_DOUBLE, DoubleValue: &v}, true
+	case bool:
+		return &network.PropertyValue{Kind: network.PropertyValueKind_BOOL, BoolValue: &v}, true
+	case dbtype.Date, time.Time, dbtype.LocalDateTime:
+		s, _ := Format(v)
+		return &network.PropertyValue{Kind: network.PropertyValueKind_DATE, DateValue: &s}, true
+	case []string, []any:
+		list, ok := formatList(v)
+		if !ok {
+			return nil, false
+		}
+		return &network.PropertyValue{Kind: network.PropertyValueKind_LIST, ListValue: list}, true
+	}
+	return nil, false
+}
+
+// Format 返回 Neo4j 属性值的字符串形式 (用于兼容的 properties 字段和属性约束校验)。
+// 列表格式化为 JSON 数组；不支持的类型返回 false。
+func Format(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case dbtype.Date:
+		return v.Time().Format(time.DateOnly), true
+	case time.Time:
+		return v.Format(time.RFC3339Nano), true
+	case dbtype.LocalDateTime:
+		return v.Time().Format(localDateTimeLayout), true
+	case []string, []any:
+		list, ok := formatList(v)
+		if !ok {
+			return "", false
+		}
+		data, _ := json.Marshal(list)
+		return string(data), true
+	}
+	return "", false
+}
+
+// StringView 合并字符串属性和已转换的带类型属性的字符串形式，同名键以带类型的值为准
+func StringView(props map[string]string, typed map[string]any) map[string]string {
+	if len(typed) == 0 {
+		return props
+	}
+	out := make(map[string]string, len(props)+len(typed))
+	for k, v := range props {
+		out[k] = v
+	}
+	for k, v := range typed {
+		if s, ok := Format(v); ok {
+			out[k] = s
+		}
+	}
+	return out
+}
+
+// formatList 将列表元素逐个格式化为字符串，嵌套列表等不支持的元素返回 false
+func formatList(value any) ([]string, bool) {
+	switch v := value.(type) {
+	case []string:
+		return append([]string{}, v...), true
+	case []any:
+		out := make([]string, len(v))
+		for i, elem := range v {
+			if _, nested := elem.([]any); nested {
+				return nil, false
+			}
+			s, ok := Format(elem)
+			if !ok {
+				return nil, false
+			}
+			out[i] = s
+		}
+		return out, true
+	}
+	return nil, false
+}
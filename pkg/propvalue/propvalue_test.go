@@ -0,0 +1,113 @@
+package propvalue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	network "labelwall/biz/model/relationship/network"
+	"labelwall/pkg/typeregistry"
+)
+
+func strPtr(s string) *string { return &s }
+
+func TestToDB_FromDB_RoundTrip(t *testing.T) {
+	i, f, b := int64(42), 3.5, true
+	values := []*network.PropertyValue{
+		{Kind: network.PropertyValueKind_STRING, StringValue: strPtr("北京")},
+		{Kind: network.PropertyValueKind_INT, IntValue: &i},
+		{Kind: network.PropertyValueKind_DOUBLE, DoubleValue: &f},
+		{Kind: network.PropertyValueKind_BOOL, BoolValue: &b},
+		{Kind: network.PropertyValueKind_DATE, DateValue: strPtr("2020-01-02")},
+		{Kind: network.PropertyValueKind_DATE, DateValue: strPtr("2020-01-02T03:04:05+08:00")},
+		{Kind: network.PropertyValueKind_LIST, ListValue: []string{"go", "neo4j"}},
+	}
+	for _, v := range values {
+		dbValue, err := ToDB(v)
+		require.NoError(t, err, v.String())
+		got, ok := FromDB(dbValue)
+		require.True(t, ok, v.String())
+		assert.Equal(t, v, got)
+	}
+
+	dbValue, _ := ToDB(values[4])
+	assert.IsType(t, dbtype.Date{}, dbValue, "纯日期保存为 Neo4j Date")
+	dbValue, _ = ToDB(values[1])
+	assert.IsType(t, int64(0), dbValue)
+}
+
+func TestToDB_Invalid(t *testing.T) {
+	invalid := []*network.PropertyValue{
+		nil,
+		{},
+		{Kind: network.PropertyValueKind_INT},
+		{Kind: network.PropertyValueKind_INT, StringValue: strPtr("1")},
+		{Kind: network.PropertyValueKind_DATE, DateValue: strPtr("01/02/2020")},
+		{Kind: network.PropertyValueKind_LIST},
+		{Kind: 99, StringValue: strPtr("x")},
+	}
+	for _, v := range invalid {
+		_, err := ToDB(v)
+		assert.Error(t, err, v.String())
+	}
+}
+
+func TestToDBMap(t *testing.T) {
+	out, errs := ToDBMap(nil)
+	assert.Nil(t, out)
+	assert.Nil(t, errs)
+
+	i := int64(1)
+	out, errs = ToDBMap(map[string]*network.PropertyValue{"age": {Kind: network.PropertyValueKind_INT, IntValue: &i}})
+	require.Empty(t, errs)
+	assert.Equal(t, map[string]any{"age": int64(1)}, out)
+
+	out, errs = ToDBMap(map[string]*network.PropertyValue{
+		"b":   {Kind: network.PropertyValueKind_BOOL},
+		"a":   {Kind: network.PropertyValueKind_INT},
+		"age": {Kind: network.PropertyValueKind_INT, IntValue: &i},
+	})
+	assert.Nil(t, out)
+	require.Len(t, errs, 2)
+	assert.Equal(t, "a", errs[0].Field, "按键排序")
+	assert.Equal(t, typeregistry.CodeInvalidType, errs[1].Code)
+}
+
+func TestFormat(t *testing.T) {
+	cases := map[string]any{
+		"text":                 "text",
+		"-7":                   int64(-7),
+		"1000000":              float64(1e6),
+		"0.25":                 0.25,
+		"false":                false,
+		"2020-01-02":           dbtype.Date(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)),
+		"2020-01-02T03:04:05Z": time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		"2020-01-02T03:04:05":  dbtype.LocalDateTime(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)),
+		`["a","1"]`:            []any{"a", int64(1)},
+		`["x"]`:                []string{"x"},
+	}
+	for want, value := range cases {
+		got, ok := Format(value)
+		require.True(t, ok, want)
+		assert.Equal(t, want, got)
+	}
+
+	for _, value := range []any{nil, map[string]any{}, []any{[]any{"nested"}}, dbtype.Point2D{}} {
+		_, ok := Format(value)
+		assert.False(t, ok)
+		_, ok = FromDB(value)
+		assert.False(t, ok)
+	}
+}
+
+func TestStringView(t *testing.T) {
+	props := map[string]string{"age": "old", "city": "北京"}
+	assert.Equal(t, props, StringView(props, nil))
+
+	view := StringView(props, map[string]any{"age": int64(30), "tags": []string{"a"}})
+	assert.Equal(t, map[string]string{"age": "30", "city": "北京", "tags": `["a"]`}, view)
+	assert.Equal(t, "old", props["age"], "不修改输入")
+}
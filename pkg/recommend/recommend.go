@@ -0,0 +1,81 @@
+// Package recommend 为节点推荐 "可能认识的人"。
+//
+// 候选人是经过共同联系人或共同所属组织 (公司、学校) 可达的二跳节点，按共同联系人数、共同组织数、
+// Adamic-Adar 指数和 Jaccard 相似度的加权和排序。与存储无关，邻居数据由调用方提供。
+package recommend
+
+import (
+	"math"
+	"sort"
+)
+
+// 综合得分中各项的权重
+const (
+	MutualWeight     = 1.0 // 每个共同联系人
+	SharedOrgWeight  = 0.5 // 每个共同组织
+	AdamicAdarWeight = 1.0
+	JaccardWeight    = 2.0
+)
+
+// Common 是候选人与目标节点的一个共同邻居
+type Common struct {
+	ID     string
+	Degree int // 共同邻居的关系数，用于 Adamic-Adar
+}
+
+// Candidate 是一个二跳候选人
+type Candidate struct {
+	ID         string
+	Contacts   int      // 候选人的联系人数量，用于 Jaccard
+	Mutuals    []Common // 共同联系人
+	SharedOrgs []Common // 共同所属的组织
+}
+
+// Scored 是打分后的候选人
+type Scored struct {
+	Candidate
+	AdamicAdar float64 // 全部共同邻居 (联系人和组织) 的 1 / ln(度) 之和
+	Jaccard    float64 // 联系人集合的 Jaccard 相似度
+	Score      float64 // 加权和
+}
+
+// Rank 为候选人打分，按得分降序返回前 limit 个 (limit <= 0 时全部返回)；
+// 得分相同时按共同联系人数降序、ID 升序。contacts 为目标节点的联系人数量。
+func Rank(contacts int, candidates []Candidate, limit int) []Scored {
+	scored := make([]Scored, 0, len(candidates))
+	for _, c := range candidates {
+		s := Scored{Candidate: c}
+		for _, common := range c.Mutuals {
+			s.AdamicAdar += adamicAdarTerm(common.Degree)
+		}
+		for _, common := range c.SharedOrgs {
+			s.AdamicAdar += adamicAdarTerm(common.Degree)
+		}
+		mutual := len(c.Mutuals)
+		if union := contacts + c.Contacts - mutual; union > 0 {
+			s.Jaccard = float64(mutual) / float64(union)
+		}
+		s.Score = MutualWeight*float64(mutual) + SharedOrgWeight*float64(len(c.SharedOrgs)) +
+			AdamicAdarWeight*s.AdamicAdar + JaccardWeight*s.Jaccard
+		scored = append(scored, s)
+	}
+	sort.SliceStable(scored, func(i, j int) bool {
+		a, b := scored[i], scored[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if len(a.Mutuals) != len(b.Mutuals) {
+			return len(a.Mutuals) > len(b.Mutuals)
+		}
+		return a.ID < b.ID
+	})
+	if limit > 0 && len(scored) > limit {
+		scored = scored[:limit]
+	}
+	return scored
+}
+
+// adamicAdarTerm 返回度为 degree 的共同邻居的贡献。共同邻居至少连接目标节点和候选人，度小于 2 时按 2 计算。
+func adamicAdarTerm(degree int) float64 {
+	return 1 / math.Log(float64(max(degree, 2)))
+}
@@ -0,0 +1,41 @@
+package recommend
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRank(t *testing.T) {
+	candidates := []Candidate{
+		{ID: "org-only", Contacts: 4, SharedOrgs: []Common{{ID: "c1", Degree: 100}}},
+		{ID: "two-mutuals", Contacts: 3, Mutuals: []Common{{ID: "m1", Degree: 2}, {ID: "m2", Degree: 10}}},
+		{ID: "one-mutual", Contacts: 1, Mutuals: []Common{{ID: "m1", Degree: 2}}},
+	}
+	got := Rank(3, candidates, 0)
+	require.Len(t, got, 3)
+	assert.Equal(t, []string{"two-mutuals", "one-mutual", "org-only"}, []string{got[0].ID, got[1].ID, got[2].ID})
+
+	// 3 个联系人与 3 个联系人共有 2 个: 2 / (3 + 3 - 2)
+	assert.InDelta(t, 0.5, got[0].Jaccard, 1e-9)
+	assert.InDelta(t, 1/math.Log(2)+1/math.Log(10), got[0].AdamicAdar, 1e-9)
+	assert.InDelta(t, 2*MutualWeight+got[0].AdamicAdar+JaccardWeight*0.5, got[0].Score, 1e-9)
+
+	assert.Zero(t, got[2].Jaccard, "没有共同联系人")
+	assert.InDelta(t, SharedOrgWeight+1/math.Log(100), got[2].Score, 1e-9, "大组织的贡献较小")
+
+	assert.Len(t, Rank(3, candidates, 1), 1)
+	assert.Empty(t, Rank(0, nil, 10))
+}
+
+func TestRank_Ties(t *testing.T) {
+	candidates := []Candidate{
+		{ID: "b", Mutuals: []Common{{ID: "m", Degree: 2}}},
+		{ID: "a", Mutuals: []Common{{ID: "m", Degree: 2}}},
+		{ID: "c", Mutuals: []Common{{ID: "m", Degree: 1}}},
+	}
+	got := Rank(1, candidates, 0)
+	assert.Equal(t, []string{"a", "b", "c"}, []string{got[0].ID, got[1].ID, got[2].ID}, "得分相同时按 ID 升序，度小于 2 按 2 计算")
+}
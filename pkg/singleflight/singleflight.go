@@ -0,0 +1,87 @@
+// Package singleflight 合并同一个 key 上并发的重复调用 (缓存未命中时防止击穿)。
+//
+// 与 golang.org/x/sync/singleflight 类似，但增加了泛型和 context 支持:
+// fn 在独立的 goroutine 中以不受调用者取消影响的 context 执行，
+// 某个调用者的请求被取消时只有它自己提前返回，其他等待者仍能拿到结果。
+// 调用者的截止时间也随之丢弃，因此 fn 的 context 带有 Group.Timeout 的时限。
+package singleflight
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultTimeout 是 Group.Timeout 未设置时 fn 的执行时限
+const DefaultTimeout = 30 * time.Second
+
+// call 是一次正在执行或已完成的调用
+type call[T any] struct {
+	done chan struct{}
+	val  T
+	err  error
+}
+
+// Group 管理一组按 key 合并的调用，零值可直接使用
+type Group[T any] struct {
+	// Timeout 是 fn 的执行时限，从第一个调用者开始计算；<= 0 时使用 DefaultTimeout
+	Timeout time.Duration
+
+	mu sync.Mutex
+	m  map[string]*call[T]
+}
+
+// Do 执行 fn 并返回其结果。同一 key 同时只有一个 fn 在执行，
+// 期间到达的调用者等待并共享同一结果，shared 表示结果是否与其他调用者共享。
+// ctx 取消时立即返回 ctx.Err()，不会取消正在执行的 fn；fn 超过 Timeout 时其 context 被取消。
+func (g *Group[T]) Do(ctx context.Context, key string, fn func(ctx context.Context) (T, error)) (v T, shared bool, err error) {
+	g.mu.Lock()
+	if g.m == nil {
+		g.m = make(map[string]*call[T])
+	}
+	c, inflight := g.m[key]
+	if !inflight {
+		c = &call[T]{done: make(chan struct{})}
+		g.m[key] = c
+		go g.run(ctx, key, c, fn)
+	}
+	g.mu.Unlock()
+
+	select {
+	case <-c.done:
+		return c.val, inflight, c.err
+	case <-ctx.Done():
+		var zero T
+		return zero, inflight, ctx.Err()
+	}
+}
+
+func (g *Group[T]) run(ctx context.Context, key string, c *call[T], fn func(ctx context.Context) (T, error)) {
+	timeout := g.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+	defer cancel()
+	defer func() {
+		if p := recover(); p != nil {
+			c.err = fmt.Errorf("singleflight: panic in %q: %v", key, p)
+		}
+		g.mu.Lock()
+		if g.m[key] == c {
+			delete(g.m, key)
+		}
+		g.mu.Unlock()
+		close(c.done)
+	}()
+	c.val, c.err = fn(ctx)
+}
+
+// Forget 使之后对 key 的调用不再加入正在执行的调用，而是重新执行 fn。
+// 数据被修改后调用，避免新的读请求拿到修改前开始的查询结果。
+func (g *Group[T]) Forget(key string) {
+	g.mu.Lock()
+	delete(g.m, key)
+	g.mu.Unlock()
+}
@@ -0,0 +1,132 @@
+package singleflight
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupDoCoalesces(t *testing.T) {
+	var g Group[int]
+	var calls atomic.Int32
+	release := make(chan struct{})
+
+	var wg sync.WaitGroup
+	results := make([]int, 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			v, _, err := g.Do(context.Background(), "k", func(ctx context.Context) (int, error) {
+				calls.Add(1)
+				<-release
+				return 42, nil
+			})
+			require.NoError(t, err)
+			results[i] = v
+		}(i)
+	}
+	// 等待所有调用者进入等待后再放行
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+	for _, v := range results {
+		assert.Equal(t, 42, v)
+	}
+
+	// 调用完成后同一 key 会重新执行
+	v, shared, err := g.Do(context.Background(), "k", func(ctx context.Context) (int, error) { return 7, nil })
+	require.NoError(t, err)
+	assert.False(t, shared)
+	assert.Equal(t, 7, v)
+}
+
+func TestGroupDoCallerCancel(t *testing.T) {
+	var g Group[string]
+	release := make(chan struct{})
+	fn := func(ctx context.Context) (string, error) {
+		<-release
+		return "v", ctx.Err()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		_, _, err := g.Do(ctx, "k", fn)
+		errCh <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	// 发起调用的请求取消后，其他等待者仍能拿到结果
+	done := make(chan string, 1)
+	go func() {
+		v, shared, err := g.Do(context.Background(), "k", fn)
+		assert.True(t, shared)
+		assert.NoError(t, err)
+		done <- v
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	assert.ErrorIs(t, <-errCh, context.Canceled)
+
+	close(release)
+	assert.Equal(t, "v", <-done)
+}
+
+func TestGroupDoTimeout(t *testing.T) {
+	g := Group[int]{Timeout: 20 * time.Millisecond}
+
+	// 调用者没有截止时间，fn 仍在 Timeout 后被取消
+	_, _, err := g.Do(context.Background(), "k", func(ctx context.Context) (int, error) {
+		deadline, ok := ctx.Deadline()
+		assert.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(20*time.Millisecond), deadline, 20*time.Millisecond)
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// 零值 Group 使用默认时限
+	var zero Group[int]
+	_, _, err = zero.Do(context.Background(), "k", func(ctx context.Context) (int, error) {
+		_, ok := ctx.Deadline()
+		assert.True(t, ok)
+		return 0, nil
+	})
+	require.NoError(t, err)
+}
+
+func TestGroupDoErrorAndPanic(t *testing.T) {
+	var g Group[int]
+	boom := errors.New("boom")
+	_, _, err := g.Do(context.Background(), "err", func(ctx context.Context) (int, error) { return 0, boom })
+	assert.ErrorIs(t, err, boom)
+
+	_, _, err = g.Do(context.Background(), "panic", func(ctx context.Context) (int, error) { panic("bad") })
+	assert.ErrorContains(t, err, "panic")
+}
+
+func TestGroupForget(t *testing.T) {
+	var g Group[int]
+	release := make(chan struct{})
+	go g.Do(context.Background(), "k", func(ctx context.Context) (int, error) {
+		<-release
+		return 1, nil
+	})
+	time.Sleep(10 * time.Millisecond)
+
+	g.Forget("k")
+	v, shared, err := g.Do(context.Background(), "k", func(ctx context.Context) (int, error) { return 2, nil })
+	require.NoError(t, err)
+	assert.False(t, shared)
+	assert.Equal(t, 2, v, "Forget 之后不再加入旧的调用")
+	close(release)
+}
@@ -0,0 +1,359 @@
+// Package typeregistry 维护节点标签和关系类型的注册表。
+//
+// Thrift 中的 NodeType / RelationType 枚举只是内置类型；运行时新增的类型分配新的编码，
+// 仍以 network.NodeType(code) / network.RelationType(code) 传递，不需要重新生成 Thrift 代码。
+// DAL、映射工具和 schema 初始化通过注册表在编码与标签名之间转换，而不是调用枚举的 String()。
+package typeregistry
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	network "labelwall/biz/model/relationship/network"
+)
+
+// NodeTypeDef 节点类型定义，Name 即 Neo4j 标签。Properties 为空表示不限制节点属性。
+type NodeTypeDef struct {
+	Name       string           `json:"name"`
+	Code       network.NodeType `json:"code"`
+	Builtin    bool             `json:"builtin"`
+	Properties []PropertySchema `json:"properties,omitempty"`
+}
+
+// TypePair 关系允许的 (起始节点类型, 目标节点类型) 组合，均为节点类型名
+type TypePair struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
+// RelationTypeDef 关系类型定义，AllowedPairs 为空表示不限制两端节点类型，Properties 为空表示不限制关系属性
+type RelationTypeDef struct {
+	Name         string               `json:"name"`
+	Code         network.RelationType `json:"code"`
+	Builtin      bool                 `json:"builtin"`
+	AllowedPairs []TypePair           `json:"allowed_pairs,omitempty"`
+	Properties   []PropertySchema     `json:"properties,omitempty"`
+}
+
+// namePattern 类型名会直接拼接进 Cypher (标签和关系类型不能参数化)，只允许大写字母、数字和下划线
+var namePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]{0,63}$`)
+
+// ValidateName 检查类型名是否可以安全地用作 Neo4j 标签或关系类型
+func ValidateName(name string) error {
+	if !namePattern.MatchString(name) {
+		return fmt.Errorf("无效的类型名 %q (须以大写字母开头，只包含大写字母、数字和下划线，最长 64 个字符)", name)
+	}
+	return nil
+}
+
+// Registry 类型注册表，并发安全
+type Registry struct {
+	mu         sync.RWMutex
+	nodeByCode map[network.NodeType]NodeTypeDef
+	nodeByName map[string]network.NodeType
+	relByCode  map[network.RelationType]RelationTypeDef
+	relByName  map[string]network.RelationType
+}
+
+var defaultRegistry = New()
+
+// Default 返回进程内共享的注册表，启动时从存储加载持久化的类型定义
+func Default() *Registry {
+	return defaultRegistry
+}
+
+// New 创建一个只包含 Thrift 内置类型的注册表
+func New() *Registry {
+	r := &Registry{
+		nodeByCode: make(map[network.NodeType]NodeTypeDef),
+		nodeByName: make(map[string]network.NodeType),
+		relByCode:  make(map[network.RelationType]RelationTypeDef),
+		relByName:  make(map[string]network.RelationType),
+	}
+	for _, t := range []network.NodeType{network.NodeType_PERSON, network.NodeType_COMPANY, network.NodeType_SCHOOL} {
+		r.putNode(NodeTypeDef{Name: t.String(), Code: t, Builtin: true})
+	}
+	for _, t := range []network.RelationType{
+		network.RelationType_COLLEAGUE, network.RelationType_SCHOOLMATE, network.RelationType_FRIEND,
+		network.RelationType_VISITED, network.RelationType_FOLLOWING,
+	} {
+		r.putRelation(RelationTypeDef{Name: t.String(), Code: t, Builtin: true})
+	}
+	return r
+}
+
+// isBuiltinNodeType 判断名称是否为 Thrift 枚举中的节点类型
+func isBuiltinNodeType(name string) bool {
+	_, err := network.NodeTypeFromString(name)
+	return err == nil
+}
+
+// isBuiltinRelationType 判断名称是否为 Thrift 枚举中的关系类型
+func isBuiltinRelationType(name string) bool {
+	_, err := network.RelationTypeFromString(name)
+	return err == nil
+}
+
+// --- 节点类型 ---
+
+// NodeLabel 返回节点类型对应的 Neo4j 标签，未注册时 ok 为 false
+func (r *Registry) NodeLabel(t network.NodeType) (string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	def, ok := r.nodeByCode[t]
+	return def.Name, ok
+}
+
+// NodeTypeName 返回节点类型名，未注册时退回枚举的 String() (用于日志、缓存键和导出)
+func (r *Registry) NodeTypeName(t network.NodeType) string {
+	if name, ok := r.NodeLabel(t); ok {
+		return name
+	}
+	return t.String()
+}
+
+// NodeTypeByName 按名称 (标签) 查找节点类型
+func (r *Registry) NodeTypeByName(name string) (network.NodeType, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	t, ok := r.nodeByName[name]
+	return t, ok
+}
+
+// NodeTypeByLabels 从节点的标签列表中找到第一个已注册的节点类型
+func (r *Registry) NodeTypeByLabels(labels []string) (network.NodeType, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, label := range labels {
+		if t, ok := r.nodeByName[label]; ok {
+			return t, true
+		}
+	}
+	return 0, false
+}
+
+// ParseNodeType 同时接受类型名 (不区分大小写) 和编码
+func (r *Registry) ParseNodeType(s string) (network.NodeType, error) {
+	if t, ok := r.NodeTypeByName(strings.ToUpper(strings.TrimSpace(s))); ok {
+		return t, nil
+	}
+	if n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64); err == nil {
+		if _, ok := r.NodeLabel(network.NodeType(n)); ok {
+			return network.NodeType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("无效的节点类型 %q", s)
+}
+
+// NodeTypes 返回全部节点类型，按编码排序
+func (r *Registry) NodeTypes() []NodeTypeDef {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	defs := make([]NodeTypeDef, 0, len(r.nodeByCode))
+	for _, def := range r.nodeByCode {
+		def.Properties = CloneSchema(def.Properties)
+		defs = append(defs, def)
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Code < defs[j].Code })
+	return defs
+}
+
+// NodePropertySchema 返回节点类型的属性约束，nil 表示不限制
+func (r *Registry) NodePropertySchema(t network.NodeType) []PropertySchema {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return CloneSchema(r.nodeByCode[t].Properties)
+}
+
+// NextNodeTypeCode 返回下一个可分配的节点类型编码
+func (r *Registry) NextNodeTypeCode() network.NodeType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var maxCode network.NodeType
+	for code := range r.nodeByCode {
+		maxCode = max(maxCode, code)
+	}
+	return maxCode + 1
+}
+
+// PutNodeType 注册节点类型。同名同编码的重复注册会更新属性约束，名称或编码与已有类型冲突时返回错误。
+func (r *Registry) PutNodeType(def NodeTypeDef) error {
+	if err := ValidateName(def.Name); err != nil {
+		return err
+	}
+	if def.Code <= 0 {
+		return fmt.Errorf("节点类型 %s 的编码必须为正数", def.Name)
+	}
+	if err := ValidateSchema(def.Properties); err != nil {
+		return fmt.Errorf("节点类型 %s 的属性约束无效: %w", def.Name, err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if code, ok := r.nodeByName[def.Name]; ok && code != def.Code {
+		return fmt.Errorf("节点类型 %s 已使用编码 %d", def.Name, code)
+	}
+	if existing, ok := r.nodeByCode[def.Code]; ok && existing.Name != def.Name {
+		return fmt.Errorf("节点类型编码 %d 已被 %s 使用", def.Code, existing.Name)
+	}
+	def.Builtin = isBuiltinNodeType(def.Name)
+	def.Properties = CloneSchema(def.Properties)
+	r.putNode(def)
+	return nil
+}
+
+func (r *Registry) putNode(def NodeTypeDef) {
+	r.nodeByCode[def.Code] = def
+	r.nodeByName[def.Name] = def.Code
+}
+
+// --- 关系类型 ---
+
+// RelationLabel 返回关系类型对应的 Neo4j 关系类型名，未注册时 ok 为 false
+func (r *Registry) RelationLabel(t network.RelationType) (string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	def, ok := r.relByCode[t]
+	return def.Name, ok
+}
+
+// RelationTypeName 返回关系类型名，未注册时退回枚举的 String()
+func (r *Registry) RelationTypeName(t network.RelationType) string {
+	if name, ok := r.RelationLabel(t); ok {
+		return name
+	}
+	return t.String()
+}
+
+// RelationTypeByName 按名称查找关系类型
+func (r *Registry) RelationTypeByName(name string) (network.RelationType, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	t, ok := r.relByName[name]
+	return t, ok
+}
+
+// ParseRelationType 同时接受类型名 (不区分大小写) 和编码
+func (r *Registry) ParseRelationType(s string) (network.RelationType, error) {
+	if t, ok := r.RelationTypeByName(strings.ToUpper(strings.TrimSpace(s))); ok {
+		return t, nil
+	}
+	if n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64); err == nil {
+		if _, ok := r.RelationLabel(network.RelationType(n)); ok {
+			return network.RelationType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("无效的关系类型 %q", s)
+}
+
+// RelationTypes 返回全部关系类型，按编码排序
+func (r *Registry) RelationTypes() []RelationTypeDef {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	defs := make([]RelationTypeDef, 0, len(r.relByCode))
+	for _, def := range r.relByCode {
+		def.AllowedPairs = slices.Clone(def.AllowedPairs)
+		def.Properties = CloneSchema(def.Properties)
+		defs = append(defs, def)
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Code < defs[j].Code })
+	return defs
+}
+
+// AllowedPairs 返回关系类型允许的节点类型组合，nil 表示不限制
+func (r *Registry) AllowedPairs(t network.RelationType) []TypePair {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return slices.Clone(r.relByCode[t].AllowedPairs)
+}
+
+// RelationPropertySchema 返回关系类型的属性约束，nil 表示不限制
+func (r *Registry) RelationPropertySchema(t network.RelationType) []PropertySchema {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return CloneSchema(r.relByCode[t].Properties)
+}
+
+// AllowsRelation 判断两端节点 (以标签列表表示) 之间是否允许创建该类型的关系
+func (r *Registry) AllowsRelation(t network.RelationType, sourceLabels, targetLabels []string) bool {
+	pairs := r.AllowedPairs(t)
+	if len(pairs) == 0 {
+		return true
+	}
+	for _, p := range pairs {
+		if slices.Contains(sourceLabels, p.Source) && slices.Contains(targetLabels, p.Target) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextRelationTypeCode 返回下一个可分配的关系类型编码
+func (r *Registry) NextRelationTypeCode() network.RelationType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var maxCode network.RelationType
+	for code := range r.relByCode {
+		maxCode = max(maxCode, code)
+	}
+	return maxCode + 1
+}
+
+// PutRelationType 注册或更新关系类型。已有类型 (包括内置类型) 只能修改 AllowedPairs 和属性约束，
+// 组合中的节点类型必须已注册。
+func (r *Registry) PutRelationType(def RelationTypeDef) error {
+	if err := ValidateName(def.Name); err != nil {
+		return err
+	}
+	if def.Code <= 0 {
+		return fmt.Errorf("关系类型 %s 的编码必须为正数", def.Name)
+	}
+	if err := ValidateSchema(def.Properties); err != nil {
+		return fmt.Errorf("关系类型 %s 的属性约束无效: %w", def.Name, err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if code, ok := r.relByName[def.Name]; ok && code != def.Code {
+		return fmt.Errorf("关系类型 %s 已使用编码 %d", def.Name, code)
+	}
+	if existing, ok := r.relByCode[def.Code]; ok && existing.Name != def.Name {
+		return fmt.Errorf("关系类型编码 %d 已被 %s 使用", def.Code, existing.Name)
+	}
+	for _, p := range def.AllowedPairs {
+		if _, ok := r.nodeByName[p.Source]; !ok {
+			return fmt.Errorf("关系类型 %s 的起始节点类型 %s 未注册", def.Name, p.Source)
+		}
+		if _, ok := r.nodeByName[p.Target]; !ok {
+			return fmt.Errorf("关系类型 %s 的目标节点类型 %s 未注册", def.Name, p.Target)
+		}
+	}
+	def.Builtin = isBuiltinRelationType(def.Name)
+	def.AllowedPairs = slices.Clone(def.AllowedPairs)
+	def.Properties = CloneSchema(def.Properties)
+	r.putRelation(def)
+	return nil
+}
+
+func (r *Registry) putRelation(def RelationTypeDef) {
+	r.relByCode[def.Code] = def
+	r.relByName[def.Name] = def.Code
+}
+
+// Load 注册从存储读取的类型定义 (先节点后关系)，遇到冲突时返回第一个错误
+func (r *Registry) Load(nodeTypes []NodeTypeDef, relationTypes []RelationTypeDef) error {
+	for _, def := range nodeTypes {
+		if err := r.PutNodeType(def); err != nil {
+			return err
+		}
+	}
+	for _, def := range relationTypes {
+		if err := r.PutRelationType(def); err != nil {
+			return err
+		}
+	}
+	return nil
+}
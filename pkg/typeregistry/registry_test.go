@@ -0,0 +1,130 @@
+package typeregistry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	network "labelwall/biz/model/relationship/network"
+)
+
+func TestNew_Builtins(t *testing.T) {
+	r := New()
+
+	label, ok := r.NodeLabel(network.NodeType_COMPANY)
+	require.True(t, ok)
+	assert.Equal(t, "COMPANY", label)
+	rt, ok := r.RelationTypeByName("FRIEND")
+	require.True(t, ok)
+	assert.Equal(t, network.RelationType_FRIEND, rt)
+
+	nodeTypes := r.NodeTypes()
+	require.Len(t, nodeTypes, 3)
+	assert.Equal(t, network.NodeType_PERSON, nodeTypes[0].Code, "按编码排序")
+	for _, def := range nodeTypes {
+		assert.True(t, def.Builtin)
+	}
+	assert.Equal(t, network.NodeType(4), r.NextNodeTypeCode())
+	assert.Equal(t, network.RelationType(6), r.NextRelationTypeCode())
+
+	// 内置关系类型不限制两端节点类型
+	assert.Nil(t, r.AllowedPairs(network.RelationType_FRIEND))
+	assert.True(t, r.AllowsRelation(network.RelationType_FRIEND, []string{"COMPANY"}, []string{"SCHOOL"}))
+}
+
+func TestValidateName(t *testing.T) {
+	for _, name := range []string{"PROJECT", "WORKS_ON", "A1"} {
+		assert.NoError(t, ValidateName(name), name)
+	}
+	for _, name := range []string{"", "project", "1ABC", "_X", "A-B", "A B", "A`) DETACH DELETE n //"} {
+		assert.Error(t, ValidateName(name), name)
+	}
+}
+
+func TestPutNodeType(t *testing.T) {
+	r := New()
+
+	require.NoError(t, r.PutNodeType(NodeTypeDef{Name: "PROJECT", Code: 4}))
+	require.NoError(t, r.PutNodeType(NodeTypeDef{Name: "PROJECT", Code: 4}), "重复注册是幂等的")
+	assert.Error(t, r.PutNodeType(NodeTypeDef{Name: "PROJECT", Code: 5}), "名称已使用其他编码")
+	assert.Error(t, r.PutNodeType(NodeTypeDef{Name: "EVENT", Code: 4}), "编码已被占用")
+	assert.Error(t, r.PutNodeType(NodeTypeDef{Name: "EVENT", Code: 0}))
+	assert.Error(t, r.PutNodeType(NodeTypeDef{Name: "event", Code: 5}))
+
+	code, ok := r.NodeTypeByLabels([]string{"Other", "PROJECT"})
+	require.True(t, ok)
+	assert.Equal(t, network.NodeType(4), code)
+	assert.Equal(t, "PROJECT", r.NodeTypeName(4))
+	assert.Equal(t, network.NodeType(5), r.NextNodeTypeCode())
+	assert.False(t, r.NodeTypes()[3].Builtin)
+
+	_, ok = r.NodeTypeByLabels([]string{"OutboxEvent"})
+	assert.False(t, ok)
+}
+
+func TestParseTypes(t *testing.T) {
+	r := New()
+	require.NoError(t, r.PutNodeType(NodeTypeDef{Name: "PROJECT", Code: 4}))
+	require.NoError(t, r.PutRelationType(RelationTypeDef{Name: "WORKS_ON", Code: 6}))
+
+	cases := map[string]network.NodeType{"person": network.NodeType_PERSON, " Project ": 4, "2": network.NodeType_COMPANY, "4": 4}
+	for in, want := range cases {
+		got, err := r.ParseNodeType(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+	_, err := r.ParseNodeType("9")
+	assert.Error(t, err)
+	_, err = r.ParseNodeType("unknown")
+	assert.Error(t, err)
+
+	rt, err := r.ParseRelationType("works_on")
+	require.NoError(t, err)
+	assert.Equal(t, network.RelationType(6), rt)
+	_, err = r.ParseRelationType("7")
+	assert.Error(t, err)
+}
+
+func TestPutRelationType_AllowedPairs(t *testing.T) {
+	r := New()
+	require.NoError(t, r.PutNodeType(NodeTypeDef{Name: "PROJECT", Code: 4}))
+
+	assert.Error(t, r.PutRelationType(RelationTypeDef{
+		Name: "WORKS_ON", Code: 6, AllowedPairs: []TypePair{{Source: "PERSON", Target: "EVENT"}},
+	}), "组合中的节点类型必须已注册")
+
+	pairs := []TypePair{{Source: "PERSON", Target: "PROJECT"}}
+	require.NoError(t, r.PutRelationType(RelationTypeDef{Name: "WORKS_ON", Code: 6, AllowedPairs: pairs}))
+	pairs[0].Target = "COMPANY"
+	assert.Equal(t, []TypePair{{Source: "PERSON", Target: "PROJECT"}}, r.AllowedPairs(6), "注册表保存副本")
+
+	assert.True(t, r.AllowsRelation(6, []string{"PERSON"}, []string{"PROJECT"}))
+	assert.False(t, r.AllowsRelation(6, []string{"PROJECT"}, []string{"PERSON"}))
+	assert.False(t, r.AllowsRelation(6, []string{"PERSON"}, []string{"COMPANY"}))
+
+	// 内置关系类型可以追加限制，仍保持 Builtin
+	require.NoError(t, r.PutRelationType(RelationTypeDef{
+		Name: "VISITED", Code: network.RelationType_VISITED, AllowedPairs: []TypePair{{Source: "PERSON", Target: "COMPANY"}},
+	}))
+	assert.False(t, r.AllowsRelation(network.RelationType_VISITED, []string{"COMPANY"}, []string{"PERSON"}))
+	for _, def := range r.RelationTypes() {
+		if def.Name == "VISITED" {
+			assert.True(t, def.Builtin)
+		}
+	}
+	assert.Error(t, r.PutRelationType(RelationTypeDef{Name: "VISITED", Code: 7}), "内置类型不能改编码")
+}
+
+func TestLoad(t *testing.T) {
+	r := New()
+	err := r.Load(
+		[]NodeTypeDef{{Name: "PERSON", Code: network.NodeType_PERSON, Builtin: true}, {Name: "PROJECT", Code: 4}},
+		[]RelationTypeDef{{Name: "WORKS_ON", Code: 6, AllowedPairs: []TypePair{{Source: "PERSON", Target: "PROJECT"}}}},
+	)
+	require.NoError(t, err)
+	assert.Len(t, r.NodeTypes(), 4)
+	assert.Len(t, r.RelationTypes(), 6)
+
+	assert.Error(t, r.Load([]NodeTypeDef{{Name: "OTHER", Code: 4}}, nil), "编码冲突")
+}
@@ -0,0 +1,216 @@
+package typeregistry
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 属性值类型
+const (
+	ValueString = "string"
+	ValueInt    = "int"
+	ValueDate   = "date"
+	ValueEnum   = "enum"
+	ValueURL    = "url"
+)
+
+// 属性校验错误码
+const (
+	CodeRequired        = "required"
+	CodeUnknown         = "unknown"
+	CodeInvalidType     = "invalid_type"
+	CodeInvalidEnum     = "invalid_enum"
+	CodePatternMismatch = "pattern_mismatch"
+	CodeTooShort        = "too_short"
+	CodeTooLong         = "too_long"
+)
+
+// reservedPropertyKeys 由服务写入或对应请求中的独立字段，不能出现在属性约束中
+var reservedPropertyKeys = []string{"id", "name", "avatar", "profession", "label", "created_at", "updated_at"}
+
+// propertyKeyPattern 属性键只允许字母、数字和下划线
+var propertyKeyPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{0,63}$`)
+
+// PropertySchema 类型的一个属性约束 (对应 Node.properties / Relation.properties 中的一个键)
+type PropertySchema struct {
+	Key        string   `json:"key"`
+	Type       string   `json:"type,omitempty"` // 为空时按 string 处理
+	Required   bool     `json:"required,omitempty"`
+	EnumValues []string `json:"enum_values,omitempty"`
+	Pattern    string   `json:"pattern,omitempty"`
+	MinLength  int      `json:"min_length,omitempty"`
+	MaxLength  int      `json:"max_length,omitempty"` // 0 表示不限制
+}
+
+// FieldError 单个属性的校验错误
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// CloneSchema 深拷贝属性约束列表
+func CloneSchema(schema []PropertySchema) []PropertySchema {
+	if schema == nil {
+		return nil
+	}
+	out := make([]PropertySchema, len(schema))
+	for i, p := range schema {
+		p.EnumValues = slices.Clone(p.EnumValues)
+		out[i] = p
+	}
+	return out
+}
+
+// ValidateSchema 检查属性约束本身是否有效 (键、值类型、枚举值、正则和长度范围)
+func ValidateSchema(schema []PropertySchema) error {
+	seen := make(map[string]struct{}, len(schema))
+	for _, p := range schema {
+		if !propertyKeyPattern.MatchString(p.Key) {
+			return fmt.Errorf("无效的属性键 %q (须以字母开头，只包含字母、数字和下划线)", p.Key)
+		}
+		if slices.Contains(reservedPropertyKeys, p.Key) {
+			return fmt.Errorf("属性键 %s 是保留字段", p.Key)
+		}
+		if _, dup := seen[p.Key]; dup {
+			return fmt.Errorf("属性键 %s 重复", p.Key)
+		}
+		seen[p.Key] = struct{}{}
+
+		switch p.Type {
+		case "", ValueString, ValueInt, ValueDate, ValueURL:
+			if len(p.EnumValues) > 0 {
+				return fmt.Errorf("属性 %s 不是 enum 类型，不能设置 enum_values", p.Key)
+			}
+		case ValueEnum:
+			if len(p.EnumValues) == 0 {
+				return fmt.Errorf("enum 属性 %s 必须设置 enum_values", p.Key)
+			}
+		default:
+			return fmt.Errorf("属性 %s 的值类型 %q 无效 (可选 string、int、date、enum、url)", p.Key, p.Type)
+		}
+		if p.Pattern != "" {
+			if _, err := regexp.Compile(p.Pattern); err != nil {
+				return fmt.Errorf("属性 %s 的正则表达式无效: %w", p.Key, err)
+			}
+		}
+		if p.MinLength < 0 || p.MaxLength < 0 {
+			return fmt.Errorf("属性 %s 的长度限制不能为负数", p.Key)
+		}
+		if p.MaxLength > 0 && p.MinLength > p.MaxLength {
+			return fmt.Errorf("属性 %s 的 min_length 不能大于 max_length", p.Key)
+		}
+	}
+	return nil
+}
+
+// ValidateProperties 按约束校验属性，返回按出现顺序排列的字段错误。
+// schema 为空表示该类型不限制属性。partial 为 true 时 (更新) 只校验请求中出现的键，不检查缺失的必填键。
+// 可选属性的空值视为未设置。
+func ValidateProperties(schema []PropertySchema, props map[string]string, partial bool) []FieldError {
+	if len(schema) == 0 {
+		return nil
+	}
+	var errs []FieldError
+	declared := make(map[string]struct{}, len(schema))
+	for _, p := range schema {
+		declared[p.Key] = struct{}{}
+		value, present := props[p.Key]
+		if value == "" {
+			if p.Required && (present || !partial) {
+				errs = append(errs, FieldError{Field: p.Key, Code: CodeRequired, Message: "必填属性不能为空"})
+			}
+			continue
+		}
+		if fe, ok := validateValue(p, value); !ok {
+			errs = append(errs, fe)
+		}
+	}
+
+	var unknown []string
+	for key := range props {
+		if _, ok := declared[key]; !ok {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	for _, key := range unknown {
+		errs = append(errs, FieldError{Field: key, Code: CodeUnknown, Message: "未声明的属性"})
+	}
+	return errs
+}
+
+// PropertiesError 按约束校验属性，存在字段错误时返回汇总全部错误的 error，否则返回 nil。
+// 用于不逐字段返回错误的路径 (批量创建的单项结果、导入)
+func PropertiesError(schema []PropertySchema, props map[string]string, partial bool) error {
+	errs := ValidateProperties(schema, props, partial)
+	if len(errs) == 0 {
+		return nil
+	}
+	parts := make([]string, len(errs))
+	for i, e := range errs {
+		parts[i] = e.Error()
+	}
+	return fmt.Errorf("属性不符合类型约束: %s", strings.Join(parts, "; "))
+}
+
+// validateValue 校验单个非空值，依次检查值类型、长度和正则
+func validateValue(p PropertySchema, value string) (FieldError, bool) {
+	fail := func(code, format string, args ...any) (FieldError, bool) {
+		return FieldError{Field: p.Key, Code: code, Message: fmt.Sprintf(format, args...)}, false
+	}
+
+	switch p.Type {
+	case ValueInt:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fail(CodeInvalidType, "须为整数")
+		}
+	case ValueDate:
+		if !isDate(value) {
+			return fail(CodeInvalidType, "须为日期 (YYYY-MM-DD 或 RFC 3339)")
+		}
+	case ValueURL:
+		u, err := url.Parse(value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fail(CodeInvalidType, "须为 http 或 https URL")
+		}
+	case ValueEnum:
+		if !slices.Contains(p.EnumValues, value) {
+			return fail(CodeInvalidEnum, "须为以下取值之一: %v", p.EnumValues)
+		}
+	}
+
+	length := utf8.RuneCountInString(value)
+	if p.MinLength > 0 && length < p.MinLength {
+		return fail(CodeTooShort, "长度不能少于 %d 个字符", p.MinLength)
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		return fail(CodeTooLong, "长度不能超过 %d 个字符", p.MaxLength)
+	}
+	if p.Pattern != "" {
+		re, err := regexp.Compile(p.Pattern)
+		if err != nil || !re.MatchString(value) {
+			return fail(CodePatternMismatch, "须匹配 %s", p.Pattern)
+		}
+	}
+	return FieldError{}, true
+}
+
+func isDate(value string) bool {
+	if _, err := time.Parse(time.DateOnly, value); err == nil {
+		return true
+	}
+	_, err := time.Parse(time.RFC3339, value)
+	return err == nil
+}
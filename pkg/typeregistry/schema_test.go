@@ -0,0 +1,119 @@
+package typeregistry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	network "labelwall/biz/model/relationship/network"
+)
+
+var personSchema = []PropertySchema{
+	{Key: "age", Type: ValueInt, Required: true},
+	{Key: "birthday", Type: ValueDate},
+	{Key: "gender", Type: ValueEnum, EnumValues: []string{"male", "female"}},
+	{Key: "homepage", Type: ValueURL},
+	{Key: "phone", Pattern: `^\d{11}$`},
+	{Key: "bio", MinLength: 2, MaxLength: 5},
+}
+
+func codes(errs []FieldError) map[string]string {
+	out := make(map[string]string, len(errs))
+	for _, e := range errs {
+		out[e.Field] = e.Code
+	}
+	return out
+}
+
+func TestValidateSchema(t *testing.T) {
+	require.NoError(t, ValidateSchema(personSchema))
+	require.NoError(t, ValidateSchema(nil))
+
+	invalid := map[string][]PropertySchema{
+		"空键":          {{Key: ""}},
+		"非法键":         {{Key: "a-b"}},
+		"保留键":         {{Key: "name"}},
+		"重复键":         {{Key: "a"}, {Key: "a"}},
+		"未知类型":        {{Key: "a", Type: "float"}},
+		"enum 缺少取值":   {{Key: "a", Type: ValueEnum}},
+		"非 enum 设置取值": {{Key: "a", EnumValues: []string{"x"}}},
+		"无效正则":        {{Key: "a", Pattern: "("}},
+		"负长度":         {{Key: "a", MinLength: -1}},
+		"长度范围颠倒":      {{Key: "a", MinLength: 5, MaxLength: 2}},
+	}
+	for name, schema := range invalid {
+		assert.Error(t, ValidateSchema(schema), name)
+	}
+}
+
+func TestValidateProperties(t *testing.T) {
+	assert.Nil(t, ValidateProperties(nil, map[string]string{"anything": "x"}, false), "无约束时不限制")
+
+	valid := map[string]string{
+		"age": "30", "birthday": "1990-01-02", "gender": "female",
+		"homepage": "https://example.com/me", "phone": "13800000000", "bio": "工程师",
+	}
+	assert.Empty(t, ValidateProperties(personSchema, valid, false))
+	assert.Empty(t, ValidateProperties(personSchema, map[string]string{"age": "1", "birthday": "2020-01-02T03:04:05Z"}, false))
+
+	errs := ValidateProperties(personSchema, map[string]string{
+		"birthday": "02/01/1990", "gender": "other", "homepage": "example.com",
+		"phone": "123", "bio": "这是一段很长的简介", "profesion": "engineer",
+	}, false)
+	assert.Equal(t, map[string]string{
+		"age":       CodeRequired,
+		"birthday":  CodeInvalidType,
+		"gender":    CodeInvalidEnum,
+		"homepage":  CodeInvalidType,
+		"phone":     CodePatternMismatch,
+		"bio":       CodeTooLong,
+		"profesion": CodeUnknown,
+	}, codes(errs))
+	assert.Equal(t, "age", errs[0].Field, "按约束顺序返回，未声明的键在最后")
+	assert.Equal(t, "profesion", errs[len(errs)-1].Field)
+
+	assert.Equal(t, map[string]string{"age": CodeInvalidType, "bio": CodeTooShort},
+		codes(ValidateProperties(personSchema, map[string]string{"age": "3.5", "bio": "a"}, false)))
+	assert.Equal(t, map[string]string{"age": CodeRequired},
+		codes(ValidateProperties(personSchema, map[string]string{"age": ""}, false)))
+}
+
+func TestValidateProperties_Partial(t *testing.T) {
+	// 更新时不要求必填键出现，但不能把必填键置空
+	assert.Empty(t, ValidateProperties(personSchema, map[string]string{"gender": "male"}, true))
+	assert.Equal(t, map[string]string{"age": CodeRequired},
+		codes(ValidateProperties(personSchema, map[string]string{"age": ""}, true)))
+	assert.Equal(t, map[string]string{"nickname": CodeUnknown},
+		codes(ValidateProperties(personSchema, map[string]string{"nickname": "x"}, true)))
+	// 可选键的空值视为未设置
+	assert.Empty(t, ValidateProperties(personSchema, map[string]string{"homepage": ""}, true))
+}
+
+func TestRegistry_PropertySchema(t *testing.T) {
+	r := New()
+	assert.Nil(t, r.NodePropertySchema(network.NodeType_PERSON))
+
+	require.NoError(t, r.PutNodeType(NodeTypeDef{Name: "PERSON", Code: network.NodeType_PERSON, Properties: personSchema}))
+	schema := r.NodePropertySchema(network.NodeType_PERSON)
+	assert.Equal(t, personSchema, schema)
+	schema[2].EnumValues[0] = "changed"
+	assert.Equal(t, "male", r.NodePropertySchema(network.NodeType_PERSON)[2].EnumValues[0], "返回副本")
+
+	assert.Error(t, r.PutNodeType(NodeTypeDef{Name: "PERSON", Code: network.NodeType_PERSON, Properties: []PropertySchema{{Key: "id"}}}))
+	assert.Error(t, r.PutRelationType(RelationTypeDef{Name: "FRIEND", Code: network.RelationType_FRIEND, Properties: []PropertySchema{{Key: "x", Type: "bad"}}}))
+
+	since := []PropertySchema{{Key: "since", Type: ValueDate, Required: true}}
+	require.NoError(t, r.PutRelationType(RelationTypeDef{Name: "FRIEND", Code: network.RelationType_FRIEND, Properties: since}))
+	assert.Equal(t, since, r.RelationPropertySchema(network.RelationType_FRIEND))
+}
+
+func TestPropertiesError(t *testing.T) {
+	assert.NoError(t, PropertiesError(personSchema, map[string]string{"age": "30"}, false))
+	assert.NoError(t, PropertiesError(nil, map[string]string{"anything": "x"}, false))
+
+	err := PropertiesError(personSchema, map[string]string{"age": "x", "nickname": "y"}, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "age")
+	assert.Contains(t, err.Error(), "nickname")
+}
@@ -18,6 +18,27 @@ enum RelationType {
     // 可添加更多关系类型
 }
 
+// 属性值类型
+enum PropertyValueKind {
+    STRING = 1
+    INT = 2
+    DOUBLE = 3
+    BOOL = 4
+    DATE = 5          // 日期或时间
+    LIST = 6          // 字符串列表
+}
+
+// 带类型的属性值，按 kind 读取对应的字段
+struct PropertyValue {
+    1: PropertyValueKind kind
+    2: optional string string_value
+    3: optional i64 int_value
+    4: optional double double_value
+    5: optional bool bool_value
+    6: optional string date_value      // YYYY-MM-DD 或 RFC 3339 时间
+    7: optional list<string> list_value
+}
+
 // 节点信息
 struct Node {
     1: string id              // 节点ID
@@ -25,7 +46,8 @@ struct Node {
     3: string name            // 名称
     4: optional string avatar // 头像URL
     5: optional string profession // 职业
-    6: optional map<string, string> properties // 其他属性
+    6: optional map<string, string> properties // 其他属性 (所有值的字符串形式，兼容旧客户端)
+    7: optional map<string, PropertyValue> typed_properties // 带类型的其他属性
 }
 
 // 关系信息
@@ -35,7 +57,8 @@ struct Relation {
     3: string target          // 目标节点ID
     4: RelationType type      // 关系类型
     5: optional string label  // 关系标签
-    6: optional map<string, string> properties // 关系属性
+    6: optional map<string, string> properties // 关系属性 (所有值的字符串形式，兼容旧客户端)
+    7: optional map<string, PropertyValue> typed_properties // 带类型的关系属性
 }
 
 // =============== 节点 CRUD 操作 ===============
@@ -47,6 +70,7 @@ struct CreateNodeRequest {
     3: optional string avatar
     4: optional string profession
     5: optional map<string, string> properties
+    6: optional map<string, PropertyValue> typed_properties // 带类型的属性，与 properties 中的同名键冲突时优先
 }
 
 // 创建节点响应
@@ -54,6 +78,7 @@ struct CreateNodeResponse {
     1: bool success
     2: string message
     3: Node node
+    4: optional list<FieldError> field_errors // 属性不符合类型约束时的逐项错误
 }
 
 // 更新节点请求
@@ -63,6 +88,7 @@ struct UpdateNodeRequest {
     3: optional string avatar
     4: optional string profession
     5: optional map<string, string> properties
+    6: optional map<string, PropertyValue> typed_properties // 带类型的属性，与 properties 中的同名键冲突时优先
 }
 
 // 更新节点响应
@@ -70,6 +96,7 @@ struct UpdateNodeResponse {
     1: bool success
     2: string message
     3: Node node
+    4: optional list<FieldError> field_errors // 属性不符合类型约束时的逐项错误
 }
 
 // 获取节点请求
@@ -95,12 +122,94 @@ struct DeleteNodeResponse {
     2: string message
 }
 
+// 搜索过滤运算符
+enum FilterOp {
+    EQ = 1            // 等于
+    NE = 2            // 不等于
+    CONTAINS = 3      // 包含子串
+    STARTS_WITH = 4   // 前缀匹配
+    ENDS_WITH = 5     // 后缀匹配
+    IN = 6            // 等于 values 中的任意一个
+    GT = 7            // 大于
+    GTE = 8           // 大于等于
+    LT = 9            // 小于
+    LTE = 10          // 小于等于
+    EXISTS = 11       // 属性存在
+    NOT_EXISTS = 12   // 属性不存在
+}
+
+// 过滤表达式中各项的组合方式
+enum FilterLogic {
+    AND = 1
+    OR = 2
+}
+
+// 排序方向
+enum SortOrder {
+    ASC = 1  // 升序
+    DESC = 2 // 降序
+}
+
+// 路径查询模式
+enum PathMode {
+    SINGLE = 1       // 一条最短路径
+    ALL_SHORTEST = 2 // 全部最短路径
+    K_SHORTEST = 3   // 前 k 条最短路径 (Yen 算法)
+    WEIGHTED = 4     // 按关系属性加权的代价最小路径 (Dijkstra，搜索超出展开预算时返回失败)
+}
+
+// 遍历时允许的关系方向
+enum Direction {
+    BOTH = 1     // 不区分方向
+    OUTGOING = 2 // 只沿关系方向 (start -> end) 前进
+    INCOMING = 3 // 只逆着关系方向前进
+}
+
+// 单个属性条件
+struct FilterCondition {
+    1: string field                        // 属性名 (包括 name、profession 等核心属性)
+    2: FilterOp op
+    3: optional PropertyValue value        // 比较值，IN、EXISTS、NOT_EXISTS 不使用
+    4: optional list<PropertyValue> values // IN 的候选值
+    5: optional bool case_insensitive      // 字符串比较时忽略大小写
+}
+
+// 过滤表达式，conditions 和 groups 中的各项按 logic 组合 (默认 AND)，groups 可以嵌套
+struct FilterExpr {
+    1: optional FilterLogic logic
+    2: optional list<FilterCondition> conditions
+    3: optional list<FilterExpr> groups
+}
+
+// 排序键
+struct SortField {
+    1: string field           // 属性名: id、name、created_at、updated_at 等核心属性，或类型属性约束中声明的属性
+    2: optional SortOrder order // 排序方向，默认 ASC
+}
+
 // 搜索节点请求
 struct SearchNodesRequest {
     1: optional map<string, string> criteria // 新增: 搜索条件 (key: 属性名, value: 搜索值)
     2: optional NodeType type   // 节点类型(可选)
     3: optional i32 limit       // 限制返回数量
     4: optional i32 offset      // 偏移量，用于分页
+    5: optional string cursor   // 游标，取自上一页响应的 next_cursor；设置后忽略 offset
+    6: optional FilterExpr filter // 结构化过滤表达式，与 criteria 按 AND 组合 (GET 请求中以 JSON 字符串传入)
+    7: optional list<SortField> sort // 排序键，按顺序比较，未设置时按 name、id 升序 (GET 请求中以 JSON 字符串传入)
+    8: optional list<string> facets // 分面字段: type (节点类型)、name、profession 或类型属性约束中声明的属性，按全部匹配的节点统计
+    9: optional i32 facet_size      // 每个分面最多返回的取值数，默认 10
+}
+
+// 分面中的一个取值及其节点数
+struct FacetBucket {
+    1: string value // 取值: type 分面为节点类型名称，其他字段为属性值的字符串形式
+    2: i32 count    // 匹配该取值的节点数
+}
+
+// 一个字段的分面统计
+struct Facet {
+    1: string field
+    2: list<FacetBucket> buckets // 按节点数降序、取值升序排列，最多 facet_size 个；属性缺失的节点不计入
 }
 
 // 搜索节点响应
@@ -109,6 +218,100 @@ struct SearchNodesResponse {
     2: string message
     3: list<Node> nodes
     4: i32 total               // 总匹配数
+    5: optional string next_cursor // 下一页游标，为空表示没有更多结果
+    6: optional list<Facet> facets // 分面统计，按请求中 facets 的顺序排列
+}
+
+// 全文搜索请求 (基于 Neo4j 全文索引，按相关度排序)
+struct FulltextSearchNodesRequest {
+    1: string query             // 搜索文本，按词切分；中文按单字切分
+    2: optional NodeType type   // 节点类型(可选)
+    3: optional bool fuzzy      // 模糊匹配，允许拼写错误 (3-5 个字符的词容忍 1 处编辑，更长的词容忍 2 处)
+    4: optional i32 limit       // 限制返回数量
+    5: optional i32 offset      // 偏移量，用于分页
+}
+
+// 全文搜索命中项
+struct FulltextHit {
+    1: Node node
+    2: double score                     // 相关度得分，越大越相关
+    3: map<string, string> highlights   // 命中的属性 (name、profession 或自定义属性) 及其高亮文本，匹配部分以 <em></em> 包裹
+}
+
+// 全文搜索响应
+struct FulltextSearchNodesResponse {
+    1: bool success
+    2: string message
+    3: list<FulltextHit> hits
+    4: i32 total               // 总匹配数
+}
+
+// =============== 批量创建 ===============
+
+// 批量创建中的单个节点
+struct BatchNodeItem {
+    1: optional string temp_key // 客户端临时键，同一批次的关系可通过 source_key/target_key 引用
+    2: NodeType type
+    3: string name
+    4: optional string avatar
+    5: optional string profession
+    6: optional map<string, string> properties
+    7: optional map<string, PropertyValue> typed_properties
+}
+
+// 批量创建中的单个关系
+struct BatchRelationItem {
+    1: optional string source     // 源节点ID (已存在的节点)
+    2: optional string target     // 目标节点ID (已存在的节点)
+    3: RelationType type
+    4: optional string label
+    5: optional map<string, string> properties
+    6: optional string source_key // 引用同一批次节点的 temp_key，设置后忽略 source
+    7: optional string target_key // 引用同一批次节点的 temp_key，设置后忽略 target
+    8: optional map<string, PropertyValue> typed_properties
+}
+
+// 单个节点的创建结果
+struct BatchNodeResult {
+    1: i32 index                // 在请求列表中的下标
+    2: optional string temp_key
+    3: bool success
+    4: optional string error
+    5: optional Node node
+}
+
+// 单个关系的创建结果
+struct BatchRelationResult {
+    1: i32 index                // 在请求列表中的下标
+    2: bool success
+    3: optional string error
+    4: optional Relation relation
+}
+
+// 批量创建节点请求
+struct BatchCreateNodesRequest {
+    1: list<BatchNodeItem> nodes
+    2: optional list<BatchRelationItem> relations // 可选，与节点在同一事务中创建的关系，可引用本批次节点的 temp_key
+}
+
+// 批量创建节点响应
+struct BatchCreateNodesResponse {
+    1: bool success
+    2: string message
+    3: list<BatchNodeResult> node_results
+    4: optional list<BatchRelationResult> relation_results
+}
+
+// 批量创建关系请求
+struct BatchCreateRelationsRequest {
+    1: list<BatchRelationItem> relations
+}
+
+// 批量创建关系响应
+struct BatchCreateRelationsResponse {
+    1: bool success
+    2: string message
+    3: list<BatchRelationResult> results
 }
 
 // =============== 关系 CRUD 操作 ===============
@@ -120,6 +323,7 @@ struct CreateRelationRequest {
     3: RelationType type       // 关系类型
     4: optional string label   // 关系标签
     5: optional map<string, string> properties // 关系属性
+    6: optional map<string, PropertyValue> typed_properties // 带类型的属性，与 properties 中的同名键冲突时优先
 }
 
 // 创建关系响应
@@ -127,6 +331,7 @@ struct CreateRelationResponse {
     1: bool success
     2: string message
     3: Relation relation
+    4: optional list<FieldError> field_errors // 属性不符合类型约束时的逐项错误
 }
 
 // 更新关系请求
@@ -135,6 +340,7 @@ struct UpdateRelationRequest {
     2: optional RelationType type
     3: optional string label
     4: optional map<string, string> properties
+    5: optional map<string, PropertyValue> typed_properties // 带类型的属性，与 properties 中的同名键冲突时优先
 }
 
 // 更新关系响应
@@ -142,6 +348,7 @@ struct UpdateRelationResponse {
     1: bool success
     2: string message
     3: Relation relation
+    4: optional list<FieldError> field_errors // 带类型的属性值无效时的逐项错误
 }
 
 // 获取关系请求
@@ -175,6 +382,8 @@ struct GetNodeRelationsRequest {
     4: optional bool incoming  // 是否包含进来的关系，默认true
     5: optional i32 limit      // 限制返回数量
     6: optional i32 offset     // 偏移量，用于分页
+    7: optional string cursor  // 游标，取自上一页响应的 next_cursor；设置后忽略 offset
+    8: optional list<SortField> sort // 排序键，按顺序比较，未设置时按 id 升序 (GET 请求中以 JSON 字符串传入)
 }
 
 // 获取节点关系响应
@@ -183,6 +392,37 @@ struct GetNodeRelationsResponse {
     2: string message
     3: list<Relation> relations
     4: i32 total              // 总关系数
+    5: optional string next_cursor // 下一页游标，为空表示没有更多结果
+}
+
+// 可能认识的人推荐请求
+struct GetRecommendationsRequest {
+    1: string node_id          // 节点ID (PERSON)
+    2: optional i32 limit      // 返回数量，默认 10，最大 50
+}
+
+// 推荐项
+struct Recommendation {
+    1: Node node                             // 推荐的人
+    2: double score                          // 综合得分，越大越可能认识
+    3: i32 mutual_count                      // 共同联系人数
+    4: list<Node> mutual_nodes               // 共同联系人 (推荐理由)
+    5: list<Node> shared_organizations       // 共同所属的公司或学校 (推荐理由)
+    6: double adamic_adar                    // Adamic-Adar 指数
+    7: double jaccard                        // 联系人集合的 Jaccard 相似度
+}
+
+// 可能认识的人推荐响应
+struct GetRecommendationsResponse {
+    1: bool success
+    2: string message
+    3: list<Recommendation> recommendations // 按得分降序
+}
+
+// 按关系类型覆盖遍历方向
+struct RelationDirection {
+    1: RelationType type
+    2: Direction direction
 }
 
 // 网络查询请求
@@ -191,6 +431,12 @@ struct GetNetworkRequest {
     2: optional i32 depth = 1                       // 从起始节点扩展的深度
     3: optional list<RelationType> relationTypes    // 要包含/遍历的关系类型过滤器
     4: optional list<NodeType> nodeTypes            // 最终结果中要包含的节点类型过滤器
+    5: optional i32 limit                           // 每页返回的节点数量 (按节点 id 排序分页)
+    6: optional i32 offset                          // 节点偏移量，用于分页
+    7: optional i32 max_nodes                       // 返回节点数上限
+    8: optional i32 max_relations                   // 返回关系数上限
+    9: optional Direction direction                 // 遍历的关系方向 (从起始节点向外)，默认 BOTH
+    10: optional list<RelationDirection> relation_directions // 按关系类型覆盖 direction
 }
 
 // 网络查询响应
@@ -199,6 +445,29 @@ struct GetNetworkResponse {
     2: string message
     3: list<Node> nodes          // 返回完整节点信息而非ID
     4: list<Relation> relations
+    5: bool truncated            // 图谱是否不完整 (还有更多节点或关系被上限截断)
+}
+
+// 图谱导出请求 (过滤条件与 GetNetworkRequest 相同)
+struct ExportNetworkRequest {
+    1: optional map<string, string> startNodeCriteria // 用于查找起始节点的条件
+    2: optional i32 depth = 1                       // 从起始节点扩展的深度
+    3: optional list<RelationType> relationTypes    // 要包含/遍历的关系类型过滤器
+    4: optional list<NodeType> nodeTypes            // 最终结果中要包含的节点类型过滤器
+    5: optional i32 max_nodes                       // 导出节点数上限
+    6: optional i32 max_relations                   // 导出关系数上限
+    7: optional string format = "graphml"           // 导出格式: graphml | gexf | jgf | cypher
+    8: optional Direction direction                 // 遍历的关系方向 (从起始节点向外)，默认 BOTH
+    9: optional list<RelationDirection> relation_directions // 按关系类型覆盖 direction
+}
+
+// 图谱导出结果 (Handler 按 format 编码后以文件流返回；仅出错时以 JSON 返回)
+struct ExportNetworkResponse {
+    1: bool success
+    2: string message
+    3: list<Node> nodes
+    4: list<Relation> relations
+    5: bool truncated            // 是否因上限截断
 }
 
 // 路径查询请求
@@ -207,6 +476,24 @@ struct GetPathRequest {
     2: string target_id          // 目标节点ID
     3: optional i32 max_depth    // 最大查询深度
     4: optional list<RelationType> types // 关系类型筛选(可选)
+    5: optional PathMode mode    // 查询模式，默认 SINGLE
+    6: optional i32 k            // K_SHORTEST 模式返回的路径数，默认 3
+    7: optional string weight_property // WEIGHTED 模式作为代价的关系属性，默认 weight
+    8: optional double default_weight  // 关系缺少该属性 (或不是非负数值) 时的代价，默认 1
+    9: optional Direction direction    // 关系方向 (从起点走向终点)，默认 BOTH
+    // 设置 relation_directions 中与 direction 不同的方向或任一节点约束 (11-13) 时，服务端逐个节点展开搜索路径，
+    // 与 WEIGHTED 模式共用搜索预算 (最多展开 20000 次、读取 2000 个节点的关系)，超出时返回失败，需要减小 max_depth
+    10: optional list<RelationDirection> relation_directions // 按关系类型覆盖 direction
+    11: optional list<string> exclude_node_ids       // 路径不能经过的节点ID
+    12: optional list<string> via_node_ids           // 路径必须经过的节点ID (顺序不限)
+    13: optional list<NodeType> intermediate_node_types // 中间节点允许的类型 (via_node_ids 中的节点除外)
+}
+
+// 一条路径
+struct Path {
+    1: list<Node> nodes          // 路径上的节点，从起点到终点
+    2: list<Relation> relations  // 路径上的关系，relations[i] 连接 nodes[i] 和 nodes[i+1]
+    3: optional double cost      // WEIGHTED 模式下路径的总代价
 }
 
 // 路径查询响应
@@ -215,6 +502,103 @@ struct GetPathResponse {
     2: string message
     3: list<Node> nodes          // 路径上的节点
     4: list<Relation> relations  // 路径上的关系
+    5: optional list<Path> paths // 找到的全部路径，按长度升序；nodes 和 relations 与第一条路径相同
+    6: optional double total_cost // WEIGHTED 模式下路径的总代价
+}
+
+// =============== 类型注册表 (管理接口) ===============
+
+// 属性约束，对应 properties 中的一个键
+struct PropertySchema {
+    1: string key                          // 属性键
+    2: string type                         // 值类型: string (默认)、int、date、enum、url
+    3: bool required                       // 创建时是否必填
+    4: optional list<string> enum_values   // type 为 enum 时允许的取值
+    5: optional string pattern             // 值须匹配的正则表达式
+    6: optional i64 min_length             // 最小长度 (字符数)
+    7: optional i64 max_length             // 最大长度 (字符数)
+}
+
+// 属性校验错误
+struct FieldError {
+    1: string field           // 属性键
+    2: string code            // 错误码: required、unknown、invalid_type、invalid_enum、pattern_mismatch、too_short、too_long
+    3: string message         // 错误说明
+}
+
+// 节点类型定义
+struct NodeTypeInfo {
+    1: string name            // 类型名，即 Neo4j 标签
+    2: i64 code               // 类型编码，作为 NodeType 的值使用
+    3: bool builtin           // 是否为 Thrift 枚举中的内置类型
+    4: optional list<PropertySchema> properties // 属性约束，为空表示不限制
+}
+
+// 关系允许连接的节点类型组合
+struct TypePair {
+    1: string source          // 起始节点类型名
+    2: string target          // 目标节点类型名
+}
+
+// 关系类型定义
+struct RelationTypeInfo {
+    1: string name            // 类型名，即 Neo4j 关系类型
+    2: i64 code               // 类型编码，作为 RelationType 的值使用
+    3: bool builtin           // 是否为 Thrift 枚举中的内置类型
+    4: optional list<TypePair> allowed_pairs // 允许的节点类型组合，为空表示不限制
+    5: optional list<PropertySchema> properties // 属性约束，为空表示不限制
+}
+
+// 获取类型列表请求
+struct ListTypesRequest {
+}
+
+// 获取类型列表响应
+struct ListTypesResponse {
+    1: bool success
+    2: string message
+    3: list<NodeTypeInfo> node_types
+    4: list<RelationTypeInfo> relation_types
+}
+
+// 创建节点类型请求
+struct CreateNodeTypeRequest {
+    1: string name            // 类型名 (大写字母、数字和下划线)
+    2: optional list<PropertySchema> properties // 属性约束，为空表示不限制
+}
+
+// 创建节点类型响应
+struct CreateNodeTypeResponse {
+    1: bool success
+    2: string message
+    3: NodeTypeInfo node_type
+}
+
+// 更新节点类型请求 (替换属性约束)
+struct UpdateNodeTypeRequest {
+    1: string name            // 类型名
+    2: optional list<PropertySchema> properties // 属性约束，为空表示不限制
+}
+
+// 更新节点类型响应
+struct UpdateNodeTypeResponse {
+    1: bool success
+    2: string message
+    3: NodeTypeInfo node_type
+}
+
+// 创建或更新关系类型请求
+struct SaveRelationTypeRequest {
+    1: string name            // 类型名 (大写字母、数字和下划线)
+    2: optional list<TypePair> allowed_pairs // 允许的节点类型组合，为空表示不限制
+    3: optional list<PropertySchema> properties // 属性约束，为空表示不限制
+}
+
+// 创建或更新关系类型响应
+struct SaveRelationTypeResponse {
+    1: bool success
+    2: string message
+    3: RelationTypeInfo relation_type
 }
 
 // 关系网络服务定义
@@ -222,24 +606,41 @@ service NetworkService {
     // 网络查询
     GetNetworkResponse GetNetwork(1: GetNetworkRequest req) (api.get="/api/v1/network")
 
+    // 图谱导出 (GraphML / GEXF / JSON Graph / Cypher)
+    ExportNetworkResponse ExportNetwork(1: ExportNetworkRequest req) (api.get="/api/v1/network/export")
+
     // 路径查询
     GetPathResponse GetPath(1: GetPathRequest req) (api.get="/api/v1/path")
 
     // 搜索节点
     SearchNodesResponse SearchNodes(1: SearchNodesRequest req) (api.get="/api/v1/nodes/search")
 
+    // 全文搜索节点 (相关度排序、高亮、模糊匹配)
+    FulltextSearchNodesResponse FulltextSearchNodes(1: FulltextSearchNodesRequest req) (api.get="/api/v1/nodes/fulltext")
+
     // 节点 CRUD
     CreateNodeResponse CreateNode(1: CreateNodeRequest req) (api.post="/api/v1/nodes")
     GetNodeResponse GetNode(1: GetNodeRequest req) (api.get="/api/v1/nodes/:id")
     UpdateNodeResponse UpdateNode(1: UpdateNodeRequest req) (api.put="/api/v1/nodes/:id")
     DeleteNodeResponse DeleteNode(1: DeleteNodeRequest req) (api.delete="/api/v1/nodes/:id")
+    BatchCreateNodesResponse BatchCreateNodes(1: BatchCreateNodesRequest req) (api.post="/api/v1/nodes/batch")
 
     // 关系 CRUD
     CreateRelationResponse CreateRelation(1: CreateRelationRequest req) (api.post="/api/v1/relations")
     GetRelationResponse GetRelation(1: GetRelationRequest req) (api.get="/api/v1/relations/:id")
     UpdateRelationResponse UpdateRelation(1: UpdateRelationRequest req) (api.put="/api/v1/relations/:id")
     DeleteRelationResponse DeleteRelation(1: DeleteRelationRequest req) (api.delete="/api/v1/relations/:id")
+    BatchCreateRelationsResponse BatchCreateRelations(1: BatchCreateRelationsRequest req) (api.post="/api/v1/relations/batch")
 
     // 获取节点的所有关系
     GetNodeRelationsResponse GetNodeRelations(1: GetNodeRelationsRequest req) (api.get="/api/v1/nodes/:node_id/relations")
+
+    // 可能认识的人 (二跳推荐)
+    GetRecommendationsResponse GetRecommendations(1: GetRecommendationsRequest req) (api.get="/api/v1/nodes/:node_id/recommendations")
+
+    // 类型注册表管理
+    ListTypesResponse ListTypes(1: ListTypesRequest req) (api.get="/api/v1/admin/types")
+    CreateNodeTypeResponse CreateNodeType(1: CreateNodeTypeRequest req) (api.post="/api/v1/admin/types/nodes")
+    SaveRelationTypeResponse SaveRelationType(1: SaveRelationTypeRequest req) (api.put="/api/v1/admin/types/relations/:name")
+    UpdateNodeTypeResponse UpdateNodeType(1: UpdateNodeTypeRequest req) (api.put="/api/v1/admin/types/nodes/:name")
 }